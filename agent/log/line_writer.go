@@ -16,13 +16,14 @@
 package log
 
 import (
-	"io"
+	"encoding/json"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/common"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/shared"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 )
@@ -36,36 +37,116 @@ type LineWriter struct {
 	num       int
 	startTime time.Time
 	replacer  *strings.Replacer
+	// markers is set if the step script prints command markers, see
+	// common.HasCommandMarkers
+	markers bool
+	// command is the command currently running, as told by the markers of
+	// the step script
+	command *rpc.LogCommand
 }
 
-// NewLineWriter returns a new line reader.
-func NewLineWriter(peer rpc.Peer, stepUUID string, secret ...string) io.Writer {
+// NewLineWriter returns a new line reader. Command markers are only parsed if
+// markers is set, so other steps can not fake them.
+func NewLineWriter(peer rpc.Peer, stepUUID string, markers bool, secret ...string) *LineWriter {
 	lw := &LineWriter{
 		peer:      peer,
 		stepUUID:  stepUUID,
 		startTime: time.Now().UTC(),
 		replacer:  shared.NewSecretsReplacer(secret),
+		markers:   markers,
 	}
 	return lw
 }
 
 func (w *LineWriter) Write(p []byte) (n int, err error) {
+	w.Lock()
+	defer w.Unlock()
+
 	data := string(p)
-	if w.replacer != nil {
-		data = w.replacer.Replace(data)
+	var marker common.CommandMarker
+	var isMarker bool
+	if w.markers {
+		var output string
+		if marker, output, isMarker = common.ParseCommandMarker(data); isMarker {
+			data = output
+		}
+	}
+
+	if data != "" {
+		if w.replacer != nil {
+			data = w.replacer.Replace(data)
+		}
+		log.Trace().Str("step-uuid", w.stepUUID).Msgf("grpc write line: %s", data)
+
+		w.enqueue(rpc.LogEntryStdout, []byte(strings.TrimSuffix(data, "\n"))) // remove trailing newline
+	}
+
+	if isMarker {
+		switch marker.Kind {
+		case common.CommandMarkerStart:
+			// commands run one after another and a failing one ends the
+			// script, so the previous command succeeded
+			exitCode := 0
+			w.endCommand(&exitCode)
+			w.startCommand(marker.Value)
+		case common.CommandMarkerExit:
+			exitCode := marker.Value
+			w.endCommand(&exitCode)
+		}
+	}
+
+	return len(p), nil
+}
+
+// Finish ends the command still running once the log stream is closed,
+// e.g. because the step got killed before its script could mark the end.
+func (w *LineWriter) Finish() {
+	w.Lock()
+	defer w.Unlock()
+
+	w.endCommand(nil)
+}
+
+func (w *LineWriter) startCommand(index int) {
+	w.command = &rpc.LogCommand{
+		Event:   rpc.LogCommandStart,
+		Index:   index,
+		Started: time.Now().UnixMilli(),
+	}
+	w.enqueueCommand(w.command)
+}
+
+func (w *LineWriter) endCommand(exitCode *int) {
+	if w.command == nil {
+		return
+	}
+
+	w.enqueueCommand(&rpc.LogCommand{
+		Event:    rpc.LogCommandEnd,
+		Index:    w.command.Index,
+		Started:  w.command.Started,
+		Duration: time.Now().UnixMilli() - w.command.Started,
+		ExitCode: exitCode,
+	})
+	w.command = nil
+}
+
+func (w *LineWriter) enqueueCommand(command *rpc.LogCommand) {
+	data, err := json.Marshal(command)
+	if err != nil {
+		log.Error().Err(err).Str("step-uuid", w.stepUUID).Msg("could not encode command of log")
+		return
 	}
-	log.Trace().Str("step-uuid", w.stepUUID).Msgf("grpc write line: %s", data)
+	w.enqueue(rpc.LogEntryMetadata, data)
+}
 
-	line := &rpc.LogEntry{
-		Data:     []byte(strings.TrimSuffix(data, "\n")), // remove trailing newline
+func (w *LineWriter) enqueue(entryType int, data []byte) {
+	w.peer.EnqueueLog(&rpc.LogEntry{
+		Data:     data,
 		StepUUID: w.stepUUID,
 		Time:     int64(time.Since(w.startTime).Seconds()),
-		Type:     rpc.LogEntryStdout,
+		Type:     entryType,
 		Line:     w.num,
-	}
-
+	})
 	w.num++
-
-	w.peer.EnqueueLog(line)
-	return len(data), nil
 }
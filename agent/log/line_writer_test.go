@@ -15,10 +15,12 @@
 package log_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"go.woodpecker-ci.org/woodpecker/v3/agent/log"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
@@ -30,7 +32,7 @@ func TestLineWriter(t *testing.T) {
 	peer.On("EnqueueLog", mock.Anything)
 
 	secrets := []string{"world"}
-	lw := log.NewLineWriter(peer, "e9ea76a5-44a1-4059-9c4a-6956c478b26d", secrets...)
+	lw := log.NewLineWriter(peer, "e9ea76a5-44a1-4059-9c4a-6956c478b26d", false, secrets...)
 
 	_, err := lw.Write([]byte("hello world\n"))
 	assert.NoError(t, err)
@@ -55,3 +57,77 @@ func TestLineWriter(t *testing.T) {
 
 	peer.AssertExpectations(t)
 }
+
+func TestLineWriterCommands(t *testing.T) {
+	var entries []*rpc.LogEntry
+	peer := rpc_mocks.NewMockPeer(t)
+	peer.On("EnqueueLog", mock.Anything).Run(func(args mock.Arguments) {
+		entries = append(entries, args.Get(0).(*rpc.LogEntry))
+	})
+
+	lw := log.NewLineWriter(peer, "e9ea76a5-44a1-4059-9c4a-6956c478b26d", true)
+	for _, line := range []string{
+		"::woodpecker::command::start::0\n",
+		"+ make build\n",
+		"::woodpecker::command::start::1\n",
+		"+ make test\n",
+		"no newline::woodpecker::command::exit::2\n",
+		"::woodpecker::command::start::0\n",
+	} {
+		_, err := lw.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	lw.Finish()
+
+	type entry struct {
+		line    int
+		typ     int
+		data    string
+		command rpc.LogCommand
+	}
+	var got []entry
+	for _, e := range entries {
+		got = append(got, entry{line: e.Line, typ: e.Type})
+		if e.Type != rpc.LogEntryMetadata {
+			got[len(got)-1].data = string(e.Data)
+			continue
+		}
+		var command rpc.LogCommand
+		require.NoError(t, json.Unmarshal(e.Data, &command))
+		assert.NotZero(t, command.Started)
+		command.Started, command.Duration = 0, 0
+		got[len(got)-1].command = command
+	}
+
+	zero, failed := 0, 2
+	assert.Equal(t, []entry{
+		{line: 0, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandStart, Index: 0}},
+		{line: 1, typ: rpc.LogEntryStdout, data: "+ make build"},
+		{line: 2, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandEnd, Index: 0, ExitCode: &zero}},
+		{line: 3, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandStart, Index: 1}},
+		{line: 4, typ: rpc.LogEntryStdout, data: "+ make test"},
+		{line: 5, typ: rpc.LogEntryStdout, data: "no newline"},
+		{line: 6, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandEnd, Index: 1, ExitCode: &failed}},
+		// a command still running when the log stream closes has no exit code
+		{line: 7, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandStart, Index: 0}},
+		{line: 8, typ: rpc.LogEntryMetadata, command: rpc.LogCommand{Event: rpc.LogCommandEnd, Index: 0}},
+	}, got)
+}
+
+func TestLineWriterWithoutMarkers(t *testing.T) {
+	var entries []*rpc.LogEntry
+	peer := rpc_mocks.NewMockPeer(t)
+	peer.On("EnqueueLog", mock.Anything).Run(func(args mock.Arguments) {
+		entries = append(entries, args.Get(0).(*rpc.LogEntry))
+	})
+
+	// plugins and services can not fake command markers
+	lw := log.NewLineWriter(peer, "e9ea76a5-44a1-4059-9c4a-6956c478b26d", false)
+	_, err := lw.Write([]byte("::woodpecker::command::start::0\n"))
+	require.NoError(t, err)
+	lw.Finish()
+
+	require.Len(t, entries, 1)
+	assert.Equal(t, rpc.LogEntryStdout, entries[0].Type)
+	assert.Equal(t, "::woodpecker::command::start::0", string(entries[0].Data))
+}
@@ -16,11 +16,13 @@ package agent
 
 import (
 	"io"
+	"sync"
 
 	"github.com/rs/zerolog"
 
 	"go.woodpecker-ci.org/woodpecker/v3/agent/log"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/common"
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/logging"
 	pipeline_utils "go.woodpecker-ci.org/woodpecker/v3/pipeline/utils"
@@ -28,6 +30,11 @@ import (
 )
 
 func (r *Runner) createLogger(_logger zerolog.Logger, workflow *rpc.Workflow) logging.Logger {
+	// a retried step opens a log stream per attempt, all attempts share one
+	// writer so the line numbers continue instead of starting over
+	var mu sync.Mutex
+	logStreams := make(map[string]*log.LineWriter)
+
 	return func(step *backend_types.Step, rc io.ReadCloser) error {
 		defer rc.Close()
 
@@ -42,10 +49,18 @@ func (r *Runner) createLogger(_logger zerolog.Logger, workflow *rpc.Workflow) lo
 
 		logger.Debug().Msg("log stream opened")
 
-		logStream := log.NewLineWriter(r.client, step.UUID, secrets...)
+		mu.Lock()
+		logStream, ok := logStreams[step.UUID]
+		if !ok {
+			logStream = log.NewLineWriter(r.client, step.UUID, common.HasCommandMarkers(step), secrets...)
+			logStreams[step.UUID] = logStream
+		}
+		mu.Unlock()
+
 		if err := pipeline_utils.CopyLineByLine(logStream, rc, pipeline.MaxLogLineLength); err != nil {
 			logger.Error().Err(err).Msg("copy limited logStream part")
 		}
+		logStream.Finish()
 
 		logger.Debug().Msg("log stream copied, close ...")
 		return nil
@@ -0,0 +1,175 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+)
+
+// artifactChunkSize is the size of the chunks artifacts are uploaded in.
+const artifactChunkSize = 512 * 1024
+
+type artifactClient struct {
+	client proto.WoodpeckerClient
+}
+
+// NewArtifactClient returns a client transferring the artifacts of a workflow
+// over a connection built by DialArtifacts.
+func NewArtifactClient(conn grpc.ClientConnInterface) artifact.Client {
+	return &artifactClient{client: proto.NewWoodpeckerClient(conn)}
+}
+
+// Upload streams an artifact to the server in chunks, the name is sent with
+// the first one.
+func (c *artifactClient) Upload(ctx context.Context, name string, content io.Reader) error {
+	stream, err := c.client.UploadArtifact(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &proto.UploadArtifactRequest{Name: name}
+	for {
+		buf := make([]byte, artifactChunkSize)
+		n, err := io.ReadFull(content, buf)
+		if n > 0 || req.GetName() != "" {
+			req.Data = buf[:n]
+			if err := stream.Send(req); err != nil {
+				// the server closed the stream, its error is returned by CloseAndRecv
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return err
+			}
+			req = &proto.UploadArtifactRequest{}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// Download receives the artifacts of the workflows with the given name. Each
+// one starts with a chunk carrying its name.
+func (c *artifactClient) Download(ctx context.Context, workflow string, fn func(name string, content io.Reader) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.client.DownloadArtifact(ctx, &proto.DownloadArtifactRequest{Workflow: workflow})
+	if err != nil {
+		return err
+	}
+
+	reader := &chunkReader{stream: stream}
+	for {
+		name, err := reader.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(name, reader); err != nil {
+			return err
+		}
+		// skip what was not read of the artifact
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return err
+		}
+	}
+}
+
+// UploadReport sends the test reports of a step to the server at once, they
+// replace the results of any earlier upload of the step.
+func (c *artifactClient) UploadReport(ctx context.Context, step, format string, files []artifact.ReportFile) error {
+	req := &proto.UploadReportRequest{
+		Step:   step,
+		Format: format,
+		Files:  make([]*proto.ReportFile, 0, len(files)),
+	}
+	for _, file := range files {
+		req.Files = append(req.Files, &proto.ReportFile{Name: file.Name, Data: file.Data})
+	}
+
+	_, err := c.client.UploadReport(ctx, req)
+	return err
+}
+
+// chunkReader reads the content of one artifact after another from a
+// download stream.
+type chunkReader struct {
+	stream  grpc.ServerStreamingClient[proto.ArtifactChunk]
+	pending *proto.ArtifactChunk
+	data    []byte
+	err     error
+}
+
+// next moves to the next artifact of the stream and returns its name.
+func (r *chunkReader) next() (string, error) {
+	if r.pending == nil && r.err == nil {
+		r.pending, r.err = r.stream.Recv()
+	}
+	if r.pending == nil {
+		return "", r.err
+	}
+	if r.pending.GetName() == "" {
+		return "", errors.New("artifact chunk without a name")
+	}
+
+	name := r.pending.GetName()
+	r.data = r.pending.GetData()
+	r.pending = nil
+	return name, nil
+}
+
+// Read returns the content of the current artifact, it ends at the first
+// chunk of the next one.
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if r.pending != nil {
+			return 0, io.EOF
+		}
+		if r.err != nil {
+			return 0, r.err
+		}
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if chunk.GetName() != "" {
+			r.pending = chunk
+			return 0, io.EOF
+		}
+		r.data = chunk.GetData()
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+)
+
+type artifactServer struct {
+	proto.UnimplementedWoodpeckerServer
+	uploads map[string][]byte
+	reports map[string]*proto.UploadReportRequest
+}
+
+func checkArtifactToken(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token := md.Get("artifact-token"); len(token) != 1 || token[0] != "secret" {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+func (s *artifactServer) UploadArtifact(stream proto.Woodpecker_UploadArtifactServer) error {
+	if err := checkArtifactToken(stream.Context()); err != nil {
+		return err
+	}
+	var name string
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if req.GetName() != "" {
+			name = req.GetName()
+		}
+		data = append(data, req.GetData()...)
+	}
+	s.uploads[name] = data
+	return stream.SendAndClose(new(proto.Empty))
+}
+
+func (s *artifactServer) DownloadArtifact(req *proto.DownloadArtifactRequest, stream proto.Woodpecker_DownloadArtifactServer) error {
+	if err := checkArtifactToken(stream.Context()); err != nil {
+		return err
+	}
+	if req.GetWorkflow() != "build" {
+		return nil
+	}
+	chunks := []*proto.ArtifactChunk{
+		{Name: "app", Data: []byte("ab")},
+		{Data: []byte("cd")},
+		{Name: "empty"},
+		{Name: "docs", Data: []byte("ef")},
+	}
+	for _, chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *artifactServer) UploadReport(ctx context.Context, req *proto.UploadReportRequest) (*proto.Empty, error) {
+	if err := checkArtifactToken(ctx); err != nil {
+		return nil, err
+	}
+	s.reports[req.GetStep()] = req
+	return new(proto.Empty), nil
+}
+
+func startArtifactServer(t *testing.T) (*artifactServer, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	impl := &artifactServer{uploads: map[string][]byte{}, reports: map[string]*proto.UploadReportRequest{}}
+	server := grpc.NewServer()
+	proto.RegisterWoodpeckerServer(server, impl)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return impl, listener.Addr().String()
+}
+
+func TestArtifactClient(t *testing.T) {
+	impl, addr := startArtifactServer(t)
+
+	conn, err := DialArtifacts(addr, "secret", false, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := NewArtifactClient(conn)
+
+	large := bytes.Repeat([]byte("x"), artifactChunkSize+10)
+	require.NoError(t, client.Upload(t.Context(), "large", bytes.NewReader(large)))
+	require.NoError(t, client.Upload(t.Context(), "empty", strings.NewReader("")))
+	assert.Equal(t, large, impl.uploads["large"])
+	assert.Contains(t, impl.uploads, "empty")
+
+	downloads := map[string]string{}
+	err = client.Download(t.Context(), "build", func(name string, content io.Reader) error {
+		// only read a part of the artifact, the rest has to be skipped
+		if name == "docs" {
+			buf := make([]byte, 1)
+			_, err := content.Read(buf)
+			downloads[name] = string(buf)
+			return err
+		}
+		data, err := io.ReadAll(content)
+		downloads[name] = string(data)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "abcd", "empty": "", "docs": "e"}, downloads)
+
+	err = client.Download(t.Context(), "lint", func(string, io.Reader) error {
+		t.Fatal("workflow lint has no artifacts")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	require.NoError(t, client.UploadReport(t.Context(), "test", "junit", []artifact.ReportFile{
+		{Name: "reports/unit.xml", Data: []byte("<testsuite/>")},
+	}))
+	require.Contains(t, impl.reports, "test")
+	assert.Equal(t, "junit", impl.reports["test"].GetFormat())
+	require.Len(t, impl.reports["test"].GetFiles(), 1)
+	assert.Equal(t, "reports/unit.xml", impl.reports["test"].GetFiles()[0].GetName())
+	assert.Equal(t, "<testsuite/>", string(impl.reports["test"].GetFiles()[0].GetData()))
+}
+
+func TestArtifactClientInvalidToken(t *testing.T) {
+	_, addr := startArtifactServer(t)
+
+	conn, err := DialArtifacts(addr, "invalid", false, false)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := NewArtifactClient(conn)
+
+	err = client.Upload(t.Context(), "app", strings.NewReader("app"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = client.Download(t.Context(), "build", func(string, io.Reader) error { return nil })
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	err = client.UploadReport(t.Context(), "test", "junit", nil)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
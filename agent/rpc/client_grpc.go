@@ -241,9 +241,10 @@ func (c *client) Next(ctx context.Context, filter rpc.Filter) (*rpc.Workflow, er
 	}
 
 	w := &rpc.Workflow{
-		ID:      res.GetWorkflow().GetId(),
-		Timeout: res.GetWorkflow().GetTimeout(),
-		Config:  new(backend_types.Config),
+		ID:            res.GetWorkflow().GetId(),
+		Timeout:       res.GetWorkflow().GetTimeout(),
+		Config:        new(backend_types.Config),
+		ArtifactToken: res.GetWorkflow().GetArtifactToken(),
 	}
 	if err := json.Unmarshal(res.GetWorkflow().GetPayload(), w.Config); err != nil {
 		log.Error().Err(err).Msgf("could not unmarshal workflow config of '%s'", w.ID)
@@ -302,6 +303,7 @@ func (c *client) Done(ctx context.Context, workflowID string, state rpc.Workflow
 			Finished: state.Finished,
 			Error:    state.Error,
 			Canceled: state.Canceled,
+			Failure:  state.Failure,
 		},
 	}
 
@@ -434,6 +436,7 @@ func (c *client) RegisterAgent(ctx context.Context, info rpc.AgentInfo) (int64,
 			Version:      info.Version,
 			Capacity:     int32(info.Capacity),
 			CustomLabels: info.CustomLabels,
+			Resources:    toProtoResources(info.Resources),
 		},
 	}
 
@@ -446,8 +449,11 @@ func (c *client) UnregisterAgent(ctx context.Context) error {
 	return err
 }
 
-func (c *client) ReportHealth(ctx context.Context) error {
-	req := &proto.ReportHealthRequest{Status: "I am alive!"}
+func (c *client) ReportHealth(ctx context.Context, resources rpc.Resources) error {
+	req := &proto.ReportHealthRequest{
+		Status:    "I am alive!",
+		Resources: toProtoResources(resources),
+	}
 
 	_, err := retryRPC(ctx, c, "report_health", func() (*proto.Empty, error) {
 		if !c.IsConnected() {
@@ -458,3 +464,11 @@ func (c *client) ReportHealth(ctx context.Context) error {
 	})
 	return err
 }
+
+func toProtoResources(resources rpc.Resources) *proto.Resources {
+	return &proto.Resources{
+		Cpu:    resources.CPU,
+		Memory: resources.Memory,
+		Disk:   resources.Disk,
+	}
+}
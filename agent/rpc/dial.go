@@ -27,6 +27,7 @@ import (
 	grpc_credentials "google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
 // DialConfig bundles everything Dial needs. Callers build this from a
@@ -69,14 +70,7 @@ func (c *AgentConn) Close() {
 // inside the interceptor; callers typically want a context separate from
 // request ctx so the interceptor survives long-running polls.
 func Dial(authCtx context.Context, cfg DialConfig) (*AgentConn, error) {
-	var transport grpc.DialOption
-	if cfg.Secure {
-		transport = grpc.WithTransportCredentials(grpc_credentials.NewTLS(
-			&tls.Config{InsecureSkipVerify: cfg.SkipTLSVerify}, //nolint:gosec // user-opt-in via DialConfig.SkipTLSVerify
-		))
-	} else {
-		transport = grpc.WithTransportCredentials(insecure.NewCredentials())
-	}
+	transport := transportCredentials(cfg.Secure, cfg.SkipTLSVerify)
 
 	keepaliveOpts := grpc.WithKeepaliveParams(keepalive.ClientParameters{
 		Time:    cfg.KeepaliveTime,
@@ -124,3 +118,31 @@ func Dial(authCtx context.Context, cfg DialConfig) (*AgentConn, error) {
 		AgentID:         reportedAgentID,
 	}, nil
 }
+
+// DialArtifacts builds the connection of an artifact step. It authenticates
+// every call with the artifact token of its workflow instead of the agent
+// token.
+func DialArtifacts(serverAddr, artifactToken string, secure, skipTLSVerify bool) (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(
+		serverAddr, transportCredentials(secure, skipTLSVerify),
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, "artifact-token", artifactToken), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(metadata.AppendToOutgoingContext(ctx, "artifact-token", artifactToken), desc, cc, method, opts...)
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create artifact gRPC connection: %w", err)
+	}
+	return conn, nil
+}
+
+func transportCredentials(secure, skipTLSVerify bool) grpc.DialOption {
+	if secure {
+		return grpc.WithTransportCredentials(grpc_credentials.NewTLS(
+			&tls.Config{InsecureSkipVerify: skipTLSVerify}, //nolint:gosec // user-opt-in via skipTLSVerify
+		))
+	}
+	return grpc.WithTransportCredentials(insecure.NewCredentials())
+}
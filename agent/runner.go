@@ -25,7 +25,9 @@ import (
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/metadata"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	pipeline_runtime "go.woodpecker-ci.org/woodpecker/v3/pipeline/runtime"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
@@ -41,15 +43,19 @@ type Runner struct {
 	hostname string
 	counter  *State
 	backend  backend_types.Backend
+	cache    *cache.Config
+	artifact *artifact.Config
 }
 
-func NewRunner(workEngine rpc.Peer, f rpc.Filter, h string, state *State, backend backend_types.Backend) Runner {
+func NewRunner(workEngine rpc.Peer, f rpc.Filter, h string, state *State, backend backend_types.Backend, cache *cache.Config, artifact *artifact.Config) Runner {
 	return Runner{
 		client:   workEngine,
 		filter:   f,
 		hostname: h,
 		counter:  state,
 		backend:  backend,
+		cache:    cache,
+		artifact: artifact,
 	}
 }
 
@@ -167,9 +173,11 @@ func (r *Runner) Run(runnerCtx context.Context) error {
 			step.Environment["CI_SYSTEM_PLATFORM"] = runtime.GOOS + "/" + runtime.GOARCH
 		}
 	}
+	r.cache.Prepare(workflow.Config)
+	r.artifact.Prepare(workflow.Config, workflow.ArtifactToken)
 
 	// Run pipeline
-	err = pipeline_runtime.New(
+	workflowRuntime := pipeline_runtime.New(
 		workflow.Config,
 		r.backend,
 		pipeline_runtime.WithContext(workflowCtx),
@@ -181,7 +189,8 @@ func (r *Runner) Run(runnerCtx context.Context) error {
 			"repo":            repoName,
 			"pipeline_number": pipelineNumber,
 		}),
-	).Run(runnerCtx)
+	)
+	err = workflowRuntime.Run(runnerCtx)
 
 	state.Finished = time.Now().Unix()
 
@@ -195,9 +204,13 @@ func (r *Runner) Run(runnerCtx context.Context) error {
 			state.Error = err.Error()
 		}
 	}
+	if !state.Canceled {
+		state.Failure = failureClass(errors.Join(err, workflowRuntime.Err()))
+	}
 
 	logger.Debug().
 		Str("error", state.Error).
+		Str("failure", state.Failure).
 		Bool("canceled", state.Canceled).
 		Msg("workflow finished")
 
@@ -219,6 +232,21 @@ func (r *Runner) Run(runnerCtx context.Context) error {
 	return nil
 }
 
+// failureClass tells the server whether the workflow failed because of the
+// infrastructure it ran on, so the server can decide to run it again.
+func failureClass(err error) string {
+	var setupErr *pipeline_errors.SetupError
+	var oomErr *pipeline_errors.OomError
+	switch {
+	case errors.As(err, &setupErr):
+		return rpc.FailureSetupError
+	case errors.As(err, &oomErr):
+		return rpc.FailureOOM
+	default:
+		return ""
+	}
+}
+
 func extractRepositoryName(config *backend_types.Config) string {
 	return config.Stages[0].Steps[0].Environment["CI_REPO"]
 }
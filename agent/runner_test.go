@@ -24,8 +24,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	rpc_mocks "go.woodpecker-ci.org/woodpecker/v3/rpc/mocks"
 )
@@ -88,10 +90,48 @@ func TestRunReportsCancelWithoutError(t *testing.T) {
 		Return(nil)
 
 	counter := &State{Metadata: map[string]Info{}}
-	runner := NewRunner(peer, rpc.Filter{}, "test-agent", counter, engine)
+	runner := NewRunner(peer, rpc.Filter{}, "test-agent", counter, engine, &cache.Config{}, &artifact.Config{})
 
 	assert.NoError(t, runner.Run(t.Context()))
 
 	assert.True(t, done.Canceled, "the workflow must be reported as canceled")
 	assert.Empty(t, done.Error, "a cancellation is not a workflow error")
 }
+
+// A workflow the backend could not set up is reported with its failure class,
+// so the server can run it again.
+func TestRunReportsSetupFailure(t *testing.T) {
+	engine := mocks.NewMockBackend(t)
+	engine.On("SetupWorkflow", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("docker daemon not reachable"))
+	engine.On("DestroyWorkflow", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	var done rpc.WorkflowState
+	peer := rpc_mocks.NewMockPeer(t)
+	peer.On("Next", mock.Anything, mock.Anything).Return(dummyWorkflow(), nil)
+	peer.On("Init", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	peer.On("Wait", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			if ctx, ok := args.Get(0).(context.Context); ok {
+				<-ctx.Done()
+			}
+		}).
+		Return(false, nil).Maybe()
+	peer.On("Done", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			state, ok := args.Get(2).(rpc.WorkflowState)
+			if !ok {
+				t.Error("Done called without a workflow state")
+				return
+			}
+			done = state
+		}).
+		Return(nil)
+
+	counter := &State{Metadata: map[string]Info{}}
+	runner := NewRunner(peer, rpc.Filter{}, "test-agent", counter, engine, &cache.Config{}, &artifact.Config{})
+
+	assert.NoError(t, runner.Run(t.Context()))
+
+	assert.Equal(t, rpc.FailureSetupError, done.Failure)
+	assert.Contains(t, done.Error, "docker daemon not reachable")
+}
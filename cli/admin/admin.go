@@ -20,6 +20,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/loglevel"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/org"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/registry"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/retention"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/secret"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/admin/user"
 )
@@ -32,6 +33,7 @@ var Command = &cli.Command{
 		loglevel.Command,
 		org.Command,
 		registry.Command,
+		retention.Command,
 		secret.Command,
 		user.Command,
 	},
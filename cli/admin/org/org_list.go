@@ -32,6 +32,7 @@ var Command = &cli.Command{
 	Usage: "manage organizations",
 	Commands: []*cli.Command{
 		orgListCmd,
+		orgUpdateCmd,
 	},
 }
 
@@ -0,0 +1,81 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+// RetentionPolicyTemplate is the default format of a retention policy.
+var RetentionPolicyTemplate = "\x1b[33mRetention policy\x1b[0m" + `
+Keep pipelines: {{ .KeepPipelines }}
+Pipeline days: {{ .PipelineDays }}
+Log days: {{ .LogDays }}
+Keep last success: {{ .KeepLastSuccess }}
+`
+
+// RetentionPolicyFlags returns the flags to configure a retention policy.
+func RetentionPolicyFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:  "keep-pipelines",
+			Usage: "number of latest pipelines to keep per branch, tag or pull request (0 to disable)",
+		},
+		&cli.IntFlag{
+			Name:  "pipeline-days",
+			Usage: "only delete pipelines older than the given number of days (0 to disable)",
+		},
+		&cli.IntFlag{
+			Name:  "log-days",
+			Usage: "delete logs of pipelines finished more than the given number of days ago (0 to disable)",
+		},
+		&cli.BoolFlag{
+			Name:  "keep-last-success",
+			Usage: "always keep the latest successful pipeline per branch, tag or pull request",
+		},
+	}
+}
+
+// UpdateRetentionPolicy applies the retention policy flags set on the command
+// to the policy, values of flags that are not set are kept.
+func UpdateRetentionPolicy(c *cli.Command, policy *woodpecker.RetentionPolicy) {
+	if c.IsSet("keep-pipelines") {
+		policy.KeepPipelines = c.Int("keep-pipelines")
+	}
+	if c.IsSet("pipeline-days") {
+		policy.PipelineDays = c.Int("pipeline-days")
+	}
+	if c.IsSet("log-days") {
+		policy.LogDays = c.Int("log-days")
+	}
+	if c.IsSet("keep-last-success") {
+		policy.KeepLastSuccess = c.Bool("keep-last-success")
+	}
+}
+
+// RetentionPolicyOrEmpty returns an empty policy instead of an error if the
+// server has no policy for the scope yet.
+func RetentionPolicyOrEmpty(policy *woodpecker.RetentionPolicy, err error) (*woodpecker.RetentionPolicy, error) {
+	var clientErr *woodpecker.ClientError
+	if errors.As(err, &clientErr) && clientErr.StatusCode == http.StatusNotFound {
+		return new(woodpecker.RetentionPolicy), nil
+	}
+	return policy, err
+}
@@ -34,10 +34,12 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/cli/lint"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend"
+	backend_common "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/common"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/docker"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/kubernetes"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/local"
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/metadata"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/compiler"
@@ -271,6 +273,11 @@ func runExec(ctx context.Context, c *cli.Command, yamls []*builder.YamlFile, rep
 		return err
 	}
 
+	cacheConfig := &cache.Config{
+		Image: c.String("cache-image"),
+		Dir:   c.String("cache-dir"),
+	}
+
 	var execErr error
 	// TODO: respect depends_on and run in parallel where possible
 	for _, item := range items {
@@ -282,6 +289,11 @@ func runExec(ctx context.Context, c *cli.Command, yamls []*builder.YamlFile, rep
 			fmt.Printf("ctrl+c received, terminating workflow '%s'\n", item.Workflow.Name)
 		})
 
+		cacheConfig.Prepare(item.Config)
+		if removeArtifactSteps(item.Config) {
+			fmt.Println("artifacts are only transferred by a server, skipping the artifact steps")
+		}
+
 		runtime := pipeline_runtime.New(
 			item.Config, backendEngine,
 			pipeline_runtime.WithContext(pipelineCtx), //nolint:contextcheck
@@ -326,6 +338,29 @@ func convertPathForWindows(path string) string {
 }
 
 var defaultLogger = logging.Logger(func(step *backend_types.Step, rc io.ReadCloser) error {
-	logWriter := NewLineWriter(step.Name, step.UUID)
+	logWriter := NewLineWriter(step.Name, step.UUID, backend_common.HasCommandMarkers(step))
 	return pipeline_utils.CopyLineByLine(logWriter, rc, pipeline.MaxLogLineLength)
 })
+
+// removeArtifactSteps removes the steps transferring artifacts, as they need
+// a server to store them. It reports whether there were any.
+func removeArtifactSteps(conf *backend_types.Config) bool {
+	removed := false
+	stages := conf.Stages[:0]
+	for _, stage := range conf.Stages {
+		steps := stage.Steps[:0]
+		for _, step := range stage.Steps {
+			if step.Type == backend_types.StepTypeArtifacts {
+				removed = true
+				continue
+			}
+			steps = append(steps, step)
+		}
+		stage.Steps = steps
+		if len(steps) > 0 {
+			stages = append(stages, stage)
+		}
+	}
+	conf.Stages = stages
+	return removed
+}
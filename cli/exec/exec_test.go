@@ -26,6 +26,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 )
 
 func TestExecDummy(t *testing.T) {
@@ -123,3 +125,18 @@ func TestRepoRootFromFile(t *testing.T) {
 	require.NoError(t, os.WriteFile(otherFile, []byte("steps: {}\n"), 0o644))
 	assert.Equal(t, rootDir, repoRootFromFile(otherFile))
 }
+
+func TestRemoveArtifactSteps(t *testing.T) {
+	build := &backend_types.Step{Name: "build", Type: backend_types.StepTypeCommands}
+	conf := &backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{{Name: "download-artifacts", Type: backend_types.StepTypeArtifacts}}},
+		{Steps: []*backend_types.Step{build}},
+		{Steps: []*backend_types.Step{{Name: "upload-artifacts", Type: backend_types.StepTypeArtifacts}}},
+	}}
+
+	assert.True(t, removeArtifactSteps(conf))
+	require.Len(t, conf.Stages, 1)
+	assert.Equal(t, []*backend_types.Step{build}, conf.Stages[0].Steps)
+
+	assert.False(t, removeArtifactSteps(conf))
+}
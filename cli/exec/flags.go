@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/shared/constant"
 )
 
 var flags = []cli.Flag{
@@ -83,6 +85,18 @@ var flags = []cli.Flag{
 		Name:    "secrets-file",
 		Usage:   "path to yaml file with secrets map",
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_DIR"),
+		Name:    "cache-dir",
+		Usage:   "volume or directory to store caches of cache steps in",
+		Value:   "woodpecker-cache",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_IMAGE"),
+		Name:    "cache-image",
+		Usage:   "image used to run cache steps",
+		Value:   constant.DefaultHelperImage,
+	},
 
 	//
 	// backend options for pipeline compiler
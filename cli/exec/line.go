@@ -19,6 +19,8 @@ import (
 	"io"
 	"os"
 	"time"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/common"
 )
 
 // LineWriter sends logs to the client.
@@ -27,19 +29,31 @@ type LineWriter struct {
 	stepUUID  string
 	num       int
 	startTime time.Time
+	markers   bool
 }
 
-// NewLineWriter returns a new line reader.
-func NewLineWriter(stepName, stepUUID string) io.WriteCloser {
+// NewLineWriter returns a new line reader. Command markers are left out of
+// the output if markers is set.
+func NewLineWriter(stepName, stepUUID string, markers bool) io.WriteCloser {
 	return &LineWriter{
 		stepName:  stepName,
 		stepUUID:  stepUUID,
 		startTime: time.Now().UTC(),
+		markers:   markers,
 	}
 }
 
 func (w *LineWriter) Write(p []byte) (n int, err error) {
-	fmt.Fprintf(os.Stderr, "[%s:L%d:%ds] %s", w.stepName, w.num, int64(time.Since(w.startTime).Seconds()), p)
+	line := string(p)
+	// command markers are only of use to group the logs in the ui
+	if _, output, ok := common.ParseCommandMarker(line); ok && w.markers {
+		if output == "" {
+			return len(p), nil
+		}
+		line = output
+	}
+
+	fmt.Fprintf(os.Stderr, "[%s:L%d:%ds] %s", w.stepName, w.num, int64(time.Since(w.startTime).Seconds()), line)
 	w.num++
 	return len(p), nil
 }
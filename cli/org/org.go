@@ -18,6 +18,7 @@ import (
 	"github.com/urfave/cli/v3"
 
 	"go.woodpecker-ci.org/woodpecker/v3/cli/org/registry"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/org/retention"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/org/secret"
 )
 
@@ -27,6 +28,7 @@ var Command = &cli.Command{
 	Usage: "manage organizations",
 	Commands: []*cli.Command{
 		registry.Command,
+		retention.Command,
 		secret.Command,
 	},
 }
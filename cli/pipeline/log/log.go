@@ -24,6 +24,7 @@ var Command = &cli.Command{
 	Usage: "manage logs",
 	Commands: []*cli.Command{
 		logPurgeCmd,
+		logSearchCmd,
 		logShowCmd,
 	},
 }
@@ -0,0 +1,110 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/template"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var logSearchCmd = buildLogSearchCmd()
+
+func buildLogSearchCmd() *cli.Command {
+	return &cli.Command{
+		Name:      "search",
+		Usage:     "search the logs of recent pipelines",
+		ArgsUsage: "<repo-id|repo-full-name> <query>",
+		Action:    logSearch,
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "since",
+				Usage: "only search pipelines created within this duration, the server searches the last 30 days by default",
+			},
+			&cli.IntFlag{
+				Name:  "context",
+				Usage: "number of lines to show around a match",
+				Value: 2, //nolint:mnd
+			},
+		},
+	}
+}
+
+func logSearch(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	matches, err := searchLogs(c, client)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("_").Parse(tmplLogMatch + "\n")
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if err := tmpl.Execute(os.Stdout, match); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func searchLogs(c *cli.Command, client woodpecker.Client) ([]*woodpecker.LogMatch, error) {
+	repoIDOrFullName := c.Args().First()
+	if len(repoIDOrFullName) == 0 {
+		return nil, fmt.Errorf("missing required argument repo-id / repo-full-name")
+	}
+	repoID, err := internal.ParseRepo(client, repoIDOrFullName)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repo '%s': %w ", repoIDOrFullName, err)
+	}
+
+	query := c.Args().Get(1)
+	if len(query) == 0 {
+		return nil, fmt.Errorf("missing required argument query")
+	}
+
+	opt := woodpecker.LogSearchOptions{
+		Query:   query,
+		Context: c.Int("context"),
+	}
+	if since := c.Duration("since"); since != 0 {
+		opt.Since = time.Now().Add(-since)
+	}
+
+	return client.LogSearch(repoID, opt)
+}
+
+// template for log search matches.
+var tmplLogMatch = "\x1b[33m#{{ .PipelineNumber }} > {{ .StepName }} (line {{ .Line }}):\x1b[0m" + `
+{{- range .Before }}
+  {{ . }}
+{{- end }}
+> {{ .Text }}
+{{- range .After }}
+  {{ . }}
+{{- end }}
+`
@@ -0,0 +1,105 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker/mocks"
+)
+
+func TestLogSearch(t *testing.T) {
+	matches := []*woodpecker.LogMatch{{PipelineNumber: 3, StepName: "test", Line: 7, Text: "connection refused"}}
+
+	tests := []struct {
+		name    string
+		args    []string
+		check   func(t *testing.T, opt woodpecker.LogSearchOptions)
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			args: []string{"search", "repo/name", "connection refused"},
+			check: func(t *testing.T, opt woodpecker.LogSearchOptions) {
+				assert.Equal(t, "connection refused", opt.Query)
+				assert.True(t, opt.Since.IsZero())
+				assert.Equal(t, 2, opt.Context)
+			},
+		},
+		{
+			name: "since and context",
+			args: []string{"search", "--since", "24h", "--context", "0", "repo/name", "refused"},
+			check: func(t *testing.T, opt woodpecker.LogSearchOptions) {
+				assert.WithinDuration(t, time.Now().Add(-24*time.Hour), opt.Since, time.Minute)
+				assert.Zero(t, opt.Context)
+			},
+		},
+		{
+			name:    "missing query",
+			args:    []string{"search", "repo/name"},
+			wantErr: "missing required argument query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mocks.NewMockClient(t)
+			client.On("RepoLookup", "repo/name").Return(&woodpecker.Repo{ID: 1}, nil)
+			if tt.check != nil {
+				client.On("LogSearch", int64(1), mock.Anything).Return(func(_ int64, opt woodpecker.LogSearchOptions) ([]*woodpecker.LogMatch, error) {
+					tt.check(t, opt)
+					return matches, nil
+				})
+			}
+
+			command := buildLogSearchCmd()
+			command.Writer = io.Discard
+			command.Action = func(_ context.Context, c *cli.Command) error {
+				got, err := searchLogs(c, client)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					return nil
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, matches, got)
+				return nil
+			}
+
+			assert.NoError(t, command.Run(t.Context(), tt.args))
+		})
+	}
+
+	t.Run("api error", func(t *testing.T) {
+		client := mocks.NewMockClient(t)
+		client.On("RepoLookup", "repo/name").Return(&woodpecker.Repo{ID: 1}, nil)
+		client.On("LogSearch", int64(1), mock.Anything).Return(nil, errors.New("search failed"))
+
+		command := buildLogSearchCmd()
+		command.Action = func(_ context.Context, c *cli.Command) error {
+			_, err := searchLogs(c, client)
+			return err
+		}
+		assert.EqualError(t, command.Run(t.Context(), []string{"search", "repo/name", "refused"}), "search failed")
+	})
+}
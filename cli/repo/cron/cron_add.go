@@ -47,6 +47,10 @@ var cronCreateCmd = &cli.Command{
 			Usage:    "cron schedule",
 			Required: true,
 		},
+		&cli.IntFlag{
+			Name:  "priority",
+			Usage: "queue priority of the cron pipelines, higher values are scheduled first",
+		},
 		&cli.BoolFlag{
 			Name:  "enabled",
 			Usage: "whether cron is enabled",
@@ -85,6 +89,10 @@ func cronCreate(ctx context.Context, c *cli.Command) error {
 		Schedule: schedule,
 		Enabled:  enabled,
 	}
+	if c.IsSet("priority") {
+		priority := c.Int("priority")
+		cron.Priority = &priority
+	}
 	cron, err = client.CronCreate(repoID, cron)
 	if err != nil {
 		return err
@@ -50,6 +50,10 @@ var cronUpdateCmd = &cli.Command{
 			Name:  "schedule",
 			Usage: "cron schedule",
 		},
+		&cli.IntFlag{
+			Name:  "priority",
+			Usage: "queue priority of the cron pipelines, higher values are scheduled first",
+		},
 		&cli.BoolFlag{
 			Name:  "enabled",
 			Usage: "whether cron is enabled",
@@ -87,6 +91,10 @@ func cronUpdate(ctx context.Context, c *cli.Command) error {
 		Schedule: schedule,
 		Enabled:  enabled,
 	}
+	if c.IsSet("priority") {
+		priority := c.Int("priority")
+		cron.Priority = &priority
+	}
 	cron, err = client.CronUpdate(repoID, cron)
 	if err != nil {
 		return err
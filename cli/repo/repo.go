@@ -26,6 +26,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/cli/output"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/repo/cron"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/repo/registry"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/repo/retention"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/repo/secret"
 	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
 )
@@ -42,6 +43,7 @@ var Command = &cli.Command{
 		registry.Command,
 		repoRemoveCmd,
 		repoRepairCmd,
+		retention.Command,
 		secret.Command,
 		repoShowCmd,
 		repoSyncCmd,
@@ -60,6 +60,10 @@ var repoUpdateCmd = &cli.Command{
 			Name:  "timeout",
 			Usage: "repository timeout",
 		},
+		&cli.IntFlag{
+			Name:  "priority",
+			Usage: "repository queue priority, higher values are scheduled first",
+		},
 		&cli.StringFlag{
 			Name:  "visibility",
 			Usage: "repository visibility",
@@ -94,6 +98,7 @@ func repoUpdate(ctx context.Context, c *cli.Command) error {
 		visibility      = c.String("visibility")
 		config          = c.String("config")
 		timeout         = c.Duration("timeout")
+		priority        = c.Int("priority")
 		requireApproval = c.String("require-approval")
 		pipelineCounter = c.Int("pipeline-counter")
 		unsafe          = c.Bool("unsafe")
@@ -142,6 +147,9 @@ func repoUpdate(ctx context.Context, c *cli.Command) error {
 		v := int64(timeout / time.Minute)
 		patch.Timeout = &v
 	}
+	if c.IsSet("priority") {
+		patch.Priority = &priority
+	}
 	if c.IsSet("config") {
 		patch.Config = &config
 	}
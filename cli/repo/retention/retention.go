@@ -0,0 +1,42 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+// Command exports the retention command set.
+var Command = &cli.Command{
+	Name:  "retention",
+	Usage: "manage the retention policy of a repository",
+	Commands: []*cli.Command{
+		retentionDeleteCmd,
+		retentionSetCmd,
+		retentionShowCmd,
+	},
+}
+
+func parseTargetArgs(client woodpecker.Client, c *cli.Command) (repoID int64, err error) {
+	repoIDOrFullName := c.String("repository")
+	if repoIDOrFullName == "" {
+		repoIDOrFullName = c.Args().First()
+	}
+
+	return internal.ParseRepo(client, repoIDOrFullName)
+}
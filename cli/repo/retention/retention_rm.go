@@ -0,0 +1,48 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+)
+
+var retentionDeleteCmd = &cli.Command{
+	Name:      "rm",
+	Usage:     "remove the retention policy",
+	ArgsUsage: "[repo-id|repo-full-name]",
+	Action:    retentionDelete,
+	Flags: []cli.Flag{
+		common.RepoFlag,
+	},
+}
+
+func retentionDelete(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	repoID, err := parseTargetArgs(client, c)
+	if err != nil {
+		return err
+	}
+
+	return client.RetentionPolicyDelete(repoID)
+}
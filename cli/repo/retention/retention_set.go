@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var retentionSetCmd = &cli.Command{
+	Name:      "set",
+	Usage:     "create or update the retention policy, only the given values are changed",
+	ArgsUsage: "[repo-id|repo-full-name]",
+	Action:    retentionSet,
+	Flags: append([]cli.Flag{
+		common.RepoFlag,
+	}, common.RetentionPolicyFlags()...),
+}
+
+func retentionSet(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	_, err = setRetentionPolicy(c, client)
+	return err
+}
+
+func setRetentionPolicy(c *cli.Command, client woodpecker.Client) (*woodpecker.RetentionPolicy, error) {
+	repoID, err := parseTargetArgs(client, c)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := common.RetentionPolicyOrEmpty(client.RetentionPolicy(repoID))
+	if err != nil {
+		return nil, err
+	}
+	common.UpdateRetentionPolicy(c, policy)
+
+	return client.RetentionPolicySet(repoID, policy)
+}
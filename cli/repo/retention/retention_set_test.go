@@ -0,0 +1,76 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker/mocks"
+)
+
+func TestRetentionSet(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   *woodpecker.RetentionPolicy
+		mockError error
+		args      []string
+		expected  *woodpecker.RetentionPolicy
+	}{
+		{
+			name:      "create policy",
+			mockError: &woodpecker.ClientError{StatusCode: http.StatusNotFound},
+			args:      []string{"set", "--keep-pipelines", "20", "--keep-last-success", "123"},
+			expected:  &woodpecker.RetentionPolicy{KeepPipelines: 20, KeepLastSuccess: true},
+		},
+		{
+			name:     "update keeps values not given",
+			current:  &woodpecker.RetentionPolicy{ID: 1, RepoID: 123, KeepPipelines: 20, KeepLastSuccess: true},
+			args:     []string{"set", "--log-days", "30", "--keep-last-success=false", "123"},
+			expected: &woodpecker.RetentionPolicy{ID: 1, RepoID: 123, KeepPipelines: 20, LogDays: 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewMockClient(t)
+			mockClient.On("RetentionPolicy", int64(123)).Return(tt.current, tt.mockError)
+			mockClient.On("RetentionPolicySet", int64(123), tt.expected).Return(tt.expected, nil)
+
+			// flags keep their state between runs, so every run needs new ones
+			command := &cli.Command{
+				Name:   retentionSetCmd.Name,
+				Flags:  common.RetentionPolicyFlags(),
+				Writer: io.Discard,
+			}
+			command.Action = func(_ context.Context, c *cli.Command) error {
+				output, err := setRetentionPolicy(c, mockClient)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, output)
+				return nil
+			}
+
+			require.NoError(t, command.Run(t.Context(), tt.args))
+		})
+	}
+}
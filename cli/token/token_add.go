@@ -0,0 +1,80 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var tokenCreateCmd = &cli.Command{
+	Name:      "add",
+	Usage:     "create a personal access token, it is only shown once",
+	ArgsUsage: "<name>",
+	Action:    tokenCreate,
+	Flags:     tokenCreateFlags(),
+}
+
+func tokenCreateFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "scope",
+			Usage:    "scope granted to the token (repo:read, repo:write, pipeline:write, secrets:read, secrets:write, user:write, admin)",
+			Required: true,
+		},
+		&cli.DurationFlag{
+			Name:  "expires-in",
+			Usage: "time until the token expires, it never expires if not set",
+		},
+	}
+}
+
+func tokenCreate(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	token, err := createToken(c, client, time.Now())
+	if err != nil {
+		return err
+	}
+	fmt.Println(token.Token)
+	return nil
+}
+
+func createToken(c *cli.Command, client woodpecker.Client, now time.Time) (*woodpecker.NewPersonalAccessToken, error) {
+	name := c.Args().First()
+	if name == "" {
+		return nil, errors.New("missing token name")
+	}
+
+	opt := &woodpecker.PersonalAccessTokenCreate{Name: name}
+	for _, scope := range c.StringSlice("scope") {
+		opt.Scopes = append(opt.Scopes, woodpecker.TokenScope(scope))
+	}
+	if expiresIn := c.Duration("expires-in"); expiresIn > 0 {
+		opt.Expires = now.Add(expiresIn).Unix()
+	}
+
+	return client.PersonalAccessTokenCreate(opt)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker/mocks"
+)
+
+func TestTokenCreate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected *woodpecker.PersonalAccessTokenCreate
+		wantErr  string
+	}{
+		{
+			name: "never expires",
+			args: []string{"add", "--scope", "repo:read", "--scope", "pipeline:write", "deploy"},
+			expected: &woodpecker.PersonalAccessTokenCreate{
+				Name:   "deploy",
+				Scopes: []woodpecker.TokenScope{woodpecker.TokenScopeRepoRead, woodpecker.TokenScopePipelineWrite},
+			},
+		},
+		{
+			name: "expires",
+			args: []string{"add", "--scope", "admin", "--expires-in", "720h", "admin-token"},
+			expected: &woodpecker.PersonalAccessTokenCreate{
+				Name:    "admin-token",
+				Scopes:  []woodpecker.TokenScope{woodpecker.TokenScopeAdmin},
+				Expires: now.Add(720 * time.Hour).Unix(),
+			},
+		},
+		{
+			name:    "missing name",
+			args:    []string{"add", "--scope", "admin"},
+			wantErr: "missing token name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := mocks.NewMockClient(t)
+			if tt.expected != nil {
+				mockClient.On("PersonalAccessTokenCreate", tt.expected).
+					Return(&woodpecker.NewPersonalAccessToken{Token: "secret"}, nil)
+			}
+
+			// flags keep their state between runs, so every run needs new ones
+			command := &cli.Command{
+				Name:   tokenCreateCmd.Name,
+				Flags:  tokenCreateFlags(),
+				Writer: io.Discard,
+			}
+			command.Action = func(_ context.Context, c *cli.Command) error {
+				token, err := createToken(c, mockClient, now)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					return nil
+				}
+				require.NoError(t, err)
+				assert.Equal(t, "secret", token.Token)
+				return nil
+			}
+
+			require.NoError(t, command.Run(t.Context(), tt.args))
+		})
+	}
+}
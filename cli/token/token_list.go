@@ -0,0 +1,81 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/common"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+	"go.woodpecker-ci.org/woodpecker/v3/woodpecker-go/woodpecker"
+)
+
+var tokenListCmd = &cli.Command{
+	Name:      "ls",
+	Usage:     "list personal access tokens",
+	ArgsUsage: " ",
+	Action:    tokenList,
+	Flags:     []cli.Flag{common.FormatFlag(tmplTokenList, false)},
+}
+
+func tokenList(ctx context.Context, c *cli.Command) error {
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := client.PersonalAccessTokenList()
+	if err != nil || len(tokens) == 0 {
+		return err
+	}
+
+	tmpl, err := template.New("_").Funcs(tokenFuncMap).Parse(c.String("format") + "\n")
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err := tmpl.Execute(os.Stdout, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Template for token list items.
+var tmplTokenList = "\x1b[33m{{ .Name }} \x1b[0m" + `
+ID: {{ .ID }}
+Scopes: {{ scopes .Scopes }}
+Expires: {{ if .Expires }}{{ date .Expires }}{{ else }}never{{ end }}
+Last used: {{ if .LastUsed }}{{ date .LastUsed }}{{ else }}never{{ end }}
+`
+
+var tokenFuncMap = template.FuncMap{
+	"scopes": func(s []woodpecker.TokenScope) string {
+		scopes := make([]string, 0, len(s))
+		for _, scope := range s {
+			scopes = append(scopes, string(scope))
+		}
+		return strings.Join(scopes, ", ")
+	},
+	"date": func(unix int64) string {
+		return time.Unix(unix, 0).Format(time.RFC3339)
+	},
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/cli/internal"
+)
+
+var tokenRevokeCmd = &cli.Command{
+	Name:      "rm",
+	Usage:     "revoke a personal access token",
+	ArgsUsage: "<token-id>",
+	Action:    tokenRevoke,
+}
+
+func tokenRevoke(ctx context.Context, c *cli.Command) error {
+	tokenID, err := strconv.ParseInt(c.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid token id: %w", err)
+	}
+
+	client, err := internal.NewClient(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	if err := client.PersonalAccessTokenDelete(tokenID); err != nil {
+		return err
+	}
+	fmt.Printf("Successfully revoked token %d\n", tokenID)
+	return nil
+}
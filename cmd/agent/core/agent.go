@@ -16,6 +16,7 @@
 package core
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/docker/go-units"
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v3"
 	"golang.org/x/sync/errgroup"
@@ -37,10 +39,13 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/agent"
 	agent_rpc "go.woodpecker-ci.org/woodpecker/v3/agent/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/logger"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/utils"
 	"go.woodpecker-ci.org/woodpecker/v3/version"
 )
@@ -67,7 +72,20 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 		hostname, _ = os.Hostname()
 	}
 
+	cacheConfig := readCacheConfig(c)
+	artifactConfig := readArtifactConfig(c)
+
+	resources, err := parseResources(c.String("cpu"), c.String("memory"), c.String("disk"))
+	if err != nil {
+		return err
+	}
+
 	maxWorkflows := c.Int("max-workflows")
+	if !c.IsSet("max-workflows") && resources.CPU > 0 {
+		// the server only hands out workflows that fit into the resources of
+		// the agent, so by default poll for one workflow per CPU core.
+		maxWorkflows = max(int(resources.CPU/1000), 1)
+	}
 	singleWorkflow := c.Bool("single-workflow")
 	if singleWorkflow && maxWorkflows > 1 {
 		log.Warn().Msgf("max-workflows forced from %d to 1 due to agent running single workflow mode.", maxWorkflows)
@@ -203,6 +221,7 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 		Platform:     engInfo.Platform,
 		Capacity:     maxWorkflows,
 		CustomLabels: customLabels,
+		Resources:    resources,
 	})
 	if err != nil {
 		return err
@@ -255,7 +274,7 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 
 	serviceWaitingGroup.Go(func() error {
 		for {
-			err := client.ReportHealth(grpcCtx)
+			err := client.ReportHealth(grpcCtx, resources)
 			if err != nil {
 				log.Err(err).Msg("failed to report health")
 				// Check if the error is due to context cancellation
@@ -277,7 +296,7 @@ func run(ctx context.Context, c *cli.Command, backends []types.Backend) error {
 	// https://go.dev/blog/go1.22 fixed scope for goroutines in loops
 	for i := range maxWorkflows {
 		serviceWaitingGroup.Go(func() error {
-			runner := agent.NewRunner(client, filter, hostname, counter, backendEngine)
+			runner := agent.NewRunner(client, filter, hostname, counter, backendEngine, cacheConfig, artifactConfig)
 			log.Debug().Msgf("created new runner %d", i)
 
 			for {
@@ -372,3 +391,58 @@ func stringSliceAddToMap(sl []string, m map[string]string) error {
 	}
 	return nil
 }
+
+// parseResources parses the resources the agent can allocate for workflows.
+// The CPU is given in cores, memory and disk in bytes or with a unit like 4GiB.
+func parseResources(cpu, memory, disk string) (rpc.Resources, error) {
+	var resources rpc.Resources
+	if cpu != "" {
+		cores, err := strconv.ParseFloat(cpu, 64)
+		if err != nil || cores < 0 {
+			return resources, fmt.Errorf("invalid cpu '%s'", cpu)
+		}
+		resources.CPU = int64(cores * 1000)
+	}
+	if memory != "" {
+		bytes, err := units.RAMInBytes(memory)
+		if err != nil || bytes < 0 {
+			return resources, fmt.Errorf("invalid memory '%s'", memory)
+		}
+		resources.Memory = bytes
+	}
+	if disk != "" {
+		bytes, err := units.RAMInBytes(disk)
+		if err != nil || bytes < 0 {
+			return resources, fmt.Errorf("invalid disk '%s'", disk)
+		}
+		resources.Disk = bytes
+	}
+	return resources, nil
+}
+
+// readCacheConfig reads where the caches of cache steps are stored.
+func readCacheConfig(c *cli.Command) *cache.Config {
+	return &cache.Config{
+		Image: c.String("cache-image"),
+		Dir:   c.String("cache-dir"),
+		S3: s3.Config{
+			Endpoint:        c.String("cache-s3-endpoint"),
+			Bucket:          c.String("cache-s3-bucket"),
+			Region:          c.String("cache-s3-region"),
+			AccessKeyID:     c.String("cache-s3-access-key-id"),
+			SecretAccessKey: c.String("cache-s3-secret-access-key"),
+			PathStyle:       c.Bool("cache-s3-path-style"),
+		},
+		S3Prefix: c.String("cache-s3-prefix"),
+	}
+}
+
+// readArtifactConfig reads how artifact steps connect to the server.
+func readArtifactConfig(c *cli.Command) *artifact.Config {
+	return &artifact.Config{
+		Image:      c.String("artifacts-image"),
+		Server:     cmp.Or(c.String("artifacts-server"), c.String("server")),
+		Secure:     c.Bool("grpc-secure"),
+		SkipVerify: c.Bool("grpc-skip-insecure"),
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 )
 
 func TestStringSliceAddToMap(t *testing.T) {
@@ -73,3 +75,19 @@ func TestStringSliceAddToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestParseResources(t *testing.T) {
+	resources, err := parseResources("2.5", "8GiB", "100g")
+	assert.NoError(t, err)
+	assert.Equal(t, rpc.Resources{CPU: 2500, Memory: 8 << 30, Disk: 100 << 30}, resources)
+
+	resources, err = parseResources("", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, rpc.Resources{}, resources)
+
+	_, err = parseResources("four", "", "")
+	assert.Error(t, err)
+
+	_, err = parseResources("", "lots", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/urfave/cli/v3"
+
+	agent_rpc "go.woodpecker-ci.org/woodpecker/v3/agent/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
+)
+
+// artifactStep uploads or downloads the artifacts of an artifact step, the
+// workspace is the working directory and the step is configured by
+// environment variables.
+func artifactStep(ctx context.Context, _ *cli.Command) error {
+	server := os.Getenv(artifact.EnvServer)
+	if server == "" {
+		return errors.New("no artifact server configured")
+	}
+	secure, _ := strconv.ParseBool(os.Getenv(artifact.EnvSecure))
+	skipVerify, _ := strconv.ParseBool(os.Getenv(artifact.EnvSkipVerify))
+
+	conn, err := agent_rpc.DialArtifacts(server, os.Getenv(artifact.EnvToken), secure, skipVerify)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	workspace, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	return artifact.RunStep(ctx, agent_rpc.NewArtifactClient(conn), os.Getenv, workspace, os.Stdout)
+}
@@ -20,6 +20,8 @@ import (
 	"time"
 
 	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/shared/constant"
 )
 
 //nolint:mnd
@@ -94,9 +96,24 @@ var flags = []cli.Flag{
 	&cli.IntFlag{
 		Sources: cli.EnvVars("WOODPECKER_MAX_WORKFLOWS", "WOODPECKER_MAX_PROCS"), // cspell:words PROCS
 		Name:    "max-workflows",
-		Usage:   "agent parallel workflows",
+		Usage:   "agent parallel workflows, defaults to the number of CPU cores if cpu is set",
 		Value:   1,
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AGENT_CPU"),
+		Name:    "cpu",
+		Usage:   "CPU cores the agent can allocate for workflows, e.g. 4 or 0.5",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AGENT_MEMORY"),
+		Name:    "memory",
+		Usage:   "memory the agent can allocate for workflows, e.g. 8GiB",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AGENT_DISK"),
+		Name:    "disk",
+		Usage:   "disk space the agent can allocate for workflows, e.g. 100GiB",
+	},
 	&cli.BoolFlag{
 		Sources: cli.EnvVars("WOODPECKER_AGENT_SINGLE_WORKFLOW"),
 		Name:    "single-workflow",
@@ -132,6 +149,71 @@ var flags = []cli.Flag{
 		Usage:   "backend to run pipelines on",
 		Value:   "auto-detect",
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_IMAGE"),
+		Name:    "cache-image",
+		Usage:   "image running cache steps on container backends, it has to contain the agent binary",
+		Value:   constant.DefaultHelperImage,
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_DIR"),
+		Name:    "cache-dir",
+		Usage:   "volume (docker), persistent volume claim (kubernetes) or directory (local) caches are stored in",
+		Value:   "woodpecker-cache",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_ENDPOINT"),
+		Name:    "cache-s3-endpoint",
+		Usage:   "url of a S3 compatible storage to store caches in instead of the cache dir",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_BUCKET"),
+		Name:    "cache-s3-bucket",
+		Usage:   "bucket to store caches in",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_REGION"),
+		Name:    "cache-s3-region",
+		Usage:   "region of the cache bucket",
+		Value:   "us-east-1",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_ACCESS_KEY_ID"),
+		Name:    "cache-s3-access-key-id",
+		Usage:   "access key id for the cache bucket",
+	},
+	&cli.StringFlag{
+		Name:  "cache-s3-secret-access-key",
+		Usage: "secret access key for the cache bucket",
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_CACHE_S3_SECRET_ACCESS_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_CACHE_S3_SECRET_ACCESS_KEY"),
+		),
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_PATH_STYLE"),
+		Name:    "cache-s3-path-style",
+		Usage:   "address the cache bucket by path instead of by host name, most self-hosted storages require it",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_CACHE_S3_PREFIX"),
+		Name:    "cache-s3-prefix",
+		Usage:   "prefix of all cache objects in the bucket",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACTS_IMAGE"),
+		Name:    "artifacts-image",
+		Usage:   "image running artifact steps on container backends, it has to contain the agent binary",
+		Value:   constant.DefaultHelperImage,
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACTS_SERVER"),
+		Name:    "artifacts-server",
+		Usage:   "server grpc address artifact steps connect to, defaults to WOODPECKER_SERVER",
+	},
 	&cli.IntFlag{
 		Sources: cli.EnvVars("WOODPECKER_CONNECT_RETRY_COUNT"),
 		Name:    "connect-retry-count",
@@ -23,7 +23,9 @@ import (
 	"github.com/urfave/cli/v3"
 
 	"go.woodpecker-ci.org/woodpecker/v3/cmd/shared"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/logger"
 	"go.woodpecker-ci.org/woodpecker/v3/version"
 )
@@ -46,6 +48,18 @@ func GenApp(backends []backend_types.Backend) *cli.Command {
 			Hidden: true, // internal helper for local backend and cmd
 			Action: shared.Base64Decoder,
 		},
+		{
+			Name:   cache.HelperCommand,
+			Usage:  "restores or saves a cache",
+			Hidden: true, // internal helper for cache steps
+			Action: shared.CacheStep,
+		},
+		{
+			Name:   artifact.HelperCommand,
+			Usage:  "uploads or downloads artifacts",
+			Hidden: true, // internal helper for artifact steps
+			Action: artifactStep,
+		},
 	}
 	agentFlags := slices.Concat(flags, logger.GlobalLoggerFlags)
 	for _, b := range backends {
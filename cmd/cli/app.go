@@ -27,8 +27,10 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/cli/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/repo"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/setup"
+	"go.woodpecker-ci.org/woodpecker/v3/cli/token"
 	"go.woodpecker-ci.org/woodpecker/v3/cli/update"
 	"go.woodpecker-ci.org/woodpecker/v3/cmd/shared"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	"go.woodpecker-ci.org/woodpecker/v3/version"
 )
 
@@ -56,6 +58,12 @@ func newApp() *cli.Command {
 			Hidden: true, // internal helper for local backend and cmd
 			Action: shared.Base64Decoder,
 		},
+		{
+			Name:   cache.HelperCommand,
+			Usage:  "restores or saves a cache",
+			Hidden: true, // internal helper for cache steps
+			Action: shared.CacheStep,
+		},
 		exec.Command,
 		info.Command,
 		lint.Command,
@@ -63,6 +71,7 @@ func newApp() *cli.Command {
 		pipeline.Command,
 		repo.Command,
 		setup.Command,
+		token.Command,
 		update.Command,
 	}
 
@@ -220,6 +220,18 @@ var flags = append([]cli.Flag{
 		Usage:   "The maximum time in minutes you can set in the repo settings before a pipeline gets killed",
 		Value:   120,
 	},
+	&cli.IntFlag{
+		Sources: cli.EnvVars("WOODPECKER_MAX_WORKFLOW_ATTEMPTS"),
+		Name:    "max-workflow-attempts",
+		Usage:   "maximum number of attempts to run a workflow that failed because of the infrastructure, workflows can lower it with retry.attempts",
+		Value:   3,
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_WORKFLOW_RETRY_BACKOFF"),
+		Name:    "workflow-retry-backoff",
+		Usage:   "default time to wait before the next attempt of a workflow, doubled with every attempt",
+		Value:   10 * time.Second,
+	},
 	&cli.StringSliceFlag{
 		Sources: cli.EnvVars("WOODPECKER_DEFAULT_WORKFLOW_LABELS"),
 		Name:    "default-workflow-labels",
@@ -234,6 +246,11 @@ var flags = append([]cli.Flag{
 		Usage:   "session expiration time",
 		Value:   time.Hour * 72,
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_AUDIT_LOG_FILE"),
+		Name:    "audit-log-file",
+		Usage:   "file the audit log is appended to as json lines in addition to the database",
+	},
 	&cli.StringSliceFlag{
 		Sources: cli.EnvVars("WOODPECKER_PLUGINS_PRIVILEGED"),
 		Name:    "plugins-privileged",
@@ -357,6 +374,12 @@ var flags = append([]cli.Flag{
 		Usage:   "Hosts that are allowed to be contacted by extensions",
 		Value:   hostmatcher.MatchBuiltinExternal,
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_WEBHOOK_ALLOWED_HOSTS"),
+		Name:    "webhook-allowed-hosts",
+		Usage:   "Hosts that are allowed to be contacted by outgoing webhooks",
+		Value:   hostmatcher.MatchBuiltinExternal,
+	},
 	&cli.StringFlag{
 		Sources: cli.EnvVars("WOODPECKER_DATABASE_DRIVER"),
 		Name:    "db-driver",
@@ -424,7 +447,7 @@ var flags = append([]cli.Flag{
 	&cli.StringFlag{
 		Sources: cli.EnvVars("WOODPECKER_LOG_STORE"),
 		Name:    "log-store",
-		Usage:   "log store to use ('database', 'addon' or 'file')",
+		Usage:   "log store to use ('database', 'addon', 'file' or 's3')",
 		Value:   "database",
 	},
 	&cli.StringFlag{
@@ -432,6 +455,131 @@ var flags = append([]cli.Flag{
 		Name:    "log-store-file-path",
 		Usage:   "directory used for file based log storage or addon executable file path",
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_ENDPOINT"),
+		Name:    "log-store-s3-endpoint",
+		Usage:   "url of the S3 compatible storage to store logs in",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_BUCKET"),
+		Name:    "log-store-s3-bucket",
+		Usage:   "bucket to store logs in",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_REGION"),
+		Name:    "log-store-s3-region",
+		Usage:   "region of the log bucket",
+		Value:   "us-east-1",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_ACCESS_KEY_ID"),
+		Name:    "log-store-s3-access-key-id",
+		Usage:   "access key id for the log bucket",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_LOG_STORE_S3_SECRET_ACCESS_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_LOG_STORE_S3_SECRET_ACCESS_KEY"),
+		),
+		Name:  "log-store-s3-secret-access-key",
+		Usage: "secret access key for the log bucket",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_PATH_STYLE"),
+		Name:    "log-store-s3-path-style",
+		Usage:   "address the log bucket by path instead of by host name, most self-hosted storages require it",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_LOG_STORE_S3_PREFIX"),
+		Name:    "log-store-s3-prefix",
+		Usage:   "prefix of all log objects in the bucket",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE"),
+		Name:    "artifact-store",
+		Usage:   "artifact store to use ('file' or 's3')",
+		Value:   "file",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_FILE_PATH"),
+		Name:    "artifact-store-file-path",
+		Usage:   "directory used for file based artifact storage",
+		Value:   artifactStoreFilePathDefaultValue(),
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_ENDPOINT"),
+		Name:    "artifact-store-s3-endpoint",
+		Usage:   "url of the S3 compatible storage to store artifacts in",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_BUCKET"),
+		Name:    "artifact-store-s3-bucket",
+		Usage:   "bucket to store artifacts in",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_REGION"),
+		Name:    "artifact-store-s3-region",
+		Usage:   "region of the artifact bucket",
+		Value:   "us-east-1",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_ACCESS_KEY_ID"),
+		Name:    "artifact-store-s3-access-key-id",
+		Usage:   "access key id for the artifact bucket",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_ARTIFACT_STORE_S3_SECRET_ACCESS_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_ARTIFACT_STORE_S3_SECRET_ACCESS_KEY"),
+		),
+		Name:  "artifact-store-s3-secret-access-key",
+		Usage: "secret access key for the artifact bucket",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_PATH_STYLE"),
+		Name:    "artifact-store-s3-path-style",
+		Usage:   "address the artifact bucket by path instead of by host name, most self-hosted storages require it",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_STORE_S3_PREFIX"),
+		Name:    "artifact-store-s3-prefix",
+		Usage:   "prefix of all artifact objects in the bucket",
+	},
+	&cli.Int64Flag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_MAX_SIZE"),
+		Name:    "artifact-max-size",
+		Usage:   "maximum size of a single artifact in bytes, 0 disables the limit",
+		Value:   1024 * 1024 * 1024,
+	},
+	&cli.DurationFlag{
+		Sources: cli.EnvVars("WOODPECKER_ARTIFACT_RETENTION"),
+		Name:    "artifact-retention",
+		Usage:   "time artifacts are kept after their upload, 0 keeps them until they are deleted manually",
+		Value:   30 * 24 * time.Hour,
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_BACKEND"),
+		Name:    "queue-backend",
+		Usage:   "queue backend to use ('memory' or 'database')",
+		Value:   "memory",
+	},
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_QUEUE_FAIR_SHARE"),
+		Name:    "queue-fair-share",
+		Usage:   "balance agents between orgs and their repos instead of always taking the oldest workflow",
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_PUBSUB_BACKEND"),
+		Name:    "pubsub-backend",
+		Usage:   "pubsub backend used to pass pipeline events to the UI ('memory' or 'database')",
+		Value:   "memory",
+	},
 	//
 	// backend options for pipeline compiler
 	//
@@ -478,7 +626,7 @@ var flags = append([]cli.Flag{
 	&cli.StringFlag{
 		Name:    "forge-url",
 		Usage:   "url of the forge",
-		Sources: cli.EnvVars("WOODPECKER_FORGE_URL", "WOODPECKER_GITHUB_URL", "WOODPECKER_GITLAB_URL", "WOODPECKER_GITEA_URL", "WOODPECKER_FORGEJO_URL", "WOODPECKER_BITBUCKET_URL", "WOODPECKER_BITBUCKET_DC_URL"),
+		Sources: cli.EnvVars("WOODPECKER_FORGE_URL", "WOODPECKER_GITHUB_URL", "WOODPECKER_GITLAB_URL", "WOODPECKER_GITEA_URL", "WOODPECKER_FORGEJO_URL", "WOODPECKER_BITBUCKET_URL", "WOODPECKER_BITBUCKET_DC_URL", "WOODPECKER_GIT_URL"),
 	},
 	&cli.StringFlag{
 		Sources: cli.NewValueSourceChain(
@@ -575,6 +723,33 @@ var flags = append([]cli.Flag{
 		Usage:   "github tokens should only get access to public repos",
 		Value:   false,
 	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_GITHUB_APP_ID"),
+		Name:    "github-app-id",
+		Usage:   "github app id, enables acting on repos through the app instead of the repo owner's token",
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GITHUB_APP_PRIVATE_KEY_FILE")),
+			cli.EnvVar("WOODPECKER_GITHUB_APP_PRIVATE_KEY"),
+		),
+		Name:  "github-app-private-key",
+		Usage: "github app private key in PEM format",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GITHUB_APP_WEBHOOK_SECRET_FILE")),
+			cli.EnvVar("WOODPECKER_GITHUB_APP_WEBHOOK_SECRET"),
+		),
+		Name:  "github-app-webhook-secret",
+		Usage: "github app webhook secret",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
 	//
 	// Gitea
 	//
@@ -643,6 +818,62 @@ var flags = append([]cli.Flag{
 		Usage:   "Bitbucket DataCenter/Server oauth2 scope should be configured to include PROJECT_ADMIN configuration.",
 	},
 	//
+	// plain Git
+	//
+	&cli.BoolFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT"),
+		Name:    "git",
+		Usage:   "plain Git driver is enabled",
+	},
+	&cli.StringSliceFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_REPOS"),
+		Name:    "git-repos",
+		Usage:   "list of repository clone urls, optionally prefixed with owner/name=",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_USERS_FILE"),
+		Name:    "git-users-file",
+		Usage:   "htpasswd file with the bcrypt hashed passwords of the users",
+	},
+	&cli.StringSliceFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_PUSH_USERS"),
+		Name:    "git-push-users",
+		Usage:   "list of users allowed to push to all repositories, or to one as owner/name:login",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_USERNAME_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_USERNAME"),
+		),
+		Name:  "git-username",
+		Usage: "username used to clone repositories over http",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.NewValueSourceChain(
+			cli.File(os.Getenv("WOODPECKER_GIT_PASSWORD_FILE")),
+			cli.EnvVar("WOODPECKER_GIT_PASSWORD"),
+		),
+		Name:  "git-password",
+		Usage: "password used to clone repositories over http",
+		Config: cli.StringConfig{
+			TrimSpace: true,
+		},
+	},
+	&cli.StringFlag{
+		Sources: cli.EnvVars("WOODPECKER_GIT_CACHE_DIR"),
+		Name:    "git-cache-dir",
+		Usage:   "directory of the mirrors used to read pipeline configs, defaults to a temporary directory",
+	},
+	//
 	// development flags
 	//
 	&cli.StringFlag{
@@ -695,6 +926,16 @@ func datasourceDefaultValue() string {
 	return "woodpecker.sqlite"
 }
 
+// artifactStoreFilePathDefaultValue returns the default directory to store
+// artifacts in, next to the default sqlite database.
+func artifactStoreFilePathDefaultValue() string {
+	_, found := os.LookupEnv("WOODPECKER_IN_CONTAINER")
+	if found {
+		return "/var/lib/woodpecker/artifacts"
+	}
+	return "artifacts"
+}
+
 func getFirstNonEmptyEnvVar(envVars ...string) string {
 	for _, envVar := range envVars {
 		val := os.Getenv(envVar)
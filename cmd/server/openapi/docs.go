@@ -18,6 +18,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/openid-configuration": {
+            "get": {
+                "description": "Relying parties use it to verify the identity tokens issued to pipeline steps.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get the OpenID Connect discovery document",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/OpenIDConfiguration"
+                        }
+                    }
+                }
+            }
+        },
         "/agents": {
             "get": {
                 "produces": [
@@ -250,6 +270,89 @@ const docTemplate = `{
                 }
             }
         },
+        "/audit": {
+            "get": {
+                "description": "Returns the newest entries first. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit log"
+                ],
+                "summary": "List audit log entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of actions performed by this user login",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of this action, e.g. secret.update",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of this target type, e.g. secret",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "only entries of this repository",
+                        "name": "repo_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries before this time (RFC 3339)",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries after this time (RFC 3339)",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuditLog"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/badges/{repo_id}/cc.xml": {
             "get": {
                 "description": "CCMenu displays the pipeline status of projects on a CI server as an item in the Mac's menu bar.\nMore details on how to install, you can find at http://ccmenu.org/\nThe response format adheres to CCTray v1 Specification, https://cctray.org/v1/",
@@ -835,6 +938,41 @@ const docTemplate = `{
                 }
             }
         },
+        "/hook/forges/{forge_id}": {
+            "post": {
+                "description": "Receives the webhooks an app installed on the forge sends for all of its repositories, e.g. a GitHub App.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Incoming webhook from a forge app",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the forge's id",
+                        "name": "forge_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook payload",
+                        "name": "hook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
         "/log-level": {
             "get": {
                 "description": "Endpoint returns the current logging level. Requires admin rights.",
@@ -907,6 +1045,25 @@ const docTemplate = `{
                 }
             }
         },
+        "/oidc/jwks": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get the keys identity tokens are signed with",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JWKS"
+                        }
+                    }
+                }
+            }
+        },
         "/orgs": {
             "get": {
                 "description": "Returns all registered orgs in the system. Requires admin rights.",
@@ -1059,6 +1216,50 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
+            },
+            "patch": {
+                "description": "Updates the given org. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Orgs"
+                ],
+                "summary": "Update an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the org's information",
+                        "name": "org",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/OrgPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Org"
+                        }
+                    }
+                }
             }
         },
         "/orgs/{org_id}/agents": {
@@ -1248,6 +1449,96 @@ const docTemplate = `{
                 }
             }
         },
+        "/orgs/{org_id}/audit": {
+            "get": {
+                "description": "Returns the newest entries of the organization and its repositories first. Requires organization admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Audit log"
+                ],
+                "summary": "List audit log entries of an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the organization's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of actions performed by this user login",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of this action, e.g. secret.update",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries of this target type, e.g. secret",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "only entries of this repository",
+                        "name": "repo_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries before this time (RFC 3339)",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only entries after this time (RFC 3339)",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuditLog"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/orgs/{org_id}/permissions": {
             "get": {
                 "produces": [
@@ -1514,15 +1805,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/orgs/{org_id}/secrets": {
+        "/orgs/{org_id}/retention": {
             "get": {
+                "description": "The organization policy applies to all repos of the organization without an own policy.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Organization secrets"
+                    "Retention policies"
                 ],
-                "summary": "List organization secrets",
+                "summary": "Get the retention policy of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1538,12 +1830,123 @@ const docTemplate = `{
                         "name": "org_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "for response pagination, page offset number",
-                        "name": "page",
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates or replaces the retention policy of an organization.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Retention policies"
+                ],
+                "summary": "Set the retention policy of an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the retention policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Retention policies"
+                ],
+                "summary": "Delete the retention policy of an organization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/orgs/{org_id}/secrets": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Organization secrets"
+                ],
+                "summary": "List organization secrets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
                         "in": "query"
                     },
                     {
@@ -1741,15 +2144,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/pipelines": {
+        "/orgs/{org_id}/webhooks": {
             "get": {
+                "description": "Webhooks of the parent scopes are not included.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pipeline queues"
+                    "Webhooks"
                 ],
-                "summary": "List pipelines in queue",
+                "summary": "List the webhooks of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1758,6 +2162,27 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1766,23 +2191,20 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Feed"
+                                "$ref": "#/definitions/Webhook"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/queue/info": {
-            "get": {
-                "description": "Returns pipeline queue information with agent details",
+            },
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Pipeline queues"
+                    "Webhooks"
                 ],
-                "summary": "Get pipeline queue information",
+                "summary": "Create a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1791,27 +2213,43 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/QueueInfo"
+                            "$ref": "#/definitions/Webhook"
                         }
                     }
                 }
             }
         },
-        "/queue/norunningpipelines": {
+        "/orgs/{org_id}/webhooks/{webhook_id}": {
             "get": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Pipeline queues"
+                    "Webhooks"
                 ],
-                "summary": "Block til pipeline queue has a running item",
+                "summary": "Get a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1820,24 +2258,40 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
                     }
                 }
-            }
-        },
-        "/queue/pause": {
-            "post": {
+            },
+            "delete": {
+                "description": "Deletes the delivery history as well.",
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Pipeline queues"
+                    "Webhooks"
                 ],
-                "summary": "Pause the pipeline queue",
+                "summary": "Delete a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1846,6 +2300,20 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1853,17 +2321,15 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
-            }
-        },
-        "/queue/resume": {
-            "post": {
+            },
+            "patch": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Pipeline queues"
+                    "Webhooks"
                 ],
-                "summary": "Resume the pipeline queue",
+                "summary": "Update a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1872,24 +2338,51 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook's data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/WebhookPatch"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
                     }
                 }
             }
         },
-        "/registries": {
+        "/orgs/{org_id}/webhooks/{webhook_id}/deliveries": {
             "get": {
+                "description": "Returns the newest deliveries first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registries"
+                    "Webhooks"
                 ],
-                "summary": "List global registries",
+                "summary": "List the deliveries of a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1899,6 +2392,20 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "default": 1,
@@ -1920,20 +2427,23 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Registry"
+                                "$ref": "#/definitions/WebhookDelivery"
                             }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/orgs/{org_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver": {
             "post": {
+                "description": "Queues the payload of the delivery again as a new delivery.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Registries"
+                    "Webhooks"
                 ],
-                "summary": "Create a global registry",
+                "summary": "Redeliver a delivery of a webhook of an organization",
                 "parameters": [
                     {
                         "type": "string",
@@ -1944,9 +2454,243 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the registry object data",
-                        "name": "registry",
-                        "in": "body",
+                        "type": "string",
+                        "description": "the org's id",
+                        "name": "org_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the delivery's id",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDelivery"
+                        }
+                    }
+                }
+            }
+        },
+        "/pipelines": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "List pipelines in queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Feed"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/queue/info": {
+            "get": {
+                "description": "Returns pipeline queue information with agent details",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "Get pipeline queue information",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/QueueInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/queue/norunningpipelines": {
+            "get": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "Block til pipeline queue has a running item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/queue/pause": {
+            "post": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "Pause the pipeline queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/queue/resume": {
+            "post": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Pipeline queues"
+                ],
+                "summary": "Resume the pipeline queue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/registries": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registries"
+                ],
+                "summary": "List global registries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Registry"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Registries"
+                ],
+                "summary": "Create a global registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "the registry object data",
+                        "name": "registry",
+                        "in": "body",
                         "required": true,
                         "schema": {
                             "$ref": "#/definitions/Registry"
@@ -2693,15 +3437,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/repos/{repo_id}/logs/{pipeline_number}": {
-            "delete": {
+        "/repos/{repo_id}/hook-url": {
+            "get": {
+                "description": "Forges without a webhook API need the url to trigger pipelines, e.g. from a post-receive hook of a plain Git server.",
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Pipeline logs"
+                    "Repositories"
                 ],
-                "summary": "Deletes all logs of a pipeline",
+                "summary": "Get the webhook url of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -2717,31 +3462,28 @@ const docTemplate = `{
                         "name": "repo_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "the number of the pipeline",
-                        "name": "pipeline_number",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/logs/{pipeline_number}/{step_id}": {
+        "/repos/{repo_id}/logs/search": {
             "get": {
+                "description": "Returns the lines containing the query, ignoring case, of the logs of the latest 100 pipelines created since the given time, newest first.\nAt most 100 lines are returned. Finished steps are looked up in the search index of the log store first, words of the query are matched from their start there.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Pipeline logs"
                 ],
-                "summary": "Get logs for a pipeline step",
+                "summary": "Search the logs of the recent pipelines of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -2759,8 +3501,108 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "the number of the pipeline",
+                        "type": "string",
+                        "description": "the text to search for",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only search pipelines created after this RFC3339 time, defaults to 30 days ago",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 2,
+                        "description": "the number of lines returned around a match",
+                        "name": "context",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/LogMatch"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/logs/{pipeline_number}": {
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Pipeline logs"
+                ],
+                "summary": "Deletes all logs of a pipeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/logs/{pipeline_number}/{step_id}": {
+            "get": {
+                "description": "Entries of type LogEntryMetadata carry a JSON encoded object in their data, marking where a command of the step starts or ends:\n{\"event\": \"start\"|\"end\", \"index\": 0, \"started\": \u003cunix ms\u003e, \"duration\": \u003cms\u003e, \"exit_code\": 0}",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline logs"
+                ],
+                "summary": "Get logs for a pipeline step",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of the pipeline",
                         "name": "pipeline_number",
                         "in": "path",
                         "required": true
@@ -2831,6 +3673,66 @@ const docTemplate = `{
                 }
             }
         },
+        "/repos/{repo_id}/logs/{pipeline_number}/{step_id}/attempts/{attempt}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pipeline logs"
+                ],
+                "summary": "Get the logs a pipeline step wrote during a previous attempt of its workflow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the step id",
+                        "name": "step_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of the attempt",
+                        "name": "attempt",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/LogEntry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/repos/{repo_id}/logs/{pipeline_number}/{step_id}/download": {
             "get": {
                 "produces": [
@@ -3269,15 +4171,15 @@ const docTemplate = `{
                 }
             }
         },
-        "/repos/{repo_id}/pipelines/{pipeline_number}/cancel": {
-            "post": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/artifacts": {
+            "get": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
                     "Pipelines"
                 ],
-                "summary": "Cancel a pipeline",
+                "summary": "List the artifacts uploaded by the workflows of a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3304,20 +4206,27 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Artifact"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/pipelines/{pipeline_number}/config": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/artifacts/{artifact_id}": {
             "get": {
+                "description": "The artifact is returned as gzip compressed tar archive of its files.",
                 "produces": [
-                    "application/json"
+                    "application/gzip"
                 ],
                 "tags": [
                     "Pipelines"
                 ],
-                "summary": "Get configuration files for a pipeline",
+                "summary": "Download an artifact of a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3340,30 +4249,31 @@ const docTemplate = `{
                         "name": "pipeline_number",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the id of the artifact",
+                        "name": "artifact_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Config"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/repos/{repo_id}/pipelines/{pipeline_number}/decline": {
-            "post": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/attempts": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Pipelines"
                 ],
-                "summary": "Decline a pipeline",
+                "summary": "List the finished attempts of all workflows of a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3392,21 +4302,24 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Pipeline"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WorkflowAttempt"
+                            }
                         }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/pipelines/{pipeline_number}/metadata": {
-            "get": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/cancel": {
+            "post": {
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
                     "Pipelines"
                 ],
-                "summary": "Get metadata for a pipeline or a specific workflow, including previous pipeline info",
+                "summary": "Cancel a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3433,23 +4346,20 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/metadata.Metadata"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/repos/{repo_id}/pull_requests": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/config": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repositories"
+                    "Pipelines"
                 ],
-                "summary": "List active pull requests of a repository",
+                "summary": "Get configuration files for a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3468,17 +4378,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "for response pagination, page offset number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "for response pagination, max items per page",
-                        "name": "perPage",
-                        "in": "query"
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3487,22 +4390,22 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/PullRequest"
+                                "$ref": "#/definitions/Config"
                             }
                         }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/registries": {
-            "get": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/decline": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repository registries"
+                    "Pipelines"
                 ],
-                "summary": "List registries",
+                "summary": "Decline a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3521,39 +4424,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "for response pagination, page offset number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "for response pagination, max items per page",
-                        "name": "perPage",
-                        "in": "query"
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Registry"
-                            }
+                            "$ref": "#/definitions/Pipeline"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/repos/{repo_id}/pipelines/{pipeline_number}/metadata": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repository registries"
+                    "Pipelines"
                 ],
-                "summary": "Create a registry",
+                "summary": "Get metadata for a pipeline or a specific workflow, including previous pipeline info",
                 "parameters": [
                     {
                         "type": "string",
@@ -3571,75 +4466,33 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the new registry data",
-                        "name": "registry",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/Registry"
-                        }
+                        "type": "integer",
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "$ref": "#/definitions/metadata.Metadata"
                         }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/registries/{registry}": {
+        "/repos/{repo_id}/pipelines/{pipeline_number}/tests": {
             "get": {
+                "description": "The results are parsed from the test reports uploaded by the steps of the pipeline. Each result contains the status of the test in the previous pipelines of the repository, a test which passed and failed within them is flaky.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repository registries"
-                ],
-                "summary": "Get a registry by name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "Bearer \u003cpersonal access token\u003e",
-                        "description": "Insert your personal access token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "the repository id",
-                        "name": "repo_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "the registry name",
-                        "name": "registry",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/Registry"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "produces": [
-                    "text/plain"
-                ],
-                "tags": [
-                    "Repository registries"
+                    "Pipelines"
                 ],
-                "summary": "Delete a registry by name",
+                "summary": "List the test results of a pipeline",
                 "parameters": [
                     {
                         "type": "string",
@@ -3656,80 +4509,48 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "the registry name",
-                        "name": "registry",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "No Content"
-                    }
-                }
-            },
-            "patch": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Repository registries"
-                ],
-                "summary": "Update a registry by name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "Bearer \u003cpersonal access token\u003e",
-                        "description": "Insert your personal access token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "type": "integer",
-                        "description": "the repository id",
-                        "name": "repo_id",
+                        "description": "the number of the pipeline",
+                        "name": "pipeline_number",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "the registry name",
-                        "name": "registry",
-                        "in": "path",
-                        "required": true
+                        "description": "filter test results by status",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
-                        "description": "the attributes for the registry",
-                        "name": "registryData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/Registry"
-                        }
+                        "type": "boolean",
+                        "description": "only return flaky tests",
+                        "name": "flaky",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Registry"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/TestResult"
+                            }
                         }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/repair": {
-            "post": {
+        "/repos/{repo_id}/pull_requests": {
+            "get": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
                     "Repositories"
                 ],
-                "summary": "Repair a repository",
+                "summary": "List active pull requests of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -3745,24 +4566,44 @@ const docTemplate = `{
                         "name": "repo_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PullRequest"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/secrets": {
+        "/repos/{repo_id}/registries": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repository secrets"
+                    "Repository registries"
                 ],
-                "summary": "List repository secrets",
+                "summary": "List registries",
                 "parameters": [
                     {
                         "type": "string",
@@ -3800,7 +4641,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Secret"
+                                "$ref": "#/definitions/Registry"
                             }
                         }
                     }
@@ -3811,9 +4652,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Repository secrets"
+                    "Repository registries"
                 ],
-                "summary": "Create a repository secret",
+                "summary": "Create a registry",
                 "parameters": [
                     {
                         "type": "string",
@@ -3831,12 +4672,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the new secret",
-                        "name": "secret",
+                        "description": "the new registry data",
+                        "name": "registry",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 ],
@@ -3844,21 +4685,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 }
             }
         },
-        "/repos/{repo_id}/secrets/{secretName}": {
+        "/repos/{repo_id}/registries/{registry}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Repository secrets"
+                    "Repository registries"
                 ],
-                "summary": "Get a repository secret by name",
+                "summary": "Get a registry by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -3877,8 +4718,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret name",
-                        "name": "secretName",
+                        "description": "the registry name",
+                        "name": "registry",
                         "in": "path",
                         "required": true
                     }
@@ -3887,7 +4728,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 }
@@ -3897,9 +4738,9 @@ const docTemplate = `{
                     "text/plain"
                 ],
                 "tags": [
-                    "Repository secrets"
+                    "Repository registries"
                 ],
-                "summary": "Delete a repository secret by name",
+                "summary": "Delete a registry by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -3918,8 +4759,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret name",
-                        "name": "secretName",
+                        "description": "the registry name",
+                        "name": "registry",
                         "in": "path",
                         "required": true
                     }
@@ -3935,9 +4776,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Repository secrets"
+                    "Repository registries"
                 ],
-                "summary": "Update a repository secret by name",
+                "summary": "Update a registry by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -3956,18 +4797,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "the secret name",
-                        "name": "secretName",
+                        "description": "the registry name",
+                        "name": "registry",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the secret itself",
-                        "name": "secret",
+                        "description": "the attributes for the registry",
+                        "name": "registryData",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/SecretPatch"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 ],
@@ -3975,21 +4816,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/Registry"
                         }
                     }
                 }
             }
         },
-        "/secrets": {
-            "get": {
+        "/repos/{repo_id}/repair": {
+            "post": {
                 "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Secrets"
+                    "Repositories"
                 ],
-                "summary": "List global secrets",
+                "summary": "Repair a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4001,39 +4842,28 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "default": 1,
-                        "description": "for response pagination, page offset number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 50,
-                        "description": "for response pagination, max items per page",
-                        "name": "perPage",
-                        "in": "query"
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Secret"
-                            }
-                        }
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/repos/{repo_id}/retention": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Secrets"
+                    "Retention policies"
                 ],
-                "summary": "Create a global secret",
+                "summary": "Get the retention policy of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4044,34 +4874,31 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the secret object data",
-                        "name": "secret",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/Secret"
-                        }
-                    }
-                ],
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/RetentionPolicy"
                         }
                     }
                 }
-            }
-        },
-        "/secrets/{secret}": {
-            "get": {
+            },
+            "post": {
+                "description": "Creates or replaces the retention policy of a repository.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Secrets"
+                    "Retention policies"
                 ],
-                "summary": "Get a global secret by name",
+                "summary": "Set the retention policy of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4082,18 +4909,27 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "the retention policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "$ref": "#/definitions/RetentionPolicy"
                         }
                     }
                 }
@@ -4103,9 +4939,9 @@ const docTemplate = `{
                     "text/plain"
                 ],
                 "tags": [
-                    "Secrets"
+                    "Retention policies"
                 ],
-                "summary": "Delete a global secret by name",
+                "summary": "Delete the retention policy of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4116,9 +4952,9 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
                         "in": "path",
                         "required": true
                     }
@@ -4128,15 +4964,17 @@ const docTemplate = `{
                         "description": "No Content"
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/repos/{repo_id}/secrets": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Secrets"
+                    "Repository secrets"
                 ],
-                "summary": "Update a global secret by name",
+                "summary": "List repository secrets",
                 "parameters": [
                     {
                         "type": "string",
@@ -4147,41 +4985,47 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the secret's name",
-                        "name": "secret",
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the secret's data",
-                        "name": "secretData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/SecretPatch"
-                        }
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Secret"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Secret"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/signature/public-key": {
-            "get": {
+            },
+            "post": {
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Repository secrets"
                 ],
-                "summary": "Get server's signature public key",
+                "summary": "Create a repository secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -4190,80 +5034,122 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the new secret",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
                     }
                 }
             }
         },
-        "/stream/events": {
+        "/repos/{repo_id}/secrets/{secretName}": {
             "get": {
-                "description": "With quic and http2 support",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Events"
+                    "Repository secrets"
+                ],
+                "summary": "Get a repository secret by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Stream events like pipeline updates",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
                     }
                 }
-            }
-        },
-        "/stream/logs/{repo_id}/{pipeline}/{step_id}": {
-            "get": {
+            },
+            "delete": {
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Pipeline logs"
+                    "Repository secrets"
                 ],
-                "summary": "Stream logs of a pipeline step",
+                "summary": "Delete a repository secret by name",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "the repository id",
-                        "name": "repo_id",
-                        "in": "path",
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "the number of the pipeline",
-                        "name": "pipeline",
+                        "description": "the repository id",
+                        "name": "repo_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "the step id",
-                        "name": "step_id",
+                        "type": "string",
+                        "description": "the secret name",
+                        "name": "secretName",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "204": {
+                        "description": "No Content"
                     }
                 }
-            }
-        },
-        "/user": {
-            "get": {
+            },
+            "patch": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Repository secrets"
                 ],
-                "summary": "Get the currently authenticated user",
+                "summary": "Update a repository secret by name",
                 "parameters": [
                     {
                         "type": "string",
@@ -4272,28 +5158,51 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the secret name",
+                        "name": "secretName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the secret itself",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SecretPatch"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/Secret"
                         }
                     }
                 }
             }
         },
-        "/user/feed": {
+        "/repos/{repo_id}/webhooks": {
             "get": {
-                "description": "The feed lists the most recent pipeline for the currently authenticated user.",
+                "description": "Webhooks of the parent scopes are not included.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Webhooks"
                 ],
-                "summary": "Get the currently authenticated users pipeline feed",
+                "summary": "List the webhooks of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4302,6 +5211,27 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4310,23 +5240,20 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Feed"
+                                "$ref": "#/definitions/Webhook"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/user/repos": {
-            "get": {
-                "description": "Retrieve the currently authenticated User's Repository list",
+            },
+            "post": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Webhooks"
                 ],
-                "summary": "Get user's repositories",
+                "summary": "Create a webhook of a repository",
                 "parameters": [
                     {
                         "type": "string",
@@ -4337,15 +5264,932 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "query all repos, including inactive ones",
-                        "name": "all",
-                        "in": "query"
-                    },
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/webhooks/{webhook_id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the delivery history as well.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the webhook's data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/WebhookPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Webhook"
+                        }
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/webhooks/{webhook_id}/deliveries": {
+            "get": {
+                "description": "Returns the newest deliveries first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List the deliveries of a webhook of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/repos/{repo_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "description": "Queues the payload of the delivery again as a new delivery.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a delivery of a webhook of a repository",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the delivery's id",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDelivery"
+                        }
+                    }
+                }
+            }
+        },
+        "/retention": {
+            "get": {
+                "description": "The global policy applies to all repos without an own or an organization policy. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Retention policies"
+                ],
+                "summary": "Get the global retention policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates or replaces the global retention policy. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Retention policies"
+                ],
+                "summary": "Set the global retention policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "the retention policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetentionPolicy"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Retention policies"
+                ],
+                "summary": "Delete the global retention policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/secrets": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secrets"
+                ],
+                "summary": "List global secrets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Secret"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secrets"
+                ],
+                "summary": "Create a global secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "the secret object data",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
+                    }
+                }
+            }
+        },
+        "/secrets/{secret}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secrets"
+                ],
+                "summary": "Get a global secret by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the secret's name",
+                        "name": "secret",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Secrets"
+                ],
+                "summary": "Delete a global secret by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the secret's name",
+                        "name": "secret",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secrets"
+                ],
+                "summary": "Update a global secret by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the secret's name",
+                        "name": "secret",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the secret's data",
+                        "name": "secretData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SecretPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Secret"
+                        }
+                    }
+                }
+            }
+        },
+        "/signature/public-key": {
+            "get": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get server's signature public key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/stream/events": {
+            "get": {
+                "description": "With quic and http2 support",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Stream events like pipeline updates",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/stream/logs/{repo_id}/{pipeline}/{step_id}": {
+            "get": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Pipeline logs"
+                ],
+                "summary": "Stream logs of a pipeline step",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "the repository id",
+                        "name": "repo_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of the pipeline",
+                        "name": "pipeline",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the step id",
+                        "name": "step_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/user": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get the currently authenticated user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/feed": {
+            "get": {
+                "description": "The feed lists the most recent pipeline for the currently authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get the currently authenticated users pipeline feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Feed"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/repos": {
+            "get": {
+                "description": "Retrieve the currently authenticated User's Repository list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get user's repositories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "query all repos, including inactive ones",
+                        "name": "all",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter repos by name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/RepoLastPipeline"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/token": {
+            "post": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Return the token of the current user as string",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Reset's the current personal access token of the user and returns a new one.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Reset a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/user/tokens": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "List the personal access tokens of the current user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PersonalAccessToken"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "The token is only returned once. A personal access token can only create tokens with scopes it has itself.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create a personal access token",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "filter repos by name",
-                        "name": "name",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "the name, scopes and expiry of the token",
+                        "name": "token",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PersonalAccessTokenCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NewPersonalAccessToken"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/tokens/{token_id}": {
+            "delete": {
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Revoke a personal access token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the personal access token id",
+                        "name": "token_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Returns all registered, active users in the system. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
                         "in": "query"
                     }
                 ],
@@ -4355,22 +6199,60 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/RepoLastPipeline"
+                                "$ref": "#/definitions/User"
                             }
                         }
                     }
                 }
+            },
+            "post": {
+                "description": "Creates a new user account with the specified external login. Requires admin rights.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "the user's data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                }
             }
         },
-        "/user/token": {
-            "post": {
+        "/users/{login}": {
+            "get": {
+                "description": "Returns a user with the specified login name. Requires admin rights.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Users"
                 ],
-                "summary": "Return the token of the current user as string",
+                "summary": "Get a user",
                 "parameters": [
                     {
                         "type": "string",
@@ -4379,23 +6261,94 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the user's login name",
+                        "name": "login",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "specify forge (else default will be used)",
+                        "name": "forge_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "specify user id at forge (else fallback to login)",
+                        "name": "forge_remote_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
                     }
                 }
             },
             "delete": {
-                "description": "Reset's the current personal access token of the user and returns a new one.",
+                "description": "Deletes the given user. Requires admin rights.",
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "User"
+                    "Users"
                 ],
-                "summary": "Reset a token",
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the user's login name",
+                        "name": "login",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "specify forge (else default will be used)",
+                        "name": "forge_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "specify user id at forge (else fallback to login)",
+                        "name": "forge_remote_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            },
+            "patch": {
+                "description": "Changes the data of an existing user. Requires admin rights.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update a user",
                 "parameters": [
                     {
                         "type": "string",
@@ -4404,25 +6357,72 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the user's login name",
+                        "name": "login",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the user's data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/User"
+                        }
                     }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Endpoint returns the server version and build information.",
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get version",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "source": {
+                                    "type": "string"
+                                },
+                                "version": {
+                                    "type": "string"
+                                }
+                            }
+                        }
                     }
                 }
             }
         },
-        "/users": {
+        "/webhooks": {
             "get": {
-                "description": "Returns all registered, active users in the system. Requires admin rights.",
+                "description": "Requires admin rights.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "List users",
+                "summary": "List the global webhooks",
                 "parameters": [
                     {
                         "type": "string",
@@ -4453,21 +6453,21 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/User"
+                                "$ref": "#/definitions/Webhook"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "Creates a new user account with the specified external login. Requires admin rights.",
+                "description": "Requires admin rights.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Create a user",
+                "summary": "Create a global webhook",
                 "parameters": [
                     {
                         "type": "string",
@@ -4478,12 +6478,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "the user's data",
-                        "name": "user",
+                        "description": "the webhook",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/Webhook"
                         }
                     }
                 ],
@@ -4491,22 +6491,22 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/Webhook"
                         }
                     }
                 }
             }
         },
-        "/users/{login}": {
+        "/webhooks/{webhook_id}": {
             "get": {
-                "description": "Returns a user with the specified login name. Requires admin rights.",
+                "description": "Requires admin rights.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Get a user",
+                "summary": "Get a global webhook",
                 "parameters": [
                     {
                         "type": "string",
@@ -4517,44 +6517,31 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the user's login name",
-                        "name": "login",
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "specify forge (else default will be used)",
-                        "name": "forge_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "specify user id at forge (else fallback to login)",
-                        "name": "forge_remote_id",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/Webhook"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Deletes the given user. Requires admin rights.",
+                "description": "Requires admin rights. Deletes the delivery history as well.",
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Delete a user",
+                "summary": "Delete a global webhook",
                 "parameters": [
                     {
                         "type": "string",
@@ -4565,24 +6552,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the user's login name",
-                        "name": "login",
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "specify forge (else default will be used)",
-                        "name": "forge_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "specify user id at forge (else fallback to login)",
-                        "name": "forge_remote_id",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4592,17 +6566,14 @@ const docTemplate = `{
                 }
             },
             "patch": {
-                "description": "Changes the data of an existing user. Requires admin rights.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Requires admin rights.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Webhooks"
                 ],
-                "summary": "Update a user",
+                "summary": "Update a global webhook",
                 "parameters": [
                     {
                         "type": "string",
@@ -4613,19 +6584,19 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "the user's login name",
-                        "name": "login",
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "the user's data",
-                        "name": "user",
+                        "description": "the webhook's data",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/WebhookPatch"
                         }
                     }
                 ],
@@ -4633,39 +6604,109 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/User"
+                            "$ref": "#/definitions/Webhook"
                         }
                     }
                 }
             }
         },
-        "/version": {
+        "/webhooks/{webhook_id}/deliveries": {
             "get": {
-                "description": "Endpoint returns the server version and build information.",
+                "description": "Requires admin rights. Returns the newest deliveries first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Webhooks"
+                ],
+                "summary": "List the deliveries of a global webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "for response pagination, page offset number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "for response pagination, max items per page",
+                        "name": "perPage",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get version",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "source": {
-                                    "type": "string"
-                                },
-                                "version": {
-                                    "type": "string"
-                                }
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
                             }
                         }
                     }
                 }
             }
+        },
+        "/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver": {
+            "post": {
+                "description": "Requires admin rights. Queues the payload of the delivery again as a new delivery.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver a delivery of a global webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "Bearer \u003cpersonal access token\u003e",
+                        "description": "Insert your personal access token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the webhook's id",
+                        "name": "webhook_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the delivery's id",
+                        "name": "delivery_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WebhookDelivery"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -4713,6 +6754,14 @@ const docTemplate = `{
                 "platform": {
                     "type": "string"
                 },
+                "resources": {
+                    "description": "Resources the agent can allocate for workflows, as last reported by it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
                 "token": {
                     "type": "string"
                 },
@@ -4724,6 +6773,182 @@ const docTemplate = `{
                 }
             }
         },
+        "Artifact": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "expires": {
+                    "description": "Expires is zero if the artifact does not expire.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pipeline_id": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "workflow": {
+                    "type": "string"
+                },
+                "workflow_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "AuditAction": {
+            "type": "string",
+            "enum": [
+                "secret.create",
+                "secret.update",
+                "secret.delete",
+                "registry.create",
+                "registry.update",
+                "registry.delete",
+                "repo.activate",
+                "repo.update",
+                "repo.deactivate",
+                "repo.delete",
+                "repo.chown",
+                "repo.move",
+                "repo.repair",
+                "cron.create",
+                "cron.update",
+                "cron.delete",
+                "agent.create",
+                "agent.update",
+                "agent.delete",
+                "forge.create",
+                "forge.update",
+                "forge.delete",
+                "user.create",
+                "user.update",
+                "user.delete",
+                "user.token_reset",
+                "token.create",
+                "token.delete",
+                "pipeline.approve",
+                "pipeline.decline",
+                "webhook.create",
+                "webhook.update",
+                "webhook.delete"
+            ],
+            "x-enum-varnames": [
+                "AuditSecretCreate",
+                "AuditSecretUpdate",
+                "AuditSecretDelete",
+                "AuditRegistryCreate",
+                "AuditRegistryUpdate",
+                "AuditRegistryDelete",
+                "AuditRepoActivate",
+                "AuditRepoUpdate",
+                "AuditRepoDeactivate",
+                "AuditRepoDelete",
+                "AuditRepoChown",
+                "AuditRepoMove",
+                "AuditRepoRepair",
+                "AuditCronCreate",
+                "AuditCronUpdate",
+                "AuditCronDelete",
+                "AuditAgentCreate",
+                "AuditAgentUpdate",
+                "AuditAgentDelete",
+                "AuditForgeCreate",
+                "AuditForgeUpdate",
+                "AuditForgeDelete",
+                "AuditUserCreate",
+                "AuditUserUpdate",
+                "AuditUserDelete",
+                "AuditUserTokenReset",
+                "AuditTokenCreate",
+                "AuditTokenDelete",
+                "AuditPipelineApprove",
+                "AuditPipelineDecline",
+                "AuditWebhookCreate",
+                "AuditWebhookUpdate",
+                "AuditWebhookDelete"
+            ]
+        },
+        "AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/AuditAction"
+                },
+                "actor_id": {
+                    "type": "integer"
+                },
+                "actor_login": {
+                    "type": "string"
+                },
+                "client_ip": {
+                    "type": "string"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_value": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "old_value": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "repo_id": {
+                    "type": "integer"
+                },
+                "target_id": {
+                    "type": "integer"
+                },
+                "target_name": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "$ref": "#/definitions/AuditTargetType"
+                }
+            }
+        },
+        "AuditTargetType": {
+            "type": "string",
+            "enum": [
+                "secret",
+                "registry",
+                "repo",
+                "cron",
+                "agent",
+                "forge",
+                "user",
+                "token",
+                "pipeline",
+                "webhook"
+            ],
+            "x-enum-varnames": [
+                "AuditTargetSecret",
+                "AuditTargetRegistry",
+                "AuditTargetRepo",
+                "AuditTargetCron",
+                "AuditTargetAgent",
+                "AuditTargetForge",
+                "AuditTargetUser",
+                "AuditTargetToken",
+                "AuditTargetPipeline",
+                "AuditTargetWebhook"
+            ]
+        },
         "CancelInfo": {
             "type": "object",
             "properties": {
@@ -4780,6 +7005,9 @@ const docTemplate = `{
                 "next_exec": {
                     "type": "integer"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "repo_id": {
                     "type": "integer"
                 },
@@ -4810,6 +7038,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "schedule": {
                     "type": "string"
                 },
@@ -4824,6 +7055,19 @@ const docTemplate = `{
                 }
             }
         },
+        "FailureClass": {
+            "type": "string",
+            "enum": [
+                "agent_lost",
+                "setup_error",
+                "oom"
+            ],
+            "x-enum-varnames": [
+                "FailureAgentLost",
+                "FailureSetupError",
+                "FailureOOM"
+            ]
+        },
         "Feed": {
             "type": "object",
             "properties": {
@@ -4968,6 +7212,40 @@ const docTemplate = `{
                 }
             }
         },
+        "JWK": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "type": "string"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                }
+            }
+        },
+        "JWKS": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/JWK"
+                    }
+                }
+            }
+        },
         "LogEntry": {
             "type": "object",
             "properties": {
@@ -5011,6 +7289,111 @@ const docTemplate = `{
                 "LogEntryProgress"
             ]
         },
+        "LogMatch": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "before": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "pipeline_number": {
+                    "type": "integer"
+                },
+                "step_id": {
+                    "type": "integer"
+                },
+                "step_name": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "NewPersonalAccessToken": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "Created is the unix timestamp the token was created at.",
+                    "type": "integer"
+                },
+                "expires": {
+                    "description": "Expires is the unix timestamp the token expires at, 0 if it never expires.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used": {
+                    "description": "LastUsed is the unix timestamp the token was last used at, it is updated at most once a minute.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TokenScope"
+                    }
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "OpenIDConfiguration": {
+            "type": "object",
+            "properties": {
+                "claims_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id_token_signing_alg_values_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "jwks_uri": {
+                    "type": "string"
+                },
+                "response_types_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scopes_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subject_types_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "Org": {
             "type": "object",
             "properties": {
@@ -5025,6 +7408,18 @@ const docTemplate = `{
                 },
                 "name": {
                     "type": "string"
+                },
+                "share_weight": {
+                    "description": "ShareWeight is the share of agents the org gets compared to other orgs\nif fair share scheduling is enabled. Values below 1 count as 1.",
+                    "type": "integer"
+                }
+            }
+        },
+        "OrgPatch": {
+            "type": "object",
+            "properties": {
+                "share_weight": {
+                    "type": "integer"
                 }
             }
         },
@@ -5062,6 +7457,53 @@ const docTemplate = `{
                 }
             }
         },
+        "PersonalAccessToken": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "description": "Created is the unix timestamp the token was created at.",
+                    "type": "integer"
+                },
+                "expires": {
+                    "description": "Expires is the unix timestamp the token expires at, 0 if it never expires.",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_used": {
+                    "description": "LastUsed is the unix timestamp the token was last used at, it is updated at most once a minute.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TokenScope"
+                    }
+                }
+            }
+        },
+        "PersonalAccessTokenCreate": {
+            "type": "object",
+            "properties": {
+                "expires": {
+                    "description": "Expires is the unix timestamp the token expires at, 0 if it never expires.",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TokenScope"
+                    }
+                }
+            }
+        },
         "Pipeline": {
             "type": "object",
             "properties": {
@@ -5135,6 +7577,10 @@ const docTemplate = `{
                     "description": "deprecated, use release.is_prerelease instead",
                     "type": "boolean"
                 },
+                "logs_deleted": {
+                    "description": "logs got removed by a retention policy",
+                    "type": "boolean"
+                },
                 "message": {
                     "type": "string"
                 },
@@ -5156,6 +7602,9 @@ const docTemplate = `{
                 "pr_milestone": {
                     "type": "string"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "ref": {
                     "type": "string"
                 },
@@ -5239,9 +7688,42 @@ const docTemplate = `{
         "PullRequest": {
             "type": "object",
             "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "author_avatar": {
+                    "type": "string"
+                },
+                "branch": {
+                    "type": "string"
+                },
+                "commit": {
+                    "type": "string"
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "forge_url": {
+                    "type": "string"
+                },
+                "from_fork": {
+                    "type": "boolean"
+                },
                 "index": {
                     "type": "string"
                 },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ref": {
+                    "type": "string"
+                },
+                "refspec": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -5414,9 +7896,18 @@ const docTemplate = `{
                 "owner": {
                     "type": "string"
                 },
+                "poll_interval": {
+                    "type": "integer"
+                },
+                "pr_comment": {
+                    "type": "boolean"
+                },
                 "pr_enabled": {
                     "type": "boolean"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "private": {
                     "type": "boolean"
                 },
@@ -5536,9 +8027,18 @@ const docTemplate = `{
                 "owner": {
                     "type": "string"
                 },
+                "poll_interval": {
+                    "type": "integer"
+                },
+                "pr_comment": {
+                    "type": "boolean"
+                },
                 "pr_enabled": {
                     "type": "boolean"
                 },
+                "priority": {
+                    "type": "integer"
+                },
                 "private": {
                     "type": "boolean"
                 },
@@ -5607,6 +8107,15 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "poll_interval": {
+                    "type": "integer"
+                },
+                "pr_comment": {
+                    "type": "boolean"
+                },
+                "priority": {
+                    "type": "integer"
+                },
                 "registry_extension_endpoint": {
                     "type": "string"
                 },
@@ -5619,32 +8128,104 @@ const docTemplate = `{
                 "secret_extension_endpoint": {
                     "type": "string"
                 },
-                "secret_extension_netrc": {
-                    "type": "boolean"
+                "secret_extension_netrc": {
+                    "type": "boolean"
+                },
+                "timeout": {
+                    "type": "integer"
+                },
+                "trusted": {
+                    "$ref": "#/definitions/model.TrustedConfigurationPatch"
+                },
+                "visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "RepoVisibility": {
+            "type": "string",
+            "enum": [
+                "public",
+                "private",
+                "internal"
+            ],
+            "x-enum-varnames": [
+                "VisibilityPublic",
+                "VisibilityPrivate",
+                "VisibilityInternal"
+            ]
+        },
+        "Resources": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "description": "in millicores",
+                    "type": "integer"
+                },
+                "disk": {
+                    "description": "in bytes",
+                    "type": "integer"
+                },
+                "memory": {
+                    "description": "in bytes",
+                    "type": "integer"
+                }
+            }
+        },
+        "RetentionPolicy": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "keep_last_success": {
+                    "description": "KeepLastSuccess always keeps the latest successful pipeline per branch,\ntag or pull request together with its logs.",
+                    "type": "boolean"
+                },
+                "keep_pipelines": {
+                    "description": "KeepPipelines is the number of the latest pipelines kept per branch,\ntag or pull request.",
+                    "type": "integer"
+                },
+                "log_days": {
+                    "description": "LogDays is the number of days the logs of a pipeline are kept after\nit finished.",
+                    "type": "integer"
                 },
-                "timeout": {
+                "org_id": {
                     "type": "integer"
                 },
-                "trusted": {
-                    "$ref": "#/definitions/model.TrustedConfigurationPatch"
+                "pipeline_days": {
+                    "description": "PipelineDays limits the deletion to pipelines older than that many\ndays. Without KeepPipelines all pipelines older than that are deleted.",
+                    "type": "integer"
                 },
-                "visibility": {
-                    "type": "string"
+                "repo_id": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
                 }
             }
         },
-        "RepoVisibility": {
-            "type": "string",
-            "enum": [
-                "public",
-                "private",
-                "internal"
-            ],
-            "x-enum-varnames": [
-                "VisibilityPublic",
-                "VisibilityPrivate",
-                "VisibilityInternal"
-            ]
+        "RetryPolicy": {
+            "type": "object",
+            "properties": {
+                "backoff": {
+                    "description": "Backoff is the delay before the second attempt in seconds, it is doubled\nfor every further attempt.",
+                    "type": "integer"
+                },
+                "max_attempts": {
+                    "description": "MaxAttempts is the maximum number of attempts, including the first one.",
+                    "type": "integer"
+                },
+                "on": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FailureClass"
+                    }
+                }
+            }
         },
         "Secret": {
             "type": "object",
@@ -5810,14 +8391,16 @@ const docTemplate = `{
                 "service",
                 "plugin",
                 "commands",
-                "cache"
+                "cache",
+                "artifacts"
             ],
             "x-enum-varnames": [
                 "StepTypeClone",
                 "StepTypeService",
                 "StepTypePlugin",
                 "StepTypeCommands",
-                "StepTypeCache"
+                "StepTypeCache",
+                "StepTypeArtifacts"
             ]
         },
         "Task": {
@@ -5826,6 +8409,10 @@ const docTemplate = `{
                 "agent_id": {
                     "type": "integer"
                 },
+                "attempt": {
+                    "description": "Attempt is the number of the current attempt to run the task, it is\nincreased whenever the task is put back into the queue.",
+                    "type": "integer"
+                },
                 "concurrency_group": {
                     "description": "ConcurrencyGroup identifies tasks that are limited against each other.\nIt is empty when no concurrency limit applies.",
                     "type": "string"
@@ -5862,15 +8449,31 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "not_before": {
+                    "description": "NotBefore is the unix timestamp in milliseconds until a task put back\ninto the queue waits before it is handed out again.",
+                    "type": "integer"
+                },
                 "pid": {
                     "type": "integer"
                 },
                 "pipeline_id": {
                     "type": "integer"
                 },
+                "priority": {
+                    "description": "Priority orders pending tasks in the queue: higher values are handed out\nfirst, tasks of equal priority keep their queue order.",
+                    "type": "integer"
+                },
                 "repo_id": {
                     "type": "integer"
                 },
+                "resources": {
+                    "description": "Resources are requested from the agent the task runs on, the task is only\nhanded out to an agent with enough free resources left.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
                 "run_on": {
                     "type": "array",
                     "items": {
@@ -5879,6 +8482,121 @@ const docTemplate = `{
                 }
             }
         },
+        "TestResult": {
+            "type": "object",
+            "properties": {
+                "classname": {
+                    "type": "string"
+                },
+                "details": {
+                    "type": "string"
+                },
+                "duration": {
+                    "description": "Duration is the run time of the test in milliseconds.",
+                    "type": "integer"
+                },
+                "file": {
+                    "description": "File and Line locate the test in the repo, if the report contains them.",
+                    "type": "string"
+                },
+                "flaky": {
+                    "description": "Flaky is set if the test passed and failed within its history.",
+                    "type": "boolean"
+                },
+                "history": {
+                    "description": "History holds the status of the test in the previous pipelines of the\nrepo, the latest first. It is only set by the API.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TestRun"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pipeline_id": {
+                    "type": "integer"
+                },
+                "pipeline_number": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/TestStatus"
+                },
+                "step_id": {
+                    "type": "integer"
+                },
+                "suite": {
+                    "type": "string"
+                }
+            }
+        },
+        "TestRun": {
+            "type": "object",
+            "properties": {
+                "pipeline_number": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/TestStatus"
+                }
+            }
+        },
+        "TestStatus": {
+            "type": "string",
+            "enum": [
+                "passed",
+                "failed",
+                "error",
+                "skipped"
+            ],
+            "x-enum-comments": {
+                "TestStatusError": "the test could not be run to its end",
+                "TestStatusFailed": "an assertion of the test failed",
+                "TestStatusSkipped": "the test was not run at all"
+            },
+            "x-enum-descriptions": [
+                "",
+                "an assertion of the test failed",
+                "the test could not be run to its end",
+                "the test was not run at all"
+            ],
+            "x-enum-varnames": [
+                "TestStatusPassed",
+                "TestStatusFailed",
+                "TestStatusError",
+                "TestStatusSkipped"
+            ]
+        },
+        "TokenScope": {
+            "type": "string",
+            "enum": [
+                "repo:read",
+                "repo:write",
+                "pipeline:write",
+                "secrets:read",
+                "secrets:write",
+                "user:write",
+                "admin"
+            ],
+            "x-enum-varnames": [
+                "TokenScopeRepoRead",
+                "TokenScopeRepoWrite",
+                "TokenScopePipelineWrite",
+                "TokenScopeSecretsRead",
+                "TokenScopeSecretsWrite",
+                "TokenScopeUserWrite",
+                "TokenScopeAdmin"
+            ]
+        },
         "User": {
             "type": "object",
             "properties": {
@@ -5917,6 +8635,95 @@ const docTemplate = `{
                 }
             }
         },
+        "Webhook": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookTrigger"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "org_id": {
+                    "type": "integer"
+                },
+                "repo_id": {
+                    "type": "integer"
+                },
+                "updated": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created": {
+                    "type": "integer"
+                },
+                "delivered": {
+                    "description": "Delivered is the unix time of the last attempt.",
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/WebhookTrigger"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "next_attempt": {
+                    "description": "NextAttempt is the unix time a pending delivery is sent next.",
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "description": "ResponseCode is the http status code of the last attempt, 0 if the\nwebhook could not be reached.",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/WebhookDeliveryStatus"
+                },
+                "webhook_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "success",
+                "failure"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySuccess",
+                "WebhookDeliveryFailure"
+            ]
+        },
         "WebhookEvent": {
             "type": "string",
             "enum": [
@@ -5942,6 +8749,80 @@ const docTemplate = `{
                 "EventManual"
             ]
         },
+        "WebhookPatch": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookTrigger"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "WebhookTrigger": {
+            "type": "string",
+            "enum": [
+                "pipeline_created",
+                "pipeline_started",
+                "pipeline_finished",
+                "workflow_finished",
+                "approval_required",
+                "agent_offline"
+            ],
+            "x-enum-varnames": [
+                "WebhookTriggerPipelineCreated",
+                "WebhookTriggerPipelineStarted",
+                "WebhookTriggerPipelineFinished",
+                "WebhookTriggerWorkflowFinished",
+                "WebhookTriggerApprovalRequired",
+                "WebhookTriggerAgentOffline"
+            ]
+        },
+        "WorkflowAttempt": {
+            "type": "object",
+            "properties": {
+                "agent_id": {
+                    "type": "integer"
+                },
+                "attempt": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "failure": {
+                    "$ref": "#/definitions/FailureClass"
+                },
+                "finished": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "pipeline_id": {
+                    "type": "integer"
+                },
+                "started": {
+                    "type": "integer"
+                },
+                "state": {
+                    "$ref": "#/definitions/StatusValue"
+                },
+                "workflow_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "errors.PipelineError": {
             "type": "object",
             "properties": {
@@ -5964,28 +8845,32 @@ const docTemplate = `{
                 "deprecation",
                 "compiler",
                 "generic",
-                "bad_habit"
+                "bad_habit",
+                "secret_leak"
             ],
             "x-enum-comments": {
                 "PipelineErrorTypeBadHabit": "some bad-habit error",
                 "PipelineErrorTypeCompiler": "some error with the config semantics",
                 "PipelineErrorTypeDeprecation": "using some deprecated feature",
                 "PipelineErrorTypeGeneric": "some generic error",
-                "PipelineErrorTypeLinter": "some error with the config syntax"
+                "PipelineErrorTypeLinter": "some error with the config syntax",
+                "PipelineErrorTypeSecretLeak": "logs of a step seem to contain credentials"
             },
             "x-enum-descriptions": [
                 "some error with the config syntax",
                 "using some deprecated feature",
                 "some error with the config semantics",
                 "some generic error",
-                "some bad-habit error"
+                "some bad-habit error",
+                "logs of a step seem to contain credentials"
             ],
             "x-enum-varnames": [
                 "PipelineErrorTypeLinter",
                 "PipelineErrorTypeDeprecation",
                 "PipelineErrorTypeCompiler",
                 "PipelineErrorTypeGeneric",
-                "PipelineErrorTypeBadHabit"
+                "PipelineErrorTypeBadHabit",
+                "PipelineErrorTypeSecretLeak"
             ]
         },
         "metadata.Author": {
@@ -6314,6 +9199,7 @@ const docTemplate = `{
                 "forgejo",
                 "bitbucket",
                 "bitbucket-dc",
+                "git",
                 "addon"
             ],
             "x-enum-varnames": [
@@ -6323,6 +9209,7 @@ const docTemplate = `{
                 "ForgeTypeForgejo",
                 "ForgeTypeBitbucket",
                 "ForgeTypeBitbucketDatacenter",
+                "ForgeTypeGit",
                 "ForgeTypeAddon"
             ]
         },
@@ -6335,6 +9222,10 @@ const docTemplate = `{
                 "agent_name": {
                     "type": "string"
                 },
+                "attempt": {
+                    "description": "Attempt is the number of the current attempt to run the task, it is\nincreased whenever the task is put back into the queue.",
+                    "type": "integer"
+                },
                 "concurrency_group": {
                     "description": "ConcurrencyGroup identifies tasks that are limited against each other.\nIt is empty when no concurrency limit applies.",
                     "type": "string"
@@ -6371,6 +9262,10 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "not_before": {
+                    "description": "NotBefore is the unix timestamp in milliseconds until a task put back\ninto the queue waits before it is handed out again.",
+                    "type": "integer"
+                },
                 "pid": {
                     "type": "integer"
                 },
@@ -6380,9 +9275,21 @@ const docTemplate = `{
                 "pipeline_number": {
                     "type": "integer"
                 },
+                "priority": {
+                    "description": "Priority orders pending tasks in the queue: higher values are handed out\nfirst, tasks of equal priority keep their queue order.",
+                    "type": "integer"
+                },
                 "repo_id": {
                     "type": "integer"
                 },
+                "resources": {
+                    "description": "Resources are requested from the agent the task runs on, the task is only\nhanded out to an agent with enough free resources left.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
                 "run_on": {
                     "type": "array",
                     "items": {
@@ -6425,6 +9332,9 @@ const docTemplate = `{
                 "agent_id": {
                     "type": "integer"
                 },
+                "attempt": {
+                    "type": "integer"
+                },
                 "children": {
                     "type": "array",
                     "items": {
@@ -6458,6 +9368,9 @@ const docTemplate = `{
                 "platform": {
                     "type": "string"
                 },
+                "retry": {
+                    "$ref": "#/definitions/RetryPolicy"
+                },
                 "started": {
                     "type": "integer"
                 },
@@ -40,8 +40,12 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	cron_scheduler "go.woodpecker-ci.org/woodpecker/v3/server/cron"
 	"go.woodpecker-ci.org/woodpecker/v3/server/metric"
+	"go.woodpecker-ci.org/woodpecker/v3/server/poller"
+	"go.woodpecker-ci.org/woodpecker/v3/server/retention"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	"go.woodpecker-ci.org/woodpecker/v3/server/web"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/logger"
@@ -139,6 +143,68 @@ func run(ctx context.Context, c *cli.Command) error {
 		return nil
 	})
 
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("starting artifact cleanup service ...")
+		if err := artifact.RunCleanup(ctx, _store, server.Config.Services.Artifacts); err != nil {
+			go stopServerFunc(err)
+			return err
+		}
+		log.Info().Msg("artifact cleanup service stopped")
+		return nil
+	})
+
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("starting retention service ...")
+		if err := retention.New(_store, server.Config.Services.LogStore, server.Config.Services.Artifacts).Run(ctx); err != nil {
+			go stopServerFunc(err)
+			return err
+		}
+		log.Info().Msg("retention service stopped")
+		return nil
+	})
+
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("starting poller service ...")
+		if err := poller.New(_store).Run(ctx); err != nil {
+			go stopServerFunc(err)
+			return err
+		}
+		log.Info().Msg("poller service stopped")
+		return nil
+	})
+
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("starting webhook service ...")
+		if err := server.Config.Services.Webhooks.Run(ctx); err != nil {
+			go stopServerFunc(err)
+			return err
+		}
+		log.Info().Msg("webhook service stopped")
+		return nil
+	})
+
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("compressing logs of finished steps ...")
+		if err := service_log.CompressFinished(ctx, _store, server.Config.Services.LogStore); err != nil {
+			// logs stay readable uncompressed, so the server keeps running
+			log.Error().Err(err).Msg("could not compress logs of finished steps")
+			return nil
+		}
+		log.Info().Msg("logs of finished steps compressed")
+		return nil
+	})
+
+	serviceWaitingGroup.Go(func() error {
+		log.Info().Msg("indexing logs of finished steps ...")
+		if err := service_log.IndexFinished(ctx, _store, server.Config.Services.LogStore); err != nil {
+			// logs of steps not indexed are just not found by searches
+			log.Error().Err(err).Msg("could not index logs of finished steps")
+			return nil
+		}
+		log.Info().Msg("logs of finished steps indexed")
+		return nil
+	})
+
 	// start the grpc server
 	serviceWaitingGroup.Go(func() error {
 		log.Info().Msg("starting grpc server ...")
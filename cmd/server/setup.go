@@ -33,17 +33,27 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/setup"
 	"go.woodpecker-ci.org/woodpecker/v3/server/logging"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub/database"
 	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub/memory"
 	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
 	"go.woodpecker-ci.org/woodpecker/v3/server/scheduler"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services"
+	service_artifact "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	artifact_file "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/file"
+	artifact_s3 "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/s3"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/audit"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/idtoken"
 	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/addon"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/log/file"
+	log_s3 "go.woodpecker-ci.org/woodpecker/v3/server/services/log/s3"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/permissions"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/webhook"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
 )
 
 func setupStore(ctx context.Context, c *cli.Command) (store.Store, error) {
@@ -106,10 +116,39 @@ func checkSqliteFileExist(path string) error {
 	return err
 }
 
-func setupQueue(ctx context.Context, s store.Store) (queue.Queue, error) {
-	return queue.New(ctx, queue.Config{
-		Backend: queue.TypeMemory,
-		Store:   s,
+func setupQueue(ctx context.Context, c *cli.Command, s store.Store) (queue.Queue, error) {
+	config := queue.Config{
+		Backend:        queue.Type(c.String("queue-backend")),
+		Store:          s,
+		AgentResources: scheduler.NewAgentResources(ctx, s),
+	}
+	if c.Bool("queue-fair-share") {
+		config.FairShare = scheduler.NewFairShare(ctx, s)
+	}
+	return queue.New(ctx, config)
+}
+
+func setupPubSub(ctx context.Context, c *cli.Command, s store.Store) (pubsub.PubSub, error) {
+	switch c.String("pubsub-backend") {
+	case "memory":
+		return memory.New(), nil
+	case "database":
+		return database.New(ctx, s), nil
+	default:
+		return nil, fmt.Errorf("pubsub backend '%s' does not exist", c.String("pubsub-backend"))
+	}
+}
+
+// setupLogging passes live logs on through the pubsub if it is shared by
+// multiple servers, as the agent and the client can be connected to different
+// ones.
+func setupLogging(ctx context.Context, c *cli.Command, ps pubsub.PubSub) logging.Log {
+	if c.String("pubsub-backend") != "database" {
+		return logging.New()
+	}
+	return logging.NewPubSub(ctx, ps, func(_ context.Context, stepID int64) ([]*model.LogEntry, error) {
+		// the log stores only need the id of the step to find its logs
+		return server.Config.Services.LogStore.LogFind(&model.Step{ID: stepID})
 	})
 }
 
@@ -123,11 +162,38 @@ func setupLogStore(c *cli.Command, s store.Store) (service_log.Service, error) {
 		return file.NewLogStore(c.String("log-store-file-path"))
 	case "addon":
 		return addon.Load(c.String("log-store-file-path"))
+	case "s3":
+		return log_s3.NewLogStore(s3.Config{
+			Endpoint:        c.String("log-store-s3-endpoint"),
+			Bucket:          c.String("log-store-s3-bucket"),
+			Region:          c.String("log-store-s3-region"),
+			AccessKeyID:     c.String("log-store-s3-access-key-id"),
+			SecretAccessKey: c.String("log-store-s3-secret-access-key"),
+			PathStyle:       c.Bool("log-store-s3-path-style"),
+		}, c.String("log-store-s3-prefix"))
 	default:
 		return s, nil
 	}
 }
 
+func setupArtifactStore(c *cli.Command) (service_artifact.Service, error) {
+	switch c.String("artifact-store") {
+	case "file":
+		return artifact_file.NewArtifactStore(c.String("artifact-store-file-path"))
+	case "s3":
+		return artifact_s3.NewArtifactStore(s3.Config{
+			Endpoint:        c.String("artifact-store-s3-endpoint"),
+			Bucket:          c.String("artifact-store-s3-bucket"),
+			Region:          c.String("artifact-store-s3-region"),
+			AccessKeyID:     c.String("artifact-store-s3-access-key-id"),
+			SecretAccessKey: c.String("artifact-store-s3-secret-access-key"),
+			PathStyle:       c.Bool("artifact-store-s3-path-style"),
+		}, c.String("artifact-store-s3-prefix"))
+	default:
+		return nil, fmt.Errorf("artifact store '%s' does not exist", c.String("artifact-store"))
+	}
+}
+
 const jwtSecretID = "jwt-secret"
 
 func setupGrpcSecret(secret string) (string, bool) {
@@ -161,10 +227,13 @@ func setupJWTSecret(_store store.Store) (string, error) {
 
 func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err error) {
 	// services
-	server.Config.Services.Logs = logging.New()
 	server.Config.Services.Membership = setupMembershipService(ctx, s)
-	pubsub := memory.New()
-	queue, err := setupQueue(ctx, s)
+	pubsub, err := setupPubSub(ctx, c, s)
+	if err != nil {
+		return fmt.Errorf("could not setup pubsub: %w", err)
+	}
+	server.Config.Services.Logs = setupLogging(ctx, c, pubsub)
+	queue, err := setupQueue(ctx, c, s)
 	if err != nil {
 		return fmt.Errorf("could not setup queue: %w", err)
 	}
@@ -177,6 +246,17 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	if err != nil {
 		return fmt.Errorf("could not setup log store: %w", err)
 	}
+	server.Config.Services.Artifacts, err = setupArtifactStore(c)
+	if err != nil {
+		return fmt.Errorf("could not setup artifact store: %w", err)
+	}
+	if path := c.String("audit-log-file"); path != "" {
+		sink, err := audit.NewFileSink(path)
+		if err != nil {
+			return err
+		}
+		server.Config.Services.AuditSink = sink
+	}
 
 	// agents
 	server.Config.Agent.DisableUserRegisteredAgentRegistration = c.Bool("disable-user-agent-registration")
@@ -214,6 +294,10 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	server.Config.Pipeline.DefaultCancelPreviousPipelineEvents = events
 	server.Config.Pipeline.DefaultTimeout = c.Int64("default-pipeline-timeout")
 	server.Config.Pipeline.MaxTimeout = c.Int64("max-pipeline-timeout")
+	server.Config.Pipeline.MaxWorkflowAttempts = max(c.Int("max-workflow-attempts"), 1)
+	server.Config.Pipeline.WorkflowRetryBackoff = c.Duration("workflow-retry-backoff")
+	server.Config.Pipeline.ArtifactMaxSize = c.Int64("artifact-max-size")
+	server.Config.Pipeline.ArtifactRetention = c.Duration("artifact-retention")
 
 	_labels := c.StringSlice("default-workflow-labels")
 	labels := make(map[string]string, len(_labels))
@@ -262,6 +346,11 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 		rootPath = "/" + rootPath
 	}
 	server.Config.Server.RootPath = rootPath
+	server.Config.Services.IDTokens, err = idtoken.New(s, idTokenIssuer(u, rootPath))
+	if err != nil {
+		return fmt.Errorf("could not setup id token issuer: %w", err)
+	}
+	server.Config.Services.Webhooks = webhook.New(s, server.Config.Services.Manager.WebhookClient(), serverHost)
 	server.Config.Server.CustomCSSFile = strings.TrimSpace(c.String("custom-css-file"))
 	server.Config.Server.CustomJsFile = strings.TrimSpace(c.String("custom-js-file"))
 	server.Config.Pipeline.Networks = c.StringSlice("network")
@@ -287,3 +376,9 @@ func setupEvilGlobals(ctx context.Context, c *cli.Command, s store.Store) (err e
 	server.Config.Permissions.OwnersAllowlist = permissions.NewOwnersAllowlist(c.StringSlice("repo-owners"))
 	return nil
 }
+
+// idTokenIssuer returns the issuer of identity tokens, it has to match the
+// address the discovery document is served at below the root path.
+func idTokenIssuer(host *url.URL, rootPath string) string {
+	return host.Scheme + "://" + host.Host + rootPath
+}
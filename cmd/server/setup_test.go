@@ -17,6 +17,7 @@ package main
 import (
 	"encoding/base32"
 	"errors"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
@@ -136,3 +137,13 @@ func TestSetupGrpcSecret(t *testing.T) {
 		assert.Len(t, decoded, 32)
 	})
 }
+
+func TestIDTokenIssuer(t *testing.T) {
+	host, err := url.Parse("https://ci.example.com/woodpecker")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ci.example.com/woodpecker", idTokenIssuer(host, "/woodpecker"))
+
+	host, err = url.Parse("https://ci.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ci.example.com", idTokenIssuer(host, ""))
+}
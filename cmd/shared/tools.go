@@ -21,6 +21,8 @@ import (
 	"os"
 
 	"github.com/urfave/cli/v3"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 )
 
 // Base64Decoder decodes one Base64 argument and writes its bytes to stdout.
@@ -37,3 +39,13 @@ func Base64Decoder(_ context.Context, c *cli.Command) error {
 	_, err = os.Stdout.Write(decoded)
 	return err
 }
+
+// CacheStep restores or saves the cache of a cache step, the workspace is the
+// working directory and the step is configured by environment variables.
+func CacheStep(ctx context.Context, _ *cli.Command) error {
+	workspace, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	return cache.RunStep(ctx, os.Getenv, workspace, os.Stdout)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -82,3 +83,27 @@ func TestBase64DecoderErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheStep(t *testing.T) {
+	cacheDir := t.TempDir()
+	workspace := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(workspace, "vendor"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "vendor", "lib.go"), []byte("package lib"), 0o644))
+
+	t.Chdir(workspace)
+	t.Setenv("WOODPECKER_CACHE_SCOPE", "octocat/hello-world")
+	t.Setenv("WOODPECKER_CACHE_DIR", cacheDir)
+	t.Setenv("WOODPECKER_CACHE_ACTION", "save")
+	t.Setenv("WOODPECKER_CACHE_KEY", "vendor")
+	t.Setenv("WOODPECKER_CACHE_PATHS", "vendor")
+
+	command := &cli.Command{
+		Name:   "cache",
+		Action: CacheStep,
+	}
+	require.NoError(t, command.Run(t.Context(), []string{"cache"}))
+	assert.FileExists(t, filepath.Join(cacheDir, "octocat", "hello-world", "vendor.tar.gz"))
+
+	t.Setenv("WOODPECKER_CACHE_ACTION", "download")
+	require.EqualError(t, command.Run(t.Context(), []string{"cache"}), `unknown cache action "download"`)
+}
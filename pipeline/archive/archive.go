@@ -0,0 +1,217 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive writes and extracts gzip compressed tar archives of paths
+// of a workspace, as used by caches and artifacts.
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// CleanPaths normalizes the archived paths, they have to stay inside of the
+// workspace.
+func CleanPaths(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no paths to archive")
+	}
+
+	cleaned := make([]string, 0, len(paths))
+	for _, p := range paths {
+		p = path.Clean(filepath.ToSlash(p))
+		if path.IsAbs(p) || filepath.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+			return nil, fmt.Errorf("path %q must be relative to the workspace", p)
+		}
+		cleaned = append(cleaned, p)
+	}
+	return cleaned, nil
+}
+
+// inPaths reports whether name is one of the paths or inside of one of them.
+func inPaths(name string, paths []string) bool {
+	for _, p := range paths {
+		if p == "." || name == p || strings.HasPrefix(name, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Write writes a gzip compressed tar archive of the paths of the workspace
+// and returns the number of archived files. Paths that don't exist are
+// skipped.
+func Write(w io.Writer, root *os.Root, paths []string, skip func(name string) bool) (int, error) {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	files := 0
+	for _, p := range paths {
+		if _, err := root.Lstat(p); errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		err := fs.WalkDir(root.FS(), p, func(name string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if name == "." || skip(name) {
+				return nil
+			}
+			added, err := addToArchive(tw, root, name)
+			if added {
+				files++
+			}
+			return err
+		})
+		if err != nil {
+			return files, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return files, err
+	}
+	return files, gz.Close()
+}
+
+func addToArchive(tw *tar.Writer, root *os.Root, name string) (bool, error) {
+	info, err := root.Lstat(name)
+	if err != nil {
+		return false, err
+	}
+
+	var link string
+	switch {
+	case info.Mode()&fs.ModeSymlink != 0:
+		if link, err = root.Readlink(name); err != nil {
+			return false, err
+		}
+	case !info.Mode().IsRegular() && !info.IsDir():
+		// sockets, devices and the like can't be restored anyway
+		return false, nil
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return false, err
+	}
+	header.Name = name
+	if info.IsDir() {
+		header.Name += "/"
+	}
+	// owners differ between the containers of a workflow, so they are not kept
+	header.Uid, header.Gid, header.Uname, header.Gname = 0, 0, "", ""
+	if err := tw.WriteHeader(header); err != nil {
+		return false, err
+	}
+
+	if !info.Mode().IsRegular() {
+		return false, nil
+	}
+	file, err := root.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	_, err = io.Copy(tw, file)
+	return true, err
+}
+
+// Extract extracts a gzip compressed tar archive into the workspace and
+// returns the number of extracted files. Only entries inside of the paths are
+// extracted, the root keeps symlinks of the archive from pointing writes
+// outside of the workspace.
+func Extract(r io.Reader, root *os.Root, paths []string) (int, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("read archive: %w", err)
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	files := 0
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files, nil
+		}
+		if err != nil {
+			return files, fmt.Errorf("read archive: %w", err)
+		}
+
+		name := path.Clean(header.Name)
+		if !inPaths(name, paths) {
+			return files, fmt.Errorf("archive entry %q is outside of the archived paths", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := root.MkdirAll(name, header.FileInfo().Mode().Perm()|0o700); err != nil {
+				return files, err
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, root, name, header); err != nil {
+				return files, err
+			}
+			files++
+		case tar.TypeSymlink:
+			if err := prepareEntry(root, name); err != nil {
+				return files, err
+			}
+			if err := root.Symlink(header.Linkname, name); err != nil {
+				return files, err
+			}
+		}
+	}
+}
+
+func extractFile(r io.Reader, root *os.Root, name string, header *tar.Header) error {
+	if err := prepareEntry(root, name); err != nil {
+		return err
+	}
+
+	file, err := root.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, header.FileInfo().Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return root.Chtimes(name, header.ModTime, header.ModTime)
+}
+
+// prepareEntry creates the parent directory of an entry and removes what
+// exists at its place.
+func prepareEntry(root *os.Root, name string) error {
+	if err := root.MkdirAll(path.Dir(name), 0o755); err != nil {
+		return err
+	}
+	if err := root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
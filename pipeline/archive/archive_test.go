@@ -0,0 +1,81 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanPaths(t *testing.T) {
+	paths, err := CleanPaths([]string{"./vendor/", ".", "a/../b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vendor", ".", "b"}, paths)
+
+	for _, invalid := range []string{"/etc", "..", "../x", "a/../../x"} {
+		_, err := CleanPaths([]string{invalid})
+		assert.Error(t, err, invalid)
+	}
+
+	_, err = CleanPaths(nil)
+	assert.Error(t, err)
+}
+
+func TestWriteAndExtract(t *testing.T) {
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "dist", "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dist", "app"), []byte("app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "dist", "sub", "skipped"), []byte("skipped"), 0o644))
+	require.NoError(t, os.Symlink("app", filepath.Join(src, "dist", "link")))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "other"), []byte("other"), 0o644))
+
+	srcRoot, err := os.OpenRoot(src)
+	require.NoError(t, err)
+	defer srcRoot.Close()
+
+	var buf bytes.Buffer
+	files, err := Write(&buf, srcRoot, []string{"dist", "missing"}, func(name string) bool {
+		return name == "dist/sub/skipped"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, files)
+
+	dst := t.TempDir()
+	dstRoot, err := os.OpenRoot(dst)
+	require.NoError(t, err)
+	defer dstRoot.Close()
+
+	files, err = Extract(bytes.NewReader(buf.Bytes()), dstRoot, []string{"."})
+	require.NoError(t, err)
+	assert.Equal(t, 1, files)
+
+	content, err := os.ReadFile(filepath.Join(dst, "dist", "app"))
+	require.NoError(t, err)
+	assert.Equal(t, "app", string(content))
+	link, err := os.Readlink(filepath.Join(dst, "dist", "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "app", link)
+	assert.DirExists(t, filepath.Join(dst, "dist", "sub"))
+	assert.NoFileExists(t, filepath.Join(dst, "dist", "sub", "skipped"))
+	assert.NoFileExists(t, filepath.Join(dst, "other"))
+
+	_, err = Extract(bytes.NewReader(buf.Bytes()), dstRoot, []string{"other"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,202 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/archive"
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+)
+
+// RunStep runs the artifact helper of a prepared artifact step with the
+// workspace being the working directory.
+func RunStep(ctx context.Context, client Client, getenv func(string) string, workspace string, out io.Writer) error {
+	if download := getenv(envDownload); download != "" {
+		for workflow := range strings.SplitSeq(download, ",") {
+			if err := Download(ctx, client, workspace, workflow, out); err != nil {
+				return err
+			}
+		}
+	}
+
+	if upload := getenv(envUpload); upload != "" {
+		var artifacts []backend_types.Artifact
+		if err := json.Unmarshal([]byte(upload), &artifacts); err != nil {
+			return fmt.Errorf("invalid artifacts to upload: %w", err)
+		}
+		for _, artifact := range artifacts {
+			if err := Upload(ctx, client, workspace, artifact, out); err != nil {
+				return err
+			}
+		}
+	}
+
+	if reports := getenv(envReports); reports != "" {
+		var stepReports []backend_types.Report
+		if err := json.Unmarshal([]byte(reports), &stepReports); err != nil {
+			return fmt.Errorf("invalid test reports to upload: %w", err)
+		}
+		for _, report := range stepReports {
+			if err := UploadReport(ctx, client, workspace, report, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Upload uploads the paths of an artifact of the workspace.
+func Upload(ctx context.Context, client Client, workspace string, artifact backend_types.Artifact, out io.Writer) error {
+	paths, err := archive.CleanPaths(artifact.Paths)
+	if err != nil {
+		return fmt.Errorf("artifact %s: %w", artifact.Name, err)
+	}
+
+	root, err := os.OpenRoot(workspace)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	// the archive is written to a file first, so empty artifacts are not
+	// uploaded; images without a temp dir get it in the workspace instead
+	tmpDir := os.TempDir()
+	if _, err := os.Stat(tmpDir); err != nil {
+		tmpDir = workspace
+	}
+	tmp, err := os.CreateTemp(tmpDir, ".woodpecker-artifact-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	tmpName, _ := filepath.Rel(workspace, tmp.Name())
+	files, err := archive.Write(tmp, root, paths, func(name string) bool {
+		return name == filepath.ToSlash(tmpName)
+	})
+	if err != nil {
+		return fmt.Errorf("archive artifact %s: %w", artifact.Name, err)
+	}
+	if files == 0 {
+		_, _ = fmt.Fprintf(out, "no files of artifact %s found in %s\n", artifact.Name, strings.Join(paths, ", "))
+		return nil
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := client.Upload(ctx, artifact.Name, tmp); err != nil {
+		return fmt.Errorf("upload artifact %s: %w", artifact.Name, err)
+	}
+
+	_, _ = fmt.Fprintf(out, "uploaded %d files as artifact %s\n", files, artifact.Name)
+	return nil
+}
+
+// Download extracts all artifacts of the workflows with the given name into
+// the workspace.
+func Download(ctx context.Context, client Client, workspace, workflow string, out io.Writer) error {
+	root, err := os.OpenRoot(workspace)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	found := false
+	err = client.Download(ctx, workflow, func(name string, content io.Reader) error {
+		found = true
+		// artifacts may contain any path of the workspace of their workflow
+		files, err := archive.Extract(content, root, []string{"."})
+		if err != nil {
+			return fmt.Errorf("extract artifact %s of workflow %s: %w", name, workflow, err)
+		}
+		_, _ = fmt.Fprintf(out, "downloaded %d files of artifact %s of workflow %s\n", files, name, workflow)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("download artifacts of workflow %s: %w", workflow, err)
+	}
+	if !found {
+		_, _ = fmt.Fprintf(out, "workflow %s has no artifacts\n", workflow)
+	}
+	return nil
+}
+
+// maxReportSize limits the size of all test report files of a step, as they
+// are sent in a single message.
+const maxReportSize = 3 * 1024 * 1024
+
+// UploadReport sends the test report files of a step matching its patterns.
+func UploadReport(ctx context.Context, client Client, workspace string, report backend_types.Report, out io.Writer) error {
+	patterns, err := archive.CleanPaths(report.Paths)
+	if err != nil {
+		return fmt.Errorf("test reports of step %s: %w", report.Step, err)
+	}
+
+	root, err := os.OpenRoot(workspace)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	var names []string
+	for _, pattern := range patterns {
+		matches, err := doublestar.Glob(root.FS(), pattern, doublestar.WithFilesOnly())
+		if err != nil {
+			return fmt.Errorf("test reports of step %s: %w", report.Step, err)
+		}
+		for _, name := range matches {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	var files []ReportFile
+	size := 0
+	for _, name := range names {
+		data, err := root.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if size+len(data) > maxReportSize {
+			_, _ = fmt.Fprintf(out, "skip test report %s of step %s, the reports of a step are limited to %d bytes\n", name, report.Step, maxReportSize)
+			continue
+		}
+		size += len(data)
+		files = append(files, ReportFile{Name: name, Data: data})
+	}
+	if len(files) == 0 {
+		_, _ = fmt.Fprintf(out, "no test reports of step %s found in %s\n", report.Step, strings.Join(patterns, ", "))
+		return nil
+	}
+
+	if err := client.UploadReport(ctx, report.Step, report.Format, files); err != nil {
+		return fmt.Errorf("upload test reports of step %s: %w", report.Step, err)
+	}
+	_, _ = fmt.Fprintf(out, "uploaded %d test reports of step %s\n", len(files), report.Step)
+	return nil
+}
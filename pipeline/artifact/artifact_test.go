@@ -0,0 +1,190 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+)
+
+// memoryClient keeps the uploaded artifacts of the workflow "build".
+type memoryClient struct {
+	names     []string
+	artifacts map[string][]byte
+	reports   map[string][]ReportFile
+}
+
+func (c *memoryClient) Upload(_ context.Context, name string, content io.Reader) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	if c.artifacts == nil {
+		c.artifacts = map[string][]byte{}
+	}
+	c.names = append(c.names, name)
+	c.artifacts[name] = data
+	return nil
+}
+
+func (c *memoryClient) Download(_ context.Context, workflow string, fn func(name string, content io.Reader) error) error {
+	if workflow != "build" {
+		return nil
+	}
+	for _, name := range c.names {
+		if err := fn(name, bytes.NewReader(c.artifacts[name])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *memoryClient) UploadReport(_ context.Context, step, format string, files []ReportFile) error {
+	if c.reports == nil {
+		c.reports = map[string][]ReportFile{}
+	}
+	c.reports[step+"/"+format] = files
+	return nil
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	upload := &backend_types.Step{
+		Name: "upload-artifacts",
+		Type: backend_types.StepTypeArtifacts,
+		Artifacts: &backend_types.StepArtifacts{
+			Upload:  []backend_types.Artifact{{Name: "build", Paths: []string{"dist"}}},
+			Reports: []backend_types.Report{{Step: "test", Format: "junit", Paths: []string{"*.xml"}}},
+		},
+	}
+	download := &backend_types.Step{
+		Name:        "download-artifacts",
+		Type:        backend_types.StepTypeArtifacts,
+		Environment: map[string]string{"CI_REPO": "octocat/hello-world"},
+		Artifacts:   &backend_types.StepArtifacts{Download: []string{"build", "lint"}},
+	}
+	other := &backend_types.Step{Name: "test", Type: backend_types.StepTypeCommands, Image: "golang"}
+	conf := &backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{download}},
+		{Steps: []*backend_types.Step{other}},
+		{Steps: []*backend_types.Step{upload}},
+	}}
+
+	config := &Config{Image: "woodpeckerci/woodpecker-agent", Server: "woodpecker-server:9000", Secure: true}
+	config.Prepare(conf, "token")
+
+	assert.Equal(t, "woodpeckerci/woodpecker-agent", upload.Image)
+	assert.Equal(t, []string{"/bin/woodpecker-agent", "artifacts"}, upload.Entrypoint)
+	assert.Equal(t, map[string]string{
+		"WOODPECKER_ARTIFACTS_SERVER":      "woodpecker-server:9000",
+		"WOODPECKER_ARTIFACTS_TOKEN":       "token",
+		"WOODPECKER_ARTIFACTS_SECURE":      "true",
+		"WOODPECKER_ARTIFACTS_SKIP_VERIFY": "false",
+		"WOODPECKER_ARTIFACTS_UPLOAD":      `[{"name":"build","paths":["dist"]}]`,
+		"WOODPECKER_ARTIFACTS_REPORTS":     `[{"step":"test","format":"junit","paths":["*.xml"]}]`,
+	}, upload.Environment)
+	assert.Equal(t, "build,lint", download.Environment["WOODPECKER_ARTIFACTS_DOWNLOAD"])
+	assert.Equal(t, "octocat/hello-world", download.Environment["CI_REPO"])
+	assert.NotContains(t, download.Environment, "WOODPECKER_ARTIFACTS_UPLOAD")
+	assert.Equal(t, "golang", other.Image)
+	assert.Empty(t, other.Environment)
+}
+
+func TestRunStep(t *testing.T) {
+	client := &memoryClient{}
+
+	source := t.TempDir()
+	writeFiles(t, source, map[string]string{
+		"dist/app":         "binary",
+		"dist/lib/lib.so":  "library",
+		"coverage.out":     "coverage",
+		"src/not-uploaded": "source",
+	})
+	uploadEnv := map[string]string{
+		"WOODPECKER_ARTIFACTS_UPLOAD": `[{"name":"app","paths":["dist"]},{"name":"coverage","paths":["coverage.out"]},{"name":"docs","paths":["docs"]}]`,
+	}
+
+	var out strings.Builder
+	require.NoError(t, RunStep(t.Context(), client, func(key string) string { return uploadEnv[key] }, source, &out))
+	assert.Equal(t, []string{"app", "coverage"}, client.names)
+	assert.Contains(t, out.String(), "uploaded 2 files as artifact app")
+	assert.Contains(t, out.String(), "no files of artifact docs found in docs")
+
+	target := t.TempDir()
+	downloadEnv := map[string]string{"WOODPECKER_ARTIFACTS_DOWNLOAD": "build,lint"}
+
+	out.Reset()
+	require.NoError(t, RunStep(t.Context(), client, func(key string) string { return downloadEnv[key] }, target, &out))
+	assert.Contains(t, out.String(), "downloaded 2 files of artifact app of workflow build")
+	assert.Contains(t, out.String(), "workflow lint has no artifacts")
+
+	content, err := os.ReadFile(filepath.Join(target, "dist", "lib", "lib.so"))
+	require.NoError(t, err)
+	assert.Equal(t, "library", string(content))
+	content, err = os.ReadFile(filepath.Join(target, "coverage.out"))
+	require.NoError(t, err)
+	assert.Equal(t, "coverage", string(content))
+	assert.NoFileExists(t, filepath.Join(target, "src", "not-uploaded"))
+}
+
+func TestRunStepReports(t *testing.T) {
+	client := &memoryClient{}
+
+	workspace := t.TempDir()
+	writeFiles(t, workspace, map[string]string{
+		"reports/unit.xml":          "<testsuite/>",
+		"reports/nested/e2e.xml":    "<testsuites/>",
+		"reports/coverage.out":      "coverage",
+		"reports/other/unit.xml.gz": "gzip",
+	})
+	env := map[string]string{
+		"WOODPECKER_ARTIFACTS_REPORTS": `[{"step":"test","format":"junit","paths":["reports/**/*.xml","reports/unit.xml"]},{"step":"lint","format":"junit","paths":["lint.xml"]}]`,
+	}
+
+	var out strings.Builder
+	require.NoError(t, RunStep(t.Context(), client, func(key string) string { return env[key] }, workspace, &out))
+	assert.Equal(t, map[string][]ReportFile{
+		"test/junit": {
+			{Name: "reports/unit.xml", Data: []byte("<testsuite/>")},
+			{Name: "reports/nested/e2e.xml", Data: []byte("<testsuites/>")},
+		},
+	}, client.reports)
+	assert.Contains(t, out.String(), "uploaded 2 test reports of step test")
+	assert.Contains(t, out.String(), "no test reports of step lint found in lint.xml")
+}
+
+func TestRunStepInvalidPath(t *testing.T) {
+	env := map[string]string{"WOODPECKER_ARTIFACTS_UPLOAD": `[{"name":"app","paths":["../dist"]}]`}
+	err := RunStep(t.Context(), &memoryClient{}, func(key string) string { return env[key] }, t.TempDir(), io.Discard)
+	assert.EqualError(t, err, `artifact app: path "../dist" must be relative to the workspace`)
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifact runs the artifact steps of a workflow. They upload the
+// artifacts declared by the steps of a workflow to the server, and download
+// the ones of the workflows it depends on into its workspace.
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+)
+
+const (
+	// HelperCommand is the hidden command of the agent which runs an
+	// artifact step.
+	HelperCommand = "artifacts"
+	// helperBinary is the agent binary inside of the helper image.
+	helperBinary = "/bin/woodpecker-agent"
+)
+
+// environment variables passing the config to the artifact helper.
+const (
+	EnvServer     = "WOODPECKER_ARTIFACTS_SERVER"
+	EnvToken      = "WOODPECKER_ARTIFACTS_TOKEN"
+	EnvSecure     = "WOODPECKER_ARTIFACTS_SECURE"
+	EnvSkipVerify = "WOODPECKER_ARTIFACTS_SKIP_VERIFY"
+	envUpload     = "WOODPECKER_ARTIFACTS_UPLOAD"
+	envDownload   = "WOODPECKER_ARTIFACTS_DOWNLOAD"
+	envReports    = "WOODPECKER_ARTIFACTS_REPORTS"
+)
+
+// Client transfers artifacts from and to the server.
+type Client interface {
+	// Upload stores the archive of an artifact of the workflow.
+	Upload(ctx context.Context, name string, content io.Reader) error
+	// Download calls fn with the archive of every artifact uploaded by the
+	// workflows with the given name.
+	Download(ctx context.Context, workflow string, fn func(name string, content io.Reader) error) error
+	// UploadReport sends the test report files of a step, they replace the
+	// ones sent for the step before.
+	UploadReport(ctx context.Context, step, format string, files []ReportFile) error
+}
+
+// ReportFile is a test report file of a step.
+type ReportFile struct {
+	// Name is the path of the file relative to the workspace.
+	Name string
+	Data []byte
+}
+
+// Config defines how artifact steps reach the server.
+type Config struct {
+	// Image runs the artifact steps on container backends, it has to
+	// contain the agent binary.
+	Image string
+	// Server is the grpc address of the server as seen from the steps.
+	Server string
+	// Secure and SkipVerify configure the TLS connection to the server.
+	Secure     bool
+	SkipVerify bool
+}
+
+// Prepare turns the artifact steps of a workflow into steps running the
+// artifact helper. The token authenticates them as steps of the workflow.
+func (c *Config) Prepare(conf *backend_types.Config, token string) {
+	for _, stage := range conf.Stages {
+		for _, step := range stage.Steps {
+			if step.Type == backend_types.StepTypeArtifacts && step.Artifacts != nil {
+				c.prepareStep(step, token)
+			}
+		}
+	}
+}
+
+func (c *Config) prepareStep(step *backend_types.Step, token string) {
+	step.Image = c.Image
+	step.Entrypoint = []string{helperBinary, HelperCommand}
+	step.Commands = nil
+
+	if step.Environment == nil {
+		step.Environment = map[string]string{}
+	}
+	env := step.Environment
+	env[EnvServer] = c.Server
+	env[EnvToken] = token
+	env[EnvSecure] = strconv.FormatBool(c.Secure)
+	env[EnvSkipVerify] = strconv.FormatBool(c.SkipVerify)
+	if len(step.Artifacts.Upload) > 0 {
+		upload, _ := json.Marshal(step.Artifacts.Upload)
+		env[envUpload] = string(upload)
+	}
+	if len(step.Artifacts.Download) > 0 {
+		env[envDownload] = strings.Join(step.Artifacts.Download, ",")
+	}
+	if len(step.Artifacts.Reports) > 0 {
+		reports, _ := json.Marshal(step.Artifacts.Reports)
+		env[envReports] = string(reports)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+)
+
+// Step scripts print markers into the log stream, so the commands of a step
+// can be told apart. A start marker is printed before each command, an exit
+// marker with the exit code once the script ends. As the output of the
+// previous command may not end with a newline, markers are matched at the
+// end of a line.
+const commandMarkerPrefix = "::woodpecker::command::"
+
+// CommandMarkerKind is the kind of a command marker.
+type CommandMarkerKind string
+
+const (
+	// CommandMarkerStart is printed before a command, its value is the index
+	// of the command.
+	CommandMarkerStart CommandMarkerKind = "start"
+	// CommandMarkerExit is printed when the script ends, its value is the
+	// exit code of the script.
+	CommandMarkerExit CommandMarkerKind = "exit"
+)
+
+// CommandMarker is a marker printed by a step script.
+type CommandMarker struct {
+	Kind  CommandMarkerKind
+	Value int
+}
+
+var commandMarkerRegex = regexp.MustCompile(regexp.QuoteMeta(commandMarkerPrefix) + `(start|exit)::(-?[0-9]+)\r?\n?$`)
+
+// HasCommandMarkers reports whether the script of a step prints command
+// markers. Only the commands of steps are told apart, not the ones of
+// services, and the output of plugins is never parsed for markers.
+func HasCommandMarkers(step *types.Step) bool {
+	return step.Type == types.StepTypeCommands && len(step.Commands) > 0
+}
+
+// ParseCommandMarker looks for a command marker at the end of a log line. It
+// returns the marker and the output printed before it on the same line.
+func ParseCommandMarker(line string) (marker CommandMarker, output string, ok bool) {
+	// cheap check first, as every line of a log is passed in
+	if !strings.Contains(line, commandMarkerPrefix) {
+		return CommandMarker{}, "", false
+	}
+
+	match := commandMarkerRegex.FindStringSubmatchIndex(line)
+	if match == nil {
+		return CommandMarker{}, "", false
+	}
+	value, err := strconv.Atoi(line[match[4]:match[5]])
+	if err != nil {
+		return CommandMarker{}, "", false
+	}
+
+	marker = CommandMarker{
+		Kind:  CommandMarkerKind(line[match[2]:match[3]]),
+		Value: value,
+	}
+	return marker, line[:match[0]], true
+}
+
+// CommandStartMarkerPosix returns the posix shell code printing the start
+// marker of a command. The marker is built by printf, so tracing the script
+// with `set -x` does not print another marker.
+func CommandStartMarkerPosix(index int) string {
+	return fmt.Sprintf("printf '%s%s::%%d\\n' %d", commandMarkerPrefix, CommandMarkerStart, index)
+}
+
+// CommandExitTrapPosix is the posix shell code printing the exit marker with
+// the exit code of the script when it ends, no matter if it failed or not.
+var CommandExitTrapPosix = fmt.Sprintf(`trap 'printf "%s%s::%%d\n" "$?"' EXIT`, commandMarkerPrefix, CommandMarkerExit)
@@ -0,0 +1,46 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommandMarker(t *testing.T) {
+	tests := []struct {
+		line   string
+		marker CommandMarker
+		output string
+		ok     bool
+	}{
+		{line: "::woodpecker::command::start::3\n", marker: CommandMarker{Kind: CommandMarkerStart, Value: 3}, ok: true},
+		{line: "::woodpecker::command::exit::-1\r\n", marker: CommandMarker{Kind: CommandMarkerExit, Value: -1}, ok: true},
+		{line: "no newline::woodpecker::command::exit::2", marker: CommandMarker{Kind: CommandMarkerExit, Value: 2}, output: "no newline", ok: true},
+		{line: "hello world\n"},
+		{line: "::woodpecker::command::start::1 and more\n"},
+		// set -x prints the printf call itself, which must not count as marker
+		{line: "+ printf '::woodpecker::command::start::%d\\n' 1\n"},
+		{line: "::woodpecker::command::other::1\n"},
+	}
+
+	for _, test := range tests {
+		marker, output, ok := ParseCommandMarker(test.line)
+		assert.Equal(t, test.ok, ok, test.line)
+		assert.Equal(t, test.marker, marker, test.line)
+		assert.Equal(t, test.output, output, test.line)
+	}
+}
@@ -20,11 +20,14 @@ import (
 	"golang.org/x/text/encoding/unicode"
 )
 
-func GenerateContainerConf(commands []string, osType, workDir string) (env map[string]string, entry []string, err error) {
+// GenerateContainerConf returns the environment and entrypoint running the
+// commands of a step in a container. If markers is set, the script prints
+// command markers, see HasCommandMarkers.
+func GenerateContainerConf(commands []string, osType, workDir string, markers bool) (env map[string]string, entry []string, err error) {
 	env = make(map[string]string)
 	if osType == "windows" {
 		encoder := unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM).NewEncoder()
-		utf16leBytes, err := encoder.Bytes([]byte(generateScriptWindows(commands, workDir)))
+		utf16leBytes, err := encoder.Bytes([]byte(generateScriptWindows(commands, workDir, markers)))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -34,7 +37,7 @@ func GenerateContainerConf(commands []string, osType, workDir string) (env map[s
 		// cspell:disable-next-line
 		entry = []string{"powershell", "-noprofile", "-noninteractive", "-encodedcommand", env["CI_SCRIPT"]}
 	} else {
-		env["CI_SCRIPT"] = base64.StdEncoding.EncodeToString([]byte(generateScriptPosix(commands, workDir)))
+		env["CI_SCRIPT"] = base64.StdEncoding.EncodeToString([]byte(generateScriptPosix(commands, workDir, markers)))
 		env["SHELL"] = "/bin/sh"
 		entry = []string{"/bin/sh", "-c", "echo $CI_SCRIPT | base64 -d | /bin/sh -e"}
 	}
@@ -24,7 +24,7 @@ import (
 
 // generateScriptPosix is a helper function that generates a step script
 // for a linux container using the given.
-func generateScriptPosix(commands []string, workDir string) string {
+func generateScriptPosix(commands []string, workDir string, markers bool) string {
 	var buf bytes.Buffer
 
 	if err := setupScriptTmpl.Execute(&buf, map[string]string{
@@ -34,7 +34,14 @@ func generateScriptPosix(commands []string, workDir string) string {
 		return fmt.Sprintf("echo 'failed to generate posix script from commands: %s'; exit 1", err.Error())
 	}
 
-	for _, command := range commands {
+	if markers {
+		buf.WriteString(CommandExitTrapPosix + "\n")
+	}
+
+	for i, command := range commands {
+		if markers {
+			fmt.Fprintf(&buf, "\n%s", CommandStartMarkerPosix(i))
+		}
 		fmt.Fprintf(
 			&buf,
 			traceScript,
@@ -57,6 +64,11 @@ password $CI_NETRC_PASSWORD
 EOF
 chmod 0600 $HOME/.netrc
 fi
+if [ -n "$CI_ID_TOKEN_FILE" ]; then
+mkdir -p "$(dirname "$CI_ID_TOKEN_FILE")"
+printf '%s' "$CI_ID_TOKEN" > "$CI_ID_TOKEN_FILE"
+chmod 0600 "$CI_ID_TOKEN_FILE"
+fi
 unset CI_NETRC_USERNAME
 unset CI_NETRC_PASSWORD
 unset CI_SCRIPT
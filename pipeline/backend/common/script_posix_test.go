@@ -37,27 +37,39 @@ password $CI_NETRC_PASSWORD
 EOF
 chmod 0600 $HOME/.netrc
 fi
+if [ -n "$CI_ID_TOKEN_FILE" ]; then
+mkdir -p "$(dirname "$CI_ID_TOKEN_FILE")"
+printf '%s' "$CI_ID_TOKEN" > "$CI_ID_TOKEN_FILE"
+chmod 0600 "$CI_ID_TOKEN_FILE"
+fi
 unset CI_NETRC_USERNAME
 unset CI_NETRC_PASSWORD
 unset CI_SCRIPT
 mkdir -p "/woodpecker/some"
 cd "/woodpecker/some"
+trap 'printf "::woodpecker::command::exit::%d\n" "$?"' EXIT
 
+printf '::woodpecker::command::start::%d\n' 0
 echo + 'echo ${PATH}'
 echo ${PATH}
 
+printf '::woodpecker::command::start::%d\n' 1
 echo + 'go build'
 go build
 
+printf '::woodpecker::command::start::%d\n' 2
 echo + 'go test'
 go test
 `,
 		},
 	}
 	for _, test := range testdata {
-		script := generateScriptPosix(test.from, "/woodpecker/some")
+		script := generateScriptPosix(test.from, "/woodpecker/some", true)
 		assert.EqualValues(t, test.want, script, "Want encoded script for %s", test.from)
 	}
+
+	// scripts of services print no markers
+	assert.NotContains(t, generateScriptPosix([]string{"go test"}, "/woodpecker/some", false), "::woodpecker::")
 }
 
 func TestSetupScriptProtoParse(t *testing.T) {
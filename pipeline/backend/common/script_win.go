@@ -21,7 +21,7 @@ import (
 	"text/template"
 )
 
-func generateScriptWindows(commands []string, workDir string) string {
+func generateScriptWindows(commands []string, workDir string, markers bool) string {
 	var buf bytes.Buffer
 
 	if err := setupScriptWinTmpl.Execute(&buf, map[string]string{
@@ -31,16 +31,25 @@ func generateScriptWindows(commands []string, workDir string) string {
 		return fmt.Sprintf("echo 'failed to generate posix script from commands: %s'; exit 1", err.Error())
 	}
 
-	for _, command := range commands {
+	for i, command := range commands {
 		escaped := fmt.Sprintf("%q", command)
 		escaped = strings.ReplaceAll(escaped, "$", `\$`)
+		if !markers {
+			fmt.Fprintf(&buf, traceScriptWin, escaped, command)
+			continue
+		}
 		fmt.Fprintf(
 			&buf,
-			traceScriptWin,
+			markedTraceScriptWin,
+			commandMarkerPrefix, CommandMarkerStart, i,
 			escaped,
 			command,
+			commandMarkerPrefix, CommandMarkerExit,
 		)
 	}
+	if markers {
+		fmt.Fprintf(&buf, exitScriptWin, commandMarkerPrefix, CommandMarkerExit)
+	}
 
 	return buf.String()
 }
@@ -57,6 +66,10 @@ $netrc=[string]::Format("{0}\_netrc",$Env:HOME);
 "login $Env:CI_NETRC_USERNAME" >> $netrc;
 "password $Env:CI_NETRC_PASSWORD" >> $netrc;
 };
+if ($Env:CI_ID_TOKEN_FILE) {
+New-Item -Path (Split-Path -Parent $Env:CI_ID_TOKEN_FILE) -ItemType Directory -Force | Out-Null;
+[IO.File]::WriteAllText($Env:CI_ID_TOKEN_FILE, $Env:CI_ID_TOKEN);
+};
 [Environment]::SetEnvironmentVariable("CI_NETRC_PASSWORD",$null);
 [Environment]::SetEnvironmentVariable("CI_SCRIPT",$null);
 cd "{{.WorkDir}}";
@@ -64,9 +77,23 @@ cd "{{.WorkDir}}";
 
 var setupScriptWinTmpl, _ = template.New("").Parse(setupScriptWinProto)
 
-// traceScript is a helper script that is added to the step script
+// traceScriptWin is a helper script that is added to the step script
 // to trace a command.
 const traceScriptWin = `
 Write-Output ('+ %s');
 & %s; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
 `
+
+// markedTraceScriptWin is a helper script that is added to the step script
+// to mark the start of a command and trace it. The exit code of a failing
+// command is marked before exiting.
+const markedTraceScriptWin = `
+Write-Output ('%s%s::%d');
+Write-Output ('+ %s');
+& %s; if ($LASTEXITCODE -ne 0) {Write-Output ('%s%s::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
+`
+
+// exitScriptWin marks the end of a script whose commands all succeeded.
+const exitScriptWin = `
+Write-Output ('%s%s::0');
+`
@@ -40,25 +40,37 @@ $netrc=[string]::Format("{0}\_netrc",$Env:HOME);
 "login $Env:CI_NETRC_USERNAME" >> $netrc;
 "password $Env:CI_NETRC_PASSWORD" >> $netrc;
 };
+if ($Env:CI_ID_TOKEN_FILE) {
+New-Item -Path (Split-Path -Parent $Env:CI_ID_TOKEN_FILE) -ItemType Directory -Force | Out-Null;
+[IO.File]::WriteAllText($Env:CI_ID_TOKEN_FILE, $Env:CI_ID_TOKEN);
+};
 [Environment]::SetEnvironmentVariable("CI_NETRC_PASSWORD",$null);
 [Environment]::SetEnvironmentVariable("CI_SCRIPT",$null);
 cd "/woodpecker/some";
 
+Write-Output ('::woodpecker::command::start::0');
 Write-Output ('+ "echo %PATH%"');
-& echo %PATH%; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
+& echo %PATH%; if ($LASTEXITCODE -ne 0) {Write-Output ('::woodpecker::command::exit::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
 
+Write-Output ('::woodpecker::command::start::1');
 Write-Output ('+ "go build"');
-& go build; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
+& go build; if ($LASTEXITCODE -ne 0) {Write-Output ('::woodpecker::command::exit::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
 
+Write-Output ('::woodpecker::command::start::2');
 Write-Output ('+ "go test"');
-& go test; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
+& go test; if ($LASTEXITCODE -ne 0) {Write-Output ('::woodpecker::command::exit::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
+
+Write-Output ('::woodpecker::command::exit::0');
 `,
 		},
 	}
 	for _, test := range testdata {
-		script := generateScriptWindows(test.from, "/woodpecker/some")
+		script := generateScriptWindows(test.from, "/woodpecker/some", true)
 		assert.EqualValues(t, test.want, script, "Want encoded script for %s", test.from)
 	}
+
+	// scripts of services print no markers
+	assert.NotContains(t, generateScriptWindows([]string{"go test"}, "/woodpecker/some", false), "::woodpecker::")
 }
 
 func TestSetupScriptWinProtoParse(t *testing.T) {
@@ -191,6 +191,7 @@ func TestToConfigSmall(t *testing.T) {
 	conf, err := engine.toConfig(&backend_types.Step{
 		Name:     "test",
 		UUID:     "09238932",
+		Type:     backend_types.StepTypeCommands,
 		Commands: []string{"go test"},
 	}, BackendOptions{})
 
@@ -206,7 +207,7 @@ func TestToConfigSmall(t *testing.T) {
 			"wp_uuid": "09238932",
 		},
 		Env: []string{
-			"CI_SCRIPT=CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCnVuc2V0IENJX05FVFJDX1VTRVJOQU1FCnVuc2V0IENJX05FVFJDX1BBU1NXT1JECnVuc2V0IENJX1NDUklQVApta2RpciAtcCAiIgpjZCAiIgoKZWNobyArICdnbyB0ZXN0JwpnbyB0ZXN0Cg==",
+			"CI_SCRIPT=CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCmlmIFsgLW4gIiRDSV9JRF9UT0tFTl9GSUxFIiBdOyB0aGVuCm1rZGlyIC1wICIkKGRpcm5hbWUgIiRDSV9JRF9UT0tFTl9GSUxFIikiCnByaW50ZiAnJXMnICIkQ0lfSURfVE9LRU4iID4gIiRDSV9JRF9UT0tFTl9GSUxFIgpjaG1vZCAwNjAwICIkQ0lfSURfVE9LRU5fRklMRSIKZmkKdW5zZXQgQ0lfTkVUUkNfVVNFUk5BTUUKdW5zZXQgQ0lfTkVUUkNfUEFTU1dPUkQKdW5zZXQgQ0lfU0NSSVBUCm1rZGlyIC1wICIiCmNkICIiCnRyYXAgJ3ByaW50ZiAiOjp3b29kcGVja2VyOjpjb21tYW5kOjpleGl0OjolZFxuIiAiJD8iJyBFWElUCgpwcmludGYgJzo6d29vZHBlY2tlcjo6Y29tbWFuZDo6c3RhcnQ6OiVkXG4nIDAKZWNobyArICdnbyB0ZXN0JwpnbyB0ZXN0Cg==",
 			"SHELL=/bin/sh",
 		},
 	}, conf)
@@ -268,7 +269,7 @@ func TestToConfigFull(t *testing.T) {
 			"wp_uuid": "09238932",
 		},
 		Env: []string{
-			"CI_SCRIPT=CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCnVuc2V0IENJX05FVFJDX1VTRVJOQU1FCnVuc2V0IENJX05FVFJDX1BBU1NXT1JECnVuc2V0IENJX1NDUklQVApta2RpciAtcCAiL3NyYy9hYmMiCmNkICIvc3JjL2FiYyIKCmVjaG8gKyAnZ28gdGVzdCcKZ28gdGVzdAoKZWNobyArICdnbyB2ZXQgLi8uLi4nCmdvIHZldCAuLy4uLgo=",
+			"CI_SCRIPT=CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCmlmIFsgLW4gIiRDSV9JRF9UT0tFTl9GSUxFIiBdOyB0aGVuCm1rZGlyIC1wICIkKGRpcm5hbWUgIiRDSV9JRF9UT0tFTl9GSUxFIikiCnByaW50ZiAnJXMnICIkQ0lfSURfVE9LRU4iID4gIiRDSV9JRF9UT0tFTl9GSUxFIgpjaG1vZCAwNjAwICIkQ0lfSURfVE9LRU5fRklMRSIKZmkKdW5zZXQgQ0lfTkVUUkNfVVNFUk5BTUUKdW5zZXQgQ0lfTkVUUkNfUEFTU1dPUkQKdW5zZXQgQ0lfU0NSSVBUCm1rZGlyIC1wICIvc3JjL2FiYyIKY2QgIi9zcmMvYWJjIgp0cmFwICdwcmludGYgIjo6d29vZHBlY2tlcjo6Y29tbWFuZDo6ZXhpdDo6JWRcbiIgIiQ/IicgRVhJVAoKcHJpbnRmICc6Ondvb2RwZWNrZXI6OmNvbW1hbmQ6OnN0YXJ0OjolZFxuJyAwCmVjaG8gKyAnZ28gdGVzdCcKZ28gdGVzdAoKcHJpbnRmICc6Ondvb2RwZWNrZXI6OmNvbW1hbmQ6OnN0YXJ0OjolZFxuJyAxCmVjaG8gKyAnZ28gdmV0IC4vLi4uJwpnbyB2ZXQgLi8uLi4K",
 			"SHELL=/bin/sh",
 			"TAGS=sqlite",
 		},
@@ -279,7 +280,7 @@ func TestToConfigFull(t *testing.T) {
 }
 
 // windowsCIScriptBase64 is the UTF-16LE encoded script, base64 encoded for powershell's -encodedcommand.
-const windowsCIScriptBase64 = "CgAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFACAAPQAgADAACgAkAEUAcgByAG8AcgBBAGMAdABpAG8AbgBQAHIAZQBmAGUAcgBlAG4AYwBlACAAPQAgACcAUwB0AG8AcAAnADsACgBpAGYAIAAoAC0AbgBvAHQAIAAoAFQAZQBzAHQALQBQAGEAdABoACAAIgBDADoALwBzAHIAYwAvAGEAYgBjACIAKQApACAAewAgAE4AZQB3AC0ASQB0AGUAbQAgAC0AUABhAHQAaAAgACIAQwA6AC8AcwByAGMALwBhAGIAYwAiACAALQBJAHQAZQBtAFQAeQBwAGUAIABEAGkAcgBlAGMAdABvAHIAeQAgAC0ARgBvAHIAYwBlACAAfQA7AAoAaQBmACAAKAAtAG4AbwB0ACAAWwBFAG4AdgBpAHIAbwBuAG0AZQBuAHQAXQA6ADoARwBlAHQARQBuAHYAaQByAG8AbgBtAGUAbgB0AFYAYQByAGkAYQBiAGwAZQAoACcASABPAE0ARQAnACkAKQAgAHsAIABbAEUAbgB2AGkAcgBvAG4AbQBlAG4AdABdADoAOgBTAGUAdABFAG4AdgBpAHIAbwBuAG0AZQBuAHQAVgBhAHIAaQBhAGIAbABlACgAJwBIAE8ATQBFACcALAAgACcAYwA6AFwAcgBvAG8AdAAnACkAIAB9ADsACgBpAGYAIAAoAC0AbgBvAHQAIAAoAFQAZQBzAHQALQBQAGEAdABoACAAIgAkAGUAbgB2ADoASABPAE0ARQAiACkAKQAgAHsAIABOAGUAdwAtAEkAdABlAG0AIAAtAFAAYQB0AGgAIAAiACQAZQBuAHYAOgBIAE8ATQBFACIAIAAtAEkAdABlAG0AVAB5AHAAZQAgAEQAaQByAGUAYwB0AG8AcgB5ACAALQBGAG8AcgBjAGUAIAB9ADsACgBpAGYAIAAoACQARQBuAHYAOgBDAEkAXwBOAEUAVABSAEMAXwBNAEEAQwBIAEkATgBFACkAIAB7AAoAJABuAGUAdAByAGMAPQBbAHMAdAByAGkAbgBnAF0AOgA6AEYAbwByAG0AYQB0ACgAIgB7ADAAfQBcAF8AbgBlAHQAcgBjACIALAAkAEUAbgB2ADoASABPAE0ARQApADsACgAiAG0AYQBjAGgAaQBuAGUAIAAkAEUAbgB2ADoAQwBJAF8ATgBFAFQAUgBDAF8ATQBBAEMASABJAE4ARQAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKACIAbABvAGcAaQBuACAAJABFAG4AdgA6AEMASQBfAE4ARQBUAFIAQwBfAFUAUwBFAFIATgBBAE0ARQAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKACIAcABhAHMAcwB3AG8AcgBkACAAJABFAG4AdgA6AEMASQBfAE4ARQBUAFIAQwBfAFAAQQBTAFMAVwBPAFIARAAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKAH0AOwAKAFsARQBuAHYAaQByAG8AbgBtAGUAbgB0AF0AOgA6AFMAZQB0AEUAbgB2AGkAcgBvAG4AbQBlAG4AdABWAGEAcgBpAGEAYgBsAGUAKAAiAEMASQBfAE4ARQBUAFIAQwBfAFAAQQBTAFMAVwBPAFIARAAiACwAJABuAHUAbABsACkAOwAKAFsARQBuAHYAaQByAG8AbgBtAGUAbgB0AF0AOgA6AFMAZQB0AEUAbgB2AGkAcgBvAG4AbQBlAG4AdABWAGEAcgBpAGEAYgBsAGUAKAAiAEMASQBfAFMAQwBSAEkAUABUACIALAAkAG4AdQBsAGwAKQA7AAoAYwBkACAAIgBDADoALwBzAHIAYwAvAGEAYgBjACIAOwAKAAoAVwByAGkAdABlAC0ATwB1AHQAcAB1AHQAIAAoACcAKwAgACIAZwBvACAAdABlAHMAdAAiACcAKQA7AAoAJgAgAGcAbwAgAHQAZQBzAHQAOwAgAGkAZgAgACgAJABMAEEAUwBUAEUAWABJAFQAQwBPAEQARQAgAC0AbgBlACAAMAApACAAewBlAHgAaQB0ACAAJABMAEEAUwBUAEUAWABJAFQAQwBPAEQARQB9AAoACgBXAHIAaQB0AGUALQBPAHUAdABwAHUAdAAgACgAJwArACAAIgBnAG8AIAB2AGUAdAAgAC4ALwAuAC4ALgAiACcAKQA7AAoAJgAgAGcAbwAgAHYAZQB0ACAALgAvAC4ALgAuADsAIABpAGYAIAAoACQATABBAFMAVABFAFgASQBUAEMATwBEAEUAIAAtAG4AZQAgADAAKQAgAHsAZQB4AGkAdAAgACQATABBAFMAVABFAFgASQBUAEMATwBEAEUAfQAKAA=="
+const windowsCIScriptBase64 = "CgAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFACAAPQAgADAACgAkAEUAcgByAG8AcgBBAGMAdABpAG8AbgBQAHIAZQBmAGUAcgBlAG4AYwBlACAAPQAgACcAUwB0AG8AcAAnADsACgBpAGYAIAAoAC0AbgBvAHQAIAAoAFQAZQBzAHQALQBQAGEAdABoACAAIgBDADoALwBzAHIAYwAvAGEAYgBjACIAKQApACAAewAgAE4AZQB3AC0ASQB0AGUAbQAgAC0AUABhAHQAaAAgACIAQwA6AC8AcwByAGMALwBhAGIAYwAiACAALQBJAHQAZQBtAFQAeQBwAGUAIABEAGkAcgBlAGMAdABvAHIAeQAgAC0ARgBvAHIAYwBlACAAfQA7AAoAaQBmACAAKAAtAG4AbwB0ACAAWwBFAG4AdgBpAHIAbwBuAG0AZQBuAHQAXQA6ADoARwBlAHQARQBuAHYAaQByAG8AbgBtAGUAbgB0AFYAYQByAGkAYQBiAGwAZQAoACcASABPAE0ARQAnACkAKQAgAHsAIABbAEUAbgB2AGkAcgBvAG4AbQBlAG4AdABdADoAOgBTAGUAdABFAG4AdgBpAHIAbwBuAG0AZQBuAHQAVgBhAHIAaQBhAGIAbABlACgAJwBIAE8ATQBFACcALAAgACcAYwA6AFwAcgBvAG8AdAAnACkAIAB9ADsACgBpAGYAIAAoAC0AbgBvAHQAIAAoAFQAZQBzAHQALQBQAGEAdABoACAAIgAkAGUAbgB2ADoASABPAE0ARQAiACkAKQAgAHsAIABOAGUAdwAtAEkAdABlAG0AIAAtAFAAYQB0AGgAIAAiACQAZQBuAHYAOgBIAE8ATQBFACIAIAAtAEkAdABlAG0AVAB5AHAAZQAgAEQAaQByAGUAYwB0AG8AcgB5ACAALQBGAG8AcgBjAGUAIAB9ADsACgBpAGYAIAAoACQARQBuAHYAOgBDAEkAXwBOAEUAVABSAEMAXwBNAEEAQwBIAEkATgBFACkAIAB7AAoAJABuAGUAdAByAGMAPQBbAHMAdAByAGkAbgBnAF0AOgA6AEYAbwByAG0AYQB0ACgAIgB7ADAAfQBcAF8AbgBlAHQAcgBjACIALAAkAEUAbgB2ADoASABPAE0ARQApADsACgAiAG0AYQBjAGgAaQBuAGUAIAAkAEUAbgB2ADoAQwBJAF8ATgBFAFQAUgBDAF8ATQBBAEMASABJAE4ARQAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKACIAbABvAGcAaQBuACAAJABFAG4AdgA6AEMASQBfAE4ARQBUAFIAQwBfAFUAUwBFAFIATgBBAE0ARQAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKACIAcABhAHMAcwB3AG8AcgBkACAAJABFAG4AdgA6AEMASQBfAE4ARQBUAFIAQwBfAFAAQQBTAFMAVwBPAFIARAAiACAAPgA+ACAAJABuAGUAdAByAGMAOwAKAH0AOwAKAGkAZgAgACgAJABFAG4AdgA6AEMASQBfAEkARABfAFQATwBLAEUATgBfAEYASQBMAEUAKQAgAHsACgBOAGUAdwAtAEkAdABlAG0AIAAtAFAAYQB0AGgAIAAoAFMAcABsAGkAdAAtAFAAYQB0AGgAIAAtAFAAYQByAGUAbgB0ACAAJABFAG4AdgA6AEMASQBfAEkARABfAFQATwBLAEUATgBfAEYASQBMAEUAKQAgAC0ASQB0AGUAbQBUAHkAcABlACAARABpAHIAZQBjAHQAbwByAHkAIAAtAEYAbwByAGMAZQAgAHwAIABPAHUAdAAtAE4AdQBsAGwAOwAKAFsASQBPAC4ARgBpAGwAZQBdADoAOgBXAHIAaQB0AGUAQQBsAGwAVABlAHgAdAAoACQARQBuAHYAOgBDAEkAXwBJAEQAXwBUAE8ASwBFAE4AXwBGAEkATABFACwAIAAkAEUAbgB2ADoAQwBJAF8ASQBEAF8AVABPAEsARQBOACkAOwAKAH0AOwAKAFsARQBuAHYAaQByAG8AbgBtAGUAbgB0AF0AOgA6AFMAZQB0AEUAbgB2AGkAcgBvAG4AbQBlAG4AdABWAGEAcgBpAGEAYgBsAGUAKAAiAEMASQBfAE4ARQBUAFIAQwBfAFAAQQBTAFMAVwBPAFIARAAiACwAJABuAHUAbABsACkAOwAKAFsARQBuAHYAaQByAG8AbgBtAGUAbgB0AF0AOgA6AFMAZQB0AEUAbgB2AGkAcgBvAG4AbQBlAG4AdABWAGEAcgBpAGEAYgBsAGUAKAAiAEMASQBfAFMAQwBSAEkAUABUACIALAAkAG4AdQBsAGwAKQA7AAoAYwBkACAAIgBDADoALwBzAHIAYwAvAGEAYgBjACIAOwAKAAoAVwByAGkAdABlAC0ATwB1AHQAcAB1AHQAIAAoACcAOgA6AHcAbwBvAGQAcABlAGMAawBlAHIAOgA6AGMAbwBtAG0AYQBuAGQAOgA6AHMAdABhAHIAdAA6ADoAMAAnACkAOwAKAFcAcgBpAHQAZQAtAE8AdQB0AHAAdQB0ACAAKAAnACsAIAAiAGcAbwAgAHQAZQBzAHQAIgAnACkAOwAKACYAIABnAG8AIAB0AGUAcwB0ADsAIABpAGYAIAAoACQATABBAFMAVABFAFgASQBUAEMATwBEAEUAIAAtAG4AZQAgADAAKQAgAHsAVwByAGkAdABlAC0ATwB1AHQAcAB1AHQAIAAoACcAOgA6AHcAbwBvAGQAcABlAGMAawBlAHIAOgA6AGMAbwBtAG0AYQBuAGQAOgA6AGUAeABpAHQAOgA6AHsAMAB9ACcAIAAtAGYAIAAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFACkAOwAgAGUAeABpAHQAIAAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFAH0ACgAKAFcAcgBpAHQAZQAtAE8AdQB0AHAAdQB0ACAAKAAnADoAOgB3AG8AbwBkAHAAZQBjAGsAZQByADoAOgBjAG8AbQBtAGEAbgBkADoAOgBzAHQAYQByAHQAOgA6ADEAJwApADsACgBXAHIAaQB0AGUALQBPAHUAdABwAHUAdAAgACgAJwArACAAIgBnAG8AIAB2AGUAdAAgAC4ALwAuAC4ALgAiACcAKQA7AAoAJgAgAGcAbwAgAHYAZQB0ACAALgAvAC4ALgAuADsAIABpAGYAIAAoACQATABBAFMAVABFAFgASQBUAEMATwBEAEUAIAAtAG4AZQAgADAAKQAgAHsAVwByAGkAdABlAC0ATwB1AHQAcAB1AHQAIAAoACcAOgA6AHcAbwBvAGQAcABlAGMAawBlAHIAOgA6AGMAbwBtAG0AYQBuAGQAOgA6AGUAeABpAHQAOgA6AHsAMAB9ACcAIAAtAGYAIAAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFACkAOwAgAGUAeABpAHQAIAAkAEwAQQBTAFQARQBYAEkAVABDAE8ARABFAH0ACgAKAFcAcgBpAHQAZQAtAE8AdQB0AHAAdQB0ACAAKAAnADoAOgB3AG8AbwBkAHAAZQBjAGsAZQByADoAOgBjAG8AbQBtAGEAbgBkADoAOgBlAHgAaQB0ADoAOgAwACcAKQA7AAoA"
 
 func TestToWindowsConfig(t *testing.T) {
 	engine := docker{
@@ -353,15 +354,23 @@ $netrc=[string]::Format("{0}\_netrc",$Env:HOME);
 "login $Env:CI_NETRC_USERNAME" >> $netrc;
 "password $Env:CI_NETRC_PASSWORD" >> $netrc;
 };
+if ($Env:CI_ID_TOKEN_FILE) {
+New-Item -Path (Split-Path -Parent $Env:CI_ID_TOKEN_FILE) -ItemType Directory -Force | Out-Null;
+[IO.File]::WriteAllText($Env:CI_ID_TOKEN_FILE, $Env:CI_ID_TOKEN);
+};
 [Environment]::SetEnvironmentVariable("CI_NETRC_PASSWORD",$null);
 [Environment]::SetEnvironmentVariable("CI_SCRIPT",$null);
 cd "C:/src/abc";
 
+Write-Output ('::woodpecker::command::start::0');
 Write-Output ('+ "go test"');
-& go test; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
+& go test; if ($LASTEXITCODE -ne 0) {Write-Output ('::woodpecker::command::exit::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
 
+Write-Output ('::woodpecker::command::start::1');
 Write-Output ('+ "go vet ./..."');
-& go vet ./...; if ($LASTEXITCODE -ne 0) {exit $LASTEXITCODE}
+& go vet ./...; if ($LASTEXITCODE -ne 0) {Write-Output ('::woodpecker::command::exit::{0}' -f $LASTEXITCODE); exit $LASTEXITCODE}
+
+Write-Output ('::woodpecker::command::exit::0');
 `, string(ciScript))
 	}
 }
@@ -264,7 +264,7 @@ func TestTinyPod(t *testing.T) {
 						},
 						{
 							"name": "CI_SCRIPT",
-							"value": "CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCnVuc2V0IENJX05FVFJDX1VTRVJOQU1FCnVuc2V0IENJX05FVFJDX1BBU1NXT1JECnVuc2V0IENJX1NDUklQVApta2RpciAtcCAiL3dvb2RwZWNrZXIvc3JjIgpjZCAiL3dvb2RwZWNrZXIvc3JjIgoKZWNobyArICdncmFkbGUgYnVpbGQnCmdyYWRsZSBidWlsZAo="
+							"value": "CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCmlmIFsgLW4gIiRDSV9JRF9UT0tFTl9GSUxFIiBdOyB0aGVuCm1rZGlyIC1wICIkKGRpcm5hbWUgIiRDSV9JRF9UT0tFTl9GSUxFIikiCnByaW50ZiAnJXMnICIkQ0lfSURfVE9LRU4iID4gIiRDSV9JRF9UT0tFTl9GSUxFIgpjaG1vZCAwNjAwICIkQ0lfSURfVE9LRU5fRklMRSIKZmkKdW5zZXQgQ0lfTkVUUkNfVVNFUk5BTUUKdW5zZXQgQ0lfTkVUUkNfUEFTU1dPUkQKdW5zZXQgQ0lfU0NSSVBUCm1rZGlyIC1wICIvd29vZHBlY2tlci9zcmMiCmNkICIvd29vZHBlY2tlci9zcmMiCnRyYXAgJ3ByaW50ZiAiOjp3b29kcGVja2VyOjpjb21tYW5kOjpleGl0OjolZFxuIiAiJD8iJyBFWElUCgpwcmludGYgJzo6d29vZHBlY2tlcjo6Y29tbWFuZDo6c3RhcnQ6OiVkXG4nIDAKZWNobyArICdncmFkbGUgYnVpbGQnCmdyYWRsZSBidWlsZAo="
 						}
 					],
 					"resources": {},
@@ -293,6 +293,7 @@ func TestTinyPod(t *testing.T) {
 		WorkingDir:  "/woodpecker/src",
 		Pull:        false,
 		Privileged:  false,
+		Type:        types.StepTypeCommands,
 		Commands:    []string{"gradle build"},
 		Volumes:     []string{"workspace:/woodpecker/src"},
 		Environment: map[string]string{"CI": "woodpecker"},
@@ -402,7 +403,7 @@ func TestFullPod(t *testing.T) {
 						},
 						{
 							"name": "CI_SCRIPT",
-							"value": "CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCnVuc2V0IENJX05FVFJDX1VTRVJOQU1FCnVuc2V0IENJX05FVFJDX1BBU1NXT1JECnVuc2V0IENJX1NDUklQVApta2RpciAtcCAiL3dvb2RwZWNrZXIvc3JjIgpjZCAiL3dvb2RwZWNrZXIvc3JjIgoKZWNobyArICdnbyBnZXQnCmdvIGdldAoKZWNobyArICdnbyB0ZXN0JwpnbyB0ZXN0Cg=="
+							"value": "CmlmIFsgLW4gIiRDSV9ORVRSQ19NQUNISU5FIiBdOyB0aGVuCmNhdCA8PEVPRiA+ICRIT01FLy5uZXRyYwptYWNoaW5lICRDSV9ORVRSQ19NQUNISU5FCmxvZ2luICRDSV9ORVRSQ19VU0VSTkFNRQpwYXNzd29yZCAkQ0lfTkVUUkNfUEFTU1dPUkQKRU9GCmNobW9kIDA2MDAgJEhPTUUvLm5ldHJjCmZpCmlmIFsgLW4gIiRDSV9JRF9UT0tFTl9GSUxFIiBdOyB0aGVuCm1rZGlyIC1wICIkKGRpcm5hbWUgIiRDSV9JRF9UT0tFTl9GSUxFIikiCnByaW50ZiAnJXMnICIkQ0lfSURfVE9LRU4iID4gIiRDSV9JRF9UT0tFTl9GSUxFIgpjaG1vZCAwNjAwICIkQ0lfSURfVE9LRU5fRklMRSIKZmkKdW5zZXQgQ0lfTkVUUkNfVVNFUk5BTUUKdW5zZXQgQ0lfTkVUUkNfUEFTU1dPUkQKdW5zZXQgQ0lfU0NSSVBUCm1rZGlyIC1wICIvd29vZHBlY2tlci9zcmMiCmNkICIvd29vZHBlY2tlci9zcmMiCnRyYXAgJ3ByaW50ZiAiOjp3b29kcGVja2VyOjpjb21tYW5kOjpleGl0OjolZFxuIiAiJD8iJyBFWElUCgpwcmludGYgJzo6d29vZHBlY2tlcjo6Y29tbWFuZDo6c3RhcnQ6OiVkXG4nIDAKZWNobyArICdnbyBnZXQnCmdvIGdldAoKcHJpbnRmICc6Ondvb2RwZWNrZXI6OmNvbW1hbmQ6OnN0YXJ0OjolZFxuJyAxCmVjaG8gKyAnZ28gdGVzdCcKZ28gdGVzdAo="
 						},
 						{
 							"name": "SHELL",
@@ -535,6 +536,7 @@ func TestFullPod(t *testing.T) {
 		WorkingDir:  "/woodpecker/src",
 		Pull:        true,
 		Privileged:  true,
+		Type:        types.StepTypeCommands,
 		Commands:    []string{"go get", "go test"},
 		Entrypoint:  []string{"/bin/sh", "-c"},
 		Volumes:     []string{"woodpecker-cache:/woodpecker/src/cache"},
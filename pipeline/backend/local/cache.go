@@ -0,0 +1,74 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
+)
+
+// execCache runs the cache helper of the agent binary for a cache step.
+func (e *local) execCache(ctx context.Context, step *types.Step, state *workflowState, env []string) error {
+	// volumes are not supported, so the helper works on the cache directory
+	// itself instead of the mount
+	if dir := e.cacheDir(step); dir != "" {
+		env = append(env, cache.EnvDir+"="+dir)
+	}
+
+	return e.execHelper(ctx, step, state, env, cache.HelperCommand)
+}
+
+// execHelper runs a hidden helper command of the agent binary in the
+// workspace.
+func (e *local) execHelper(ctx context.Context, step *types.Step, state *workflowState, env []string, command string) error {
+	binary, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := newCmd(ctx, binary, command)
+	cmd.Env = env
+	cmd.Dir = state.workspaceDir
+
+	reader, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	// Get output and redirect Stderr to Stdout
+	cmd.Stderr = cmd.Stdout
+
+	// Save state
+	state.stepState.Store(step.UUID, &stepState{
+		cmd:    cmd,
+		output: reader,
+	})
+
+	return cmd.Start()
+}
+
+// cacheDir returns the directory caches are stored in, relative ones are
+// placed in the temp dir to be shared between workflows.
+func (e *local) cacheDir(step *types.Step) string {
+	dir := cache.HostDir(step)
+	if dir == "" || filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(e.tempDir, dir)
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package local
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+)
+
+func TestCacheDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	e := local{tempDir: tmpDir}
+
+	assert.Equal(t, filepath.Join(tmpDir, "woodpecker-cache"), e.cacheDir(&types.Step{Volumes: []string{"woodpecker-cache:/woodpecker-cache"}}))
+	assert.Equal(t, "/var/cache/woodpecker", e.cacheDir(&types.Step{Volumes: []string{"/var/cache/woodpecker:/woodpecker-cache"}}))
+	assert.Empty(t, e.cacheDir(&types.Step{}))
+}
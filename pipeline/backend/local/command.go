@@ -29,6 +29,7 @@ import (
 	"golang.org/x/text/encoding/unicode"
 	"golang.org/x/text/transform"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/common"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 )
 
@@ -99,7 +100,12 @@ func (e *local) genCmdByShell(shell string, cmdList []string, baseDir string) (a
 		fallthrough
 		// normal posix shells
 	case "sh", "bash", "zsh":
-		return []string{"-e", "-c", script}, nil
+		// posix shells mark the commands, so their output can be grouped
+		script := common.CommandExitTrapPosix + "\n"
+		for i, cmd := range cmdList {
+			script += fmt.Sprintf("%s\necho %s\n%s\n", common.CommandStartMarkerPosix(i), strings.TrimSpace(shellescape.Quote("+ "+cmd)), cmd)
+		}
+		return []string{"-e", "-c", strings.TrimSpace(script)}, nil
 	case "":
 		return nil, ErrNoShellSet
 	case "cmd":
@@ -87,8 +87,13 @@ echo test`, args[3])
 		assert.Len(t, args, 3)
 		assert.Equal(t, "-e", args[0])
 		assert.Equal(t, "-c", args[1])
-		assert.Contains(t, args[2], "echo hello")
-		assert.Contains(t, args[2], "pwd")
+		assert.EqualValues(t, `trap 'printf "::woodpecker::command::exit::%d\n" "$?"' EXIT
+printf '::woodpecker::command::start::%d\n' 0
+echo '+ echo hello'
+echo hello
+printf '::woodpecker::command::start::%d\n' 1
+echo '+ pwd'
+pwd`, args[2])
 
 		args, err = e.genCmdByShell("bash", []string{"ls -la"}, t.TempDir())
 		require.NoError(t, err)
@@ -29,6 +29,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/urfave/cli/v3"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/artifact"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 )
 
@@ -170,6 +171,10 @@ func (e *local) StartStep(ctx context.Context, step *types.Step, taskUUID string
 		return e.execCommands(ctx, step, state, env)
 	case types.StepTypePlugin:
 		return e.execPlugin(ctx, step, state, env)
+	case types.StepTypeCache:
+		return e.execCache(ctx, step, state, env)
+	case types.StepTypeArtifacts:
+		return e.execHelper(ctx, step, state, env, artifact.HelperCommand)
 	default:
 		return ErrUnsupportedStepType
 	}
@@ -253,8 +253,10 @@ func TestRunStep(t *testing.T) {
 			require.Truef(t, len(outputLines) > 3, "output of lines must be bigger than 3 at least but we got: %#v", outputLines)
 			// we first test output without environments
 			wantBeforeEnvs := []string{
+				"::woodpecker::command::start::0",
 				"+ echo hello",
 				"hello",
+				"::woodpecker::command::start::1",
 				"+ env",
 			}
 			gotBeforeEnvs := outputLines[:len(wantBeforeEnvs)]
@@ -263,6 +265,8 @@ func TestRunStep(t *testing.T) {
 			gotEnvs := slices.DeleteFunc(outputLines[len(wantBeforeEnvs):], func(s string) bool {
 				return strings.HasPrefix(s, "_=") || strings.HasPrefix(s, "SHLVL=")
 			})
+			assert.Equal(t, "::woodpecker::command::exit::0", gotEnvs[len(gotEnvs)-1])
+			gotEnvs = gotEnvs[:len(gotEnvs)-1]
 			assert.ElementsMatch(t, []string{
 				"PWD=" + state.baseDir + "/workspace",
 				"USERPROFILE=" + state.baseDir + "/home",
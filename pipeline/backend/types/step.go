@@ -14,6 +14,8 @@
 
 package types
 
+import "time"
+
 // Step defines a container process.
 type Step struct {
 	Name           string            `json:"name"`
@@ -40,6 +42,11 @@ type Step struct {
 	OnFailure      bool              `json:"on_failure,omitempty"`
 	OnSuccess      bool              `json:"on_success,omitempty"`
 	Failure        string            `json:"failure,omitempty"`
+	Timeout        time.Duration     `json:"timeout,omitempty"`
+	Retry          *StepRetry        `json:"retry,omitempty"`
+	Cache          *StepCache        `json:"cache,omitempty"`
+	Artifacts      *StepArtifacts    `json:"artifacts,omitempty"`
+	IDToken        *StepIDToken      `json:"id_token,omitempty"`
 	AuthConfig     Auth              `json:"auth_config"`
 	NetworkMode    string            `json:"network_mode,omitempty"`
 	Ports          []Port            `json:"ports,omitempty"`
@@ -47,13 +54,76 @@ type Step struct {
 	WorkflowLabels map[string]string `json:"workflow_labels,omitempty"`
 }
 
+// StepRetry defines if a step is run again after it failed.
+type StepRetry struct {
+	Count       int           `json:"count"`
+	Delay       time.Duration `json:"delay,omitempty"`
+	OnExitCodes []int         `json:"on_exit_codes,omitempty"`
+}
+
+// StepCache defines what a step of type cache restores or saves.
+type StepCache struct {
+	Action      CacheAction `json:"action"`
+	Key         string      `json:"key"`
+	RestoreKeys []string    `json:"restore_keys,omitempty"`
+	Paths       []string    `json:"paths"`
+	// Scope is where the caches are stored, it separates the caches of
+	// different repositories and pull requests.
+	Scope string `json:"scope,omitempty"`
+	// RestoreScopes are read from if there is no cache in the scope, but
+	// never written to.
+	RestoreScopes []string `json:"restore_scopes,omitempty"`
+}
+
+// StepIDToken defines the identity token the server issues for a step.
+type StepIDToken struct {
+	Audience string `json:"audience"`
+}
+
+// CacheAction is the action of a cache step.
+type CacheAction string
+
+const (
+	CacheActionRestore CacheAction = "restore"
+	CacheActionSave    CacheAction = "save"
+)
+
+// StepArtifacts defines what a step of type artifacts uploads or downloads.
+type StepArtifacts struct {
+	// Upload are the artifacts uploaded by the steps of the workflow.
+	Upload []Artifact `json:"upload,omitempty"`
+	// Download are the names of the workflows whose artifacts are downloaded.
+	Download []string `json:"download,omitempty"`
+	// Reports are the test reports uploaded by the steps of the workflow.
+	Reports []Report `json:"reports,omitempty"`
+}
+
+// Artifact defines the paths a step declared as artifact.
+type Artifact struct {
+	Name  string   `json:"name"`
+	Paths []string `json:"paths"`
+}
+
+// ReportFormatJUnit is the format of JUnit XML test reports.
+const ReportFormatJUnit = "junit"
+
+// Report defines the test report files a step declared.
+type Report struct {
+	// Step is the name of the step that wrote the reports.
+	Step   string `json:"step"`
+	Format string `json:"format"`
+	// Paths are glob patterns relative to the workspace.
+	Paths []string `json:"paths"`
+}
+
 // StepType identifies the type of step.
 type StepType string
 
 const (
-	StepTypeClone    StepType = "clone"
-	StepTypeService  StepType = "service"
-	StepTypePlugin   StepType = "plugin"
-	StepTypeCommands StepType = "commands"
-	StepTypeCache    StepType = "cache"
+	StepTypeClone     StepType = "clone"
+	StepTypeService   StepType = "service"
+	StepTypePlugin    StepType = "plugin"
+	StepTypeCommands  StepType = "commands"
+	StepTypeCache     StepType = "cache"
+	StepTypeArtifacts StepType = "artifacts"
 )
@@ -0,0 +1,236 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache restores and saves the caches of cache steps. It runs inside
+// of the step, next to the workspace, and stores the caches as compressed
+// archives in a Storage.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/archive"
+)
+
+// Options define what a cache step restores or saves.
+type Options struct {
+	// Key is the template of the cache key.
+	Key string
+	// RestoreKeys are the templates of the key prefixes to fall back to.
+	RestoreKeys []string
+	// Paths are the cached paths relative to the workspace.
+	Paths []string
+	// Scope separates the caches of different repositories.
+	Scope string
+	// RestoreScopes are searched if there is no cache in the scope.
+	RestoreScopes []string
+}
+
+var validScope = regexp.MustCompile(`^[a-zA-Z0-9._/-]*$`)
+
+// scopedKey prefixes a key with the scope.
+func scopedKey(scope, key string) string {
+	if scope == "" {
+		return key
+	}
+	return scope + "/" + key
+}
+
+func checkScope(scope string) error {
+	if !validScope.MatchString(scope) || strings.Contains(scope, "..") {
+		return fmt.Errorf("invalid cache scope %q", scope)
+	}
+	return nil
+}
+
+// Restore extracts the cache of the key into the workspace. If there is none,
+// the most recent cache matching one of the restore keys is restored instead.
+// A missing cache is no error.
+func Restore(ctx context.Context, storage Storage, workspace string, opts Options, out io.Writer) error {
+	paths, err := archive.CleanPaths(opts.Paths)
+	if err != nil {
+		return err
+	}
+	scopes := append([]string{opts.Scope}, opts.RestoreScopes...)
+	for _, scope := range scopes {
+		if err := checkScope(scope); err != nil {
+			return err
+		}
+	}
+
+	root, err := os.OpenRoot(workspace)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	key, err := RenderKey(opts.Key, root.FS())
+	if err != nil {
+		return err
+	}
+	prefixes := make([]string, 0, len(opts.RestoreKeys))
+	for _, restoreKey := range opts.RestoreKeys {
+		prefix, err := RenderKey(restoreKey, root.FS())
+		if err != nil {
+			return err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	var content io.ReadCloser
+	restoredKey := key
+	err = ErrNotFound
+	for _, scope := range scopes {
+		content, restoredKey, err = find(ctx, storage, scope, key, prefixes)
+		if !errors.Is(err, ErrNotFound) {
+			break
+		}
+	}
+	if errors.Is(err, ErrNotFound) {
+		_, _ = fmt.Fprintf(out, "no cache found for key %s\n", key)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("load cache %s: %w", restoredKey, err)
+	}
+	defer content.Close()
+
+	files, err := archive.Extract(content, root, paths)
+	if err != nil {
+		return fmt.Errorf("restore cache %s: %w", restoredKey, err)
+	}
+
+	if restoredKey == key {
+		_, _ = fmt.Fprintf(out, "restored %d files from cache %s\n", files, key)
+	} else {
+		_, _ = fmt.Fprintf(out, "no cache found for key %s, restored %d files from cache %s instead\n", key, files, restoredKey)
+	}
+	return nil
+}
+
+// find returns the cache of the key in a scope, or the most recent one
+// matching one of the prefixes instead.
+func find(ctx context.Context, storage Storage, scope, key string, prefixes []string) (io.ReadCloser, string, error) {
+	content, err := storage.Get(ctx, scopedKey(scope, key))
+	if !errors.Is(err, ErrNotFound) {
+		return content, key, err
+	}
+	for _, prefix := range prefixes {
+		latest, err := storage.Latest(ctx, scopedKey(scope, prefix))
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, prefix, err
+		}
+		content, err := storage.Get(ctx, latest)
+		return content, path.Base(latest), err
+	}
+	return nil, key, ErrNotFound
+}
+
+// Save stores the paths of the workspace as cache of the key, unless there is
+// a cache for the key already.
+func Save(ctx context.Context, storage Storage, workspace string, opts Options, out io.Writer) error {
+	paths, err := archive.CleanPaths(opts.Paths)
+	if err != nil {
+		return err
+	}
+	if err := checkScope(opts.Scope); err != nil {
+		return err
+	}
+
+	root, err := os.OpenRoot(workspace)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	key, err := RenderKey(opts.Key, root.FS())
+	if err != nil {
+		return err
+	}
+	scoped := scopedKey(opts.Scope, key)
+
+	// caches are immutable, the key has to change with its content
+	existing, err := storage.Get(ctx, scoped)
+	if err == nil {
+		_ = existing.Close()
+		_, _ = fmt.Fprintf(out, "cache %s exists already, skip saving it\n", key)
+		return nil
+	}
+	if !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("check cache %s: %w", key, err)
+	}
+
+	// the archive is written to a file first, as its size has to be known to
+	// upload it; images without a temp dir get it in the workspace instead
+	tmpDir := os.TempDir()
+	if _, err := os.Stat(tmpDir); err != nil {
+		tmpDir = workspace
+	}
+	tmp, err := os.CreateTemp(tmpDir, ".woodpecker-cache-*"+archiveExt)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	tmpName, _ := filepath.Rel(workspace, tmp.Name())
+	files, err := archive.Write(tmp, root, paths, func(name string) bool {
+		return name == filepath.ToSlash(tmpName)
+	})
+	if err != nil {
+		return fmt.Errorf("archive cache %s: %w", key, err)
+	}
+	if files == 0 {
+		_, _ = fmt.Fprintf(out, "no files to cache found in %s\n", strings.Join(paths, ", "))
+		return nil
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := storage.Put(ctx, scoped, tmp, size); err != nil {
+		return fmt.Errorf("store cache %s: %w", key, err)
+	}
+
+	_, _ = fmt.Fprintf(out, "saved %d files as cache %s (%s)\n", files, key, formatSize(size))
+	return nil
+}
+
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		name = filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+	}
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	t.Helper()
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+	require.NoError(t, err)
+	return string(content)
+}
+
+func TestSaveAndRestore(t *testing.T) {
+	storage := NewLocalStorage(t.TempDir())
+	opts := Options{
+		Key:   `deps-{{ hashFiles "go.sum" }}`,
+		Paths: []string{"vendor", "missing"},
+		Scope: "octocat/hello-world",
+	}
+
+	source := t.TempDir()
+	writeFiles(t, source, map[string]string{
+		"go.sum":             "v1",
+		"vendor/a/a.go":      "package a",
+		"vendor/b/b.go":      "package b",
+		"not-cached/main.go": "package main",
+	})
+	require.NoError(t, os.Symlink("a/a.go", filepath.Join(source, "vendor", "link.go")))
+
+	var out strings.Builder
+	require.NoError(t, Save(t.Context(), storage, source, opts, &out))
+	assert.Contains(t, out.String(), "saved 2 files as cache deps-")
+
+	out.Reset()
+	require.NoError(t, Save(t.Context(), storage, source, opts, &out))
+	assert.Contains(t, out.String(), "exists already, skip saving it")
+
+	target := t.TempDir()
+	writeFiles(t, target, map[string]string{
+		"go.sum":        "v1",
+		"vendor/b/b.go": "outdated",
+	})
+
+	out.Reset()
+	require.NoError(t, Restore(t.Context(), storage, target, opts, &out))
+	assert.Contains(t, out.String(), "restored 2 files from cache deps-")
+	assert.Equal(t, "package a", readFile(t, target, "vendor/a/a.go"))
+	assert.Equal(t, "package b", readFile(t, target, "vendor/b/b.go"))
+	assert.Equal(t, "package a", readFile(t, target, "vendor/link.go"))
+	assert.NoFileExists(t, filepath.Join(target, "not-cached", "main.go"))
+
+	// other repositories don't share the cache
+	out.Reset()
+	opts.Scope = "octocat/other"
+	require.NoError(t, Restore(t.Context(), storage, target, opts, &out))
+	assert.Contains(t, out.String(), "no cache found for key deps-")
+}
+
+func TestRestoreScopes(t *testing.T) {
+	storage := NewLocalStorage(t.TempDir())
+	pull := Options{
+		Key:           "deps",
+		RestoreKeys:   []string{"de"},
+		Paths:         []string{"deps"},
+		Scope:         "octocat/hello-world/pull/1",
+		RestoreScopes: []string{"octocat/hello-world"},
+	}
+	base := Options{Key: "deps", RestoreKeys: []string{"de"}, Paths: []string{"deps"}, Scope: "octocat/hello-world"}
+
+	source := t.TempDir()
+	writeFiles(t, source, map[string]string{"deps/file": "pull"})
+	require.NoError(t, Save(t.Context(), storage, source, pull, &strings.Builder{}))
+
+	// caches of a pull request are not seen by its base
+	var out strings.Builder
+	require.NoError(t, Restore(t.Context(), storage, t.TempDir(), base, &out))
+	assert.Contains(t, out.String(), "no cache found for key deps")
+
+	writeFiles(t, source, map[string]string{"deps/file": "base"})
+	require.NoError(t, Save(t.Context(), storage, source, base, &strings.Builder{}))
+
+	// pull requests restore their own cache first and the base one else
+	target := t.TempDir()
+	require.NoError(t, Restore(t.Context(), storage, target, pull, &strings.Builder{}))
+	assert.Equal(t, "pull", readFile(t, target, "deps/file"))
+
+	pull.Scope = "octocat/hello-world/pull/2"
+	target = t.TempDir()
+	require.NoError(t, Restore(t.Context(), storage, target, pull, &strings.Builder{}))
+	assert.Equal(t, "base", readFile(t, target, "deps/file"))
+}
+
+func TestRestoreKeys(t *testing.T) {
+	storage := NewLocalStorage(t.TempDir())
+	save := func(content string, modTime time.Time) {
+		t.Helper()
+		workspace := t.TempDir()
+		writeFiles(t, workspace, map[string]string{"lock": content, "deps/file": content})
+		require.NoError(t, Save(t.Context(), storage, workspace, Options{
+			Key:   `deps-{{ hashFiles "lock" }}`,
+			Paths: []string{"deps"},
+		}, &strings.Builder{}))
+
+		key, err := RenderKey(`deps-{{ hashFiles "lock" }}`, os.DirFS(workspace))
+		require.NoError(t, err)
+		archive := storage.(*localStorage).file(key)
+		require.NoError(t, os.Chtimes(archive, modTime, modTime))
+	}
+	save("old", time.Now().Add(-time.Hour))
+	save("new", time.Now().Add(-time.Minute))
+
+	workspace := t.TempDir()
+	writeFiles(t, workspace, map[string]string{"lock": "changed"})
+
+	var out strings.Builder
+	require.NoError(t, Restore(t.Context(), storage, workspace, Options{
+		Key:         `deps-{{ hashFiles "lock" }}`,
+		RestoreKeys: []string{"other-", "deps-"},
+		Paths:       []string{"deps"},
+	}, &out))
+	assert.Contains(t, out.String(), "restored 1 files from cache deps-")
+	assert.Contains(t, out.String(), "instead")
+	assert.Equal(t, "new", readFile(t, workspace, "deps/file"))
+}
+
+func TestRestoreOutsideOfPaths(t *testing.T) {
+	storage := NewLocalStorage(t.TempDir())
+
+	for name, entries := range map[string][]*tar.Header{
+		"file outside": {
+			{Name: "src/main.go", Typeflag: tar.TypeReg, Mode: 0o644},
+		},
+		"path traversal": {
+			{Name: "deps/../../escape", Typeflag: tar.TypeReg, Mode: 0o644},
+		},
+		"symlink escape": {
+			{Name: "deps/link", Typeflag: tar.TypeSymlink, Linkname: "/tmp"},
+			{Name: "deps/link/escape", Typeflag: tar.TypeReg, Mode: 0o644},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var archive bytes.Buffer
+			gz := gzip.NewWriter(&archive)
+			tw := tar.NewWriter(gz)
+			for _, header := range entries {
+				require.NoError(t, tw.WriteHeader(header))
+			}
+			require.NoError(t, tw.Close())
+			require.NoError(t, gz.Close())
+			key := strings.ReplaceAll(name, " ", "-")
+			require.NoError(t, storage.Put(t.Context(), key, &archive, int64(archive.Len())))
+
+			workspace := t.TempDir()
+			err := Restore(t.Context(), storage, workspace, Options{Key: key, Paths: []string{"deps"}}, &strings.Builder{})
+			assert.Error(t, err)
+			assert.NoFileExists(t, filepath.Join(workspace, "src", "main.go"))
+			assert.NoFileExists(t, "/tmp/escape")
+		})
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	assert.Error(t, checkScope("../other"))
+	assert.NoError(t, checkScope("octocat/hello-world"))
+}
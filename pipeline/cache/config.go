@@ -0,0 +1,182 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+)
+
+const (
+	// MountPath is where the cache directory is mounted into cache steps.
+	MountPath = "/woodpecker-cache"
+	// HelperCommand is the hidden command of the agent and cli which runs a
+	// cache step.
+	HelperCommand = "cache"
+	// helperBinary is the agent binary inside of the cache image.
+	helperBinary = "/bin/woodpecker-agent"
+)
+
+// EnvDir is the environment variable with the cache directory of a cache
+// step.
+const EnvDir = "WOODPECKER_CACHE_DIR"
+
+// environment variables passing the config to the cache helper.
+const (
+	envAction        = "WOODPECKER_CACHE_ACTION"
+	envKey           = "WOODPECKER_CACHE_KEY"
+	envRestoreKeys   = "WOODPECKER_CACHE_RESTORE_KEYS"
+	envPaths         = "WOODPECKER_CACHE_PATHS"
+	envScope         = "WOODPECKER_CACHE_SCOPE"
+	envRestoreScopes = "WOODPECKER_CACHE_RESTORE_SCOPES"
+	envS3Endpoint    = "WOODPECKER_CACHE_S3_ENDPOINT"
+	envS3Bucket      = "WOODPECKER_CACHE_S3_BUCKET"
+	envS3Region      = "WOODPECKER_CACHE_S3_REGION"
+	envS3AccessKey   = "WOODPECKER_CACHE_S3_ACCESS_KEY_ID"
+	envS3Secret      = "WOODPECKER_CACHE_S3_SECRET_ACCESS_KEY"
+	envS3PathStyle   = "WOODPECKER_CACHE_S3_PATH_STYLE"
+	envS3Prefix      = "WOODPECKER_CACHE_S3_PREFIX"
+)
+
+// Config defines where an agent stores the caches.
+type Config struct {
+	// Image runs the cache steps on container backends, it has to contain
+	// the agent binary.
+	Image string
+	// Dir is the volume or directory the caches are stored in, unless they
+	// are stored in a S3 bucket.
+	Dir string
+	// S3 is the bucket the caches are stored in if it has a bucket set.
+	S3 s3.Config
+	// S3Prefix is prepended to the keys of all objects in the bucket.
+	S3Prefix string
+}
+
+// Prepare turns the cache steps of a workflow into steps running the cache
+// helper with the storage of the config.
+func (c *Config) Prepare(conf *backend_types.Config) {
+	for _, stage := range conf.Stages {
+		for _, step := range stage.Steps {
+			if step.Type == backend_types.StepTypeCache && step.Cache != nil {
+				c.prepareStep(step)
+			}
+		}
+	}
+}
+
+func (c *Config) prepareStep(step *backend_types.Step) {
+	step.Image = c.Image
+	step.Entrypoint = []string{helperBinary, HelperCommand}
+	step.Commands = nil
+
+	if step.Environment == nil {
+		step.Environment = map[string]string{}
+	}
+	env := step.Environment
+	env[envAction] = string(step.Cache.Action)
+	env[envKey] = step.Cache.Key
+	env[envRestoreKeys] = strings.Join(step.Cache.RestoreKeys, ",")
+	env[envPaths] = strings.Join(step.Cache.Paths, ",")
+	// set last, so the environment of the step can't change the scope
+	env[envScope] = step.Cache.Scope
+	env[envRestoreScopes] = strings.Join(step.Cache.RestoreScopes, ",")
+
+	if c.S3.Bucket != "" {
+		env[envS3Endpoint] = c.S3.Endpoint
+		env[envS3Bucket] = c.S3.Bucket
+		env[envS3Region] = c.S3.Region
+		env[envS3AccessKey] = c.S3.AccessKeyID
+		env[envS3Secret] = c.S3.SecretAccessKey
+		env[envS3PathStyle] = strconv.FormatBool(c.S3.PathStyle)
+		env[envS3Prefix] = c.S3Prefix
+		return
+	}
+
+	env[EnvDir] = MountPath
+	step.Volumes = append(step.Volumes, c.Dir+":"+MountPath)
+}
+
+// HostDir returns the cache directory mounted into a prepared cache step, it
+// is used by backends that run the helper without containers.
+func HostDir(step *backend_types.Step) string {
+	for _, volume := range step.Volumes {
+		if source, ok := strings.CutSuffix(volume, ":"+MountPath); ok {
+			return source
+		}
+	}
+	return ""
+}
+
+// RunStep runs the cache helper of a prepared cache step with the workspace
+// being the working directory.
+func RunStep(ctx context.Context, getenv func(string) string, workspace string, out io.Writer) error {
+	storage, err := storageFromEnv(getenv)
+	if err != nil {
+		return err
+	}
+
+	opts := Options{
+		Key:           getenv(envKey),
+		RestoreKeys:   splitList(getenv(envRestoreKeys)),
+		Paths:         splitList(getenv(envPaths)),
+		Scope:         getenv(envScope),
+		RestoreScopes: splitList(getenv(envRestoreScopes)),
+	}
+
+	switch action := backend_types.CacheAction(getenv(envAction)); action {
+	case backend_types.CacheActionRestore:
+		return Restore(ctx, storage, workspace, opts, out)
+	case backend_types.CacheActionSave:
+		return Save(ctx, storage, workspace, opts, out)
+	default:
+		return fmt.Errorf("unknown cache action %q", action)
+	}
+}
+
+func storageFromEnv(getenv func(string) string) (Storage, error) {
+	if bucket := getenv(envS3Bucket); bucket != "" {
+		pathStyle, _ := strconv.ParseBool(getenv(envS3PathStyle))
+		return NewS3Storage(s3.Config{
+			Endpoint:        getenv(envS3Endpoint),
+			Bucket:          bucket,
+			Region:          getenv(envS3Region),
+			AccessKeyID:     getenv(envS3AccessKey),
+			SecretAccessKey: getenv(envS3Secret),
+			PathStyle:       pathStyle,
+		}, getenv(envS3Prefix))
+	}
+
+	if dir := getenv(EnvDir); dir != "" {
+		return NewLocalStorage(dir), nil
+	}
+	return nil, errors.New("no cache storage configured")
+}
+
+func splitList(s string) []string {
+	var list []string
+	for item := range strings.SplitSeq(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
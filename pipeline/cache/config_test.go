@@ -0,0 +1,109 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+)
+
+func cacheStep(action backend_types.CacheAction) *backend_types.Step {
+	return &backend_types.Step{
+		Name: "cache",
+		Type: backend_types.StepTypeCache,
+		// the environment of the step can't change the scope
+		Environment: map[string]string{"CI_REPO": "octocat/other", "WOODPECKER_CACHE_SCOPE": "octocat/other"},
+		Volumes:     []string{"wp_default:/woodpecker"},
+		Cache: &backend_types.StepCache{
+			Action:      action,
+			Key:         "deps-{{ os }}",
+			RestoreKeys: []string{"deps-"},
+			Paths:       []string{"vendor", ".cache"},
+			Scope:       "octocat/hello-world",
+		},
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	restore := cacheStep(backend_types.CacheActionRestore)
+	other := &backend_types.Step{Name: "build", Type: backend_types.StepTypeCommands, Image: "golang"}
+	conf := &backend_types.Config{Stages: []*backend_types.Stage{{Steps: []*backend_types.Step{restore, other}}}}
+
+	config := &Config{Image: "woodpeckerci/woodpecker-agent", Dir: "woodpecker-cache"}
+	config.Prepare(conf)
+
+	assert.Equal(t, "woodpeckerci/woodpecker-agent", restore.Image)
+	assert.Equal(t, []string{"/bin/woodpecker-agent", "cache"}, restore.Entrypoint)
+	assert.Equal(t, []string{"wp_default:/woodpecker", "woodpecker-cache:/woodpecker-cache"}, restore.Volumes)
+	assert.Equal(t, "woodpecker-cache", HostDir(restore))
+	assert.Equal(t, map[string]string{
+		"CI_REPO":                         "octocat/other",
+		"WOODPECKER_CACHE_ACTION":         "restore",
+		"WOODPECKER_CACHE_KEY":            "deps-{{ os }}",
+		"WOODPECKER_CACHE_RESTORE_KEYS":   "deps-",
+		"WOODPECKER_CACHE_PATHS":          "vendor,.cache",
+		"WOODPECKER_CACHE_SCOPE":          "octocat/hello-world",
+		"WOODPECKER_CACHE_RESTORE_SCOPES": "",
+		"WOODPECKER_CACHE_DIR":            "/woodpecker-cache",
+	}, restore.Environment)
+	assert.Equal(t, "golang", other.Image)
+
+	save := cacheStep(backend_types.CacheActionSave)
+	config = &Config{
+		Image:    "woodpeckerci/woodpecker-agent",
+		S3:       s3.Config{Endpoint: "http://minio:9000", Bucket: "cache", AccessKeyID: "id", SecretAccessKey: "secret", PathStyle: true},
+		S3Prefix: "ci",
+	}
+	config.Prepare(&backend_types.Config{Stages: []*backend_types.Stage{{Steps: []*backend_types.Step{save}}}})
+
+	assert.Equal(t, []string{"wp_default:/woodpecker"}, save.Volumes)
+	assert.Empty(t, HostDir(save))
+	assert.Equal(t, "cache", save.Environment["WOODPECKER_CACHE_S3_BUCKET"])
+	assert.Equal(t, "true", save.Environment["WOODPECKER_CACHE_S3_PATH_STYLE"])
+	assert.NotContains(t, save.Environment, "WOODPECKER_CACHE_DIR")
+}
+
+func TestRunStep(t *testing.T) {
+	cacheDir := t.TempDir()
+	run := func(action backend_types.CacheAction, workspace string) string {
+		t.Helper()
+		step := cacheStep(action)
+		(&Config{Dir: cacheDir}).Prepare(&backend_types.Config{Stages: []*backend_types.Stage{{Steps: []*backend_types.Step{step}}}})
+		// the directory is used as is without the mount of a container
+		step.Environment["WOODPECKER_CACHE_DIR"] = HostDir(step)
+
+		var out strings.Builder
+		require.NoError(t, RunStep(t.Context(), func(name string) string { return step.Environment[name] }, workspace, &out))
+		return out.String()
+	}
+
+	source := t.TempDir()
+	writeFiles(t, source, map[string]string{"vendor/lib.go": "package lib"})
+	assert.Contains(t, run(backend_types.CacheActionSave, source), "saved 1 files")
+
+	target := t.TempDir()
+	assert.Contains(t, run(backend_types.CacheActionRestore, target), "restored 1 files")
+	assert.Equal(t, "package lib", readFile(t, target, "vendor/lib.go"))
+	assert.DirExists(t, cacheDir+"/octocat/hello-world")
+
+	err := RunStep(t.Context(), func(string) string { return "" }, target, &strings.Builder{})
+	assert.EqualError(t, err, "no cache storage configured")
+}
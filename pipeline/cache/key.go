@@ -0,0 +1,126 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+const maxKeyLength = 250
+
+// invalidKeyChars matches the characters replaced in rendered keys, like the
+// slashes of branch names.
+var invalidKeyChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// ValidateKey checks that a key template can be parsed. The files it hashes
+// only exist at runtime, so it can't be rendered before.
+func ValidateKey(key string) error {
+	_, err := parseKey(key, nil)
+	return err
+}
+
+// RenderKey renders a key template against the files of the workspace.
+func RenderKey(key string, workspace fs.FS) (string, error) {
+	tmpl, err := parseKey(key, workspace)
+	if err != nil {
+		return "", err
+	}
+
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, nil); err != nil {
+		return "", fmt.Errorf("render cache key %q: %w", key, err)
+	}
+
+	result := invalidKeyChars.ReplaceAllString(strings.TrimSpace(rendered.String()), "-")
+	if result == "" {
+		return "", fmt.Errorf("cache key %q is empty", key)
+	}
+	if len(result) > maxKeyLength {
+		return "", fmt.Errorf("cache key %q is longer than %d characters", result, maxKeyLength)
+	}
+	return result, nil
+}
+
+func parseKey(key string, workspace fs.FS) (*template.Template, error) {
+	tmpl, err := template.New("key").Option("missingkey=error").Funcs(template.FuncMap{
+		"hashFiles": func(patterns ...string) (string, error) {
+			return hashFiles(workspace, patterns...)
+		},
+		"os":   func() string { return runtime.GOOS },
+		"arch": func() string { return runtime.GOARCH },
+	}).Parse(key)
+	if err != nil {
+		return nil, fmt.Errorf("parse cache key %q: %w", key, err)
+	}
+	return tmpl, nil
+}
+
+// hashFiles returns the sha256 sum of all regular files matching one of the
+// glob patterns, e.g. **/go.sum.
+func hashFiles(workspace fs.FS, patterns ...string) (string, error) {
+	if len(patterns) == 0 {
+		return "", errors.New("hashFiles needs at least one pattern")
+	}
+
+	var files []string
+	for _, pattern := range patterns {
+		matches, err := doublestar.Glob(workspace, pattern, doublestar.WithFilesOnly())
+		if err != nil {
+			return "", fmt.Errorf("hashFiles: invalid pattern %q: %w", pattern, err)
+		}
+		files = append(files, matches...)
+	}
+	if len(files) == 0 {
+		return "", fmt.Errorf("hashFiles: no files match %s", strings.Join(patterns, ", "))
+	}
+	slices.Sort(files)
+	files = slices.Compact(files)
+
+	hash := sha256.New()
+	for _, file := range files {
+		fileHash, err := hashFile(workspace, file)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(fileHash)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashFile(workspace fs.FS, name string) ([]byte, error) {
+	file, err := workspace.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("hashFiles: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("hashFiles: read %s: %w", name, err)
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderKey(t *testing.T) {
+	workspace := fstest.MapFS{
+		"go.sum":          {Data: []byte("a")},
+		"web/pnpm-lock":   {Data: []byte("b")},
+		"web/lib/go.sum":  {Data: []byte("c")},
+		"web/lib/readme":  {Data: []byte("d")},
+		"empty/.keep":     {Data: []byte{}},
+		"docs/index.html": {Data: []byte("e")},
+	}
+
+	key, err := RenderKey(`go-{{ hashFiles "go.sum" }}`, workspace)
+	require.NoError(t, err)
+	// sha256 of the sha256 of "a"
+	assert.Equal(t, "go-bf5d3affb73efd2ec6c36ad3112dd933efed63c4e1cbffcfa88e2759c144f2d8", key)
+
+	all, err := RenderKey(`go-{{ hashFiles "**/go.sum" }}`, workspace)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, all)
+
+	// duplicate matches and the order of patterns don't change the hash
+	twice, err := RenderKey(`go-{{ hashFiles "web/lib/go.sum" "**/go.sum" }}`, workspace)
+	require.NoError(t, err)
+	assert.Equal(t, all, twice)
+
+	platform, err := RenderKey(`deps-{{ os }}-{{ arch }}`, workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "deps-"+runtime.GOOS+"-"+runtime.GOARCH, platform)
+
+	prefix, err := RenderKey("go-", workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "go-", prefix)
+
+	_, err = RenderKey(`go-{{ hashFiles "missing" }}`, workspace)
+	assert.ErrorContains(t, err, "hashFiles: no files match missing")
+
+	// environment variables are substituted before, e.g. a branch name
+	branch, err := RenderKey(`go-feature/cache keys-{{ hashFiles "go.sum" }}`, workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "go-feature-cache-keys-bf5d3affb73efd2ec6c36ad3112dd933efed63c4e1cbffcfa88e2759c144f2d8", branch)
+
+	branchPrefix, err := RenderKey("go-feature/cache keys-", workspace)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(branch, branchPrefix))
+
+	_, err = RenderKey(" ", workspace)
+	assert.ErrorContains(t, err, "is empty")
+
+	_, err = RenderKey("{{ .Secret }}", workspace)
+	assert.Error(t, err)
+}
+
+func TestValidateKey(t *testing.T) {
+	assert.NoError(t, ValidateKey(`go-{{ hashFiles "go.sum" }}`))
+	assert.Error(t, ValidateKey(`go-{{ hashFiles "go.sum" }`))
+	assert.Error(t, ValidateKey(`go-{{ unknown }}`))
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned if there is no cache for a key.
+var ErrNotFound = errors.New("cache not found")
+
+// Storage stores the cache archives.
+type Storage interface {
+	// Get returns the archive of a key or ErrNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put stores the archive of a key.
+	Put(ctx context.Context, key string, archive io.Reader, size int64) error
+	// Latest returns the most recently stored key with the prefix or
+	// ErrNotFound.
+	Latest(ctx context.Context, prefix string) (string, error)
+}
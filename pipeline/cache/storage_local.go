@@ -0,0 +1,116 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// archiveExt is the extension of the stored archives.
+const archiveExt = ".tar.gz"
+
+type localStorage struct {
+	dir string
+}
+
+// NewLocalStorage returns a storage keeping the archives in a directory.
+func NewLocalStorage(dir string) Storage {
+	return &localStorage{dir: dir}
+}
+
+func (s *localStorage) file(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key)+archiveExt)
+}
+
+func (s *localStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.file(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// mark the archive as used, so cleanups can remove the least recently
+	// used archives first
+	now := time.Now()
+	_ = os.Chtimes(file.Name(), now, now)
+	return file, nil
+}
+
+func (s *localStorage) Put(_ context.Context, key string, archive io.Reader, _ int64) error {
+	name := s.file(key)
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so a concurrent restore never reads a
+	// partial archive
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, archive); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+func (s *localStorage) Latest(_ context.Context, prefix string) (string, error) {
+	dir, filePrefix := path.Split(prefix)
+	entries, err := os.ReadDir(filepath.Join(s.dir, filepath.FromSlash(dir)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, prefix)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var latest string
+	var latestTime time.Time
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), archiveExt)
+		if !ok || !entry.Type().IsRegular() || !strings.HasPrefix(name, filePrefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if latest == "" || info.ModTime().After(latestTime) {
+			latest, latestTime = dir+name, info.ModTime()
+		}
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, prefix)
+	}
+	return latest, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+)
+
+type s3Storage struct {
+	client *s3.Client
+	prefix string
+}
+
+// NewS3Storage returns a storage keeping the archives in a S3 bucket. All
+// objects are stored below the prefix.
+func NewS3Storage(config s3.Config, prefix string) (Storage, error) {
+	client, err := s3.New(config)
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &s3Storage{client: client, prefix: prefix}, nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	archive, err := s.client.Get(ctx, s.prefix+key+archiveExt)
+	if errors.Is(err, s3.ErrNotFound) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	return archive, err
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, archive io.Reader, size int64) error {
+	return s.client.Put(ctx, s.prefix+key+archiveExt, archive, size)
+}
+
+func (s *s3Storage) Latest(ctx context.Context, prefix string) (string, error) {
+	objects, err := s.client.List(ctx, s.prefix+prefix)
+	if err != nil {
+		return "", err
+	}
+
+	var latest s3.Object
+	for _, object := range objects {
+		// caches of nested scopes, e.g. the ones of pull requests, don't
+		// match the prefix of their parent scope
+		name := strings.TrimPrefix(object.Key, s.prefix+prefix)
+		if !strings.HasSuffix(object.Key, archiveExt) || strings.Contains(name, "/") {
+			continue
+		}
+		if latest.Key == "" || object.LastModified.After(latest.LastModified) {
+			latest = object
+		}
+	}
+
+	if latest.Key == "" {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, prefix)
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(latest.Key, s.prefix), archiveExt), nil
+}
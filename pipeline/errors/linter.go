@@ -23,6 +23,7 @@ import (
 type LinterErrorData struct {
 	File  string `json:"file"`
 	Field string `json:"field"`
+	Line  int    `json:"line,omitempty"`
 }
 
 type DeprecationErrorData struct {
@@ -35,6 +36,7 @@ type BadHabitErrorData struct {
 	File  string `json:"file"`
 	Field string `json:"field"`
 	Docs  string `json:"docs"`
+	Line  int    `json:"line,omitempty"`
 }
 
 func GetLinterData(e *PipelineError) *LinterErrorData {
@@ -28,6 +28,7 @@ const (
 	PipelineErrorTypeCompiler    PipelineErrorType = "compiler"    // some error with the config semantics
 	PipelineErrorTypeGeneric     PipelineErrorType = "generic"     // some generic error
 	PipelineErrorTypeBadHabit    PipelineErrorType = "bad_habit"   // some bad-habit error
+	PipelineErrorTypeSecretLeak  PipelineErrorType = "secret_leak" // logs of a step seem to contain credentials
 )
 
 type PipelineError struct {
@@ -37,6 +38,11 @@ type PipelineError struct {
 	Data      any               `json:"data"`
 }
 
+type SecretLeakErrorData struct {
+	Step  string `json:"step"`
+	Lines []int  `json:"lines"`
+}
+
 func (e *PipelineError) Error() string {
 	return fmt.Sprintf("[%s] %s", e.Type, e.Message)
 }
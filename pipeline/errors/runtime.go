@@ -17,6 +17,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 var (
@@ -51,11 +52,38 @@ func (e *OomError) Error() string {
 	return fmt.Sprintf("uuid=%s: received oom kill", e.UUID)
 }
 
+// A TimeoutError reports the process ran longer than the step timeout allows.
+type TimeoutError struct {
+	UUID    string
+	Timeout time.Duration
+}
+
+// Error returns the error message in string format.
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("uuid=%s: timed out after %s", e.UUID, e.Timeout)
+}
+
+// A SetupError reports the backend failed to set up the workflow environment.
+type SetupError struct {
+	Err error
+}
+
+// Error returns the error message in string format.
+func (e *SetupError) Error() string {
+	return fmt.Sprintf("could not set up workflow: %v", e.Err)
+}
+
+// Unwrap returns the error of the backend.
+func (e *SetupError) Unwrap() error {
+	return e.Err
+}
+
 // IsStepFailure reports whether err was caused by a step itself terminating
-// unsuccessfully (non-zero exit code or oom kill), as opposed to the runtime
-// or backend failing to execute the workflow.
+// unsuccessfully (non-zero exit code, oom kill or timeout), as opposed to the
+// runtime or backend failing to execute the workflow.
 func IsStepFailure(err error) bool {
 	var exitErr *ExitError
 	var oomErr *OomError
-	return errors.As(err, &exitErr) || errors.As(err, &oomErr)
+	var timeoutErr *TimeoutError
+	return errors.As(err, &exitErr) || errors.As(err, &oomErr) || errors.As(err, &timeoutErr)
 }
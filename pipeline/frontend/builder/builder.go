@@ -74,7 +74,7 @@ func (b *PipelineBuilder) Build() (items []*Item, errorsAndWarnings error) {
 			if len(axes) > 1 {
 				workflow.AxisID = i + 1
 			}
-			item, err := b.genItemForWorkflow(workflow, axis, string(y.Data))
+			item, err := b.genItemForWorkflow(workflow, axis, y)
 			if err != nil && pipeline_errors.HasBlockingErrors(err) {
 				return nil, err
 			} else if err != nil {
@@ -93,11 +93,14 @@ func (b *PipelineBuilder) Build() (items []*Item, errorsAndWarnings error) {
 	}
 
 	items = filterMissingDependencies(items)
+	setArtifactDownloads(items)
 
 	return items, errorsAndWarnings
 }
 
-func (b *PipelineBuilder) genItemForWorkflow(workflow *Workflow, axis matrix.Axis, data string) (item *Item, errorsAndWarnings error) {
+func (b *PipelineBuilder) genItemForWorkflow(workflow *Workflow, axis matrix.Axis, file *YamlFile) (item *Item, errorsAndWarnings error) {
+	data := string(file.Data)
+
 	workflowMetadata := b.GetWorkflowMetadata(workflow)
 	environ := b.environmentVariables(workflowMetadata, axis)
 
@@ -133,7 +136,7 @@ func (b *PipelineBuilder) genItemForWorkflow(workflow *Workflow, axis matrix.Axi
 		linter.WithTrustedClonePlugins(b.TrustedClonePlugins),
 	).Lint([]*linter.WorkflowConfig{{
 		Workflow:  parsed,
-		File:      workflow.Name,
+		File:      file.Name,
 		RawConfig: data,
 	}}))
 	if pipeline_errors.HasBlockingErrors(errorsAndWarnings) {
@@ -169,6 +172,9 @@ func (b *PipelineBuilder) genItemForWorkflow(workflow *Workflow, axis matrix.Axi
 		DependsOn:        parsed.DependsOn,
 		ConcurrencyLimit: parsed.Concurrency.Limit,
 		ConcurrencyGroup: parsed.Concurrency.Group,
+		Priority:         parsed.Priority,
+		Resources:        parsed.Resources,
+		Retry:            parsed.Retry,
 		// TODO: remove in next major.
 		RunsOn: parsed.RunsOn, //nolint:staticcheck
 	}
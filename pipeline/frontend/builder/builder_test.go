@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
@@ -208,6 +209,80 @@ depends_on:
 	assert.Equal(t, "test", items[0].DependsOn[1].Name, "Should depend on test")
 }
 
+func TestArtifactDownloads(t *testing.T) {
+	t.Parallel()
+
+	m := &testMetadata{
+		pipelineEvent: "push",
+	}
+
+	b := PipelineBuilder{
+		GetWorkflowMetadata: m.GetWorkflowMetadata,
+		RepoTrusted:         &metadata.TrustedConfiguration{},
+		Yamls: []*YamlFile{
+			{Name: "build", Data: []byte(`
+when:
+  event: push
+skip_clone: true
+steps:
+  - name: build
+    image: scratch
+    artifacts: dist/
+`)},
+			{Name: "lint", Data: []byte(`
+when:
+  event: push
+skip_clone: true
+steps:
+  - name: lint
+    image: scratch
+`)},
+			{Name: "deploy", Data: []byte(`
+when:
+  event: push
+skip_clone: true
+steps:
+  - name: deploy
+    image: scratch
+
+depends_on:
+  - build
+  - lint
+`)},
+			{Name: "notify", Data: []byte(`
+when:
+  event: push
+skip_clone: true
+steps:
+  - name: notify
+    image: scratch
+
+depends_on:
+  - lint
+`)},
+		},
+	}
+
+	items, err := b.Build()
+	require.NoError(t, err)
+	require.Len(t, items, 4)
+
+	stepNames := func(item *Item) (names []string) {
+		for _, stage := range item.Config.Stages {
+			for _, step := range stage.Steps {
+				names = append(names, step.Name)
+			}
+		}
+		return names
+	}
+
+	assert.Equal(t, []string{"build", "upload-artifacts"}, stepNames(items[0]))
+	assert.Equal(t, []string{"download-artifacts", "deploy"}, stepNames(items[1]))
+	assert.Equal(t, []string{"build"}, items[1].Config.Stages[0].Steps[0].Artifacts.Download)
+	assert.Equal(t, []string{"lint"}, stepNames(items[2]))
+	assert.Equal(t, []string{"notify"}, stepNames(items[3]))
+}
+
 func TestRunsOn(t *testing.T) {
 	t.Parallel()
 
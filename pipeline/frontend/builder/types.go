@@ -19,6 +19,7 @@ import (
 
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/constraint"
+	yaml_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
 )
 
 type Item struct {
@@ -28,6 +29,9 @@ type Item struct {
 	RunsOn           []string
 	ConcurrencyLimit int
 	ConcurrencyGroup string
+	Priority         int
+	Resources        yaml_types.Resources
+	Retry            yaml_types.Retry
 	Config           *backend_types.Config
 }
 
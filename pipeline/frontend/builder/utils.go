@@ -16,8 +16,10 @@ package builder
 
 import (
 	"path/filepath"
+	"slices"
 	"strings"
 
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/constraint"
 )
 
@@ -81,3 +83,45 @@ func ContainsItemWithName(name string, items []*Item) bool {
 	}
 	return false
 }
+
+// setArtifactDownloads limits the artifacts downloaded by a workflow to the
+// ones of its remaining dependencies that upload any. The download step is
+// dropped if there are none.
+func setArtifactDownloads(items []*Item) {
+	uploads := make(map[string]bool)
+	for _, item := range items {
+		if hasArtifactUploads(item.Config) {
+			uploads[item.Workflow.Name] = true
+		}
+	}
+
+	for _, item := range items {
+		stages := item.Config.Stages[:0]
+		for _, stage := range item.Config.Stages {
+			stage.Steps = slices.DeleteFunc(stage.Steps, func(step *backend_types.Step) bool {
+				if step.Type != backend_types.StepTypeArtifacts || len(step.Artifacts.Download) == 0 {
+					return false
+				}
+				step.Artifacts.Download = slices.DeleteFunc(step.Artifacts.Download, func(name string) bool {
+					return !uploads[name] || !slices.Contains(item.DependsOn.Names(), name)
+				})
+				return len(step.Artifacts.Download) == 0
+			})
+			if len(stage.Steps) != 0 {
+				stages = append(stages, stage)
+			}
+		}
+		item.Config.Stages = stages
+	}
+}
+
+func hasArtifactUploads(config *backend_types.Config) bool {
+	for _, stage := range config.Stages {
+		for _, step := range stage.Steps {
+			if step.Type == backend_types.StepTypeArtifacts && len(step.Artifacts.Upload) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler
+
+import (
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	yaml_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
+)
+
+const (
+	// UploadArtifactsName is the name of the step uploading the artifacts of a workflow.
+	UploadArtifactsName = "upload-artifacts"
+	// DownloadArtifactsName is the name of the step downloading the artifacts of the
+	// workflows a workflow depends on.
+	DownloadArtifactsName = "download-artifacts"
+	// UploadReportsName is the name of the step uploading the test reports of a workflow.
+	UploadReportsName = "upload-reports"
+)
+
+// artifactsStep returns a step of type artifacts. Its image and commands are
+// set by the agent, as it runs the artifacts helper of the agent.
+func (c *Compiler) artifactsStep(name string, conf *yaml_types.Workflow, artifacts *backend_types.StepArtifacts) (*backend_types.Step, error) {
+	step, err := c.createProcess(&yaml_types.Container{Name: name}, conf, backend_types.StepTypeArtifacts)
+	if err != nil {
+		return nil, err
+	}
+	step.Artifacts = artifacts
+	return step, nil
+}
@@ -206,6 +206,17 @@ func (c *Compiler) Compile(conf *yaml_types.Workflow) (*backend_types.Config, er
 		}
 	}
 
+	// add the step downloading the artifacts of the workflows this one depends
+	// on, the pipeline builder drops it if none of them uploads any
+	if len(conf.DependsOn) != 0 {
+		step, err := c.artifactsStep(DownloadArtifactsName, conf, &backend_types.StepArtifacts{Download: conf.DependsOn.Names()})
+		if err != nil {
+			return nil, err
+		}
+
+		config.Stages = append(config.Stages, &backend_types.Stage{Steps: []*backend_types.Step{step}})
+	}
+
 	// add services steps
 	if len(conf.Services.ContainerList) != 0 {
 		stage := new(backend_types.Stage)
@@ -234,6 +245,8 @@ func (c *Compiler) Compile(conf *yaml_types.Workflow) (*backend_types.Config, er
 	}
 
 	steps := make([]*dagCompilerStep, 0, len(conf.Steps.ContainerList))
+	var uploads []backend_types.Artifact
+	var reports []backend_types.Report
 	for pos, container := range conf.Steps.ContainerList {
 		// Skip if local and should not run local
 		if c.local && !container.When.IsLocal() {
@@ -247,7 +260,9 @@ func (c *Compiler) Compile(conf *yaml_types.Workflow) (*backend_types.Config, er
 		}
 
 		stepType := backend_types.StepTypeCommands
-		if container.IsPlugin() {
+		if container.Cache != nil {
+			stepType = backend_types.StepTypeCache
+		} else if container.IsPlugin() {
 			stepType = backend_types.StepTypePlugin
 		}
 		step, err := c.createProcess(container, conf, stepType)
@@ -266,6 +281,20 @@ func (c *Compiler) Compile(conf *yaml_types.Workflow) (*backend_types.Config, er
 			name:      container.Name,
 			dependsOn: container.DependsOn,
 		})
+
+		if len(container.Artifacts) != 0 {
+			uploads = append(uploads, backend_types.Artifact{
+				Name:  container.Name,
+				Paths: container.Artifacts,
+			})
+		}
+		if container.Reports != nil && len(container.Reports.JUnit) != 0 {
+			reports = append(reports, backend_types.Report{
+				Step:   container.Name,
+				Format: backend_types.ReportFormatJUnit,
+				Paths:  container.Reports.JUnit,
+			})
+		}
 	}
 
 	// generate stages out of steps
@@ -285,5 +314,28 @@ func (c *Compiler) Compile(conf *yaml_types.Workflow) (*backend_types.Config, er
 
 	config.Stages = append(config.Stages, stepStages...)
 
+	// add the steps uploading the artifacts once all steps succeeded and the
+	// test reports even if some failed
+	uploadStage := new(backend_types.Stage)
+	if len(uploads) != 0 {
+		step, err := c.artifactsStep(UploadArtifactsName, conf, &backend_types.StepArtifacts{Upload: uploads})
+		if err != nil {
+			return nil, err
+		}
+		uploadStage.Steps = append(uploadStage.Steps, step)
+	}
+	if len(reports) != 0 {
+		step, err := c.artifactsStep(UploadReportsName, conf, &backend_types.StepArtifacts{Reports: reports})
+		if err != nil {
+			return nil, err
+		}
+		step.OnFailure = true
+		step.Failure = string(metadata.FailureIgnore)
+		uploadStage.Steps = append(uploadStage.Steps, step)
+	}
+	if len(uploadStage.Steps) != 0 {
+		config.Stages = append(config.Stages, uploadStage)
+	}
+
 	return config, nil
 }
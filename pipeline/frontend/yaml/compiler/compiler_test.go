@@ -16,6 +16,7 @@ package compiler
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -148,6 +149,207 @@ func TestCompilerCompile(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "workflow with step timeout and retry",
+			fronConf: &yaml_types.Workflow{SkipClone: true, Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
+				Name:    "test",
+				Image:   "dummy_img",
+				Timeout: 10 * time.Minute,
+				Retry:   yaml_types.StepRetry{Count: 2, Delay: 5 * time.Second, OnExitCodes: []int{1}},
+			}}}},
+			backConf: &backend_types.Config{
+				Network: defaultNetwork,
+				Volume:  defaultVolume,
+				Stages: []*backend_types.Stage{{
+					Steps: []*backend_types.Step{{
+						Name:          "test",
+						Type:          backend_types.StepTypePlugin,
+						Image:         "dummy_img",
+						OnSuccess:     true,
+						Failure:       "fail",
+						Timeout:       10 * time.Minute,
+						Retry:         &backend_types.StepRetry{Count: 2, Delay: 5 * time.Second, OnExitCodes: []int{1}},
+						Volumes:       []string{defaultVolume + ":/woodpecker"},
+						WorkingDir:    "/woodpecker/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/woodpecker",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"test"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}},
+			},
+		},
+		{
+			name: "workflow with cache step",
+			fronConf: &yaml_types.Workflow{SkipClone: true, Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
+				Name: "restore",
+				Cache: &yaml_types.Cache{
+					Action:      yaml_types.CacheActionRestore,
+					Key:         `go-{{ hashFiles "go.sum" }}`,
+					RestoreKeys: []string{"go-"},
+					Paths:       []string{".go"},
+				},
+			}}}},
+			backConf: &backend_types.Config{
+				Network: defaultNetwork,
+				Volume:  defaultVolume,
+				Stages: []*backend_types.Stage{{
+					Steps: []*backend_types.Step{{
+						Name: "restore",
+						Type: backend_types.StepTypeCache,
+						Cache: &backend_types.StepCache{
+							Action:      backend_types.CacheActionRestore,
+							Key:         `go-{{ hashFiles "go.sum" }}`,
+							RestoreKeys: []string{"go-"},
+							Paths:       []string{".go"},
+							Scope:       "octacat/hello-world",
+						},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/test"},
+						WorkingDir:    "/test/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/test",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"restore"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}},
+			},
+		},
+		{
+			name: "workflow with artifacts",
+			fronConf: &yaml_types.Workflow{
+				SkipClone: true,
+				DependsOn: constraint.DependsOn{{Name: "test"}},
+				Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
+					Name:      "build",
+					Image:     "golang",
+					Commands:  []string{"go build -o dist/app"},
+					Artifacts: []string{"dist/app"},
+				}}},
+			},
+			backConf: &backend_types.Config{
+				Network: defaultNetwork,
+				Volume:  defaultVolume,
+				Stages: []*backend_types.Stage{{
+					Steps: []*backend_types.Step{{
+						Name:          "download-artifacts",
+						Type:          backend_types.StepTypeArtifacts,
+						Artifacts:     &backend_types.StepArtifacts{Download: []string{"test"}},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/woodpecker"},
+						WorkingDir:    "/woodpecker/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/woodpecker",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"download-artifacts"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}, {
+					Steps: []*backend_types.Step{{
+						Name:          "build",
+						Type:          backend_types.StepTypeCommands,
+						Image:         "golang",
+						Commands:      []string{"go build -o dist/app"},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/test"},
+						WorkingDir:    "/test/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/test",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"build"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}, {
+					Steps: []*backend_types.Step{{
+						Name: "upload-artifacts",
+						Type: backend_types.StepTypeArtifacts,
+						Artifacts: &backend_types.StepArtifacts{Upload: []backend_types.Artifact{
+							{Name: "build", Paths: []string{"dist/app"}},
+						}},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/woodpecker"},
+						WorkingDir:    "/woodpecker/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/woodpecker",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"upload-artifacts"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}},
+			},
+		},
+		{
+			name: "workflow with test reports",
+			fronConf: &yaml_types.Workflow{
+				SkipClone: true,
+				Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
+					Name:     "test",
+					Image:    "golang",
+					Commands: []string{"go test ./..."},
+					Reports:  &yaml_types.Reports{JUnit: []string{"reports/*.xml"}},
+				}}},
+			},
+			backConf: &backend_types.Config{
+				Network: defaultNetwork,
+				Volume:  defaultVolume,
+				Stages: []*backend_types.Stage{{
+					Steps: []*backend_types.Step{{
+						Name:          "test",
+						Type:          backend_types.StepTypeCommands,
+						Image:         "golang",
+						Commands:      []string{"go test ./..."},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/test"},
+						WorkingDir:    "/test/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/test",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"test"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}, {
+					Steps: []*backend_types.Step{{
+						Name: "upload-reports",
+						Type: backend_types.StepTypeArtifacts,
+						Artifacts: &backend_types.StepArtifacts{Reports: []backend_types.Report{
+							{Step: "test", Format: "junit", Paths: []string{"reports/*.xml"}},
+						}},
+						OnSuccess:     true,
+						OnFailure:     true,
+						Failure:       "ignore",
+						Volumes:       []string{defaultVolume + ":/woodpecker"},
+						WorkingDir:    "/woodpecker/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/woodpecker",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"upload-reports"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}},
+			},
+		},
+		{
+			name: "workflow with id token",
+			fronConf: &yaml_types.Workflow{SkipClone: true, Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
+				Name:     "deploy",
+				Image:    "alpine",
+				Commands: []string{"./deploy.sh"},
+				IDToken:  &yaml_types.IDToken{Audience: "sts.amazonaws.com", File: "/run/token"},
+			}}}},
+			backConf: &backend_types.Config{
+				Network: defaultNetwork,
+				Volume:  defaultVolume,
+				Stages: []*backend_types.Stage{{
+					Steps: []*backend_types.Step{{
+						Name:          "deploy",
+						Type:          backend_types.StepTypeCommands,
+						Image:         "alpine",
+						Commands:      []string{"./deploy.sh"},
+						IDToken:       &backend_types.StepIDToken{Audience: "sts.amazonaws.com"},
+						OnSuccess:     true,
+						Failure:       "fail",
+						Volumes:       []string{defaultVolume + ":/test"},
+						WorkingDir:    "/test/src/github.com/octocat/hello-world",
+						WorkspaceBase: "/test",
+						Networks:      []backend_types.Conn{{Name: "test_default", Aliases: []string{"deploy"}}},
+						ExtraHosts:    []backend_types.HostAlias{},
+					}},
+				}},
+			},
+		},
 		{
 			name: "workflow with three steps",
 			fronConf: &yaml_types.Workflow{Steps: yaml_types.ContainerList{ContainerList: []*yaml_types.Container{{
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"maps"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,8 @@ const (
 	DefaultWorkspaceBase = pluginWorkspaceBase
 )
 
+var pullNumber = regexp.MustCompile(`\d+`)
+
 func (c *Compiler) createProcess(container *yaml_types.Container, workflow *yaml_types.Workflow, stepType backend_types.StepType) (*backend_types.Step, error) {
 	var (
 		uuid = ulid.Make()
@@ -169,6 +172,34 @@ func (c *Compiler) createProcess(container *yaml_types.Container, workflow *yaml
 		failure = string(metadata.FailureIgnore)
 	}
 
+	var retry *backend_types.StepRetry
+	if container.Retry.Count > 0 {
+		retry = &backend_types.StepRetry{
+			Count:       container.Retry.Count,
+			Delay:       container.Retry.Delay,
+			OnExitCodes: container.Retry.OnExitCodes,
+		}
+	}
+
+	var cache *backend_types.StepCache
+	if container.Cache != nil {
+		cache = &backend_types.StepCache{
+			Action:      backend_types.CacheAction(container.Cache.Action),
+			Key:         container.Cache.Key,
+			RestoreKeys: container.Cache.RestoreKeys,
+			Paths:       container.Cache.Paths,
+		}
+		cache.Scope, cache.RestoreScopes = c.cacheScopes()
+	}
+
+	var idToken *backend_types.StepIDToken
+	if container.IDToken != nil {
+		idToken = &backend_types.StepIDToken{Audience: container.IDToken.Audience}
+		if container.IDToken.File != "" {
+			environment["CI_ID_TOKEN_FILE"] = container.IDToken.File
+		}
+	}
+
 	return &backend_types.Step{
 		Name:           container.Name,
 		UUID:           uuid.String(),
@@ -194,6 +225,10 @@ func (c *Compiler) createProcess(container *yaml_types.Container, workflow *yaml
 		OnSuccess:      onSuccess,
 		OnFailure:      onFailure,
 		Failure:        failure,
+		Timeout:        container.Timeout,
+		Retry:          retry,
+		Cache:          cache,
+		IDToken:        idToken,
 		NetworkMode:    networkMode,
 		Ports:          ports,
 		BackendOptions: container.BackendOptions,
@@ -227,3 +262,15 @@ func convertPort(portDef string) (backend_types.Port, error) {
 
 	return port, nil
 }
+
+// cacheScopes returns the scope the caches of cache steps are stored in and
+// the scopes they may be restored from. Pull requests get their own scope and
+// only read the caches of the repository, so they can't change the caches
+// used by pipelines of its branches.
+func (c *Compiler) cacheScopes() (string, []string) {
+	repo := path.Join(c.metadata.Repo.Owner, c.metadata.Repo.Name)
+	if !c.metadata.Curr.Event.IsPull() {
+		return repo, nil
+	}
+	return path.Join(repo, "pull", pullNumber.FindString(c.metadata.Curr.Commit.Ref)), []string{repo}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/metadata"
 )
 
 func TestConvertPortNumber(t *testing.T) {
@@ -59,3 +60,18 @@ func TestConvertPortWrong(t *testing.T) {
 	_, err := convertPort(portDef)
 	assert.Error(t, err)
 }
+
+func TestCacheScopes(t *testing.T) {
+	meta := metadata.Metadata{
+		Repo: metadata.Repo{Owner: "octocat", Name: "hello-world"},
+		Curr: metadata.Pipeline{Event: metadata.EventPush, Commit: metadata.Commit{Ref: "refs/heads/main"}},
+	}
+	scope, restoreScopes := New(WithMetadata(meta)).cacheScopes()
+	assert.Equal(t, "octocat/hello-world", scope)
+	assert.Empty(t, restoreScopes)
+
+	meta.Curr = metadata.Pipeline{Event: metadata.EventPull, Commit: metadata.Commit{Ref: "refs/pull/12/head"}}
+	scope, restoreScopes = New(WithMetadata(meta)).cacheScopes()
+	assert.Equal(t, "octocat/hello-world/pull/12", scope)
+	assert.Equal(t, []string{"octocat/hello-world"}, restoreScopes)
+}
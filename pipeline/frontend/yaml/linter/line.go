@@ -0,0 +1,131 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linter
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/multierr"
+	go_yaml "go.yaml.in/yaml/v4"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+)
+
+// fieldIndex matches the indexes of a field segment, e.g. `[0]` of `when[0]`.
+var fieldIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// addLines sets the line of the config a linter error refers to, so it can be
+// shown next to the config, e.g. as forge annotation.
+func addLines(config *WorkflowConfig, err error) {
+	if err == nil {
+		return
+	}
+
+	root := new(go_yaml.Node)
+	if go_yaml.Unmarshal([]byte(config.RawConfig), root) != nil {
+		return
+	}
+
+	for _, err := range multierr.Errors(err) {
+		pipelineErr, ok := err.(*pipeline_errors.PipelineError)
+		if !ok {
+			continue
+		}
+		switch data := pipelineErr.Data.(type) {
+		case *pipeline_errors.LinterErrorData:
+			data.Line = fieldLine(root, data.Field)
+		case pipeline_errors.BadHabitErrorData:
+			data.Line = fieldLine(root, data.Field)
+			pipelineErr.Data = data
+		}
+	}
+}
+
+// fieldLine returns the line of a field like `steps.build.image` or
+// `steps.build.when[0]`. If the field doesn't exist it returns the line of its
+// closest existing parent, or zero if there is none.
+func fieldLine(root *go_yaml.Node, field string) int {
+	node := root
+	if node.Kind == go_yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line := 0
+	for _, segment := range strings.Split(field, ".") {
+		var indexes []int
+		for _, match := range fieldIndex.FindAllStringSubmatch(segment, -1) {
+			index, _ := strconv.Atoi(match[1])
+			indexes = append(indexes, index)
+		}
+		if key := fieldIndex.ReplaceAllString(segment, ""); key != "" {
+			if index, err := strconv.Atoi(key); err == nil {
+				indexes = append([]int{index}, indexes...)
+			} else if node = child(node, key); node == nil {
+				return line
+			}
+			line = node.Line
+		}
+		for _, index := range indexes {
+			if node = item(node, index); node == nil {
+				return line
+			}
+			line = node.Line
+		}
+	}
+	return line
+}
+
+// child returns the value of a key of a map, or of the item of a list having
+// the key as name, as steps can be defined both ways.
+func child(node *go_yaml.Node, key string) *go_yaml.Node {
+	node = resolveAlias(node)
+	switch node.Kind {
+	case go_yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				// point to the key, values of maps and lists start on the next line
+				value := resolveAlias(node.Content[i+1])
+				if value.Kind == go_yaml.ScalarNode {
+					return value
+				}
+				return &go_yaml.Node{Kind: value.Kind, Content: value.Content, Line: node.Content[i].Line}
+			}
+		}
+	case go_yaml.SequenceNode:
+		for _, entry := range node.Content {
+			if name := child(entry, "name"); name != nil && name.Value == key {
+				return resolveAlias(entry)
+			}
+		}
+	}
+	return nil
+}
+
+func item(node *go_yaml.Node, index int) *go_yaml.Node {
+	node = resolveAlias(node)
+	if node.Kind != go_yaml.SequenceNode || index >= len(node.Content) {
+		return nil
+	}
+	return resolveAlias(node.Content[index])
+}
+
+func resolveAlias(node *go_yaml.Node) *go_yaml.Node {
+	if node.Kind == go_yaml.AliasNode && node.Alias != nil {
+		return node.Alias
+	}
+	return node
+}
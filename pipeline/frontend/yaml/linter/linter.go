@@ -16,11 +16,15 @@ package linter
 
 import (
 	"fmt"
+	"path"
 	"regexp"
 	"slices"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"go.uber.org/multierr"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/archive"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/cache"
 	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/linter/schema"
@@ -33,6 +37,9 @@ import (
 // this should be exempt from privileged action as it makes the container even more unprivileged.
 const networkModeNone = "none"
 
+// windowsAbsPath matches absolute paths of windows containers like `C:\token`.
+var windowsAbsPath = regexp.MustCompile(`^[a-zA-Z]:[\\/]`)
+
 // A Linter lints a pipeline configuration.
 type Linter struct {
 	trusted             TrustedConfiguration
@@ -113,6 +120,8 @@ func (l *Linter) lintFile(config *WorkflowConfig) error {
 		linterErr = multierr.Append(linterErr, err)
 	}
 
+	addLines(config, linterErr)
+
 	return linterErr
 }
 
@@ -180,7 +189,11 @@ func (l *Linter) lintContainers(config *WorkflowConfig, area string) error {
 	}
 
 	for _, container := range containers {
-		if err := l.lintImage(config, container, area); err != nil {
+		if container.Cache != nil {
+			if err := l.lintCache(config, container, area); err != nil {
+				linterErr = multierr.Append(linterErr, err)
+			}
+		} else if err := l.lintImage(config, container, area); err != nil {
 			linterErr = multierr.Append(linterErr, err)
 		}
 		if err := l.lintTrusted(config, container, area); err != nil {
@@ -198,6 +211,18 @@ func (l *Linter) lintContainers(config *WorkflowConfig, area string) error {
 		if err := l.lintDependsOn(config, container, area); err != nil {
 			linterErr = multierr.Append(linterErr, err)
 		}
+		if err := l.lintDetached(config, container, area); err != nil {
+			linterErr = multierr.Append(linterErr, err)
+		}
+		if err := l.lintArtifacts(config, container, area); err != nil {
+			linterErr = multierr.Append(linterErr, err)
+		}
+		if err := l.lintReports(config, container, area); err != nil {
+			linterErr = multierr.Append(linterErr, err)
+		}
+		if err := l.lintIDToken(config, container, area); err != nil {
+			linterErr = multierr.Append(linterErr, err)
+		}
 	}
 
 	return linterErr
@@ -230,6 +255,121 @@ func (l *Linter) lintDependsOn(config *WorkflowConfig, c *types.Container, area
 	return linterErr
 }
 
+func (l *Linter) lintDetached(config *WorkflowConfig, c *types.Container, area string) error {
+	// services are rejected by the schema already
+	if area != "steps" || !c.Detached {
+		return nil
+	}
+	if c.Timeout != 0 || c.Retry.Count != 0 {
+		return newLinterError("Cannot use `timeout` or `retry` on detached steps", config.File, fmt.Sprintf("%s.%s", area, c.Name), false)
+	}
+	return nil
+}
+
+func (l *Linter) lintCache(config *WorkflowConfig, c *types.Container, area string) error {
+	field := fmt.Sprintf("%s.%s.cache", area, c.Name)
+	if area != "steps" {
+		return newLinterError("Cache steps are only allowed in `steps`", config.File, field, false)
+	}
+	if len(c.Image) != 0 || len(c.Commands) != 0 || len(c.Entrypoint) != 0 || len(c.Settings) != 0 {
+		return newLinterError("Cannot configure `cache` together with `image`, `commands`, `entrypoint` or `settings`", config.File, field, false)
+	}
+
+	var linterErr error
+	for _, key := range append([]string{c.Cache.Key}, c.Cache.RestoreKeys...) {
+		if err := cache.ValidateKey(key); err != nil {
+			linterErr = multierr.Append(linterErr, newLinterError(err.Error(), config.File, field, false))
+		}
+	}
+	if c.Cache.Action == types.CacheActionSave && len(c.Cache.RestoreKeys) != 0 {
+		linterErr = multierr.Append(linterErr, newLinterError("`restore_keys` are only used to restore a cache", config.File, field+".restore_keys", true))
+	}
+	return linterErr
+}
+
+func (l *Linter) lintArtifacts(config *WorkflowConfig, c *types.Container, area string) error {
+	if len(c.Artifacts) == 0 {
+		return nil
+	}
+
+	field := fmt.Sprintf("%s.%s.artifacts", area, c.Name)
+	if area != "steps" {
+		return newLinterError("Artifacts are only allowed in `steps`", config.File, field, false)
+	}
+	if c.Cache != nil || c.Detached {
+		return newLinterError("Cache steps and detached steps cannot upload artifacts", config.File, field, false)
+	}
+	if _, err := archive.CleanPaths(c.Artifacts); err != nil {
+		return newLinterError(fmt.Sprintf("Invalid artifact %s", err), config.File, field, false)
+	}
+
+	// the name of the step is the name of the artifact
+	for _, other := range config.Workflow.Steps.ContainerList {
+		if other != c && other.Name == c.Name && len(other.Artifacts) != 0 {
+			return newLinterError("Steps uploading artifacts need a unique name", config.File, field, false)
+		}
+	}
+	return nil
+}
+
+func (l *Linter) lintReports(config *WorkflowConfig, c *types.Container, area string) error {
+	if c.Reports == nil || len(c.Reports.JUnit) == 0 {
+		return nil
+	}
+
+	field := fmt.Sprintf("%s.%s.reports", area, c.Name)
+	if area != "steps" {
+		return newLinterError("Test reports are only allowed in `steps`", config.File, field, false)
+	}
+	if c.Cache != nil || c.Detached {
+		return newLinterError("Cache steps and detached steps cannot have test reports", config.File, field, false)
+	}
+	patterns, err := archive.CleanPaths(c.Reports.JUnit)
+	if err != nil {
+		return newLinterError(fmt.Sprintf("Invalid test report %s", err), config.File, field, false)
+	}
+	for _, pattern := range patterns {
+		if !doublestar.ValidatePattern(pattern) {
+			return newLinterError(fmt.Sprintf("Invalid test report pattern %q", pattern), config.File, field, false)
+		}
+	}
+
+	// test results are attributed to the step by its name
+	for _, other := range config.Workflow.Steps.ContainerList {
+		if other != c && other.Name == c.Name && other.Reports != nil {
+			return newLinterError("Steps with test reports need a unique name", config.File, field, false)
+		}
+	}
+	return nil
+}
+
+func (l *Linter) lintIDToken(config *WorkflowConfig, c *types.Container, area string) error {
+	if c.IDToken == nil {
+		return nil
+	}
+
+	field := fmt.Sprintf("%s.%s.id_token", area, c.Name)
+	if area != "steps" {
+		return newLinterError("ID tokens are only allowed in `steps`", config.File, field, false)
+	}
+	if c.Cache != nil {
+		return newLinterError("Cache steps cannot request an ID token", config.File, field, false)
+	}
+	if len(c.IDToken.Audience) == 0 {
+		return newLinterError("ID tokens need an `audience`", config.File, field+".audience", false)
+	}
+	if len(c.IDToken.File) != 0 {
+		// the file is written by the step script, which plugins do not have
+		if c.IsPlugin() {
+			return newLinterError("Plugins cannot write the ID token to a `file`", config.File, field+".file", false)
+		}
+		if !path.IsAbs(c.IDToken.File) && !windowsAbsPath.MatchString(c.IDToken.File) {
+			return newLinterError("The ID token `file` must be an absolute path", config.File, field+".file", false)
+		}
+	}
+	return nil
+}
+
 func (l *Linter) lintImage(config *WorkflowConfig, c *types.Container, area string) error {
 	if len(c.Image) == 0 {
 		return newLinterError("Invalid or missing image", config.File, fmt.Sprintf("%s.%s", area, c.Name), false)
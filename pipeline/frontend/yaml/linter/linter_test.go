@@ -70,6 +70,28 @@ steps:
 services:
   - name: redis
     image: redis
+`,
+	}, {
+		Title: "cache", Data: `
+when:
+  event: push
+
+steps:
+  - name: restore
+    cache:
+      action: restore
+      key: go-{{ hashFiles "go.sum" }}
+      restore_keys: go-
+      paths: .go
+  - name: build
+    image: golang
+    commands:
+      - go build
+  - name: save
+    cache:
+      action: save
+      key: go-{{ hashFiles "go.sum" }}
+      paths: .go
 `,
 	}, {
 		Title: "merge maps", Data: `
@@ -189,6 +211,62 @@ func TestLintErrors(t *testing.T) {
 			from: "steps: { build: { image: golang, network_mode: 'container:name' }  }",
 			want: "Insufficient trust level to use `network_mode`",
 		},
+		{
+			from: "steps: { build: { image: golang, detach: true, timeout: 10m } }",
+			want: "Cannot use `timeout` or `retry` on detached steps",
+		},
+		{
+			from: "steps: { restore: { image: alpine, cache: { action: restore, key: deps, paths: vendor } } }",
+			want: "Cannot configure `cache` together with `image`, `commands`, `entrypoint` or `settings`",
+		},
+		{
+			from: "steps: { restore: { cache: { action: restore, key: 'deps-{{ hashFiles }', paths: vendor } } }",
+			want: "parse cache key \"deps-{{ hashFiles }\": template: key:1: unexpected \"}\" in operand",
+		},
+		{
+			from: "services: { cache: { cache: { action: restore, key: deps, paths: vendor } } }",
+			want: "Cache steps are only allowed in `steps`",
+		},
+		{
+			from: "services: { db: { image: postgres, artifacts: dump.sql } }",
+			want: "Artifacts are only allowed in `steps`",
+		},
+		{
+			from: "steps: { build: { image: golang, detach: true, artifacts: dist } }",
+			want: "Cache steps and detached steps cannot upload artifacts",
+		},
+		{
+			from: "steps: { build: { image: golang, artifacts: ../dist } }",
+			want: "Invalid artifact path \"../dist\" must be relative to the workspace",
+		},
+		{
+			from: "services: { db: { image: postgres, reports: { junit: report.xml } } }",
+			want: "Test reports are only allowed in `steps`",
+		},
+		{
+			from: "steps: { test: { image: golang, reports: { junit: '/tmp/*.xml' } } }",
+			want: "Invalid test report path \"/tmp/*.xml\" must be relative to the workspace",
+		},
+		{
+			from: "steps: { test: { image: golang, reports: { junit: 'reports/[.xml' } } }",
+			want: "Invalid test report pattern \"reports/[.xml\"",
+		},
+		{
+			from: "services: { db: { image: postgres, id_token: { audience: vault } } }",
+			want: "ID tokens are only allowed in `steps`",
+		},
+		{
+			from: "steps: { deploy: { image: alpine, commands: ./deploy.sh, id_token: { file: /run/token } } }",
+			want: "ID tokens need an `audience`",
+		},
+		{
+			from: "steps: { deploy: { image: plugins/s3, settings: { bucket: test }, id_token: { audience: vault, file: /run/token } } }",
+			want: "Plugins cannot write the ID token to a `file`",
+		},
+		{
+			from: "steps: { deploy: { image: alpine, commands: ./deploy.sh, id_token: { audience: vault, file: run/token } } }",
+			want: "The ID token `file` must be an absolute path",
+		},
 		{
 			from: "steps: { build: { image: golang, settings: { test: 'true' }, commands: [ 'echo ja', 'echo nein' ] } }",
 			want: "Cannot configure both `commands` and `settings`",
@@ -246,6 +324,58 @@ func TestLintErrors(t *testing.T) {
 	}
 }
 
+func TestLintErrorLines(t *testing.T) {
+	testdata := []struct {
+		name string
+		from string
+		want string
+		line int
+	}{
+		{
+			name: "step map",
+			from: "steps:\n  test:\n    image: golang\n  build:\n    image: golang\n    privileged: true\n",
+			want: "Insufficient trust level to use `privileged` mode",
+			line: 4,
+		},
+		{
+			name: "step list",
+			from: "steps:\n  - name: test\n    image: golang\n  - name: build\n    image: ''\n",
+			want: "Invalid or missing image",
+			line: 4,
+		},
+		{
+			name: "nested field",
+			from: "steps:\n  build:\n    image: golang\n    commands: [ go build ]\n    settings:\n      foo: bar\n",
+			want: "Cannot configure both `commands` and `settings`",
+			line: 2,
+		},
+	}
+
+	for _, test := range testdata {
+		t.Run(test.name, func(t *testing.T) {
+			conf, err := yaml.ParseString(test.from)
+			require.NoError(t, err)
+
+			lerr := linter.New().Lint([]*linter.WorkflowConfig{{
+				File:      ".woodpecker.yaml",
+				RawConfig: test.from,
+				Workflow:  conf,
+			}})
+			require.Error(t, lerr)
+
+			for _, lerr := range errors.GetPipelineErrors(lerr) {
+				if lerr.Message == test.want {
+					data := errors.GetLinterData(lerr)
+					require.NotNil(t, data)
+					assert.Equal(t, test.line, data.Line)
+					return
+				}
+			}
+			t.Errorf("expected error %q", test.want)
+		})
+	}
+}
+
 func TestDeprecations(t *testing.T) {
 	testdata := []struct {
 		from string
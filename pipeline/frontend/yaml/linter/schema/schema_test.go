@@ -137,6 +137,81 @@ func TestSchema(t *testing.T) {
 			testFile: ".woodpecker/test-concurrency-invalid.yaml",
 			fail:     true,
 		},
+		{
+			name:     "Priority",
+			testFile: ".woodpecker/test-priority.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Resources",
+			testFile: ".woodpecker/test-resources.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Resources invalid",
+			testFile: ".woodpecker/test-resources-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "Retry",
+			testFile: ".woodpecker/test-retry.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Retry invalid",
+			testFile: ".woodpecker/test-retry-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "Step timeout and retry",
+			testFile: ".woodpecker/test-step-retry.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Step retry invalid",
+			testFile: ".woodpecker/test-step-retry-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "Cache steps",
+			testFile: ".woodpecker/test-cache.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Cache steps invalid",
+			testFile: ".woodpecker/test-cache-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "Artifacts",
+			testFile: ".woodpecker/test-artifacts.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Artifacts invalid",
+			testFile: ".woodpecker/test-artifacts-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "Test reports",
+			testFile: ".woodpecker/test-reports.yaml",
+			fail:     false,
+		},
+		{
+			name:     "Test reports invalid",
+			testFile: ".woodpecker/test-reports-invalid.yaml",
+			fail:     true,
+		},
+		{
+			name:     "ID token",
+			testFile: ".woodpecker/test-id-token.yaml",
+			fail:     false,
+		},
+		{
+			name:     "ID token invalid",
+			testFile: ".woodpecker/test-id-token-invalid.yaml",
+			fail:     true,
+		},
 		{
 			name:     "Service without name in array syntax",
 			testFile: ".woodpecker/test-broken-service-without-name.yaml",
@@ -0,0 +1,37 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types/base"
+
+const (
+	CacheActionRestore = "restore"
+	CacheActionSave    = "save"
+)
+
+// Cache defines a step which restores or saves a cache instead of running a
+// container of its own.
+type Cache struct {
+	// Action is either restore or save.
+	Action string `yaml:"action,omitempty"`
+	// Key identifies the cache, it is a template which can hash files, e.g.
+	// go-{{ hashFiles "go.sum" }}.
+	Key string `yaml:"key,omitempty"`
+	// RestoreKeys are prefixes of keys to fall back to if there is no cache
+	// for the key itself, the most recent matching cache is restored.
+	RestoreKeys base.StringOrSlice `yaml:"restore_keys,omitempty"`
+	// Paths are the paths relative to the workspace which are cached.
+	Paths base.StringOrSlice `yaml:"paths,omitempty"`
+}
@@ -15,6 +15,8 @@
 package types
 
 import (
+	"time"
+
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/constraint"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types/base"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/utils"
@@ -36,6 +38,15 @@ type Container struct {
 	When      constraint.When      `yaml:"when,omitempty"`
 	Failure   string               `yaml:"failure,omitempty"`
 	Detached  bool                 `yaml:"detach,omitempty"`
+	Timeout   time.Duration        `yaml:"timeout,omitempty"`
+	Retry     StepRetry            `yaml:"retry,omitempty"`
+	// cache
+	Cache *Cache `yaml:"cache,omitempty"`
+	// artifacts
+	Artifacts base.StringOrSlice `yaml:"artifacts,omitempty"`
+	Reports   *Reports           `yaml:"reports,omitempty"`
+	// identity
+	IDToken *IDToken `yaml:"id_token,omitempty"`
 	// state
 	Volumes Volumes `yaml:"volumes,omitempty"`
 	// network
@@ -58,7 +69,8 @@ type Container struct {
 }
 
 func (c *Container) IsPlugin() bool {
-	return len(c.Commands) == 0 &&
+	return c.Cache == nil &&
+		len(c.Commands) == 0 &&
 		len(c.Entrypoint) == 0 &&
 		len(c.Environment) == 0
 }
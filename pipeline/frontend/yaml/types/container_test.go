@@ -16,6 +16,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.yaml.in/yaml/v4"
@@ -67,6 +68,11 @@ ports:
   - 8080
   - 4443/tcp
   - 51820/udp
+timeout: 10m
+retry:
+  count: 2
+  delay: 5s
+  on_exit_codes: [1, 137]
 `)
 
 func TestUnmarshalContainer(t *testing.T) {
@@ -112,7 +118,9 @@ func TestUnmarshalContainer(t *testing.T) {
 			"foo": "bar",
 			"baz": false,
 		},
-		Ports: []string{"8080", "4443/tcp", "51820/udp"},
+		Ports:   []string{"8080", "4443/tcp", "51820/udp"},
+		Timeout: 10 * time.Minute,
+		Retry:   StepRetry{Count: 2, Delay: 5 * time.Second, OnExitCodes: []int{1, 137}},
 	}
 	got := Container{}
 	err := yaml.Unmarshal(containerYaml, &got)
@@ -236,6 +244,25 @@ func TestUnmarshalContainers(t *testing.T) {
 				},
 			},
 		},
+		{
+			from: `restore-cache:
+    cache:
+      action: restore
+      key: go-{{ hashFiles "go.sum" }}
+      restore_keys: go-
+      paths: [.go/pkg/mod, .cache]`,
+			want: []*Container{
+				{
+					Name: "restore-cache",
+					Cache: &Cache{
+						Action:      CacheActionRestore,
+						Key:         `go-{{ hashFiles "go.sum" }}`,
+						RestoreKeys: base.StringOrSlice{"go-"},
+						Paths:       base.StringOrSlice{".go/pkg/mod", ".cache"},
+					},
+				},
+			},
+		},
 	}
 	for _, test := range testdata {
 		in := []byte(test.from)
@@ -283,4 +310,7 @@ func TestIsPlugin(t *testing.T) {
 	assert.False(t, (&Container{
 		Entrypoint: base.StringOrSlice([]string{"echo 'this is not a plugin'"}),
 	}).IsPlugin())
+	assert.False(t, (&Container{
+		Cache: &Cache{Action: CacheActionSave},
+	}).IsPlugin())
 }
@@ -0,0 +1,24 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// IDToken requests an OpenID Connect identity token for a step. The token is
+// issued by the server when the workflow starts and exposed as CI_ID_TOKEN.
+type IDToken struct {
+	// Audience is the `aud` claim of the token, usually the service accepting it.
+	Audience string `yaml:"audience,omitempty"`
+	// File is an absolute path the token is additionally written to.
+	File string `yaml:"file,omitempty"`
+}
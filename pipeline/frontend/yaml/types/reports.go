@@ -0,0 +1,24 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types/base"
+
+// Reports defines the test reports a step writes into the workspace. They
+// are sent to the server after the step, even if it failed.
+type Reports struct {
+	// JUnit are glob patterns of JUnit XML reports relative to the workspace.
+	JUnit base.StringOrSlice `yaml:"junit,omitempty"`
+}
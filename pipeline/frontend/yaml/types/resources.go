@@ -0,0 +1,30 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types/base"
+)
+
+// Resources are requested by a workflow from the agent it runs on. The
+// workflow is only handed out to an agent that has enough of them left.
+type Resources struct {
+	// CPU is the number of CPU cores, fractions like 0.5 are allowed.
+	CPU float64 `yaml:"cpu,omitempty"`
+	// Memory is given in bytes or with a unit like 4GiB.
+	Memory base.MemStringOrInt `yaml:"memory,omitempty"`
+	// Disk is given in bytes or with a unit like 20GiB.
+	Disk base.MemStringOrInt `yaml:"disk,omitempty"`
+}
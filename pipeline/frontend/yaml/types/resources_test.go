@@ -0,0 +1,32 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestUnmarshalResources(t *testing.T) {
+	var parsed struct {
+		Resources Resources `yaml:"resources"`
+	}
+	err := yaml.Unmarshal([]byte("resources:\n  cpu: 0.5\n  memory: 4GiB\n  disk: 1048576"), &parsed)
+	require.NoError(t, err)
+	assert.Equal(t, Resources{CPU: 0.5, Memory: 4 << 30, Disk: 1 << 20}, parsed.Resources)
+}
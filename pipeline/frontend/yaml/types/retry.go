@@ -0,0 +1,44 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types/base"
+)
+
+// Retry defines if a workflow is run again after it failed because of the
+// infrastructure it ran on, instead of one of its steps.
+type Retry struct {
+	// Attempts is the maximum number of attempts, including the first one.
+	Attempts int `yaml:"attempts,omitempty"`
+	// Backoff is the time to wait before the next attempt, it is doubled with
+	// every attempt.
+	Backoff time.Duration `yaml:"backoff,omitempty"`
+	// On lists the failures the workflow is retried on.
+	On base.StringOrSlice `yaml:"on,omitempty"`
+}
+
+// StepRetry defines if a step is run again after it failed.
+type StepRetry struct {
+	// Count is the number of times the step is run again at most.
+	Count int `yaml:"count,omitempty"`
+	// Delay is the time to wait before the step is run again.
+	Delay time.Duration `yaml:"delay,omitempty"`
+	// OnExitCodes limits retries to these exit codes, every failure is
+	// retried if it is empty.
+	OnExitCodes []int `yaml:"on_exit_codes,omitempty"`
+}
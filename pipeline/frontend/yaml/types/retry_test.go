@@ -0,0 +1,33 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v4"
+)
+
+func TestUnmarshalRetry(t *testing.T) {
+	var parsed struct {
+		Retry Retry `yaml:"retry"`
+	}
+	err := yaml.Unmarshal([]byte("retry:\n  attempts: 3\n  backoff: 30s\n  on: oom"), &parsed)
+	require.NoError(t, err)
+	assert.Equal(t, Retry{Attempts: 3, Backoff: 30 * time.Second, On: []string{"oom"}}, parsed.Retry)
+}
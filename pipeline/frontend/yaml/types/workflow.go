@@ -29,6 +29,9 @@ type (
 		Labels      map[string]string    `yaml:"labels,omitempty"`
 		DependsOn   constraint.DependsOn `yaml:"depends_on,omitempty"`
 		Concurrency Concurrency          `yaml:"concurrency,omitempty"`
+		Priority    int                  `yaml:"priority,omitempty"`
+		Resources   Resources            `yaml:"resources,omitempty"`
+		Retry       Retry                `yaml:"retry,omitempty"`
 		SkipClone   bool                 `yaml:"skip_clone,omitempty"`
 		// Deprecated: use when.status. TODO remove in next major.
 		RunsOn []string `yaml:"runs_on,omitempty"`
@@ -18,7 +18,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -110,6 +113,8 @@ func (r *Runtime) setStepEnv(step *backend_types.Step) error {
 }
 
 // startStep starts the step container and spawns a goroutine to stream its logs.
+// The stepCtx is the workflow context, limited by the step timeout if it has one.
+// A non-empty header is written to the log before the output of the step.
 // It returns:
 //   - waitForLogs: must be called before WaitStep — it blocks until the log stream
 //     is fully drained. Some backends (e.g. local) close the log stream when
@@ -119,16 +124,19 @@ func (r *Runtime) setStepEnv(step *backend_types.Step) error {
 //
 // If StartStep or TailStep fail, startStep returns a non-nil error and the caller
 // must not call waitForLogs.
-func (r *Runtime) startStep(step *backend_types.Step) (func(), int64, error) {
-	if err := r.engine.StartStep(r.ctx, step, r.taskUUID); err != nil {
+func (r *Runtime) startStep(stepCtx context.Context, step *backend_types.Step, header string) (func(), int64, error) {
+	if err := r.engine.StartStep(stepCtx, step, r.taskUUID); err != nil {
 		return nil, 0, err
 	}
 	startTime := time.Now().Unix()
 
-	rc, err := r.engine.TailStep(r.ctx, step, r.taskUUID)
+	rc, err := r.engine.TailStep(stepCtx, step, r.taskUUID)
 	if err != nil {
 		return nil, 0, err
 	}
+	if header != "" {
+		rc = &headerReadCloser{Reader: io.MultiReader(strings.NewReader(header+"\n"), rc), Closer: rc}
+	}
 
 	var wg sync.WaitGroup
 	wg.Go(func() {
@@ -164,18 +172,35 @@ func (r *Runtime) cancelErr(err error, step *backend_types.Step) error {
 	return pipeline_errors.ErrCancel
 }
 
+// timedOut reports whether the step the stepCtx belongs to ran over its
+// timeout. A workflow that is canceled or timed out as a whole does not count.
+func (r *Runtime) timedOut(stepCtx context.Context) bool {
+	return errors.Is(stepCtx.Err(), context.DeadlineExceeded) && !r.canceled()
+}
+
 // completeStep drains the log stream, waits for the process to exit, destroys
-// the container, and maps exit conditions (OOM kill, non-zero exit code, context
-// cancellation) to typed errors.
+// the container, and maps exit conditions (OOM kill, non-zero exit code, timeout,
+// context cancellation) to typed errors.
 //
 // The runnerCtx is intentionally used for DestroyStep so that container cleanup can
-// still reach the backend even after the workflow context (r.ctx) is canceled.
-func (r *Runtime) completeStep(runnerCtx context.Context, step *backend_types.Step, waitForLogs func(), startTime int64) (*backend_types.State, error) {
+// still reach the backend even after the workflow context (r.ctx) is canceled or
+// the step ran over its timeout.
+func (r *Runtime) completeStep(stepCtx, runnerCtx context.Context, step *backend_types.Step, waitForLogs func(), startTime int64) (*backend_types.State, error) {
 	// Drain the log stream before waiting on the process exit.
 	waitForLogs()
 
-	waitState, err := r.engine.WaitStep(r.ctx, step, r.taskUUID) //nolint:contextcheck
-	if err != nil {
+	waitState, err := r.engine.WaitStep(stepCtx, step, r.taskUUID)
+	// How an aborted wait is reported is backend specific, it may be an error
+	// or a state of a killed process, so the context is the only reliable signal.
+	timedOut := r.timedOut(stepCtx)
+	switch {
+	case timedOut:
+		// The step may still be running until it gets destroyed below.
+		waitState = &backend_types.State{
+			Exited: true,
+			Error:  &pipeline_errors.TimeoutError{UUID: step.UUID, Timeout: step.Timeout},
+		}
+	case err != nil:
 		if !r.cancelFallout(err) {
 			return nil, err
 		}
@@ -198,6 +223,9 @@ func (r *Runtime) completeStep(runnerCtx context.Context, step *backend_types.St
 		waitState.Error = pipeline_errors.ErrCancel
 	}
 
+	if timedOut {
+		return waitState, waitState.Error
+	}
 	if waitState.OOMKilled {
 		return waitState, &pipeline_errors.OomError{
 			UUID: step.UUID,
@@ -214,33 +242,118 @@ func (r *Runtime) completeStep(runnerCtx context.Context, step *backend_types.St
 	return waitState, nil
 }
 
-// runBlockingStep starts the step and blocks until it fully completes.
-// The error is traced and returned to runStage, which feeds it into the
-// stage error group.
+// runBlockingStep starts the step and blocks until it fully completes. A step
+// that fails is run again as long as its retry policy allows, only the result
+// of the last attempt is traced. The error is returned to runStage, which
+// feeds it into the stage error group.
 func (r *Runtime) runBlockingStep(runnerCtx context.Context, step *backend_types.Step) error {
+	var (
+		processState *backend_types.State
+		err          error
+	)
+	for attempt := 1; ; attempt++ {
+		processState, err = r.runStepAttempt(runnerCtx, step, attempt, err)
+		if processState == nil {
+			// The step never ran — trace the start failure and surface it.
+			return r.traceStep(nil, err, step)
+		}
+		if !r.retryStep(step, attempt, err) {
+			break
+		}
+	}
+
+	err = r.traceStep(processState, err, step)
+	if err != nil && metadata.Failure(step.Failure) == metadata.FailureIgnore {
+		return nil
+	}
+	return err
+}
+
+// runStepAttempt runs a step once. A step with a timeout is destroyed once it
+// runs over. The error the previous attempt failed with is named in the log
+// header of the attempt. The returned state is nil if the step could not be
+// started.
+func (r *Runtime) runStepAttempt(runnerCtx context.Context, step *backend_types.Step, attempt int, prevErr error) (*backend_types.State, error) {
 	logger := r.makeLogger()
 
-	waitForLogs, startTime, err := r.startStep(step)
+	stepCtx := r.ctx
+	if step.Timeout > 0 {
+		var cancel context.CancelFunc
+		stepCtx, cancel = context.WithTimeout(r.ctx, step.Timeout)
+		defer cancel()
+	}
+
+	var header string
+	if step.Retry != nil {
+		header = fmt.Sprintf("--- attempt %d of %d", attempt, step.Retry.Count+1)
+		if prevErr != nil {
+			header += fmt.Sprintf(", previous attempt failed: %s", stepFailureReason(prevErr))
+		}
+		header += " ---"
+	}
+
+	waitForLogs, startTime, err := r.startStep(stepCtx, step, header)
 	if err != nil {
-		// The step never ran — trace the start failure and surface it.
-		if r.cancelFallout(err) {
+		switch {
+		case r.cancelFallout(err):
 			err = r.cancelErr(err, step)
+		case r.timedOut(stepCtx):
+			err = &pipeline_errors.TimeoutError{UUID: step.UUID, Timeout: step.Timeout}
 		}
-		return r.traceStep(nil, err, step)
+		return nil, err
 	}
 
-	processState, err := r.completeStep(runnerCtx, step, waitForLogs, startTime)
-	logger.Debug().Str("step", step.Name).Msg("complete")
+	processState, err := r.completeStep(stepCtx, runnerCtx, step, waitForLogs, startTime)
+	logger.Debug().Str("step", step.Name).Int("attempt", attempt).Msg("complete")
 
 	if r.cancelFallout(err) {
 		err = r.cancelErr(err, step)
 	}
+	return processState, err
+}
 
-	err = r.traceStep(processState, err, step)
-	if err != nil && metadata.Failure(step.Failure) == metadata.FailureIgnore {
-		return nil
+// retryStep reports whether a step that completed its attempt is run again. It
+// waits for the retry delay of the step before it returns true.
+func (r *Runtime) retryStep(step *backend_types.Step, attempt int, err error) bool {
+	if step.Retry == nil || attempt > step.Retry.Count || !pipeline_errors.IsStepFailure(err) || r.canceled() {
+		return false
+	}
+
+	// a timeout or oom kill has no meaningful exit code to filter on
+	if len(step.Retry.OnExitCodes) > 0 {
+		var exitErr *pipeline_errors.ExitError
+		if !errors.As(err, &exitErr) || !slices.Contains(step.Retry.OnExitCodes, exitErr.Code) {
+			return false
+		}
+	}
+
+	logger := r.makeLogger()
+	logger.Debug().Err(err).Str("step", step.Name).Msgf("attempt %d failed, retrying in %s", attempt, step.Retry.Delay)
+
+	select {
+	case <-r.ctx.Done():
+		return false
+	case <-time.After(step.Retry.Delay):
+		return true
+	}
+}
+
+// stepFailureReason describes why a step failed in the words used for the log
+// header of the next attempt.
+func stepFailureReason(err error) string {
+	var exitErr *pipeline_errors.ExitError
+	var oomErr *pipeline_errors.OomError
+	var timeoutErr *pipeline_errors.TimeoutError
+	switch {
+	case errors.As(err, &exitErr):
+		return fmt.Sprintf("exit code %d", exitErr.Code)
+	case errors.As(err, &oomErr):
+		return "out of memory"
+	case errors.As(err, &timeoutErr):
+		return fmt.Sprintf("timed out after %s", timeoutErr.Timeout)
+	default:
+		return err.Error()
 	}
-	return err
 }
 
 // runDetachedStep starts the step and returns as soon as the container is running
@@ -249,7 +362,7 @@ func (r *Runtime) runBlockingStep(runnerCtx context.Context, step *backend_types
 // Any error that occurs after setup is logged but not propagated — it cannot
 // influence the pipeline outcome at that point.
 func (r *Runtime) runDetachedStep(runnerCtx context.Context, step *backend_types.Step) error {
-	waitForLogs, startTime, err := r.startStep(step)
+	waitForLogs, startTime, err := r.startStep(r.ctx, step, "")
 	if err != nil {
 		// Setup failed before the container was running — treat it like a
 		// blocking failure so the pipeline is aware.
@@ -266,7 +379,7 @@ func (r *Runtime) runDetachedStep(runnerCtx context.Context, step *backend_types
 
 		logger := r.makeLogger()
 
-		processState, err := r.completeStep(runnerCtx, step, waitForLogs, startTime)
+		processState, err := r.completeStep(r.ctx, runnerCtx, step, waitForLogs, startTime)
 		logger.Debug().Str("step", step.Name).Msg("complete")
 
 		if r.cancelFallout(err) {
@@ -283,6 +396,13 @@ func (r *Runtime) runDetachedStep(runnerCtx context.Context, step *backend_types
 	return nil
 }
 
+// headerReadCloser reads a header before the log stream of a step and closes
+// the log stream.
+type headerReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
 // traceStep reports the current state of a step to the tracer.
 //
 //   - processState == nil, err == nil  →  step is being marked as started
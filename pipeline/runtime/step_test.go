@@ -21,6 +21,7 @@ import (
 	"errors"
 	"io"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -202,7 +203,7 @@ func TestStartStep(t *testing.T) {
 		r := newDummyRuntime(t, newTestTracer(t))
 		step := dummyStep("s1")
 
-		waitForLogs, startTime, err := r.startStep(step)
+		waitForLogs, startTime, err := r.startStep(r.ctx, step, "")
 
 		assert.NoError(t, err)
 		assert.NotNil(t, waitForLogs)
@@ -216,7 +217,7 @@ func TestStartStep(t *testing.T) {
 		step := dummyStep("fail")
 		step.Environment[dummy.EnvKeyStepStartFail] = "true"
 
-		_, _, err := r.startStep(step)
+		_, _, err := r.startStep(r.ctx, step, "")
 
 		assert.Error(t, err)
 	})
@@ -228,7 +229,7 @@ func TestStartStep(t *testing.T) {
 		step.Environment[dummy.EnvKeyStepTailFail] = "true"
 		r.logger = logging.Logger(func(_ *backend_types.Step, _ io.ReadCloser) error { return nil })
 
-		_, _, err := r.startStep(step)
+		_, _, err := r.startStep(r.ctx, step, "")
 
 		assert.Error(t, err)
 	})
@@ -249,7 +250,7 @@ func TestStartStep(t *testing.T) {
 			})))
 		step := dummyStep("s1")
 
-		waitForLogs, _, err := r.startStep(step)
+		waitForLogs, _, err := r.startStep(r.ctx, step, "")
 		require.NoError(t, err)
 
 		waitForLogs()
@@ -274,7 +275,7 @@ func TestStartStep(t *testing.T) {
 			})),
 		)
 
-		waitForLogs, _, err := r.startStep(dummyStep("s1"))
+		waitForLogs, _, err := r.startStep(r.ctx, dummyStep("s1"), "")
 		require.NoError(t, err) // startStep itself succeeds
 
 		// waitForLogs blocks until the goroutine finishes; the branch is hit inside.
@@ -295,7 +296,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.NoError(t, err)
 		assert.True(t, ws.Exited)
@@ -310,7 +311,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		var exitErr *pipeline_errors.ExitError
 		assert.True(t, errors.As(err, &exitErr))
@@ -326,7 +327,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		var oomErr *pipeline_errors.OomError
 		assert.True(t, errors.As(err, &oomErr))
@@ -341,7 +342,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.NoError(t, err)
 		require.NotNil(t, ws, "nil guard must allocate a new State")
@@ -356,7 +357,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.NoError(t, err)
 		assert.Equal(t, pipeline_errors.ErrCancel, ws.Error)
@@ -370,7 +371,7 @@ func TestCompleteStep(t *testing.T) {
 		// DestroyStep should NOT be called — early return.
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.EqualError(t, err, "engine exploded")
 		assert.Nil(t, ws)
@@ -385,7 +386,7 @@ func TestCompleteStep(t *testing.T) {
 			Return(errors.New("cleanup failed"))
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.EqualError(t, err, "cleanup failed")
 		assert.Nil(t, ws)
@@ -399,7 +400,7 @@ func TestCompleteStep(t *testing.T) {
 		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		r := New(&backend_types.Config{}, engine, WithTracer(newTestTracer(t)), WithLogger(newTestLogger(t)))
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, 9999)
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, 9999)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int64(9999), ws.Started)
@@ -425,7 +426,7 @@ func TestCompleteStep(t *testing.T) {
 			WithContext(canceledCtx), // r.ctx is canceled
 		)
 
-		ws, err := r.completeStep(t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
+		ws, err := r.completeStep(r.ctx, t.Context(), dummyStep("s1"), func() {}, time.Now().Unix())
 
 		assert.NoError(t, err)
 		require.NotNil(t, ws)
@@ -567,6 +568,141 @@ func TestRunBlockingStep(t *testing.T) {
 
 		assert.ErrorIs(t, err, pipeline_errors.ErrCancel)
 	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		t.Parallel()
+		tracer := newTestTracer(t)
+		r := newDummyRuntime(t, tracer)
+		step := dummyStep("hang")
+		step.Environment[dummy.EnvKeyStepSleep] = "3m"
+		step.Timeout = 50 * time.Millisecond
+
+		err := r.runBlockingStep(t.Context(), step)
+
+		var timeoutErr *pipeline_errors.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+		assert.True(t, pipeline_errors.IsStepFailure(err), "a timeout is a step failure")
+
+		calls := getTracerStates(tracer)
+		require.Len(t, calls, 1)
+		assert.True(t, calls[0].CurrStepState.Exited)
+		assert.ErrorAs(t, calls[0].CurrStepState.Error, &timeoutErr)
+	})
+
+	t.Run("RetryUntilExhausted", func(t *testing.T) {
+		t.Parallel()
+		tracer := newTestTracer(t)
+		r := newDummyRuntime(t, tracer)
+		logs := captureLogs(r)
+		step := dummyStep("flaky")
+		step.Environment[dummy.EnvKeyStepExitCode] = "1"
+		step.Retry = &backend_types.StepRetry{Count: 2, Delay: time.Millisecond}
+
+		err := r.runBlockingStep(t.Context(), step)
+
+		var exitErr *pipeline_errors.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 1, exitErr.Code)
+		assert.Len(t, getTracerStates(tracer), 1, "only the last attempt is traced")
+		assert.Equal(t, []string{
+			"--- attempt 1 of 3 ---",
+			"--- attempt 2 of 3, previous attempt failed: exit code 1 ---",
+			"--- attempt 3 of 3, previous attempt failed: exit code 1 ---",
+		}, logs.headers())
+	})
+
+	t.Run("RetrySucceeds", func(t *testing.T) {
+		t.Parallel()
+		engine := mocks.NewMockBackend(t)
+		engine.On("StartStep", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		engine.On("TailStep", mock.Anything, mock.Anything, mock.Anything).
+			Return(func(context.Context, *backend_types.Step, string) (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("")), nil
+			}).Twice()
+		engine.On("WaitStep", mock.Anything, mock.Anything, mock.Anything).
+			Return(&backend_types.State{Exited: true, ExitCode: 1}, nil).Once()
+		engine.On("WaitStep", mock.Anything, mock.Anything, mock.Anything).
+			Return(&backend_types.State{Exited: true, ExitCode: 0}, nil).Once()
+		engine.On("DestroyStep", mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		tracer := newTestTracer(t)
+		r := New(&backend_types.Config{}, engine, WithTracer(tracer), WithLogger(newTestLogger(t)))
+		step := dummyStep("flaky")
+		step.Retry = &backend_types.StepRetry{Count: 2, OnExitCodes: []int{1}}
+
+		err := r.runBlockingStep(t.Context(), step)
+
+		assert.NoError(t, err)
+		calls := getTracerStates(tracer)
+		require.Len(t, calls, 1)
+		assert.Equal(t, 0, calls[0].CurrStepState.ExitCode)
+	})
+
+	t.Run("NoRetryOnOtherExitCode", func(t *testing.T) {
+		t.Parallel()
+		r := newDummyRuntime(t, newTestTracer(t))
+		logs := captureLogs(r)
+		step := dummyStep("broken")
+		step.Environment[dummy.EnvKeyStepExitCode] = "2"
+		step.Retry = &backend_types.StepRetry{Count: 2, OnExitCodes: []int{1}}
+
+		err := r.runBlockingStep(t.Context(), step)
+
+		var exitErr *pipeline_errors.ExitError
+		require.ErrorAs(t, err, &exitErr)
+		assert.Equal(t, 2, exitErr.Code)
+		assert.Equal(t, []string{"--- attempt 1 of 3 ---"}, logs.headers())
+	})
+
+	t.Run("RetryTimeout", func(t *testing.T) {
+		t.Parallel()
+		r := newDummyRuntime(t, newTestTracer(t))
+		logs := captureLogs(r)
+		step := dummyStep("hang")
+		step.Environment[dummy.EnvKeyStepSleep] = "3m"
+		step.Timeout = 20 * time.Millisecond
+		step.Retry = &backend_types.StepRetry{Count: 1}
+
+		err := r.runBlockingStep(t.Context(), step)
+
+		var timeoutErr *pipeline_errors.TimeoutError
+		require.ErrorAs(t, err, &timeoutErr)
+		assert.Equal(t, []string{
+			"--- attempt 1 of 2 ---",
+			"--- attempt 2 of 2, previous attempt failed: timed out after 20ms ---",
+		}, logs.headers())
+	})
+}
+
+// capturedLogs collects the log lines of all steps of a runtime.
+type capturedLogs struct {
+	sync.Mutex
+	lines []string
+}
+
+// headers returns the attempt headers among the captured lines.
+func (c *capturedLogs) headers() []string {
+	c.Lock()
+	defer c.Unlock()
+	var headers []string
+	for _, line := range c.lines {
+		if strings.HasPrefix(line, "--- attempt") {
+			headers = append(headers, line)
+		}
+	}
+	return headers
+}
+
+func captureLogs(r *Runtime) *capturedLogs {
+	logs := new(capturedLogs)
+	r.logger = func(_ *backend_types.Step, rc io.ReadCloser) error {
+		data, _ := io.ReadAll(rc)
+		logs.Lock()
+		logs.lines = append(logs.lines, strings.Split(strings.TrimSpace(string(data)), "\n")...)
+		logs.Unlock()
+		return rc.Close()
+	}
+	return logs
 }
 
 func TestRunDetachedStep(t *testing.T) {
@@ -57,8 +57,12 @@ func (r *Runtime) Run(runnerCtx context.Context) error {
 	r.started = time.Now().Unix()
 
 	if err := r.engine.SetupWorkflow(r.ctx, r.spec, r.taskUUID); err != nil { //nolint:contextcheck
-		r.traceWorkflowSetupError(err)
-		return err
+		var invalidErr *pipeline_errors.ErrInvalidWorkflowSetup
+		if errors.As(err, &invalidErr) {
+			r.traceWorkflowSetupError(err)
+			return err
+		}
+		return &pipeline_errors.SetupError{Err: err}
 	}
 
 	for _, stage := range r.spec.Stages {
@@ -166,7 +166,8 @@ func TestRunSetupWorkflowError(t *testing.T) {
 
 	err := r.Run(t.Context())
 
-	assert.Error(t, err)
+	var setupErr *pipeline_errors.SetupError
+	assert.ErrorAs(t, err, &setupErr)
 }
 
 func TestRunSetupWorkflowInvalidSetupError(t *testing.T) {
@@ -186,6 +187,8 @@ func TestRunSetupWorkflowInvalidSetupError(t *testing.T) {
 	err := r.Run(t.Context())
 
 	assert.Error(t, err)
+	var backendErr *pipeline_errors.SetupError
+	assert.False(t, errors.As(err, &backendErr), "invalid setups are no backend failures")
 	calls := getTracerStates(tracer)
 	require.Len(t, calls, 1)
 	assert.Equal(t, step, calls[0].CurrStep)
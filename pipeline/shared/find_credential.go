@@ -0,0 +1,96 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// credentialPatterns match credentials of well known services, named by the
+// kind of credential.
+var credentialPatterns = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)},
+	{"AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"Stripe key", regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}\b`)},
+	{"JSON web token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+}
+
+var (
+	// tokenPattern matches words which may be random tokens, e.g. base64 or
+	// base62 encoded keys.
+	tokenPattern = regexp.MustCompile(`[A-Za-z0-9+/_=-]{24,}`)
+	// digestPattern matches hashes printed by package managers and container
+	// tools, which look random but are no secrets.
+	digestPattern = regexp.MustCompile(`(?i)sha(1|256|384|512)[-:][A-Za-z0-9+/=]+`)
+)
+
+// minTokenEntropy is the share of the highest possible Shannon entropy of a
+// token's characters that makes it look random.
+const minTokenEntropy = 0.85
+
+// FindCredential returns the kind of credential a log line seems to contain.
+// Besides credentials of well known services it reports tokens which look
+// random, so it may report false positives and is meant for warnings only.
+// Masked secrets are not reported.
+func FindCredential(line string) (kind string, found bool) {
+	for _, credential := range credentialPatterns {
+		if credential.pattern.MatchString(line) {
+			return credential.name, true
+		}
+	}
+
+	for _, token := range tokenPattern.FindAllString(digestPattern.ReplaceAllString(line, ""), -1) {
+		if isRandomToken(token) {
+			return "high entropy string", true
+		}
+	}
+	return "", false
+}
+
+// isRandomToken tells whether a token mixes upper and lower case letters and
+// digits with about as much entropy as a random one of its length. Hex
+// encoded hashes and UUIDs stay below as they use 16 characters only.
+func isRandomToken(token string) bool {
+	if !strings.ContainsFunc(token, unicode.IsUpper) ||
+		!strings.ContainsFunc(token, unicode.IsLower) ||
+		!strings.ContainsFunc(token, unicode.IsDigit) {
+		return false
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range token {
+		counts[r]++
+	}
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(len(token))
+		entropy -= p * math.Log2(p)
+	}
+
+	// a token can not use more distinct characters than it is long, and base64
+	// has 64 of them
+	maxEntropy := math.Log2(float64(min(len(token), 64))) //nolint:mnd
+	return entropy >= minTokenEntropy*maxEntropy
+}
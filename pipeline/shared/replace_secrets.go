@@ -14,34 +14,144 @@
 
 package shared
 
-import "strings"
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Strings shorter than minStringLength are not considered secrets.
+// Do not sanitize them.
+const minStringLength = 3
+
+// Parts of base64 encoded secrets shorter than minBase64Length, e.g. the
+// end of a wrapped encoding, are too likely to appear in other text.
+const minBase64Length = 8
+
+// base64LineLengths are the lengths common tools wrap base64 output at, e.g.
+// base64 (76) and openssl or PEM files (64).
+var base64LineLengths = []int{64, 76}
 
 // NewSecretsReplacer creates a new strings.Replacer to replace sensitive
 // strings with asterisks. It takes a slice of secrets strings as input
 // and returns a populated strings.Replacer that will replace those
 // secrets with asterisks. Each secret string is split on newlines to
 // handle multi-line secrets.
+//
+// Besides the secrets themselves, their common encodings are replaced too:
+// base64 (also when embedded in a longer encoded text or wrapped over lines),
+// URL encoding and JSON string escaping.
 func NewSecretsReplacer(secrets []string) *strings.Replacer {
-	var oldNew []string
-
-	// Strings shorter than minStringLength are not considered secrets.
-	// Do not sanitize them.
-	const minStringLength = 3
+	var olds []string
+	add := func(old string) {
+		if len(old) > 0 && !slices.Contains(olds, old) {
+			olds = append(olds, old)
+		}
+	}
 
-	for _, old := range secrets {
-		old = strings.TrimSpace(old)
-		if len(old) <= minStringLength {
+	for _, secret := range secrets {
+		secret = strings.TrimSpace(secret)
+		if len(secret) <= minStringLength {
 			continue
 		}
 		// since replacer is executed on each line we have to split multi-line-secrets
-		for _, part := range strings.Split(old, "\n") {
-			if len(part) == 0 {
-				continue
+		for _, part := range strings.Split(secret, "\n") {
+			add(strings.TrimSuffix(part, "\r"))
+		}
+		for _, encoded := range encodedSecret(secret) {
+			if len(encoded) > minStringLength {
+				add(encoded)
 			}
-			oldNew = append(oldNew, part)
-			oldNew = append(oldNew, "********")
 		}
 	}
 
+	// the replacer tries the olds in order at each position, so a secret being
+	// the prefix of another one must not hide the longer one
+	slices.SortStableFunc(olds, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	oldNew := make([]string, 0, 2*len(olds)) //nolint:mnd
+	for _, old := range olds {
+		oldNew = append(oldNew, old, "********")
+	}
 	return strings.NewReplacer(oldNew...)
 }
+
+// encodedSecret returns the common encodings of a secret, split into the
+// lines they are printed on.
+func encodedSecret(secret string) []string {
+	var encoded []string
+
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding} {
+		var parts []string
+		// the encoding of a secret depends on its offset in the encoded text
+		for offset := range 3 { //nolint:mnd
+			parts = append(parts, base64Fragment(encoding, secret, offset))
+		}
+
+		// wrapped output of e.g. `echo $SECRET | base64`
+		for _, text := range []string{secret, secret + "\n"} {
+			full := encoding.EncodeToString([]byte(text))
+			for _, length := range base64LineLengths {
+				if len(full) > length {
+					parts = append(parts, chunks(full, length)...)
+				}
+			}
+		}
+
+		for _, part := range parts {
+			if len(part) >= minBase64Length {
+				encoded = append(encoded, part)
+			}
+		}
+	}
+
+	encoded = append(encoded, url.QueryEscape(secret), url.PathEscape(secret))
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(secret); err == nil {
+		escaped := strings.TrimSuffix(b.String(), "\n")
+		encoded = append(encoded, escaped[1:len(escaped)-1])
+	}
+	if escaped, err := json.Marshal(secret); err == nil {
+		encoded = append(encoded, string(escaped[1:len(escaped)-1]))
+	}
+
+	return slices.DeleteFunc(encoded, func(e string) bool {
+		return e == secret
+	})
+}
+
+// base64Fragment returns the part of the base64 encoding of a text that only
+// depends on the secret, when the secret starts at the given byte offset of
+// the text.
+func base64Fragment(encoding *base64.Encoding, secret string, offset int) string {
+	full := encoding.EncodeToString(append(make([]byte, offset), secret...))
+
+	// each 3 bytes encode to 4 characters, skip the group holding the bytes
+	// before the secret and the last one if it holds bytes after it
+	start := 0
+	if offset > 0 {
+		start = 4 //nolint:mnd
+	}
+	end := (offset + len(secret)) / 3 * 4 //nolint:mnd
+	if end <= start {
+		return ""
+	}
+	return full[start:end]
+}
+
+func chunks(s string, length int) []string {
+	var parts []string
+	for len(s) > length {
+		parts = append(parts, s[:length])
+		s = s[length:]
+	}
+	return append(parts, s)
+}
@@ -37,6 +37,28 @@ type LogEntry struct {
 	Data     []byte `json:"data,omitempty"`
 }
 
+// LogCommandEvent is the event of a command a metadata entry records.
+type LogCommandEvent string
+
+const (
+	LogCommandStart LogCommandEvent = "start"
+	LogCommandEnd   LogCommandEvent = "end"
+)
+
+// LogCommand is the data of a LogEntryMetadata entry, it marks where the
+// output of a command of a step starts or ends.
+type LogCommand struct {
+	Event LogCommandEvent `json:"event"`
+	// Index of the command in the commands of the step.
+	Index int `json:"index"`
+	// Started is the unix time in milliseconds the command started.
+	Started int64 `json:"started"`
+	// Duration in milliseconds, only set for the end event.
+	Duration int64 `json:"duration,omitempty"`
+	// ExitCode of the command, only set for the end event and if known.
+	ExitCode *int `json:"exit_code,omitempty"`
+}
+
 func (l *LogEntry) String() string {
 	switch l.Type {
 	case LogEntryExitCode:
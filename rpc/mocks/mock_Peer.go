@@ -440,16 +440,16 @@ func (_c *MockPeer_RegisterAgent_Call) RunAndReturn(run func(ctx context.Context
 }
 
 // ReportHealth provides a mock function for the type MockPeer
-func (_mock *MockPeer) ReportHealth(c context.Context) error {
-	ret := _mock.Called(c)
+func (_mock *MockPeer) ReportHealth(c context.Context, resources rpc.Resources) error {
+	ret := _mock.Called(c, resources)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReportHealth")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = returnFunc(c)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, rpc.Resources) error); ok {
+		r0 = returnFunc(c, resources)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -463,18 +463,24 @@ type MockPeer_ReportHealth_Call struct {
 
 // ReportHealth is a helper method to define mock.On call
 //   - c context.Context
-func (_e *MockPeer_Expecter) ReportHealth(c any) *MockPeer_ReportHealth_Call {
-	return &MockPeer_ReportHealth_Call{Call: _e.mock.On("ReportHealth", c)}
+//   - resources rpc.Resources
+func (_e *MockPeer_Expecter) ReportHealth(c any, resources any) *MockPeer_ReportHealth_Call {
+	return &MockPeer_ReportHealth_Call{Call: _e.mock.On("ReportHealth", c, resources)}
 }
 
-func (_c *MockPeer_ReportHealth_Call) Run(run func(c context.Context)) *MockPeer_ReportHealth_Call {
+func (_c *MockPeer_ReportHealth_Call) Run(run func(c context.Context, resources rpc.Resources)) *MockPeer_ReportHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 rpc.Resources
+		if args[1] != nil {
+			arg1 = args[1].(rpc.Resources)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -485,7 +491,7 @@ func (_c *MockPeer_ReportHealth_Call) Return(err error) *MockPeer_ReportHealth_C
 	return _c
 }
 
-func (_c *MockPeer_ReportHealth_Call) RunAndReturn(run func(c context.Context) error) *MockPeer_ReportHealth_Call {
+func (_c *MockPeer_ReportHealth_Call) RunAndReturn(run func(c context.Context, resources rpc.Resources) error) *MockPeer_ReportHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -250,6 +250,7 @@ type Peer interface {
 	//   - Backend: Execution backend (e.g., "docker", "kubernetes")
 	//   - Capacity: Maximum concurrent workflows (e.g., 2)
 	//   - CustomLabels: Additional key-value labels for filtering
+	//   - Resources: CPU, memory and disk the agent can allocate for workflows
 	//
 	// Context Handling:
 	//   - Context cancellation indicates agent is aborting startup
@@ -298,10 +299,13 @@ type Peer interface {
 	//   - Display accurate agent status in UI
 	//   - Trigger alerts for infrastructure issues
 	//
+	// The resources are the ones the agent can currently allocate for
+	// workflows, they replace the ones given to RegisterAgent().
+	//
 	// Returns:
 	//   - nil on success
 	//   - error if communication fails
-	ReportHealth(c context.Context) error
+	ReportHealth(c context.Context, resources Resources) error
 
 	// IsConnected returns true if the gRPC connection to the server is in Ready state.
 	//
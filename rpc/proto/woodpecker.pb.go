@@ -142,6 +142,7 @@ type WorkflowState struct {
 	Finished      int64                  `protobuf:"varint,2,opt,name=finished,proto3" json:"finished,omitempty"`
 	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	Canceled      bool                   `protobuf:"varint,4,opt,name=canceled,proto3" json:"canceled,omitempty"`
+	Failure       string                 `protobuf:"bytes,5,opt,name=failure,proto3" json:"failure,omitempty"` // setup_error or oom, empty for any other failure
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,6 +205,13 @@ func (x *WorkflowState) GetCanceled() bool {
 	return false
 }
 
+func (x *WorkflowState) GetFailure() string {
+	if x != nil {
+		return x.Failure
+	}
+	return ""
+}
+
 type LogEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StepUuid      string                 `protobuf:"bytes,1,opt,name=step_uuid,json=stepUuid,proto3" json:"step_uuid,omitempty"`
@@ -329,6 +337,7 @@ type Workflow struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Timeout       int64                  `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	ArtifactToken string                 `protobuf:"bytes,4,opt,name=artifact_token,json=artifactToken,proto3" json:"artifact_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -384,6 +393,73 @@ func (x *Workflow) GetPayload() []byte {
 	return nil
 }
 
+func (x *Workflow) GetArtifactToken() string {
+	if x != nil {
+		return x.ArtifactToken
+	}
+	return ""
+}
+
+type Resources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpu           int64                  `protobuf:"varint,1,opt,name=cpu,proto3" json:"cpu,omitempty"`       // in millicores
+	Memory        int64                  `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"` // in bytes
+	Disk          int64                  `protobuf:"varint,3,opt,name=disk,proto3" json:"disk,omitempty"`     // in bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resources) Reset() {
+	*x = Resources{}
+	mi := &file_woodpecker_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resources) ProtoMessage() {}
+
+func (x *Resources) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resources.ProtoReflect.Descriptor instead.
+func (*Resources) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Resources) GetCpu() int64 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *Resources) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *Resources) GetDisk() int64 {
+	if x != nil {
+		return x.Disk
+	}
+	return 0
+}
+
 type NextRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filter        *Filter                `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
@@ -393,7 +469,7 @@ type NextRequest struct {
 
 func (x *NextRequest) Reset() {
 	*x = NextRequest{}
-	mi := &file_woodpecker_proto_msgTypes[5]
+	mi := &file_woodpecker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +481,7 @@ func (x *NextRequest) String() string {
 func (*NextRequest) ProtoMessage() {}
 
 func (x *NextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[5]
+	mi := &file_woodpecker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +494,7 @@ func (x *NextRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextRequest.ProtoReflect.Descriptor instead.
 func (*NextRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{5}
+	return file_woodpecker_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NextRequest) GetFilter() *Filter {
@@ -438,7 +514,7 @@ type InitRequest struct {
 
 func (x *InitRequest) Reset() {
 	*x = InitRequest{}
-	mi := &file_woodpecker_proto_msgTypes[6]
+	mi := &file_woodpecker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +526,7 @@ func (x *InitRequest) String() string {
 func (*InitRequest) ProtoMessage() {}
 
 func (x *InitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[6]
+	mi := &file_woodpecker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +539,7 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
 func (*InitRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{6}
+	return file_woodpecker_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InitRequest) GetId() string {
@@ -489,7 +565,7 @@ type WaitRequest struct {
 
 func (x *WaitRequest) Reset() {
 	*x = WaitRequest{}
-	mi := &file_woodpecker_proto_msgTypes[7]
+	mi := &file_woodpecker_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +577,7 @@ func (x *WaitRequest) String() string {
 func (*WaitRequest) ProtoMessage() {}
 
 func (x *WaitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[7]
+	mi := &file_woodpecker_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +590,7 @@ func (x *WaitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitRequest.ProtoReflect.Descriptor instead.
 func (*WaitRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{7}
+	return file_woodpecker_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WaitRequest) GetId() string {
@@ -534,7 +610,7 @@ type DoneRequest struct {
 
 func (x *DoneRequest) Reset() {
 	*x = DoneRequest{}
-	mi := &file_woodpecker_proto_msgTypes[8]
+	mi := &file_woodpecker_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -546,7 +622,7 @@ func (x *DoneRequest) String() string {
 func (*DoneRequest) ProtoMessage() {}
 
 func (x *DoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[8]
+	mi := &file_woodpecker_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,7 +635,7 @@ func (x *DoneRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoneRequest.ProtoReflect.Descriptor instead.
 func (*DoneRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{8}
+	return file_woodpecker_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DoneRequest) GetId() string {
@@ -585,7 +661,7 @@ type ExtendRequest struct {
 
 func (x *ExtendRequest) Reset() {
 	*x = ExtendRequest{}
-	mi := &file_woodpecker_proto_msgTypes[9]
+	mi := &file_woodpecker_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +673,7 @@ func (x *ExtendRequest) String() string {
 func (*ExtendRequest) ProtoMessage() {}
 
 func (x *ExtendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[9]
+	mi := &file_woodpecker_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +686,7 @@ func (x *ExtendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendRequest.ProtoReflect.Descriptor instead.
 func (*ExtendRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{9}
+	return file_woodpecker_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExtendRequest) GetId() string {
@@ -630,7 +706,7 @@ type UpdateRequest struct {
 
 func (x *UpdateRequest) Reset() {
 	*x = UpdateRequest{}
-	mi := &file_woodpecker_proto_msgTypes[10]
+	mi := &file_woodpecker_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +718,7 @@ func (x *UpdateRequest) String() string {
 func (*UpdateRequest) ProtoMessage() {}
 
 func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[10]
+	mi := &file_woodpecker_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +731,7 @@ func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{10}
+	return file_woodpecker_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateRequest) GetId() string {
@@ -681,7 +757,7 @@ type LogRequest struct {
 
 func (x *LogRequest) Reset() {
 	*x = LogRequest{}
-	mi := &file_woodpecker_proto_msgTypes[11]
+	mi := &file_woodpecker_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -693,7 +769,7 @@ func (x *LogRequest) String() string {
 func (*LogRequest) ProtoMessage() {}
 
 func (x *LogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[11]
+	mi := &file_woodpecker_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +782,7 @@ func (x *LogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
 func (*LogRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{11}
+	return file_woodpecker_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LogRequest) GetLogEntries() []*LogEntry {
@@ -724,7 +800,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_woodpecker_proto_msgTypes[12]
+	mi := &file_woodpecker_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -736,7 +812,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[12]
+	mi := &file_woodpecker_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,19 +825,20 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{12}
+	return file_woodpecker_proto_rawDescGZIP(), []int{13}
 }
 
 type ReportHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Resources     *Resources             `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReportHealthRequest) Reset() {
 	*x = ReportHealthRequest{}
-	mi := &file_woodpecker_proto_msgTypes[13]
+	mi := &file_woodpecker_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +850,7 @@ func (x *ReportHealthRequest) String() string {
 func (*ReportHealthRequest) ProtoMessage() {}
 
 func (x *ReportHealthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[13]
+	mi := &file_woodpecker_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +863,7 @@ func (x *ReportHealthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportHealthRequest.ProtoReflect.Descriptor instead.
 func (*ReportHealthRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{13}
+	return file_woodpecker_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReportHealthRequest) GetStatus() string {
@@ -796,6 +873,13 @@ func (x *ReportHealthRequest) GetStatus() string {
 	return ""
 }
 
+func (x *ReportHealthRequest) GetResources() *Resources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 type AgentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
@@ -803,13 +887,14 @@ type AgentInfo struct {
 	Backend       string                 `protobuf:"bytes,3,opt,name=backend,proto3" json:"backend,omitempty"`
 	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	CustomLabels  map[string]string      `protobuf:"bytes,5,rep,name=customLabels,proto3" json:"customLabels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Resources     *Resources             `protobuf:"bytes,6,opt,name=resources,proto3" json:"resources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AgentInfo) Reset() {
 	*x = AgentInfo{}
-	mi := &file_woodpecker_proto_msgTypes[14]
+	mi := &file_woodpecker_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -821,7 +906,7 @@ func (x *AgentInfo) String() string {
 func (*AgentInfo) ProtoMessage() {}
 
 func (x *AgentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[14]
+	mi := &file_woodpecker_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +919,7 @@ func (x *AgentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
 func (*AgentInfo) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{14}
+	return file_woodpecker_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AgentInfo) GetPlatform() string {
@@ -872,6 +957,13 @@ func (x *AgentInfo) GetCustomLabels() map[string]string {
 	return nil
 }
 
+func (x *AgentInfo) GetResources() *Resources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
 type RegisterAgentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Info          *AgentInfo             `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
@@ -881,7 +973,7 @@ type RegisterAgentRequest struct {
 
 func (x *RegisterAgentRequest) Reset() {
 	*x = RegisterAgentRequest{}
-	mi := &file_woodpecker_proto_msgTypes[15]
+	mi := &file_woodpecker_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +985,7 @@ func (x *RegisterAgentRequest) String() string {
 func (*RegisterAgentRequest) ProtoMessage() {}
 
 func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[15]
+	mi := &file_woodpecker_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +998,7 @@ func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
 func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{15}
+	return file_woodpecker_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RegisterAgentRequest) GetInfo() *AgentInfo {
@@ -916,6 +1008,214 @@ func (x *RegisterAgentRequest) GetInfo() *AgentInfo {
 	return nil
 }
 
+type UploadArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // only set in the first message
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadArtifactRequest) Reset() {
+	*x = UploadArtifactRequest{}
+	mi := &file_woodpecker_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadArtifactRequest) ProtoMessage() {}
+
+func (x *UploadArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadArtifactRequest.ProtoReflect.Descriptor instead.
+func (*UploadArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UploadArtifactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadArtifactRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DownloadArtifactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      string                 `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadArtifactRequest) Reset() {
+	*x = DownloadArtifactRequest{}
+	mi := &file_woodpecker_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArtifactRequest) ProtoMessage() {}
+
+func (x *DownloadArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArtifactRequest.ProtoReflect.Descriptor instead.
+func (*DownloadArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DownloadArtifactRequest) GetWorkflow() string {
+	if x != nil {
+		return x.Workflow
+	}
+	return ""
+}
+
+type UploadReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Files         []*ReportFile          `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadReportRequest) Reset() {
+	*x = UploadReportRequest{}
+	mi := &file_woodpecker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadReportRequest) ProtoMessage() {}
+
+func (x *UploadReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadReportRequest.ProtoReflect.Descriptor instead.
+func (*UploadReportRequest) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UploadReportRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *UploadReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *UploadReportRequest) GetFiles() []*ReportFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ReportFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // path relative to the workspace
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportFile) Reset() {
+	*x = ReportFile{}
+	mi := &file_woodpecker_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportFile) ProtoMessage() {}
+
+func (x *ReportFile) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportFile.ProtoReflect.Descriptor instead.
+func (*ReportFile) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReportFile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReportFile) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type VersionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GrpcVersion   int32                  `protobuf:"varint,1,opt,name=grpc_version,json=grpcVersion,proto3" json:"grpc_version,omitempty"`
@@ -926,7 +1226,7 @@ type VersionResponse struct {
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
-	mi := &file_woodpecker_proto_msgTypes[16]
+	mi := &file_woodpecker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -938,7 +1238,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[16]
+	mi := &file_woodpecker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,7 +1251,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{16}
+	return file_woodpecker_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *VersionResponse) GetGrpcVersion() int32 {
@@ -977,7 +1277,7 @@ type NextResponse struct {
 
 func (x *NextResponse) Reset() {
 	*x = NextResponse{}
-	mi := &file_woodpecker_proto_msgTypes[17]
+	mi := &file_woodpecker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -989,7 +1289,7 @@ func (x *NextResponse) String() string {
 func (*NextResponse) ProtoMessage() {}
 
 func (x *NextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[17]
+	mi := &file_woodpecker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,7 +1302,7 @@ func (x *NextResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextResponse.ProtoReflect.Descriptor instead.
 func (*NextResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{17}
+	return file_woodpecker_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *NextResponse) GetWorkflow() *Workflow {
@@ -1021,7 +1321,7 @@ type RegisterAgentResponse struct {
 
 func (x *RegisterAgentResponse) Reset() {
 	*x = RegisterAgentResponse{}
-	mi := &file_woodpecker_proto_msgTypes[18]
+	mi := &file_woodpecker_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1333,7 @@ func (x *RegisterAgentResponse) String() string {
 func (*RegisterAgentResponse) ProtoMessage() {}
 
 func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[18]
+	mi := &file_woodpecker_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1346,7 @@ func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
 func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{18}
+	return file_woodpecker_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RegisterAgentResponse) GetAgentId() int64 {
@@ -1065,7 +1365,7 @@ type WaitResponse struct {
 
 func (x *WaitResponse) Reset() {
 	*x = WaitResponse{}
-	mi := &file_woodpecker_proto_msgTypes[19]
+	mi := &file_woodpecker_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1077,7 +1377,7 @@ func (x *WaitResponse) String() string {
 func (*WaitResponse) ProtoMessage() {}
 
 func (x *WaitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[19]
+	mi := &file_woodpecker_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1390,7 @@ func (x *WaitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaitResponse.ProtoReflect.Descriptor instead.
 func (*WaitResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{19}
+	return file_woodpecker_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *WaitResponse) GetCanceled() bool {
@@ -1100,6 +1400,58 @@ func (x *WaitResponse) GetCanceled() bool {
 	return false
 }
 
+type ArtifactChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // set in the first chunk of each artifact
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtifactChunk) Reset() {
+	*x = ArtifactChunk{}
+	mi := &file_woodpecker_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactChunk) ProtoMessage() {}
+
+func (x *ArtifactChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_woodpecker_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactChunk.ProtoReflect.Descriptor instead.
+func (*ArtifactChunk) Descriptor() ([]byte, []int) {
+	return file_woodpecker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ArtifactChunk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtifactChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type AuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AgentToken    string                 `protobuf:"bytes,1,opt,name=agent_token,json=agentToken,proto3" json:"agent_token,omitempty"`
@@ -1110,7 +1462,7 @@ type AuthRequest struct {
 
 func (x *AuthRequest) Reset() {
 	*x = AuthRequest{}
-	mi := &file_woodpecker_proto_msgTypes[20]
+	mi := &file_woodpecker_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1474,7 @@ func (x *AuthRequest) String() string {
 func (*AuthRequest) ProtoMessage() {}
 
 func (x *AuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[20]
+	mi := &file_woodpecker_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1487,7 @@ func (x *AuthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
 func (*AuthRequest) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{20}
+	return file_woodpecker_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AuthRequest) GetAgentToken() string {
@@ -1163,7 +1515,7 @@ type AuthResponse struct {
 
 func (x *AuthResponse) Reset() {
 	*x = AuthResponse{}
-	mi := &file_woodpecker_proto_msgTypes[21]
+	mi := &file_woodpecker_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1527,7 @@ func (x *AuthResponse) String() string {
 func (*AuthResponse) ProtoMessage() {}
 
 func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_woodpecker_proto_msgTypes[21]
+	mi := &file_woodpecker_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1540,7 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
 func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_woodpecker_proto_rawDescGZIP(), []int{21}
+	return file_woodpecker_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *AuthResponse) GetStatus() string {
@@ -1225,12 +1577,13 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\texit_code\x18\x05 \x01(\x05R\bexitCode\x12\x14\n" +
 	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1a\n" +
 	"\bcanceled\x18\a \x01(\bR\bcanceled\x12\x18\n" +
-	"\askipped\x18\b \x01(\bR\askipped\"w\n" +
+	"\askipped\x18\b \x01(\bR\askipped\"\x91\x01\n" +
 	"\rWorkflowState\x12\x18\n" +
 	"\astarted\x18\x01 \x01(\x03R\astarted\x12\x1a\n" +
 	"\bfinished\x18\x02 \x01(\x03R\bfinished\x12\x14\n" +
 	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1a\n" +
-	"\bcanceled\x18\x04 \x01(\bR\bcanceled\"w\n" +
+	"\bcanceled\x18\x04 \x01(\bR\bcanceled\x12\x18\n" +
+	"\afailure\x18\x05 \x01(\tR\afailure\"w\n" +
 	"\bLogEntry\x12\x1b\n" +
 	"\tstep_uuid\x18\x01 \x01(\tR\bstepUuid\x12\x12\n" +
 	"\x04time\x18\x02 \x01(\x03R\x04time\x12\x12\n" +
@@ -1241,11 +1594,16 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\x06labels\x18\x01 \x03(\v2\x19.proto.Filter.LabelsEntryR\x06labels\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"u\n" +
 	"\bWorkflow\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\atimeout\x18\x02 \x01(\x03R\atimeout\x12\x18\n" +
-	"\apayload\x18\x03 \x01(\fR\apayload\"4\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\x12%\n" +
+	"\x0eartifact_token\x18\x04 \x01(\tR\rartifactToken\"I\n" +
+	"\tResources\x12\x10\n" +
+	"\x03cpu\x18\x01 \x01(\x03R\x03cpu\x12\x16\n" +
+	"\x06memory\x18\x02 \x01(\x03R\x06memory\x12\x12\n" +
+	"\x04disk\x18\x03 \x01(\x03R\x04disk\"4\n" +
 	"\vNextRequest\x12%\n" +
 	"\x06filter\x18\x01 \x01(\v2\r.proto.FilterR\x06filter\"I\n" +
 	"\vInitRequest\x12\x0e\n" +
@@ -1266,20 +1624,35 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\n" +
 	"logEntries\x18\x01 \x03(\v2\x0f.proto.LogEntryR\n" +
 	"logEntries\"\a\n" +
-	"\x05Empty\"-\n" +
+	"\x05Empty\"]\n" +
 	"\x13ReportHealthRequest\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\x80\x02\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12.\n" +
+	"\tresources\x18\x02 \x01(\v2\x10.proto.ResourcesR\tresources\"\xb0\x02\n" +
 	"\tAgentInfo\x12\x1a\n" +
 	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x1a\n" +
 	"\bcapacity\x18\x02 \x01(\x05R\bcapacity\x12\x18\n" +
 	"\abackend\x18\x03 \x01(\tR\abackend\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\tR\aversion\x12F\n" +
-	"\fcustomLabels\x18\x05 \x03(\v2\".proto.AgentInfo.CustomLabelsEntryR\fcustomLabels\x1a?\n" +
+	"\fcustomLabels\x18\x05 \x03(\v2\".proto.AgentInfo.CustomLabelsEntryR\fcustomLabels\x12.\n" +
+	"\tresources\x18\x06 \x01(\v2\x10.proto.ResourcesR\tresources\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"<\n" +
 	"\x14RegisterAgentRequest\x12$\n" +
-	"\x04info\x18\x01 \x01(\v2\x10.proto.AgentInfoR\x04info\"[\n" +
+	"\x04info\x18\x01 \x01(\v2\x10.proto.AgentInfoR\x04info\"?\n" +
+	"\x15UploadArtifactRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"5\n" +
+	"\x17DownloadArtifactRequest\x12\x1a\n" +
+	"\bworkflow\x18\x01 \x01(\tR\bworkflow\"j\n" +
+	"\x13UploadReportRequest\x12\x12\n" +
+	"\x04step\x18\x01 \x01(\tR\x04step\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12'\n" +
+	"\x05files\x18\x03 \x03(\v2\x11.proto.ReportFileR\x05files\"4\n" +
+	"\n" +
+	"ReportFile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"[\n" +
 	"\x0fVersionResponse\x12!\n" +
 	"\fgrpc_version\x18\x01 \x01(\x05R\vgrpcVersion\x12%\n" +
 	"\x0eserver_version\x18\x02 \x01(\tR\rserverVersion\";\n" +
@@ -1288,7 +1661,10 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\x15RegisterAgentResponse\x12\x19\n" +
 	"\bagent_id\x18\x01 \x01(\x03R\aagentId\"*\n" +
 	"\fWaitResponse\x12\x1a\n" +
-	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"I\n" +
+	"\bcanceled\x18\x01 \x01(\bR\bcanceled\"7\n" +
+	"\rArtifactChunk\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"I\n" +
 	"\vAuthRequest\x12\x1f\n" +
 	"\vagent_token\x18\x01 \x01(\tR\n" +
 	"agentToken\x12\x19\n" +
@@ -1296,7 +1672,7 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\fAuthResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x19\n" +
 	"\bagent_id\x18\x02 \x01(\x03R\aagentId\x12!\n" +
-	"\faccess_token\x18\x03 \x01(\tR\vaccessToken2\xc2\x04\n" +
+	"\faccess_token\x18\x03 \x01(\tR\vaccessToken2\x8e\x06\n" +
 	"\n" +
 	"Woodpecker\x121\n" +
 	"\aVersion\x12\f.proto.Empty\x1a\x16.proto.VersionResponse\"\x00\x121\n" +
@@ -1309,7 +1685,10 @@ const file_woodpecker_proto_rawDesc = "" +
 	"\x03Log\x12\x11.proto.LogRequest\x1a\f.proto.Empty\"\x00\x12L\n" +
 	"\rRegisterAgent\x12\x1b.proto.RegisterAgentRequest\x1a\x1c.proto.RegisterAgentResponse\"\x00\x12/\n" +
 	"\x0fUnregisterAgent\x12\f.proto.Empty\x1a\f.proto.Empty\"\x00\x12:\n" +
-	"\fReportHealth\x12\x1a.proto.ReportHealthRequest\x1a\f.proto.Empty\"\x002C\n" +
+	"\fReportHealth\x12\x1a.proto.ReportHealthRequest\x1a\f.proto.Empty\"\x00\x12@\n" +
+	"\x0eUploadArtifact\x12\x1c.proto.UploadArtifactRequest\x1a\f.proto.Empty\"\x00(\x01\x12L\n" +
+	"\x10DownloadArtifact\x12\x1e.proto.DownloadArtifactRequest\x1a\x14.proto.ArtifactChunk\"\x000\x01\x12:\n" +
+	"\fUploadReport\x12\x1a.proto.UploadReportRequest\x1a\f.proto.Empty\"\x002C\n" +
 	"\x0eWoodpeckerAuth\x121\n" +
 	"\x04Auth\x12\x12.proto.AuthRequest\x1a\x13.proto.AuthResponse\"\x00B.Z,go.woodpecker-ci.org/woodpecker/v3/rpc/protob\x06proto3"
 
@@ -1325,72 +1704,87 @@ func file_woodpecker_proto_rawDescGZIP() []byte {
 	return file_woodpecker_proto_rawDescData
 }
 
-var file_woodpecker_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_woodpecker_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_woodpecker_proto_goTypes = []any{
-	(*StepState)(nil),             // 0: proto.StepState
-	(*WorkflowState)(nil),         // 1: proto.WorkflowState
-	(*LogEntry)(nil),              // 2: proto.LogEntry
-	(*Filter)(nil),                // 3: proto.Filter
-	(*Workflow)(nil),              // 4: proto.Workflow
-	(*NextRequest)(nil),           // 5: proto.NextRequest
-	(*InitRequest)(nil),           // 6: proto.InitRequest
-	(*WaitRequest)(nil),           // 7: proto.WaitRequest
-	(*DoneRequest)(nil),           // 8: proto.DoneRequest
-	(*ExtendRequest)(nil),         // 9: proto.ExtendRequest
-	(*UpdateRequest)(nil),         // 10: proto.UpdateRequest
-	(*LogRequest)(nil),            // 11: proto.LogRequest
-	(*Empty)(nil),                 // 12: proto.Empty
-	(*ReportHealthRequest)(nil),   // 13: proto.ReportHealthRequest
-	(*AgentInfo)(nil),             // 14: proto.AgentInfo
-	(*RegisterAgentRequest)(nil),  // 15: proto.RegisterAgentRequest
-	(*VersionResponse)(nil),       // 16: proto.VersionResponse
-	(*NextResponse)(nil),          // 17: proto.NextResponse
-	(*RegisterAgentResponse)(nil), // 18: proto.RegisterAgentResponse
-	(*WaitResponse)(nil),          // 19: proto.WaitResponse
-	(*AuthRequest)(nil),           // 20: proto.AuthRequest
-	(*AuthResponse)(nil),          // 21: proto.AuthResponse
-	nil,                           // 22: proto.Filter.LabelsEntry
-	nil,                           // 23: proto.AgentInfo.CustomLabelsEntry
+	(*StepState)(nil),               // 0: proto.StepState
+	(*WorkflowState)(nil),           // 1: proto.WorkflowState
+	(*LogEntry)(nil),                // 2: proto.LogEntry
+	(*Filter)(nil),                  // 3: proto.Filter
+	(*Workflow)(nil),                // 4: proto.Workflow
+	(*Resources)(nil),               // 5: proto.Resources
+	(*NextRequest)(nil),             // 6: proto.NextRequest
+	(*InitRequest)(nil),             // 7: proto.InitRequest
+	(*WaitRequest)(nil),             // 8: proto.WaitRequest
+	(*DoneRequest)(nil),             // 9: proto.DoneRequest
+	(*ExtendRequest)(nil),           // 10: proto.ExtendRequest
+	(*UpdateRequest)(nil),           // 11: proto.UpdateRequest
+	(*LogRequest)(nil),              // 12: proto.LogRequest
+	(*Empty)(nil),                   // 13: proto.Empty
+	(*ReportHealthRequest)(nil),     // 14: proto.ReportHealthRequest
+	(*AgentInfo)(nil),               // 15: proto.AgentInfo
+	(*RegisterAgentRequest)(nil),    // 16: proto.RegisterAgentRequest
+	(*UploadArtifactRequest)(nil),   // 17: proto.UploadArtifactRequest
+	(*DownloadArtifactRequest)(nil), // 18: proto.DownloadArtifactRequest
+	(*UploadReportRequest)(nil),     // 19: proto.UploadReportRequest
+	(*ReportFile)(nil),              // 20: proto.ReportFile
+	(*VersionResponse)(nil),         // 21: proto.VersionResponse
+	(*NextResponse)(nil),            // 22: proto.NextResponse
+	(*RegisterAgentResponse)(nil),   // 23: proto.RegisterAgentResponse
+	(*WaitResponse)(nil),            // 24: proto.WaitResponse
+	(*ArtifactChunk)(nil),           // 25: proto.ArtifactChunk
+	(*AuthRequest)(nil),             // 26: proto.AuthRequest
+	(*AuthResponse)(nil),            // 27: proto.AuthResponse
+	nil,                             // 28: proto.Filter.LabelsEntry
+	nil,                             // 29: proto.AgentInfo.CustomLabelsEntry
 }
 var file_woodpecker_proto_depIdxs = []int32{
-	22, // 0: proto.Filter.labels:type_name -> proto.Filter.LabelsEntry
+	28, // 0: proto.Filter.labels:type_name -> proto.Filter.LabelsEntry
 	3,  // 1: proto.NextRequest.filter:type_name -> proto.Filter
 	1,  // 2: proto.InitRequest.state:type_name -> proto.WorkflowState
 	1,  // 3: proto.DoneRequest.state:type_name -> proto.WorkflowState
 	0,  // 4: proto.UpdateRequest.state:type_name -> proto.StepState
 	2,  // 5: proto.LogRequest.logEntries:type_name -> proto.LogEntry
-	23, // 6: proto.AgentInfo.customLabels:type_name -> proto.AgentInfo.CustomLabelsEntry
-	14, // 7: proto.RegisterAgentRequest.info:type_name -> proto.AgentInfo
-	4,  // 8: proto.NextResponse.workflow:type_name -> proto.Workflow
-	12, // 9: proto.Woodpecker.Version:input_type -> proto.Empty
-	5,  // 10: proto.Woodpecker.Next:input_type -> proto.NextRequest
-	6,  // 11: proto.Woodpecker.Init:input_type -> proto.InitRequest
-	7,  // 12: proto.Woodpecker.Wait:input_type -> proto.WaitRequest
-	8,  // 13: proto.Woodpecker.Done:input_type -> proto.DoneRequest
-	9,  // 14: proto.Woodpecker.Extend:input_type -> proto.ExtendRequest
-	10, // 15: proto.Woodpecker.Update:input_type -> proto.UpdateRequest
-	11, // 16: proto.Woodpecker.Log:input_type -> proto.LogRequest
-	15, // 17: proto.Woodpecker.RegisterAgent:input_type -> proto.RegisterAgentRequest
-	12, // 18: proto.Woodpecker.UnregisterAgent:input_type -> proto.Empty
-	13, // 19: proto.Woodpecker.ReportHealth:input_type -> proto.ReportHealthRequest
-	20, // 20: proto.WoodpeckerAuth.Auth:input_type -> proto.AuthRequest
-	16, // 21: proto.Woodpecker.Version:output_type -> proto.VersionResponse
-	17, // 22: proto.Woodpecker.Next:output_type -> proto.NextResponse
-	12, // 23: proto.Woodpecker.Init:output_type -> proto.Empty
-	19, // 24: proto.Woodpecker.Wait:output_type -> proto.WaitResponse
-	12, // 25: proto.Woodpecker.Done:output_type -> proto.Empty
-	12, // 26: proto.Woodpecker.Extend:output_type -> proto.Empty
-	12, // 27: proto.Woodpecker.Update:output_type -> proto.Empty
-	12, // 28: proto.Woodpecker.Log:output_type -> proto.Empty
-	18, // 29: proto.Woodpecker.RegisterAgent:output_type -> proto.RegisterAgentResponse
-	12, // 30: proto.Woodpecker.UnregisterAgent:output_type -> proto.Empty
-	12, // 31: proto.Woodpecker.ReportHealth:output_type -> proto.Empty
-	21, // 32: proto.WoodpeckerAuth.Auth:output_type -> proto.AuthResponse
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	5,  // 6: proto.ReportHealthRequest.resources:type_name -> proto.Resources
+	29, // 7: proto.AgentInfo.customLabels:type_name -> proto.AgentInfo.CustomLabelsEntry
+	5,  // 8: proto.AgentInfo.resources:type_name -> proto.Resources
+	15, // 9: proto.RegisterAgentRequest.info:type_name -> proto.AgentInfo
+	20, // 10: proto.UploadReportRequest.files:type_name -> proto.ReportFile
+	4,  // 11: proto.NextResponse.workflow:type_name -> proto.Workflow
+	13, // 12: proto.Woodpecker.Version:input_type -> proto.Empty
+	6,  // 13: proto.Woodpecker.Next:input_type -> proto.NextRequest
+	7,  // 14: proto.Woodpecker.Init:input_type -> proto.InitRequest
+	8,  // 15: proto.Woodpecker.Wait:input_type -> proto.WaitRequest
+	9,  // 16: proto.Woodpecker.Done:input_type -> proto.DoneRequest
+	10, // 17: proto.Woodpecker.Extend:input_type -> proto.ExtendRequest
+	11, // 18: proto.Woodpecker.Update:input_type -> proto.UpdateRequest
+	12, // 19: proto.Woodpecker.Log:input_type -> proto.LogRequest
+	16, // 20: proto.Woodpecker.RegisterAgent:input_type -> proto.RegisterAgentRequest
+	13, // 21: proto.Woodpecker.UnregisterAgent:input_type -> proto.Empty
+	14, // 22: proto.Woodpecker.ReportHealth:input_type -> proto.ReportHealthRequest
+	17, // 23: proto.Woodpecker.UploadArtifact:input_type -> proto.UploadArtifactRequest
+	18, // 24: proto.Woodpecker.DownloadArtifact:input_type -> proto.DownloadArtifactRequest
+	19, // 25: proto.Woodpecker.UploadReport:input_type -> proto.UploadReportRequest
+	26, // 26: proto.WoodpeckerAuth.Auth:input_type -> proto.AuthRequest
+	21, // 27: proto.Woodpecker.Version:output_type -> proto.VersionResponse
+	22, // 28: proto.Woodpecker.Next:output_type -> proto.NextResponse
+	13, // 29: proto.Woodpecker.Init:output_type -> proto.Empty
+	24, // 30: proto.Woodpecker.Wait:output_type -> proto.WaitResponse
+	13, // 31: proto.Woodpecker.Done:output_type -> proto.Empty
+	13, // 32: proto.Woodpecker.Extend:output_type -> proto.Empty
+	13, // 33: proto.Woodpecker.Update:output_type -> proto.Empty
+	13, // 34: proto.Woodpecker.Log:output_type -> proto.Empty
+	23, // 35: proto.Woodpecker.RegisterAgent:output_type -> proto.RegisterAgentResponse
+	13, // 36: proto.Woodpecker.UnregisterAgent:output_type -> proto.Empty
+	13, // 37: proto.Woodpecker.ReportHealth:output_type -> proto.Empty
+	13, // 38: proto.Woodpecker.UploadArtifact:output_type -> proto.Empty
+	25, // 39: proto.Woodpecker.DownloadArtifact:output_type -> proto.ArtifactChunk
+	13, // 40: proto.Woodpecker.UploadReport:output_type -> proto.Empty
+	27, // 41: proto.WoodpeckerAuth.Auth:output_type -> proto.AuthResponse
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_woodpecker_proto_init() }
@@ -1404,7 +1798,7 @@ func file_woodpecker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_woodpecker_proto_rawDesc), len(file_woodpecker_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -34,17 +34,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Woodpecker_Version_FullMethodName         = "/proto.Woodpecker/Version"
-	Woodpecker_Next_FullMethodName            = "/proto.Woodpecker/Next"
-	Woodpecker_Init_FullMethodName            = "/proto.Woodpecker/Init"
-	Woodpecker_Wait_FullMethodName            = "/proto.Woodpecker/Wait"
-	Woodpecker_Done_FullMethodName            = "/proto.Woodpecker/Done"
-	Woodpecker_Extend_FullMethodName          = "/proto.Woodpecker/Extend"
-	Woodpecker_Update_FullMethodName          = "/proto.Woodpecker/Update"
-	Woodpecker_Log_FullMethodName             = "/proto.Woodpecker/Log"
-	Woodpecker_RegisterAgent_FullMethodName   = "/proto.Woodpecker/RegisterAgent"
-	Woodpecker_UnregisterAgent_FullMethodName = "/proto.Woodpecker/UnregisterAgent"
-	Woodpecker_ReportHealth_FullMethodName    = "/proto.Woodpecker/ReportHealth"
+	Woodpecker_Version_FullMethodName          = "/proto.Woodpecker/Version"
+	Woodpecker_Next_FullMethodName             = "/proto.Woodpecker/Next"
+	Woodpecker_Init_FullMethodName             = "/proto.Woodpecker/Init"
+	Woodpecker_Wait_FullMethodName             = "/proto.Woodpecker/Wait"
+	Woodpecker_Done_FullMethodName             = "/proto.Woodpecker/Done"
+	Woodpecker_Extend_FullMethodName           = "/proto.Woodpecker/Extend"
+	Woodpecker_Update_FullMethodName           = "/proto.Woodpecker/Update"
+	Woodpecker_Log_FullMethodName              = "/proto.Woodpecker/Log"
+	Woodpecker_RegisterAgent_FullMethodName    = "/proto.Woodpecker/RegisterAgent"
+	Woodpecker_UnregisterAgent_FullMethodName  = "/proto.Woodpecker/UnregisterAgent"
+	Woodpecker_ReportHealth_FullMethodName     = "/proto.Woodpecker/ReportHealth"
+	Woodpecker_UploadArtifact_FullMethodName   = "/proto.Woodpecker/UploadArtifact"
+	Woodpecker_DownloadArtifact_FullMethodName = "/proto.Woodpecker/DownloadArtifact"
+	Woodpecker_UploadReport_FullMethodName     = "/proto.Woodpecker/UploadReport"
 )
 
 // WoodpeckerClient is the client API for Woodpecker service.
@@ -64,6 +67,10 @@ type WoodpeckerClient interface {
 	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
 	UnregisterAgent(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	ReportHealth(ctx context.Context, in *ReportHealthRequest, opts ...grpc.CallOption) (*Empty, error)
+	// artifacts and test reports are transferred by the steps of a workflow, authenticated by its artifact token
+	UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadArtifactRequest, Empty], error)
+	DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArtifactChunk], error)
+	UploadReport(ctx context.Context, in *UploadReportRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type woodpeckerClient struct {
@@ -184,6 +191,48 @@ func (c *woodpeckerClient) ReportHealth(ctx context.Context, in *ReportHealthReq
 	return out, nil
 }
 
+func (c *woodpeckerClient) UploadArtifact(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadArtifactRequest, Empty], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Woodpecker_ServiceDesc.Streams[0], Woodpecker_UploadArtifact_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadArtifactRequest, Empty]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Woodpecker_UploadArtifactClient = grpc.ClientStreamingClient[UploadArtifactRequest, Empty]
+
+func (c *woodpeckerClient) DownloadArtifact(ctx context.Context, in *DownloadArtifactRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ArtifactChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Woodpecker_ServiceDesc.Streams[1], Woodpecker_DownloadArtifact_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadArtifactRequest, ArtifactChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Woodpecker_DownloadArtifactClient = grpc.ServerStreamingClient[ArtifactChunk]
+
+func (c *woodpeckerClient) UploadReport(ctx context.Context, in *UploadReportRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, Woodpecker_UploadReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WoodpeckerServer is the server API for Woodpecker service.
 // All implementations must embed UnimplementedWoodpeckerServer
 // for forward compatibility.
@@ -201,6 +250,10 @@ type WoodpeckerServer interface {
 	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
 	UnregisterAgent(context.Context, *Empty) (*Empty, error)
 	ReportHealth(context.Context, *ReportHealthRequest) (*Empty, error)
+	// artifacts and test reports are transferred by the steps of a workflow, authenticated by its artifact token
+	UploadArtifact(grpc.ClientStreamingServer[UploadArtifactRequest, Empty]) error
+	DownloadArtifact(*DownloadArtifactRequest, grpc.ServerStreamingServer[ArtifactChunk]) error
+	UploadReport(context.Context, *UploadReportRequest) (*Empty, error)
 	mustEmbedUnimplementedWoodpeckerServer()
 }
 
@@ -244,6 +297,15 @@ func (UnimplementedWoodpeckerServer) UnregisterAgent(context.Context, *Empty) (*
 func (UnimplementedWoodpeckerServer) ReportHealth(context.Context, *ReportHealthRequest) (*Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReportHealth not implemented")
 }
+func (UnimplementedWoodpeckerServer) UploadArtifact(grpc.ClientStreamingServer[UploadArtifactRequest, Empty]) error {
+	return status.Error(codes.Unimplemented, "method UploadArtifact not implemented")
+}
+func (UnimplementedWoodpeckerServer) DownloadArtifact(*DownloadArtifactRequest, grpc.ServerStreamingServer[ArtifactChunk]) error {
+	return status.Error(codes.Unimplemented, "method DownloadArtifact not implemented")
+}
+func (UnimplementedWoodpeckerServer) UploadReport(context.Context, *UploadReportRequest) (*Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadReport not implemented")
+}
 func (UnimplementedWoodpeckerServer) mustEmbedUnimplementedWoodpeckerServer() {}
 func (UnimplementedWoodpeckerServer) testEmbeddedByValue()                    {}
 
@@ -463,6 +525,42 @@ func _Woodpecker_ReportHealth_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Woodpecker_UploadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WoodpeckerServer).UploadArtifact(&grpc.GenericServerStream[UploadArtifactRequest, Empty]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Woodpecker_UploadArtifactServer = grpc.ClientStreamingServer[UploadArtifactRequest, Empty]
+
+func _Woodpecker_DownloadArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WoodpeckerServer).DownloadArtifact(m, &grpc.GenericServerStream[DownloadArtifactRequest, ArtifactChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Woodpecker_DownloadArtifactServer = grpc.ServerStreamingServer[ArtifactChunk]
+
+func _Woodpecker_UploadReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WoodpeckerServer).UploadReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Woodpecker_UploadReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WoodpeckerServer).UploadReport(ctx, req.(*UploadReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Woodpecker_ServiceDesc is the grpc.ServiceDesc for Woodpecker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -514,8 +612,23 @@ var Woodpecker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportHealth",
 			Handler:    _Woodpecker_ReportHealth_Handler,
 		},
+		{
+			MethodName: "UploadReport",
+			Handler:    _Woodpecker_UploadReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadArtifact",
+			Handler:       _Woodpecker_UploadArtifact_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifact",
+			Handler:       _Woodpecker_DownloadArtifact_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "woodpecker.proto",
 }
 
@@ -18,6 +18,13 @@ import (
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
 )
 
+// Failures of a workflow reported by the agent, that are caused by the
+// infrastructure instead of the steps of the workflow.
+const (
+	FailureSetupError = "setup_error"
+	FailureOOM        = "oom"
+)
+
 type (
 	// Filter defines filters for fetching items from the queue.
 	Filter struct {
@@ -42,6 +49,9 @@ type (
 		Finished int64  `json:"finished"`
 		Error    string `json:"error"`
 		Canceled bool   `json:"canceled"`
+		// Failure tells why the workflow failed if it was not one of its
+		// steps, so the server can decide whether to retry it.
+		Failure string `json:"failure"`
 	}
 
 	// Workflow defines the workflow execution details.
@@ -49,6 +59,9 @@ type (
 		ID      string                `json:"id"`
 		Config  *backend_types.Config `json:"config"`
 		Timeout int64                 `json:"timeout"`
+		// ArtifactToken authenticates the steps of the workflow transferring
+		// artifacts, it is only set if the workflow has artifact steps.
+		ArtifactToken string `json:"artifact_token,omitempty"`
 	}
 
 	Version struct {
@@ -63,5 +76,14 @@ type (
 		Backend      string            `json:"backend"`
 		Capacity     int               `json:"capacity"`
 		CustomLabels map[string]string `json:"custom_labels"`
+		Resources    Resources         `json:"resources"`
+	}
+
+	// Resources defines the resources an agent can allocate for workflows.
+	// Zero values are not limited.
+	Resources struct {
+		CPU    int64 `json:"cpu"`    // in millicores
+		Memory int64 `json:"memory"` // in bytes
+		Disk   int64 `json:"disk"`   // in bytes
 	}
 )
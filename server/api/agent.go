@@ -137,6 +137,7 @@ func PatchAgent(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldAgent := *agent
 
 	// Update allowed fields
 	agent.Name = in.Name
@@ -151,6 +152,7 @@ func PatchAgent(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditAgentUpdate, agentAuditTarget(agent), &oldAgent, agent)
 	c.JSON(http.StatusOK, agent)
 }
 
@@ -185,6 +187,7 @@ func PostAgent(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
+	recordAudit(c, model.AuditAgentCreate, agentAuditTarget(agent), nil, agent)
 	c.JSON(http.StatusOK, agent)
 }
 
@@ -228,6 +231,7 @@ func DeleteAgent(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error deleting user. %s", err)
 		return
 	}
+	recordAudit(c, model.AuditAgentDelete, agentAuditTarget(agent), agent, nil)
 	c.Status(http.StatusNoContent)
 }
 
@@ -276,6 +280,7 @@ func PostOrgAgent(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditAgentCreate, agentAuditTarget(agent), nil, agent)
 	c.JSON(http.StatusOK, agent)
 }
 
@@ -340,6 +345,7 @@ func PatchOrgAgent(c *gin.Context) {
 		c.String(http.StatusBadRequest, err.Error())
 		return
 	}
+	oldAgent := *agent
 
 	// Update allowed fields
 	agent.Name = in.Name
@@ -353,6 +359,7 @@ func PatchOrgAgent(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditAgentUpdate, agentAuditTarget(agent), &oldAgent, agent)
 	c.JSON(http.StatusOK, agent)
 }
 
@@ -404,5 +411,6 @@ func DeleteOrgAgent(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditAgentDelete, agentAuditTarget(agent), agent, nil)
 	c.Status(http.StatusNoContent)
 }
@@ -127,6 +127,9 @@ func TestPatchAgent(t *testing.T) {
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentFind", int64(1)).Return(fakeAgent, nil)
 		mockStore.On("AgentUpdate", mock.AnythingOfType("*model.Agent")).Return(nil)
+		mockStore.On("AuditLogCreate", mock.MatchedBy(func(entry *model.AuditLog) bool {
+			return entry.Action == model.AuditAgentUpdate && entry.OldValue["name"] == "test-agent" && entry.NewValue["name"] == "updated-agent"
+		})).Return(nil)
 
 		mockManager := manager_mocks.NewMockManager(t)
 		server.Config.Services.Manager = mockManager
@@ -162,6 +165,10 @@ func TestPostAgent(t *testing.T) {
 
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentCreate", mock.AnythingOfType("*model.Agent")).Return(nil)
+		mockStore.On("AuditLogCreate", mock.MatchedBy(func(entry *model.AuditLog) bool {
+			// the agent token must not end up in the audit log
+			return entry.Action == model.AuditAgentCreate && entry.ActorID == 1 && entry.NewValue["token"] == "********"
+		})).Return(nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -191,6 +198,7 @@ func TestDeleteAgent(t *testing.T) {
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentFind", int64(1)).Return(fakeAgent, nil)
 		mockStore.On("AgentDelete", mock.AnythingOfType("*model.Agent")).Return(nil)
+		mockStore.On("AuditLogCreate", mock.AnythingOfType("*model.AuditLog")).Return(nil)
 
 		mockManager := manager_mocks.NewMockManager(t)
 		server.Config.Services.Manager = mockManager
@@ -204,6 +212,7 @@ func TestDeleteAgent(t *testing.T) {
 		c, _ := gin.CreateTestContext(w)
 		c.Set("store", mockStore)
 		c.Params = gin.Params{{Key: "agent_id", Value: "1"}}
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/", nil)
 
 		DeleteAgent(c)
 		c.Writer.WriteHeaderNow()
@@ -247,6 +256,7 @@ func TestPostOrgAgent(t *testing.T) {
 	t.Run("create org agent should succeed", func(t *testing.T) {
 		mockStore := store_mocks.NewMockStore(t)
 		mockStore.On("AgentCreate", mock.AnythingOfType("*model.Agent")).Return(nil)
+		mockStore.On("AuditLogCreate", mock.AnythingOfType("*model.AuditLog")).Return(nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
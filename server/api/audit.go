@@ -0,0 +1,213 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/audit"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// GetAuditLogs
+//
+//	@Summary		List audit log entries
+//	@Description	Returns the newest entries first. Requires admin rights.
+//	@Router			/audit [get]
+//	@Produce		json
+//	@Success		200	{array}	AuditLog
+//	@Tags			Audit log
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			actor			query	string	false	"only entries of actions performed by this user login"
+//	@Param			action			query	string	false	"only entries of this action, e.g. secret.update"
+//	@Param			target_type		query	string	false	"only entries of this target type, e.g. secret"
+//	@Param			repo_id			query	int		false	"only entries of this repository"
+//	@Param			before			query	string	false	"only entries before this time (RFC 3339)"
+//	@Param			after			query	string	false	"only entries after this time (RFC 3339)"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetAuditLogs(c *gin.Context) {
+	filter, err := auditLogFilter(c)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Error parsing filter. %s", err)
+		return
+	}
+
+	listAuditLogs(c, filter)
+}
+
+// GetOrgAuditLogs
+//
+//	@Summary		List audit log entries of an organization
+//	@Description	Returns the newest entries of the organization and its repositories first. Requires organization admin rights.
+//	@Router			/orgs/{org_id}/audit [get]
+//	@Produce		json
+//	@Success		200	{array}	AuditLog
+//	@Tags			Audit log
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	int		true	"the organization's id"
+//	@Param			actor			query	string	false	"only entries of actions performed by this user login"
+//	@Param			action			query	string	false	"only entries of this action, e.g. secret.update"
+//	@Param			target_type		query	string	false	"only entries of this target type, e.g. secret"
+//	@Param			repo_id			query	int		false	"only entries of this repository"
+//	@Param			before			query	string	false	"only entries before this time (RFC 3339)"
+//	@Param			after			query	string	false	"only entries after this time (RFC 3339)"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetOrgAuditLogs(c *gin.Context) {
+	filter, err := auditLogFilter(c)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Error parsing filter. %s", err)
+		return
+	}
+	filter.OrgID = session.Org(c).ID
+
+	listAuditLogs(c, filter)
+}
+
+func listAuditLogs(c *gin.Context, filter *model.AuditLogFilter) {
+	entries, err := store.FromContext(c).AuditLogList(filter, session.Pagination(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting audit log. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, entries)
+}
+
+func auditLogFilter(c *gin.Context) (*model.AuditLogFilter, error) {
+	filter := &model.AuditLogFilter{
+		ActorLogin: c.Query("actor"),
+		Action:     model.AuditAction(c.Query("action")),
+		TargetType: model.AuditTargetType(c.Query("target_type")),
+	}
+
+	if repoID := c.Query("repo_id"); repoID != "" {
+		id, err := strconv.ParseInt(repoID, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		filter.RepoID = id
+	}
+
+	if before := c.Query("before"); before != "" {
+		beforeDt, err := time.Parse(time.RFC3339, before)
+		if err != nil {
+			return nil, err
+		}
+		filter.Before = beforeDt.Unix()
+	}
+
+	if after := c.Query("after"); after != "" {
+		afterDt, err := time.Parse(time.RFC3339, after)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = afterDt.Unix()
+	}
+
+	return filter, nil
+}
+
+// recordAudit records an action of the current user. Old and new values
+// are stored with their credentials redacted, they are nil for created and
+// deleted targets respectively.
+func recordAudit(c *gin.Context, action model.AuditAction, target model.AuditTarget, oldValue, newValue any) {
+	entry := &model.AuditLog{
+		Action:      action,
+		AuditTarget: target,
+		OldValue:    audit.Redact(oldValue),
+		NewValue:    audit.Redact(newValue),
+		ClientIP:    c.ClientIP(),
+	}
+	if user := session.User(c); user != nil {
+		entry.ActorID = user.ID
+		entry.ActorLogin = user.Login
+	}
+
+	audit.Record(store.FromContext(c), server.Config.Services.AuditSink, entry)
+}
+
+func secretAuditTarget(secret *model.Secret, repo *model.Repo) model.AuditTarget {
+	target := model.AuditTarget{Type: model.AuditTargetSecret, ID: secret.ID, Name: secret.Name, OrgID: secret.OrgID}
+	if repo != nil {
+		target.OrgID, target.RepoID = repo.OrgID, repo.ID
+	}
+	return target
+}
+
+func registryAuditTarget(registry *model.Registry, repo *model.Repo) model.AuditTarget {
+	target := model.AuditTarget{Type: model.AuditTargetRegistry, ID: registry.ID, Name: registry.Address, OrgID: registry.OrgID}
+	if repo != nil {
+		target.OrgID, target.RepoID = repo.OrgID, repo.ID
+	}
+	return target
+}
+
+func repoAuditTarget(repo *model.Repo) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetRepo, ID: repo.ID, Name: repo.FullName, OrgID: repo.OrgID, RepoID: repo.ID}
+}
+
+func cronAuditTarget(cron *model.Cron, repo *model.Repo) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetCron, ID: cron.ID, Name: cron.Name, OrgID: repo.OrgID, RepoID: repo.ID}
+}
+
+func pipelineAuditTarget(pipeline *model.Pipeline, repo *model.Repo) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetPipeline, ID: pipeline.ID, Name: strconv.FormatInt(pipeline.Number, 10), OrgID: repo.OrgID, RepoID: repo.ID}
+}
+
+// pipelineAuditValue returns the fields of a pipeline relevant for approvals,
+// the whole pipeline would bloat the audit log.
+func pipelineAuditValue(pipeline *model.Pipeline) map[string]any {
+	return map[string]any{
+		"status":    pipeline.Status,
+		"event":     pipeline.Event,
+		"ref":       pipeline.Ref,
+		"commit":    pipeline.Commit,
+		"author":    pipeline.Author,
+		"from_fork": pipeline.FromFork,
+	}
+}
+
+func agentAuditTarget(agent *model.Agent) model.AuditTarget {
+	// global agents have no org
+	return model.AuditTarget{Type: model.AuditTargetAgent, ID: agent.ID, Name: agent.Name, OrgID: max(agent.OrgID, 0)}
+}
+
+func forgeAuditTarget(forge *model.Forge) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetForge, ID: forge.ID, Name: forge.URL}
+}
+
+func userAuditTarget(user *model.User) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetUser, ID: user.ID, Name: user.Login}
+}
+
+func webhookAuditTarget(hook *model.Webhook, repo *model.Repo) model.AuditTarget {
+	target := model.AuditTarget{Type: model.AuditTargetWebhook, ID: hook.ID, Name: hook.Name, OrgID: hook.OrgID}
+	if repo != nil {
+		target.OrgID, target.RepoID = repo.OrgID, repo.ID
+	}
+	return target
+}
+
+func tokenAuditTarget(token *model.PersonalAccessToken) model.AuditTarget {
+	return model.AuditTarget{Type: model.AuditTargetToken, ID: token.ID, Name: token.Name}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+func seedAuditLogs(t *testing.T, s store.Store) {
+	t.Helper()
+	for _, entry := range []*model.AuditLog{
+		{ActorLogin: "alice", Action: model.AuditSecretCreate, AuditTarget: model.AuditTarget{Type: model.AuditTargetSecret, Name: "token", OrgID: 1, RepoID: 1}},
+		{ActorLogin: "bob", Action: model.AuditCronDelete, AuditTarget: model.AuditTarget{Type: model.AuditTargetCron, Name: "nightly", OrgID: 1, RepoID: 1}},
+		{ActorLogin: "alice", Action: model.AuditSecretDelete, AuditTarget: model.AuditTarget{Type: model.AuditTargetSecret, Name: "token", OrgID: 2, RepoID: 2}},
+		{ActorLogin: "admin", Action: model.AuditForgeUpdate, AuditTarget: model.AuditTarget{Type: model.AuditTargetForge, Name: "https://github.com"}},
+	} {
+		require.NoError(t, s.AuditLogCreate(entry))
+	}
+}
+
+func TestGetAuditLogs(t *testing.T) {
+	s := newTestStore(t)
+	seedAuditLogs(t, s)
+
+	t.Run("lists all entries newest first", func(t *testing.T) {
+		tc := newTestContext(t, s)
+
+		GetAuditLogs(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var got []*model.AuditLog
+		tc.decodeJSON(t, &got)
+		require.Len(t, got, 4)
+		assert.Equal(t, model.AuditForgeUpdate, got[0].Action)
+	})
+
+	t.Run("filters by actor and target type", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Request = httptest.NewRequest(http.MethodGet, "/?actor=alice&target_type=secret&repo_id=2", nil)
+
+		GetAuditLogs(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var got []*model.AuditLog
+		tc.decodeJSON(t, &got)
+		require.Len(t, got, 1)
+		assert.Equal(t, model.AuditSecretDelete, got[0].Action)
+	})
+
+	t.Run("invalid time returns bad request", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Request = httptest.NewRequest(http.MethodGet, "/?before=yesterday", nil)
+
+		GetAuditLogs(tc.Ctx)
+
+		assert.Equal(t, http.StatusBadRequest, tc.Recorder.Code)
+	})
+}
+
+func TestGetOrgAuditLogs(t *testing.T) {
+	s := newTestStore(t)
+	seedAuditLogs(t, s)
+
+	t.Run("only lists entries of the org", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Set("org", &model.Org{ID: 1})
+
+		GetOrgAuditLogs(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var got []*model.AuditLog
+		tc.decodeJSON(t, &got)
+		require.Len(t, got, 2)
+		for _, entry := range got {
+			assert.EqualValues(t, 1, entry.OrgID)
+		}
+	})
+
+	t.Run("filter can not leave the org", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Set("org", &model.Org{ID: 1})
+		tc.Ctx.Request = httptest.NewRequest(http.MethodGet, "/?repo_id=2", nil)
+
+		GetOrgAuditLogs(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var got []*model.AuditLog
+		tc.decodeJSON(t, &got)
+		assert.Empty(t, got)
+	})
+}
+
+func TestRecordAudit(t *testing.T) {
+	s := newTestStore(t)
+	repo, user := cronFixture(t, s)
+	cron := seedCron(t, s, repo.ID, "audited")
+
+	tc := newTestContext(t, s)
+	withUser(user)(tc)
+	withRepo(repo, &model.Perm{})(tc)
+	withParam("cron", strItoa(cron.ID))(tc)
+	tc.Ctx.Request.RemoteAddr = "192.0.2.1:1234"
+
+	DeleteCron(tc.Ctx)
+	require.Equal(t, http.StatusNoContent, tc.Ctx.Writer.Status())
+
+	entries, err := s.AuditLogList(&model.AuditLogFilter{}, &model.ListOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.Equal(t, model.AuditCronDelete, entry.Action)
+	assert.Equal(t, user.ID, entry.ActorID)
+	assert.Equal(t, "owner", entry.ActorLogin)
+	assert.Equal(t, model.AuditTargetCron, entry.Type)
+	assert.Equal(t, cron.ID, entry.AuditTarget.ID)
+	assert.Equal(t, repo.ID, entry.RepoID)
+	assert.Equal(t, "audited", entry.OldValue["name"])
+	assert.Nil(t, entry.NewValue)
+	assert.Equal(t, "192.0.2.1", entry.ClientIP)
+	assert.NotZero(t, entry.Created)
+}
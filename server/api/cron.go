@@ -134,6 +134,7 @@ func PostCron(c *gin.Context) {
 		Branch:    strings.TrimSpace(in.Branch),
 		Variables: in.Variables,
 		Enabled:   in.Enabled,
+		Priority:  in.Priority,
 	}
 	if cron.Timezone == "" {
 		cron.Timezone = "UTC"
@@ -167,6 +168,7 @@ func PostCron(c *gin.Context) {
 		}
 		return
 	}
+	recordAudit(c, model.AuditCronCreate, cronAuditTarget(cron, repo), nil, cron)
 	c.JSON(http.StatusOK, cron)
 }
 
@@ -210,6 +212,7 @@ func PatchCron(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldCron := *cron
 	if in.Branch != nil {
 		if branch := strings.TrimSpace(*in.Branch); branch != "" {
 			// check if branch exists on forge
@@ -263,6 +266,9 @@ func PatchCron(c *gin.Context) {
 	if in.Variables != nil {
 		cron.Variables = in.Variables
 	}
+	if in.Priority != nil {
+		cron.Priority = *in.Priority
+	}
 	cron.CreatorID = user.ID
 
 	if err := cron.Validate(); err != nil {
@@ -273,6 +279,7 @@ func PatchCron(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating cron %q. %s", in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditCronUpdate, cronAuditTarget(cron, repo), &oldCron, cron)
 	c.JSON(http.StatusOK, cron)
 }
 
@@ -314,9 +321,16 @@ func DeleteCron(c *gin.Context) {
 		c.String(http.StatusBadRequest, "Error parsing cron id. %s", err)
 		return
 	}
-	if err := store.FromContext(c).CronDelete(repo, id); err != nil {
+	_store := store.FromContext(c)
+	cron, err := _store.CronFind(repo, id)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	if err := _store.CronDelete(repo, id); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditCronDelete, cronAuditTarget(cron, repo), cron, nil)
 	c.Status(http.StatusNoContent)
 }
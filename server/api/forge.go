@@ -42,15 +42,14 @@ func GetForges(c *gin.Context) {
 		return
 	}
 
-	user := session.User(c)
-	if user != nil && user.Admin {
-		c.JSON(http.StatusOK, forges)
-		return
-	}
-
 	// copy forges data without sensitive information
+	user := session.User(c)
 	for i, forge := range forges {
-		forges[i] = forge.PublicCopy()
+		if user != nil && user.Admin {
+			forges[i] = forge.RedactedCopy()
+		} else {
+			forges[i] = forge.PublicCopy()
+		}
 	}
 
 	c.JSON(http.StatusOK, forges)
@@ -80,7 +79,7 @@ func GetForge(c *gin.Context) {
 
 	user := session.User(c)
 	if user != nil && user.Admin {
-		c.JSON(http.StatusOK, forge)
+		c.JSON(http.StatusOK, forge.RedactedCopy())
 	} else {
 		c.JSON(http.StatusOK, forge.PublicCopy())
 	}
@@ -117,6 +116,7 @@ func PatchForge(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldForge := *forge
 	forge.URL = in.URL
 	forge.Type = in.Type
 	forge.OAuthClientID = in.OAuthClientID
@@ -124,6 +124,7 @@ func PatchForge(c *gin.Context) {
 	forge.SkipVerify = in.SkipVerify
 	forge.Orgs = in.Orgs
 	forge.AdditionalOptions = in.AdditionalOptions
+	forge.KeepSensitiveOptions(&oldForge)
 	if in.OAuthClientSecret != "" {
 		forge.OAuthClientSecret = in.OAuthClientSecret
 	}
@@ -134,7 +135,8 @@ func PatchForge(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, forge)
+	recordAudit(c, model.AuditForgeUpdate, forgeAuditTarget(forge), &oldForge, forge)
+	c.JSON(http.StatusOK, forge.RedactedCopy())
 }
 
 // PostForge
@@ -169,7 +171,8 @@ func PostForge(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
-	c.JSON(http.StatusOK, forge)
+	recordAudit(c, model.AuditForgeCreate, forgeAuditTarget(forge), nil, forge)
+	c.JSON(http.StatusOK, forge.RedactedCopy())
 }
 
 // DeleteForge
@@ -200,5 +203,6 @@ func DeleteForge(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error deleting user. %s", err)
 		return
 	}
+	recordAudit(c, model.AuditForgeDelete, forgeAuditTarget(forge), forge, nil)
 	c.Status(http.StatusNoContent)
 }
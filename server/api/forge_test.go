@@ -40,6 +40,7 @@ func newForgeCtx(t *testing.T, method string, body any) (*gin.Context, *httptest
 	c.Request.Header.Set("Content-Type", "application/json")
 
 	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
 	c.Set("store", _store)
 	return c, rec, _store
 }
@@ -101,6 +102,36 @@ func TestPatchForge(t *testing.T) {
 		assert.Equal(t, "client-secret", updated.OAuthClientSecret)
 	})
 
+	t.Run("should keep the credentials of the additional options", func(t *testing.T) {
+		c, rec, _store := newForgeCtx(t, http.MethodPatch, &model.ForgeWithOAuthClientSecret{
+			Forge: model.Forge{
+				Type:              model.ForgeTypeGithub,
+				URL:               "https://github.com",
+				AdditionalOptions: map[string]any{"app-id": "2"},
+			},
+		})
+		c.Params = gin.Params{{Key: "forge_id", Value: "1"}}
+
+		_store.On("ForgeGet", int64(1)).Return(&model.Forge{
+			ID:                1,
+			Type:              model.ForgeTypeGithub,
+			URL:               "https://github.com",
+			AdditionalOptions: map[string]any{"app-id": "1", "app-private-key": "key", "app-webhook-secret": "secret"},
+		}, nil)
+
+		var updated *model.Forge
+		_store.On("ForgeUpdate", mock.Anything).Run(func(args mock.Arguments) {
+			updated, _ = args.Get(0).(*model.Forge)
+		}).Return(nil)
+
+		PatchForge(c)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		require.NotNil(t, updated)
+		assert.Equal(t, map[string]any{"app-id": "2", "app-private-key": "key", "app-webhook-secret": "secret"}, updated.AdditionalOptions)
+		assert.NotContains(t, rec.Body.String(), "app-private-key")
+	})
+
 	t.Run("should clear the allowed orgs of the forge", func(t *testing.T) {
 		c, rec, _store := newForgeCtx(t, http.MethodPatch, &model.ForgeWithOAuthClientSecret{
 			Forge: model.Forge{
@@ -129,3 +160,25 @@ func TestPatchForge(t *testing.T) {
 		assert.Empty(t, updated.Orgs)
 	})
 }
+
+func TestGetForge(t *testing.T) {
+	t.Run("should not return the credentials of the additional options", func(t *testing.T) {
+		c, rec, _store := newForgeCtx(t, http.MethodGet, nil)
+		c.Params = gin.Params{{Key: "forge_id", Value: "1"}}
+		c.Set("user", &model.User{Admin: true})
+
+		_store.On("ForgeGet", int64(1)).Return(&model.Forge{
+			ID:                1,
+			Type:              model.ForgeTypeGithub,
+			URL:               "https://github.com",
+			AdditionalOptions: map[string]any{"app-id": "1", "app-private-key": "key", "app-webhook-secret": "secret"},
+		}, nil)
+
+		GetForge(c)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"app-id":"1"`)
+		assert.NotContains(t, rec.Body.String(), "app-private-key")
+		assert.NotContains(t, rec.Body.String(), "app-webhook-secret")
+	})
+}
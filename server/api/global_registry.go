@@ -99,6 +99,7 @@ func PostGlobalRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting global registry %q. %s", in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryCreate, registryAuditTarget(registry, nil), nil, registry)
 	c.JSON(http.StatusOK, registry.Copy())
 }
 
@@ -128,6 +129,7 @@ func PatchGlobalRegistry(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldRegistry := *registry
 	if in.Address != "" {
 		registry.Address = in.Address
 	}
@@ -147,6 +149,7 @@ func PatchGlobalRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating global registry %q. %s", in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryUpdate, registryAuditTarget(registry, nil), &oldRegistry, registry)
 	c.JSON(http.StatusOK, registry.Copy())
 }
 
@@ -162,9 +165,15 @@ func PatchGlobalRegistry(c *gin.Context) {
 func DeleteGlobalRegistry(c *gin.Context) {
 	addr := c.Param("registry")
 	registryService := server.Config.Services.Manager.RegistryService()
+	registry, err := registryService.GlobalRegistryFind(addr)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
 	if err := registryService.GlobalRegistryDelete(addr); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryDelete, registryAuditTarget(registry, nil), registry, nil)
 	c.Status(http.StatusNoContent)
 }
@@ -30,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	registry_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/registry/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -52,6 +53,10 @@ func newGlobalRegistryCtxWithService(t *testing.T, method string, body any) (*gi
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("RegistryService").Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -208,6 +213,7 @@ func TestDeleteGlobalRegistry(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newGlobalRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "docker.io"}}
+		svc.On("GlobalRegistryFind", "docker.io").Return(storedGlobalRegistry(), nil)
 		svc.On("GlobalRegistryDelete", "docker.io").Return(nil)
 
 		DeleteGlobalRegistry(c)
@@ -218,7 +224,7 @@ func TestDeleteGlobalRegistry(t *testing.T) {
 	t.Run("missing registry returns not found", func(t *testing.T) {
 		c, rec, svc := newGlobalRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "nope"}}
-		svc.On("GlobalRegistryDelete", "nope").Return(types.ErrRecordNotExist)
+		svc.On("GlobalRegistryFind", "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteGlobalRegistry(c)
 
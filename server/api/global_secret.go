@@ -101,6 +101,7 @@ func PostGlobalSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting global secret %q. %s", in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretCreate, secretAuditTarget(secret, nil), nil, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -130,6 +131,7 @@ func PatchGlobalSecret(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldSecret := *secret
 	if in.Value != nil && *in.Value != "" {
 		secret.Value = *in.Value
 	}
@@ -152,6 +154,7 @@ func PatchGlobalSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating global secret %q. %s", in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretUpdate, secretAuditTarget(secret, nil), &oldSecret, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -167,9 +170,15 @@ func PatchGlobalSecret(c *gin.Context) {
 func DeleteGlobalSecret(c *gin.Context) {
 	name := c.Param("secret")
 	secretService := server.Config.Services.Manager.SecretService()
+	secret, err := secretService.GlobalSecretFind(name)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
 	if err := secretService.GlobalSecretDelete(name); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretDelete, secretAuditTarget(secret, nil), secret, nil)
 	c.Status(http.StatusNoContent)
 }
@@ -31,6 +31,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	secret_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/secret/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -69,6 +70,10 @@ func newGlobalSecretCtxWithService(t *testing.T, method string, body any) (*gin.
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("SecretService").Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -225,6 +230,7 @@ func TestDeleteGlobalSecret(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newGlobalSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "api_token"}}
+		svc.On("GlobalSecretFind", "api_token").Return(storedGlobalSecret(), nil)
 		svc.On("GlobalSecretDelete", "api_token").Return(nil)
 
 		DeleteGlobalSecret(c)
@@ -235,7 +241,7 @@ func TestDeleteGlobalSecret(t *testing.T) {
 	t.Run("missing secret returns not found", func(t *testing.T) {
 		c, rec, svc := newGlobalSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "nope"}}
-		svc.On("GlobalSecretDelete", "nope").Return(types.ErrRecordNotExist)
+		svc.On("GlobalSecretFind", "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteGlobalSecret(c)
 
@@ -33,6 +33,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	store_types "go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
 )
 
@@ -117,44 +118,132 @@ func PostHook(c *gin.Context) {
 	// 2. Parse the webhook data
 	//
 
+	repoFromForge, pipelineFromForge, ok := parseHook(c, _forge)
+	if !ok {
+		return
+	}
+
+	//
+	// 3. Check the repo from the token is matching the repo returned by the forge
+	//
+
+	if repo.ForgeRemoteID != repoFromForge.ForgeRemoteID {
+		log.Warn().Msgf("ignoring hook: repo %s does not match the repo from the token", repo.FullName)
+		c.String(http.StatusBadRequest, "failure to parse token from hook")
+		return
+	}
+
+	handleHook(c, _store, _forge, repo, repoFromForge, pipelineFromForge)
+}
+
+// PostForgeHook
+//
+//	@Summary	Incoming webhook from a forge app
+//	@Description	Receives the webhooks an app installed on the forge sends for all of its repositories, e.g. a GitHub App.
+//	@Router		/hook/forges/{forge_id} [post]
+//	@Produce	plain
+//	@Success	200
+//	@Tags		System
+//	@Param		forge_id	path	int		true	"the forge's id"
+//	@Param		hook		body	object	true	"the webhook payload"
+func PostForgeHook(c *gin.Context) {
+	_store := store.FromContext(c)
+
+	forgeID, err := strconv.ParseInt(c.Param("forge_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	//
+	// 1. Check if the webhook is valid and authorized
+	//
+
+	_forge, err := server.Config.Services.Manager.ForgeByID(forgeID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	appHooker, ok := _forge.(forge.AppHooker)
+	if !ok {
+		c.String(http.StatusNotFound, "forge does not support app hooks")
+		return
+	}
+	if err := appHooker.VerifyAppHook(c.Request); err != nil {
+		if errors.Is(err, types.ErrNotImplemented) {
+			c.String(http.StatusNotFound, "forge does not support app hooks")
+			return
+		}
+		msg := "failure to verify app hook"
+		log.Error().Err(err).Int64("forge-id", forgeID).Msg(msg)
+		c.String(http.StatusUnauthorized, msg)
+		return
+	}
+
+	//
+	// 2. Parse the webhook data
+	//
+
+	repoFromForge, pipelineFromForge, ok := parseHook(c, _forge)
+	if !ok {
+		return
+	}
+
+	//
+	// 3. Find the repo, the app sends hooks of repos not enabled in woodpecker too
+	//
+
+	repo, err := _store.GetRepoForgeID(forgeID, repoFromForge.ForgeRemoteID)
+	if errors.Is(err, store_types.ErrRecordNotExist) {
+		log.Debug().Msgf("ignoring hook: repo %s is not enabled", repoFromForge.FullName)
+		c.Status(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	handleHook(c, _store, _forge, repo, repoFromForge, pipelineFromForge)
+}
+
+// parseHook parses the webhook data of the request. If it doesn't result in
+// a pipeline the response is written and false returned.
+func parseHook(c *gin.Context, _forge forge.Forge) (*model.Repo, *model.Pipeline, bool) {
 	repoFromForge, pipelineFromForge, err := _forge.Hook(c, c.Request)
 	if err != nil {
 		if errors.Is(err, &types.ErrIgnoreEvent{}) {
 			msg := fmt.Sprintf("forge driver: %s", err)
 			log.Debug().Err(err).Msg(msg)
 			c.String(http.StatusOK, msg)
-			return
+			return nil, nil, false
 		}
 
 		msg := "failure to parse hook"
 		log.Debug().Err(err).Msg(msg)
 		c.String(http.StatusBadRequest, msg)
-		return
+		return nil, nil, false
 	}
 
 	if pipelineFromForge == nil {
 		msg := "ignoring hook: hook parsing resulted in empty pipeline"
 		log.Debug().Msg(msg)
 		c.String(http.StatusOK, msg)
-		return
+		return nil, nil, false
 	}
 	if repoFromForge == nil {
 		msg := "failure to ascertain repo from hook"
 		log.Debug().Msg(msg)
 		c.String(http.StatusBadRequest, msg)
-		return
+		return nil, nil, false
 	}
 
-	//
-	// 3. Check the repo from the token is matching the repo returned by the forge
-	//
-
-	if repo.ForgeRemoteID != repoFromForge.ForgeRemoteID {
-		log.Warn().Msgf("ignoring hook: repo %s does not match the repo from the token", repo.FullName)
-		c.String(http.StatusBadRequest, "failure to parse token from hook")
-		return
-	}
+	return repoFromForge, pipelineFromForge, true
+}
 
+// handleHook creates the pipeline for a hook received for the repo.
+func handleHook(c *gin.Context, _store store.Store, _forge forge.Forge, repo, repoFromForge *model.Repo, pipelineFromForge *model.Pipeline) {
 	//
 	// 4. Check if the repo is active and has an owner
 	//
@@ -209,7 +298,24 @@ func PostHook(c *gin.Context) {
 	}
 
 	//
-	// 6. Finally create a pipeline
+	// 6. Skip commits the poller triggered a pipeline for already
+	//
+
+	if repo.PollInterval > 0 && (pipelineFromForge.Event == model.EventPush || pipelineFromForge.Event == model.EventPull) {
+		duplicate, err := pipeline.IsDuplicate(_store, repo, pipelineFromForge)
+		if err != nil {
+			handleDBError(c, err)
+			return
+		}
+		if duplicate {
+			log.Debug().Str("repo", repo.FullName).Msgf("ignoring hook: commit %s was already found by polling", pipelineFromForge.Commit)
+			c.Status(http.StatusNoContent)
+			return
+		}
+	}
+
+	//
+	// 7. Finally create a pipeline
 	//
 	// Pipeline creation can be slow (forge round-trips, config fetching). To
 	// avoid the forge timing out and retrying the webhook delivery, we wait only
@@ -15,6 +15,7 @@
 package api_test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -35,6 +36,7 @@ import (
 	registry_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/registry/mocks"
 	secret_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/secret/mocks"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
 )
 
@@ -108,3 +110,69 @@ func TestHook(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, c.Writer.Status())
 	assert.Equal(t, "true", w.Header().Get("Pipeline-Filtered"))
 }
+
+// appForge is a forge receiving app hooks.
+type appForge struct {
+	*forge_mocks.MockForge
+	verifyErr error
+}
+
+func (f *appForge) VerifyAppHook(_ *http.Request) error {
+	return f.verifyErr
+}
+
+func TestForgeHook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	repo := &model.Repo{
+		ID:            123,
+		ForgeRemoteID: "123",
+		FullName:      "owner/name",
+	}
+
+	newContext := func(_store *store_mocks.MockStore) (*gin.Context, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", _store)
+		c.Params = gin.Params{{Key: "forge_id", Value: "1"}}
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/hook/forges/1", nil)
+		return c, w
+	}
+
+	t.Run("forge without app hooks", func(t *testing.T) {
+		_manager := manager_mocks.NewMockManager(t)
+		server.Config.Services.Manager = _manager
+		_manager.On("ForgeByID", int64(1)).Return(forge_mocks.NewMockForge(t), nil)
+
+		c, w := newContext(store_mocks.NewMockStore(t))
+		api.PostForgeHook(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_manager := manager_mocks.NewMockManager(t)
+		server.Config.Services.Manager = _manager
+		_manager.On("ForgeByID", int64(1)).Return(&appForge{MockForge: forge_mocks.NewMockForge(t), verifyErr: errors.New("invalid signature")}, nil)
+
+		c, w := newContext(store_mocks.NewMockStore(t))
+		api.PostForgeHook(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("repo not enabled", func(t *testing.T) {
+		_manager := manager_mocks.NewMockManager(t)
+		_store := store_mocks.NewMockStore(t)
+		_forge := forge_mocks.NewMockForge(t)
+		server.Config.Services.Manager = _manager
+		_manager.On("ForgeByID", int64(1)).Return(&appForge{MockForge: _forge}, nil)
+		_forge.On("Hook", mock.Anything, mock.Anything).Return(repo, &model.Pipeline{Event: model.EventPush}, nil)
+		_store.On("GetRepoForgeID", int64(1), repo.ForgeRemoteID).Return(nil, types.ErrRecordNotExist)
+
+		c, _ := newContext(_store)
+		api.PostForgeHook(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	// logSearchPeriod is how far back searches look by default.
+	logSearchPeriod = 30 * 24 * time.Hour
+	// logSearchPipelines limits the pipelines whose logs a search reads.
+	logSearchPipelines  = 100
+	logSearchMatches    = 100
+	logSearchContext    = 2
+	maxLogSearchContext = 10
+)
+
+// SearchLogs
+//
+//	@Summary		Search the logs of the recent pipelines of a repository
+//	@Description	Returns the lines containing the query, ignoring case, of the logs of the latest 100 pipelines created since the given time, newest first.
+//	@Description	At most 100 lines are returned. Finished steps are looked up in the search index of the log store first, words of the query are matched from their start there.
+//	@Router			/repos/{repo_id}/logs/search [get]
+//	@Produce		json
+//	@Success		200	{array}	LogMatch
+//	@Tags			Pipeline logs
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			q				query	string	true	"the text to search for"
+//	@Param			since			query	string	false	"only search pipelines created after this RFC3339 time, defaults to 30 days ago"
+//	@Param			context			query	int		false	"the number of lines returned around a match"	default(2)
+func SearchLogs(c *gin.Context) {
+	repo := session.Repo(c)
+
+	query := c.Query("q")
+	if query == "" {
+		c.String(http.StatusBadRequest, "query must not be empty")
+		return
+	}
+
+	since := time.Now().Add(-logSearchPeriod)
+	if s := c.Query("since"); s != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, s); err != nil {
+			_ = c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	contextLines := logSearchContext
+	if s := c.Query("context"); s != "" {
+		var err error
+		if contextLines, err = strconv.Atoi(s); err != nil || contextLines < 0 || contextLines > maxLogSearchContext {
+			c.String(http.StatusBadRequest, "context must be between 0 and %d", maxLogSearchContext)
+			return
+		}
+	}
+
+	_store := store.FromContext(c)
+	pipelines, err := _store.GetPipelineList(repo,
+		&model.ListOptionsWithAll{ListOptions: &model.ListOptions{Page: 1, PerPage: logSearchPipelines}},
+		&model.PipelineFilter{After: since.Unix()})
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	var steps []*model.Step
+	pipelineNumbers := make(map[int64]int64, len(pipelines))
+	for _, pipeline := range pipelines {
+		pipelineSteps, err := _store.StepList(pipeline.ID)
+		if err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+		for _, step := range pipelineSteps {
+			// steps never started have no logs
+			if step.State == model.StatusPending || step.State == model.StatusSkipped {
+				continue
+			}
+			steps = append(steps, step)
+			pipelineNumbers[step.ID] = pipeline.Number
+		}
+	}
+
+	matches, err := service_log.Search(server.Config.Services.LogStore, steps, query, contextLines, logSearchMatches)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, errors.Join(errors.New("could not search logs"), err))
+		return
+	}
+	for _, match := range matches {
+		match.PipelineNumber = pipelineNumbers[match.StepID]
+	}
+
+	c.JSON(http.StatusOK, matches)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestSearchLogs(t *testing.T) {
+	s := newTestStore(t)
+	repo := &model.Repo{ForgeRemoteID: "1", Owner: "owner", Name: "repo", FullName: "owner/repo"}
+	require.NoError(t, s.CreateRepo(repo))
+
+	// the database store indexes the logs itself
+	originalLogStore := server.Config.Services.LogStore
+	server.Config.Services.LogStore = s
+	t.Cleanup(func() {
+		server.Config.Services.LogStore = originalLogStore
+	})
+
+	// pipelines are numbered in the order they are created
+	for i, data := range []string{"connection refused", "all good"} {
+		pipeline := &model.Pipeline{RepoID: repo.ID}
+		step := &model.Step{UUID: string(rune('a' + i)), Name: "test", State: model.StatusFailure, Finished: 1}
+		require.NoError(t, s.CreatePipeline(pipeline, step))
+		require.NoError(t, s.LogAppend(step, []*model.LogEntry{
+			{StepID: step.ID, Line: 0, Data: []byte("dial tcp")},
+			{StepID: step.ID, Line: 1, Data: []byte(data)},
+		}))
+		s.StepFinished(step)
+	}
+
+	search := func(t *testing.T, query string) *testContext {
+		tc := newTestContext(t, s)
+		withRepo(repo, nil)(tc)
+		tc.Ctx.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+		SearchLogs(tc.Ctx)
+		return tc
+	}
+
+	t.Run("returns matching lines with context", func(t *testing.T) {
+		tc := search(t, "q=Connection+Refused")
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var matches []*model.LogMatch
+		tc.decodeJSON(t, &matches)
+		require.Len(t, matches, 1)
+		assert.Equal(t, int64(1), matches[0].PipelineNumber)
+		assert.Equal(t, "test", matches[0].StepName)
+		assert.Equal(t, 1, matches[0].Line)
+		assert.Equal(t, "connection refused", matches[0].Text)
+		assert.Equal(t, []string{"dial tcp"}, matches[0].Before)
+	})
+
+	t.Run("ignores pipelines before since", func(t *testing.T) {
+		tc := search(t, "q=refused&since=2100-01-01T00:00:00Z")
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		assert.JSONEq(t, "[]", tc.Recorder.Body.String())
+	})
+
+	t.Run("rejects invalid queries", func(t *testing.T) {
+		for _, query := range []string{"", "q=refused&since=yesterday", "q=refused&context=100"} {
+			tc := search(t, query)
+			assert.Equal(t, http.StatusBadRequest, tc.Recorder.Code, query)
+		}
+	})
+}
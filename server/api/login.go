@@ -121,8 +121,10 @@ func HandleAuth(c *gin.Context) {
 	}
 
 	userFromForge, redirectURL, err := _forge.Login(c, &forge_types.OAuthRequest{
-		Code:  c.Request.FormValue("code"),
-		State: state,
+		Code:     c.Request.FormValue("code"),
+		State:    state,
+		Login:    c.Request.PostFormValue("login"),
+		Password: c.Request.PostFormValue("password"),
 	})
 	if err != nil {
 		log.Error().Err(err).Msg("cannot authenticate user")
@@ -0,0 +1,56 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+)
+
+// GetOpenIDConfiguration
+//
+//	@Summary		Get the OpenID Connect discovery document
+//	@Description	Relying parties use it to verify the identity tokens issued to pipeline steps.
+//	@Router			/.well-known/openid-configuration [get]
+//	@Produce		json
+//	@Success		200	{object}	OpenIDConfiguration
+//	@Tags			System
+func GetOpenIDConfiguration(c *gin.Context) {
+	issuer := server.Config.Services.IDTokens
+	if issuer == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	c.JSON(http.StatusOK, issuer.Configuration())
+}
+
+// GetJWKS
+//
+//	@Summary	Get the keys identity tokens are signed with
+//	@Router		/oidc/jwks [get]
+//	@Produce	json
+//	@Success	200	{object}	JWKS
+//	@Tags		System
+func GetJWKS(c *gin.Context) {
+	issuer := server.Config.Services.IDTokens
+	if issuer == nil {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	c.JSON(http.StatusOK, issuer.JWKS())
+}
@@ -0,0 +1,73 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/idtoken"
+)
+
+func TestOpenIDConfiguration(t *testing.T) {
+	s := newTestStore(t)
+
+	orig := server.Config.Services.IDTokens
+	t.Cleanup(func() { server.Config.Services.IDTokens = orig })
+
+	t.Run("disabled issuer returns not found", func(t *testing.T) {
+		server.Config.Services.IDTokens = nil
+		tc := newTestContext(t, s)
+
+		GetOpenIDConfiguration(tc.Ctx)
+
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+	})
+
+	issuer, err := idtoken.New(s, "https://ci.example.com")
+	require.NoError(t, err)
+	server.Config.Services.IDTokens = issuer
+
+	t.Run("discovery document points to the keys", func(t *testing.T) {
+		tc := newTestContext(t, s)
+
+		GetOpenIDConfiguration(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var config idtoken.Configuration
+		tc.decodeJSON(t, &config)
+		assert.Equal(t, "https://ci.example.com", config.Issuer)
+		assert.Equal(t, "https://ci.example.com/api/oidc/jwks", config.JWKSURI)
+	})
+
+	t.Run("jwks contains the signing key", func(t *testing.T) {
+		tc := newTestContext(t, s)
+
+		GetJWKS(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var jwks idtoken.JWKS
+		tc.decodeJSON(t, &jwks)
+		require.Len(t, jwks.Keys, 1)
+		assert.Equal(t, "RS256", jwks.Keys[0].Algorithm)
+		assert.NotEmpty(t, jwks.Keys[0].KeyID)
+	})
+}
@@ -181,6 +181,43 @@ func LookupOrg(c *gin.Context) {
 	c.JSON(http.StatusOK, org)
 }
 
+// PatchOrg
+//
+//	@Summary		Update an organization
+//	@Description	Updates the given org. Requires admin rights.
+//	@Router			/orgs/{org_id} [patch]
+//	@Produce		json
+//	@Success		200	{object}	Org
+//	@Tags			Orgs
+//	@Param			Authorization	header	string		true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string		true	"the org's id"
+//	@Param			org				body	OrgPatch	true	"the org's information"
+func PatchOrg(c *gin.Context) {
+	_store := store.FromContext(c)
+	org := session.Org(c)
+
+	in := new(model.OrgPatch)
+	if err := c.Bind(in); err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if in.ShareWeight != nil {
+		if *in.ShareWeight < 1 {
+			c.String(http.StatusBadRequest, "Share weight must be at least 1")
+			return
+		}
+		org.ShareWeight = *in.ShareWeight
+	}
+
+	if err := _store.OrgUpdate(org); err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, org)
+}
+
 // DeleteOrg
 //
 //	@Summary		Delete an organization
@@ -109,6 +109,7 @@ func PostOrgRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting org %q registry %q. %s", org.ID, in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryCreate, registryAuditTarget(registry, nil), nil, registry)
 	c.JSON(http.StatusOK, registry.Copy())
 }
 
@@ -139,6 +140,7 @@ func PatchOrgRegistry(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldRegistry := *registry
 	if in.Address != "" {
 		registry.Address = in.Address
 	}
@@ -158,6 +160,7 @@ func PatchOrgRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating org %q registry %q. %s", org.ID, in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryUpdate, registryAuditTarget(registry, nil), &oldRegistry, registry)
 	c.JSON(http.StatusOK, registry.Copy())
 }
 
@@ -176,9 +179,15 @@ func DeleteOrgRegistry(c *gin.Context) {
 	addr := c.Param("registry")
 
 	registryService := server.Config.Services.Manager.RegistryService()
+	registry, err := registryService.OrgRegistryFind(org.ID, addr)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
 	if err := registryService.OrgRegistryDelete(org.ID, addr); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryDelete, registryAuditTarget(registry, nil), registry, nil)
 	c.Status(http.StatusNoContent)
 }
@@ -30,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	registry_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/registry/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -53,6 +54,10 @@ func newOrgRegistryCtxWithService(t *testing.T, method string, body any) (*gin.C
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("RegistryService").Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -209,6 +214,7 @@ func TestDeleteOrgRegistry(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newOrgRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "docker.io"}}
+		svc.On("OrgRegistryFind", orgSecretID, "docker.io").Return(storedOrgRegistry(), nil)
 		svc.On("OrgRegistryDelete", orgSecretID, "docker.io").Return(nil)
 
 		DeleteOrgRegistry(c)
@@ -219,7 +225,7 @@ func TestDeleteOrgRegistry(t *testing.T) {
 	t.Run("missing registry returns not found", func(t *testing.T) {
 		c, rec, svc := newOrgRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "nope"}}
-		svc.On("OrgRegistryDelete", orgSecretID, "nope").Return(types.ErrRecordNotExist)
+		svc.On("OrgRegistryFind", orgSecretID, "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteOrgRegistry(c)
 
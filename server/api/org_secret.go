@@ -111,6 +111,7 @@ func PostOrgSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting org %q secret %q. %s", org.ID, in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretCreate, secretAuditTarget(secret, nil), nil, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -141,6 +142,7 @@ func PatchOrgSecret(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldSecret := *secret
 	if in.Value != nil && *in.Value != "" {
 		secret.Value = *in.Value
 	}
@@ -163,6 +165,7 @@ func PatchOrgSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating org %q secret %q. %s", org.ID, in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretUpdate, secretAuditTarget(secret, nil), &oldSecret, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -181,9 +184,15 @@ func DeleteOrgSecret(c *gin.Context) {
 	name := c.Param("secret")
 
 	secretService := server.Config.Services.Manager.SecretService()
+	secret, err := secretService.OrgSecretFind(org.ID, name)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
 	if err := secretService.OrgSecretDelete(org.ID, name); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretDelete, secretAuditTarget(secret, nil), secret, nil)
 	c.Status(http.StatusNoContent)
 }
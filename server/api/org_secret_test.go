@@ -30,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	secret_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/secret/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -61,6 +62,10 @@ func newOrgSecretCtxWithService(t *testing.T, method string, body any) (*gin.Con
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("SecretService").Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -218,6 +223,7 @@ func TestDeleteOrgSecret(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newOrgSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "api_token"}}
+		svc.On("OrgSecretFind", orgSecretID, "api_token").Return(storedOrgSecret(), nil)
 		svc.On("OrgSecretDelete", orgSecretID, "api_token").Return(nil)
 
 		DeleteOrgSecret(c)
@@ -228,7 +234,7 @@ func TestDeleteOrgSecret(t *testing.T) {
 	t.Run("missing secret returns not found", func(t *testing.T) {
 		c, rec, svc := newOrgSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "nope"}}
-		svc.On("OrgSecretDelete", orgSecretID, "nope").Return(types.ErrRecordNotExist)
+		svc.On("OrgSecretFind", orgSecretID, "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteOrgSecret(c)
 
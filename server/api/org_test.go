@@ -165,3 +165,42 @@ func TestGetOrgPermissions(t *testing.T) {
 		assert.True(t, membership.called)
 	})
 }
+
+func TestPatchOrg(t *testing.T) {
+	s := newTestStore(t)
+
+	t.Run("update share weight", func(t *testing.T) {
+		org := &model.Org{Name: "weighted-org", ForgeID: 1}
+		require.NoError(t, s.OrgCreate(org))
+
+		weight := 3
+		tc := newTestContext(t, s)
+		withRequest(http.MethodPatch, &model.OrgPatch{ShareWeight: &weight})(tc)
+		tc.Ctx.Set("org", org)
+
+		PatchOrg(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		out := new(model.Org)
+		tc.decodeJSON(t, out)
+		assert.Equal(t, 3, out.ShareWeight)
+
+		stored, err := s.OrgGet(org.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, stored.ShareWeight)
+	})
+
+	t.Run("reject share weight below one", func(t *testing.T) {
+		org := &model.Org{Name: "invalid-org", ForgeID: 1}
+		require.NoError(t, s.OrgCreate(org))
+
+		weight := 0
+		tc := newTestContext(t, s)
+		withRequest(http.MethodPatch, &model.OrgPatch{ShareWeight: &weight})(tc)
+		tc.Ctx.Set("org", org)
+
+		PatchOrg(tc.Ctx)
+
+		assert.Equal(t, http.StatusBadRequest, tc.Recorder.Code)
+	})
+}
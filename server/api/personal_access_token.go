@@ -0,0 +1,140 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
+)
+
+// GetPersonalAccessTokens
+//
+//	@Summary	List the personal access tokens of the current user
+//	@Router		/user/tokens [get]
+//	@Produce	json
+//	@Success	200	{array}	PersonalAccessToken
+//	@Tags		User
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+func GetPersonalAccessTokens(c *gin.Context) {
+	tokens, err := store.FromContext(c).PersonalAccessTokenList(session.User(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting personal access tokens. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+// PostPersonalAccessToken
+//
+//	@Summary		Create a personal access token
+//	@Description	The token is only returned once. A personal access token can only create tokens with scopes it has itself.
+//	@Router			/user/tokens [post]
+//	@Produce		json
+//	@Success		200	{object}	NewPersonalAccessToken
+//	@Tags			User
+//	@Param			Authorization	header	string						true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			token			body	PersonalAccessTokenCreate	true	"the name, scopes and expiry of the token"
+func PostPersonalAccessToken(c *gin.Context) {
+	user := session.User(c)
+
+	in := new(model.PersonalAccessTokenCreate)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing request. %s", err)
+		return
+	}
+	pat := &model.PersonalAccessToken{
+		UserID:  user.ID,
+		Name:    strings.TrimSpace(in.Name),
+		Scopes:  in.Scopes,
+		Expires: in.Expires,
+	}
+	if err := pat.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error creating personal access token. %s", err)
+		return
+	}
+
+	// a token must not create a token with more rights than it has itself
+	if current := session.PersonalAccessToken(c); current != nil {
+		for _, scope := range pat.Scopes {
+			if !current.HasScope(scope) {
+				c.String(http.StatusForbidden, "Token is missing the %s scope", scope)
+				return
+			}
+		}
+	}
+
+	_store := store.FromContext(c)
+	if err := _store.PersonalAccessTokenCreate(pat); err != nil {
+		if errors.Is(err, types.ErrInsertDuplicateDetected) {
+			c.String(http.StatusConflict, "personal access token with this name exists already")
+		} else {
+			c.String(http.StatusInternalServerError, "Error creating personal access token %q. %s", pat.Name, err)
+		}
+		return
+	}
+
+	t := token.New(token.PersonalToken)
+	t.Set("user-id", strconv.FormatInt(user.ID, 10))
+	t.Set("token-id", strconv.FormatInt(pat.ID, 10))
+	tokenString, err := t.SignExpires(user.Hash, pat.Expires)
+	if err != nil {
+		_ = _store.PersonalAccessTokenDelete(pat)
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	recordAudit(c, model.AuditTokenCreate, tokenAuditTarget(pat), nil, pat)
+	c.JSON(http.StatusOK, &model.NewPersonalAccessToken{PersonalAccessToken: pat, Token: tokenString})
+}
+
+// DeletePersonalAccessToken
+//
+//	@Summary	Revoke a personal access token
+//	@Router		/user/tokens/{token_id} [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		User
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		token_id		path	int		true	"the personal access token id"
+func DeletePersonalAccessToken(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("token_id"), 10, 64)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Error parsing token id. %s", err)
+		return
+	}
+
+	_store := store.FromContext(c)
+	pat, err := _store.PersonalAccessTokenFind(session.User(c), id)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	if err := _store.PersonalAccessTokenDelete(pat); err != nil {
+		handleDBError(c, err)
+		return
+	}
+	recordAudit(c, model.AuditTokenDelete, tokenAuditTarget(pat), pat, nil)
+	c.Status(http.StatusNoContent)
+}
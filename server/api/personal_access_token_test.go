@@ -0,0 +1,115 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
+)
+
+func TestPersonalAccessTokens(t *testing.T) {
+	s := newTestStore(t)
+	user := &model.User{Login: "alice", ForgeRemoteID: "1", Hash: "alice-hash"}
+	require.NoError(t, s.CreateUser(user))
+
+	t.Run("invalid scope is rejected", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withUser(user)(tc)
+		withRequest(http.MethodPost, model.PersonalAccessTokenCreate{Name: "ci", Scopes: []model.TokenScope{"repo:everything"}})(tc)
+
+		PostPersonalAccessToken(tc.Ctx)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, tc.Recorder.Code)
+	})
+
+	var created model.NewPersonalAccessToken
+	t.Run("create returns the signed token", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withUser(user)(tc)
+		expires := time.Now().Add(time.Hour).Unix()
+		withRequest(http.MethodPost, model.PersonalAccessTokenCreate{Name: "ci", Scopes: []model.TokenScope{model.TokenScopeRepoRead}, Expires: expires})(tc)
+
+		PostPersonalAccessToken(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		tc.decodeJSON(t, &created)
+		assert.NotZero(t, created.ID)
+		assert.Equal(t, expires, created.Expires)
+
+		parsed, err := token.Parse([]token.Type{token.PersonalToken}, created.Token, func(*token.Token) (string, error) {
+			return user.Hash, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, strconv.FormatInt(created.ID, 10), parsed.Get("token-id"))
+		assert.Equal(t, strconv.FormatInt(user.ID, 10), parsed.Get("user-id"))
+	})
+
+	t.Run("names are unique", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withUser(user)(tc)
+		withRequest(http.MethodPost, model.PersonalAccessTokenCreate{Name: "ci", Scopes: []model.TokenScope{model.TokenScopeRepoRead}})(tc)
+
+		PostPersonalAccessToken(tc.Ctx)
+
+		assert.Equal(t, http.StatusConflict, tc.Recorder.Code)
+	})
+
+	t.Run("token can not grant scopes it does not have", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withUser(user)(tc)
+		tc.Ctx.Set("personal-access-token", &model.PersonalAccessToken{Scopes: []model.TokenScope{model.TokenScopeUserWrite, model.TokenScopeRepoRead}})
+		withRequest(http.MethodPost, model.PersonalAccessTokenCreate{Name: "deploy", Scopes: []model.TokenScope{model.TokenScopePipelineWrite}})(tc)
+
+		PostPersonalAccessToken(tc.Ctx)
+
+		assert.Equal(t, http.StatusForbidden, tc.Recorder.Code)
+	})
+
+	t.Run("list returns the tokens without their value", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withUser(user)(tc)
+
+		GetPersonalAccessTokens(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		assert.NotContains(t, tc.Recorder.Body.String(), created.Token)
+		var tokens []*model.PersonalAccessToken
+		tc.decodeJSON(t, &tokens)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, "ci", tokens[0].Name)
+	})
+
+	t.Run("delete revokes the token", func(t *testing.T) {
+		for _, code := range []int{http.StatusNoContent, http.StatusNotFound} {
+			tc := newTestContext(t, s)
+			withUser(user)(tc)
+			withParam("token_id", strconv.FormatInt(created.ID, 10))(tc)
+
+			DeletePersonalAccessToken(tc.Ctx)
+
+			assert.Equal(t, code, tc.Ctx.Writer.Status())
+		}
+	})
+}
@@ -35,6 +35,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
 	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline/metadata"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
@@ -214,7 +215,20 @@ func DeletePipeline(c *gin.Context) {
 		return
 	}
 
-	err := store.FromContext(c).DeletePipeline(pl)
+	_store := store.FromContext(c)
+	artifacts, err := _store.ArtifactList(pl)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	for _, upload := range artifacts {
+		if err := artifact.Delete(c, _store, server.Config.Services.Artifacts, upload); err != nil {
+			c.String(http.StatusInternalServerError, "Error deleting pipeline artifacts. %s", err)
+			return
+		}
+	}
+
+	err = _store.DeletePipeline(pl)
 	if err != nil {
 		c.String(http.StatusInternalServerError, "Error deleting pipeline. %s", err)
 		return
@@ -280,8 +294,33 @@ func GetPipelineLastByBranch(c *gin.Context) {
 
 // GetStepLogs
 //
-//	@Summary	Get logs for a pipeline step
-//	@Router		/repos/{repo_id}/logs/{pipeline_number}/{step_id} [get]
+//	@Summary		Get logs for a pipeline step
+//	@Description	Entries of type LogEntryMetadata carry a JSON encoded object in their data, marking where a command of the step starts or ends:
+//	@Description	{"event": "start"|"end", "index": 0, "started": <unix ms>, "duration": <ms>, "exit_code": 0}
+//	@Router			/repos/{repo_id}/logs/{pipeline_number}/{step_id} [get]
+//	@Produce		json
+//	@Success		200	{array}	LogEntry
+//	@Tags			Pipeline logs
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			pipeline_number	path	int		true	"the number of the pipeline"
+//	@Param			step_id			path	int		true	"the step id"
+func GetStepLogs(c *gin.Context) {
+	step := session.Step(c)
+
+	logs, err := server.Config.Services.LogStore.LogFind(step)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
+
+// GetStepAttemptLogs
+//
+//	@Summary	Get the logs a pipeline step wrote during a previous attempt of its workflow
+//	@Router		/repos/{repo_id}/logs/{pipeline_number}/{step_id}/attempts/{attempt} [get]
 //	@Produce	json
 //	@Success	200	{array}	LogEntry
 //	@Tags		Pipeline logs
@@ -289,10 +328,18 @@ func GetPipelineLastByBranch(c *gin.Context) {
 //	@Param		repo_id			path	int		true	"the repository id"
 //	@Param		pipeline_number	path	int		true	"the number of the pipeline"
 //	@Param		step_id			path	int		true	"the step id"
-func GetStepLogs(c *gin.Context) {
+//	@Param		attempt			path	int		true	"the number of the attempt"
+func GetStepAttemptLogs(c *gin.Context) {
+	_store := store.FromContext(c)
 	step := session.Step(c)
 
-	logs, err := server.Config.Services.LogStore.LogFind(step)
+	attempt, err := strconv.Atoi(c.Param("attempt"))
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	logs, err := _store.LogAttemptFind(step, attempt)
 	if err != nil {
 		handleDBError(c, err)
 		return
@@ -329,8 +376,13 @@ func DownloadStepLogs(c *gin.Context) {
 	c.Header("X-Content-Type-Options", "nosniff")
 	c.Status(http.StatusOK)
 
-	for i, entry := range logs {
-		if i > 0 {
+	first := true
+	for _, entry := range logs {
+		// metadata like the command sections is of no use in a plain text file
+		if entry.Type == model.LogEntryMetadata {
+			continue
+		}
+		if !first {
 			if _, err = io.WriteString(c.Writer, "\n"); err != nil {
 				_ = c.Error(err)
 				return
@@ -340,6 +392,7 @@ func DownloadStepLogs(c *gin.Context) {
 			_ = c.Error(err)
 			return
 		}
+		first = false
 	}
 }
 
@@ -380,6 +433,10 @@ func DeleteStepLogs(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	if err := _store.LogAttemptDelete(_step); err != nil {
+		handleDBError(c, err)
+		return
+	}
 
 	c.Status(http.StatusNoContent)
 }
@@ -407,6 +464,174 @@ func GetPipelineConfig(c *gin.Context) {
 	c.JSON(http.StatusOK, configs)
 }
 
+// GetPipelineAttempts
+//
+//	@Summary	List the finished attempts of all workflows of a pipeline
+//	@Router		/repos/{repo_id}/pipelines/{pipeline_number}/attempts [get]
+//	@Produce	json
+//	@Success	200	{array}	WorkflowAttempt
+//	@Tags		Pipelines
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		repo_id			path	int		true	"the repository id"
+//	@Param		pipeline_number	path	int		true	"the number of the pipeline"
+func GetPipelineAttempts(c *gin.Context) {
+	_store := store.FromContext(c)
+	pl := session.Pipeline(c)
+
+	attempts, err := _store.WorkflowAttemptList(pl)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// GetPipelineArtifacts
+//
+//	@Summary	List the artifacts uploaded by the workflows of a pipeline
+//	@Router		/repos/{repo_id}/pipelines/{pipeline_number}/artifacts [get]
+//	@Produce	json
+//	@Success	200	{array}	Artifact
+//	@Tags		Pipelines
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		repo_id			path	int		true	"the repository id"
+//	@Param		pipeline_number	path	int		true	"the number of the pipeline"
+func GetPipelineArtifacts(c *gin.Context) {
+	_store := store.FromContext(c)
+	pl := session.Pipeline(c)
+
+	artifacts, err := _store.ArtifactList(pl)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, artifacts)
+}
+
+// GetPipelineArtifact
+//
+//	@Summary		Download an artifact of a pipeline
+//	@Description	The artifact is returned as gzip compressed tar archive of its files.
+//	@Router			/repos/{repo_id}/pipelines/{pipeline_number}/artifacts/{artifact_id} [get]
+//	@Produce		application/gzip
+//	@Success		200
+//	@Tags			Pipelines
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			pipeline_number	path	int		true	"the number of the pipeline"
+//	@Param			artifact_id		path	int		true	"the id of the artifact"
+func GetPipelineArtifact(c *gin.Context) {
+	_store := store.FromContext(c)
+	pl := session.Pipeline(c)
+
+	artifactID, err := strconv.ParseInt(c.Param("artifact_id"), 10, 64)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	upload, err := _store.ArtifactFind(pl, artifactID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	content, err := server.Config.Services.Artifacts.ArtifactRead(c, upload)
+	if err != nil {
+		_ = c.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, upload.Size, "application/gzip", content, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": upload.Name + ".tar.gz"}),
+	})
+}
+
+// testHistoryPipelines is the number of previous pipelines the history of a
+// test covers.
+const testHistoryPipelines = 10
+
+// GetPipelineTests
+//
+//	@Summary		List the test results of a pipeline
+//	@Description	The results are parsed from the test reports uploaded by the steps of the pipeline. Each result contains the status of the test in the previous pipelines of the repository, a test which passed and failed within them is flaky.
+//	@Router			/repos/{repo_id}/pipelines/{pipeline_number}/tests [get]
+//	@Produce		json
+//	@Success		200	{array}	TestResult
+//	@Tags			Pipelines
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			pipeline_number	path	int		true	"the number of the pipeline"
+//	@Param			status			query	string	false	"filter test results by status"
+//	@Param			flaky			query	bool	false	"only return flaky tests"
+func GetPipelineTests(c *gin.Context) {
+	_store := store.FromContext(c)
+	repo := session.Repo(c)
+	pl := session.Pipeline(c)
+
+	var status model.TestStatus
+	if s := c.Query("status"); s != "" {
+		status = model.TestStatus(s)
+		if err := status.Validate(); err != nil {
+			_ = c.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+	}
+	onlyFlaky := c.Query("flaky") == "true"
+
+	results, err := _store.TestResultList(pl)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	history, err := _store.TestResultHistory(repo, pl.Number, testHistoryPipelines)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+
+	// the history is ordered by pipeline, the latest first
+	runs := make(map[string][]*model.TestRun)
+	for _, previous := range history {
+		key := previous.Key()
+		if n := len(runs[key]); n > 0 && runs[key][n-1].PipelineNumber == previous.PipelineNumber {
+			// matrix workflows report a test once per workflow, a failure wins
+			if previous.Status.Failed() {
+				runs[key][n-1].Status = previous.Status
+			}
+			continue
+		}
+		runs[key] = append(runs[key], &model.TestRun{PipelineNumber: previous.PipelineNumber, Status: previous.Status})
+	}
+
+	filtered := make([]*model.TestResult, 0, len(results))
+	for _, result := range results {
+		result.History = runs[result.Key()]
+		result.Flaky = isFlakyTest(result)
+		if (status != "" && result.Status != status) || (onlyFlaky && !result.Flaky) {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+
+	c.JSON(http.StatusOK, filtered)
+}
+
+// isFlakyTest reports whether a test passed and failed within its history.
+func isFlakyTest(result *model.TestResult) bool {
+	passed := result.Status == model.TestStatusPassed
+	failed := result.Status.Failed()
+	for _, run := range result.History {
+		passed = passed || run.Status == model.TestStatusPassed
+		failed = failed || run.Status.Failed()
+	}
+	return passed && failed
+}
+
 // GetPipelineMetadata
 //
 //	@Summary	Get metadata for a pipeline or a specific workflow, including previous pipeline info
@@ -488,10 +713,12 @@ func PostApproval(c *gin.Context) {
 		pl     = session.Pipeline(c)
 	)
 
+	oldValue := pipelineAuditValue(pl)
 	newPipeline, err := pipeline.Approve(c, _store, pl, user, repo)
 	if err != nil {
 		handlePipelineErr(c, err)
 	} else {
+		recordAudit(c, model.AuditPipelineApprove, pipelineAuditTarget(newPipeline, repo), oldValue, pipelineAuditValue(newPipeline))
 		c.JSON(http.StatusOK, newPipeline.ToAPIModel())
 	}
 }
@@ -514,10 +741,12 @@ func PostDecline(c *gin.Context) {
 		pl     = session.Pipeline(c)
 	)
 
+	oldValue := pipelineAuditValue(pl)
 	pl, err := pipeline.Decline(c, _store, pl, user, repo)
 	if err != nil {
 		handlePipelineErr(c, err)
 	} else {
+		recordAudit(c, model.AuditPipelineDecline, pipelineAuditTarget(pl, repo), oldValue, pipelineAuditValue(pl))
 		c.JSON(http.StatusOK, pl.ToAPIModel())
 	}
 }
@@ -640,6 +869,9 @@ func DeletePipelineLogs(c *gin.Context) {
 		if lErr := server.Config.Services.LogStore.LogDelete(step); err != nil {
 			err = errors.Join(err, lErr)
 		}
+		if lErr := _store.LogAttemptDelete(step); lErr != nil {
+			err = errors.Join(err, lErr)
+		}
 	}
 	if err != nil {
 		c.String(http.StatusInternalServerError, "Error deleting pipeline logs. %s", err)
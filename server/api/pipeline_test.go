@@ -34,6 +34,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub/memory"
 	queue_mocks "go.woodpecker-ci.org/woodpecker/v3/server/queue/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/scheduler"
+	artifact_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/mocks"
 	config_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/config/mocks"
 	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
@@ -135,6 +136,7 @@ func TestDownloadStepLogs(t *testing.T) {
 	logs := []*model.LogEntry{
 		{StepID: step.ID, Line: 0, Data: []byte("first line")},
 		{StepID: step.ID, Line: 1, Data: []byte("\x1b[31msecond line\x1b[0m")},
+		{StepID: step.ID, Line: 2, Type: model.LogEntryMetadata, Data: []byte(`{"event":"end","index":0,"started":1,"duration":1}`)},
 	}
 
 	mockLogStore := log_mocks.NewMockService(t)
@@ -164,7 +166,17 @@ func TestDeletePipeline(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	t.Run("should delete pipeline", func(t *testing.T) {
+		artifacts := []*model.Artifact{{ID: 1, PipelineID: 2, Name: "dist"}}
+
+		mockArtifacts := artifact_mocks.NewMockService(t)
+		mockArtifacts.On("ArtifactDelete", mock.Anything, artifacts[0]).Return(nil)
+		origArtifacts := server.Config.Services.Artifacts
+		server.Config.Services.Artifacts = mockArtifacts
+		t.Cleanup(func() { server.Config.Services.Artifacts = origArtifacts })
+
 		mockStore := store_mocks.NewMockStore(t)
+		mockStore.On("ArtifactList", fakePipeline).Return(artifacts, nil)
+		mockStore.On("ArtifactDelete", artifacts[0]).Return(nil)
 		mockStore.On("DeletePipeline", mock.Anything).Return(nil)
 
 		c, _ := gin.CreateTestContext(httptest.NewRecorder())
@@ -240,6 +252,134 @@ func TestGetPipelineMetadata(t *testing.T) {
 	})
 }
 
+func TestGetPipelineAttempts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	attempts := []*model.WorkflowAttempt{
+		{ID: 1, PipelineID: 2, WorkflowID: 3, Attempt: 1, State: model.StatusFailure, Failure: model.FailureAgentLost},
+	}
+
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("WorkflowAttemptList", fakePipeline).Return(attempts, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Set("store", mockStore)
+	c.Set("pipeline", fakePipeline)
+
+	GetPipelineAttempts(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response []*model.WorkflowAttempt
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, attempts, response)
+}
+
+func TestGetPipelineArtifact(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	artifact := &model.Artifact{ID: 5, PipelineID: 2, Name: "dist", Size: 7}
+
+	mockArtifacts := artifact_mocks.NewMockService(t)
+	mockArtifacts.On("ArtifactRead", mock.Anything, artifact).Return(io.NopCloser(bytes.NewBufferString("archive")), nil)
+	origArtifacts := server.Config.Services.Artifacts
+	server.Config.Services.Artifacts = mockArtifacts
+	t.Cleanup(func() { server.Config.Services.Artifacts = origArtifacts })
+
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("ArtifactFind", fakePipeline, int64(5)).Return(artifact, nil)
+	mockStore.On("ArtifactFind", fakePipeline, int64(6)).Return(nil, types.ErrRecordNotExist)
+
+	t.Run("download", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Set("pipeline", fakePipeline)
+		c.Params = gin.Params{{Key: "artifact_id", Value: "5"}}
+
+		GetPipelineArtifact(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=dist.tar.gz", w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "archive", w.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Set("store", mockStore)
+		c.Set("pipeline", fakePipeline)
+		c.Params = gin.Params{{Key: "artifact_id", Value: "6"}}
+
+		GetPipelineArtifact(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetPipelineTests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	repo := &model.Repo{ID: 1}
+	result := func(number int64, name string, status model.TestStatus) *model.TestResult {
+		return &model.TestResult{PipelineNumber: number, Suite: "unit", Name: name, Status: status}
+	}
+
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("TestResultList", fakePipeline).Return([]*model.TestResult{
+		result(2, "a", model.TestStatusFailed),
+		result(2, "b", model.TestStatusPassed),
+		result(2, "c", model.TestStatusPassed),
+	}, nil)
+	mockStore.On("TestResultHistory", repo, int64(2), testHistoryPipelines).Return([]*model.TestResult{
+		// a passed in one workflow of the matrix and failed in the other
+		result(1, "a", model.TestStatusPassed),
+		result(1, "a", model.TestStatusError),
+		result(1, "b", model.TestStatusPassed),
+		result(1, "c", model.TestStatusFailed),
+	}, nil)
+
+	get := func(query string) (int, []*model.TestResult) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/?"+query, nil)
+		c.Set("store", mockStore)
+		c.Set("repo", repo)
+		c.Set("pipeline", fakePipeline)
+
+		GetPipelineTests(c)
+
+		var response []*model.TestResult
+		if w.Code == http.StatusOK {
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		}
+		return w.Code, response
+	}
+
+	code, results := get("")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, results, 3)
+	assert.Equal(t, []*model.TestRun{{PipelineNumber: 1, Status: model.TestStatusError}}, results[0].History)
+	assert.False(t, results[0].Flaky)
+	assert.False(t, results[1].Flaky)
+	assert.True(t, results[2].Flaky)
+
+	code, results = get("status=failed")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "a", results[0].Name)
+
+	code, results = get("flaky=true")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "c", results[0].Name)
+
+	code, _ = get("status=unknown")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
 func TestCancelPipeline(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -331,6 +471,7 @@ func TestCreatePipeline(t *testing.T) {
 		mockStore.On("PipelineConfigCreate", mock.Anything).Return(nil).Maybe()
 		mockStore.On("WorkflowsCreate", mock.Anything).Return(nil)
 		mockStore.On("UpdatePipeline", mock.Anything).Return(nil).Maybe()
+		mockStore.On("UpdatePipelineErrors", mock.Anything).Return(nil).Maybe()
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -403,6 +544,7 @@ func TestCreatePipeline(t *testing.T) {
 		mockStore.On("PipelineConfigCreate", mock.Anything).Return(nil).Maybe()
 		mockStore.On("WorkflowsCreate", mock.Anything).Return(nil)
 		mockStore.On("UpdatePipeline", mock.Anything).Return(nil).Maybe()
+		mockStore.On("UpdatePipelineErrors", mock.Anything).Return(nil).Maybe()
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
@@ -447,6 +589,7 @@ func TestCreatePipeline(t *testing.T) {
 		mockStore.On("GetUser", int64(1)).Return(fakeUser, nil)
 		mockStore.On("CreatePipeline", mock.Anything).Return(nil)
 		mockStore.On("UpdatePipeline", mock.Anything).Return(nil)
+		mockStore.On("UpdatePipelineErrors", mock.Anything).Return(nil)
 
 		w := httptest.NewRecorder()
 		c, _ := gin.CreateTestContext(w)
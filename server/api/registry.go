@@ -81,6 +81,7 @@ func PostRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting registry %q. %s", in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryCreate, registryAuditTarget(registry, repo), nil, registry)
 	c.JSON(http.StatusOK, in.Copy())
 }
 
@@ -112,6 +113,7 @@ func PatchRegistry(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldRegistry := *registry
 	if in.Username != "" {
 		registry.Username = in.Username
 	}
@@ -127,6 +129,7 @@ func PatchRegistry(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating registry %q. %s", in.Address, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryUpdate, registryAuditTarget(registry, repo), &oldRegistry, registry)
 	c.JSON(http.StatusOK, in.Copy())
 }
 
@@ -172,10 +175,16 @@ func DeleteRegistry(c *gin.Context) {
 	addr := c.Param("registry")
 
 	registryService := server.Config.Services.Manager.RegistryServiceFromRepo(repo)
-	err := registryService.RegistryDelete(repo, addr)
+	registry, err := registryService.RegistryFind(repo, addr)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	err = registryService.RegistryDelete(repo, addr)
 	if err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditRegistryDelete, registryAuditTarget(registry, repo), registry, nil)
 	c.Status(http.StatusNoContent)
 }
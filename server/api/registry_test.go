@@ -30,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	registry_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/registry/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -58,6 +59,10 @@ func newRegistryCtxWithService(t *testing.T, method string, body any) (*gin.Cont
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("RegistryServiceFromRepo", mock.Anything).Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -218,6 +223,7 @@ func TestDeleteRegistry(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "docker.io"}}
+		svc.On("RegistryFind", secretTestRepo, "docker.io").Return(storedRegistry(), nil)
 		svc.On("RegistryDelete", secretTestRepo, "docker.io").Return(nil)
 
 		DeleteRegistry(c)
@@ -228,7 +234,7 @@ func TestDeleteRegistry(t *testing.T) {
 	t.Run("missing registry returns not found", func(t *testing.T) {
 		c, rec, svc := newRegistryCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "registry", Value: "nope"}}
-		svc.On("RegistryDelete", secretTestRepo, "nope").Return(types.ErrRecordNotExist)
+		svc.On("RegistryFind", secretTestRepo, "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteRegistry(c)
 
@@ -116,7 +116,10 @@ func PostRepo(c *gin.Context) {
 	}
 
 	from.ForgeID = user.ForgeID
+	var oldRepo *model.Repo
 	if enabledOnce {
+		oldRepo = new(model.Repo)
+		*oldRepo = *repo
 		repo.Update(from)
 	} else {
 		repo = from
@@ -210,6 +213,7 @@ func PostRepo(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditRepoActivate, repoAuditTarget(repo), oldRepo, repo)
 	c.JSON(http.StatusOK, repo)
 }
 
@@ -233,6 +237,7 @@ func PatchRepo(c *gin.Context) {
 		_ = c.AbortWithError(http.StatusBadRequest, err)
 		return
 	}
+	oldRepo := *repo
 
 	if in.Timeout != nil && *in.Timeout > server.Config.Pipeline.MaxTimeout && !user.Admin {
 		c.String(http.StatusForbidden, fmt.Sprintf("Timeout is not allowed to be higher than max timeout (%d min)", server.Config.Pipeline.MaxTimeout))
@@ -269,6 +274,9 @@ func PatchRepo(c *gin.Context) {
 	if in.AllowDeploy != nil {
 		repo.AllowDeploy = *in.AllowDeploy
 	}
+	if in.PRComment != nil {
+		repo.PRComment = *in.PRComment
+	}
 
 	if in.RequireApproval != nil {
 		if mode := model.ApprovalMode(*in.RequireApproval); mode.Valid() {
@@ -288,6 +296,16 @@ func PatchRepo(c *gin.Context) {
 			return
 		}
 	}
+	if in.PollInterval != nil {
+		repo.PollInterval = *in.PollInterval
+		if repo.PollInterval < 0 {
+			c.String(http.StatusBadRequest, "Poll interval must not be negative")
+			return
+		}
+	}
+	if in.Priority != nil {
+		repo.Priority = *in.Priority
+	}
 	if in.Config != nil {
 		repo.Config = *in.Config
 	}
@@ -334,6 +352,16 @@ func PatchRepo(c *gin.Context) {
 		return
 	}
 
+	// forget the heads seen by polling, so enabling it again does not trigger
+	// pipelines for everything pushed meanwhile
+	if repo.PollInterval == 0 && oldRepo.PollInterval != 0 {
+		if err := _store.RepoPollDelete(repo.ID); err != nil {
+			_ = c.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	recordAudit(c, model.AuditRepoUpdate, repoAuditTarget(repo), &oldRepo, repo)
 	c.JSON(http.StatusOK, repo)
 }
 
@@ -350,6 +378,7 @@ func ChownRepo(c *gin.Context) {
 	_store := store.FromContext(c)
 	repo := session.Repo(c)
 	user := session.User(c)
+	oldUserID := repo.UserID
 	repo.UserID = user.ID
 
 	err := _store.UpdateRepo(repo)
@@ -357,6 +386,8 @@ func ChownRepo(c *gin.Context) {
 		_ = c.AbortWithError(http.StatusInternalServerError, err)
 		return
 	}
+	// the owner is not part of the json representation of a repo
+	recordAudit(c, model.AuditRepoChown, repoAuditTarget(repo), map[string]int64{"user_id": oldUserID}, map[string]int64{"user_id": repo.UserID})
 	c.JSON(http.StatusOK, repo)
 }
 
@@ -518,11 +549,13 @@ func DeleteRepo(c *gin.Context) {
 		}
 	}
 
+	oldRepo := *repo
 	if remove {
 		if err := _store.DeleteRepo(repo); err != nil {
 			handleDBError(c, err)
 			return
 		}
+		recordAudit(c, model.AuditRepoDelete, repoAuditTarget(repo), &oldRepo, nil)
 	} else {
 		repo.IsActive = false
 		repo.UserID = 0
@@ -531,11 +564,38 @@ func DeleteRepo(c *gin.Context) {
 			_ = c.AbortWithError(http.StatusInternalServerError, err)
 			return
 		}
+		recordAudit(c, model.AuditRepoDeactivate, repoAuditTarget(repo), &oldRepo, repo)
 	}
 
 	c.JSON(http.StatusOK, repo)
 }
 
+// GetRepoHookURL
+//
+//	@Summary		Get the webhook url of a repository
+//	@Description	Forges without a webhook API need the url to trigger pipelines, e.g. from a post-receive hook of a plain Git server.
+//	@Router			/repos/{repo_id}/hook-url [get]
+//	@Produce		plain
+//	@Success		200	{string}	string
+//	@Tags			Repositories
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+func GetRepoHookURL(c *gin.Context) {
+	repo := session.Repo(c)
+
+	// the same jwt token the forge got on activation
+	t := token.New(token.HookToken)
+	t.Set("repo-forge-remote-id", string(repo.ForgeRemoteID))
+	t.Set("forge-id", strconv.FormatInt(repo.ForgeID, 10))
+	sig, err := t.Sign(repo.Hash)
+	if err != nil {
+		c.String(http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.String(http.StatusOK, "%s/api/hook?access_token=%s", server.Config.Server.WebhookHost, sig)
+}
+
 // RepairRepo
 //
 //	@Summary	Repair a repository
@@ -554,6 +614,7 @@ func RepairRepo(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditRepoRepair, repoAuditTarget(repo), nil, nil)
 	c.Status(http.StatusNoContent)
 }
 
@@ -610,6 +671,7 @@ func MoveRepo(c *gin.Context) {
 		return
 	}
 
+	oldRepo := *repo
 	repo.Update(from)
 
 	// the owner changed, so re-resolve the org of the repo: org-level
@@ -663,6 +725,7 @@ func MoveRepo(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
+	recordAudit(c, model.AuditRepoMove, repoAuditTarget(repo), &oldRepo, repo)
 	c.Status(http.StatusNoContent)
 }
 
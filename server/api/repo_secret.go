@@ -83,6 +83,7 @@ func PostSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error inserting secret %q. %s", in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretCreate, secretAuditTarget(secret, repo), nil, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -116,6 +117,7 @@ func PatchSecret(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	oldSecret := *secret
 	if in.Value != nil && *in.Value != "" {
 		secret.Value = *in.Value
 	}
@@ -137,6 +139,7 @@ func PatchSecret(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error updating secret %q. %s", in.Name, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretUpdate, secretAuditTarget(secret, repo), &oldSecret, secret)
 	c.JSON(http.StatusOK, secret.Copy())
 }
 
@@ -182,9 +185,15 @@ func DeleteSecret(c *gin.Context) {
 	name := c.Param("secret")
 
 	secretService := server.Config.Services.Manager.SecretServiceFromRepo(repo)
+	secret, err := secretService.SecretFind(repo, name)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
 	if err := secretService.SecretDelete(repo, name); err != nil {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditSecretDelete, secretAuditTarget(secret, repo), secret, nil)
 	c.Status(http.StatusNoContent)
 }
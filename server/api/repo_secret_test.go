@@ -30,6 +30,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
 	secret_service_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/secret/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
@@ -62,8 +63,8 @@ func newSecretCtx(t *testing.T, method string, body any) (*gin.Context, *httptes
 
 // newSecretCtxWithService is like newSecretCtx but also wires a mock secret
 // service (returned by a mock manager) into the global config. The secret CRUD
-// handlers only touch the secret service; the underlying store is unit-tested
-// in its own package, so it is mocked here.
+// handlers only touch the secret service and the audit log; the underlying
+// store is unit-tested in its own package, so it is mocked here.
 func newSecretCtxWithService(t *testing.T, method string, body any) (*gin.Context, *httptest.ResponseRecorder, *secret_service_mocks.MockService) {
 	t.Helper()
 	c, rec := newSecretCtx(t, method, body)
@@ -72,6 +73,10 @@ func newSecretCtxWithService(t *testing.T, method string, body any) (*gin.Contex
 	mgr := manager_mocks.NewMockManager(t)
 	mgr.On("SecretServiceFromRepo", mock.Anything).Return(svc)
 	server.Config.Services.Manager = mgr
+
+	_store := store_mocks.NewMockStore(t)
+	_store.On("AuditLogCreate", mock.Anything).Return(nil).Maybe()
+	c.Set("store", _store)
 	return c, rec, svc
 }
 
@@ -235,6 +240,7 @@ func TestDeleteSecret(t *testing.T) {
 	t.Run("happy path returns no content", func(t *testing.T) {
 		c, _, svc := newSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "api_token"}}
+		svc.On("SecretFind", secretTestRepo, "api_token").Return(storedSecret(), nil)
 		svc.On("SecretDelete", secretTestRepo, "api_token").Return(nil)
 
 		DeleteSecret(c)
@@ -245,7 +251,7 @@ func TestDeleteSecret(t *testing.T) {
 	t.Run("missing secret returns not found", func(t *testing.T) {
 		c, rec, svc := newSecretCtxWithService(t, http.MethodDelete, nil)
 		c.Params = gin.Params{{Key: "secret", Value: "nope"}}
-		svc.On("SecretDelete", secretTestRepo, "nope").Return(types.ErrRecordNotExist)
+		svc.On("SecretFind", secretTestRepo, "nope").Return(nil, types.ErrRecordNotExist)
 
 		DeleteSecret(c)
 
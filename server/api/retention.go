@@ -0,0 +1,211 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+// GetGlobalRetentionPolicy
+//
+//	@Summary		Get the global retention policy
+//	@Description	The global policy applies to all repos without an own or an organization policy. Requires admin rights.
+//	@Router			/retention [get]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Retention policies
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+func GetGlobalRetentionPolicy(c *gin.Context) {
+	getRetentionPolicy(c, 0, 0)
+}
+
+// PostGlobalRetentionPolicy
+//
+//	@Summary		Set the global retention policy
+//	@Description	Creates or replaces the global retention policy. Requires admin rights.
+//	@Router			/retention [post]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Retention policies
+//	@Param			Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			policy			body	RetentionPolicy	true	"the retention policy"
+func PostGlobalRetentionPolicy(c *gin.Context) {
+	postRetentionPolicy(c, 0, 0)
+}
+
+// DeleteGlobalRetentionPolicy
+//
+//	@Summary	Delete the global retention policy
+//	@Router		/retention [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Retention policies
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+func DeleteGlobalRetentionPolicy(c *gin.Context) {
+	deleteRetentionPolicy(c, 0, 0)
+}
+
+// GetOrgRetentionPolicy
+//
+//	@Summary		Get the retention policy of an organization
+//	@Description	The organization policy applies to all repos of the organization without an own policy.
+//	@Router			/orgs/{org_id}/retention [get]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Retention policies
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+func GetOrgRetentionPolicy(c *gin.Context) {
+	getRetentionPolicy(c, session.Org(c).ID, 0)
+}
+
+// PostOrgRetentionPolicy
+//
+//	@Summary		Set the retention policy of an organization
+//	@Description	Creates or replaces the retention policy of an organization.
+//	@Router			/orgs/{org_id}/retention [post]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Retention policies
+//	@Param			Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string			true	"the org's id"
+//	@Param			policy			body	RetentionPolicy	true	"the retention policy"
+func PostOrgRetentionPolicy(c *gin.Context) {
+	postRetentionPolicy(c, session.Org(c).ID, 0)
+}
+
+// DeleteOrgRetentionPolicy
+//
+//	@Summary	Delete the retention policy of an organization
+//	@Router		/orgs/{org_id}/retention [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Retention policies
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		org_id			path	string	true	"the org's id"
+func DeleteOrgRetentionPolicy(c *gin.Context) {
+	deleteRetentionPolicy(c, session.Org(c).ID, 0)
+}
+
+// GetRepoRetentionPolicy
+//
+//	@Summary	Get the retention policy of a repository
+//	@Router		/repos/{repo_id}/retention [get]
+//	@Produce	json
+//	@Success	200	{object}	RetentionPolicy
+//	@Tags		Retention policies
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		repo_id			path	int		true	"the repository id"
+func GetRepoRetentionPolicy(c *gin.Context) {
+	getRetentionPolicy(c, 0, session.Repo(c).ID)
+}
+
+// PostRepoRetentionPolicy
+//
+//	@Summary		Set the retention policy of a repository
+//	@Description	Creates or replaces the retention policy of a repository.
+//	@Router			/repos/{repo_id}/retention [post]
+//	@Produce		json
+//	@Success		200	{object}	RetentionPolicy
+//	@Tags			Retention policies
+//	@Param			Authorization	header	string			true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int				true	"the repository id"
+//	@Param			policy			body	RetentionPolicy	true	"the retention policy"
+func PostRepoRetentionPolicy(c *gin.Context) {
+	postRetentionPolicy(c, 0, session.Repo(c).ID)
+}
+
+// DeleteRepoRetentionPolicy
+//
+//	@Summary	Delete the retention policy of a repository
+//	@Router		/repos/{repo_id}/retention [delete]
+//	@Produce	plain
+//	@Success	204
+//	@Tags		Retention policies
+//	@Param		Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param		repo_id			path	int		true	"the repository id"
+func DeleteRepoRetentionPolicy(c *gin.Context) {
+	deleteRetentionPolicy(c, 0, session.Repo(c).ID)
+}
+
+func getRetentionPolicy(c *gin.Context, orgID, repoID int64) {
+	policy, err := store.FromContext(c).RetentionPolicyFind(orgID, repoID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, policy)
+}
+
+// postRetentionPolicy creates the policy of a scope or replaces the existing one.
+func postRetentionPolicy(c *gin.Context, orgID, repoID int64) {
+	in := new(model.RetentionPolicy)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing retention policy. %s", err)
+		return
+	}
+	if err := in.Validate(); err != nil {
+		c.String(http.StatusBadRequest, "Error validating retention policy. %s", err)
+		return
+	}
+
+	_store := store.FromContext(c)
+	policy, err := _store.RetentionPolicyFind(orgID, repoID)
+	if err != nil && !errors.Is(err, types.ErrRecordNotExist) {
+		handleDBError(c, err)
+		return
+	}
+	exists := err == nil
+	if !exists {
+		policy = &model.RetentionPolicy{OrgID: orgID, RepoID: repoID}
+	}
+	policy.KeepPipelines = in.KeepPipelines
+	policy.PipelineDays = in.PipelineDays
+	policy.LogDays = in.LogDays
+	policy.KeepLastSuccess = in.KeepLastSuccess
+
+	if exists {
+		err = _store.RetentionPolicyUpdate(policy)
+	} else {
+		err = _store.RetentionPolicyCreate(policy)
+	}
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error storing retention policy. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, policy)
+}
+
+func deleteRetentionPolicy(c *gin.Context, orgID, repoID int64) {
+	_store := store.FromContext(c)
+	policy, err := _store.RetentionPolicyFind(orgID, repoID)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	if err := _store.RetentionPolicyDelete(policy); err != nil {
+		handleDBError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
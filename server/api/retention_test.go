@@ -0,0 +1,116 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestRetentionPolicy(t *testing.T) {
+	s := newTestStore(t)
+	repo := &model.Repo{ID: 1, FullName: "owner/repo"}
+	org := &model.Org{ID: 2, Name: "owner"}
+
+	t.Run("missing policy returns not found", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, &model.Perm{Admin: true})(tc)
+
+		GetRepoRetentionPolicy(tc.Ctx)
+
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+	})
+
+	t.Run("invalid policy is rejected", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRequest(http.MethodPost, model.RetentionPolicy{KeepPipelines: -1})(tc)
+
+		PostGlobalRetentionPolicy(tc.Ctx)
+
+		assert.Equal(t, http.StatusBadRequest, tc.Recorder.Code)
+	})
+
+	t.Run("post creates and replaces the policy of the scope", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, &model.Perm{Admin: true})(tc)
+		// the scope always comes from the url
+		withRequest(http.MethodPost, model.RetentionPolicy{OrgID: 5, KeepPipelines: 10, KeepLastSuccess: true})(tc)
+
+		PostRepoRetentionPolicy(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var created model.RetentionPolicy
+		tc.decodeJSON(t, &created)
+		assert.True(t, created.IsRepository())
+		assert.Equal(t, repo.ID, created.RepoID)
+		assert.Equal(t, 10, created.KeepPipelines)
+
+		tc = newTestContext(t, s)
+		withRepo(repo, &model.Perm{Admin: true})(tc)
+		withRequest(http.MethodPost, model.RetentionPolicy{LogDays: 30})(tc)
+
+		PostRepoRetentionPolicy(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var updated model.RetentionPolicy
+		tc.decodeJSON(t, &updated)
+		assert.Equal(t, created.ID, updated.ID)
+		assert.Equal(t, 0, updated.KeepPipelines)
+		assert.Equal(t, 30, updated.LogDays)
+		assert.False(t, updated.KeepLastSuccess)
+	})
+
+	t.Run("scopes are independent", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Set("org", org)
+		withRequest(http.MethodPost, model.RetentionPolicy{PipelineDays: 90})(tc)
+
+		PostOrgRetentionPolicy(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+
+		tc = newTestContext(t, s)
+		GetGlobalRetentionPolicy(tc.Ctx)
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+
+		tc = newTestContext(t, s)
+		tc.Ctx.Set("org", org)
+		GetOrgRetentionPolicy(tc.Ctx)
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var policy model.RetentionPolicy
+		tc.decodeJSON(t, &policy)
+		assert.Equal(t, org.ID, policy.OrgID)
+		assert.Equal(t, 90, policy.PipelineDays)
+	})
+
+	t.Run("delete removes the policy", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		tc.Ctx.Set("org", org)
+		DeleteOrgRetentionPolicy(tc.Ctx)
+		assert.Equal(t, http.StatusNoContent, tc.Ctx.Writer.Status())
+
+		tc = newTestContext(t, s)
+		tc.Ctx.Set("org", org)
+		DeleteOrgRetentionPolicy(tc.Ctx)
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+	})
+}
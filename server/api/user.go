@@ -276,6 +276,7 @@ func DeleteToken(c *gin.Context) {
 		c.String(http.StatusInternalServerError, "Error revoking tokens. %s", err)
 		return
 	}
+	recordAudit(c, model.AuditUserTokenReset, userAuditTarget(user), nil, nil)
 
 	t := token.New(token.UserToken)
 	t.Set("user-id", strconv.FormatInt(user.ID, 10))
@@ -130,6 +130,8 @@ func PatchUser(c *gin.Context) {
 		}
 	}
 
+	oldUser := *user
+
 	// TODO: disallow to change login, email, avatar if the user is using oauth
 	user.Login = in.Login
 	user.Email = in.Email
@@ -142,6 +144,7 @@ func PatchUser(c *gin.Context) {
 		return
 	}
 
+	recordAudit(c, model.AuditUserUpdate, userAuditTarget(user), &oldUser, user)
 	c.JSON(http.StatusOK, user)
 }
 
@@ -185,6 +188,7 @@ func PostUser(c *gin.Context) {
 		c.String(http.StatusInternalServerError, err.Error())
 		return
 	}
+	recordAudit(c, model.AuditUserCreate, userAuditTarget(user), nil, user)
 	c.JSON(http.StatusOK, user)
 }
 
@@ -225,5 +229,6 @@ func DeleteUser(c *gin.Context) {
 		handleDBError(c, err)
 		return
 	}
+	recordAudit(c, model.AuditUserDelete, userAuditTarget(user), user, nil)
 	c.Status(http.StatusNoContent)
 }
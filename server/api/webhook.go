@@ -0,0 +1,492 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+// GetGlobalWebhooks
+//
+//	@Summary		List the global webhooks
+//	@Description	Requires admin rights.
+//	@Router			/webhooks [get]
+//	@Produce		json
+//	@Success		200	{array}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetGlobalWebhooks(c *gin.Context) {
+	listWebhooks(c, 0, 0)
+}
+
+// PostGlobalWebhook
+//
+//	@Summary		Create a global webhook
+//	@Description	Requires admin rights.
+//	@Router			/webhooks [post]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook			body	Webhook	true	"the webhook"
+func PostGlobalWebhook(c *gin.Context) {
+	postWebhook(c, 0, 0)
+}
+
+// GetGlobalWebhook
+//
+//	@Summary		Get a global webhook
+//	@Description	Requires admin rights.
+//	@Router			/webhooks/{webhook_id} [get]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func GetGlobalWebhook(c *gin.Context) {
+	getWebhook(c, 0, 0)
+}
+
+// PatchGlobalWebhook
+//
+//	@Summary		Update a global webhook
+//	@Description	Requires admin rights.
+//	@Router			/webhooks/{webhook_id} [patch]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			webhook			body	WebhookPatch	true	"the webhook's data"
+func PatchGlobalWebhook(c *gin.Context) {
+	patchWebhook(c, 0, 0)
+}
+
+// DeleteGlobalWebhook
+//
+//	@Summary		Delete a global webhook
+//	@Description	Requires admin rights. Deletes the delivery history as well.
+//	@Router			/webhooks/{webhook_id} [delete]
+//	@Produce		plain
+//	@Success		204
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func DeleteGlobalWebhook(c *gin.Context) {
+	deleteWebhook(c, 0, 0)
+}
+
+// GetGlobalWebhookDeliveries
+//
+//	@Summary		List the deliveries of a global webhook
+//	@Description	Requires admin rights. Returns the newest deliveries first.
+//	@Router			/webhooks/{webhook_id}/deliveries [get]
+//	@Produce		json
+//	@Success		200	{array}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetGlobalWebhookDeliveries(c *gin.Context) {
+	listWebhookDeliveries(c, 0, 0)
+}
+
+// PostGlobalWebhookRedeliver
+//
+//	@Summary		Redeliver a delivery of a global webhook
+//	@Description	Requires admin rights. Queues the payload of the delivery again as a new delivery.
+//	@Router			/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver [post]
+//	@Produce		json
+//	@Success		200	{object}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			delivery_id		path	int		true	"the delivery's id"
+func PostGlobalWebhookRedeliver(c *gin.Context) {
+	redeliverWebhookDelivery(c, 0, 0)
+}
+
+// GetOrgWebhooks
+//
+//	@Summary		List the webhooks of an organization
+//	@Description	Webhooks of the parent scopes are not included.
+//	@Router			/orgs/{org_id}/webhooks [get]
+//	@Produce		json
+//	@Success		200	{array}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetOrgWebhooks(c *gin.Context) {
+	listWebhooks(c, session.Org(c).ID, 0)
+}
+
+// PostOrgWebhook
+//
+//	@Summary		Create a webhook of an organization
+//	@Router			/orgs/{org_id}/webhooks [post]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook			body	Webhook	true	"the webhook"
+func PostOrgWebhook(c *gin.Context) {
+	postWebhook(c, session.Org(c).ID, 0)
+}
+
+// GetOrgWebhook
+//
+//	@Summary		Get a webhook of an organization
+//	@Router			/orgs/{org_id}/webhooks/{webhook_id} [get]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func GetOrgWebhook(c *gin.Context) {
+	getWebhook(c, session.Org(c).ID, 0)
+}
+
+// PatchOrgWebhook
+//
+//	@Summary		Update a webhook of an organization
+//	@Router			/orgs/{org_id}/webhooks/{webhook_id} [patch]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			webhook			body	WebhookPatch	true	"the webhook's data"
+func PatchOrgWebhook(c *gin.Context) {
+	patchWebhook(c, session.Org(c).ID, 0)
+}
+
+// DeleteOrgWebhook
+//
+//	@Summary		Delete a webhook of an organization
+//	@Description	Deletes the delivery history as well.
+//	@Router			/orgs/{org_id}/webhooks/{webhook_id} [delete]
+//	@Produce		plain
+//	@Success		204
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func DeleteOrgWebhook(c *gin.Context) {
+	deleteWebhook(c, session.Org(c).ID, 0)
+}
+
+// GetOrgWebhookDeliveries
+//
+//	@Summary		List the deliveries of a webhook of an organization
+//	@Description	Returns the newest deliveries first.
+//	@Router			/orgs/{org_id}/webhooks/{webhook_id}/deliveries [get]
+//	@Produce		json
+//	@Success		200	{array}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetOrgWebhookDeliveries(c *gin.Context) {
+	listWebhookDeliveries(c, session.Org(c).ID, 0)
+}
+
+// PostOrgWebhookRedeliver
+//
+//	@Summary		Redeliver a delivery of a webhook of an organization
+//	@Description	Queues the payload of the delivery again as a new delivery.
+//	@Router			/orgs/{org_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver [post]
+//	@Produce		json
+//	@Success		200	{object}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			org_id			path	string	true	"the org's id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			delivery_id		path	int		true	"the delivery's id"
+func PostOrgWebhookRedeliver(c *gin.Context) {
+	redeliverWebhookDelivery(c, session.Org(c).ID, 0)
+}
+
+// GetRepoWebhooks
+//
+//	@Summary		List the webhooks of a repository
+//	@Description	Webhooks of the parent scopes are not included.
+//	@Router			/repos/{repo_id}/webhooks [get]
+//	@Produce		json
+//	@Success		200	{array}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetRepoWebhooks(c *gin.Context) {
+	listWebhooks(c, 0, session.Repo(c).ID)
+}
+
+// PostRepoWebhook
+//
+//	@Summary		Create a webhook of a repository
+//	@Router			/repos/{repo_id}/webhooks [post]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook			body	Webhook	true	"the webhook"
+func PostRepoWebhook(c *gin.Context) {
+	postWebhook(c, 0, session.Repo(c).ID)
+}
+
+// GetRepoWebhook
+//
+//	@Summary		Get a webhook of a repository
+//	@Router			/repos/{repo_id}/webhooks/{webhook_id} [get]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func GetRepoWebhook(c *gin.Context) {
+	getWebhook(c, 0, session.Repo(c).ID)
+}
+
+// PatchRepoWebhook
+//
+//	@Summary		Update a webhook of a repository
+//	@Router			/repos/{repo_id}/webhooks/{webhook_id} [patch]
+//	@Produce		json
+//	@Success		200	{object}	Webhook
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			webhook			body	WebhookPatch	true	"the webhook's data"
+func PatchRepoWebhook(c *gin.Context) {
+	patchWebhook(c, 0, session.Repo(c).ID)
+}
+
+// DeleteRepoWebhook
+//
+//	@Summary		Delete a webhook of a repository
+//	@Description	Deletes the delivery history as well.
+//	@Router			/repos/{repo_id}/webhooks/{webhook_id} [delete]
+//	@Produce		plain
+//	@Success		204
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+func DeleteRepoWebhook(c *gin.Context) {
+	deleteWebhook(c, 0, session.Repo(c).ID)
+}
+
+// GetRepoWebhookDeliveries
+//
+//	@Summary		List the deliveries of a webhook of a repository
+//	@Description	Returns the newest deliveries first.
+//	@Router			/repos/{repo_id}/webhooks/{webhook_id}/deliveries [get]
+//	@Produce		json
+//	@Success		200	{array}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			page			query	int		false	"for response pagination, page offset number"	default(1)
+//	@Param			perPage			query	int		false	"for response pagination, max items per page"	default(50)
+func GetRepoWebhookDeliveries(c *gin.Context) {
+	listWebhookDeliveries(c, 0, session.Repo(c).ID)
+}
+
+// PostRepoWebhookRedeliver
+//
+//	@Summary		Redeliver a delivery of a webhook of a repository
+//	@Description	Queues the payload of the delivery again as a new delivery.
+//	@Router			/repos/{repo_id}/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver [post]
+//	@Produce		json
+//	@Success		200	{object}	WebhookDelivery
+//	@Tags			Webhooks
+//	@Param			Authorization	header	string	true	"Insert your personal access token"	default(Bearer <personal access token>)
+//	@Param			repo_id			path	int		true	"the repository id"
+//	@Param			webhook_id		path	int		true	"the webhook's id"
+//	@Param			delivery_id		path	int		true	"the delivery's id"
+func PostRepoWebhookRedeliver(c *gin.Context) {
+	redeliverWebhookDelivery(c, 0, session.Repo(c).ID)
+}
+
+func listWebhooks(c *gin.Context, orgID, repoID int64) {
+	hooks, err := store.FromContext(c).WebhookList(orgID, repoID, session.Pagination(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting webhook list. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, hooks)
+}
+
+func postWebhook(c *gin.Context, orgID, repoID int64) {
+	in := new(model.Webhook)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing webhook. %s", err)
+		return
+	}
+	hook := &model.Webhook{
+		OrgID:   orgID,
+		RepoID:  repoID,
+		Name:    in.Name,
+		URL:     in.URL,
+		Events:  in.Events,
+		Enabled: in.Enabled,
+	}
+	if err := hook.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error inserting webhook. %s", err)
+		return
+	}
+	if err := store.FromContext(c).WebhookCreate(hook); err != nil {
+		c.String(http.StatusInternalServerError, "Error inserting webhook. %s", err)
+		return
+	}
+	recordAudit(c, model.AuditWebhookCreate, webhookAuditTarget(hook, session.Repo(c)), nil, hook)
+	c.JSON(http.StatusOK, hook)
+}
+
+func getWebhook(c *gin.Context, orgID, repoID int64) {
+	hook, ok := findWebhook(c, orgID, repoID)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, hook)
+}
+
+func patchWebhook(c *gin.Context, orgID, repoID int64) {
+	hook, ok := findWebhook(c, orgID, repoID)
+	if !ok {
+		return
+	}
+	oldHook := *hook
+
+	in := new(model.WebhookPatch)
+	if err := c.Bind(in); err != nil {
+		c.String(http.StatusBadRequest, "Error parsing webhook. %s", err)
+		return
+	}
+	if in.Name != nil {
+		hook.Name = *in.Name
+	}
+	if in.URL != nil {
+		hook.URL = *in.URL
+	}
+	if in.Events != nil {
+		hook.Events = in.Events
+	}
+	if in.Enabled != nil {
+		hook.Enabled = *in.Enabled
+	}
+	if err := hook.Validate(); err != nil {
+		c.String(http.StatusUnprocessableEntity, "Error updating webhook. %s", err)
+		return
+	}
+	if err := store.FromContext(c).WebhookUpdate(hook); err != nil {
+		c.String(http.StatusInternalServerError, "Error updating webhook. %s", err)
+		return
+	}
+	recordAudit(c, model.AuditWebhookUpdate, webhookAuditTarget(hook, session.Repo(c)), &oldHook, hook)
+	c.JSON(http.StatusOK, hook)
+}
+
+func deleteWebhook(c *gin.Context, orgID, repoID int64) {
+	hook, ok := findWebhook(c, orgID, repoID)
+	if !ok {
+		return
+	}
+	if err := store.FromContext(c).WebhookDelete(hook); err != nil {
+		handleDBError(c, err)
+		return
+	}
+	recordAudit(c, model.AuditWebhookDelete, webhookAuditTarget(hook, session.Repo(c)), hook, nil)
+	c.Status(http.StatusNoContent)
+}
+
+func listWebhookDeliveries(c *gin.Context, orgID, repoID int64) {
+	hook, ok := findWebhook(c, orgID, repoID)
+	if !ok {
+		return
+	}
+	deliveries, err := store.FromContext(c).WebhookDeliveryList(hook, session.Pagination(c))
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error getting webhook deliveries. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+func redeliverWebhookDelivery(c *gin.Context, orgID, repoID int64) {
+	hook, ok := findWebhook(c, orgID, repoID)
+	if !ok {
+		return
+	}
+	id, err := strconv.ParseInt(c.Param("delivery_id"), 10, 64)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Error parsing delivery id. %s", err)
+		return
+	}
+	delivery, err := store.FromContext(c).WebhookDeliveryFind(hook, id)
+	if err != nil {
+		handleDBError(c, err)
+		return
+	}
+	redelivery, err := server.Config.Services.Webhooks.Redeliver(delivery)
+	if err != nil {
+		c.String(http.StatusInternalServerError, "Error queueing webhook delivery. %s", err)
+		return
+	}
+	c.JSON(http.StatusOK, redelivery)
+}
+
+// findWebhook returns the webhook of the request, webhooks of other scopes
+// are reported as not found.
+func findWebhook(c *gin.Context, orgID, repoID int64) (*model.Webhook, bool) {
+	id, err := strconv.ParseInt(c.Param("webhook_id"), 10, 64)
+	if err != nil {
+		c.String(http.StatusBadRequest, "Error parsing webhook id. %s", err)
+		return nil, false
+	}
+	hook, err := store.FromContext(c).WebhookFind(id)
+	if err == nil && (hook.OrgID != orgID || hook.RepoID != repoID) {
+		err = types.ErrRecordNotExist
+	}
+	if err != nil {
+		handleDBError(c, err)
+		return nil, false
+	}
+	return hook, true
+}
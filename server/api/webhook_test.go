@@ -0,0 +1,155 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/webhook"
+)
+
+func TestWebhooks(t *testing.T) {
+	s := newTestStore(t)
+	server.Config.Services.Webhooks = webhook.New(s, nil, "https://ci.example.com")
+	t.Cleanup(func() { server.Config.Services.Webhooks = nil })
+
+	repo := &model.Repo{ID: 1, OrgID: 2, FullName: "owner/repo"}
+	admin := &model.Perm{Admin: true}
+
+	t.Run("invalid webhook is rejected", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withRequest(http.MethodPost, model.Webhook{Name: "chat", URL: "ftp://chat.example.com"})(tc)
+
+		PostRepoWebhook(tc.Ctx)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, tc.Recorder.Code)
+	})
+
+	var hook model.Webhook
+	t.Run("post creates a webhook in the scope of the url", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withRequest(http.MethodPost, model.Webhook{
+			OrgID:   5,
+			Name:    "chat",
+			URL:     "https://chat.example.com/hook",
+			Events:  []model.WebhookTrigger{model.WebhookTriggerPipelineFinished},
+			Enabled: true,
+		})(tc)
+
+		PostRepoWebhook(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		tc.decodeJSON(t, &hook)
+		assert.NotZero(t, hook.ID)
+		assert.True(t, hook.IsRepository())
+		assert.Equal(t, repo.ID, hook.RepoID)
+	})
+
+	t.Run("patch updates only the given fields", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+		enabled := false
+		withRequest(http.MethodPatch, model.WebhookPatch{Enabled: &enabled})(tc)
+
+		PatchRepoWebhook(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var updated model.Webhook
+		tc.decodeJSON(t, &updated)
+		assert.False(t, updated.Enabled)
+		assert.Equal(t, "chat", updated.Name)
+		assert.Equal(t, hook.Events, updated.Events)
+	})
+
+	t.Run("webhooks of other scopes are not found", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+
+		GetGlobalWebhook(tc.Ctx)
+
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+
+		tc = newTestContext(t, s)
+		withRepo(&model.Repo{ID: 3, FullName: "owner/other"}, admin)(tc)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+
+		DeleteRepoWebhook(tc.Ctx)
+
+		assert.Equal(t, http.StatusNotFound, tc.Recorder.Code)
+	})
+
+	t.Run("redeliver queues the payload again", func(t *testing.T) {
+		delivery := &model.WebhookDelivery{
+			WebhookID: hook.ID,
+			Event:     model.WebhookTriggerPipelineFinished,
+			Payload:   `{"event":"pipeline_finished"}`,
+			Status:    model.WebhookDeliveryFailure,
+			Attempts:  5,
+		}
+		require.NoError(t, s.WebhookDeliveryCreate(delivery))
+
+		tc := newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+		withParam("delivery_id", strItoa(delivery.ID))(tc)
+
+		PostRepoWebhookRedeliver(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var redelivery model.WebhookDelivery
+		tc.decodeJSON(t, &redelivery)
+		assert.NotEqual(t, delivery.ID, redelivery.ID)
+		assert.Equal(t, model.WebhookDeliveryPending, redelivery.Status)
+		assert.Equal(t, delivery.Payload, redelivery.Payload)
+
+		tc = newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+
+		GetRepoWebhookDeliveries(tc.Ctx)
+
+		require.Equal(t, http.StatusOK, tc.Recorder.Code)
+		var deliveries []*model.WebhookDelivery
+		tc.decodeJSON(t, &deliveries)
+		assert.Len(t, deliveries, 2)
+	})
+
+	t.Run("delete removes the webhook", func(t *testing.T) {
+		tc := newTestContext(t, s)
+		withRepo(repo, admin)(tc)
+		withParam("webhook_id", strItoa(hook.ID))(tc)
+
+		DeleteRepoWebhook(tc.Ctx)
+
+		assert.Equal(t, http.StatusNoContent, tc.Ctx.Writer.Status())
+		_, err := s.WebhookFind(hook.ID)
+		assert.Error(t, err)
+
+		logs, err := s.AuditLogList(&model.AuditLogFilter{TargetType: model.AuditTargetWebhook}, &model.ListOptions{Page: 1, PerPage: 50})
+		require.NoError(t, err)
+		assert.Len(t, logs, 3)
+	})
+}
@@ -23,8 +23,12 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/scheduler"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/audit"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/idtoken"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/permissions"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/webhook"
 )
 
 var Config = struct {
@@ -34,6 +38,10 @@ var Config = struct {
 		Membership cache.MembershipService
 		Manager    services.Manager
 		LogStore   log.Service
+		Artifacts  artifact.Service
+		IDTokens   *idtoken.Issuer
+		AuditSink  audit.Sink
+		Webhooks   *webhook.Service
 	}
 	Server struct {
 		JWTSecret             string
@@ -78,6 +86,10 @@ var Config = struct {
 		PrivilegedPlugins                   []string
 		DefaultTimeout                      int64
 		MaxTimeout                          int64
+		MaxWorkflowAttempts                 int
+		WorkflowRetryBackoff                time.Duration
+		ArtifactMaxSize                     int64
+		ArtifactRetention                   time.Duration
 		Proxy                               struct {
 			No    string
 			HTTP  string
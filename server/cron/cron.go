@@ -155,6 +155,7 @@ func CreatePipeline(ctx context.Context, store store.Store, cron *model.Cron) (*
 		Branch:              cron.Branch,
 		Timestamp:           cron.NextExec,
 		Cron:                cron.Name,
+		Priority:            cron.Priority,
 		ForgeURL:            commit.ForgeURL,
 		AdditionalVariables: cron.Variables,
 	}, nil
@@ -0,0 +1,31 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import "net/http"
+
+// AppHooker is an optional interface for forges receiving webhooks of an app
+// installed on the forge, instead of hooks registered per repository.
+//
+// App hooks are delivered to /api/hook/forges/<forge-id> and are
+// authenticated by the forge rather than by a per-repo token.
+//
+// Implementations: GitHub (in GitHub App mode).
+type AppHooker interface {
+	// VerifyAppHook checks the request was sent by the forge's app.
+	// Returns types.ErrNotImplemented if the forge isn't set up as app.
+	// The request body must remain readable for Hook.
+	VerifyAppHook(r *http.Request) error
+}
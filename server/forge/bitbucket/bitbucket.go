@@ -325,6 +325,76 @@ func (c *config) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return c.newClient(ctx, user).CreateStatus(repo.Owner, repo.Name, pipeline.Commit, &status)
 }
 
+// PullComment creates the pipeline summary comment on the pull request of the
+// pipeline or updates the existing one.
+func (c *config) PullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	id, ok := pullRequestID(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client := c.newClient(ctx, u)
+	comment, err := findPullComment(client, u, r, id)
+	if err != nil {
+		return err
+	}
+
+	if comment == nil {
+		return client.CreatePullRequestComment(r.Owner, r.Name, id, &internal.PullRequestComment{
+			Content: internal.CommentContent{Raw: body},
+		})
+	}
+	if common.SkipPullComment(p, comment.Content.Raw) {
+		return nil
+	}
+	comment.Content.Raw = body
+	return client.UpdatePullRequestComment(r.Owner, r.Name, id, comment)
+}
+
+// ClosePullComment removes the pipeline summary comment on the pull request
+// of the pipeline, as Bitbucket can't collapse it.
+func (c *config) ClosePullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline) error {
+	id, ok := pullRequestID(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client := c.newClient(ctx, u)
+	comment, err := findPullComment(client, u, r, id)
+	if err != nil || comment == nil {
+		return err
+	}
+	return client.DeletePullRequestComment(r.Owner, r.Name, id, comment.ID)
+}
+
+// findPullComment returns the pipeline summary comment posted by the user on
+// the pull request.
+func findPullComment(client *internal.Client, u *model.User, r *model.Repo, id int64) (*internal.PullRequestComment, error) {
+	comments, err := shared_utils.Paginate(func(page int) ([]*internal.PullRequestComment, error) {
+		comments, err := client.ListPullRequestComments(r.Owner, r.Name, id, &internal.ListOpts{
+			Page:    page,
+			PageLen: pageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return comments.Values, nil
+	}, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		if comment.Deleted || comment.User == nil || comment.User.UUID != string(u.ForgeRemoteID) {
+			continue
+		}
+		if _, ok := common.PullCommentPipeline(comment.Content.Raw); ok {
+			return comment, nil
+		}
+	}
+	return nil, nil
+}
+
 // Activate activates the repository by registering repository push hooks with
 // the Bitbucket repository. Prior to registering hook, previously created hooks
 // are deleted.
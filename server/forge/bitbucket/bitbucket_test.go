@@ -194,6 +194,19 @@ func TestBitbucket(t *testing.T) {
 	err = c.Status(ctx, fakeUser, fakeRepo, fakePipeline, fakeWorkflow)
 	assert.NoError(t, err)
 
+	commentUser := &model.User{Login: "superman", AccessToken: "cfcd2084", ForgeRemoteID: "{4d8c0f46-cd62-4b77-b0cf-faa3e4d932c6}"}
+	// updates the existing summary comment
+	err = c.PullComment(ctx, commentUser, fakeRepo, &model.Pipeline{Number: 6, Event: model.EventPull, Ref: "refs/pull-requests/1/from"}, "[//]: # (woodpecker-pipeline-summary 6)\n\nPipeline #6")
+	assert.NoError(t, err)
+	// doesn't replace the summary of a newer pipeline
+	err = c.PullComment(ctx, commentUser, fakeRepo, &model.Pipeline{Number: 4, Event: model.EventPull, Ref: "refs/pull-requests/1/from"}, "[//]: # (woodpecker-pipeline-summary 4)\n\nPipeline #4")
+	assert.NoError(t, err)
+	// creates the first summary comment
+	err = c.PullComment(ctx, commentUser, fakeRepo, &model.Pipeline{Number: 1, Event: model.EventPull, Ref: "refs/pull-requests/2/from"}, "[//]: # (woodpecker-pipeline-summary 1)\n\nPipeline #1")
+	assert.NoError(t, err)
+	err = c.ClosePullComment(ctx, commentUser, fakeRepo, &model.Pipeline{Number: 7, Event: model.EventPullClosed, Ref: "refs/pull-requests/1/from"})
+	assert.NoError(t, err)
+
 	buf := bytes.NewBufferString(fixtures.HookPush)
 	req, _ := http.NewRequest(http.MethodPost, "/hook", buf)
 	req.Header = http.Header{}
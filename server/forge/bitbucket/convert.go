@@ -31,8 +31,19 @@ const (
 	statusPending = "INPROGRESS" // cspell:disable-line
 	statusSuccess = "SUCCESSFUL"
 	statusFailure = "FAILED"
+
+	pullRefs = "refs/pull-requests/%d/from"
 )
 
+// pullRequestID returns the id of the pull request of a pipeline ref.
+func pullRequestID(ref string) (int64, bool) {
+	var id int64
+	if _, err := fmt.Sscanf(ref, pullRefs, &id); err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
 // convertStatus is a helper function used to convert a Woodpecker status to a
 // Bitbucket commit status.
 func convertStatus(status model.StatusValue) string {
@@ -168,7 +179,7 @@ func convertPullHook(from *internal.PullRequestHook) *model.Pipeline {
 	pipeline := &model.Pipeline{
 		Event:  event,
 		Commit: from.PullRequest.Source.Commit.Hash,
-		Ref:    fmt.Sprintf("refs/pull-requests/%d/from", from.PullRequest.ID),
+		Ref:    fmt.Sprintf(pullRefs, from.PullRequest.ID),
 		Refspec: fmt.Sprintf(
 			"%s:%s",
 			from.PullRequest.Source.Branch.Name,
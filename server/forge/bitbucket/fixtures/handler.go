@@ -42,9 +42,57 @@ func Handler() http.Handler {
 	e.GET("/2.0/repositories/:owner/:name/commits/:commit", getBranchHead)
 	e.GET("/2.0/repositories/:owner/:name/pullrequests", getPullRequests)
 	e.GET("/2.0/repositories/:owner/:name/diffstat/:commit", getCommitDiffstat)
+	e.GET("/2.0/repositories/:owner/:name/pullrequests/:id/comments", getPullRequestComments)
+	e.POST("/2.0/repositories/:owner/:name/pullrequests/:id/comments", createPullRequestComment)
+	e.PUT("/2.0/repositories/:owner/:name/pullrequests/:id/comments/:comment", updatePullRequestComment)
+	e.DELETE("/2.0/repositories/:owner/:name/pullrequests/:id/comments/:comment", deletePullRequestComment)
 	return e
 }
 
+func getPullRequestComments(c *gin.Context) {
+	if c.Param("id") != "1" || (c.Query("page") != "" && c.Query("page") != "1") {
+		c.String(http.StatusOK, `{"values": []}`)
+		return
+	}
+	c.String(http.StatusOK, pullRequestCommentsPayload)
+}
+
+func createPullRequestComment(c *gin.Context) {
+	// the pull request has a summary comment which has to be updated instead
+	if c.Param("id") == "1" {
+		c.String(http.StatusBadRequest, "")
+		return
+	}
+	c.String(http.StatusCreated, `{"id": 3}`)
+}
+
+func updatePullRequestComment(c *gin.Context) {
+	var comment struct {
+		Content struct {
+			Raw string `json:"raw"`
+		} `json:"content"`
+	}
+	if err := c.BindJSON(&comment); err != nil {
+		return
+	}
+	// only newer pipelines than the summarized one may replace it
+	var number int64
+	_, err := fmt.Sscanf(comment.Content.Raw, "[//]: # (woodpecker-pipeline-summary %d)", &number)
+	if c.Param("comment") != "2" || err != nil || number < 5 {
+		c.String(http.StatusBadRequest, "")
+		return
+	}
+	c.String(http.StatusOK, `{"id": 2}`)
+}
+
+func deletePullRequestComment(c *gin.Context) {
+	if c.Param("comment") != "2" {
+		c.String(http.StatusNotFound, "")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
 func getCommitDiffstat(c *gin.Context) {
 	c.String(http.StatusOK, diffStatPayload)
 }
@@ -621,3 +669,22 @@ const permissionHookPayLoad = `
 	]
 }
 `
+
+const pullRequestCommentsPayload = `
+{
+  "page": 1,
+  "pagelen": 10,
+  "values": [
+    {
+      "id": 1,
+      "user": {"uuid": "{4d8c0f46-cd62-4b77-b0cf-faa3e4d932c6}"},
+      "content": {"raw": "LGTM"}
+    },
+    {
+      "id": 2,
+      "user": {"uuid": "{4d8c0f46-cd62-4b77-b0cf-faa3e4d932c6}"},
+      "content": {"raw": "[//]: # (woodpecker-pipeline-summary 5)\n\nPipeline #5: **success**"}
+    }
+  ]
+}
+`
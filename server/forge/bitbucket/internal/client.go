@@ -44,6 +44,8 @@ const (
 	pathBranches      = "%s/2.0/repositories/%s/%s/refs/branches?%s"
 	pathOrgPerms      = "%s/2.0/workspaces/%s/permissions?%s"
 	pathPullRequests  = "%s/2.0/repositories/%s/%s/pullrequests?%s"
+	pathPullComments  = "%s/2.0/repositories/%s/%s/pullrequests/%d/comments?%s"
+	pathPullComment   = "%s/2.0/repositories/%s/%s/pullrequests/%d/comments/%d"
 	pathBranchCommits = "%s/2.0/repositories/%s/%s/commits/%s"
 	pathDir           = "%s/2.0/repositories/%s/%s/src/%s/%s"
 	pathDiffStat      = "%s/2.0/repositories/%s/%s/diffstat/%s?%s"
@@ -235,6 +237,31 @@ func (c *Client) ListPullRequests(owner, name string, opts *ListOpts) ([]*PullRe
 	return out.Values, err
 }
 
+func (c *Client) ListPullRequestComments(owner, name string, id int64, opts *ListOpts) (*PullRequestCommentResp, error) {
+	out := new(PullRequestCommentResp)
+	uri := fmt.Sprintf(pathPullComments, c.base, owner, name, id, opts.Encode())
+	_, err := c.do(uri, http.MethodGet, nil, out)
+	return out, err
+}
+
+func (c *Client) CreatePullRequestComment(owner, name string, id int64, comment *PullRequestComment) error {
+	uri := fmt.Sprintf(pathPullComments, c.base, owner, name, id, "")
+	_, err := c.do(uri, http.MethodPost, comment, nil)
+	return err
+}
+
+func (c *Client) UpdatePullRequestComment(owner, name string, id int64, comment *PullRequestComment) error {
+	uri := fmt.Sprintf(pathPullComment, c.base, owner, name, id, comment.ID)
+	_, err := c.do(uri, http.MethodPut, &PullRequestComment{Content: comment.Content}, nil)
+	return err
+}
+
+func (c *Client) DeletePullRequestComment(owner, name string, id, commentID int64) error {
+	uri := fmt.Sprintf(pathPullComment, c.base, owner, name, id, commentID)
+	_, err := c.do(uri, http.MethodDelete, nil, nil)
+	return err
+}
+
 func (c *Client) ListChangedFiles(owner, name, ref string) (result []string, err error) {
 	paths := make(map[string]struct{})
 	opts := &ListOpts{Page: 1, PageLen: pageSize}
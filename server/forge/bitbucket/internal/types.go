@@ -269,6 +269,24 @@ type PullRequest struct {
 	Title string `json:"title"`
 }
 
+type PullRequestCommentResp struct {
+	Page    int                   `json:"page"`
+	PageLen int                   `json:"pagelen"`
+	Next    string                `json:"next"`
+	Values  []*PullRequestComment `json:"values"`
+}
+
+type PullRequestComment struct {
+	ID      int64          `json:"id,omitempty"`
+	User    *Account       `json:"user,omitempty"`
+	Content CommentContent `json:"content"`
+	Deleted bool           `json:"deleted,omitempty"`
+}
+
+type CommentContent struct {
+	Raw string `json:"raw"`
+}
+
 type CommitsResp struct {
 	Values []*Commit `json:"values"`
 }
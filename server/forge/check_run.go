@@ -0,0 +1,37 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// CheckRunner is an optional interface for forges able to show a detailed
+// report of a workflow, with annotations of the files in the repo, instead of
+// a plain commit status.
+//
+// It is used instead of Forge.Status for every workflow status update. If it
+// returns types.ErrNotImplemented, e.g. because the forge isn't configured
+// for it, the status is reported with Forge.Status.
+//
+// Implementations: GitHub (in GitHub App mode), Gitea, Forgejo, GitLab.
+type CheckRunner interface {
+	// CheckRun reports the state of the workflow together with its report.
+	// Annotations are only set once the workflow is done.
+	CheckRun(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, w *model.Workflow, run *types.CheckRun) error
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+)
+
+// checkRunReviewMarker starts the reviews with the annotations of a workflow on
+// pull requests to find them again, like the marker of summary comments. It
+// holds the status context of the workflow.
+const checkRunReviewMarker = "[//]: # (woodpecker-check-run %s)"
+
+// CheckRunReviewBody returns the body of the review with the annotations of
+// the workflow with the given status context.
+func CheckRunReviewBody(statusContext, title string) string {
+	return fmt.Sprintf(checkRunReviewMarker, statusContext) + "\n\n" + fmt.Sprintf("%s: %s", statusContext, title)
+}
+
+// IsCheckRunReview reports whether a review holds the annotations of the
+// workflow with the given status context.
+func IsCheckRunReview(body, statusContext string) bool {
+	return strings.HasPrefix(body, fmt.Sprintf(checkRunReviewMarker, statusContext)+"\n")
+}
+
+// CommentAnnotations returns the annotations of a check run worth a comment
+// on forges without check runs: failures in the files changed by the commit or
+// pull request. Others would be posted for every commit again.
+func CommentAnnotations(run *forge_types.CheckRun, changedFiles []string) []*forge_types.Annotation {
+	var annotations []*forge_types.Annotation
+	for _, annotation := range run.Annotations {
+		if annotation.Level != forge_types.AnnotationLevelFailure {
+			continue
+		}
+		if !slices.Contains(changedFiles, annotation.Path) {
+			continue
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations
+}
+
+// FormatAnnotation returns the markdown of an annotation posted as comment.
+func FormatAnnotation(annotation *forge_types.Annotation) string {
+	if annotation.Title == "" {
+		return annotation.Message
+	}
+	return fmt.Sprintf("**%s**\n\n%s", annotation.Title, annotation.Message)
+}
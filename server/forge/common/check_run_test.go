@@ -0,0 +1,32 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+)
+
+func TestCheckRunReview(t *testing.T) {
+	body := common.CheckRunReviewBody("ci/woodpecker/pr/test", "1 failed test")
+	assert.Equal(t, "[//]: # (woodpecker-check-run ci/woodpecker/pr/test)\n\nci/woodpecker/pr/test: 1 failed test", body)
+
+	assert.True(t, common.IsCheckRunReview(body, "ci/woodpecker/pr/test"))
+	assert.False(t, common.IsCheckRunReview(body, "ci/woodpecker/pr/test/2"))
+	assert.False(t, common.IsCheckRunReview("LGTM", "ci/woodpecker/pr/test"))
+}
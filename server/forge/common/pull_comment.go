@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// pullCommentMarker starts the pipeline summary comments on pull requests to
+// find them again. It's an empty markdown link reference, which isn't
+// rendered, with the number of the summarized pipeline.
+const pullCommentMarker = "[//]: # (woodpecker-pipeline-summary %d)"
+
+// PullCommentBody returns the body of the summary comment of the pipeline.
+func PullCommentBody(pipeline *model.Pipeline, summary string) string {
+	return fmt.Sprintf(pullCommentMarker, pipeline.Number) + "\n\n" + summary
+}
+
+// PullCommentPipeline returns the number of the pipeline summarized by a
+// comment and whether the comment is a summary comment at all.
+func PullCommentPipeline(body string) (int64, bool) {
+	var number int64
+	if _, err := fmt.Sscanf(body, pullCommentMarker, &number); err != nil {
+		return 0, false
+	}
+	return number, true
+}
+
+// SkipPullComment reports whether the existing summary comment with the given
+// body must not be replaced by the one of the pipeline, as it belongs to a
+// newer pipeline.
+func SkipPullComment(pipeline *model.Pipeline, body string) bool {
+	number, _ := PullCommentPipeline(body)
+	return number > pipeline.Number
+}
+
+// CollapsePullComment returns the body of a summary comment with its summary
+// folded away, for forges rendering HTML details elements.
+func CollapsePullComment(body string) string {
+	marker, summary, _ := strings.Cut(body, "\n\n")
+	if strings.HasPrefix(summary, "<details>") {
+		return body
+	}
+	return marker + "\n\n<details>\n<summary>Pull request was closed</summary>\n\n" + summary + "\n</details>"
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestPullComment(t *testing.T) {
+	body := common.PullCommentBody(&model.Pipeline{Number: 12}, "Pipeline was successful")
+	assert.Equal(t, "[//]: # (woodpecker-pipeline-summary 12)\n\nPipeline was successful", body)
+
+	number, ok := common.PullCommentPipeline(body)
+	assert.True(t, ok)
+	assert.EqualValues(t, 12, number)
+
+	_, ok = common.PullCommentPipeline("LGTM")
+	assert.False(t, ok)
+
+	assert.False(t, common.SkipPullComment(&model.Pipeline{Number: 12}, body))
+	assert.False(t, common.SkipPullComment(&model.Pipeline{Number: 13}, body))
+	assert.True(t, common.SkipPullComment(&model.Pipeline{Number: 11}, body))
+
+	collapsed := common.CollapsePullComment(body)
+	assert.Equal(t, "[//]: # (woodpecker-pipeline-summary 12)\n\n<details>\n<summary>Pull request was closed</summary>\n\nPipeline was successful\n</details>", collapsed)
+	assert.Equal(t, collapsed, common.CollapsePullComment(collapsed))
+	number, ok = common.PullCommentPipeline(collapsed)
+	assert.True(t, ok)
+	assert.EqualValues(t, 12, number)
+}
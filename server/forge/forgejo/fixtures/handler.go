@@ -15,7 +15,9 @@
 package fixtures
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -35,6 +37,12 @@ func Handler() http.Handler {
 	e.POST("/api/v1/repos/:owner/:name/statuses/:commit", createRepoCommitStatus)
 	e.GET("/api/v1/repos/:owner/:name/pulls", listRepoPulls)
 	e.GET("/api/v1/repos/:owner/:name/pulls/:index/files", getPRFiles)
+	e.GET("/api/v1/repos/:owner/:name/pulls/:index/reviews", listPullReviews)
+	e.POST("/api/v1/repos/:owner/:name/pulls/:index/reviews", createPullReview)
+	e.DELETE("/api/v1/repos/:owner/:name/pulls/:index/reviews/:id", deletePullReview)
+	e.GET("/api/v1/repos/:owner/:name/issues/:index/comments", listIssueComments)
+	e.POST("/api/v1/repos/:owner/:name/issues/:index/comments", createIssueComment)
+	e.PATCH("/api/v1/repos/:owner/:name/issues/comments/:id", editIssueComment)
 	e.GET("/api/v1/user/repos", getUserRepos)
 	e.GET("/api/v1/version", getVersion)
 
@@ -75,6 +83,75 @@ func createRepoCommitStatus(c *gin.Context) {
 	c.String(http.StatusNotFound, "")
 }
 
+func listPullReviews(c *gin.Context) {
+	page := c.Query("page")
+	if c.Param("index") != "1" || (page != "" && page != "1") {
+		c.String(http.StatusOK, "[]")
+	} else {
+		c.String(http.StatusOK, listPullReviewsPayload)
+	}
+}
+
+func createPullReview(c *gin.Context) {
+	var review struct {
+		Body     string `json:"body"`
+		Comments []struct {
+			Path string `json:"path"`
+		} `json:"comments"`
+	}
+	if err := c.BindJSON(&review); err != nil || c.Param("index") != "1" || len(review.Comments) == 0 ||
+		!strings.HasPrefix(review.Body, "[//]: # (woodpecker-check-run ") {
+		c.String(http.StatusUnprocessableEntity, "")
+		return
+	}
+	c.String(http.StatusOK, "{}")
+}
+
+func deletePullReview(c *gin.Context) {
+	// only the review of the earlier report is expected to be replaced
+	if c.Param("id") != "5" {
+		c.String(http.StatusUnprocessableEntity, "")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func listIssueComments(c *gin.Context) {
+	page := c.Query("page")
+	if c.Param("index") != "1" || (page != "" && page != "1") {
+		c.String(http.StatusOK, "[]")
+	} else {
+		c.String(http.StatusOK, listIssueCommentsPayload)
+	}
+}
+
+func createIssueComment(c *gin.Context) {
+	if c.Param("index") == "1" {
+		// the pull request has a summary comment which has to be updated instead
+		c.String(http.StatusUnprocessableEntity, "")
+		return
+	}
+	c.String(http.StatusCreated, `{"id": 3}`)
+}
+
+func editIssueComment(c *gin.Context) {
+	var comment struct {
+		Body string `json:"body"`
+	}
+	if err := c.BindJSON(&comment); err != nil {
+		return
+	}
+	// only the summary comment is expected to be updated, and only by newer
+	// pipelines than the one it summarizes
+	var number int64
+	_, err := fmt.Sscanf(comment.Body, "[//]: # (woodpecker-pipeline-summary %d)", &number)
+	if c.Param("id") != "2" || err != nil || number < 5 {
+		c.String(http.StatusUnprocessableEntity, "")
+		return
+	}
+	c.String(http.StatusOK, `{"id": 2}`)
+}
+
 func getRepoFile(c *gin.Context) {
 	file := c.Param("file")
 	ref := c.Query("ref")
@@ -229,3 +306,55 @@ const prFilesPayload = `
 	}
 ]
 `
+
+const listIssueCommentsPayload = `
+[
+  {
+    "id": 1,
+    "user": {
+      "login": "someuser"
+    },
+    "body": "LGTM"
+  },
+  {
+    "id": 2,
+    "user": {
+      "login": "someuser"
+    },
+    "body": "[//]: # (woodpecker-pipeline-summary 5)\n\nPipeline #5: **success**"
+  }
+]
+`
+
+const listPullReviewsPayload = `
+[
+  {
+    "id": 4,
+    "user": {
+      "login": "someuser"
+    },
+    "body": "LGTM"
+  },
+  {
+    "id": 6,
+    "user": {
+      "login": "otheruser"
+    },
+    "body": "[//]: # (woodpecker-check-run )\n\n: copied"
+  },
+  {
+    "id": 7,
+    "user": {
+      "login": "someuser"
+    },
+    "body": "[//]: # (woodpecker-check-run ci/woodpecker/pr/lint)\n\nci/woodpecker/pr/lint: Pipeline failed"
+  },
+  {
+    "id": 5,
+    "user": {
+      "login": "someuser"
+    },
+    "body": "[//]: # (woodpecker-check-run )\n\n: Pipeline failed: 2 failed tests"
+  }
+]
+`
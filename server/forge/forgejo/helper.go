@@ -19,11 +19,13 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"codeberg.org/mvdkleijn/forgejo-sdk/forgejo/v3"
 
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/utils"
 )
@@ -194,6 +196,28 @@ func pipelineFromPullRequest(hook *pullRequestHook) *model.Pipeline {
 	return pipeline
 }
 
+// convertPullRequest converts a Forgejo pull request to a Woodpecker pull request.
+func convertPullRequest(from *forgejo.PullRequest, repoURL string) *model.PullRequest {
+	pr := &model.PullRequest{
+		Index:    model.ForgeRemoteID(strconv.Itoa(int(from.Index))),
+		Title:    from.Title,
+		Ref:      fmt.Sprintf("refs/pull/%d/head", from.Index),
+		ForgeURL: from.HTMLURL,
+		Labels:   convertLabels(from.Labels),
+	}
+	if from.Head != nil && from.Base != nil {
+		pr.Commit = from.Head.Sha
+		pr.Branch = from.Base.Ref
+		pr.Refspec = fmt.Sprintf("%s:%s", from.Head.Ref, from.Base.Ref)
+		pr.FromFork = from.Head.RepoID != from.Base.RepoID
+	}
+	if from.Poster != nil {
+		pr.Author = from.Poster.UserName
+		pr.Avatar = expandAvatar(repoURL, fixMalformedAvatar(from.Poster.AvatarURL))
+	}
+	return pr
+}
+
 func convertMilestone(milestone *forgejo.Milestone) string {
 	if milestone == nil || milestone.ID == 0 {
 		return ""
@@ -303,3 +327,25 @@ func convertLabels(from []*forgejo.Label) []string {
 	}
 	return labels
 }
+
+// pullIndex returns the index of the pull request of a pipeline ref.
+func pullIndex(ref string) (int64, bool) {
+	var index int64
+	if _, err := fmt.Sscanf(ref, "refs/pull/%d/head", &index); err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// matchingPullComment returns the pipeline summary comment posted by the user.
+func matchingPullComment(comments []*forgejo.Comment, login string) *forgejo.Comment {
+	for _, comment := range comments {
+		if comment.Poster == nil || comment.Poster.UserName != login {
+			continue
+		}
+		if _, ok := common.PullCommentPipeline(comment.Body); ok {
+			return comment
+		}
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package git implements a forge for plain Git servers like cgit or
+// gitolite, which have neither an OAuth nor a webhook API.
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// Opts defines configuration options.
+type Opts struct {
+	URL       string   // Optional url of a web frontend like cgit, used for links.
+	Repos     []string // Repositories as clone url, optionally prefixed with "owner/name=".
+	UsersFile string   // htpasswd file with the bcrypt hashed passwords of the local accounts.
+	PushUsers []string // Accounts allowed to push, as login for all repositories or owner/name:login.
+	Username  string   // Git machine account username for cloning over http.
+	Password  string   // Git machine account password for cloning over http.
+	CacheDir  string   // Directory of the local mirrors used to read files.
+}
+
+type client struct {
+	url       string
+	repos     []*repo
+	usersFile string
+	pushUsers []string
+	git       *gitCmd
+}
+
+// repo is a repository of the configured set.
+type repo struct {
+	owner string
+	name  string
+	url   string
+}
+
+func (r *repo) fullName() string {
+	return r.owner + "/" + r.name
+}
+
+// New returns a Forge implementation for plain Git servers.
+func New(opts Opts) (forge.Forge, error) {
+	if len(opts.Repos) == 0 {
+		return nil, errors.New("must have at least one repository")
+	}
+	if opts.UsersFile == "" {
+		return nil, errors.New("must have a users file")
+	}
+
+	c := &client{
+		url:       strings.TrimSuffix(opts.URL, "/"),
+		usersFile: opts.UsersFile,
+		pushUsers: opts.PushUsers,
+	}
+	for _, entry := range opts.Repos {
+		r, err := parseRepo(entry)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(c.repos, func(other *repo) bool { return other.fullName() == r.fullName() }) {
+			return nil, fmt.Errorf("repository %s is configured twice", r.fullName())
+		}
+		c.repos = append(c.repos, r)
+	}
+
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(os.TempDir(), "woodpecker-git")
+	}
+	c.git = newGitCmd(cacheDir, opts.Username, opts.Password)
+
+	return c, nil
+}
+
+// parseRepo parses a repository entry of the form "[owner/name=]url". Without
+// a name, owner and name are the last two elements of the url path.
+func parseRepo(entry string) (*repo, error) {
+	fullName, cloneURL, named := strings.Cut(strings.TrimSpace(entry), "=")
+	if !named {
+		cloneURL = fullName
+		fullName = repoPath(cloneURL)
+	}
+
+	parts := strings.Split(fullName, "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return nil, fmt.Errorf("cannot get owner and name of repository %q, use owner/name=url", entry)
+	}
+	return &repo{
+		owner: parts[len(parts)-2],
+		name:  parts[len(parts)-1],
+		url:   cloneURL,
+	}, nil
+}
+
+// repoPath returns the path of a clone url without the .git suffix, scp-like
+// urls like git@example.com:team/app.git are supported.
+func repoPath(cloneURL string) string {
+	p := cloneURL
+	if i := strings.Index(p, "://"); i >= 0 {
+		p = p[i+3:]
+		if j := strings.Index(p, "/"); j >= 0 {
+			p = p[j:]
+		}
+	} else if _, after, ok := strings.Cut(p, ":"); ok {
+		p = after
+	}
+	return strings.TrimSuffix(strings.Trim(p, "/"), ".git")
+}
+
+// Name returns the string name of this driver.
+func (c *client) Name() string {
+	return "git"
+}
+
+// URL returns the url of the web frontend.
+func (c *client) URL() string {
+	return c.url
+}
+
+// Login authenticates a local account with the login and password from the
+// login form, there is no OAuth flow.
+func (c *client) Login(_ context.Context, req *forge_types.OAuthRequest) (*model.User, string, error) {
+	if req.Login == "" {
+		return nil, "", errMissingCredentials
+	}
+
+	if err := checkPassword(c.usersFile, req.Login, req.Password); err != nil {
+		return nil, "", err
+	}
+
+	return &model.User{
+		Login:         req.Login,
+		ForgeRemoteID: model.ForgeRemoteID(req.Login),
+	}, "", nil
+}
+
+// Teams is not supported.
+func (c *client) Teams(_ context.Context, _ *model.User, _ *model.ListOptions) ([]*model.Team, error) {
+	return nil, forge_types.ErrNotImplemented
+}
+
+// Repo returns a repository of the configured set.
+func (c *client) Repo(ctx context.Context, u *model.User, remoteID model.ForgeRemoteID, owner, name string) (*model.Repo, error) {
+	fullName := string(remoteID)
+	if !remoteID.IsValid() {
+		fullName = owner + "/" + name
+	}
+
+	for _, r := range c.repos {
+		if r.fullName() == fullName {
+			return c.toRepo(ctx, u, r)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", forge_types.ErrRepoNotFound, fullName)
+}
+
+// Repos returns the configured set of repositories.
+func (c *client) Repos(ctx context.Context, u *model.User, p *model.ListOptions) ([]*model.Repo, error) {
+	repos := make([]*model.Repo, 0, len(c.repos))
+	for _, r := range model.ApplyPagination(p.All(), c.repos) {
+		repo, err := c.toRepo(ctx, u, r)
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+	return repos, nil
+}
+
+// toRepo converts a configured repository, every user can pull all of them and
+// only admins can manage them.
+func (c *client) toRepo(ctx context.Context, u *model.User, r *repo) (*model.Repo, error) {
+	branch, err := c.git.defaultBranch(ctx, r.url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get default branch of %s: %w", r.fullName(), err)
+	}
+
+	repo := &model.Repo{
+		ForgeRemoteID: model.ForgeRemoteID(r.fullName()),
+		Owner:         r.owner,
+		Name:          r.name,
+		FullName:      r.fullName(),
+		Clone:         r.url,
+		Branch:        branch,
+		IsSCMPrivate:  true,
+		Perm: &model.Perm{
+			Pull:  true,
+			Push:  c.canPush(u, r),
+			Admin: u != nil && u.Admin,
+		},
+	}
+	if isSSH(r.url) {
+		repo.CloneSSH = r.url
+	}
+	if c.url != "" {
+		repo.ForgeURL = c.url + "/" + r.fullName()
+	}
+	return repo, nil
+}
+
+// canPush reports whether the user may push to a repository, which allows to
+// run its pipelines. Admins may push to all repositories.
+func (c *client) canPush(u *model.User, r *repo) bool {
+	if u == nil {
+		return false
+	}
+	if u.Admin {
+		return true
+	}
+	for _, entry := range c.pushUsers {
+		fullName, login, scoped := strings.Cut(entry, ":")
+		if !scoped {
+			login = fullName
+		}
+		if login == u.Login && (!scoped || fullName == r.fullName()) {
+			return true
+		}
+	}
+	return false
+}
+
+// File fetches a file at the commit of the pipeline.
+func (c *client) File(ctx context.Context, _ *model.User, r *model.Repo, b *model.Pipeline, fileName string) ([]byte, error) {
+	dir, err := c.mirrorAt(ctx, r, b.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.file(ctx, dir, b.Commit, fileName)
+}
+
+// Dir fetches all files of a directory at the commit of the pipeline.
+func (c *client) Dir(ctx context.Context, _ *model.User, r *model.Repo, b *model.Pipeline, dirName string) ([]*forge_types.FileMeta, error) {
+	dir, err := c.mirrorAt(ctx, r, b.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return c.git.dir(ctx, dir, b.Commit, dirName)
+}
+
+// mirrorAt returns the mirror of a repository containing the given commit.
+func (c *client) mirrorAt(ctx context.Context, r *model.Repo, commit string) (string, error) {
+	cfg, err := c.configured(r)
+	if err != nil {
+		return "", err
+	}
+	return c.git.mirrorAt(ctx, cfg.url, commit)
+}
+
+func (c *client) configured(r *model.Repo) (*repo, error) {
+	for _, cfg := range c.repos {
+		if cfg.fullName() == string(r.ForgeRemoteID) {
+			return cfg, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s is not configured", forge_types.ErrRepoNotFound, r.FullName)
+}
+
+// Status is not supported, plain Git servers have no commit statuses.
+func (c *client) Status(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline, _ *model.Workflow) error {
+	return nil
+}
+
+// Netrc returns the machine account used to clone over http.
+func (c *client) Netrc(_ *model.User, r *model.Repo) (*model.Netrc, error) {
+	host, err := common.ExtractHostFromCloneURL(r.Clone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Netrc{
+		Login:    c.git.username,
+		Password: c.git.password,
+		Machine:  host,
+		Type:     model.ForgeTypeGit,
+	}, nil
+}
+
+// Activate does nothing, the hook has to be added to the repository on the
+// Git server.
+func (c *client) Activate(_ context.Context, _ *model.User, _ *model.Repo, _ string) error {
+	return nil
+}
+
+// Deactivate does nothing.
+func (c *client) Deactivate(_ context.Context, _ *model.User, _ *model.Repo, _ string) error {
+	return nil
+}
+
+// Branches returns the names of all branches.
+func (c *client) Branches(ctx context.Context, _ *model.User, r *model.Repo, p *model.ListOptions) ([]string, error) {
+	cfg, err := c.configured(r)
+	if err != nil {
+		return nil, err
+	}
+	heads, err := c.git.heads(ctx, cfg.url)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, 0, len(heads))
+	for branch := range heads {
+		branches = append(branches, branch)
+	}
+	slices.Sort(branches)
+	return model.ApplyPagination(p.All(), branches), nil
+}
+
+// BranchHead returns the latest commit of a branch.
+func (c *client) BranchHead(ctx context.Context, _ *model.User, r *model.Repo, branch string) (*model.Commit, error) {
+	cfg, err := c.configured(r)
+	if err != nil {
+		return nil, err
+	}
+	heads, err := c.git.heads(ctx, cfg.url)
+	if err != nil {
+		return nil, err
+	}
+
+	sha, ok := heads[branch]
+	if !ok {
+		return nil, fmt.Errorf("branch %s not found", branch)
+	}
+	return &model.Commit{SHA: sha}, nil
+}
+
+// PullRequests is not supported, plain Git servers have no pull requests.
+func (c *client) PullRequests(_ context.Context, _ *model.User, _ *model.Repo, _ *model.ListOptions) ([]*model.PullRequest, error) {
+	return nil, forge_types.ErrNotImplemented
+}
+
+// Hook parses the payload of a push sent by a hook of the Git server.
+func (c *client) Hook(ctx context.Context, r *http.Request) (*model.Repo, *model.Pipeline, error) {
+	return c.parseHook(ctx, r)
+}
+
+// OrgMembership makes every user member of all organizations, admins are
+// admins of all organizations.
+func (c *client) OrgMembership(_ context.Context, u *model.User, _ string) (*model.OrgPerm, error) {
+	return &model.OrgPerm{Member: true, Admin: u.Admin}, nil
+}
+
+// Org returns an organization, organizations are the owners of the configured
+// repositories.
+func (c *client) Org(_ context.Context, u *model.User, org string) (*model.Org, error) {
+	return &model.Org{
+		Name:   org,
+		IsUser: u != nil && u.Login == org,
+	}, nil
+}
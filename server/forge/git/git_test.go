@@ -0,0 +1,249 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestParseRepo(t *testing.T) {
+	tests := []struct {
+		entry string
+		owner string
+		name  string
+		url   string
+	}{
+		{entry: "https://git.example.com/team/app.git", owner: "team", name: "app", url: "https://git.example.com/team/app.git"},
+		{entry: "https://git.example.com/cgit/team/app", owner: "team", name: "app", url: "https://git.example.com/cgit/team/app"},
+		{entry: "git@git.example.com:team/app.git", owner: "team", name: "app", url: "git@git.example.com:team/app.git"},
+		{entry: "ssh://git@git.example.com:2222/team/app.git", owner: "team", name: "app", url: "ssh://git@git.example.com:2222/team/app.git"},
+		{entry: "ops/app=git@git.example.com:app.git", owner: "ops", name: "app", url: "git@git.example.com:app.git"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.entry, func(t *testing.T) {
+			r, err := parseRepo(tt.entry)
+			require.NoError(t, err)
+			assert.Equal(t, &repo{owner: tt.owner, name: tt.name, url: tt.url}, r)
+		})
+	}
+
+	_, err := parseRepo("git@git.example.com:app.git")
+	assert.Error(t, err)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Opts{UsersFile: "users"})
+	assert.Error(t, err)
+
+	_, err = New(Opts{Repos: []string{"https://git.example.com/team/app.git"}})
+	assert.Error(t, err)
+
+	_, err = New(Opts{
+		Repos:     []string{"https://git.example.com/team/app.git", "team/app=https://mirror.example.com/app.git"},
+		UsersFile: "users",
+	})
+	assert.ErrorContains(t, err, "configured twice")
+}
+
+func TestLogin(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	usersFile := filepath.Join(t.TempDir(), "users")
+	require.NoError(t, os.WriteFile(usersFile, []byte("# local accounts\nalice:"+string(hash)+"\n"), 0o600))
+
+	c := &client{usersFile: usersFile}
+
+	user, _, err := c.Login(t.Context(), &forge_types.OAuthRequest{Login: "alice", Password: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Login)
+	assert.Equal(t, model.ForgeRemoteID("alice"), user.ForgeRemoteID)
+
+	_, _, err = c.Login(t.Context(), &forge_types.OAuthRequest{Login: "alice", Password: "wrong"})
+	assert.ErrorIs(t, err, errInvalidCredentials)
+
+	_, _, err = c.Login(t.Context(), &forge_types.OAuthRequest{Login: "bob", Password: "secret"})
+	assert.ErrorIs(t, err, errInvalidCredentials)
+
+	_, _, err = c.Login(t.Context(), &forge_types.OAuthRequest{})
+	assert.ErrorIs(t, err, errMissingCredentials)
+}
+
+func TestGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	origin := newOrigin(t)
+	first := origin.commit(t, "first", map[string]string{".woodpecker.yaml": "steps: {}"})
+	second := origin.commit(t, "second", map[string]string{
+		".woodpecker/build.yaml": "steps: {build: {}}",
+		".woodpecker/test.yaml":  "steps: {test: {}}",
+		".woodpecker/sub/x.yaml": "steps: {}",
+	})
+	origin.git(t, "branch", "feature", first)
+	origin.git(t, "tag", "v1.0.0", first)
+	owner, name := filepath.Base(filepath.Dir(origin.dir)), filepath.Base(origin.dir)
+
+	forge, err := New(Opts{
+		Repos:     []string{"file://" + origin.dir},
+		UsersFile: "users",
+		PushUsers: []string{"carol", owner + "/" + name + ":dave", "other/repo:erin"},
+		CacheDir:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	ctx := t.Context()
+	user := &model.User{Login: "alice", Admin: true}
+
+	repo, err := forge.Repo(ctx, user, "", owner, name)
+	require.NoError(t, err)
+	assert.Equal(t, model.ForgeRemoteID(owner+"/"+name), repo.ForgeRemoteID)
+	assert.Equal(t, "main", repo.Branch)
+	assert.True(t, repo.Perm.Admin)
+
+	repos, err := forge.Repos(ctx, &model.User{Login: "bob"}, &model.ListOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.True(t, repos[0].Perm.Pull)
+	assert.False(t, repos[0].Perm.Push)
+	assert.False(t, repos[0].Perm.Admin)
+
+	// push is only granted to the configured users
+	for login, push := range map[string]bool{"carol": true, "dave": true, "erin": false} {
+		repo, err := forge.Repo(ctx, &model.User{Login: login}, "", owner, name)
+		require.NoError(t, err)
+		assert.Equal(t, push, repo.Perm.Push, login)
+	}
+
+	_, err = forge.Repo(ctx, user, "", "other", "repo")
+	assert.ErrorIs(t, err, forge_types.ErrRepoNotFound)
+
+	t.Run("branches", func(t *testing.T) {
+		branches, err := forge.Branches(ctx, user, repo, &model.ListOptions{Page: 1, PerPage: 10})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"feature", "main"}, branches)
+
+		head, err := forge.BranchHead(ctx, user, repo, "feature")
+		require.NoError(t, err)
+		assert.Equal(t, first, head.SHA)
+	})
+
+	t.Run("files", func(t *testing.T) {
+		file, err := forge.File(ctx, user, repo, &model.Pipeline{Commit: first}, ".woodpecker.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "steps: {}", string(file))
+
+		_, err = forge.File(ctx, user, repo, &model.Pipeline{Commit: first}, ".woodpecker/build.yaml")
+		assert.ErrorIs(t, err, &forge_types.ErrConfigNotFound{})
+
+		files, err := forge.Dir(ctx, user, repo, &model.Pipeline{Commit: second}, ".woodpecker")
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Equal(t, ".woodpecker/build.yaml", files[0].Name)
+		assert.Equal(t, "steps: {test: {}}", string(files[1].Data))
+
+		_, err = forge.Dir(ctx, user, repo, &model.Pipeline{Commit: first}, ".woodpecker")
+		assert.ErrorIs(t, err, &forge_types.ErrConfigNotFound{})
+	})
+
+	t.Run("commits pushed later are fetched", func(t *testing.T) {
+		third := origin.commit(t, "third", map[string]string{".woodpecker.yaml": "steps: {third: {}}"})
+
+		file, err := forge.File(ctx, user, repo, &model.Pipeline{Commit: third}, ".woodpecker.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "steps: {third: {}}", string(file))
+	})
+
+	t.Run("push hook", func(t *testing.T) {
+		hookRepo, pipeline, err := forge.Hook(ctx, hookRequest(`{"repo":"`+owner+"/"+name+`","ref":"refs/heads/main","before":"`+first+`","after":"`+second+`","sender":"alice"}`))
+		require.NoError(t, err)
+		assert.Equal(t, repo.ForgeRemoteID, hookRepo.ForgeRemoteID)
+		assert.Equal(t, model.EventPush, pipeline.Event)
+		assert.Equal(t, "main", pipeline.Branch)
+		assert.Equal(t, second, pipeline.Commit)
+		assert.Equal(t, "second", pipeline.Message)
+		assert.Equal(t, "Jane Doe", pipeline.Author)
+		assert.Equal(t, "alice", pipeline.Sender)
+		assert.ElementsMatch(t, []string{".woodpecker/build.yaml", ".woodpecker/test.yaml", ".woodpecker/sub/x.yaml"}, pipeline.ChangedFiles)
+	})
+
+	t.Run("tag hook", func(t *testing.T) {
+		_, pipeline, err := forge.Hook(ctx, hookRequest(`{"repo":"`+owner+"/"+name+`","ref":"refs/tags/v1.0.0","after":"`+first+`"}`))
+		require.NoError(t, err)
+		assert.Equal(t, model.EventTag, pipeline.Event)
+		assert.Equal(t, "refs/tags/v1.0.0", pipeline.Ref)
+		assert.Equal(t, "Jane Doe", pipeline.Sender)
+	})
+
+	t.Run("deleted branches are ignored", func(t *testing.T) {
+		_, _, err := forge.Hook(ctx, hookRequest(`{"repo":"`+owner+"/"+name+`","ref":"refs/heads/feature","before":"`+first+`","after":"0000000000000000000000000000000000000000"}`))
+		assert.ErrorIs(t, err, &forge_types.ErrIgnoreEvent{})
+	})
+}
+
+type origin struct {
+	dir string
+}
+
+// newOrigin creates a repository acting as the Git server.
+func newOrigin(t *testing.T) *origin {
+	o := &origin{dir: filepath.Join(t.TempDir(), "team", "app")}
+	require.NoError(t, os.MkdirAll(o.dir, 0o700))
+	o.git(t, "init", "--quiet", "--initial-branch=main")
+	return o
+}
+
+func (o *origin) git(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := exec.CommandContext(context.Background(), "git", args...)
+	cmd.Dir = o.dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Jane Doe", "GIT_AUTHOR_EMAIL=jane@example.com",
+		"GIT_COMMITTER_NAME=Jane Doe", "GIT_COMMITTER_EMAIL=jane@example.com",
+		"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func (o *origin) commit(t *testing.T, message string, files map[string]string) string {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(o.dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	o.git(t, "add", "--all")
+	o.git(t, "commit", "--quiet", "--message", message)
+	return o.git(t, "rev-parse", "HEAD")
+}
+
+func hookRequest(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "/api/hook", strings.NewReader(body))
+}
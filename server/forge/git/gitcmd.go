@@ -0,0 +1,268 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+)
+
+var commitPattern = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+
+// gitCmd runs the git binary. Files are read from bare mirrors of the
+// repositories, which are only fetched if they miss the requested commit.
+type gitCmd struct {
+	cacheDir string
+	username string
+	password string
+
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newGitCmd(cacheDir, username, password string) *gitCmd {
+	return &gitCmd{
+		cacheDir: cacheDir,
+		username: username,
+		password: password,
+		locks:    make(map[string]*sync.Mutex),
+	}
+}
+
+func (g *gitCmd) run(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if g.username != "" {
+		// passed by environment to not show the credentials in the process list
+		auth := base64.StdEncoding.EncodeToString([]byte(g.username + ":" + g.password))
+		cmd.Env = append(cmd.Env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// lock locks the mirror of a repository.
+func (g *gitCmd) lock(dir string) func() {
+	g.mu.Lock()
+	l, ok := g.locks[dir]
+	if !ok {
+		l = new(sync.Mutex)
+		g.locks[dir] = l
+	}
+	g.mu.Unlock()
+
+	l.Lock()
+	return l.Unlock
+}
+
+// mirrorAt returns the directory of the mirror of a repository, fetching the
+// repository if the mirror does not contain the commit yet.
+func (g *gitCmd) mirrorAt(ctx context.Context, url, commit string) (string, error) {
+	if !commitPattern.MatchString(commit) {
+		return "", fmt.Errorf("invalid commit %q", commit)
+	}
+
+	hash := sha256.Sum256([]byte(url))
+	dir := filepath.Join(g.cacheDir, hex.EncodeToString(hash[:]))
+	defer g.lock(dir)()
+
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(g.cacheDir, 0o700); err != nil {
+			return "", err
+		}
+		if _, err := g.run(ctx, "", "init", "--bare", "--quiet", dir); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", err
+	}
+
+	if g.hasCommit(ctx, dir, commit) {
+		return dir, nil
+	}
+
+	if _, err := g.run(ctx, dir, "fetch", "--quiet", "--prune", "--no-tags", "--end-of-options", url,
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*"); err != nil {
+		return "", err
+	}
+	if !g.hasCommit(ctx, dir, commit) {
+		return "", fmt.Errorf("commit %s not found", commit)
+	}
+	return dir, nil
+}
+
+func (g *gitCmd) hasCommit(ctx context.Context, dir, commit string) bool {
+	_, err := g.run(ctx, dir, "cat-file", "-e", commit+"^{commit}")
+	return err == nil
+}
+
+// file returns the content of a file at a commit.
+func (g *gitCmd) file(ctx context.Context, dir, commit, name string) ([]byte, error) {
+	object := commit + ":" + cleanPath(name)
+	typ, err := g.run(ctx, dir, "cat-file", "-t", object)
+	if err != nil || strings.TrimSpace(string(typ)) != "blob" {
+		return nil, &forge_types.ErrConfigNotFound{Configs: []string{name}}
+	}
+	return g.run(ctx, dir, "cat-file", "blob", object)
+}
+
+// dir returns the files of a directory at a commit, sub directories are
+// skipped.
+func (g *gitCmd) dir(ctx context.Context, dir, commit, name string) ([]*forge_types.FileMeta, error) {
+	name = cleanPath(name)
+	out, err := g.run(ctx, dir, "ls-tree", "-z", commit+":"+name)
+	if err != nil {
+		return nil, &forge_types.ErrConfigNotFound{Configs: []string{name}}
+	}
+
+	var files []*forge_types.FileMeta
+	for _, entry := range strings.Split(string(out), "\x00") {
+		// <mode> SP <type> SP <object> TAB <file>
+		info, fileName, ok := strings.Cut(entry, "\t")
+		if fields := strings.Fields(info); !ok || len(fields) < 2 || fields[1] != "blob" {
+			continue
+		}
+		filePath := path.Join(name, fileName)
+		data, err := g.file(ctx, dir, commit, filePath)
+		if err != nil {
+			return nil, fmt.Errorf("multi-pipeline cannot get %s: %w", filePath, err)
+		}
+		files = append(files, &forge_types.FileMeta{
+			Name: filePath,
+			Data: data,
+		})
+	}
+	return files, nil
+}
+
+// heads returns the commits of all branches of a repository.
+func (g *gitCmd) heads(ctx context.Context, url string) (map[string]string, error) {
+	out, err := g.run(ctx, "", "ls-remote", "--heads", "--end-of-options", url)
+	if err != nil {
+		return nil, err
+	}
+
+	heads := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		sha, ref, ok := strings.Cut(line, "\t")
+		if branch, isBranch := strings.CutPrefix(ref, "refs/heads/"); ok && isBranch {
+			heads[branch] = sha
+		}
+	}
+	return heads, nil
+}
+
+// defaultBranch returns the branch HEAD of a repository points to. Servers
+// not advertising it fall back to main or master.
+func (g *gitCmd) defaultBranch(ctx context.Context, url string) (string, error) {
+	out, err := g.run(ctx, "", "ls-remote", "--symref", "--end-of-options", url, "HEAD")
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		// ref: refs/heads/main <TAB> HEAD
+		if ref, ok := strings.CutPrefix(line, "ref: refs/heads/"); ok {
+			branch, _, _ := strings.Cut(ref, "\t")
+			return branch, nil
+		}
+	}
+
+	heads, err := g.heads(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := heads["master"]; ok {
+		return "master", nil
+	}
+	return "main", nil
+}
+
+type commitInfo struct {
+	author  string
+	email   string
+	message string
+}
+
+func (g *gitCmd) commit(ctx context.Context, dir, commit string) (*commitInfo, error) {
+	out, err := g.run(ctx, dir, "log", "-1", "--format=%an%x00%ae%x00%B", commit)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(string(out), "\x00", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("cannot parse commit %s", commit)
+	}
+	return &commitInfo{
+		author:  parts[0],
+		email:   parts[1],
+		message: strings.TrimSpace(parts[2]),
+	}, nil
+}
+
+// changedFiles returns the files changed between two commits, or by the
+// commit itself if there is no previous commit.
+func (g *gitCmd) changedFiles(ctx context.Context, dir, before, after string) ([]string, error) {
+	var out []byte
+	var err error
+	if commitPattern.MatchString(before) && g.hasCommit(ctx, dir, before) {
+		out, err = g.run(ctx, dir, "diff", "--name-only", "-z", before, after)
+	} else {
+		out, err = g.run(ctx, dir, "diff-tree", "--no-commit-id", "--name-only", "-r", "-z", "--root", after)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, file := range strings.Split(string(out), "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+func cleanPath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+func isSSH(url string) bool {
+	return strings.HasPrefix(url, "ssh://") || (!strings.Contains(url, "://") && strings.Contains(url, "@"))
+}
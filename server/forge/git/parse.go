@@ -0,0 +1,103 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// pushHook is the payload of a push, one per updated ref like the lines a
+// post-receive hook reads from stdin.
+type pushHook struct {
+	Repo   string `json:"repo"`
+	Ref    string `json:"ref"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+	// Sender is the user who pushed, if the Git server knows it.
+	Sender string `json:"sender"`
+}
+
+func (c *client) parseHook(ctx context.Context, r *http.Request) (*model.Repo, *model.Pipeline, error) {
+	hook := new(pushHook)
+	if err := json.NewDecoder(r.Body).Decode(hook); err != nil {
+		return nil, nil, fmt.Errorf("cannot parse hook: %w", err)
+	}
+
+	if strings.Trim(hook.After, "0") == "" {
+		return nil, nil, &forge_types.ErrIgnoreEvent{Event: "push", Reason: "ref was deleted"}
+	}
+
+	var cfg *repo
+	for _, r := range c.repos {
+		if r.fullName() == hook.Repo {
+			cfg = r
+		}
+	}
+	if cfg == nil {
+		return nil, nil, fmt.Errorf("%w: %s is not configured", forge_types.ErrRepoNotFound, hook.Repo)
+	}
+
+	repo, err := c.toRepo(ctx, nil, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pipeline := &model.Pipeline{
+		Event:     model.EventPush,
+		Commit:    hook.After,
+		Ref:       hook.Ref,
+		Sender:    hook.Sender,
+		Timestamp: time.Now().UTC().Unix(),
+	}
+	switch {
+	case strings.HasPrefix(hook.Ref, "refs/heads/"):
+		pipeline.Branch = strings.TrimPrefix(hook.Ref, "refs/heads/")
+	case strings.HasPrefix(hook.Ref, "refs/tags/"):
+		pipeline.Event = model.EventTag
+	default:
+		return nil, nil, &forge_types.ErrIgnoreEvent{Event: "push", Reason: "unsupported ref " + hook.Ref}
+	}
+
+	dir, err := c.git.mirrorAt(ctx, cfg.url, hook.After)
+	if err != nil {
+		return nil, nil, err
+	}
+	commit, err := c.git.commit(ctx, dir, hook.After)
+	if err != nil {
+		return nil, nil, err
+	}
+	pipeline.Message = commit.message
+	pipeline.Author = commit.author
+	pipeline.Email = commit.email
+	if pipeline.Sender == "" {
+		pipeline.Sender = commit.author
+	}
+
+	if pipeline.Event == model.EventPush {
+		if pipeline.ChangedFiles, err = c.git.changedFiles(ctx, dir, hook.Before, hook.After); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return repo, pipeline, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	errMissingCredentials = errors.New("missing login or password")
+	errInvalidCredentials = errors.New("invalid login or password")
+)
+
+// dummyHash is compared against for unknown accounts if the users file has no
+// other account. It is a hash of "woodpecker" with the cost htpasswd -B uses.
+const dummyHash = "$2a$05$aqPL0B8Gltef5U98momr0u9BNu7HMtME6TlXxpgB98AIxCLukyqru"
+
+// checkPassword checks the password of a local account against the htpasswd
+// file, only bcrypt hashes (htpasswd -B) are supported. The file is read on
+// every login, so accounts can be changed without restarting the server.
+// Unknown accounts are checked against another hash of the file, so they
+// can't be told apart from wrong passwords by the response time.
+func checkPassword(usersFile, login, password string) error {
+	file, err := os.Open(usersFile)
+	if err != nil {
+		return fmt.Errorf("cannot open users file: %w", err)
+	}
+	defer file.Close()
+
+	otherHash := dummyHash
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		user, hash, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		if user != login {
+			otherHash = hash
+			continue
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+			return errInvalidCredentials
+		}
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("cannot read users file: %w", err)
+	}
+
+	_ = bcrypt.CompareHashAndPassword([]byte(otherHash), []byte(password))
+	return errInvalidCredentials
+}
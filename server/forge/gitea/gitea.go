@@ -339,6 +339,158 @@ func (c *Gitea) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+// CheckRun reports the workflow with a commit status, as Gitea has no check
+// runs. Once the workflow of a pull request is done, its failures in the
+// changed files are added as review comments. Reviews can not be edited, so
+// the review of an earlier report of the workflow is replaced.
+func (c *Gitea) CheckRun(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow, run *forge_types.CheckRun) error {
+	client, err := c.newClientToken(ctx, user.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.CreateStatus(
+		repo.Owner,
+		repo.Name,
+		pipeline.Commit,
+		gitea.CreateStatusOption{
+			State:       getStatus(workflow.State),
+			TargetURL:   common.GetPipelineStatusURL(repo, pipeline, workflow),
+			Description: run.Title,
+			Context:     common.GetPipelineStatusContext(repo, pipeline, workflow),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	index, ok := pullIndex(pipeline.Ref)
+	if !ok {
+		return nil
+	}
+	statusContext := common.GetPipelineStatusContext(repo, pipeline, workflow)
+	review, err := c.findCheckRunReview(ctx, client, user, repo, index, statusContext)
+	if err != nil {
+		return err
+	}
+	if review != nil {
+		if _, err := client.DeletePullReview(repo.Owner, repo.Name, index, review.ID); err != nil {
+			return err
+		}
+	}
+
+	annotations := common.CommentAnnotations(run, pipeline.ChangedFiles)
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	comments := make([]gitea.CreatePullReviewComment, 0, len(annotations))
+	for _, annotation := range annotations {
+		comments = append(comments, gitea.CreatePullReviewComment{
+			Path:       annotation.Path,
+			Body:       common.FormatAnnotation(annotation),
+			NewLineNum: int64(annotation.Line),
+		})
+	}
+	_, _, err = client.CreatePullReview(repo.Owner, repo.Name, index, gitea.CreatePullReviewOptions{
+		State:    gitea.ReviewStateComment,
+		Body:     common.CheckRunReviewBody(statusContext, run.Title),
+		CommitID: pipeline.Commit,
+		Comments: comments,
+	})
+	return err
+}
+
+// findCheckRunReview returns the review of the user with the annotations of
+// the workflow with the given status context, if any.
+func (c *Gitea) findCheckRunReview(ctx context.Context, client *gitea.Client, u *model.User, r *model.Repo, index int64, statusContext string) (*gitea.PullReview, error) {
+	reviews, err := shared_utils.Paginate(func(page int) ([]*gitea.PullReview, error) {
+		reviews, _, err := client.ListPullReviews(r.Owner, r.Name, index, gitea.ListPullReviewsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: c.perPage(ctx),
+			},
+		})
+		return reviews, err
+	}, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, review := range reviews {
+		if review.Reviewer != nil && review.Reviewer.UserName == u.Login && common.IsCheckRunReview(review.Body, statusContext) {
+			return review, nil
+		}
+	}
+	return nil, nil
+}
+
+// PullComment creates the pipeline summary comment on the pull request of the
+// pipeline or updates the existing one.
+func (c *Gitea) PullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	index, ok := pullIndex(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := c.newClientToken(ctx, u.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	comment, err := c.findPullComment(ctx, client, u, r, index)
+	if err != nil {
+		return err
+	}
+
+	if comment == nil {
+		_, _, err = client.CreateIssueComment(r.Owner, r.Name, index, gitea.CreateIssueCommentOption{Body: body})
+		return err
+	}
+	if common.SkipPullComment(p, comment.Body) {
+		return nil
+	}
+	_, _, err = client.EditIssueComment(r.Owner, r.Name, comment.ID, gitea.EditIssueCommentOption{Body: body})
+	return err
+}
+
+// ClosePullComment collapses the pipeline summary comment on the pull request
+// of the pipeline.
+func (c *Gitea) ClosePullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline) error {
+	index, ok := pullIndex(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := c.newClientToken(ctx, u.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	comment, err := c.findPullComment(ctx, client, u, r, index)
+	if err != nil || comment == nil {
+		return err
+	}
+	_, _, err = client.EditIssueComment(r.Owner, r.Name, comment.ID, gitea.EditIssueCommentOption{Body: common.CollapsePullComment(comment.Body)})
+	return err
+}
+
+func (c *Gitea) findPullComment(ctx context.Context, client *gitea.Client, u *model.User, r *model.Repo, index int64) (*gitea.Comment, error) {
+	comments, err := shared_utils.Paginate(func(page int) ([]*gitea.Comment, error) {
+		comments, _, err := client.ListIssueComments(r.Owner, r.Name, index, gitea.ListIssueCommentOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: c.perPage(ctx),
+			},
+		})
+		return comments, err
+	}, -1)
+	if err != nil {
+		return nil, err
+	}
+	return matchingPullComment(comments, u.Login), nil
+}
+
 // Netrc returns a netrc file capable of authenticating Gitea requests and
 // cloning Gitea repositories. The netrc will use the global machine account
 // when configured.
@@ -466,10 +618,16 @@ func (c *Gitea) BranchHead(ctx context.Context, u *model.User, r *model.Repo, br
 	if err != nil {
 		return nil, err
 	}
-	return &model.Commit{
+	commit := &model.Commit{
 		SHA:      b.Commit.ID,
 		ForgeURL: b.Commit.URL,
-	}, nil
+		Message:  b.Commit.Message,
+	}
+	if b.Commit.Author != nil {
+		commit.Author = b.Commit.Author.UserName
+		commit.Email = b.Commit.Author.Email
+	}
+	return commit, nil
 }
 
 func (c *Gitea) PullRequests(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]*model.PullRequest, error) {
@@ -493,11 +651,8 @@ func (c *Gitea) PullRequests(ctx context.Context, u *model.User, r *model.Repo,
 	}
 
 	result := make([]*model.PullRequest, len(pullRequests))
-	for i := range pullRequests {
-		result[i] = &model.PullRequest{
-			Index: model.ForgeRemoteID(strconv.Itoa(int(pullRequests[i].Index))),
-			Title: pullRequests[i].Title,
-		}
+	for i, pr := range pullRequests {
+		result[i] = convertPullRequest(pr, r.ForgeURL)
 	}
 	return result, err
 }
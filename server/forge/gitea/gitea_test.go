@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/gitea/fixtures"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
@@ -120,6 +121,48 @@ func Test_gitea(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("pipeline check run", func(t *testing.T) {
+		run := &forge_types.CheckRun{
+			Title: "Pipeline failed: 1 failed test",
+			Annotations: []*forge_types.Annotation{
+				{Path: "main.go", Line: 3, Level: forge_types.AnnotationLevelFailure, Title: "TestMain", Message: "expected true"},
+				{Path: "other.go", Line: 7, Level: forge_types.AnnotationLevelFailure, Title: "TestOther", Message: "expected false"},
+			},
+		}
+		workflow := &model.Workflow{Name: "test", State: model.StatusFailure}
+
+		err := c.(*Gitea).CheckRun(ctx, fakeUser, fakeRepo, fakePipeline, workflow, run)
+		assert.NoError(t, err)
+
+		pull := &model.Pipeline{
+			Commit:       "9ecad50",
+			Event:        model.EventPull,
+			Ref:          "refs/pull/1/head",
+			ChangedFiles: []string{"main.go"},
+		}
+		err = c.(*Gitea).CheckRun(ctx, fakeUser, fakeRepo, pull, workflow, run)
+		assert.NoError(t, err)
+	})
+
+	t.Run("pull request comment", func(t *testing.T) {
+		forge, _ := c.(*Gitea)
+
+		// updates the existing summary comment
+		err := forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 6, Event: model.EventPull, Ref: "refs/pull/1/head"}, "[//]: # (woodpecker-pipeline-summary 6)\n\nPipeline #6")
+		assert.NoError(t, err)
+
+		// doesn't replace the summary of a newer pipeline
+		err = forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 4, Event: model.EventPull, Ref: "refs/pull/1/head"}, "[//]: # (woodpecker-pipeline-summary 4)\n\nPipeline #4")
+		assert.NoError(t, err)
+
+		// creates the first summary comment
+		err = forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 1, Event: model.EventPull, Ref: "refs/pull/2/head"}, "[//]: # (woodpecker-pipeline-summary 1)\n\nPipeline #1")
+		assert.NoError(t, err)
+
+		err = forge.ClosePullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 7, Event: model.EventPullClosed, Ref: "refs/pull/1/head"})
+		assert.NoError(t, err)
+	})
+
 	t.Run("PR hook", func(t *testing.T) {
 		buf := bytes.NewBufferString(fixtures.HookPullRequest)
 		req, _ := http.NewRequest(http.MethodPost, "/hook", buf)
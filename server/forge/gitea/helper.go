@@ -20,11 +20,13 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"code.gitea.io/sdk/gitea"
 
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/shared/utils"
 )
@@ -196,6 +198,29 @@ func pipelineFromPullRequest(hook *pullRequestHook) *model.Pipeline {
 	return pipeline
 }
 
+// convertPullRequest converts a Gitea pull request to a Woodpecker pull request.
+func convertPullRequest(from *gitea.PullRequest, repoURL string) *model.PullRequest {
+	pr := &model.PullRequest{
+		Index:    model.ForgeRemoteID(strconv.Itoa(int(from.Index))),
+		Title:    from.Title,
+		Ref:      fmt.Sprintf("refs/pull/%d/head", from.Index),
+		ForgeURL: from.HTMLURL,
+		Labels:   convertLabels(from.Labels),
+		Draft:    from.Draft,
+	}
+	if from.Head != nil && from.Base != nil {
+		pr.Commit = from.Head.Sha
+		pr.Branch = from.Base.Ref
+		pr.Refspec = fmt.Sprintf("%s:%s", from.Head.Ref, from.Base.Ref)
+		pr.FromFork = from.Head.RepoID != from.Base.RepoID
+	}
+	if from.Poster != nil {
+		pr.Author = from.Poster.UserName
+		pr.Avatar = expandAvatar(repoURL, fixMalformedAvatar(from.Poster.AvatarURL))
+	}
+	return pr
+}
+
 func convertMilestone(milestone *gitea.Milestone) string {
 	if milestone == nil || milestone.ID == 0 {
 		return ""
@@ -303,3 +328,25 @@ func convertLabels(from []*gitea.Label) []string {
 	}
 	return labels
 }
+
+// pullIndex returns the index of the pull request of a pipeline ref.
+func pullIndex(ref string) (int64, bool) {
+	var index int64
+	if _, err := fmt.Sscanf(ref, "refs/pull/%d/head", &index); err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// matchingPullComment returns the pipeline summary comment posted by the user.
+func matchingPullComment(comments []*gitea.Comment, login string) *gitea.Comment {
+	for _, comment := range comments {
+		if comment.Poster == nil || comment.Poster.UserName != login {
+			continue
+		}
+		if _, ok := common.PullCommentPipeline(comment.Body); ok {
+			return comment
+		}
+	}
+	return nil
+}
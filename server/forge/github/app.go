@@ -0,0 +1,307 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-github/v90/github"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+const (
+	// Specifies how long an app JWT is valid, GitHub allows up to 10 minutes.
+	appJWTTTL = 9 * time.Minute
+
+	// Specifies the remaining validity below which an installation token is renewed.
+	installationTokenMinTTL = 10 * time.Minute
+)
+
+// app holds the credentials of a GitHub App and caches the installation
+// tokens obtained with them.
+type app struct {
+	id            string
+	key           *rsa.PrivateKey
+	webhookSecret []byte
+
+	mu            sync.Mutex
+	installations map[string]int64                    // repo full name -> installation id
+	tokens        map[int64]*github.InstallationToken // installation id -> token
+}
+
+func newApp(id, privateKey, webhookSecret string) (*app, error) {
+	if privateKey == "" {
+		return nil, errors.New("GitHub App private key is required")
+	}
+	if webhookSecret == "" {
+		return nil, errors.New("GitHub App webhook secret is required")
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("parse GitHub App private key: %w", err)
+	}
+	return &app{
+		id:            id,
+		key:           key,
+		webhookSecret: []byte(webhookSecret),
+		installations: make(map[string]int64),
+		tokens:        make(map[int64]*github.InstallationToken),
+	}, nil
+}
+
+// jwt returns a token authenticating requests as the app itself.
+func (a *app) jwt(now time.Time) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer: a.id,
+		// allow for clock drift between woodpecker and GitHub
+		IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appJWTTTL)),
+	}).SignedString(a.key)
+}
+
+// appTransport authenticates requests with an app JWT.
+type appTransport struct {
+	app  *app
+	base http.RoundTripper
+}
+
+func (t *appTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.app.jwt(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
+
+// newAppClient returns a GitHub client authenticated as the app.
+func (c *client) newAppClient() (*github.Client, error) {
+	return github.NewClient(
+		github.WithURLs(github.Ptr(c.API), nil),
+		github.WithHTTPClient(&http.Client{Transport: &appTransport{app: c.app, base: c.newTransport()}}),
+	)
+}
+
+// installationToken returns a token of the app installation covering the repo.
+func (c *client) installationToken(ctx context.Context, r *model.Repo) (string, error) {
+	c.app.mu.Lock()
+	defer c.app.mu.Unlock()
+
+	id, ok := c.app.installations[r.FullName]
+	if ok {
+		if token := c.app.tokens[id]; token != nil && time.Until(token.GetExpiresAt().Time) > installationTokenMinTTL {
+			return token.GetToken(), nil
+		}
+	}
+
+	appClient, err := c.newAppClient()
+	if err != nil {
+		return "", err
+	}
+
+	id, err = c.installationID(ctx, appClient, r)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := c.createInstallationToken(ctx, appClient, id, r, nil)
+	if err != nil {
+		return "", err
+	}
+	c.app.tokens[id] = token
+	return token.GetToken(), nil
+}
+
+// netrcToken returns a token of the app installation which is only allowed to
+// read the contents of the repo, as it is handed to the pipelines. It is not
+// cached, so a pipeline always gets a token with its full lifetime.
+func (c *client) netrcToken(ctx context.Context, r *model.Repo) (string, error) {
+	repoID, err := strconv.ParseInt(string(r.ForgeRemoteID), 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid GitHub repository id %q: %w", r.ForgeRemoteID, err)
+	}
+
+	appClient, err := c.newAppClient()
+	if err != nil {
+		return "", err
+	}
+
+	c.app.mu.Lock()
+	defer c.app.mu.Unlock()
+
+	id, err := c.installationID(ctx, appClient, r)
+	if err != nil {
+		return "", err
+	}
+	token, err := c.createInstallationToken(ctx, appClient, id, r, &github.InstallationTokenOptions{
+		RepositoryIDs: []int64{repoID},
+		Permissions:   &github.InstallationPermissions{Contents: github.Ptr("read")},
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.GetToken(), nil
+}
+
+// installationID returns the id of the app installation covering the repo, the
+// caller has to hold the lock of the app.
+func (c *client) installationID(ctx context.Context, appClient *github.Client, r *model.Repo) (int64, error) {
+	if id, ok := c.app.installations[r.FullName]; ok {
+		return id, nil
+	}
+	installation, resp, err := appClient.Apps.GetRepositoryInstallation(ctx, r.Owner, r.Name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return 0, fmt.Errorf("GitHub App is not installed for %s", r.FullName)
+		}
+		return 0, err
+	}
+	c.app.installations[r.FullName] = installation.GetID()
+	return installation.GetID(), nil
+}
+
+// createInstallationToken creates a token of the installation, the caller has
+// to hold the lock of the app.
+func (c *client) createInstallationToken(ctx context.Context, appClient *github.Client, id int64, r *model.Repo, opts *github.InstallationTokenOptions) (*github.InstallationToken, error) {
+	token, resp, err := appClient.Apps.CreateInstallationToken(ctx, id, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			// the app got uninstalled, look the installation up again next time
+			delete(c.app.installations, r.FullName)
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// repoClient returns a client acting on the repo. It uses an installation
+// token in GitHub App mode and the given user token otherwise.
+func (c *client) repoClient(ctx context.Context, r *model.Repo, userToken string) (*github.Client, error) {
+	if c.app == nil {
+		return c.newClientToken(ctx, userToken)
+	}
+	token, err := c.installationToken(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return c.newClientToken(ctx, token)
+}
+
+// CheckRun reports the workflow as check run with the given report. Check runs
+// can only be created by apps, so it is only supported in GitHub App mode.
+func (c *client) CheckRun(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow, run *forge_types.CheckRun) error {
+	// deployments get a deployment status instead
+	if c.app == nil || pipeline.Event == model.EventDeploy {
+		return forge_types.ErrNotImplemented
+	}
+
+	client, err := c.repoClient(ctx, repo, user.AccessToken)
+	if err != nil {
+		return err
+	}
+	return c.checkRun(ctx, client, repo, pipeline, workflow, run)
+}
+
+// checkRun creates or updates the check run reporting the workflow. The
+// report is optional.
+func (c *client) checkRun(ctx context.Context, client *github.Client, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow, run *forge_types.CheckRun) error {
+	name := common.GetPipelineStatusContext(repo, pipeline, workflow)
+	externalID := strconv.FormatInt(pipeline.ID, 10)
+	status, conclusion := convertCheckRunStatus(workflow.State)
+	detailsURL := common.GetPipelineStatusURL(repo, pipeline, workflow)
+
+	var completedAt *github.Timestamp
+	var conclusionPtr *string
+	if conclusion != "" {
+		completedAt = &github.Timestamp{Time: time.Now()}
+		conclusionPtr = github.Ptr(conclusion)
+	}
+
+	description := common.GetPipelineStatusDescription(workflow.State)
+	output := &github.CheckRunOutput{
+		Title:   github.Ptr(description),
+		Summary: github.Ptr(description),
+	}
+	if run != nil {
+		output = convertCheckRunOutput(run)
+		// annotations get added on every update, so only add them once done
+		if conclusion == "" {
+			output.Annotations = nil
+		}
+	}
+
+	runs, _, err := client.Checks.ListCheckRunsForRef(ctx, repo.Owner, repo.Name, pipeline.Commit, &github.ListCheckRunsOptions{
+		CheckName: github.Ptr(name),
+		Filter:    github.Ptr("all"),
+	})
+	if err != nil {
+		return err
+	}
+	for _, run := range runs.CheckRuns {
+		if run.GetExternalID() != externalID {
+			continue
+		}
+		_, _, err = client.Checks.UpdateCheckRun(ctx, repo.Owner, repo.Name, run.GetID(), github.UpdateCheckRunOptions{
+			Name:        name,
+			DetailsURL:  github.Ptr(detailsURL),
+			ExternalID:  github.Ptr(externalID),
+			Status:      github.Ptr(status),
+			Conclusion:  conclusionPtr,
+			CompletedAt: completedAt,
+			Output:      output,
+		})
+		return err
+	}
+
+	_, _, err = client.Checks.CreateCheckRun(ctx, repo.Owner, repo.Name, github.CreateCheckRunOptions{
+		Name:        name,
+		HeadSHA:     pipeline.Commit,
+		DetailsURL:  github.Ptr(detailsURL),
+		ExternalID:  github.Ptr(externalID),
+		Status:      github.Ptr(status),
+		Conclusion:  conclusionPtr,
+		CompletedAt: completedAt,
+		Output:      output,
+	})
+	return err
+}
+
+// VerifyAppHook checks the signature of a webhook sent by the GitHub App. The
+// request body is left readable for Hook.
+func (c *client) VerifyAppHook(r *http.Request) error {
+	if c.app == nil {
+		return forge_types.ErrNotImplemented
+	}
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(payload))
+	return github.ValidateSignature(r.Header.Get(github.SHA256SignatureHeader), payload, c.app.webhookSecret)
+}
@@ -16,10 +16,14 @@
 package github
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/go-github/v90/github"
 
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 )
 
@@ -30,6 +34,12 @@ const (
 	statusError   = "error"
 )
 
+const (
+	checkRunQueued     = "queued"
+	checkRunInProgress = "in_progress"
+	checkRunCompleted  = "completed"
+)
+
 const (
 	descPending  = "this pipeline is pending"
 	descSuccess  = "the pipeline was successful"
@@ -45,6 +55,18 @@ const (
 	refSpec   = "%s:%s"
 )
 
+// pullNumber returns the number of the pull request of a pipeline ref.
+func pullNumber(ref string) (int, bool) {
+	var number int
+	if _, err := fmt.Sscanf(ref, headRefs, &number); err == nil {
+		return number, true
+	}
+	if _, err := fmt.Sscanf(ref, mergeRefs, &number); err == nil {
+		return number, true
+	}
+	return 0, false
+}
+
 // convertStatus is a helper function used to convert a Woodpecker status to a
 // GitHub commit status.
 func convertStatus(status model.StatusValue) string {
@@ -60,6 +82,53 @@ func convertStatus(status model.StatusValue) string {
 	}
 }
 
+// convertCheckRunStatus is a helper function used to convert a Woodpecker
+// status to the status and conclusion of a GitHub check run.
+func convertCheckRunStatus(status model.StatusValue) (string, string) {
+	switch status {
+	case model.StatusPending, model.StatusBlocked, model.StatusCreated:
+		return checkRunQueued, ""
+	case model.StatusRunning:
+		return checkRunInProgress, ""
+	case model.StatusSuccess:
+		return checkRunCompleted, "success"
+	case model.StatusFailure, model.StatusError:
+		return checkRunCompleted, "failure"
+	case model.StatusKilled, model.StatusCanceled, model.StatusDeclined:
+		return checkRunCompleted, "cancelled"
+	case model.StatusSkipped:
+		return checkRunCompleted, "skipped"
+	default:
+		return checkRunCompleted, "neutral"
+	}
+}
+
+// convertCheckRunOutput is a helper function used to convert a Woodpecker
+// check run report to the output of a GitHub check run.
+func convertCheckRunOutput(run *types.CheckRun) *github.CheckRunOutput {
+	output := &github.CheckRunOutput{
+		Title:   github.Ptr(run.Title),
+		Summary: github.Ptr(run.Title),
+	}
+	if run.Summary != "" {
+		output.Summary = github.Ptr(run.Summary)
+	}
+	if run.Text != "" {
+		output.Text = github.Ptr(run.Text)
+	}
+	for _, annotation := range run.Annotations {
+		output.Annotations = append(output.Annotations, &github.CheckRunAnnotation{
+			Path:            github.Ptr(annotation.Path),
+			StartLine:       github.Ptr(annotation.Line),
+			EndLine:         github.Ptr(annotation.Line),
+			AnnotationLevel: github.Ptr(string(annotation.Level)),
+			Title:           github.Ptr(annotation.Title),
+			Message:         github.Ptr(annotation.Message),
+		})
+	}
+	return output
+}
+
 // convertDesc is a helper function used to convert a Woodpecker status to a
 // GitHub status description.
 func convertDesc(status model.StatusValue) string {
@@ -161,6 +230,29 @@ func convertRepoHook(eventRepo *github.PushEventRepository) *model.Repo {
 
 // convertLabels is a helper function used to convert a GitHub label list to
 // the common Woodpecker label structure.
+// convertPullRequest is a helper function used to convert a GitHub pull
+// request structure to the common Woodpecker pull request structure.
+func convertPullRequest(from *github.PullRequest, merge bool) *model.PullRequest {
+	ref := fmt.Sprintf(headRefs, from.GetNumber())
+	if merge {
+		ref = fmt.Sprintf(mergeRefs, from.GetNumber())
+	}
+	return &model.PullRequest{
+		Index:    model.ForgeRemoteID(strconv.Itoa(from.GetNumber())),
+		Title:    from.GetTitle(),
+		Commit:   from.GetHead().GetSHA(),
+		Ref:      ref,
+		Branch:   from.GetBase().GetRef(),
+		Refspec:  fmt.Sprintf(refSpec, from.GetHead().GetRef(), from.GetBase().GetRef()),
+		Author:   from.GetUser().GetLogin(),
+		Avatar:   from.GetUser().GetAvatarURL(),
+		ForgeURL: from.GetHTMLURL(),
+		Labels:   convertLabels(from.GetLabels()),
+		Draft:    from.GetDraft(),
+		FromFork: from.GetHead().GetRepo().GetID() != from.GetBase().GetRepo().GetID(),
+	}
+}
+
 func convertLabels(from []*github.Label) []string {
 	labels := make([]string, len(from))
 	for i, label := range from {
@@ -168,3 +260,21 @@ func convertLabels(from []*github.Label) []string {
 	}
 	return labels
 }
+
+// convertError maps the GitHub rate limit errors to types.ErrRateLimited and
+// returns all other errors unchanged.
+func convertError(err error) error {
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		return &types.ErrRateLimited{Reset: rateErr.Rate.Reset.Time}
+	}
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		limited := &types.ErrRateLimited{}
+		if abuseErr.RetryAfter != nil {
+			limited.Reset = time.Now().Add(*abuseErr.RetryAfter)
+		}
+		return limited
+	}
+	return err
+}
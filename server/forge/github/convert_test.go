@@ -16,11 +16,14 @@
 package github
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-github/v90/github"
 	"github.com/stretchr/testify/assert"
 
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 )
 
@@ -33,6 +36,29 @@ func Test_convertStatus(t *testing.T) {
 	assert.Equal(t, statusError, convertStatus(model.StatusError))
 }
 
+func Test_convertCheckRunStatus(t *testing.T) {
+	tests := []struct {
+		status     model.StatusValue
+		runStatus  string
+		conclusion string
+	}{
+		{model.StatusPending, checkRunQueued, ""},
+		{model.StatusBlocked, checkRunQueued, ""},
+		{model.StatusRunning, checkRunInProgress, ""},
+		{model.StatusSuccess, checkRunCompleted, "success"},
+		{model.StatusFailure, checkRunCompleted, "failure"},
+		{model.StatusError, checkRunCompleted, "failure"},
+		{model.StatusKilled, checkRunCompleted, "cancelled"},
+		{model.StatusDeclined, checkRunCompleted, "cancelled"},
+		{model.StatusSkipped, checkRunCompleted, "skipped"},
+	}
+	for _, tt := range tests {
+		runStatus, conclusion := convertCheckRunStatus(tt.status)
+		assert.Equal(t, tt.runStatus, runStatus, tt.status)
+		assert.Equal(t, tt.conclusion, conclusion, tt.status)
+	}
+}
+
 func Test_convertDesc(t *testing.T) {
 	assert.Equal(t, descSuccess, convertDesc(model.StatusSuccess))
 	assert.Equal(t, descPending, convertDesc(model.StatusPending))
@@ -170,3 +196,52 @@ func Test_convertRepoHook(t *testing.T) {
 		assert.Equal(t, "octocat/hello-world", repo.FullName)
 	})
 }
+
+func Test_convertPullRequest(t *testing.T) {
+	from := &github.PullRequest{
+		Number:  github.Ptr(42),
+		Title:   github.Ptr("Add feature"),
+		HTMLURL: github.Ptr("https://github.com/octocat/hello-world/pull/42"),
+		Draft:   github.Ptr(true),
+		User:    &github.User{Login: github.Ptr("octocat"), AvatarURL: github.Ptr("https://avatars.githubusercontent.com/u/1")},
+		Labels:  []*github.Label{{Name: "bug"}},
+		Head: &github.PullRequestBranch{
+			Ref:  github.Ptr("feature"),
+			SHA:  github.Ptr("6dcb09b5b57875f334f61aebed695e2e4193db5e"),
+			Repo: &github.Repository{ID: github.Ptr(int64(2))},
+		},
+		Base: &github.PullRequestBranch{
+			Ref:  github.Ptr("main"),
+			Repo: &github.Repository{ID: github.Ptr(int64(1))},
+		},
+	}
+
+	assert.Equal(t, &model.PullRequest{
+		Index:    "42",
+		Title:    "Add feature",
+		Commit:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Ref:      "refs/pull/42/head",
+		Branch:   "main",
+		Refspec:  "feature:main",
+		Author:   "octocat",
+		Avatar:   "https://avatars.githubusercontent.com/u/1",
+		ForgeURL: "https://github.com/octocat/hello-world/pull/42",
+		Labels:   []string{"bug"},
+		Draft:    true,
+		FromFork: true,
+	}, convertPullRequest(from, false))
+
+	assert.Equal(t, "refs/pull/42/merge", convertPullRequest(from, true).Ref)
+}
+
+func Test_convertError(t *testing.T) {
+	reset := time.Unix(1_700_000_000, 0)
+	err := convertError(fmt.Errorf("list branches: %w", &github.RateLimitError{Rate: github.Rate{Reset: github.Timestamp{Time: reset}}}))
+	assert.Equal(t, &types.ErrRateLimited{Reset: reset}, err)
+
+	retryAfter := time.Minute
+	err = convertError(&github.AbuseRateLimitError{RetryAfter: &retryAfter})
+	assert.ErrorIs(t, err, &types.ErrRateLimited{})
+
+	assert.Equal(t, assert.AnError, convertError(assert.AnError))
+}
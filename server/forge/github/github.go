@@ -59,6 +59,9 @@ type Opts struct {
 	MergeRef          bool   // Clone pull requests using the merge ref.
 	OnlyPublic        bool   // Only obtain OAuth tokens with access to public repos.
 	OAuthHost         string // Public url for oauth if different from url.
+	AppID             string // GitHub App id or client id, enables GitHub App mode.
+	AppPrivateKey     string // GitHub App private key in PEM format.
+	AppWebhookSecret  string // GitHub App webhook secret.
 }
 
 // New returns a Forge implementation that integrates with a GitHub Cloud or
@@ -79,6 +82,13 @@ func New(id int64, opts Opts) (forge.Forge, error) {
 		r.url = strings.TrimSuffix(opts.URL, "/")
 		r.API = r.url + "/api/v3/"
 	}
+	if opts.AppID != "" {
+		app, err := newApp(opts.AppID, opts.AppPrivateKey, opts.AppWebhookSecret)
+		if err != nil {
+			return nil, err
+		}
+		r.app = app
+	}
 
 	return r, nil
 }
@@ -93,6 +103,7 @@ type client struct {
 	MergeRef   bool
 	OnlyPublic bool
 	oAuthHost  string
+	app        *app // nil unless in GitHub App mode
 }
 
 // Name returns the string name of this driver.
@@ -265,7 +276,7 @@ func (c *client) Repos(ctx context.Context, u *model.User, p *model.ListOptions)
 
 // File fetches the file from the GitHub repository and returns its contents.
 func (c *client) File(ctx context.Context, u *model.User, r *model.Repo, b *model.Pipeline, f string) ([]byte, error) {
-	client, err := c.newClientToken(ctx, u.AccessToken)
+	client, err := c.repoClient(ctx, r, u.AccessToken)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +298,7 @@ func (c *client) File(ctx context.Context, u *model.User, r *model.Repo, b *mode
 }
 
 func (c *client) Dir(ctx context.Context, u *model.User, r *model.Repo, b *model.Pipeline, f string) ([]*forge_types.FileMeta, error) {
-	client, err := c.newClientToken(ctx, u.AccessToken)
+	client, err := c.repoClient(ctx, r, u.AccessToken)
 	if err != nil {
 		return nil, err
 	}
@@ -340,8 +351,7 @@ func (c *client) Dir(ctx context.Context, u *model.User, r *model.Repo, b *model
 }
 
 func (c *client) PullRequests(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]*model.PullRequest, error) {
-	token := common.UserToken(ctx, r, u)
-	client, err := c.newClientToken(ctx, token)
+	client, err := c.repoClient(ctx, r, common.UserToken(ctx, r, u))
 	if err != nil {
 		return nil, err
 	}
@@ -354,22 +364,19 @@ func (c *client) PullRequests(ctx context.Context, u *model.User, r *model.Repo,
 		State: "open",
 	})
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 
 	result := make([]*model.PullRequest, len(pullRequests))
-	for i := range pullRequests {
-		result[i] = &model.PullRequest{
-			Index: model.ForgeRemoteID(strconv.Itoa(pullRequests[i].GetNumber())),
-			Title: pullRequests[i].GetTitle(),
-		}
+	for i, pr := range pullRequests {
+		result[i] = convertPullRequest(pr, c.MergeRef)
 	}
 	return result, err
 }
 
 // Netrc returns a netrc file capable of authenticating GitHub requests and
 // cloning GitHub repositories. The netrc will use the global machine account
-// when configured.
+// when configured, an installation token in GitHub App mode.
 func (c *client) Netrc(u *model.User, r *model.Repo) (*model.Netrc, error) {
 	login := ""
 	token := ""
@@ -379,6 +386,15 @@ func (c *client) Netrc(u *model.User, r *model.Repo) (*model.Netrc, error) {
 		token = "x-oauth-basic"
 	}
 
+	if c.app != nil {
+		installationToken, err := c.netrcToken(context.Background(), r)
+		if err != nil {
+			return nil, err
+		}
+		login = "x-access-token"
+		token = installationToken
+	}
+
 	host, err := common.ExtractHostFromCloneURL(r.Clone)
 	if err != nil {
 		return nil, err
@@ -395,6 +411,11 @@ func (c *client) Netrc(u *model.User, r *model.Repo) (*model.Netrc, error) {
 // Deactivate deactivates the repository be removing registered push hooks from
 // the GitHub repository.
 func (c *client) Deactivate(ctx context.Context, u *model.User, r *model.Repo, link string) error {
+	// the webhook of the GitHub App is not bound to the repo
+	if c.app != nil {
+		return nil
+	}
+
 	client, err := c.newClientToken(ctx, u.AccessToken)
 	if err != nil {
 		return err
@@ -517,7 +538,13 @@ func (c *client) newClientToken(ctx context.Context, token string) (*github.Clie
 
 	// Get the oauth2 transport to set custom base
 	tp, _ := tc.Transport.(*oauth2.Transport)
+	tp.Base = c.newTransport()
 
+	return github.NewClient(github.WithURLs(github.Ptr(c.API), nil), github.WithHTTPClient(tc))
+}
+
+// newTransport returns the transport used for requests to the GitHub API.
+func (c *client) newTransport() http.RoundTripper {
 	baseTransport := &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 	}
@@ -528,9 +555,7 @@ func (c *client) newClientToken(ctx context.Context, token string) (*github.Clie
 	}
 
 	// Wrap the base transport with User-Agent support
-	tp.Base = httputil.NewUserAgentRoundTripper(baseTransport, "forge-github")
-
-	return github.NewClient(github.WithURLs(github.Ptr(c.API), nil), github.WithHTTPClient(tc))
+	return httputil.NewUserAgentRoundTripper(baseTransport, "forge-github")
 }
 
 // matchingEmail returns matching user email.
@@ -574,7 +599,7 @@ var reDeploy = regexp.MustCompile(`.+/deployments/(\d+)`)
 // Status sends the commit status to the forge.
 // An example would be the GitHub pull request status.
 func (c *client) Status(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) error {
-	client, err := c.newClientToken(ctx, user.AccessToken)
+	client, err := c.repoClient(ctx, repo, user.AccessToken)
 	if err != nil {
 		return err
 	}
@@ -596,6 +621,11 @@ func (c *client) Status(ctx context.Context, user *model.User, repo *model.Repo,
 		return err
 	}
 
+	// apps report check runs, which GitHub shows with more detail than statuses
+	if c.app != nil {
+		return c.checkRun(ctx, client, repo, pipeline, workflow, nil)
+	}
+
 	_, _, err = client.Repositories.CreateStatus(ctx, repo.Owner, repo.Name, pipeline.Commit, github.RepoStatus{
 		Context:     github.Ptr(common.GetPipelineStatusContext(repo, pipeline, workflow)),
 		State:       github.Ptr(convertStatus(workflow.State)),
@@ -605,9 +635,89 @@ func (c *client) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+// PullComment creates the pipeline summary comment on the pull request of the
+// pipeline or updates the existing one.
+func (c *client) PullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error {
+	number, ok := pullNumber(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := c.repoClient(ctx, r, u.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	comment, err := c.findPullComment(ctx, client, u, r, number)
+	if err != nil {
+		return err
+	}
+
+	if comment == nil {
+		_, _, err = client.Issues.CreateComment(ctx, r.Owner, r.Name, number, &github.IssueComment{Body: github.Ptr(body)})
+		return err
+	}
+	if common.SkipPullComment(p, comment.GetBody()) {
+		return nil
+	}
+	_, _, err = client.Issues.EditComment(ctx, r.Owner, r.Name, comment.GetID(), &github.IssueComment{Body: github.Ptr(body)})
+	return err
+}
+
+// ClosePullComment collapses the pipeline summary comment on the pull request
+// of the pipeline.
+func (c *client) ClosePullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline) error {
+	number, ok := pullNumber(p.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := c.repoClient(ctx, r, u.AccessToken)
+	if err != nil {
+		return err
+	}
+
+	comment, err := c.findPullComment(ctx, client, u, r, number)
+	if err != nil || comment == nil {
+		return err
+	}
+	_, _, err = client.Issues.EditComment(ctx, r.Owner, r.Name, comment.GetID(), &github.IssueComment{
+		Body: github.Ptr(common.CollapsePullComment(comment.GetBody())),
+	})
+	return err
+}
+
+// findPullComment returns the pipeline summary comment on the pull request.
+// Apps post it as their bot user, which isn't known to the forge.
+func (c *client) findPullComment(ctx context.Context, client *github.Client, u *model.User, r *model.Repo, number int) (*github.IssueComment, error) {
+	opts := &github.IssueListCommentsOptions{ListOptions: github.ListOptions{Page: 1, PerPage: defaultPageSize}}
+	for opts.Page > 0 {
+		comments, resp, err := client.Issues.ListComments(ctx, r.Owner, r.Name, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, comment := range comments {
+			if (c.app != nil && comment.GetUser().GetType() != "Bot") || (c.app == nil && comment.GetUser().GetLogin() != u.Login) {
+				continue
+			}
+			if _, ok := common.PullCommentPipeline(comment.GetBody()); ok {
+				return comment, nil
+			}
+		}
+		opts.Page = resp.NextPage
+	}
+	return nil, nil
+}
+
 // Activate activates a repository by creating the post-commit hook and
-// adding the SSH deploy key, if applicable.
+// adding the SSH deploy key, if applicable. In GitHub App mode it only checks
+// the app is installed for the repository, as the app delivers the webhooks.
 func (c *client) Activate(ctx context.Context, u *model.User, r *model.Repo, link string) error {
+	if c.app != nil {
+		_, err := c.installationToken(ctx, r)
+		return err
+	}
+
 	if err := c.Deactivate(ctx, u, r, link); err != nil {
 		return err
 	}
@@ -634,8 +744,7 @@ func (c *client) Activate(ctx context.Context, u *model.User, r *model.Repo, lin
 
 // Branches returns the names of all branches for the named repository.
 func (c *client) Branches(ctx context.Context, u *model.User, r *model.Repo, p *model.ListOptions) ([]string, error) {
-	token := common.UserToken(ctx, r, u)
-	client, err := c.newClientToken(ctx, token)
+	client, err := c.repoClient(ctx, r, common.UserToken(ctx, r, u))
 	if err != nil {
 		return nil, err
 	}
@@ -647,7 +756,7 @@ func (c *client) Branches(ctx context.Context, u *model.User, r *model.Repo, p *
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 
 	branches := make([]string, 0)
@@ -659,18 +768,20 @@ func (c *client) Branches(ctx context.Context, u *model.User, r *model.Repo, p *
 
 // BranchHead returns the sha of the head (latest commit) of the specified branch.
 func (c *client) BranchHead(ctx context.Context, u *model.User, r *model.Repo, branch string) (*model.Commit, error) {
-	token := common.UserToken(ctx, r, u)
-	client, err := c.newClientToken(ctx, token)
+	client, err := c.repoClient(ctx, r, common.UserToken(ctx, r, u))
 	if err != nil {
 		return nil, err
 	}
 	b, _, err := client.Repositories.GetBranch(ctx, r.Owner, r.Name, branch, 1)
 	if err != nil {
-		return nil, err
+		return nil, convertError(err)
 	}
 	return &model.Commit{
 		SHA:      b.GetCommit().GetSHA(),
 		ForgeURL: b.GetCommit().GetHTMLURL(),
+		Message:  b.GetCommit().GetCommit().GetMessage(),
+		Author:   b.GetCommit().GetAuthor().GetLogin(),
+		Email:    b.GetCommit().GetCommit().GetAuthor().GetEmail(),
 	}, nil
 }
 
@@ -714,21 +825,30 @@ func (c *client) Hook(ctx context.Context, r *http.Request) (*model.Repo, *model
 	return repo, pipeline, nil
 }
 
-func (c *client) loadChangedFilesFromPullRequest(ctx context.Context, pull *github.PullRequest, tmpRepo *model.Repo, pipeline *model.Pipeline) (*model.Pipeline, error) {
+// hookClient returns a client to look up further details of the repository a
+// hook was received for, together with the stored repository. It returns no
+// client if there is no store to look the repository up in.
+func (c *client) hookClient(ctx context.Context, tmpRepo *model.Repo) (*github.Client, *model.Repo, error) {
+	// the installation token does not depend on the repo owner
+	if c.app != nil {
+		gh, err := c.repoClient(ctx, tmpRepo, "")
+		return gh, tmpRepo, err
+	}
+
 	_store, ok := store.TryFromContext(ctx)
 	if !ok {
 		log.Error().Msg("could not get store from context")
-		return pipeline, nil
+		return nil, nil, nil
 	}
 
 	repo, err := _store.GetRepoNameFallback(c.id, tmpRepo.ForgeRemoteID, tmpRepo.FullName)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	user, err := _store.GetUser(repo.UserID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Refresh the OAuth token before making API calls.
@@ -737,9 +857,20 @@ func (c *client) loadChangedFilesFromPullRequest(ctx context.Context, pull *gith
 	forge.Refresh(ctx, c, _store, user)
 
 	gh, err := c.newClientToken(ctx, user.AccessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return gh, repo, nil
+}
+
+func (c *client) loadChangedFilesFromPullRequest(ctx context.Context, pull *github.PullRequest, tmpRepo *model.Repo, pipeline *model.Pipeline) (*model.Pipeline, error) {
+	gh, repo, err := c.hookClient(ctx, tmpRepo)
 	if err != nil {
 		return nil, err
 	}
+	if gh == nil {
+		return pipeline, nil
+	}
 	fileList := make([]string, 0, 16)
 
 	opts := &github.ListOptions{Page: 1}
@@ -760,30 +891,9 @@ func (c *client) loadChangedFilesFromPullRequest(ctx context.Context, pull *gith
 	return pipeline, err
 }
 
-func (c *client) getTagCommitSHA(ctx context.Context, repo *model.Repo, tagName string) (string, error) {
-	_store, ok := store.TryFromContext(ctx)
-	if !ok {
-		log.Error().Msg("could not get store from context")
-		return "", nil
-	}
-
-	repo, err := _store.GetRepoNameFallback(c.id, repo.ForgeRemoteID, repo.FullName)
-	if err != nil {
-		return "", err
-	}
-
-	user, err := _store.GetUser(repo.UserID)
-	if err != nil {
-		return "", err
-	}
-
-	// Refresh the OAuth token before making API calls.
-	// The token may be expired, and without this refresh the API calls below
-	// would fail with an authentication error.
-	forge.Refresh(ctx, c, _store, user)
-
-	gh, err := c.newClientToken(ctx, user.AccessToken)
-	if err != nil {
+func (c *client) getTagCommitSHA(ctx context.Context, tmpRepo *model.Repo, tagName string) (string, error) {
+	gh, repo, err := c.hookClient(ctx, tmpRepo)
+	if err != nil || gh == nil {
 		return "", err
 	}
 
@@ -814,12 +924,6 @@ func (c *client) getTagCommitSHA(ctx context.Context, repo *model.Repo, tagName
 }
 
 func (c *client) loadChangedFilesFromCommits(ctx context.Context, tmpRepo *model.Repo, pipeline *model.Pipeline, curr, prev string) (*model.Pipeline, error) {
-	_store, ok := store.TryFromContext(ctx)
-	if !ok {
-		log.Error().Msg("could not get store from context")
-		return pipeline, nil
-	}
-
 	switch prev {
 	case curr:
 		log.Error().Msg("GitHub push event contains the same commit before and after, no changes detected")
@@ -831,24 +935,12 @@ func (c *client) loadChangedFilesFromCommits(ctx context.Context, tmpRepo *model
 		log.Trace().Msg("GitHub force push or tag event, fetching changed files using current commit")
 	}
 
-	repo, err := _store.GetRepoNameFallback(c.id, tmpRepo.ForgeRemoteID, tmpRepo.FullName)
-	if err != nil {
-		return nil, err
-	}
-
-	user, err := _store.GetUser(repo.UserID)
+	gh, repo, err := c.hookClient(ctx, tmpRepo)
 	if err != nil {
 		return nil, err
 	}
-
-	// Refresh the OAuth token before making API calls.
-	// The token may be expired, and without this refresh the API calls below
-	// would fail with an authentication error.
-	forge.Refresh(ctx, c, _store, user)
-
-	gh, err := c.newClientToken(ctx, user.AccessToken)
-	if err != nil {
-		return nil, err
+	if gh == nil {
+		return pipeline, nil
 	}
 	fileList := make([]string, 0, 16)
 
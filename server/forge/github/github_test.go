@@ -17,7 +17,15 @@ package github
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -31,6 +39,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/github/fixtures"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
@@ -95,6 +104,28 @@ func Test_github(t *testing.T) {
 		_, err := c.Repo(ctx, fakeUser, "0", fakeRepoNotFound.Owner, fakeRepoNotFound.Name)
 		assert.Error(t, err)
 	})
+	t.Run("pull request comment", func(t *testing.T) {
+		forge, _ := c.(*client)
+
+		// updates the existing summary comment
+		err := forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 6, Event: model.EventPull, Ref: "refs/pull/1/head"}, "[//]: # (woodpecker-pipeline-summary 6)\n\nPipeline #6")
+		assert.NoError(t, err)
+
+		// doesn't replace the summary of a newer pipeline
+		err = forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 4, Event: model.EventPull, Ref: "refs/pull/1/merge"}, "[//]: # (woodpecker-pipeline-summary 4)\n\nPipeline #4")
+		assert.NoError(t, err)
+
+		// creates the first summary comment
+		err = forge.PullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 1, Event: model.EventPull, Ref: "refs/pull/2/head"}, "[//]: # (woodpecker-pipeline-summary 1)\n\nPipeline #1")
+		assert.NoError(t, err)
+
+		err = forge.ClosePullComment(ctx, fakeUser, fakeRepo, &model.Pipeline{Number: 7, Event: model.EventPullClosed, Ref: "refs/pull/1/head"})
+		assert.NoError(t, err)
+	})
+	t.Run("check runs require app mode", func(t *testing.T) {
+		err := c.(*client).CheckRun(ctx, fakeUser, fakeRepo, &model.Pipeline{Event: model.EventPush}, &model.Workflow{}, &forge_types.CheckRun{})
+		assert.ErrorIs(t, err, forge_types.ErrNotImplemented)
+	})
 }
 
 func TestStatusDeployment(t *testing.T) {
@@ -379,3 +410,90 @@ func TestGetTagCommitSHA(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGitHubApp(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	s := httptest.NewServer(fixtures.Handler())
+	defer s.Close()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+
+	forge, err := New(1, Opts{
+		URL:              s.URL,
+		AppID:            "1",
+		AppPrivateKey:    privateKey,
+		AppWebhookSecret: "secret",
+	})
+	require.NoError(t, err)
+	c, _ := forge.(*client)
+
+	ctx := t.Context()
+
+	t.Run("missing private key", func(t *testing.T) {
+		_, err := New(1, Opts{AppID: "1", AppWebhookSecret: "secret"})
+		assert.Error(t, err)
+	})
+	t.Run("netrc with installation token", func(t *testing.T) {
+		netrc, err := c.Netrc(fakeUser, fakeRepo)
+		require.NoError(t, err)
+		assert.Equal(t, "x-access-token", netrc.Login)
+		// pipelines only get a token to read the contents of the repo
+		assert.Equal(t, fixtures.ScopedInstallationToken, netrc.Password)
+	})
+	t.Run("app not installed", func(t *testing.T) {
+		err := c.Activate(ctx, fakeUser, &model.Repo{Owner: "not_installed", Name: "repo", FullName: "not_installed/repo"}, "")
+		assert.ErrorContains(t, err, "not installed")
+	})
+	t.Run("activate checks installation", func(t *testing.T) {
+		assert.NoError(t, c.Activate(ctx, fakeUser, fakeRepo, ""))
+	})
+	t.Run("file with installation token", func(t *testing.T) {
+		data, err := c.File(ctx, fakeUser, fakeRepo, &model.Pipeline{Commit: "main"}, ".woodpecker.yml")
+		require.NoError(t, err)
+		assert.Equal(t, "steps: []\n", string(data))
+	})
+	t.Run("status updates the check run of the pipeline", func(t *testing.T) {
+		err := c.Status(ctx, fakeUser, fakeRepo, &model.Pipeline{ID: 1, Commit: fixtures.CheckRunCommit, Event: model.EventPush}, &model.Workflow{State: model.StatusRunning})
+		assert.NoError(t, err)
+	})
+	t.Run("status creates a check run", func(t *testing.T) {
+		err := c.Status(ctx, fakeUser, fakeRepo, &model.Pipeline{ID: 2, Commit: fixtures.CheckRunCommit, Event: model.EventPush}, &model.Workflow{State: model.StatusSuccess})
+		assert.NoError(t, err)
+	})
+	t.Run("check run with annotations", func(t *testing.T) {
+		run := &forge_types.CheckRun{
+			Title:   "Pipeline failed: 1 config error",
+			Summary: "| Step | Status |",
+			Annotations: []*forge_types.Annotation{
+				{Path: ".woodpecker.yml", Line: 4, Level: forge_types.AnnotationLevelFailure, Title: "Config error", Message: "Invalid or missing image"},
+			},
+		}
+		err := c.CheckRun(ctx, fakeUser, fakeRepo, &model.Pipeline{ID: 2, Commit: fixtures.CheckRunCommit, Event: model.EventPush}, &model.Workflow{State: model.StatusFailure}, run)
+		assert.NoError(t, err)
+
+		// annotations of a running workflow are dropped
+		err = c.CheckRun(ctx, fakeUser, fakeRepo, &model.Pipeline{ID: 2, Commit: fixtures.CheckRunCommit, Event: model.EventPush}, &model.Workflow{State: model.StatusRunning}, run)
+		assert.NoError(t, err)
+	})
+	t.Run("verify app hook", func(t *testing.T) {
+		payload := `{"zen": "Keep it logically awesome."}`
+		mac := hmac.New(sha256.New, []byte("secret"))
+		_, _ = mac.Write([]byte(payload))
+
+		req := httptest.NewRequest(http.MethodPost, "/api/hook/forges/1", strings.NewReader(payload))
+		req.Header.Set(github.SHA256SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		require.NoError(t, c.VerifyAppHook(req))
+		body, _ := io.ReadAll(req.Body)
+		assert.Equal(t, payload, string(body))
+
+		req = httptest.NewRequest(http.MethodPost, "/api/hook/forges/1", strings.NewReader(payload))
+		req.Header.Set(github.SHA256SignatureHeader, "sha256=0000")
+		assert.Error(t, c.VerifyAppHook(req))
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go/v2"
@@ -58,6 +59,15 @@ const (
 	metadataReasonReviewRequested   = "review_requested"
 )
 
+// mergeRequestIID returns the iid of the merge request of a pipeline ref.
+func mergeRequestIID(ref string) (int64, bool) {
+	var iid int64
+	if _, err := fmt.Sscanf(ref, mergeRefs, &iid); err != nil {
+		return 0, false
+	}
+	return iid, true
+}
+
 func (g *GitLab) convertGitLabRepo(_repo *gitlab.Project, projectMember *gitlab.ProjectMember) (*model.Repo, error) {
 	parts := strings.Split(_repo.PathWithNamespace, "/")
 	owner := strings.Join(parts[:len(parts)-1], "/")
@@ -453,6 +463,27 @@ func extractFromPath(str string) (string, string, error) {
 	return owner, name, nil
 }
 
+// convertMergeRequest converts a GitLab merge request to a Woodpecker pull request.
+func convertMergeRequest(from *gitlab.BasicMergeRequest) *model.PullRequest {
+	pr := &model.PullRequest{
+		Index:    model.ForgeRemoteID(strconv.Itoa(int(from.ID))),
+		Title:    from.Title,
+		Commit:   from.SHA,
+		Ref:      fmt.Sprintf(mergeRefs, from.IID),
+		Branch:   from.SourceBranch,
+		Refspec:  fmt.Sprintf("%s:%s", from.SourceBranch, from.TargetBranch),
+		ForgeURL: from.WebURL,
+		Labels:   from.Labels,
+		Draft:    from.Draft,
+		FromFork: from.SourceProjectID != from.TargetProjectID,
+	}
+	if from.Author != nil {
+		pr.Author = from.Author.Username
+		pr.Avatar = from.Author.AvatarURL
+	}
+	return pr
+}
+
 func convertLabels(from []*gitlab.EventLabel) []string {
 	labels := make([]string, len(from))
 	for i, label := range from {
@@ -334,3 +334,41 @@ var project6PayloadMembers = []byte(`
 	"expires_at": null
 }
 `)
+
+var mergeRequest1PayloadNotes = []byte(`
+[
+	{
+		"id": 1,
+		"body": "LGTM",
+		"author": {
+			"id": 1,
+			"username": "test_user"
+		},
+		"system": false
+	},
+	{
+		"id": 2,
+		"body": "[//]: # (woodpecker-pipeline-summary 5)\n\nPipeline #5: **success**",
+		"author": {
+			"id": 1,
+			"username": "test_user"
+		},
+		"system": false
+	}
+]
+`)
+
+var commitPayloadComments = []byte(`
+[
+	{
+		"note": "**test**\n\nassertion failed",
+		"path": "main.go",
+		"line": 12,
+		"line_type": "new",
+		"author": {
+			"id": 1,
+			"username": "test_user"
+		}
+	}
+]
+`)
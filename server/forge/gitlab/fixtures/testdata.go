@@ -15,6 +15,8 @@
 package fixtures
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -79,6 +81,53 @@ func NewServer(t *testing.T) *httptest.Server {
 		case "/api/v4/groups":
 			_, _ = w.Write(groupsPayload)
 			return
+		case "/api/v4/projects/4/merge_requests/1/notes":
+			// the merge request has a summary comment which has to be updated instead
+			if r.Method == http.MethodGet {
+				_, _ = w.Write(mergeRequest1PayloadNotes)
+				return
+			}
+		case "/api/v4/projects/4/merge_requests/1/notes/2":
+			// only newer pipelines than the summarized one may replace it
+			var note struct {
+				Body string `json:"body"`
+			}
+			var number int64
+			if r.Method == http.MethodPut && json.NewDecoder(r.Body).Decode(&note) == nil {
+				if _, err := fmt.Sscanf(note.Body, "[//]: # (woodpecker-pipeline-summary %d)", &number); err == nil && number >= 5 {
+					_, _ = w.Write([]byte(`{"id": 2}`))
+					return
+				}
+			}
+		case "/api/v4/projects/4/statuses/9a7ee9c5a2e3a1c7f0d8b1e4c5d6f7a8b9c0d1e2":
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": 1}`))
+				return
+			}
+		case "/api/v4/projects/4/repository/commits/9a7ee9c5a2e3a1c7f0d8b1e4c5d6f7a8b9c0d1e2/comments":
+			// the first failure was posted by an earlier report of the workflow
+			var comment struct {
+				Note string `json:"note"`
+			}
+			switch {
+			case r.Method == http.MethodGet:
+				_, _ = w.Write(commitPayloadComments)
+				return
+			case r.Method == http.MethodPost && json.NewDecoder(r.Body).Decode(&comment) == nil && comment.Note == "**lint**\n\nunused variable":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"note": "**lint**\n\nunused variable"}`))
+				return
+			}
+		case "/api/v4/projects/4/merge_requests/2/notes":
+			switch r.Method {
+			case http.MethodGet:
+				_, _ = w.Write([]byte(`[]`))
+			case http.MethodPost:
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": 3}`))
+			}
+			return
 		}
 
 		// else return a 404
@@ -357,11 +357,8 @@ func (g *GitLab) PullRequests(ctx context.Context, u *model.User, r *model.Repo,
 	}
 
 	result := make([]*model.PullRequest, len(pullRequests))
-	for i := range pullRequests {
-		result[i] = &model.PullRequest{
-			Index: model.ForgeRemoteID(strconv.Itoa(int(pullRequests[i].ID))),
-			Title: pullRequests[i].Title,
-		}
+	for i, mr := range pullRequests {
+		result[i] = convertMergeRequest(mr)
 	}
 	return result, err
 }
@@ -457,6 +454,199 @@ func (g *GitLab) Status(ctx context.Context, user *model.User, repo *model.Repo,
 	return err
 }
 
+// CheckRun reports the workflow with a commit status, as GitLab has no check
+// runs for external CI. Once the workflow is done, its failures in the changed
+// files are added as commit comments on their lines. Commit comments can not be
+// edited or deleted, so failures posted by an earlier report are skipped.
+func (g *GitLab) CheckRun(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow, run *forge_types.CheckRun) error {
+	client, err := newClient(g.url, user.AccessToken, g.skipVerify)
+	if err != nil {
+		return err
+	}
+
+	_repo, err := g.getProject(ctx, client, repo.ForgeRemoteID, repo.Owner, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = client.Commits.SetCommitStatus(_repo.ID, pipeline.Commit, &gitlab.SetCommitStatusOptions{
+		State:       getStatus(workflow.State),
+		Description: gitlab.Ptr(run.Title),
+		TargetURL:   gitlab.Ptr(common.GetPipelineStatusURL(repo, pipeline, workflow)),
+		Context:     gitlab.Ptr(common.GetPipelineStatusContext(repo, pipeline, workflow)),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	if pipeline.Event != model.EventPull {
+		return nil
+	}
+	annotations := common.CommentAnnotations(run, pipeline.ChangedFiles)
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	posted, err := findCommitComments(ctx, client, user, _repo.ID, pipeline.Commit)
+	if err != nil {
+		return err
+	}
+	for _, annotation := range annotations {
+		comment := commitComment{
+			path: annotation.Path,
+			line: int64(annotation.Line),
+			note: common.FormatAnnotation(annotation),
+		}
+		if posted[comment] {
+			continue
+		}
+		_, _, err = client.Commits.PostCommitComment(_repo.ID, pipeline.Commit, &gitlab.PostCommitCommentOptions{
+			Note:     gitlab.Ptr(comment.note),
+			Path:     gitlab.Ptr(comment.path),
+			Line:     gitlab.Ptr(comment.line),
+			LineType: gitlab.Ptr("new"),
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		posted[comment] = true
+	}
+	return nil
+}
+
+// commitComment identifies a comment on a line of a commit.
+type commitComment struct {
+	path string
+	line int64
+	note string
+}
+
+// findCommitComments returns the comments of the user on lines of the commit.
+func findCommitComments(ctx context.Context, client *gitlab.Client, user *model.User, projectID int64, sha string) (map[commitComment]bool, error) {
+	opts := &gitlab.GetCommitCommentsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: defaultPerPage,
+			Page:    1,
+		},
+	}
+	posted := make(map[commitComment]bool)
+	for {
+		comments, resp, err := client.Commits.GetCommitComments(projectID, sha, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, comment := range comments {
+			if comment.Author.Username != user.Login || comment.Path == "" {
+				continue
+			}
+			posted[commitComment{path: comment.Path, line: comment.Line, note: comment.Note}] = true
+		}
+
+		if resp.NextPage == 0 {
+			return posted, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// PullComment creates the pipeline summary comment on the merge request of the
+// pipeline or updates the existing one.
+func (g *GitLab) PullComment(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline, body string) error {
+	iid, ok := mergeRequestIID(pipeline.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := newClient(g.url, user.AccessToken, g.skipVerify)
+	if err != nil {
+		return err
+	}
+
+	_repo, err := g.getProject(ctx, client, repo.ForgeRemoteID, repo.Owner, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	note, err := findPullComment(ctx, client, user, _repo.ID, iid)
+	if err != nil {
+		return err
+	}
+
+	if note == nil {
+		_, _, err = client.Notes.CreateMergeRequestNote(_repo.ID, iid, &gitlab.CreateMergeRequestNoteOptions{
+			Body: gitlab.Ptr(body),
+		}, gitlab.WithContext(ctx))
+		return err
+	}
+	if common.SkipPullComment(pipeline, note.Body) {
+		return nil
+	}
+	_, _, err = client.Notes.UpdateMergeRequestNote(_repo.ID, iid, note.ID, &gitlab.UpdateMergeRequestNoteOptions{
+		Body: gitlab.Ptr(body),
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// ClosePullComment collapses the pipeline summary comment on the merge request
+// of the pipeline.
+func (g *GitLab) ClosePullComment(ctx context.Context, user *model.User, repo *model.Repo, pipeline *model.Pipeline) error {
+	iid, ok := mergeRequestIID(pipeline.Ref)
+	if !ok {
+		return nil
+	}
+
+	client, err := newClient(g.url, user.AccessToken, g.skipVerify)
+	if err != nil {
+		return err
+	}
+
+	_repo, err := g.getProject(ctx, client, repo.ForgeRemoteID, repo.Owner, repo.Name)
+	if err != nil {
+		return err
+	}
+
+	note, err := findPullComment(ctx, client, user, _repo.ID, iid)
+	if err != nil || note == nil {
+		return err
+	}
+	_, _, err = client.Notes.UpdateMergeRequestNote(_repo.ID, iid, note.ID, &gitlab.UpdateMergeRequestNoteOptions{
+		Body: gitlab.Ptr(common.CollapsePullComment(note.Body)),
+	}, gitlab.WithContext(ctx))
+	return err
+}
+
+// findPullComment returns the pipeline summary comment posted by the user on
+// the merge request.
+func findPullComment(ctx context.Context, client *gitlab.Client, user *model.User, projectID, iid int64) (*gitlab.Note, error) {
+	opts := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: defaultPerPage,
+			Page:    1,
+		},
+	}
+	for {
+		notes, resp, err := client.Notes.ListMergeRequestNotes(projectID, iid, opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, note := range notes {
+			if note.System || note.Author.Username != user.Login {
+				continue
+			}
+			if _, ok := common.PullCommentPipeline(note.Body); ok {
+				return note, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
 // Netrc returns a netrc file capable of authenticating Gitlab requests and
 // cloning Gitlab repositories. The netrc will use the global machine account
 // when configured.
@@ -626,6 +816,9 @@ func (g *GitLab) BranchHead(ctx context.Context, u *model.User, r *model.Repo, b
 	return &model.Commit{
 		SHA:      b.Commit.ID,
 		ForgeURL: b.Commit.WebURL,
+		Message:  b.Commit.Message,
+		Author:   b.Commit.AuthorName,
+		Email:    b.Commit.AuthorEmail,
 	}, nil
 }
 
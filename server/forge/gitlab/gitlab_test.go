@@ -138,6 +138,44 @@ func Test_GitLab(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("merge request comment", func(t *testing.T) {
+		// updates the existing summary comment
+		err := client.PullComment(ctx, &user, &repo, &model.Pipeline{Number: 6, Event: model.EventPull, Ref: "refs/merge-requests/1/head"}, "[//]: # (woodpecker-pipeline-summary 6)\n\nPipeline #6")
+		assert.NoError(t, err)
+
+		// doesn't replace the summary of a newer pipeline
+		err = client.PullComment(ctx, &user, &repo, &model.Pipeline{Number: 4, Event: model.EventPull, Ref: "refs/merge-requests/1/head"}, "[//]: # (woodpecker-pipeline-summary 4)\n\nPipeline #4")
+		assert.NoError(t, err)
+
+		// creates the first summary comment
+		err = client.PullComment(ctx, &user, &repo, &model.Pipeline{Number: 1, Event: model.EventPull, Ref: "refs/merge-requests/2/head"}, "[//]: # (woodpecker-pipeline-summary 1)\n\nPipeline #1")
+		assert.NoError(t, err)
+
+		err = client.ClosePullComment(ctx, &user, &repo, &model.Pipeline{Number: 7, Event: model.EventPullClosed, Ref: "refs/merge-requests/1/head"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("check run comments", func(t *testing.T) {
+		pipeline := &model.Pipeline{
+			Number:       8,
+			Event:        model.EventPull,
+			Ref:          "refs/merge-requests/1/head",
+			Commit:       "9a7ee9c5a2e3a1c7f0d8b1e4c5d6f7a8b9c0d1e2",
+			ChangedFiles: []string{"main.go"},
+		}
+		workflow := &model.Workflow{Name: "test", State: model.StatusFailure}
+		run := &types.CheckRun{
+			Title: "2 failures",
+			Annotations: []*types.Annotation{
+				{Path: "main.go", Line: 12, Level: types.AnnotationLevelFailure, Title: "test", Message: "assertion failed"},
+				{Path: "main.go", Line: 20, Level: types.AnnotationLevelFailure, Title: "lint", Message: "unused variable"},
+			},
+		}
+
+		// only the failure not posted yet is commented
+		assert.NoError(t, client.CheckRun(ctx, &user, &repo, pipeline, workflow, run))
+	})
+
 	t.Run("test parse webhook", func(t *testing.T) {
 		// Test hook method
 		t.Run("parse push", func(t *testing.T) {
@@ -0,0 +1,37 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forge
+
+import (
+	"context"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// PullCommenter is an optional interface for forges able to keep a comment
+// on a pull request summarizing its pipelines. The comment is created for the
+// first pipeline of the pull request and updated in place for later ones.
+//
+// Implementations: GitHub, Gitea, Forgejo, GitLab, Bitbucket.
+type PullCommenter interface {
+	// PullComment creates the summary comment on the pull request of the
+	// pipeline or replaces the body of the existing one. The body doesn't
+	// have to be updated if the comment summarizes a newer pipeline already.
+	PullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline, body string) error
+
+	// ClosePullComment collapses or removes the summary comment of the pull
+	// request of the pipeline after the pull request was closed.
+	ClosePullComment(ctx context.Context, u *model.User, r *model.Repo, p *model.Pipeline) error
+}
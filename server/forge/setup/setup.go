@@ -26,6 +26,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/bitbucket"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/bitbucketdatacenter"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/forgejo"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/git"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/gitea"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/github"
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge/gitlab"
@@ -48,6 +49,8 @@ func Forge(forge *model.Forge) (forge.Forge, error) {
 		return setupForgejo(forge)
 	case model.ForgeTypeBitbucketDatacenter:
 		return setupBitbucketDatacenter(forge)
+	case model.ForgeTypeGit:
+		return setupGit(forge)
 	default:
 		return nil, fmt.Errorf("forge not configured")
 	}
@@ -144,6 +147,9 @@ func setupGitHub(forge *model.Forge) (forge.Forge, error) {
 	// get additional config and be false by default
 	mergeRef, _ := forge.AdditionalOptions["merge-ref"].(bool)
 	publicOnly, _ := forge.AdditionalOptions["public-only"].(bool)
+	appID, _ := forge.AdditionalOptions["app-id"].(string)
+	appPrivateKey, _ := forge.AdditionalOptions["app-private-key"].(string)
+	appWebhookSecret, _ := forge.AdditionalOptions["app-webhook-secret"].(string)
 
 	opts := github.Opts{
 		URL:               forge.URL,
@@ -153,12 +159,16 @@ func setupGitHub(forge *model.Forge) (forge.Forge, error) {
 		MergeRef:          mergeRef,
 		OnlyPublic:        publicOnly,
 		OAuthHost:         forge.OAuthHost,
+		AppID:             appID,
+		AppPrivateKey:     appPrivateKey,
+		AppWebhookSecret:  appWebhookSecret,
 	}
 	log.Debug().
 		Str("url", opts.URL).
 		Str("oauth-host", opts.OAuthHost).
 		Bool("merge-ref", opts.MergeRef).
 		Bool("only-public", opts.OnlyPublic).
+		Str("app-id", opts.AppID).
 		Bool("skip-verify", opts.SkipVerify).
 		Bool("oauth-client-id-set", opts.OAuthClientID != "").
 		Bool("oauth-client-secret-set", opts.OAuthClientSecret != "").
@@ -202,6 +212,66 @@ func setupBitbucketDatacenter(forge *model.Forge) (forge.Forge, error) {
 	return bitbucketdatacenter.New(forge.ID, opts)
 }
 
+func setupGit(forge *model.Forge) (forge.Forge, error) {
+	if forge.AdditionalOptions["repos"] == nil {
+		return nil, fmt.Errorf("missing repos")
+	}
+	repos, err := stringList(forge.AdditionalOptions["repos"])
+	if err != nil {
+		return nil, fmt.Errorf("incorrect type for repos value")
+	}
+
+	pushUsers, err := stringList(forge.AdditionalOptions["push-users"])
+	if err != nil {
+		return nil, fmt.Errorf("incorrect type for push-users value")
+	}
+
+	opts := git.Opts{
+		URL:       forge.URL,
+		Repos:     repos,
+		PushUsers: pushUsers,
+	}
+	var ok bool
+	if opts.UsersFile, ok = forge.AdditionalOptions["users-file"].(string); !ok {
+		return nil, fmt.Errorf("missing users-file")
+	}
+	// optional, so missing values are left empty
+	opts.Username, _ = forge.AdditionalOptions["git-username"].(string)
+	opts.Password, _ = forge.AdditionalOptions["git-password"].(string)
+	opts.CacheDir, _ = forge.AdditionalOptions["cache-dir"].(string)
+
+	log.Debug().
+		Str("url", opts.URL).
+		Int("repos", len(opts.Repos)).
+		Str("users-file", opts.UsersFile).
+		Bool("git-username-set", opts.Username != "").
+		Str("type", string(forge.Type)).
+		Msg("setting up forge")
+	return git.New(opts)
+}
+
+// stringList returns a list option, stored as json it comes back as []any.
+func stringList(option any) ([]string, error) {
+	switch value := option.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return value, nil
+	case []any:
+		list := make([]string, 0, len(value))
+		for _, item := range value {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected list item %v", item)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("unexpected list %v", option)
+	}
+}
+
 func setupAddon(forge *model.Forge) (forge.Forge, error) {
 	executable, ok := forge.AdditionalOptions["executable"].(string)
 	if !ok {
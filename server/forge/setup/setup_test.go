@@ -107,3 +107,30 @@ func TestForgeAddonMissingExecutable(t *testing.T) {
 	})
 	assert.Error(t, err)
 }
+
+func TestForgeGit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing repos", func(t *testing.T) {
+		t.Parallel()
+		_, err := Forge(&model.Forge{
+			Type:              model.ForgeTypeGit,
+			AdditionalOptions: map[string]any{"users-file": "users"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("repos loaded from the database", func(t *testing.T) {
+		t.Parallel()
+		f, err := Forge(&model.Forge{
+			Type: model.ForgeTypeGit,
+			AdditionalOptions: map[string]any{
+				"repos":      []any{"https://git.example.com/team/app.git"},
+				"users-file": "users",
+				"push-users": []any{"alice", "team/app:bob"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "git", f.Name())
+	})
+}
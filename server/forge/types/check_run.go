@@ -0,0 +1,46 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// AnnotationLevel is the severity of an annotation.
+type AnnotationLevel string
+
+const (
+	AnnotationLevelNotice  AnnotationLevel = "notice"
+	AnnotationLevelWarning AnnotationLevel = "warning"
+	AnnotationLevelFailure AnnotationLevel = "failure"
+)
+
+// CheckRun is the detailed report of a workflow for forges showing more than
+// a plain commit status.
+type CheckRun struct {
+	// Title summarizes the result in a single line.
+	Title string
+	// Summary describes the workflow in markdown, e.g. its steps.
+	Summary string
+	// Text holds further details in markdown, e.g. the failed tests.
+	Text string
+	// Annotations point to lines of files in the repo.
+	Annotations []*Annotation
+}
+
+// Annotation is a message about a line of a file in the repo.
+type Annotation struct {
+	Path    string
+	Line    int
+	Level   AnnotationLevel
+	Title   string
+	Message string
+}
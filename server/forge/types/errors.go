@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 var (
@@ -55,3 +56,22 @@ func (*ErrConfigNotFound) Is(target error) bool {
 	_, ok := target.(*ErrConfigNotFound)
 	return ok
 }
+
+// ErrRateLimited is returned if the forge rejected a request because its API
+// rate limit has been exceeded.
+type ErrRateLimited struct {
+	// Reset is the time the rate limit is lifted, if the forge reported it.
+	Reset time.Time
+}
+
+func (m *ErrRateLimited) Error() string {
+	if m.Reset.IsZero() {
+		return "forge rate limit exceeded"
+	}
+	return fmt.Sprintf("forge rate limit exceeded, resets at %s", m.Reset.Format(time.RFC3339))
+}
+
+func (*ErrRateLimited) Is(target error) bool {
+	_, ok := target.(*ErrRateLimited)
+	return ok
+}
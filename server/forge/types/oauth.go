@@ -17,4 +17,7 @@ package types
 type OAuthRequest struct {
 	Code  string
 	State string
+	// Login and Password are sent by the login form of forges without OAuth.
+	Login    string
+	Password string
 }
@@ -0,0 +1,214 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/oklog/ulid/v2"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub"
+)
+
+// HistoryFunc returns the log entries of a step written so far.
+type HistoryFunc func(ctx context.Context, stepID int64) ([]*model.LogEntry, error)
+
+// message is passed on to the other servers for every write and close.
+type message struct {
+	Server  string            `json:"server"`
+	Entries []*model.LogEntry `json:"entries,omitempty"`
+	Closed  bool              `json:"closed,omitempty"`
+}
+
+// remoteStream receives the entries of a step written on other servers.
+type remoteStream struct {
+	sync.Mutex
+
+	cancel context.CancelFunc
+	// pending holds the entries received while the history is loaded.
+	pending []*model.LogEntry
+	loading bool
+	// lines are the lines written to the local stream already.
+	lines map[int]struct{}
+}
+
+type pubsubLogger struct {
+	sync.Mutex
+
+	ctx     context.Context
+	id      string
+	ps      pubsub.PubSub
+	local   *logger
+	history HistoryFunc
+	remote  map[int64]*remoteStream
+}
+
+// NewPubSub returns a log multiplexer for multiple servers. As agents and
+// clients can be connected to different servers, the entries written on one
+// server are passed on to the tails of all servers through the pubsub. Tails
+// of a step written on another server start with the history of the step.
+func NewPubSub(ctx context.Context, ps pubsub.PubSub, history HistoryFunc) Log {
+	return &pubsubLogger{
+		ctx:     ctx,
+		id:      ulid.Make().String(),
+		ps:      ps,
+		local:   New().(*logger),
+		history: history,
+		remote:  make(map[int64]*remoteStream),
+	}
+}
+
+func logTopic(stepID int64) pubsub.Topics {
+	return pubsub.Topics{fmt.Sprintf("log.step.%d", stepID): {}}
+}
+
+func (l *pubsubLogger) Open(c context.Context, stepID int64) error {
+	l.Lock()
+	if _, ok := l.remote[stepID]; ok {
+		l.Unlock()
+		return nil
+	}
+
+	// entries written on this server are in the local stream already
+	l.local.Lock()
+	_, written := l.local.streams[stepID]
+	l.local.open(stepID)
+	l.local.Unlock()
+
+	ctx, cancel := context.WithCancel(l.ctx)
+	stream := &remoteStream{
+		cancel:  cancel,
+		loading: !written,
+		lines:   make(map[int]struct{}),
+	}
+	l.remote[stepID] = stream
+	l.Unlock()
+
+	go func() {
+		if err := l.ps.Subscribe(ctx, logTopic(stepID), func(m pubsub.Message) {
+			l.receive(ctx, stepID, stream, m)
+		}); err != nil {
+			log.Error().Err(err).Msgf("could not subscribe to logs of step %d", stepID)
+		}
+	}()
+
+	if written {
+		return nil
+	}
+
+	// the subscription is started first, so entries written while the history
+	// is loaded are not missed
+	entries, err := l.history(c, stepID)
+	if err != nil {
+		log.Error().Err(err).Msgf("could not load log history of step %d", stepID)
+	}
+
+	stream.Lock()
+	defer stream.Unlock()
+	stream.loading = false
+	l.write(ctx, stepID, stream, append(entries, stream.pending...))
+	stream.pending = nil
+	return nil
+}
+
+func (l *pubsubLogger) receive(ctx context.Context, stepID int64, stream *remoteStream, m pubsub.Message) {
+	var msg message
+	if err := json.Unmarshal(m.Data, &msg); err != nil {
+		log.Error().Err(err).Msgf("could not decode logs of step %d", stepID)
+		return
+	}
+	if msg.Server == l.id {
+		return
+	}
+
+	if msg.Closed {
+		l.closeRemote(stepID)
+		if err := l.local.Close(ctx, stepID); err != nil && !errors.Is(err, ErrNotFound) {
+			log.Error().Err(err).Msgf("could not close logs of step %d", stepID)
+		}
+		return
+	}
+
+	stream.Lock()
+	defer stream.Unlock()
+	if stream.loading {
+		stream.pending = append(stream.pending, msg.Entries...)
+		return
+	}
+	l.write(ctx, stepID, stream, msg.Entries)
+}
+
+// write writes the entries not written yet to the local stream.
+//
+// Expects the stream to be locked by the caller.
+func (l *pubsubLogger) write(ctx context.Context, stepID int64, stream *remoteStream, entries []*model.LogEntry) {
+	var unwritten []*model.LogEntry
+	for _, entry := range entries {
+		if _, ok := stream.lines[entry.Line]; ok {
+			continue
+		}
+		stream.lines[entry.Line] = struct{}{}
+		unwritten = append(unwritten, entry)
+	}
+	if len(unwritten) == 0 {
+		return
+	}
+	if err := l.local.Write(ctx, stepID, unwritten); err != nil {
+		log.Error().Err(err).Msgf("could not write logs of step %d", stepID)
+	}
+}
+
+func (l *pubsubLogger) Write(c context.Context, stepID int64, entries []*model.LogEntry) error {
+	if err := l.local.Write(c, stepID, entries); err != nil {
+		return err
+	}
+	return l.publish(c, stepID, message{Server: l.id, Entries: entries})
+}
+
+func (l *pubsubLogger) Tail(c context.Context, stepID int64, handler LogChan) error {
+	return l.local.Tail(c, stepID, handler)
+}
+
+func (l *pubsubLogger) Close(c context.Context, stepID int64) error {
+	l.closeRemote(stepID)
+	err := l.local.Close(c, stepID)
+	if pubErr := l.publish(c, stepID, message{Server: l.id, Closed: true}); pubErr != nil {
+		return pubErr
+	}
+	return err
+}
+
+func (l *pubsubLogger) closeRemote(stepID int64) {
+	l.Lock()
+	defer l.Unlock()
+	if stream, ok := l.remote[stepID]; ok {
+		stream.cancel()
+		delete(l.remote, stepID)
+	}
+}
+
+func (l *pubsubLogger) publish(c context.Context, stepID int64, msg message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return l.ps.Publish(c, logTopic(stepID), pubsub.Message{ID: ulid.Make().String(), Data: data})
+}
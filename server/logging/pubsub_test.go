@@ -0,0 +1,107 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package logging_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/logging"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub/database"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
+)
+
+func TestPubSubLogAcrossServers(t *testing.T) {
+	store := datastore.NewTestStore(t)
+	pubsub1 := database.New(t.Context(), store)
+	pubsub2 := database.New(t.Context(), store)
+
+	// messages are only passed on once the servers started up
+	probe := make(chan struct{}, 1)
+	go func() {
+		_ = pubsub2.Subscribe(t.Context(), pubsub.Topics{"probe": {}}, func(pubsub.Message) {
+			select {
+			case probe <- struct{}{}:
+			default:
+			}
+		})
+	}()
+	require.Eventually(t, func() bool {
+		require.NoError(t, pubsub1.Publish(t.Context(), pubsub.Topics{"probe": {}}, pubsub.Message{}))
+		select {
+		case <-probe:
+			return true
+		case <-time.After(500 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	const stepID = int64(1)
+	entry := func(line int) *model.LogEntry {
+		return &model.LogEntry{StepID: stepID, Line: line, Data: []byte{byte('a' + line)}}
+	}
+
+	// the agent is connected to the first server, the client to the second
+	server1 := logging.NewPubSub(t.Context(), pubsub1, nil)
+	server2 := logging.NewPubSub(t.Context(), pubsub2, func(_ context.Context, id int64) ([]*model.LogEntry, error) {
+		assert.Equal(t, stepID, id)
+		return []*model.LogEntry{entry(0)}, nil
+	})
+
+	require.NoError(t, server1.Write(t.Context(), stepID, []*model.LogEntry{entry(0)}))
+	require.NoError(t, server2.Open(t.Context(), stepID))
+
+	var mu sync.Mutex
+	var lines []int
+	receiver := make(logging.LogChan, 10)
+	tailDone := make(chan struct{})
+	go func() {
+		defer close(tailDone)
+		assert.NoError(t, server2.Tail(t.Context(), stepID, receiver))
+	}()
+	go func() {
+		for entries := range receiver {
+			mu.Lock()
+			for _, entry := range entries {
+				lines = append(lines, entry.Line)
+			}
+			mu.Unlock()
+		}
+	}()
+
+	require.NoError(t, server1.Write(t.Context(), stepID, []*model.LogEntry{entry(1), entry(2)}))
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return assert.ObjectsAreEqual([]int{0, 1, 2}, lines)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// closing the log on the first server ends the tails of the second one
+	require.NoError(t, server1.Close(t.Context(), stepID))
+	select {
+	case <-tailDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tail on the second server did not end")
+	}
+}
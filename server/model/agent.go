@@ -38,8 +38,13 @@ type Agent struct {
 	Version      string            `json:"version"       xorm:"'version'"`
 	NoSchedule   bool              `json:"no_schedule"   xorm:"no_schedule"`
 	CustomLabels map[string]string `json:"custom_labels" xorm:"JSON 'custom_labels'"`
+	// Resources the agent can allocate for workflows, as last reported by it.
+	Resources Resources `json:"resources" xorm:"JSON 'resources'"`
 	// OrgID is counted as unset if set to -1, this is done to ensure a new(Agent) still enforce the OrgID check by default
 	OrgID int64 `json:"org_id"        xorm:"INDEX 'org_id'"`
+	// OfflineReported is the last contact of the agent when it was last
+	// reported offline to the webhooks.
+	OfflineReported int64 `json:"-" xorm:"offline_reported"`
 } //	@name	Agent
 
 const (
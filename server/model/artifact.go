@@ -0,0 +1,36 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// Artifact is an archive of files uploaded by a step. It is passed on to the
+// workflows depending on the workflow of the step and can be downloaded until
+// it expires.
+type Artifact struct {
+	ID         int64  `json:"id"          xorm:"pk autoincr 'id'"`
+	RepoID     int64  `json:"-"           xorm:"INDEX 'repo_id'"`
+	PipelineID int64  `json:"pipeline_id" xorm:"INDEX 'pipeline_id'"`
+	WorkflowID int64  `json:"workflow_id" xorm:"INDEX 'workflow_id'"`
+	Workflow   string `json:"workflow"    xorm:"'workflow'"`
+	Name       string `json:"name"        xorm:"'name'"`
+	Size       int64  `json:"size"        xorm:"'size'"`
+	Created    int64  `json:"created"     xorm:"created NOT NULL DEFAULT 0 'created'"`
+	// Expires is zero if the artifact does not expire.
+	Expires int64 `json:"expires,omitempty" xorm:"INDEX 'expires'"`
+} //	@name	Artifact
+
+// TableName return database table name for xorm.
+func (Artifact) TableName() string {
+	return "artifacts"
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// AuditAction is an administrative or security-relevant action.
+type AuditAction string //	@name	AuditAction
+
+const (
+	AuditSecretCreate    AuditAction = "secret.create"
+	AuditSecretUpdate    AuditAction = "secret.update"
+	AuditSecretDelete    AuditAction = "secret.delete"
+	AuditRegistryCreate  AuditAction = "registry.create"
+	AuditRegistryUpdate  AuditAction = "registry.update"
+	AuditRegistryDelete  AuditAction = "registry.delete"
+	AuditRepoActivate    AuditAction = "repo.activate"
+	AuditRepoUpdate      AuditAction = "repo.update"
+	AuditRepoDeactivate  AuditAction = "repo.deactivate"
+	AuditRepoDelete      AuditAction = "repo.delete"
+	AuditRepoChown       AuditAction = "repo.chown"
+	AuditRepoMove        AuditAction = "repo.move"
+	AuditRepoRepair      AuditAction = "repo.repair"
+	AuditCronCreate      AuditAction = "cron.create"
+	AuditCronUpdate      AuditAction = "cron.update"
+	AuditCronDelete      AuditAction = "cron.delete"
+	AuditAgentCreate     AuditAction = "agent.create"
+	AuditAgentUpdate     AuditAction = "agent.update"
+	AuditAgentDelete     AuditAction = "agent.delete"
+	AuditForgeCreate     AuditAction = "forge.create"
+	AuditForgeUpdate     AuditAction = "forge.update"
+	AuditForgeDelete     AuditAction = "forge.delete"
+	AuditUserCreate      AuditAction = "user.create"
+	AuditUserUpdate      AuditAction = "user.update"
+	AuditUserDelete      AuditAction = "user.delete"
+	AuditUserTokenReset  AuditAction = "user.token_reset"
+	AuditTokenCreate     AuditAction = "token.create"
+	AuditTokenDelete     AuditAction = "token.delete"
+	AuditPipelineApprove AuditAction = "pipeline.approve"
+	AuditPipelineDecline AuditAction = "pipeline.decline"
+	AuditWebhookCreate   AuditAction = "webhook.create"
+	AuditWebhookUpdate   AuditAction = "webhook.update"
+	AuditWebhookDelete   AuditAction = "webhook.delete"
+)
+
+// AuditTargetType is the type of object an audited action was performed on.
+type AuditTargetType string //	@name	AuditTargetType
+
+const (
+	AuditTargetSecret   AuditTargetType = "secret"
+	AuditTargetRegistry AuditTargetType = "registry"
+	AuditTargetRepo     AuditTargetType = "repo"
+	AuditTargetCron     AuditTargetType = "cron"
+	AuditTargetAgent    AuditTargetType = "agent"
+	AuditTargetForge    AuditTargetType = "forge"
+	AuditTargetUser     AuditTargetType = "user"
+	AuditTargetToken    AuditTargetType = "token"
+	AuditTargetPipeline AuditTargetType = "pipeline"
+	AuditTargetWebhook  AuditTargetType = "webhook"
+)
+
+// AuditTarget identifies the object an audited action was performed on.
+// OrgID and RepoID are set if the object belongs to an org or repo, entries
+// of repos also carry the org of the repo so org admins can see them.
+type AuditTarget struct {
+	Type   AuditTargetType `json:"target_type" xorm:"VARCHAR(50) INDEX 'target_type'"`
+	ID     int64           `json:"target_id"   xorm:"'target_id'"`
+	Name   string          `json:"target_name" xorm:"VARCHAR(500) 'target_name'"`
+	OrgID  int64           `json:"org_id"      xorm:"NOT NULL DEFAULT 0 INDEX 'org_id'"`
+	RepoID int64           `json:"repo_id"     xorm:"NOT NULL DEFAULT 0 INDEX 'repo_id'"`
+}
+
+// AuditLog is an entry of the audit log. Credentials in the old and new
+// values are redacted before the entry is stored.
+type AuditLog struct {
+	ID          int64       `json:"id"          xorm:"pk autoincr 'id'"`
+	Created     int64       `json:"created"     xorm:"created NOT NULL DEFAULT 0 INDEX 'created'"`
+	ActorID     int64       `json:"actor_id"    xorm:"'actor_id'"`
+	ActorLogin  string      `json:"actor_login" xorm:"VARCHAR(250) INDEX 'actor_login'"`
+	Action      AuditAction `json:"action"      xorm:"VARCHAR(50) INDEX 'action'"`
+	AuditTarget `xorm:"extends"`
+	OldValue    map[string]any `json:"old_value,omitempty" xorm:"json TEXT 'old_value'"`
+	NewValue    map[string]any `json:"new_value,omitempty" xorm:"json TEXT 'new_value'"`
+	ClientIP    string         `json:"client_ip"           xorm:"VARCHAR(100) 'client_ip'"`
+} //	@name	AuditLog
+
+// TableName returns the database table name for xorm.
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// AuditLogFilter limits the audit log entries returned, empty fields match
+// all entries.
+type AuditLogFilter struct {
+	OrgID      int64
+	RepoID     int64
+	ActorLogin string
+	Action     AuditAction
+	TargetType AuditTargetType
+	Before     int64
+	After      int64
+}
@@ -17,4 +17,10 @@ package model
 type Commit struct {
 	SHA      string
 	ForgeURL string
+
+	// Message, Author and Email are only filled by forges returning them
+	// together with the branch head.
+	Message string
+	Author  string
+	Email   string
 }
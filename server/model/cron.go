@@ -33,6 +33,7 @@ type Cron struct {
 	Branch    string            `json:"branch"     xorm:"branch"`
 	Enabled   bool              `json:"enabled"    xorm:"enabled NOT NULL DEFAULT TRUE"`
 	Variables map[string]string `json:"variables"  xorm:"json 'variables'"`
+	Priority  int               `json:"priority"   xorm:"priority NOT NULL DEFAULT 0"`
 } //	@name	Cron
 
 // TableName returns the database table name for xorm.
@@ -75,4 +76,5 @@ type CronPatch struct {
 	Branch    *string           `json:"branch"`
 	Enabled   *bool             `json:"enabled"`
 	Variables map[string]string `json:"variables"`
+	Priority  *int              `json:"priority"`
 } //	@name	CronPatch
@@ -14,6 +14,8 @@
 
 package model
 
+import "slices"
+
 type ForgeType string
 
 const (
@@ -23,6 +25,7 @@ const (
 	ForgeTypeForgejo             ForgeType = "forgejo"
 	ForgeTypeBitbucket           ForgeType = "bitbucket"
 	ForgeTypeBitbucketDatacenter ForgeType = "bitbucket-dc"
+	ForgeTypeGit                 ForgeType = "git"
 	ForgeTypeAddon               ForgeType = "addon"
 )
 
@@ -54,6 +57,43 @@ func (f *Forge) PublicCopy() *Forge {
 	return forge
 }
 
+// sensitiveForgeOptions are the additional options holding credentials.
+var sensitiveForgeOptions = []string{"git-password", "app-private-key", "app-webhook-secret"}
+
+// RedactedCopy returns a copy of the forge without the credentials in its
+// additional options.
+func (f *Forge) RedactedCopy() *Forge {
+	forge := *f
+	if f.AdditionalOptions == nil {
+		return &forge
+	}
+	forge.AdditionalOptions = make(map[string]any, len(f.AdditionalOptions))
+	for key, value := range f.AdditionalOptions {
+		if !slices.Contains(sensitiveForgeOptions, key) {
+			forge.AdditionalOptions[key] = value
+		}
+	}
+	return &forge
+}
+
+// KeepSensitiveOptions takes over the credentials of the additional options
+// of the old forge that are not set, as they are never sent to clients.
+func (f *Forge) KeepSensitiveOptions(old *Forge) {
+	for _, key := range sensitiveForgeOptions {
+		value, ok := old.AdditionalOptions[key]
+		if !ok {
+			continue
+		}
+		if current, _ := f.AdditionalOptions[key].(string); current != "" {
+			continue
+		}
+		if f.AdditionalOptions == nil {
+			f.AdditionalOptions = make(map[string]any)
+		}
+		f.AdditionalOptions[key] = value
+	}
+}
+
 // ForgeWithOAuthClientSecret allows to update the client secret.
 type ForgeWithOAuthClientSecret struct {
 	Forge
@@ -25,6 +25,10 @@ const (
 	LogEntryProgress
 )
 
+// LogEntry is a line of the log of a step. Entries of type LogEntryMetadata
+// are no output but mark where a command of the step starts or ends, their
+// data is a JSON encoded rpc.LogCommand. Lines in between belong to that
+// command.
 type LogEntry struct {
 	ID      int64        `json:"id"       xorm:"pk autoincr 'id'"`
 	StepID  int64        `json:"step_id"  xorm:"UNIQUE(s) INDEX 'step_id'"`
@@ -35,8 +39,54 @@ type LogEntry struct {
 	Type    LogEntryType `json:"type"     xorm:"'type'"`
 } //	@name	LogEntry
 
-// TODO: store info what specific command the line belongs to (must be optional and impl. by backend)
-
 func (LogEntry) TableName() string {
 	return "log_entries"
 }
+
+// LogArchive holds the log entries of a finished step, compressed into a
+// single blob to save space in the database.
+type LogArchive struct {
+	StepID int64  `xorm:"pk 'step_id'"`
+	Data   []byte `xorm:"LONGBLOB 'data'"`
+}
+
+func (LogArchive) TableName() string {
+	return "log_archives"
+}
+
+// LogAttemptArchive holds the compressed log entries a step wrote during a
+// previous attempt of its workflow, as every attempt writes its logs from the
+// first line again.
+type LogAttemptArchive struct {
+	StepID  int64  `xorm:"pk 'step_id'"`
+	Attempt int    `xorm:"pk 'attempt'"`
+	Data    []byte `xorm:"LONGBLOB 'data'"`
+}
+
+func (LogAttemptArchive) TableName() string {
+	return "log_attempt_archives"
+}
+
+// LogSearchIndex holds the words of the logs of a finished step, which the
+// database indexes for full text search. Truncated is set if the step has more
+// words than could be indexed.
+type LogSearchIndex struct {
+	StepID    int64  `xorm:"pk 'step_id'"`
+	Content   string `xorm:"LONGTEXT 'content'"`
+	Truncated bool   `xorm:"'truncated'"`
+}
+
+func (LogSearchIndex) TableName() string {
+	return "log_search"
+}
+
+// LogMatch is a line of the logs of a step matching a search.
+type LogMatch struct {
+	PipelineNumber int64    `json:"pipeline_number"`
+	StepID         int64    `json:"step_id"`
+	StepName       string   `json:"step_name"`
+	Line           int      `json:"line"`
+	Text           string   `json:"text"`
+	Before         []string `json:"before,omitempty"`
+	After          []string `json:"after,omitempty"`
+} //	@name	LogMatch
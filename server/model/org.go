@@ -22,8 +22,16 @@ type Org struct {
 	IsUser  bool   `json:"is_user"            xorm:"is_user"`
 	// if name lookup has to check for membership or not
 	Private bool `json:"-"                    xorm:"private"`
+	// ShareWeight is the share of agents the org gets compared to other orgs
+	// if fair share scheduling is enabled. Values below 1 count as 1.
+	ShareWeight int `json:"share_weight" xorm:"NOT NULL DEFAULT 0 'share_weight'"`
 } //	@name	Org
 
+// OrgPatch represents an org patch object.
+type OrgPatch struct {
+	ShareWeight *int `json:"share_weight,omitempty"`
+} //	@name	OrgPatch
+
 // TableName return database table name for xorm.
 func (Org) TableName() string {
 	return "orgs"
@@ -0,0 +1,144 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TokenScope is a permission granted to a personal access token.
+type TokenScope string //	@name	TokenScope
+
+const (
+	// TokenScopeRepoRead allows to read repos, pipelines, logs and the own account.
+	TokenScopeRepoRead TokenScope = "repo:read"
+	// TokenScopeRepoWrite allows to change the settings of repos and orgs.
+	TokenScopeRepoWrite TokenScope = "repo:write"
+	// TokenScopePipelineWrite allows to create, restart, cancel, approve and delete pipelines.
+	TokenScopePipelineWrite TokenScope = "pipeline:write"
+	// TokenScopeSecretsRead allows to list secrets, their values are never returned.
+	TokenScopeSecretsRead TokenScope = "secrets:read"
+	// TokenScopeSecretsWrite allows to create, change and delete secrets.
+	TokenScopeSecretsWrite TokenScope = "secrets:write"
+	// TokenScopeUserWrite allows to manage the personal access tokens of the user.
+	TokenScopeUserWrite TokenScope = "user:write"
+	// TokenScopeAdmin grants all scopes, server administration still
+	// requires the user to be an admin.
+	TokenScopeAdmin TokenScope = "admin"
+)
+
+// TokenScopes are all scopes a personal access token can have.
+var TokenScopes = []TokenScope{
+	TokenScopeRepoRead,
+	TokenScopeRepoWrite,
+	TokenScopePipelineWrite,
+	TokenScopeSecretsRead,
+	TokenScopeSecretsWrite,
+	TokenScopeUserWrite,
+	TokenScopeAdmin,
+}
+
+// impliedTokenScopes are the scopes granted by a scope in addition to itself.
+var impliedTokenScopes = map[TokenScope][]TokenScope{
+	TokenScopeRepoWrite:     {TokenScopeRepoRead},
+	TokenScopePipelineWrite: {TokenScopeRepoRead},
+	TokenScopeSecretsRead:   {TokenScopeRepoRead},
+	TokenScopeSecretsWrite:  {TokenScopeRepoRead, TokenScopeSecretsRead},
+}
+
+// Valid reports whether the scope is known.
+func (s TokenScope) Valid() bool {
+	return slices.Contains(TokenScopes, s)
+}
+
+const maxTokenNameLen = 255
+
+var (
+	errTokenNameInvalid   = errors.New("token name is required and must not exceed 255 characters")
+	errTokenScopesMissing = errors.New("token needs at least one scope")
+	errTokenExpired       = errors.New("token expiry must be in the future")
+)
+
+// PersonalAccessToken is a named token of a user, limited to its scopes.
+type PersonalAccessToken struct {
+	ID     int64        `json:"id"      xorm:"pk autoincr 'id'"`
+	UserID int64        `json:"-"       xorm:"UNIQUE(s) INDEX 'user_id'"`
+	Name   string       `json:"name"    xorm:"UNIQUE(s) 'name'"`
+	Scopes []TokenScope `json:"scopes"  xorm:"json 'scopes'"`
+	// Created is the unix timestamp the token was created at.
+	Created int64 `json:"created" xorm:"created NOT NULL DEFAULT 0 'created'"`
+	// Expires is the unix timestamp the token expires at, 0 if it never expires.
+	Expires int64 `json:"expires" xorm:"expires"`
+	// LastUsed is the unix timestamp the token was last used at, it is updated at most once a minute.
+	LastUsed int64 `json:"last_used" xorm:"last_used"`
+} //	@name	PersonalAccessToken
+
+// TableName returns the database table name for xorm.
+func (PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+// Validate validates the name, scopes and expiry of a new token.
+func (t *PersonalAccessToken) Validate() error {
+	if len(t.Name) == 0 || len(t.Name) > maxTokenNameLen {
+		return errTokenNameInvalid
+	}
+	if len(t.Scopes) == 0 {
+		return errTokenScopesMissing
+	}
+	for _, scope := range t.Scopes {
+		if !scope.Valid() {
+			return fmt.Errorf("unknown token scope %q", scope)
+		}
+	}
+	if t.Expires != 0 && t.Expires <= time.Now().Unix() {
+		return errTokenExpired
+	}
+	return nil
+}
+
+// HasScope reports whether the token was granted the scope, directly or
+// through a scope implying it.
+func (t *PersonalAccessToken) HasScope(scope TokenScope) bool {
+	for _, granted := range t.Scopes {
+		if granted == scope || granted == TokenScopeAdmin || slices.Contains(impliedTokenScopes[granted], scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired reports whether the token expired at the given time.
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return t.Expires != 0 && t.Expires <= now.Unix()
+}
+
+// PersonalAccessTokenCreate is the request to create a personal access token.
+type PersonalAccessTokenCreate struct {
+	Name   string       `json:"name"`
+	Scopes []TokenScope `json:"scopes"`
+	// Expires is the unix timestamp the token expires at, 0 if it never expires.
+	Expires int64 `json:"expires"`
+} //	@name	PersonalAccessTokenCreate
+
+// NewPersonalAccessToken is returned once after creating a token, the
+// token itself can't be retrieved later on.
+type NewPersonalAccessToken struct {
+	*PersonalAccessToken
+	Token string `json:"token"`
+} //	@name	NewPersonalAccessToken
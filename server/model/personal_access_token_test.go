@@ -0,0 +1,63 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersonalAccessTokenHasScope(t *testing.T) {
+	token := &PersonalAccessToken{Scopes: []TokenScope{TokenScopeSecretsWrite}}
+	assert.True(t, token.HasScope(TokenScopeSecretsWrite))
+	assert.True(t, token.HasScope(TokenScopeSecretsRead))
+	assert.True(t, token.HasScope(TokenScopeRepoRead))
+	assert.False(t, token.HasScope(TokenScopeRepoWrite))
+	assert.False(t, token.HasScope(TokenScopeAdmin))
+
+	token = &PersonalAccessToken{Scopes: []TokenScope{TokenScopeAdmin}}
+	for _, scope := range TokenScopes {
+		assert.True(t, token.HasScope(scope), scope)
+	}
+}
+
+func TestPersonalAccessTokenValidate(t *testing.T) {
+	valid := PersonalAccessToken{Name: "ci", Scopes: []TokenScope{TokenScopeRepoRead}, Expires: time.Now().Add(time.Hour).Unix()}
+	assert.NoError(t, valid.Validate())
+
+	noName := valid
+	noName.Name = ""
+	assert.ErrorIs(t, noName.Validate(), errTokenNameInvalid)
+
+	noScopes := valid
+	noScopes.Scopes = nil
+	assert.ErrorIs(t, noScopes.Validate(), errTokenScopesMissing)
+
+	unknownScope := valid
+	unknownScope.Scopes = []TokenScope{"repo:delete"}
+	assert.EqualError(t, unknownScope.Validate(), `unknown token scope "repo:delete"`)
+
+	expired := valid
+	expired.Expires = time.Now().Add(-time.Hour).Unix()
+	assert.ErrorIs(t, expired.Validate(), errTokenExpired)
+	assert.True(t, expired.Expired(time.Now()))
+
+	neverExpires := valid
+	neverExpires.Expires = 0
+	assert.NoError(t, neverExpires.Validate())
+	assert.False(t, neverExpires.Expired(time.Now()))
+}
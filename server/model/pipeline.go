@@ -55,8 +55,10 @@ type Pipeline struct {
 	PullRequestMilestone string                  `json:"pr_milestone,omitempty"  xorm:"pr_milestone"`
 	PullRequestDraft     bool                    `json:"pr_draft,omitempty"      xorm:"pr_draft"`
 	Cron                 string                  `json:"cron,omitempty"          xorm:"cron"` // name of the cron job
+	Priority             int                     `json:"priority,omitempty"      xorm:"priority"`
 	FromFork             bool                    `json:"from_fork,omitempty"     xorm:"from_fork"`
 	Version              string                  `json:"version"                 xorm:"'version'"`
+	LogsDeleted          bool                    `json:"logs_deleted,omitempty"  xorm:"NOT NULL DEFAULT FALSE 'logs_deleted'"` // logs got removed by a retention policy
 
 	// Ongoing Work: https://github.com/woodpecker-ci/woodpecker/pull/4626
 	// // New
@@ -109,6 +111,7 @@ type PipelineFilter struct {
 	Before      int64
 	After       int64
 	Branch      string
+	Commit      string
 	Events      []WebhookEvent
 	RefContains string
 	Status      StatusValue
@@ -0,0 +1,30 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// PubSubMessage is a message published through the database, so all servers
+// sharing the database can pass it on to their subscribers.
+type PubSubMessage struct {
+	ID        int64    `xorm:"pk autoincr 'id'"`
+	MessageID string   `xorm:"'message_id'"`
+	Topics    []string `xorm:"json 'topics'"`
+	Data      []byte   `xorm:"LONGBLOB 'data'"`
+	Created   int64    `xorm:"created NOT NULL DEFAULT 0 'created'"`
+}
+
+// TableName return database table name for xorm.
+func (PubSubMessage) TableName() string {
+	return "pubsub_messages"
+}
@@ -17,4 +17,19 @@ package model
 type PullRequest struct {
 	Index ForgeRemoteID `json:"index"`
 	Title string        `json:"title"`
+
+	// The following fields are only filled by forges that return them when
+	// listing pull requests. They carry the same values Forge.Hook would set
+	// on a pull request pipeline and are used to trigger pipelines by polling.
+
+	Commit   string   `json:"commit,omitempty"`
+	Ref      string   `json:"ref,omitempty"`
+	Branch   string   `json:"branch,omitempty"`
+	Refspec  string   `json:"refspec,omitempty"`
+	Author   string   `json:"author,omitempty"`
+	Avatar   string   `json:"author_avatar,omitempty"`
+	ForgeURL string   `json:"forge_url,omitempty"`
+	Labels   []string `json:"labels,omitempty"`
+	Draft    bool     `json:"draft,omitempty"`
+	FromFork bool     `json:"from_fork,omitempty"`
 } //	@name	PullRequest
@@ -59,6 +59,8 @@ type Repo struct {
 	Branch                       string               `json:"default_branch,omitempty"        xorm:"varchar(500) 'branch'"`
 	PREnabled                    bool                 `json:"pr_enabled"                      xorm:"DEFAULT TRUE 'pr_enabled'"`
 	Timeout                      int64                `json:"timeout,omitempty"               xorm:"timeout"`
+	PollInterval                 int64                `json:"poll_interval"                   xorm:"NOT NULL DEFAULT 0 'poll_interval'"`
+	Priority                     int                  `json:"priority"                        xorm:"NOT NULL DEFAULT 0 'priority'"`
 	Visibility                   RepoVisibility       `json:"visibility"                      xorm:"varchar(10) 'visibility'"`
 	IsSCMPrivate                 bool                 `json:"private"                         xorm:"private"`
 	Trusted                      TrustedConfiguration `json:"trusted"                         xorm:"json 'trusted'"`
@@ -67,6 +69,7 @@ type Repo struct {
 	IsActive                     bool                 `json:"active"                          xorm:"active"`
 	AllowPull                    bool                 `json:"allow_pr"                        xorm:"allow_pr"`
 	AllowDeploy                  bool                 `json:"allow_deploy"                    xorm:"allow_deploy"`
+	PRComment                    bool                 `json:"pr_comment"                      xorm:"DEFAULT FALSE 'pr_comment'"`
 	Config                       string               `json:"config_file"                     xorm:"varchar(500) 'config_path'"`
 	Hash                         string               `json:"-"                               xorm:"varchar(500) 'hash'"`
 	CancelPreviousPipelineEvents []WebhookEvent       `json:"cancel_previous_pipeline_events" xorm:"json 'cancel_previous_pipeline_events'"`
@@ -156,9 +159,12 @@ type RepoPatch struct {
 	RequireApproval              *string                    `json:"require_approval,omitempty"`
 	ApprovalAllowedUsers         *[]string                  `json:"approval_allowed_users,omitempty"`
 	Timeout                      *int64                     `json:"timeout,omitempty"`
+	PollInterval                 *int64                     `json:"poll_interval,omitempty"`
+	Priority                     *int                       `json:"priority,omitempty"`
 	Visibility                   *string                    `json:"visibility,omitempty"`
 	AllowPull                    *bool                      `json:"allow_pr,omitempty"`
 	AllowDeploy                  *bool                      `json:"allow_deploy,omitempty"`
+	PRComment                    *bool                      `json:"pr_comment,omitempty"`
 	CancelPreviousPipelineEvents *[]WebhookEvent            `json:"cancel_previous_pipeline_events"`
 	NetrcTrusted                 *[]string                  `json:"netrc_trusted"`
 	Trusted                      *TrustedConfigurationPatch `json:"trusted"`
@@ -0,0 +1,32 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// RepoPoll holds the state of a repository that is polled for new commits
+// instead of, or in addition to, receiving webhooks from its forge.
+type RepoPoll struct {
+	RepoID   int64             `json:"repo_id"   xorm:"pk 'repo_id'"`
+	NextPoll int64             `json:"next_poll" xorm:"INDEX 'next_poll'"`
+	LastPoll int64             `json:"last_poll" xorm:"'last_poll'"`
+	Branches map[string]string `json:"branches"  xorm:"json 'branches'"` // branch name -> last seen head commit
+	Pulls    map[string]string `json:"pulls"     xorm:"json 'pulls'"`    // pull request index -> last seen head commit
+	Failures int               `json:"failures"  xorm:"NOT NULL DEFAULT 0 'failures'"`
+	Error    string            `json:"error"     xorm:"TEXT 'error'"`
+} //	@name	RepoPoll
+
+// TableName returns the database table name for xorm.
+func (RepoPoll) TableName() string {
+	return "repo_polls"
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// Resources are the CPU, memory and disk requested by a workflow or
+// allocatable on an agent. Zero values are not set.
+type Resources struct {
+	CPU    int64 `json:"cpu"`    // in millicores
+	Memory int64 `json:"memory"` // in bytes
+	Disk   int64 `json:"disk"`   // in bytes
+} //	@name	Resources
+
+// IsZero reports whether no resources are set.
+func (r Resources) IsZero() bool {
+	return r == Resources{}
+}
+
+// Add returns the sum of both resources.
+func (r Resources) Add(other Resources) Resources {
+	return Resources{
+		CPU:    r.CPU + other.CPU,
+		Memory: r.Memory + other.Memory,
+		Disk:   r.Disk + other.Disk,
+	}
+}
+
+// Fits reports whether the request fits into the allocatable resources r
+// next to the resources already in use. Resources that are not set in r are
+// not limited.
+func (r Resources) Fits(used, request Resources) bool {
+	return fits(r.CPU, used.CPU, request.CPU) &&
+		fits(r.Memory, used.Memory, request.Memory) &&
+		fits(r.Disk, used.Disk, request.Disk)
+}
+
+func fits(allocatable, used, request int64) bool {
+	return allocatable <= 0 || used+request <= allocatable
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourcesFits(t *testing.T) {
+	allocatable := Resources{CPU: 4000, Memory: 8 << 30}
+
+	tests := []struct {
+		name    string
+		used    Resources
+		request Resources
+		fits    bool
+	}{
+		{name: "empty request", fits: true},
+		{name: "request fits", used: Resources{CPU: 1000}, request: Resources{CPU: 3000, Memory: 8 << 30}, fits: true},
+		{name: "cpu exceeded", used: Resources{CPU: 2000}, request: Resources{CPU: 2500}, fits: false},
+		{name: "memory exceeded", request: Resources{Memory: 9 << 30}, fits: false},
+		{name: "unset disk is not limited", request: Resources{Disk: 100 << 30}, fits: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.fits, allocatable.Fits(tc.used, tc.request))
+		})
+	}
+}
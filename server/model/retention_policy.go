@@ -0,0 +1,81 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "errors"
+
+var (
+	errRetentionPolicyNegative = errors.New("retention values must not be negative")
+	errRetentionPolicyEmpty    = errors.New("retention policy must keep a number of pipelines or limit the age of pipelines or logs")
+)
+
+// RetentionPolicy defines how long pipelines and their logs are kept. Like
+// secrets and registries a policy is defined for a single repo, for all repos
+// of an org or globally, the most specific policy of a repo applies.
+type RetentionPolicy struct {
+	ID     int64 `json:"id"      xorm:"pk autoincr 'id'"`
+	OrgID  int64 `json:"org_id"  xorm:"NOT NULL DEFAULT 0 UNIQUE(s) INDEX 'org_id'"`
+	RepoID int64 `json:"repo_id" xorm:"NOT NULL DEFAULT 0 UNIQUE(s) INDEX 'repo_id'"`
+	// KeepPipelines is the number of the latest pipelines kept per branch,
+	// tag or pull request.
+	KeepPipelines int `json:"keep_pipelines"    xorm:"'keep_pipelines'"`
+	// PipelineDays limits the deletion to pipelines older than that many
+	// days. Without KeepPipelines all pipelines older than that are deleted.
+	PipelineDays int `json:"pipeline_days"     xorm:"'pipeline_days'"`
+	// LogDays is the number of days the logs of a pipeline are kept after
+	// it finished.
+	LogDays int `json:"log_days"          xorm:"'log_days'"`
+	// KeepLastSuccess always keeps the latest successful pipeline per branch,
+	// tag or pull request together with its logs.
+	KeepLastSuccess bool  `json:"keep_last_success" xorm:"'keep_last_success'"`
+	Created         int64 `json:"created"           xorm:"created"`
+	Updated         int64 `json:"updated"           xorm:"updated"`
+} //	@name	RetentionPolicy
+
+// TableName return database table name for xorm.
+func (RetentionPolicy) TableName() string {
+	return "retention_policies"
+}
+
+// IsGlobal returns true if the policy applies to all repos.
+func (p RetentionPolicy) IsGlobal() bool {
+	return p.RepoID == 0 && p.OrgID == 0
+}
+
+// IsOrganization returns true if the policy applies to the repos of an org.
+func (p RetentionPolicy) IsOrganization() bool {
+	return p.RepoID == 0 && p.OrgID != 0
+}
+
+// IsRepository returns true if the policy applies to a single repo.
+func (p RetentionPolicy) IsRepository() bool {
+	return p.RepoID != 0 && p.OrgID == 0
+}
+
+// DeletesPipelines returns true if the policy deletes whole pipelines.
+func (p RetentionPolicy) DeletesPipelines() bool {
+	return p.KeepPipelines > 0 || p.PipelineDays > 0
+}
+
+// Validate validates the retention policy.
+func (p *RetentionPolicy) Validate() error {
+	switch {
+	case p.KeepPipelines < 0, p.PipelineDays < 0, p.LogDays < 0:
+		return errRetentionPolicyNegative
+	case !p.DeletesPipelines() && p.LogDays == 0:
+		return errRetentionPolicyEmpty
+	}
+	return nil
+}
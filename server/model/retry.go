@@ -0,0 +1,97 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// FailureClass tells why an attempt to run a workflow failed, if it failed
+// because of the infrastructure it ran on instead of one of its steps.
+type FailureClass string //	@name	FailureClass
+
+const (
+	// FailureAgentLost is set if the agent stopped to extend the lease of the workflow.
+	FailureAgentLost FailureClass = "agent_lost"
+	// FailureSetupError is set if the backend failed to set up the workflow.
+	FailureSetupError FailureClass = "setup_error"
+	// FailureOOM is set if a step was killed as it ran out of memory.
+	FailureOOM FailureClass = "oom"
+)
+
+// maxRetryDelay caps the growing backoff between two attempts.
+const maxRetryDelay = time.Hour
+
+// IsValid reports whether the failure class is known.
+func (f FailureClass) IsValid() bool {
+	switch f {
+	case FailureAgentLost, FailureSetupError, FailureOOM:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryPolicy defines how often and on which failures a workflow is attempted
+// to run.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int `json:"max_attempts"`
+	// Backoff is the delay before the second attempt in seconds, it is doubled
+	// for every further attempt.
+	Backoff int64          `json:"backoff"`
+	On      []FailureClass `json:"on"`
+} //	@name	RetryPolicy
+
+// Retries reports whether a workflow is attempted again after the given
+// attempt failed with failure.
+func (p *RetryPolicy) Retries(failure FailureClass, attempt int) bool {
+	if p == nil {
+		return false
+	}
+	return attempt < p.MaxAttempts && slices.Contains(p.On, failure)
+}
+
+// Delay returns the time to wait before the attempt following the given one.
+func (p *RetryPolicy) Delay(attempt int) time.Duration {
+	if p == nil || p.Backoff <= 0 || attempt < 1 {
+		return 0
+	}
+	delay := time.Duration(p.Backoff) * time.Second
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// WorkflowAttempt records a finished attempt to run a workflow.
+type WorkflowAttempt struct {
+	ID         int64        `json:"id"                xorm:"pk autoincr 'id'"`
+	PipelineID int64        `json:"pipeline_id"       xorm:"INDEX 'pipeline_id'"`
+	WorkflowID int64        `json:"workflow_id"       xorm:"INDEX 'workflow_id'"`
+	Attempt    int          `json:"attempt"           xorm:"'attempt'"`
+	AgentID    int64        `json:"agent_id"          xorm:"'agent_id'"`
+	State      StatusValue  `json:"state"             xorm:"'state'"`
+	Failure    FailureClass `json:"failure,omitempty" xorm:"'failure'"`
+	Error      string       `json:"error,omitempty"   xorm:"TEXT 'error'"`
+	Started    int64        `json:"started"           xorm:"'started'"`
+	Finished   int64        `json:"finished"          xorm:"'finished'"`
+} //	@name	WorkflowAttempt
+
+// TableName return database table name for xorm.
+func (WorkflowAttempt) TableName() string {
+	return "workflow_attempts"
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyRetries(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3, On: []FailureClass{FailureAgentLost}}
+
+	assert.True(t, policy.Retries(FailureAgentLost, 1))
+	assert.True(t, policy.Retries(FailureAgentLost, 2))
+	assert.False(t, policy.Retries(FailureAgentLost, 3), "attempts are exhausted")
+	assert.False(t, policy.Retries(FailureOOM, 1), "failure is not retried")
+
+	var none *RetryPolicy
+	assert.False(t, none.Retries(FailureAgentLost, 1))
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{Backoff: 10}
+
+	assert.Equal(t, 10*time.Second, policy.Delay(1))
+	assert.Equal(t, 20*time.Second, policy.Delay(2))
+	assert.Equal(t, 40*time.Second, policy.Delay(3))
+	assert.Equal(t, time.Hour, policy.Delay(20))
+	assert.Zero(t, (&RetryPolicy{}).Delay(1))
+}
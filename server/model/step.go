@@ -58,9 +58,10 @@ func (p *Step) Failing() bool {
 type StepType string //	@name	StepType
 
 const (
-	StepTypeClone    StepType = "clone"
-	StepTypeService  StepType = "service"
-	StepTypePlugin   StepType = "plugin"
-	StepTypeCommands StepType = "commands"
-	StepTypeCache    StepType = "cache"
+	StepTypeClone     StepType = "clone"
+	StepTypeService   StepType = "service"
+	StepTypePlugin    StepType = "plugin"
+	StepTypeCommands  StepType = "commands"
+	StepTypeCache     StepType = "cache"
+	StepTypeArtifacts StepType = "artifacts"
 )
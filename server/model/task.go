@@ -43,8 +43,33 @@ type Task struct {
 	// Created is the unix timestamp the task's pipeline was created at. It
 	// defines the queue ordering across pipelines.
 	Created int64 `json:"created" xorm:"NOT NULL DEFAULT 0 'created'"`
+	// Priority orders pending tasks in the queue: higher values are handed out
+	// first, tasks of equal priority keep their queue order.
+	Priority int `json:"priority" xorm:"NOT NULL DEFAULT 0 'priority'"`
+	// Resources are requested from the agent the task runs on, the task is only
+	// handed out to an agent with enough free resources left.
+	Resources Resources `json:"resources" xorm:"json 'resources'"`
+	// Attempt is the number of the current attempt to run the task, it is
+	// increased whenever the task is put back into the queue.
+	Attempt int `json:"attempt" xorm:"NOT NULL DEFAULT 0 'attempt'"`
+	// NotBefore is the unix timestamp in milliseconds until a task put back
+	// into the queue waits before it is handed out again.
+	NotBefore int64 `json:"not_before,omitempty" xorm:"NOT NULL DEFAULT 0 'not_before'"`
+	// State and Deadline track the lease of a task handed out by the database
+	// queue, the memory queue keeps them in memory instead.
+	State    TaskState `json:"-" xorm:"'state'"`
+	Deadline int64     `json:"-" xorm:"NOT NULL DEFAULT 0 'deadline'"`
 } //	@name	Task
 
+// TaskState is the state of a task in the database queue.
+type TaskState string
+
+const (
+	TaskStatePending  TaskState = "pending"
+	TaskStateRunning  TaskState = "running"
+	TaskStateCanceled TaskState = "canceled"
+)
+
 // TableName return database table name for xorm.
 func (Task) TableName() string {
 	return "tasks"
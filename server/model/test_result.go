@@ -0,0 +1,89 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TestStatus is the outcome of a test case.
+type TestStatus string //	@name	TestStatus
+
+const (
+	TestStatusPassed  TestStatus = "passed"
+	TestStatusFailed  TestStatus = "failed"  // an assertion of the test failed
+	TestStatusError   TestStatus = "error"   // the test could not be run to its end
+	TestStatusSkipped TestStatus = "skipped" // the test was not run at all
+)
+
+var ErrInvalidTestStatus = errors.New("invalid test status")
+
+func (s TestStatus) Validate() error {
+	switch s {
+	case TestStatusPassed, TestStatusFailed, TestStatusError, TestStatusSkipped:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidTestStatus, s)
+	}
+}
+
+// Failed reports whether the status counts as failed test.
+func (s TestStatus) Failed() bool {
+	return s == TestStatusFailed || s == TestStatusError
+}
+
+// TestResult is the result of a single test case, parsed from the test
+// reports uploaded by a step.
+type TestResult struct {
+	ID             int64  `json:"id"              xorm:"pk autoincr 'id'"`
+	RepoID         int64  `json:"-"               xorm:"INDEX 'repo_id'"`
+	PipelineID     int64  `json:"pipeline_id"     xorm:"INDEX 'pipeline_id'"`
+	PipelineNumber int64  `json:"pipeline_number" xorm:"INDEX 'pipeline_number'"`
+	StepID         int64  `json:"step_id"         xorm:"INDEX 'step_id'"`
+	Suite          string `json:"suite"           xorm:"TEXT 'suite'"`
+	Classname      string `json:"classname"       xorm:"TEXT 'classname'"`
+	Name           string `json:"name"            xorm:"TEXT 'name'"`
+	// Duration is the run time of the test in milliseconds.
+	Duration int64      `json:"duration"          xorm:"'duration'"`
+	Status   TestStatus `json:"status"            xorm:"'status'"`
+	Message  string     `json:"message,omitempty" xorm:"TEXT 'message'"`
+	Details  string     `json:"details,omitempty" xorm:"TEXT 'details'"`
+	// File and Line locate the test in the repo, if the report contains them.
+	File string `json:"file,omitempty" xorm:"TEXT 'file'"`
+	Line int    `json:"line,omitempty" xorm:"'line'"`
+
+	// History holds the status of the test in the previous pipelines of the
+	// repo, the latest first. It is only set by the API.
+	History []*TestRun `json:"history,omitempty" xorm:"-"`
+	// Flaky is set if the test passed and failed within its history.
+	Flaky bool `json:"flaky,omitempty" xorm:"-"`
+} //	@name	TestResult
+
+// TableName return database table name for xorm.
+func (TestResult) TableName() string {
+	return "test_results"
+}
+
+// Key identifies a test across pipelines.
+func (r *TestResult) Key() string {
+	return r.Suite + "\x00" + r.Classname + "\x00" + r.Name
+}
+
+// TestRun is the status of a test in a single pipeline.
+type TestRun struct {
+	PipelineNumber int64      `json:"pipeline_number"`
+	Status         TestStatus `json:"status"`
+} //	@name	TestRun
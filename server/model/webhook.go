@@ -0,0 +1,167 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+// WebhookTrigger is a state change an outgoing webhook can be subscribed to.
+type WebhookTrigger string //	@name	WebhookTrigger
+
+const (
+	WebhookTriggerPipelineCreated  WebhookTrigger = "pipeline_created"
+	WebhookTriggerPipelineStarted  WebhookTrigger = "pipeline_started"
+	WebhookTriggerPipelineFinished WebhookTrigger = "pipeline_finished"
+	WebhookTriggerWorkflowFinished WebhookTrigger = "workflow_finished"
+	WebhookTriggerApprovalRequired WebhookTrigger = "approval_required"
+	WebhookTriggerAgentOffline     WebhookTrigger = "agent_offline"
+)
+
+var webhookTriggers = []WebhookTrigger{
+	WebhookTriggerPipelineCreated,
+	WebhookTriggerPipelineStarted,
+	WebhookTriggerPipelineFinished,
+	WebhookTriggerWorkflowFinished,
+	WebhookTriggerApprovalRequired,
+	WebhookTriggerAgentOffline,
+}
+
+var (
+	errWebhookNameEmpty     = errors.New("webhook name must not be empty")
+	errWebhookEventsEmpty   = errors.New("webhook must be subscribed to at least one event")
+	errWebhookInvalidURL    = errors.New("webhook url must be an absolute http or https url")
+	errWebhookInvalidEvents = fmt.Errorf("webhook events must be out of %v", webhookTriggers)
+)
+
+// Webhook sends the state changes of pipelines and agents to an external
+// service. Like secrets and registries a webhook is defined for a single
+// repo, for all repos of an org or globally.
+type Webhook struct {
+	ID      int64            `json:"id"      xorm:"pk autoincr 'id'"`
+	OrgID   int64            `json:"org_id"  xorm:"NOT NULL DEFAULT 0 INDEX 'org_id'"`
+	RepoID  int64            `json:"repo_id" xorm:"NOT NULL DEFAULT 0 INDEX 'repo_id'"`
+	Name    string           `json:"name"    xorm:"VARCHAR(250) 'name'"`
+	URL     string           `json:"url"     xorm:"VARCHAR(500) 'url'"`
+	Events  []WebhookTrigger `json:"events"  xorm:"json 'events'"`
+	Enabled bool             `json:"enabled" xorm:"'enabled'"`
+	Created int64            `json:"created" xorm:"created"`
+	Updated int64            `json:"updated" xorm:"updated"`
+} //	@name	Webhook
+
+// TableName return database table name for xorm.
+func (Webhook) TableName() string {
+	return "webhooks"
+}
+
+// IsGlobal returns true if the webhook is sent for all repos and agents.
+func (w Webhook) IsGlobal() bool {
+	return w.RepoID == 0 && w.OrgID == 0
+}
+
+// IsOrganization returns true if the webhook is sent for the repos and
+// agents of an org.
+func (w Webhook) IsOrganization() bool {
+	return w.RepoID == 0 && w.OrgID != 0
+}
+
+// IsRepository returns true if the webhook is sent for a single repo.
+func (w Webhook) IsRepository() bool {
+	return w.RepoID != 0 && w.OrgID == 0
+}
+
+// Subscribed returns true if the webhook is sent for the given event.
+func (w Webhook) Subscribed(event WebhookTrigger) bool {
+	return slices.Contains(w.Events, event)
+}
+
+// Validate validates the webhook.
+func (w *Webhook) Validate() error {
+	if w.Name == "" {
+		return errWebhookNameEmpty
+	}
+
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errWebhookInvalidURL
+	}
+
+	if len(w.Events) == 0 {
+		return errWebhookEventsEmpty
+	}
+	for _, event := range w.Events {
+		if !slices.Contains(webhookTriggers, event) {
+			return errWebhookInvalidEvents
+		}
+	}
+
+	return nil
+}
+
+type WebhookPatch struct {
+	Name    *string          `json:"name"`
+	URL     *string          `json:"url"`
+	Events  []WebhookTrigger `json:"events"`
+	Enabled *bool            `json:"enabled"`
+} //	@name	WebhookPatch
+
+// WebhookDeliveryStatus is the state of a webhook delivery.
+type WebhookDeliveryStatus string //	@name	WebhookDeliveryStatus
+
+const (
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	WebhookDeliverySuccess WebhookDeliveryStatus = "success"
+	WebhookDeliveryFailure WebhookDeliveryStatus = "failure"
+)
+
+// WebhookDelivery is a single event sent to a webhook. Failed attempts are
+// retried until the delivery succeeds or runs out of attempts.
+type WebhookDelivery struct {
+	ID        int64                 `json:"id"         xorm:"pk autoincr 'id'"`
+	WebhookID int64                 `json:"webhook_id" xorm:"INDEX 'webhook_id'"`
+	Event     WebhookTrigger        `json:"event"      xorm:"VARCHAR(50) 'event'"`
+	Payload   string                `json:"payload"    xorm:"LONGTEXT 'payload'"`
+	Status    WebhookDeliveryStatus `json:"status"     xorm:"VARCHAR(20) INDEX 'status'"`
+	Attempts  int                   `json:"attempts"   xorm:"'attempts'"`
+	// ResponseCode is the http status code of the last attempt, 0 if the
+	// webhook could not be reached.
+	ResponseCode int    `json:"response_code" xorm:"'response_code'"`
+	Error        string `json:"error"         xorm:"TEXT 'error'"`
+	// NextAttempt is the unix time a pending delivery is sent next.
+	NextAttempt int64 `json:"next_attempt" xorm:"INDEX 'next_attempt'"`
+	// Delivered is the unix time of the last attempt.
+	Delivered int64 `json:"delivered" xorm:"'delivered'"`
+	Created   int64 `json:"created"   xorm:"created INDEX"`
+} //	@name	WebhookDelivery
+
+// TableName return database table name for xorm.
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+// WebhookPayload is the body sent to webhooks. Depending on the event it
+// holds the pipeline and the workflow or the agent.
+type WebhookPayload struct {
+	Event    WebhookTrigger `json:"event"`
+	Repo     *Repo          `json:"repo,omitempty"`
+	Pipeline *APIPipeline   `json:"pipeline,omitempty"`
+	Workflow *Workflow      `json:"workflow,omitempty"`
+	Agent    *Agent         `json:"agent,omitempty"`
+	// URL links to the pipeline or workflow in the web UI.
+	URL string `json:"url,omitempty"`
+} //	@name	WebhookPayload
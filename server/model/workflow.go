@@ -29,6 +29,8 @@ type Workflow struct {
 	Platform   string            `json:"platform,omitempty"   xorm:"platform"`
 	Environ    map[string]string `json:"environ,omitempty"    xorm:"json 'environ'"`
 	AxisID     int               `json:"-"                    xorm:"axis_id"`
+	Attempt    int               `json:"attempt,omitempty"    xorm:"NOT NULL DEFAULT 0 'attempt'"`
+	Retry      *RetryPolicy      `json:"retry,omitempty"      xorm:"json 'retry'"`
 	Children   []*Step           `json:"children,omitempty"   xorm:"-"`
 }
 
@@ -37,6 +39,12 @@ func (Workflow) TableName() string {
 	return "workflows"
 }
 
+// CurrentAttempt returns the number of the current attempt, workflows created
+// before attempts were counted are on their first one.
+func (p *Workflow) CurrentAttempt() int {
+	return max(p.Attempt, 1)
+}
+
 // Running returns true if the process state is pending or running.
 func (p *Workflow) Running() bool {
 	return p.State == StatusPending || p.State == StatusRunning
@@ -81,7 +81,7 @@ func Cancel(ctx context.Context, _forge forge.Forge, store store.Store, repo *mo
 		return err
 	}
 
-	updatePipelineStatus(ctx, _forge, killedPipeline, repo, user)
+	updatePipelineStatus(ctx, _forge, store, killedPipeline, repo, user)
 
 	if killedPipeline.Workflows, err = store.WorkflowGetTree(killedPipeline); err != nil {
 		return err
@@ -90,6 +90,7 @@ func Cancel(ctx context.Context, _forge forge.Forge, store store.Store, repo *mo
 	if err := server.Config.Services.Scheduler.PublishPipelineEvent(ctx, repo, killedPipeline); err != nil {
 		log.Error().Err(err).Msg("could not push pipeline status change to pubsub provider")
 	}
+	server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineFinished, repo, killedPipeline, nil)
 
 	return nil
 }
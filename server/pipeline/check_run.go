@@ -0,0 +1,283 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	// maxAnnotations limits the annotations of a check run, forges show only
+	// a few of them anyway (GitHub accepts 50 per request).
+	maxAnnotations = 50
+
+	// maxAnnotationMessageSize limits the message of an annotation, the full
+	// output is part of the pipeline anyway.
+	maxAnnotationMessageSize = 4096
+)
+
+// UpdateForgeStatus reports the state of the workflow to the forge. Forges
+// supporting check runs get a detailed report of the workflow, the others a
+// plain status.
+func UpdateForgeStatus(ctx context.Context, _store store.Store, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) error {
+	if checkRunner, ok := _forge.(forge.CheckRunner); ok {
+		run, err := buildCheckRun(_store, pipeline, workflow)
+		if err != nil {
+			return err
+		}
+		err = checkRunner.CheckRun(ctx, user, repo, pipeline, workflow, run)
+		if !errors.Is(err, forge_types.ErrNotImplemented) {
+			return err
+		}
+	}
+
+	return _forge.Status(ctx, user, repo, pipeline, workflow)
+}
+
+// reportConfigErrors reports the errors of a pipeline that failed before any
+// workflow was created, e.g. on linter errors, to forges supporting check runs.
+// Each config file with errors is reported like a workflow.
+func reportConfigErrors(ctx context.Context, _store store.Store, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline) error {
+	checkRunner, ok := _forge.(forge.CheckRunner)
+	if !ok {
+		return nil
+	}
+
+	reported := make(map[string]bool)
+	for _, pipelineErr := range pipeline.Errors {
+		file, _ := errorLocation(pipelineErr)
+		if file == "" || reported[file] {
+			continue
+		}
+		reported[file] = true
+
+		workflow := &model.Workflow{
+			Name:  builder.SanitizePath(file),
+			State: pipeline.Status,
+		}
+		run, err := buildCheckRun(_store, pipeline, workflow)
+		if err != nil {
+			return err
+		}
+		err = checkRunner.CheckRun(ctx, user, repo, pipeline, workflow, run)
+		if err != nil && !errors.Is(err, forge_types.ErrNotImplemented) {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildCheckRun summarizes the steps of the workflow and collects the config
+// errors and failed tests concerning it.
+func buildCheckRun(_store store.Store, pipeline *model.Pipeline, workflow *model.Workflow) (*forge_types.CheckRun, error) {
+	run := &forge_types.CheckRun{
+		Title: common.GetPipelineStatusDescription(workflow.State),
+	}
+
+	steps := workflow.Children
+	if len(steps) == 0 && workflow.ID != 0 {
+		var err error
+		if steps, err = _store.StepListFromWorkflowFind(workflow); err != nil {
+			return nil, err
+		}
+	}
+	run.Summary = stepSummary(workflow, steps)
+
+	var details []string
+	var configErrors, failedTests int
+
+	for _, pipelineErr := range pipeline.Errors {
+		file, line := errorLocation(pipelineErr)
+		if file == "" || builder.SanitizePath(file) != workflow.Name {
+			continue
+		}
+		level := forge_types.AnnotationLevelFailure
+		if pipelineErr.IsWarning {
+			level = forge_types.AnnotationLevelWarning
+		} else {
+			configErrors++
+		}
+		run.Annotations = append(run.Annotations, &forge_types.Annotation{
+			Path:    file,
+			Line:    max(line, 1),
+			Level:   level,
+			Title:   string(pipelineErr.Type),
+			Message: pipelineErr.Message,
+		})
+	}
+
+	// test results are complete once the workflow is done
+	if workflow.ID != 0 && !workflow.Running() {
+		results, err := _store.TestResultList(pipeline)
+		if err != nil {
+			return nil, err
+		}
+		stepIDs := make(map[int64]bool, len(steps))
+		for _, step := range steps {
+			stepIDs[step.ID] = true
+		}
+		for _, result := range results {
+			if !stepIDs[result.StepID] || !result.Status.Failed() {
+				continue
+			}
+			failedTests++
+			name := testName(result)
+			details = append(details, fmt.Sprintf("- `%s`: %s", name, firstLine(result.Message)))
+			if result.File == "" {
+				continue
+			}
+			message := result.Message
+			if result.Details != "" {
+				message = strings.TrimSpace(message + "\n\n" + result.Details)
+			}
+			run.Annotations = append(run.Annotations, &forge_types.Annotation{
+				Path:    result.File,
+				Line:    max(result.Line, 1),
+				Level:   forge_types.AnnotationLevelFailure,
+				Title:   name,
+				Message: truncate(message, maxAnnotationMessageSize),
+			})
+		}
+	}
+
+	var counts []string
+	if configErrors > 0 {
+		counts = append(counts, plural(configErrors, "config error"))
+	}
+	if failedTests > 0 {
+		counts = append(counts, plural(failedTests, "failed test"))
+		run.Text = "### Failed tests\n\n" + strings.Join(details, "\n")
+	}
+	if len(counts) > 0 {
+		run.Title += ": " + strings.Join(counts, ", ")
+	}
+
+	// forges would add annotations again with every update of a running workflow
+	done := !workflow.Running() && workflow.State != model.StatusBlocked && workflow.State != model.StatusCreated
+	if !done {
+		run.Annotations = nil
+	}
+	if len(run.Annotations) > maxAnnotations {
+		run.Annotations = run.Annotations[:maxAnnotations]
+	}
+	return run, nil
+}
+
+// stepSummary returns a markdown table of the steps of the workflow.
+func stepSummary(workflow *model.Workflow, steps []*model.Step) string {
+	var summary strings.Builder
+	if workflow.Error != "" {
+		fmt.Fprintf(&summary, "%s\n\n", workflow.Error)
+	}
+	if len(steps) == 0 {
+		return summary.String()
+	}
+
+	summary.WriteString("| Step | Status | Duration |\n| --- | --- | --- |\n")
+	for _, step := range steps {
+		status := string(step.State)
+		if step.Error != "" {
+			status += ": " + firstLine(step.Error)
+		} else if step.ExitCode != 0 {
+			status += fmt.Sprintf(" (exit code %d)", step.ExitCode)
+		}
+		fmt.Fprintf(&summary, "| %s | %s | %s |\n", escapeTableCell(step.Name), escapeTableCell(status), formatDuration(step.Started, step.Finished))
+	}
+	return summary.String()
+}
+
+// errorLocation returns the file and line a pipeline error refers to. The
+// error data is either typed or, when loaded from the store, a map.
+func errorLocation(pipelineErr *pipeline_errors.PipelineError) (string, int) {
+	switch pipelineErr.Type {
+	case pipeline_errors.PipelineErrorTypeLinter, pipeline_errors.PipelineErrorTypeDeprecation, pipeline_errors.PipelineErrorTypeBadHabit:
+	default:
+		return "", 0
+	}
+
+	raw, err := json.Marshal(pipelineErr.Data)
+	if err != nil {
+		return "", 0
+	}
+	var location struct {
+		File string `json:"file"`
+		Line int    `json:"line"`
+	}
+	if json.Unmarshal(raw, &location) != nil {
+		return "", 0
+	}
+	return location.File, location.Line
+}
+
+// formatDuration returns the duration between the unix timestamps, or nothing
+// if it hasn't finished yet.
+func formatDuration(started, finished int64) string {
+	if started == 0 || finished < started {
+		return ""
+	}
+	return (time.Duration(finished-started) * time.Second).String()
+}
+
+func testName(result *model.TestResult) string {
+	name := result.Name
+	if result.Classname != "" {
+		name = result.Classname + "." + name
+	}
+	if result.Suite != "" {
+		name = result.Suite + " / " + name
+	}
+	return name
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return line
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+func escapeTableCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
+
+// truncate cuts s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
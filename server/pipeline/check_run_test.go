@@ -0,0 +1,167 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestBuildCheckRun(t *testing.T) {
+	t.Parallel()
+
+	mockStore := store_mocks.NewMockStore(t)
+
+	pipeline := &model.Pipeline{
+		ID: 1,
+		Errors: []*pipeline_errors.PipelineError{
+			{
+				Type:    pipeline_errors.PipelineErrorTypeLinter,
+				Message: "Invalid or missing image",
+				// as loaded from the store
+				Data: map[string]any{"file": ".woodpecker/test.yaml", "field": "steps.test", "line": float64(3)},
+			},
+			{
+				Type:      pipeline_errors.PipelineErrorTypeBadHabit,
+				Message:   "Consider adding a `when` block",
+				IsWarning: true,
+				Data:      pipeline_errors.BadHabitErrorData{File: ".woodpecker/test.yaml", Field: "steps.test"},
+			},
+			{
+				Type:    pipeline_errors.PipelineErrorTypeLinter,
+				Message: "error of another workflow",
+				Data:    &pipeline_errors.LinterErrorData{File: ".woodpecker/build.yaml", Line: 1},
+			},
+		},
+	}
+	workflow := &model.Workflow{
+		ID:    2,
+		Name:  "test",
+		State: model.StatusFailure,
+		Children: []*model.Step{
+			{ID: 3, Name: "clone", State: model.StatusSuccess, Started: 100, Finished: 102},
+			{ID: 4, Name: "test", State: model.StatusFailure, ExitCode: 1, Started: 102, Finished: 172},
+		},
+	}
+	mockStore.On("TestResultList", pipeline).Return([]*model.TestResult{
+		{StepID: 4, Suite: "api", Classname: "auth", Name: "TestLogin", Status: model.TestStatusFailed, Message: "expected 200", File: "api/auth_test.go", Line: 42},
+		{StepID: 4, Classname: "auth", Name: "TestLogout", Status: model.TestStatusError, Message: "panic\nstack"},
+		{StepID: 4, Classname: "auth", Name: "TestToken", Status: model.TestStatusPassed},
+		{StepID: 9, Classname: "other", Name: "TestOtherWorkflow", Status: model.TestStatusFailed},
+	}, nil)
+
+	run, err := buildCheckRun(mockStore, pipeline, workflow)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Pipeline failed: 1 config error, 2 failed tests", run.Title)
+	assert.Equal(t, "| Step | Status | Duration |\n| --- | --- | --- |\n"+
+		"| clone | success | 2s |\n"+
+		"| test | failure (exit code 1) | 1m10s |\n", run.Summary)
+	assert.Equal(t, "### Failed tests\n\n- `api / auth.TestLogin`: expected 200\n- `auth.TestLogout`: panic", run.Text)
+	assert.Equal(t, []*forge_types.Annotation{
+		{Path: ".woodpecker/test.yaml", Line: 3, Level: forge_types.AnnotationLevelFailure, Title: "linter", Message: "Invalid or missing image"},
+		{Path: ".woodpecker/test.yaml", Line: 1, Level: forge_types.AnnotationLevelWarning, Title: "bad_habit", Message: "Consider adding a `when` block"},
+		{Path: "api/auth_test.go", Line: 42, Level: forge_types.AnnotationLevelFailure, Title: "api / auth.TestLogin", Message: "expected 200"},
+	}, run.Annotations)
+}
+
+func TestBuildCheckRunRunning(t *testing.T) {
+	t.Parallel()
+
+	pipeline := &model.Pipeline{
+		ID: 1,
+		Errors: []*pipeline_errors.PipelineError{{
+			Type:      pipeline_errors.PipelineErrorTypeLinter,
+			Message:   "Should not configure both `environment` and `settings`",
+			IsWarning: true,
+			Data:      &pipeline_errors.LinterErrorData{File: ".woodpecker.yaml", Line: 2},
+		}},
+	}
+	workflow := &model.Workflow{
+		ID:       2,
+		Name:     "woodpecker",
+		State:    model.StatusRunning,
+		Children: []*model.Step{{ID: 3, Name: "clone", State: model.StatusRunning}},
+	}
+
+	// test results are only looked up once the workflow is done
+	run, err := buildCheckRun(store_mocks.NewMockStore(t), pipeline, workflow)
+	require.NoError(t, err)
+	assert.Equal(t, "Pipeline is running", run.Title)
+	assert.Empty(t, run.Annotations)
+}
+
+func TestUpdateForgeStatusFallback(t *testing.T) {
+	t.Parallel()
+
+	mockForge := forge_mocks.NewMockForge(t)
+	user := &model.User{ID: 1}
+	repo := &model.Repo{ID: 1}
+	pipeline := &model.Pipeline{ID: 1}
+	workflow := &model.Workflow{ID: 2, State: model.StatusSuccess}
+
+	// forges without check runs don't need the report
+	mockForge.On("Status", mock.Anything, user, repo, pipeline, workflow).Return(nil)
+
+	assert.NoError(t, UpdateForgeStatus(t.Context(), store_mocks.NewMockStore(t), mockForge, user, repo, pipeline, workflow))
+}
+
+// checkRunForge is a forge supporting check runs.
+type checkRunForge struct {
+	*forge_mocks.MockForge
+	err error
+	run *forge_types.CheckRun
+}
+
+func (f *checkRunForge) CheckRun(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline, _ *model.Workflow, run *forge_types.CheckRun) error {
+	f.run = run
+	return f.err
+}
+
+func TestUpdateForgeStatusCheckRun(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{ID: 1}
+	repo := &model.Repo{ID: 1}
+	pipeline := &model.Pipeline{ID: 1}
+	workflow := &model.Workflow{ID: 2, State: model.StatusPending, Children: []*model.Step{{ID: 3, Name: "test", State: model.StatusPending}}}
+
+	t.Run("check run", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &checkRunForge{MockForge: forge_mocks.NewMockForge(t)}
+		assert.NoError(t, UpdateForgeStatus(t.Context(), store_mocks.NewMockStore(t), _forge, user, repo, pipeline, workflow))
+		require.NotNil(t, _forge.run)
+		assert.Equal(t, "Pipeline is pending", _forge.run.Title)
+	})
+
+	t.Run("not configured for check runs", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &checkRunForge{MockForge: forge_mocks.NewMockForge(t), err: forge_types.ErrNotImplemented}
+		_forge.On("Status", mock.Anything, user, repo, pipeline, workflow).Return(nil)
+		assert.NoError(t, UpdateForgeStatus(t.Context(), store_mocks.NewMockStore(t), _forge, user, repo, pipeline, workflow))
+	})
+}
@@ -61,6 +61,10 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 	// the pipeline.
 	forge.Refresh(ctx, _forge, _store, repoUser)
 
+	if err := ClosePullComment(ctx, _forge, repoUser, repo, pipeline); err != nil {
+		log.Error().Err(err).Str("repo", repo.FullName).Msg("failed to close pull request comment")
+	}
+
 	// update some pipeline fields
 	pipeline.RepoID = repo.ID
 	pipeline.Status = model.StatusCreated
@@ -130,9 +134,11 @@ func Create(ctx context.Context, _store store.Store, repo *model.Repo, pipeline
 		return nil, ErrFiltered
 	}
 
-	publishPipeline(ctx, _forge, pipeline, repo, repoUser)
+	publishPipeline(ctx, _forge, _store, pipeline, repo, repoUser)
+	server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineCreated, repo, pipeline, nil)
 
 	if pipeline.Status == model.StatusBlocked {
+		server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerApprovalRequired, repo, pipeline, nil)
 		return pipeline, nil
 	}
 
@@ -158,7 +164,8 @@ func updatePipelineWithErr(ctx context.Context, _forge forge.Forge, _store store
 	// update value in ref
 	*pipeline = *_pipeline
 
-	publishPipeline(ctx, _forge, pipeline, repo, repoUser)
+	publishPipeline(ctx, _forge, _store, pipeline, repo, repoUser)
+	server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineFinished, repo, pipeline, nil)
 
 	return nil
 }
@@ -171,7 +178,7 @@ func updatePipelinePending(ctx context.Context, _forge forge.Forge, _store store
 	// update value in ref
 	*pipeline = *_pipeline
 
-	publishPipeline(ctx, _forge, pipeline, repo, repoUser)
+	publishPipeline(ctx, _forge, _store, pipeline, repo, repoUser)
 
 	return nil
 }
@@ -62,11 +62,12 @@ func Decline(ctx context.Context, store store.Store, pipeline *model.Pipeline, u
 		}
 	}
 
-	updatePipelineStatus(ctx, forge, pipeline, repo, user)
+	updatePipelineStatus(ctx, forge, store, pipeline, repo, user)
 
 	if err := server.Config.Services.Scheduler.PublishPipelineEvent(ctx, repo, pipeline); err != nil {
 		log.Error().Err(err).Msg("could not push pipeline status change to pubsub provider")
 	}
+	server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineFinished, repo, pipeline, nil)
 
 	return pipeline, nil
 }
@@ -21,14 +21,40 @@ import (
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 )
 
-func updatePipelineStatus(ctx context.Context, forge forge.Forge, pipeline *model.Pipeline, repo *model.Repo, user *model.User) {
+func updatePipelineStatus(ctx context.Context, forge forge.Forge, store store.Store, pipeline *model.Pipeline, repo *model.Repo, user *model.User) {
 	for _, workflow := range pipeline.Workflows {
-		err := forge.Status(ctx, user, repo, pipeline, workflow)
+		err := UpdateForgeStatus(ctx, store, forge, user, repo, pipeline, workflow)
 		if err != nil {
 			log.Error().Err(err).Msgf("error setting commit status for %s/%d", repo.FullName, pipeline.Number)
-			return
+			break
 		}
 	}
+
+	if len(pipeline.Workflows) == 0 && len(pipeline.Errors) != 0 {
+		if err := reportConfigErrors(ctx, store, forge, user, repo, pipeline); err != nil {
+			log.Error().Err(err).Msgf("error reporting config errors for %s/%d", repo.FullName, pipeline.Number)
+		}
+	}
+
+	if err := UpdatePullComment(ctx, forge, user, repo, pipeline); err != nil {
+		log.Error().Err(err).Msgf("error updating pull request comment for %s/%d", repo.FullName, pipeline.Number)
+	}
+}
+
+// IsDuplicate reports whether the repo has a pipeline for the same event,
+// branch and commit already. It prevents running a commit twice for repos
+// that receive it both by webhook and by polling the forge.
+func IsDuplicate(_store store.Store, repo *model.Repo, pipeline *model.Pipeline) (bool, error) {
+	pipelines, err := _store.GetPipelineList(repo, &model.ListOptionsWithAll{ListOptions: &model.ListOptions{Page: 1, PerPage: 1}}, &model.PipelineFilter{
+		Branch: pipeline.Branch,
+		Commit: pipeline.Commit,
+		Events: []model.WebhookEvent{pipeline.Event},
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(pipelines) != 0, nil
 }
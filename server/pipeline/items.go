@@ -19,8 +19,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.uber.org/multierr"
 
 	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
@@ -181,6 +183,9 @@ func createPipelineItems(ctx context.Context, forge forge.Forge, store store.Sto
 	yamls []*forge_types.FileMeta, envs map[string]string, replaceExisting bool,
 ) (pipeline *model.Pipeline, items []*builder.Item, parseErr, err error) {
 	pipelineItems, parseErr := parsePipeline(ctx, forge, store, currentPipeline, user, repo, yamls, envs)
+	if !pipeline_errors.HasBlockingErrors(parseErr) {
+		parseErr = multierr.Append(parseErr, checkResources(store, repo, pipelineItems))
+	}
 	if pipeline_errors.HasBlockingErrors(parseErr) {
 		return currentPipeline, nil, parseErr, nil
 	}
@@ -265,6 +270,8 @@ func workflowsFromPipelineBuilder(pipeline *model.Pipeline, pipelineItems []*bui
 			State:      model.StatusPending,
 			Environ:    item.Workflow.Environ,
 			AxisID:     item.Workflow.AxisID,
+			Attempt:    1,
+			Retry:      workflowRetryPolicy(item),
 		}
 
 		if pipeline.Status == model.StatusBlocked {
@@ -304,3 +311,30 @@ func setPipelineItemWorkflowIDs(pipelineItems []*builder.Item, workflows []*mode
 		pipelineItems[i].Workflow.ID = wf.ID
 	}
 }
+
+// workflowRetryPolicy returns the retry policy of a workflow. A workflow can
+// lower the number of attempts the server allows and choose its own backoff
+// and failures to retry on.
+func workflowRetryPolicy(item *builder.Item) *model.RetryPolicy {
+	policy := &model.RetryPolicy{
+		MaxAttempts: server.Config.Pipeline.MaxWorkflowAttempts,
+		Backoff:     int64(server.Config.Pipeline.WorkflowRetryBackoff / time.Second),
+		On:          []model.FailureClass{model.FailureAgentLost, model.FailureSetupError},
+	}
+
+	if item.Retry.Attempts > 0 {
+		policy.MaxAttempts = min(item.Retry.Attempts, policy.MaxAttempts)
+	}
+	if item.Retry.Backoff > 0 {
+		policy.Backoff = int64(item.Retry.Backoff / time.Second)
+	}
+	if len(item.Retry.On) > 0 {
+		policy.On = nil
+		for _, on := range item.Retry.On {
+			if failure := model.FailureClass(on); failure.IsValid() {
+				policy.On = append(policy.On, failure)
+			}
+		}
+	}
+	return policy
+}
@@ -16,6 +16,7 @@ package pipeline
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -23,6 +24,7 @@ import (
 
 	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types" //nolint:depguard // needed to construct builder.Item.Config in tests; will be resolved when backend-specific fields move to BackendOptions (see enrichPipelineItemSteps TODO)
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
+	yaml_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
 	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
@@ -214,3 +216,33 @@ steps:
 	assert.Equal(t, "bar", step.Environment["FOO"])
 	assert.Equal(t, "secret world", step.Environment["HELLO"])
 }
+
+func TestWorkflowRetryPolicy(t *testing.T) {
+	server.Config.Pipeline.MaxWorkflowAttempts = 3
+	server.Config.Pipeline.WorkflowRetryBackoff = 10 * time.Second
+	t.Cleanup(func() {
+		server.Config.Pipeline.MaxWorkflowAttempts = 0
+		server.Config.Pipeline.WorkflowRetryBackoff = 0
+	})
+
+	policy := workflowRetryPolicy(&builder.Item{})
+	assert.Equal(t, &model.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     10,
+		On:          []model.FailureClass{model.FailureAgentLost, model.FailureSetupError},
+	}, policy)
+
+	policy = workflowRetryPolicy(&builder.Item{Retry: yaml_types.Retry{
+		Attempts: 5,
+		Backoff:  time.Minute,
+		On:       []string{"oom", "unknown"},
+	}})
+	assert.Equal(t, &model.RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     60,
+		On:          []model.FailureClass{model.FailureOOM},
+	}, policy, "the server limits the attempts")
+
+	policy = workflowRetryPolicy(&builder.Item{Retry: yaml_types.Retry{Attempts: 1}})
+	assert.Equal(t, 1, policy.MaxAttempts)
+}
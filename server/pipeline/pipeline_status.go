@@ -46,7 +46,7 @@ func UpdateToStatusPending(store store.Store, pipeline model.Pipeline, reviewer
 		pipeline.Reviewed = time.Now().Unix()
 	}
 	pipeline.Status = model.StatusPending
-	return &pipeline, store.UpdatePipeline(&pipeline)
+	return &pipeline, updatePipelineWithErrors(store, &pipeline)
 }
 
 func UpdateToStatusDeclined(store store.Store, pipeline model.Pipeline, reviewer string) (*model.Pipeline, error) {
@@ -67,7 +67,7 @@ func UpdateToStatusError(store store.Store, pipeline model.Pipeline, err error)
 	pipeline.Status = model.StatusError
 	pipeline.Started = time.Now().Unix()
 	pipeline.Finished = pipeline.Started
-	return &pipeline, store.UpdatePipeline(&pipeline)
+	return &pipeline, updatePipelineWithErrors(store, &pipeline)
 }
 
 func UpdateToStatusKilled(store store.Store, pipeline model.Pipeline, cancelInfo *model.CancelInfo, state model.StatusValue) (*model.Pipeline, error) {
@@ -76,3 +76,12 @@ func UpdateToStatusKilled(store store.Store, pipeline model.Pipeline, cancelInfo
 	pipeline.CancelInfo = cancelInfo
 	return &pipeline, store.UpdatePipeline(&pipeline)
 }
+
+// updatePipelineWithErrors also writes the errors found while parsing the
+// config, which only happens before the pipeline runs.
+func updatePipelineWithErrors(store store.Store, pipeline *model.Pipeline) error {
+	if err := store.UpdatePipeline(pipeline); err != nil {
+		return err
+	}
+	return store.UpdatePipelineErrors(pipeline)
+}
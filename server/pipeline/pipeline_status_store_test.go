@@ -0,0 +1,61 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
+)
+
+func TestUpdateStatusToDoneKeepsAppendedErrors(t *testing.T) {
+	s := datastore.NewTestStore(t)
+
+	require.NoError(t, s.CreateRepo(&model.Repo{Owner: "owner", Name: "repo", FullName: "owner/repo", ForgeRemoteID: "1"}))
+	pipeline := &model.Pipeline{RepoID: 1, Status: model.StatusRunning, Errors: []*pipeline_errors.PipelineError{{Message: "linter", IsWarning: true}}}
+	require.NoError(t, s.CreatePipeline(pipeline))
+
+	// the step logs are checked in the background while the last workflow
+	// finishes with the pipeline it loaded before
+	current, err := s.GetPipeline(pipeline.ID)
+	require.NoError(t, err)
+	_, err = s.AppendPipelineErrors(pipeline.ID, []*pipeline_errors.PipelineError{{Type: pipeline_errors.PipelineErrorTypeSecretLeak, IsWarning: true}})
+	require.NoError(t, err)
+
+	_, err = UpdateStatusToDone(s, *current, model.StatusSuccess, 1)
+	require.NoError(t, err)
+
+	got, err := s.GetPipeline(pipeline.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusSuccess, got.Status)
+	require.Len(t, got.Errors, 2)
+	assert.Equal(t, pipeline_errors.PipelineErrorTypeSecretLeak, got.Errors[1].Type)
+
+	t.Run("errors set before the run replace the stored ones", func(t *testing.T) {
+		_, err := UpdateToStatusPending(s, model.Pipeline{ID: pipeline.ID, RepoID: 1}, "")
+		require.NoError(t, err)
+
+		got, err := s.GetPipeline(pipeline.ID)
+		require.NoError(t, err)
+		assert.Empty(t, got.Errors)
+	})
+}
@@ -31,6 +31,7 @@ import (
 func mockStorePipeline(t *testing.T) store.Store {
 	s := mocks.NewMockStore(t)
 	s.On("UpdatePipeline", mock.Anything).Return(nil)
+	s.On("UpdatePipelineErrors", mock.Anything).Return(nil).Maybe()
 	return s
 }
 
@@ -0,0 +1,102 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge/common"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// UpdatePullComment updates the summary comment on the pull request of the
+// pipeline, if the repo enabled it and the forge supports it.
+func UpdatePullComment(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline) error {
+	if !repo.PRComment || !pipeline.IsPullRequest() || pipeline.Event == model.EventPullClosed {
+		return nil
+	}
+	commenter, ok := _forge.(forge.PullCommenter)
+	if !ok {
+		return nil
+	}
+	return commenter.PullComment(ctx, user, repo, pipeline, common.PullCommentBody(pipeline, pullCommentSummary(repo, pipeline)))
+}
+
+// ClosePullComment collapses or removes the summary comment on the pull
+// request closed by the pipeline's event.
+func ClosePullComment(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, pipeline *model.Pipeline) error {
+	if !repo.PRComment || pipeline.Event != model.EventPullClosed {
+		return nil
+	}
+	commenter, ok := _forge.(forge.PullCommenter)
+	if !ok {
+		return nil
+	}
+	return commenter.ClosePullComment(ctx, user, repo, pipeline)
+}
+
+// pullCommentSummary returns the markdown summarizing the pipeline and its
+// workflows.
+func pullCommentSummary(repo *model.Repo, pipeline *model.Pipeline) string {
+	var summary strings.Builder
+
+	fmt.Fprintf(&summary, "[Pipeline #%d](%s)", pipeline.Number, common.GetPipelineStatusURL(repo, pipeline, nil))
+	if commit := shortCommit(pipeline.Commit); commit != "" {
+		fmt.Fprintf(&summary, " for %s", commit)
+	}
+	fmt.Fprintf(&summary, ": **%s**", pipeline.Status)
+	if duration := formatDuration(pipeline.Started, pipeline.Finished); duration != "" {
+		fmt.Fprintf(&summary, " in %s", duration)
+	}
+	summary.WriteString("\n")
+
+	if len(pipeline.Workflows) != 0 {
+		summary.WriteString("\n| Workflow | Status | Duration |\n| --- | --- | --- |\n")
+		for _, workflow := range pipeline.Workflows {
+			fmt.Fprintf(&summary, "| [%s](%s) | %s | %s |\n",
+				escapeTableCell(workflow.Name),
+				common.GetPipelineStatusURL(repo, pipeline, workflow),
+				workflow.State,
+				formatDuration(workflow.Started, workflow.Finished),
+			)
+		}
+	}
+
+	var errs []string
+	for _, pipelineErr := range pipeline.Errors {
+		if !pipelineErr.IsWarning {
+			errs = append(errs, firstLine(pipelineErr.Message))
+		}
+	}
+	if len(errs) != 0 {
+		fmt.Fprintf(&summary, "\n%s:\n\n", plural(len(errs), "error"))
+		for _, err := range errs {
+			fmt.Fprintf(&summary, "- %s\n", err)
+		}
+	}
+
+	return summary.String()
+}
+
+func shortCommit(commit string) string {
+	const length = 7
+	if len(commit) > length {
+		return commit[:length]
+	}
+	return commit
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestPullCommentSummary(t *testing.T) {
+	t.Parallel()
+
+	repo := &model.Repo{ID: 1}
+	pipeline := &model.Pipeline{
+		Number:   12,
+		Commit:   "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Status:   model.StatusFailure,
+		Started:  100,
+		Finished: 172,
+		Workflows: []*model.Workflow{
+			{PID: 1, Name: "build", State: model.StatusSuccess, Started: 100, Finished: 130},
+			{PID: 2, Name: "test|unit", State: model.StatusFailure, Started: 110, Finished: 172},
+			{PID: 3, Name: "deploy", State: model.StatusSkipped},
+		},
+		Errors: []*pipeline_errors.PipelineError{
+			{Message: "Consider adding a `when` block", IsWarning: true},
+			{Message: "tests failed\nsee the logs"},
+		},
+	}
+
+	assert.Equal(t, `[Pipeline #12](/repos/1/pipeline/12) for 6dcb09b: **failure** in 1m12s
+
+| Workflow | Status | Duration |
+| --- | --- | --- |
+| [build](/repos/1/pipeline/12/1) | success | 30s |
+| [test\|unit](/repos/1/pipeline/12/2) | failure | 1m2s |
+| [deploy](/repos/1/pipeline/12/3) | skipped |  |
+
+1 error:
+
+- tests failed
+`, pullCommentSummary(repo, pipeline))
+}
+
+// pullCommentForge is a forge supporting pull request comments.
+type pullCommentForge struct {
+	*forge_mocks.MockForge
+	body   string
+	closed bool
+}
+
+func (f *pullCommentForge) PullComment(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline, body string) error {
+	f.body = body
+	return nil
+}
+
+func (f *pullCommentForge) ClosePullComment(_ context.Context, _ *model.User, _ *model.Repo, _ *model.Pipeline) error {
+	f.closed = true
+	return nil
+}
+
+func TestUpdatePullComment(t *testing.T) {
+	t.Parallel()
+
+	user := &model.User{ID: 1}
+	pipeline := &model.Pipeline{Number: 3, Event: model.EventPull, Status: model.StatusPending}
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &pullCommentForge{MockForge: forge_mocks.NewMockForge(t)}
+		assert.NoError(t, UpdatePullComment(t.Context(), _forge, user, &model.Repo{ID: 1}, pipeline))
+		assert.Empty(t, _forge.body)
+	})
+
+	t.Run("no pull request", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &pullCommentForge{MockForge: forge_mocks.NewMockForge(t)}
+		assert.NoError(t, UpdatePullComment(t.Context(), _forge, user, &model.Repo{ID: 1, PRComment: true}, &model.Pipeline{Number: 3, Event: model.EventPush}))
+		assert.Empty(t, _forge.body)
+	})
+
+	t.Run("forge without comments", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, UpdatePullComment(t.Context(), forge_mocks.NewMockForge(t), user, &model.Repo{ID: 1, PRComment: true}, pipeline))
+	})
+
+	t.Run("comment", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &pullCommentForge{MockForge: forge_mocks.NewMockForge(t)}
+		assert.NoError(t, UpdatePullComment(t.Context(), _forge, user, &model.Repo{ID: 1, PRComment: true}, pipeline))
+		assert.Equal(t, "[//]: # (woodpecker-pipeline-summary 3)\n\n[Pipeline #3](/repos/1/pipeline/3): **pending**\n", _forge.body)
+		assert.False(t, _forge.closed)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		t.Parallel()
+
+		_forge := &pullCommentForge{MockForge: forge_mocks.NewMockForge(t)}
+		closed := &model.Pipeline{Number: 4, Event: model.EventPullClosed}
+		assert.NoError(t, UpdatePullComment(t.Context(), _forge, user, &model.Repo{ID: 1, PRComment: true}, closed))
+		assert.NoError(t, ClosePullComment(t.Context(), _forge, user, &model.Repo{ID: 1, PRComment: true}, closed))
+		assert.Empty(t, _forge.body)
+		assert.True(t, _forge.closed)
+	})
+}
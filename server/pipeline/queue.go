@@ -20,9 +20,14 @@ import (
 	"maps"
 	"time"
 
+	"github.com/rs/zerolog/log"
+	"go.uber.org/multierr"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
 )
 
 // pipelineTasks builds the queue tasks for a pipeline's workflow items.
@@ -38,6 +43,9 @@ func pipelineTasks(repo *model.Repo, activePipeline *model.Pipeline, pipelineIte
 			PipelineID: activePipeline.ID,
 			RepoID:     repo.ID,
 			Created:    activePipeline.Created,
+			Priority:   taskPriority(repo, activePipeline, item),
+			Resources:  taskResources(item),
+			Attempt:    1,
 		}
 		// fall back to the current time if the pipeline has no creation
 		// timestamp, so the queue always has a defined ordering key.
@@ -80,6 +88,95 @@ func pipelineTasks(repo *model.Repo, activePipeline *model.Pipeline, pipelineIte
 	return tasks, nil
 }
 
+// taskPriority returns the queue priority of a workflow. The workflow's own
+// `priority` takes precedence over the one of its pipeline (e.g. set by a cron
+// job), which in turn takes precedence over the repo default. As anyone can
+// open a pull request, its workflows can not raise their priority above the
+// repo default unless the pipeline was approved.
+func taskPriority(repo *model.Repo, activePipeline *model.Pipeline, item *builder.Item) int {
+	switch {
+	case item.Priority > repo.Priority && activePipeline.IsPullRequest() && activePipeline.Reviewer == "":
+		return repo.Priority
+	case item.Priority != 0:
+		return item.Priority
+	case activePipeline.Priority != 0:
+		return activePipeline.Priority
+	default:
+		return repo.Priority
+	}
+}
+
+// taskResources converts the resources requested by a workflow, the CPU is
+// requested in cores but accounted in millicores.
+func taskResources(item *builder.Item) model.Resources {
+	return model.Resources{
+		CPU:    int64(item.Resources.CPU * 1000),
+		Memory: int64(item.Resources.Memory),
+		Disk:   int64(item.Resources.Disk),
+	}
+}
+
+// checkResources rejects workflows requesting more resources than any agent
+// of the repo can allocate, as the queue would never hand them out. Agents not
+// reporting resources are not limited, and without any agent nothing is
+// rejected as agents may still register. If the agents can not be loaded the
+// workflows are queued anyway.
+func checkResources(store store.Store, repo *model.Repo, pipelineItems []*builder.Item) error {
+	var agents []*model.Agent
+	var errs error
+	for _, item := range pipelineItems {
+		request := taskResources(item)
+		if request.IsZero() {
+			continue
+		}
+
+		if agents == nil {
+			var err error
+			agents, err = repoAgents(store, repo)
+			if err != nil {
+				log.Error().Err(err).Str("repo", repo.FullName).Msg("could not check resources of workflows")
+				return nil
+			}
+			if len(agents) == 0 {
+				return nil
+			}
+		}
+
+		if !fitsAnyAgent(agents, request) {
+			errs = multierr.Append(errs, &pipeline_errors.PipelineError{
+				Type:    pipeline_errors.PipelineErrorTypeGeneric,
+				Message: fmt.Sprintf("workflow '%s' requests more resources than any agent can allocate", item.Workflow.Name),
+			})
+		}
+	}
+	return errs
+}
+
+// repoAgents returns the agents that may run workflows of the repo.
+func repoAgents(store store.Store, repo *model.Repo) ([]*model.Agent, error) {
+	agents, err := store.AgentList(&model.ListOptionsWithAll{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agents: %w", err)
+	}
+
+	repoAgents := make([]*model.Agent, 0, len(agents))
+	for _, agent := range agents {
+		if agent.CanAccessRepo(repo) {
+			repoAgents = append(repoAgents, agent)
+		}
+	}
+	return repoAgents, nil
+}
+
+func fitsAnyAgent(agents []*model.Agent, request model.Resources) bool {
+	for _, agent := range agents {
+		if agent.Resources.Fits(model.Resources{}, request) {
+			return true
+		}
+	}
+	return false
+}
+
 func getTaskDependencies(dependsOn []string, items []*builder.Item) (taskIDs []string) {
 	for _, dep := range dependsOn {
 		for _, pipelineItem := range items {
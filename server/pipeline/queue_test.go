@@ -19,10 +19,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/builder"
+	yaml_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/frontend/yaml/types"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 )
 
 func TestQueuePipelineConcurrency(t *testing.T) {
@@ -100,3 +104,110 @@ func TestQueuePipelineCreated(t *testing.T) {
 		assert.GreaterOrEqual(t, task.Created, before)
 	})
 }
+
+func TestQueuePipelinePriority(t *testing.T) {
+	tests := []struct {
+		name             string
+		repoPriority     int
+		pipelinePriority int
+		itemPriority     int
+		event            model.WebhookEvent
+		reviewer         string
+		expected         int
+	}{
+		{name: "defaults to zero"},
+		{name: "inherits the repo priority", repoPriority: 5, expected: 5},
+		{name: "pipeline overrides repo", repoPriority: 5, pipelinePriority: -3, expected: -3},
+		{name: "workflow overrides pipeline and repo", repoPriority: 5, pipelinePriority: -3, itemPriority: 10, expected: 10},
+		{name: "pull request is capped at repo priority", repoPriority: 5, itemPriority: 10, event: model.EventPull, expected: 5},
+		{name: "pull request can lower its priority", repoPriority: 5, itemPriority: -1, event: model.EventPull, expected: -1},
+		{name: "approved pull request keeps its priority", repoPriority: 5, itemPriority: 10, event: model.EventPull, reviewer: "octocat", expected: 10},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &model.Repo{ID: 7, Priority: tc.repoPriority}
+			activePipeline := &model.Pipeline{ID: 42, Priority: tc.pipelinePriority, Event: tc.event, Reviewer: tc.reviewer}
+			item := &builder.Item{
+				Workflow: &builder.Workflow{ID: 1, Name: "build"},
+				Priority: tc.itemPriority,
+			}
+
+			tasks, err := pipelineTasks(repo, activePipeline, []*builder.Item{item})
+			require.NoError(t, err)
+			require.Len(t, tasks, 1)
+			assert.Equal(t, tc.expected, tasks[0].Priority)
+		})
+	}
+}
+
+func TestQueuePipelineResources(t *testing.T) {
+	repo := &model.Repo{ID: 7}
+	activePipeline := &model.Pipeline{ID: 42}
+	item := &builder.Item{
+		Workflow: &builder.Workflow{ID: 1, Name: "build"},
+		Resources: yaml_types.Resources{
+			CPU:    1.5,
+			Memory: 4 << 30,
+		},
+	}
+
+	tasks, err := pipelineTasks(repo, activePipeline, []*builder.Item{item})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, model.Resources{CPU: 1500, Memory: 4 << 30}, tasks[0].Resources)
+}
+
+func TestCheckResources(t *testing.T) {
+	repo := &model.Repo{ID: 7, OrgID: 3}
+	items := []*builder.Item{
+		{
+			Workflow:  &builder.Workflow{ID: 1, Name: "small"},
+			Resources: yaml_types.Resources{CPU: 2, Memory: 1 << 30},
+		},
+		{
+			Workflow:  &builder.Workflow{ID: 2, Name: "large"},
+			Resources: yaml_types.Resources{CPU: 16},
+		},
+		{
+			Workflow: &builder.Workflow{ID: 3, Name: "none"},
+		},
+	}
+
+	t.Run("rejects workflows no agent fits", func(t *testing.T) {
+		store := store_mocks.NewMockStore(t)
+		store.On("AgentList", mock.Anything).Return([]*model.Agent{
+			{ID: 1, OrgID: model.IDNotSet, Resources: model.Resources{CPU: 4000, Memory: 8 << 30}},
+			{ID: 2, OrgID: 5, Resources: model.Resources{}},
+		}, nil)
+
+		errs := pipeline_errors.GetPipelineErrors(checkResources(store, repo, items))
+		require.Len(t, errs, 1)
+		assert.Equal(t, pipeline_errors.PipelineErrorTypeGeneric, errs[0].Type)
+		assert.Contains(t, errs[0].Message, "'large'")
+		assert.False(t, errs[0].IsWarning)
+	})
+
+	t.Run("agents without resources fit everything", func(t *testing.T) {
+		store := store_mocks.NewMockStore(t)
+		store.On("AgentList", mock.Anything).Return([]*model.Agent{
+			{ID: 1, OrgID: model.IDNotSet, Resources: model.Resources{CPU: 4000}},
+			{ID: 2, OrgID: 3, Resources: model.Resources{Memory: 2 << 30}},
+		}, nil)
+
+		assert.NoError(t, checkResources(store, repo, items))
+	})
+
+	t.Run("nothing is rejected without agents", func(t *testing.T) {
+		store := store_mocks.NewMockStore(t)
+		store.On("AgentList", mock.Anything).Return([]*model.Agent{}, nil)
+
+		assert.NoError(t, checkResources(store, repo, items))
+	})
+
+	t.Run("agents are not loaded without requests", func(t *testing.T) {
+		store := store_mocks.NewMockStore(t)
+
+		assert.NoError(t, checkResources(store, repo, items[2:]))
+	})
+}
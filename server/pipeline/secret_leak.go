@@ -0,0 +1,73 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/shared"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// maxLeakLines limits the lines a secret leak warning lists.
+const maxLeakLines = 10
+
+// CheckStepLogs scans the logs of a finished step for credentials, e.g.
+// printed by a tool or not registered as secret and so not masked. If it
+// finds some, it appends a warning to the pipeline and returns the updated
+// pipeline.
+func CheckStepLogs(store store.Store, logStore log.Service, step *model.Step) (*model.Pipeline, error) {
+	entries, err := logStore.LogFind(step)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []int
+	var kinds []string
+	for _, entry := range entries {
+		if entry.Type != model.LogEntryStdout && entry.Type != model.LogEntryStderr {
+			continue
+		}
+		kind, found := shared.FindCredential(string(entry.Data))
+		if !found {
+			continue
+		}
+		if len(lines) < maxLeakLines {
+			lines = append(lines, entry.Line)
+		}
+		if !slices.Contains(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	if len(lines) == 0 {
+		return nil, nil
+	}
+
+	return store.AppendPipelineErrors(step.PipelineID, []*pipeline_errors.PipelineError{{
+		Type: pipeline_errors.PipelineErrorTypeSecretLeak,
+		Message: fmt.Sprintf("The logs of step `%s` seem to contain credentials (%s). "+
+			"Check them and pass credentials as secrets, so they get masked.", step.Name, strings.Join(kinds, ", ")),
+		IsWarning: true,
+		Data: &pipeline_errors.SecretLeakErrorData{
+			Step:  step.Name,
+			Lines: lines,
+		},
+	}})
+}
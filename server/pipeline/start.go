@@ -45,14 +45,14 @@ func start(ctx context.Context, forge forge.Forge, store store.Store, activePipe
 		return nil, err
 	}
 
-	updatePipelineStatus(ctx, forge, activePipeline, repo, user)
+	updatePipelineStatus(ctx, forge, store, activePipeline, repo, user)
 
 	return activePipeline, nil
 }
 
-func publishPipeline(ctx context.Context, forge forge.Forge, pipeline *model.Pipeline, repo *model.Repo, repoUser *model.User) {
+func publishPipeline(ctx context.Context, forge forge.Forge, store store.Store, pipeline *model.Pipeline, repo *model.Repo, repoUser *model.User) {
 	if err := server.Config.Services.Scheduler.PublishPipelineEvent(ctx, repo, pipeline); err != nil {
 		log.Error().Err(err).Msg("could not push pipeline status change to pubsub provider")
 	}
-	updatePipelineStatus(ctx, forge, pipeline, repo, repoUser)
+	updatePipelineStatus(ctx, forge, store, pipeline, repo, repoUser)
 }
@@ -0,0 +1,138 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testreport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// junitSuite is a testsuite element, or the testsuites root element which
+// shares its structure. Suites can be nested.
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	File      string        `xml:"file,attr"`
+	Line      string        `xml:"line,attr"`
+	Failures  []junitResult `xml:"failure"`
+	Errors    []junitResult `xml:"error"`
+	Skipped   *junitResult  `xml:"skipped"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnit parses a JUnit XML report. Its root is either a testsuites or a
+// single testsuite element.
+func parseJUnit(r io.Reader) ([]*model.TestResult, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("junit report has no root element")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid junit report: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		var suite junitSuite
+		if err := decoder.DecodeElement(&suite, &start); err != nil {
+			return nil, fmt.Errorf("invalid junit report: %w", err)
+		}
+
+		switch start.Name.Local {
+		case "testsuites":
+			// the name of the root only summarizes the run
+			suite.Name = ""
+		case "testsuite":
+		default:
+			return nil, fmt.Errorf("invalid junit report: unexpected root element %q", start.Name.Local)
+		}
+
+		var results []*model.TestResult
+		collectJUnit(&suite, &results)
+		return results, nil
+	}
+}
+
+func collectJUnit(suite *junitSuite, results *[]*model.TestResult) {
+	for _, testCase := range suite.Cases {
+		result := &model.TestResult{
+			Suite:     suite.Name,
+			Classname: testCase.Classname,
+			Name:      testCase.Name,
+			Duration:  parseJUnitTime(testCase.Time),
+			Status:    model.TestStatusPassed,
+			File:      testCase.File,
+		}
+		if line, err := strconv.Atoi(testCase.Line); err == nil && line > 0 {
+			result.Line = line
+		}
+
+		var outcome *junitResult
+		switch {
+		case len(testCase.Errors) > 0:
+			result.Status = model.TestStatusError
+			outcome = &testCase.Errors[0]
+		case len(testCase.Failures) > 0:
+			result.Status = model.TestStatusFailed
+			outcome = &testCase.Failures[0]
+		case testCase.Skipped != nil:
+			result.Status = model.TestStatusSkipped
+			outcome = testCase.Skipped
+		}
+		if outcome != nil {
+			result.Message = truncate(strings.TrimSpace(outcome.Message), maxMessageSize)
+			result.Details = truncate(strings.TrimSpace(outcome.Text), maxDetailsSize)
+		}
+
+		*results = append(*results, result)
+	}
+
+	for i := range suite.Suites {
+		collectJUnit(&suite.Suites[i], results)
+	}
+}
+
+// parseJUnitTime converts the duration in seconds, e.g. "1.5" or "1,234.5",
+// to milliseconds. Invalid durations are reported as zero.
+func parseJUnitTime(value string) int64 {
+	seconds, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 64)
+	if err != nil || math.IsNaN(seconds) || seconds < 0 || seconds > math.MaxInt64/1000 {
+		return 0
+	}
+	return int64(math.Round(seconds * 1000))
+}
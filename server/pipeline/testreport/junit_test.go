@@ -0,0 +1,96 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testreport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestParseJUnit(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="all" tests="5">
+  <testsuite name="api">
+    <testcase name="TestLogin" classname="api.auth" time="0.25"/>
+    <testcase name="TestLogout" classname="api.auth" time="1,001.5" file="api/auth_test.go" line="42">
+      <failure message="expected 200, got 500" type="AssertionError">
+        auth_test.go:42: expected 200, got 500
+      </failure>
+    </testcase>
+    <testsuite name="api/nested">
+      <testcase name="TestPanic" classname="api.nested" time="invalid">
+        <error message="panic">runtime error: nil pointer dereference</error>
+      </testcase>
+    </testsuite>
+  </testsuite>
+  <testsuite name="web">
+    <testcase name="renders" classname="web.App" time="0.001">
+      <skipped message="needs a browser"/>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	results, err := Parse("junit", strings.NewReader(report))
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TestResult{
+		{Suite: "api", Classname: "api.auth", Name: "TestLogin", Duration: 250, Status: model.TestStatusPassed},
+		{
+			Suite: "api", Classname: "api.auth", Name: "TestLogout", Duration: 1001500, Status: model.TestStatusFailed,
+			Message: "expected 200, got 500", Details: "auth_test.go:42: expected 200, got 500",
+			File: "api/auth_test.go", Line: 42,
+		},
+		{
+			Suite: "api/nested", Classname: "api.nested", Name: "TestPanic", Status: model.TestStatusError,
+			Message: "panic", Details: "runtime error: nil pointer dereference",
+		},
+		{Suite: "web", Classname: "web.App", Name: "renders", Duration: 1, Status: model.TestStatusSkipped, Message: "needs a browser"},
+	}, results)
+}
+
+func TestParseJUnitSingleSuite(t *testing.T) {
+	report := `<testsuite name="unit"><testcase name="a" classname="pkg"/></testsuite>`
+
+	results, err := Parse("junit", strings.NewReader(report))
+	require.NoError(t, err)
+	assert.Equal(t, []*model.TestResult{
+		{Suite: "unit", Classname: "pkg", Name: "a", Status: model.TestStatusPassed},
+	}, results)
+}
+
+func TestParseJUnitInvalid(t *testing.T) {
+	_, err := Parse("junit", strings.NewReader(""))
+	assert.EqualError(t, err, "junit report has no root element")
+
+	_, err = Parse("junit", strings.NewReader(`<testsuite><testcase></testsuite>`))
+	assert.ErrorContains(t, err, "invalid junit report")
+
+	_, err = Parse("junit", strings.NewReader(`<coverage/>`))
+	assert.EqualError(t, err, `invalid junit report: unexpected root element "coverage"`)
+
+	_, err = Parse("xunit", strings.NewReader(`<testsuite/>`))
+	assert.EqualError(t, err, `unsupported test report format "xunit"`)
+}
+
+func TestTruncate(t *testing.T) {
+	assert.Equal(t, "abc", truncate("abc", 3))
+	assert.Equal(t, "ab", truncate("abc", 2))
+	// ä is two bytes long and must not be split
+	assert.Equal(t, "a", truncate("aäb", 2))
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testreport parses the test reports uploaded by steps into test
+// results.
+package testreport
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+const (
+	// maxMessageSize and maxDetailsSize limit the failure output stored per
+	// test, the full output is part of the step log anyway.
+	maxMessageSize = 1024
+	maxDetailsSize = 16 * 1024
+)
+
+// Parse returns the test results of a report in the given format. Only the
+// fields describing the test itself are set.
+func Parse(format string, r io.Reader) ([]*model.TestResult, error) {
+	switch format {
+	case backend_types.ReportFormatJUnit:
+		return parseJUnit(r)
+	default:
+		return nil, fmt.Errorf("unsupported test report format %q", format)
+	}
+}
+
+// truncate cuts s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -15,9 +15,14 @@
 package pipeline
 
 import (
+	"errors"
+	"io/fs"
+
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
 // WorkflowStatus determine workflow status based on corresponding step list.
@@ -60,3 +65,56 @@ func UpdateWorkflowStatusToDone(store store.Store, workflow model.Workflow, stat
 	}
 	return &workflow, store.WorkflowUpdate(&workflow)
 }
+
+// UpdateWorkflowToRetry resets a workflow and its steps to pending for the
+// next attempt to run it. Logs of the last attempt are moved to the store, as
+// the next attempt writes its lines starting from the first one again.
+func UpdateWorkflowToRetry(store store.Store, logStore log.Service, workflow model.Workflow) (*model.Workflow, error) {
+	attempt := workflow.CurrentAttempt()
+	for _, step := range workflow.Children {
+		if step.State != model.StatusPending || step.Started != 0 {
+			if err := archiveAttemptLogs(store, logStore, step, attempt); err != nil {
+				return nil, err
+			}
+		}
+		step.State = model.StatusPending
+		step.Error = ""
+		step.ExitCode = 0
+		step.Started = 0
+		step.Finished = 0
+		if err := store.StepUpdate(step); err != nil {
+			return nil, err
+		}
+	}
+
+	workflow.Attempt = workflow.CurrentAttempt() + 1
+	workflow.State = model.StatusPending
+	workflow.Error = ""
+	workflow.AgentID = 0
+	workflow.Started = 0
+	workflow.Finished = 0
+	return &workflow, store.WorkflowUpdate(&workflow)
+}
+
+func archiveAttemptLogs(store store.Store, logStore log.Service, step *model.Step, attempt int) error {
+	logEntries, err := logStore.LogFind(step)
+	if err != nil && !isLogNotExist(err) {
+		return err
+	}
+	if len(logEntries) > 0 {
+		// the logs were archived already if moving them failed after
+		err := store.LogAttemptCreate(step, attempt, logEntries)
+		if err != nil && !errors.Is(err, types.ErrInsertDuplicateDetected) {
+			return err
+		}
+	}
+
+	if err := logStore.LogDelete(step); err != nil && !isLogNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func isLogNotExist(err error) bool {
+	return errors.Is(err, fs.ErrNotExist) || errors.Is(err, types.ErrRecordNotExist)
+}
@@ -22,6 +22,7 @@ import (
 
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
 )
 
@@ -298,3 +299,37 @@ func TestUpdateWorkflowStatusToDone(t *testing.T) {
 		assert.Equal(t, int64(1234567900), result.Finished)
 	})
 }
+
+func TestUpdateWorkflowToRetry(t *testing.T) {
+	step := &model.Step{ID: 1, State: model.StatusFailure, ExitCode: 137, Started: 10, Finished: 20}
+	skipped := &model.Step{ID: 2, State: model.StatusPending}
+	workflow := model.Workflow{
+		ID:       7,
+		State:    model.StatusRunning,
+		AgentID:  3,
+		Started:  10,
+		Children: []*model.Step{step, skipped},
+	}
+
+	mockStore := store_mocks.NewMockStore(t)
+	mockStore.On("StepUpdate", mock.MatchedBy(func(s *model.Step) bool {
+		return s.State == model.StatusPending && s.ExitCode == 0 && s.Started == 0
+	})).Return(nil)
+	mockStore.On("WorkflowUpdate", mock.MatchedBy(func(w *model.Workflow) bool {
+		return w.ID == 7 && w.State == model.StatusPending && w.Attempt == 2 && w.AgentID == 0
+	})).Return(nil)
+
+	// only the step that ran has logs of the last attempt, they are kept
+	// for the attempt
+	logEntries := []*model.LogEntry{{StepID: 1, Line: 0, Data: []byte("killed")}}
+	mockStore.On("LogAttemptCreate", step, 1, logEntries).Return(nil).Once()
+	mockLogStore := log_mocks.NewMockService(t)
+	mockLogStore.On("LogFind", step).Return(logEntries, nil).Once()
+	mockLogStore.On("LogDelete", step).Return(nil).Once()
+
+	result, err := UpdateWorkflowToRetry(mockStore, mockLogStore, workflow)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Attempt)
+	assert.Zero(t, result.Started)
+}
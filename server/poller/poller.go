@@ -0,0 +1,335 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package poller triggers pipelines for repos whose forge cannot deliver
+// webhooks to Woodpecker by periodically polling the branches and pull
+// requests of the repos that opted in.
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/forge"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	// Specifies the interval woodpecker checks for repos due to be polled.
+	checkInterval = time.Minute
+
+	// Specifies the batch size of repos to retrieve per check from database.
+	batchItems = 10
+
+	// Specifies the page size used to list branches and pull requests.
+	perPage = 50
+
+	// Specifies how long a poll may take before another server polls the repo.
+	claimDuration = 10 * time.Minute
+
+	// Specifies the upper limit of the delay between failed polls.
+	maxBackoff = 6 * time.Hour
+)
+
+// Poller polls the forges of repos with a poll interval for new branch and
+// pull request heads and creates pipelines for them.
+type Poller struct {
+	store store.Store
+	// create is replaced in tests
+	create func(context.Context, store.Store, *model.Repo, *model.Pipeline) (*model.Pipeline, error)
+}
+
+// New returns a Poller working with the given store.
+func New(store store.Store) *Poller {
+	return &Poller{
+		store:  store,
+		create: pipeline.Create,
+	}
+}
+
+// Run polls the due repos periodically until ctx is canceled.
+func (p *Poller) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(checkInterval):
+			if err := p.Poll(ctx, time.Now()); err != nil {
+				log.Error().Err(err).Msg("poll repos")
+			}
+		}
+	}
+}
+
+// Poll polls all repos which are due as of now. Each repo is polled at most
+// once, a repo that stays due because polling it failed before it could be
+// rescheduled is retried with the next check.
+func (p *Poller) Poll(ctx context.Context, now time.Time) error {
+	var afterID int64
+	for {
+		repos, err := p.store.RepoPollListDue(now.Unix(), afterID, batchItems)
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range repos {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// a failing repo must not block the others
+			if err := p.pollRepo(ctx, repo, now); err != nil {
+				log.Error().Err(err).Str("repo", repo.FullName).Msg("poll repo")
+			}
+			afterID = repo.ID
+		}
+
+		if len(repos) < batchItems {
+			return nil
+		}
+	}
+}
+
+func (p *Poller) pollRepo(ctx context.Context, repo *model.Repo, now time.Time) error {
+	state, err := p.claim(repo, now)
+	if err != nil || state == nil {
+		return err
+	}
+
+	pollErr := p.pollForge(ctx, repo, state, now)
+
+	interval := time.Duration(repo.PollInterval) * time.Minute
+	if pollErr != nil {
+		state.Failures++
+		state.Error = pollErr.Error()
+		state.NextPoll = now.Add(backoff(interval, state.Failures, pollErr, now)).Unix()
+	} else {
+		state.Failures = 0
+		state.Error = ""
+		state.LastPoll = now.Unix()
+		state.NextPoll = now.Add(interval).Unix()
+	}
+
+	if err := p.store.RepoPollUpdate(state); err != nil {
+		return err
+	}
+	return pollErr
+}
+
+// claim returns the poll state of the repo after moving its next poll behind
+// the claim duration, or nil if another server claimed it first.
+func (p *Poller) claim(repo *model.Repo, now time.Time) (*model.RepoPoll, error) {
+	until := now.Add(claimDuration).Unix()
+
+	state, err := p.store.RepoPollFind(repo.ID)
+	if errors.Is(err, types.ErrRecordNotExist) {
+		state = &model.RepoPoll{RepoID: repo.ID, NextPoll: until}
+		err = p.store.RepoPollCreate(state)
+		if errors.Is(err, types.ErrInsertDuplicateDetected) {
+			return nil, nil
+		}
+		return state, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := p.store.RepoPollClaim(state, until)
+	if err != nil || !claimed {
+		return nil, err
+	}
+	return state, nil
+}
+
+// backoff returns the delay until the next poll after the given number of
+// consecutive failures. It doubles the poll interval with each failure and
+// waits for the rate limit to reset if the forge reported one.
+func backoff(interval time.Duration, failures int, err error, now time.Time) time.Duration {
+	delay := maxBackoff
+	if failures < 32 && interval<<failures > 0 && interval<<failures < maxBackoff {
+		delay = interval << failures
+	}
+
+	var limited *forge_types.ErrRateLimited
+	if errors.As(err, &limited) && limited.Reset.Sub(now) > delay {
+		delay = limited.Reset.Sub(now)
+	}
+	return delay
+}
+
+func (p *Poller) pollForge(ctx context.Context, repo *model.Repo, state *model.RepoPoll, now time.Time) error {
+	_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
+	if err != nil {
+		return err
+	}
+
+	repoUser, err := p.store.GetUser(repo.UserID)
+	if err != nil {
+		return fmt.Errorf("get repo owner: %w", err)
+	}
+
+	// If the forge has a refresh token, the current access token
+	// may be stale. Therefore, we should refresh prior to polling.
+	forge.Refresh(ctx, _forge, p.store, repoUser)
+
+	if err := p.pollBranches(ctx, _forge, repoUser, repo, state, now); err != nil {
+		return err
+	}
+	return p.pollPullRequests(ctx, _forge, repoUser, repo, state, now)
+}
+
+func (p *Poller) pollBranches(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, state *model.RepoPoll, now time.Time) error {
+	branches, err := listAll(func(page int) ([]string, error) {
+		return _forge.Branches(ctx, user, repo, &model.ListOptions{Page: page, PerPage: perPage})
+	}, func(branch string) string { return branch })
+	if err != nil {
+		return err
+	}
+
+	// the first poll only records the heads, as there is nothing to compare them to
+	initial := state.Branches == nil
+
+	seen := make(map[string]string, len(branches))
+	for _, branch := range branches {
+		commit, err := _forge.BranchHead(ctx, user, repo, branch)
+		if err != nil {
+			return err
+		}
+		seen[branch] = commit.SHA
+
+		if initial || state.Branches[branch] == commit.SHA {
+			continue
+		}
+		p.trigger(ctx, repo, &model.Pipeline{
+			Event:     model.EventPush,
+			Commit:    commit.SHA,
+			Ref:       "refs/heads/" + branch,
+			Branch:    branch,
+			ForgeURL:  commit.ForgeURL,
+			Message:   commit.Message,
+			Author:    commit.Author,
+			Email:     commit.Email,
+			Sender:    commit.Author,
+			Timestamp: now.Unix(),
+		})
+	}
+	state.Branches = seen
+	return nil
+}
+
+func (p *Poller) pollPullRequests(ctx context.Context, _forge forge.Forge, user *model.User, repo *model.Repo, state *model.RepoPoll, now time.Time) error {
+	if !repo.AllowPull {
+		state.Pulls = nil
+		return nil
+	}
+
+	pulls, err := listAll(func(page int) ([]*model.PullRequest, error) {
+		return _forge.PullRequests(ctx, user, repo, &model.ListOptions{Page: page, PerPage: perPage})
+	}, func(pull *model.PullRequest) string { return string(pull.Index) })
+	if errors.Is(err, forge_types.ErrNotImplemented) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	initial := state.Pulls == nil
+
+	seen := make(map[string]string, len(pulls))
+	for _, pull := range pulls {
+		// the forge does not return the head of pull requests
+		if pull.Commit == "" {
+			continue
+		}
+		seen[string(pull.Index)] = pull.Commit
+
+		if initial || state.Pulls[string(pull.Index)] == pull.Commit {
+			continue
+		}
+		p.trigger(ctx, repo, &model.Pipeline{
+			Event:             model.EventPull,
+			Commit:            pull.Commit,
+			Ref:               pull.Ref,
+			Branch:            pull.Branch,
+			Refspec:           pull.Refspec,
+			ForgeURL:          pull.ForgeURL,
+			Message:           pull.Title,
+			Title:             pull.Title,
+			Author:            pull.Author,
+			Avatar:            pull.Avatar,
+			Sender:            pull.Author,
+			Timestamp:         now.Unix(),
+			PullRequestLabels: pull.Labels,
+			PullRequestDraft:  pull.Draft,
+			FromFork:          pull.FromFork,
+		})
+	}
+	state.Pulls = seen
+	return nil
+}
+
+// trigger creates the pipeline unless a webhook created it already. Errors
+// are only logged, as retrying would not change the outcome.
+func (p *Poller) trigger(ctx context.Context, repo *model.Repo, newPipeline *model.Pipeline) {
+	logger := log.With().Str("repo", repo.FullName).Str("event", string(newPipeline.Event)).Str("ref", newPipeline.Ref).Str("commit", newPipeline.Commit).Logger()
+
+	duplicate, err := pipeline.IsDuplicate(p.store, repo, newPipeline)
+	if err != nil {
+		logger.Error().Err(err).Msg("check for existing pipeline")
+		return
+	}
+	if duplicate {
+		logger.Debug().Msg("poller: pipeline exists already")
+		return
+	}
+
+	logger.Debug().Msg("poller: create pipeline for new head")
+	if _, err := p.create(ctx, p.store, repo, newPipeline); err != nil && !errors.Is(err, pipeline.ErrFiltered) {
+		logger.Error().Err(err).Msg("could not create pipeline from poll")
+	}
+}
+
+// listAll requests pages until a short or empty one is returned. As some
+// forges ignore the pagination, it also stops once a page holds no new items.
+func listAll[T any](list func(page int) ([]T, error), key func(T) string) ([]T, error) {
+	var all []T
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		items, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, item := range items {
+			if !seen[key(item)] {
+				seen[key(item)] = true
+				all = append(all, item)
+				added++
+			}
+		}
+
+		if len(items) < perPage || added == 0 {
+			return all, nil
+		}
+	}
+}
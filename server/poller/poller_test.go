@@ -0,0 +1,231 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package poller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	forge_mocks "go.woodpecker-ci.org/woodpecker/v3/server/forge/mocks"
+	forge_types "go.woodpecker-ci.org/woodpecker/v3/server/forge/types"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	manager_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func newTestPoller(t *testing.T) (*Poller, *store_mocks.MockStore, *forge_mocks.MockForge, *[]*model.Pipeline) {
+	_store := store_mocks.NewMockStore(t)
+	_forge := forge_mocks.NewMockForge(t)
+	_manager := manager_mocks.NewMockManager(t)
+	_manager.On("ForgeFromRepo", mock.Anything).Return(_forge, nil).Maybe()
+	server.Config.Services.Manager = _manager
+
+	created := new([]*model.Pipeline)
+	p := New(_store)
+	p.create = func(_ context.Context, _ store.Store, _ *model.Repo, pipeline *model.Pipeline) (*model.Pipeline, error) {
+		*created = append(*created, pipeline)
+		return pipeline, nil
+	}
+	return p, _store, _forge, created
+}
+
+func TestPollInitial(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	user := &model.User{ID: 1}
+	repo := &model.Repo{ID: 1, UserID: 1, FullName: "octocat/hello-world", PollInterval: 5, AllowPull: true}
+	p, _store, _forge, created := newTestPoller(t)
+
+	_store.On("RepoPollListDue", now.Unix(), int64(0), batchItems).Return([]*model.Repo{repo}, nil)
+	_store.On("RepoPollFind", repo.ID).Return(nil, types.ErrRecordNotExist)
+	_store.On("RepoPollCreate", &model.RepoPoll{RepoID: repo.ID, NextPoll: now.Add(claimDuration).Unix()}).Return(nil)
+	_store.On("GetUser", repo.UserID).Return(user, nil)
+	_forge.On("Branches", mock.Anything, user, repo, &model.ListOptions{Page: 1, PerPage: perPage}).Return([]string{"main"}, nil)
+	_forge.On("BranchHead", mock.Anything, user, repo, "main").Return(&model.Commit{SHA: "a1"}, nil)
+	_forge.On("PullRequests", mock.Anything, user, repo, &model.ListOptions{Page: 1, PerPage: perPage}).Return([]*model.PullRequest{{Index: "1", Commit: "b1"}}, nil)
+	_store.On("RepoPollUpdate", &model.RepoPoll{
+		RepoID:   repo.ID,
+		NextPoll: now.Add(5 * time.Minute).Unix(),
+		LastPoll: now.Unix(),
+		Branches: map[string]string{"main": "a1"},
+		Pulls:    map[string]string{"1": "b1"},
+	}).Return(nil)
+
+	assert.NoError(t, p.Poll(t.Context(), now))
+	// the first poll only records the heads
+	assert.Empty(t, *created)
+}
+
+func TestPollNewHeads(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	user := &model.User{ID: 1}
+	repo := &model.Repo{ID: 1, UserID: 1, FullName: "octocat/hello-world", PollInterval: 5, AllowPull: true}
+	p, _store, _forge, created := newTestPoller(t)
+
+	state := &model.RepoPoll{
+		RepoID:   repo.ID,
+		NextPoll: now.Unix(),
+		LastPoll: now.Add(-5 * time.Minute).Unix(),
+		Branches: map[string]string{"main": "a1", "gone": "c1"},
+		Pulls:    map[string]string{"1": "b1"},
+	}
+	_store.On("RepoPollListDue", now.Unix(), int64(0), batchItems).Return([]*model.Repo{repo}, nil)
+	_store.On("RepoPollFind", repo.ID).Return(state, nil)
+	_store.On("RepoPollClaim", state, now.Add(claimDuration).Unix()).Return(true, nil)
+	_store.On("GetUser", repo.UserID).Return(user, nil)
+	_forge.On("Branches", mock.Anything, user, repo, &model.ListOptions{Page: 1, PerPage: perPage}).Return([]string{"main", "dev", "webhook"}, nil)
+	_forge.On("BranchHead", mock.Anything, user, repo, "main").Return(&model.Commit{SHA: "a2", Message: "fix", Author: "octocat"}, nil)
+	_forge.On("BranchHead", mock.Anything, user, repo, "dev").Return(&model.Commit{SHA: "d1"}, nil)
+	_forge.On("BranchHead", mock.Anything, user, repo, "webhook").Return(&model.Commit{SHA: "e1"}, nil)
+	_forge.On("PullRequests", mock.Anything, user, repo, &model.ListOptions{Page: 1, PerPage: perPage}).Return([]*model.PullRequest{
+		{Index: "1", Commit: "b2", Ref: "refs/pull/1/head", Branch: "main", Title: "Add feature"},
+		// forges not returning the head are skipped
+		{Index: "2"},
+	}, nil)
+
+	// a webhook created the pipeline for this branch already
+	_store.On("GetPipelineList", repo, mock.Anything, &model.PipelineFilter{Branch: "webhook", Commit: "e1", Events: []model.WebhookEvent{model.EventPush}}).Return([]*model.Pipeline{{ID: 1}}, nil)
+	_store.On("GetPipelineList", repo, mock.Anything, mock.Anything).Return(nil, nil)
+	_store.On("RepoPollUpdate", mock.Anything).Return(nil)
+
+	assert.NoError(t, p.Poll(t.Context(), now))
+
+	if assert.Len(t, *created, 3) {
+		assert.Equal(t, &model.Pipeline{
+			Event:     model.EventPush,
+			Commit:    "a2",
+			Ref:       "refs/heads/main",
+			Branch:    "main",
+			Message:   "fix",
+			Author:    "octocat",
+			Sender:    "octocat",
+			Timestamp: now.Unix(),
+		}, (*created)[0])
+		assert.Equal(t, "dev", (*created)[1].Branch)
+		assert.Equal(t, &model.Pipeline{
+			Event:     model.EventPull,
+			Commit:    "b2",
+			Ref:       "refs/pull/1/head",
+			Branch:    "main",
+			Message:   "Add feature",
+			Title:     "Add feature",
+			Timestamp: now.Unix(),
+		}, (*created)[2])
+	}
+
+	assert.Equal(t, map[string]string{"main": "a2", "dev": "d1", "webhook": "e1"}, state.Branches)
+	assert.Equal(t, map[string]string{"1": "b2"}, state.Pulls)
+	assert.EqualValues(t, now.Unix(), state.LastPoll)
+	assert.EqualValues(t, now.Add(5*time.Minute).Unix(), state.NextPoll)
+}
+
+func TestPollClaimed(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	repo := &model.Repo{ID: 1, PollInterval: 5}
+	p, _store, _, _ := newTestPoller(t)
+
+	state := &model.RepoPoll{RepoID: repo.ID, NextPoll: now.Unix()}
+	_store.On("RepoPollListDue", now.Unix(), int64(0), batchItems).Return([]*model.Repo{repo}, nil)
+	_store.On("RepoPollFind", repo.ID).Return(state, nil)
+	_store.On("RepoPollClaim", state, mock.Anything).Return(false, nil)
+
+	assert.NoError(t, p.Poll(t.Context(), now))
+}
+
+func TestPollStoreError(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	p, _store, _, _ := newTestPoller(t)
+
+	// repos whose poll state cannot be loaded stay due, they must not be
+	// listed again until the next check
+	repos := make([]*model.Repo, batchItems)
+	for i := range repos {
+		repos[i] = &model.Repo{ID: int64(i + 1), PollInterval: 5}
+		_store.On("RepoPollFind", repos[i].ID).Return(nil, assert.AnError).Once()
+	}
+	_store.On("RepoPollListDue", now.Unix(), int64(0), batchItems).Return(repos, nil).Once()
+	_store.On("RepoPollListDue", now.Unix(), int64(batchItems), batchItems).Return([]*model.Repo{}, nil).Once()
+
+	assert.NoError(t, p.Poll(t.Context(), now))
+}
+
+func TestPollRateLimited(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	user := &model.User{ID: 1}
+	repo := &model.Repo{ID: 1, UserID: 1, PollInterval: 5}
+	p, _store, _forge, _ := newTestPoller(t)
+
+	state := &model.RepoPoll{RepoID: repo.ID, NextPoll: now.Unix(), Branches: map[string]string{"main": "a1"}, Failures: 1}
+	reset := now.Add(time.Hour)
+	_store.On("RepoPollListDue", now.Unix(), int64(0), batchItems).Return([]*model.Repo{repo}, nil)
+	_store.On("RepoPollFind", repo.ID).Return(state, nil)
+	_store.On("RepoPollClaim", state, mock.Anything).Return(true, nil)
+	_store.On("GetUser", repo.UserID).Return(user, nil)
+	_forge.On("Branches", mock.Anything, user, repo, mock.Anything).Return(nil, &forge_types.ErrRateLimited{Reset: reset})
+	_store.On("RepoPollUpdate", state).Return(nil)
+
+	assert.NoError(t, p.Poll(t.Context(), now))
+	assert.Equal(t, 2, state.Failures)
+	assert.EqualValues(t, reset.Unix(), state.NextPoll)
+	assert.Contains(t, state.Error, "rate limit")
+	assert.Equal(t, map[string]string{"main": "a1"}, state.Branches)
+}
+
+func TestBackoff(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	err := assert.AnError
+
+	assert.Equal(t, 10*time.Minute, backoff(5*time.Minute, 1, err, now))
+	assert.Equal(t, 40*time.Minute, backoff(5*time.Minute, 3, err, now))
+	assert.Equal(t, maxBackoff, backoff(5*time.Minute, 10, err, now))
+	assert.Equal(t, maxBackoff, backoff(5*time.Minute, 100, err, now))
+
+	// a known rate limit reset takes precedence if it is later
+	assert.Equal(t, 2*time.Hour, backoff(5*time.Minute, 1, &forge_types.ErrRateLimited{Reset: now.Add(2 * time.Hour)}, now))
+	assert.Equal(t, 10*time.Minute, backoff(5*time.Minute, 1, &forge_types.ErrRateLimited{Reset: now.Add(time.Minute)}, now))
+}
+
+func TestListAll(t *testing.T) {
+	pages := [][]string{{"a", "b"}, {"c"}}
+	items, err := listAll(func(page int) ([]string, error) {
+		if page > len(pages) {
+			return nil, nil
+		}
+		return pages[page-1], nil
+	}, func(s string) string { return s })
+	assert.NoError(t, err)
+	// a short page ends the listing
+	assert.Equal(t, []string{"a", "b"}, items)
+
+	full := make([]string, perPage)
+	for i := range full {
+		full[i] = string(rune('a' + i))
+	}
+	calls := 0
+	items, err = listAll(func(int) ([]string, error) {
+		calls++
+		return full, nil
+	}, func(s string) string { return s })
+	assert.NoError(t, err)
+	// forges ignoring the pagination return the same page again
+	assert.Len(t, items, perPage)
+	assert.Equal(t, 2, calls)
+}
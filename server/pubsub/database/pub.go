@@ -0,0 +1,172 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub/memory"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	// pollInterval is how often the database is checked for messages of other
+	// servers if it can not notify about them.
+	pollInterval = time.Second
+	// settleTime is how long messages are looked for again after they were
+	// seen, as messages with lower ids can still become visible in that time.
+	settleTime = 10 * time.Second
+	// retention is how long messages are kept in the database.
+	retention = time.Minute
+)
+
+type publisher struct {
+	sync.Mutex
+
+	ctx      context.Context
+	store    store.Store
+	local    pubsub.PubSub
+	interval time.Duration
+
+	// cursor is the id all messages up to are passed on.
+	cursor int64
+	// seen holds the messages after the cursor that were passed on already.
+	seen        map[int64]time.Time
+	initialized bool
+}
+
+// New creates a publisher that passes messages on through the database, so
+// subscribers of all servers sharing the database receive them.
+func New(ctx context.Context, s store.Store) pubsub.PubSub {
+	return newPublisher(ctx, s, pollInterval)
+}
+
+func newPublisher(ctx context.Context, s store.Store, interval time.Duration) *publisher {
+	p := &publisher{
+		ctx:      ctx,
+		store:    s,
+		local:    memory.New(),
+		interval: interval,
+		seen:     make(map[int64]time.Time),
+	}
+	go p.run()
+	return p
+}
+
+func (p *publisher) Publish(c context.Context, topics pubsub.Topics, message pubsub.Message) error {
+	if len(topics) == 0 {
+		return fmt.Errorf("%w: specify at least one", pubsub.ErrNoTopic)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	stored := &model.PubSubMessage{
+		MessageID: message.ID,
+		Topics:    slices.Collect(maps.Keys(topics)),
+		Data:      message.Data,
+	}
+	if err := p.store.PubSubMessageCreate(stored); err != nil {
+		return err
+	}
+
+	// local subscribers get the message right away
+	p.seen[stored.ID] = time.Now()
+	return p.local.Publish(c, topics, message)
+}
+
+func (p *publisher) Subscribe(c context.Context, topics pubsub.Topics, receiver pubsub.Receiver) error {
+	return p.local.Subscribe(c, topics, receiver)
+}
+
+// run passes the messages of other servers on to the local subscribers.
+func (p *publisher) run() {
+	notify, err := p.store.PubSubListen(p.ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("pubsub: could not listen for notifications, only polling")
+	}
+
+	lastCleanup := time.Now()
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-time.After(p.interval):
+		case <-notify:
+		}
+
+		p.receive()
+
+		if time.Since(lastCleanup) > retention {
+			if err := p.store.PubSubMessageDeleteBefore(time.Now().Add(-retention).Unix()); err != nil {
+				log.Error().Err(err).Msg("pubsub: could not remove old messages")
+			}
+			lastCleanup = time.Now()
+		}
+	}
+}
+
+func (p *publisher) receive() {
+	p.Lock()
+	defer p.Unlock()
+
+	messages, err := p.store.PubSubMessageList(p.cursor)
+	if err != nil {
+		log.Error().Err(err).Msg("pubsub: could not load messages")
+		return
+	}
+
+	now := time.Now()
+	for _, message := range messages {
+		if _, ok := p.seen[message.ID]; ok {
+			continue
+		}
+
+		// messages that existed before the server started are skipped
+		if !p.initialized {
+			p.seen[message.ID] = time.Time{}
+			continue
+		}
+		p.seen[message.ID] = now
+
+		topics := make(pubsub.Topics, len(message.Topics))
+		for _, topic := range message.Topics {
+			topics[topic] = struct{}{}
+		}
+		if err := p.local.Publish(p.ctx, topics, pubsub.Message{ID: message.MessageID, Data: message.Data}); err != nil {
+			log.Error().Err(err).Msgf("pubsub: could not publish message %d", message.ID)
+		}
+	}
+	p.initialized = true
+
+	// ids are assigned before the messages become visible, so a message with
+	// a lower id can still show up for a while. Only once that time has passed
+	// the cursor moves on.
+	for id, seen := range p.seen {
+		if now.Sub(seen) > settleTime {
+			p.cursor = max(p.cursor, id)
+			delete(p.seen, id)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package database
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pubsub"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
+)
+
+func TestPubsubAcrossServers(t *testing.T) {
+	store := datastore.NewTestStore(t)
+
+	// a message from before the servers started is not passed on
+	assert.NoError(t, store.PubSubMessageCreate(&model.PubSubMessage{MessageID: "0", Topics: []string{"repo"}}))
+
+	server1 := newPublisher(t.Context(), store, 10*time.Millisecond)
+	server2 := newPublisher(t.Context(), store, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	var mu sync.Mutex
+	var received []string
+	go func() {
+		assert.NoError(t, server2.Subscribe(t.Context(), pubsub.Topics{"repo": {}, "public": {}}, func(message pubsub.Message) {
+			mu.Lock()
+			received = append(received, message.ID)
+			mu.Unlock()
+		}))
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	assert.NoError(t, server1.Publish(t.Context(), pubsub.Topics{"repo": {}, "public": {}}, pubsub.Message{ID: "1", Data: []byte("data")}))
+	assert.NoError(t, server1.Publish(t.Context(), pubsub.Topics{"other": {}}, pubsub.Message{ID: "2"}))
+	assert.NoError(t, server2.Publish(t.Context(), pubsub.Topics{"public": {}}, pubsub.Message{ID: "3"}))
+	time.Sleep(100 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []string{"1", "3"}, received)
+}
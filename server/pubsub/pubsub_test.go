@@ -27,7 +27,7 @@ import (
 )
 
 func TestPubSub(t *testing.T) {
-	// for each pubsub adapter (currently we have only one)
+	// for each pubsub adapter, the database one is tested in database_test.go
 	t.Run("in_memory", func(t *testing.T) {
 		testPubSub(t, memory.New())
 	})
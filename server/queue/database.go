@@ -0,0 +1,447 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package queue
+
+import (
+	"cmp"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/constant"
+)
+
+// pausedConfigKey is the server config key the paused state of the database
+// queue is shared with.
+const pausedConfigKey = "queue-paused"
+
+// maxWaitInterval limits how far Wait backs off polling the state of a task.
+const maxWaitInterval = 2 * time.Second
+
+// database is a queue that keeps its state in the tasks table, so several
+// server instances can share it. Workers are local to the server they poll,
+// tasks are handed out by atomically claiming them in the store.
+type database struct {
+	sync.Mutex
+
+	ctx       context.Context
+	store     store.Store
+	workers   map[*worker]struct{}
+	extension time.Duration
+	share     FairShare
+	resources AgentResources
+}
+
+// NewDatabaseQueue returns a new queue backed by the store.
+func NewDatabaseQueue(ctx context.Context, s store.Store) Queue {
+	return newDatabaseQueue(ctx, Config{Store: s})
+}
+
+func newDatabaseQueue(ctx context.Context, config Config) Queue {
+	q := &database{
+		ctx:       ctx,
+		store:     config.Store,
+		workers:   map[*worker]struct{}{},
+		extension: constant.TaskTimeout,
+		share:     config.FairShare,
+		resources: config.AgentResources,
+	}
+	go q.process()
+	return q
+}
+
+// PushAtOnce pushes multiple tasks to the tail of this queue.
+func (q *database) PushAtOnce(_ context.Context, tasks []*model.Task) error {
+	for _, task := range tasks {
+		task.State = model.TaskStatePending
+	}
+	return q.store.TaskInsertBatch(tasks)
+}
+
+// Poll retrieves and removes a task head of this queue.
+func (q *database) Poll(c context.Context, agentID int64, filter func(*model.Task) (bool, int)) (*model.Task, error) {
+	q.Lock()
+	ctx, stop := context.WithCancelCause(c)
+
+	w := &worker{
+		agentID: agentID,
+		channel: make(chan *model.Task, 1),
+		filter:  filter,
+		stop:    stop,
+	}
+	q.workers[w] = struct{}{}
+	q.Unlock()
+
+	for {
+		select {
+		case <-ctx.Done():
+			q.Lock()
+			delete(q.workers, w)
+			q.Unlock()
+			return nil, ctx.Err()
+		case t := <-w.channel:
+			return t, nil
+		}
+	}
+}
+
+// Done signals the task is complete.
+func (q *database) Done(_ context.Context, id string, exitStatus model.StatusValue) error {
+	return q.finished([]string{id}, exitStatus, nil)
+}
+
+// Error signals the task is done with an error.
+func (q *database) Error(_ context.Context, id string, err error) error {
+	return q.finished([]string{id}, model.StatusFailure, err)
+}
+
+// ErrorAtOnce signals multiple tasks are done and complete with an error.
+// If still pending they will just get removed from the queue.
+func (q *database) ErrorAtOnce(_ context.Context, ids []string, err error) error {
+	if errors.Is(err, ErrCancel) {
+		return q.finished(ids, model.StatusKilled, err)
+	}
+	return q.finished(ids, model.StatusFailure, err)
+}
+
+func (q *database) finished(ids []string, exitStatus model.StatusValue, err error) error {
+	var errs []error
+	for _, id := range ids {
+		// update the dependencies first, so dependent tasks never start without
+		// knowing the status of a task that already left the queue.
+		if err := q.store.TaskUpdateDepStatus(id, exitStatus); err != nil {
+			errs = append(errs, fmt.Errorf("task id [%s]: %w", id, err))
+			continue
+		}
+
+		// a canceled task is kept until its deadline so the server that waits
+		// for it can tell the agent about the cancellation.
+		if errors.Is(err, ErrCancel) {
+			cancelErr := q.store.TaskCancel(&model.Task{
+				ID:       id,
+				Deadline: time.Now().Add(q.extension).UnixMilli(),
+			})
+			if cancelErr == nil {
+				continue
+			}
+			if !errors.Is(cancelErr, types.ErrRecordNotExist) {
+				errs = append(errs, fmt.Errorf("task id [%s]: %w", id, cancelErr))
+				continue
+			}
+		}
+
+		if deleteErr := q.store.TaskDelete(id); deleteErr != nil {
+			if errors.Is(deleteErr, types.ErrRecordNotExist) {
+				deleteErr = ErrNotFound
+			}
+			errs = append(errs, fmt.Errorf("task id [%s]: %w", id, deleteErr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Retry puts a running task back into the queue for the given attempt.
+func (q *database) Retry(_ context.Context, id string, attempt int, delay time.Duration) error {
+	task, err := q.store.TaskLoad(id)
+	if errors.Is(err, types.ErrRecordNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if task.State != model.TaskStateRunning {
+		return ErrNotFound
+	}
+
+	err = q.store.TaskRetry(&model.Task{
+		ID:        id,
+		Attempt:   attempt,
+		NotBefore: time.Now().Add(delay).UnixMilli(),
+	})
+	if errors.Is(err, types.ErrRecordNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Wait waits until the item is done executing.
+// Also signals via error ErrCancel if workflow got canceled.
+// The state of the task is polled from the store, backing off while it
+// does not change.
+func (q *database) Wait(ctx context.Context, taskID string) error {
+	task, err := q.store.TaskLoad(taskID)
+	if errors.Is(err, types.ErrRecordNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("queue: could not load task %s: %w", taskID, err)
+	}
+	switch task.State {
+	case model.TaskStateCanceled:
+		return ErrCancel
+	case model.TaskStateRunning:
+	default:
+		return nil
+	}
+
+	interval := processTimeInterval
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+		interval = min(2*interval, maxWaitInterval)
+
+		current, err := q.store.TaskLoad(taskID)
+		if errors.Is(err, types.ErrRecordNotExist) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("queue: could not load task %s: %w", taskID, err)
+		}
+		if current.State == model.TaskStateCanceled {
+			return ErrCancel
+		}
+		// a retried task has its deadline cleared, expired ones keep it
+		if current.State == model.TaskStatePending && current.Deadline == 0 {
+			return nil
+		}
+		if current.State != model.TaskStateRunning || current.AgentID != task.AgentID {
+			return ErrTaskExpired
+		}
+	}
+}
+
+// Extend extends the task execution deadline.
+func (q *database) Extend(_ context.Context, agentID int64, taskID string) error {
+	err := q.store.TaskExtend(&model.Task{
+		ID:       taskID,
+		AgentID:  agentID,
+		Deadline: time.Now().Add(q.extension).UnixMilli(),
+	})
+	if !errors.Is(err, types.ErrRecordNotExist) {
+		return err
+	}
+
+	// find out why the task could not be extended
+	task, err := q.store.TaskLoad(taskID)
+	if errors.Is(err, types.ErrRecordNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if task.State != model.TaskStateRunning {
+		return ErrNotFound
+	}
+	if task.AgentID != agentID {
+		return ErrAgentMissMatch
+	}
+	// the deadline did not change as it was just extended
+	return nil
+}
+
+// Info returns internal queue information.
+func (q *database) Info(_ context.Context) InfoT {
+	q.Lock()
+	workers := len(q.workers)
+	q.Unlock()
+
+	snapshot, err := q.snapshot()
+	if err != nil {
+		log.Error().Err(err).Msg("queue: could not load tasks")
+		snapshot = newSnapshot(nil)
+	}
+	snapshot.filterWaiting()
+
+	stats := snapshot.Info(q.ctx)
+	stats.Stats.Workers = workers
+	stats.Paused = q.paused()
+	return stats
+}
+
+// Pause stops the queue from handing out new work items in Poll.
+func (q *database) Pause() {
+	if err := q.store.ServerConfigSet(pausedConfigKey, strconv.FormatBool(true)); err != nil {
+		log.Error().Err(err).Msg("queue: could not pause")
+	}
+}
+
+// Resume starts the queue again.
+func (q *database) Resume() {
+	if err := q.store.ServerConfigDelete(pausedConfigKey); err != nil && !errors.Is(err, types.ErrRecordNotExist) {
+		log.Error().Err(err).Msg("queue: could not resume")
+	}
+}
+
+// KickAgentWorkers kicks all workers for a given agent.
+func (q *database) KickAgentWorkers(agentID int64) {
+	q.Lock()
+	defer q.Unlock()
+
+	for worker := range q.workers {
+		if worker.agentID == agentID {
+			worker.stop(ErrWorkerKicked)
+			delete(q.workers, worker)
+		}
+	}
+}
+
+func (q *database) paused() bool {
+	value, err := q.store.ServerConfigGet(pausedConfigKey)
+	if err != nil {
+		if !errors.Is(err, types.ErrRecordNotExist) {
+			log.Error().Err(err).Msg("queue: could not load paused state")
+		}
+		return false
+	}
+	paused, _ := strconv.ParseBool(value)
+	return paused
+}
+
+// helper function that loops through the queue and attempts to
+// match the item to a single subscriber until context got cancel.
+func (q *database) process() {
+	for {
+		select {
+		case <-time.After(processTimeInterval):
+		case <-q.ctx.Done():
+			return
+		}
+
+		if q.paused() {
+			continue
+		}
+
+		if err := q.store.TaskResubmitExpired(time.Now().UnixMilli()); err != nil {
+			log.Error().Err(err).Msg("queue: could not resubmit expired tasks")
+		}
+
+		q.Lock()
+		if len(q.workers) > 0 {
+			q.assign()
+		}
+		q.Unlock()
+	}
+}
+
+// assign hands pending tasks to the local workers. The tasks are matched with
+// the same rules the memory queue uses, on a snapshot of the tasks table. As
+// other servers work on the same table, a task is only handed out after it was
+// claimed successfully.
+//
+// Expects the queue to be locked by the caller.
+func (q *database) assign() {
+	snapshot, err := q.snapshot()
+	if err != nil {
+		log.Error().Err(err).Msg("queue: could not load tasks")
+		return
+	}
+	snapshot.workers = q.workers
+	snapshot.share = q.share
+	snapshot.resources = q.resources
+	snapshot.filterWaiting()
+
+	for pending, worker := snapshot.assignToWorker(); pending != nil && worker != nil; pending, worker = snapshot.assignToWorker() {
+		task, _ := pending.Value.(*model.Task)
+		snapshot.pending.Remove(pending)
+
+		claim := &model.Task{
+			ID:       task.ID,
+			AgentID:  worker.agentID,
+			Deadline: time.Now().Add(q.extension).UnixMilli(),
+		}
+		claimed, err := q.store.TaskClaim(claim)
+		if err != nil {
+			log.Error().Err(err).Msgf("queue: could not claim task %s", task.ID)
+			continue
+		}
+		if !claimed {
+			log.Debug().Msgf("queue: task %s was claimed by another server", task.ID)
+			continue
+		}
+
+		task.State = claim.State
+		task.AgentID = claim.AgentID
+		task.Deadline = claim.Deadline
+		delete(q.workers, worker)
+		snapshot.running[task.ID] = &entry{item: task}
+		worker.channel <- task
+	}
+}
+
+// snapshot loads the tasks of the store into a memory queue that is not
+// processed, to evaluate the queue rules on it.
+func (q *database) snapshot() (*fifo, error) {
+	tasks, err := q.store.TaskList()
+	if err != nil {
+		return nil, err
+	}
+	return newSnapshot(tasks), nil
+}
+
+func newSnapshot(tasks []*model.Task) *fifo {
+	// the tasks table has no insertion order, so pending tasks are kept in the
+	// order they were instantiated.
+	slices.SortStableFunc(tasks, func(a, b *model.Task) int {
+		switch {
+		case taskOrderLess(a, b):
+			return -1
+		case taskOrderLess(b, a):
+			return 1
+		}
+		return compareTaskIDs(a.ID, b.ID)
+	})
+
+	snapshot := &fifo{
+		workers:       map[*worker]struct{}{},
+		running:       map[string]*entry{},
+		pending:       list.New(),
+		waitingOnDeps: list.New(),
+	}
+	for _, task := range tasks {
+		switch task.State {
+		case model.TaskStateRunning:
+			snapshot.running[task.ID] = &entry{item: task}
+		case model.TaskStateCanceled:
+			// canceled tasks only wait for their cleanup
+		default:
+			snapshot.pending.PushBack(task)
+		}
+	}
+	return snapshot
+}
+
+// compareTaskIDs compares task ids numerically if possible, as they are the
+// ids of the workflows.
+func compareTaskIDs(a, b string) int {
+	numA, errA := strconv.ParseInt(a, 10, 64)
+	numB, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return cmp.Compare(numA, numB)
+	}
+	return cmp.Compare(a, b)
+}
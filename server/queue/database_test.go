@@ -0,0 +1,165 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func setupDatabaseTestQueue(t *testing.T) (context.Context, context.CancelCauseFunc, Queue) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	t.Cleanup(func() { cancel(nil) })
+
+	q, _ := NewDatabaseQueue(ctx, datastore.NewTestStore(t)).(*database)
+	if q == nil {
+		t.Fatal("Failed to create queue")
+	}
+
+	return ctx, cancel, q
+}
+
+func TestDatabaseBasicOperations(t *testing.T) {
+	testQueueBasicOperations(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseDependencies(t *testing.T) {
+	testQueueDependencies(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseConcurrency(t *testing.T) {
+	testQueueConcurrency(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseLeaseManagement(t *testing.T) {
+	testQueueLeaseManagement(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseWorkerManagement(t *testing.T) {
+	testQueueWorkerManagement(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseLabelBasedScoring(t *testing.T) {
+	testQueueLabelBasedScoring(t, setupDatabaseTestQueue)
+}
+
+func TestDatabasePriority(t *testing.T) {
+	testQueuePriority(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseFairShare(t *testing.T) {
+	testQueueFairShare(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseAgentResources(t *testing.T) {
+	testQueueAgentResources(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseRetry(t *testing.T) {
+	testQueueRetry(t, setupDatabaseTestQueue)
+}
+
+func TestDatabaseMultipleServers(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(t.Context())
+	defer cancel(nil)
+
+	// two servers sharing the same store
+	store := datastore.NewTestStore(t)
+	q1 := NewDatabaseQueue(ctx, store)
+	q2 := NewDatabaseQueue(ctx, store)
+
+	t.Run("task is handed out once", func(t *testing.T) {
+		var tasks []*model.Task
+		for _, id := range []string{"1", "2", "3", "4"} {
+			tasks = append(tasks, &model.Task{ID: id})
+		}
+		assert.NoError(t, q1.PushAtOnce(ctx, tasks))
+
+		var mu sync.Mutex
+		got := map[string]int{}
+		var wg sync.WaitGroup
+		for i, q := range []Queue{q1, q2, q1, q2} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				task, err := q.Poll(ctx, int64(i+1), filterFnTrue)
+				assert.NoError(t, err)
+				mu.Lock()
+				got[task.ID]++
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, map[string]int{"1": 1, "2": 1, "3": 1, "4": 1}, got)
+		for id := range got {
+			assert.NoError(t, q2.Done(ctx, id, model.StatusSuccess))
+		}
+	})
+
+	t.Run("cancel reaches the waiting server", func(t *testing.T) {
+		assert.NoError(t, q1.PushAtOnce(ctx, []*model.Task{{ID: "5"}}))
+		got, err := q1.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- q1.Wait(ctx, got.ID) }()
+
+		assert.NoError(t, q2.Extend(ctx, 1, got.ID))
+		assert.ErrorIs(t, q2.Extend(ctx, 2, got.ID), ErrAgentMissMatch)
+		assert.NoError(t, q2.ErrorAtOnce(ctx, []string{got.ID}, ErrCancel))
+
+		select {
+		case err := <-errCh:
+			assert.ErrorIs(t, err, ErrCancel)
+		case <-time.After(time.Second):
+			t.Fatal("Wait should return when the task is canceled")
+		}
+	})
+
+	t.Run("pause is shared", func(t *testing.T) {
+		q1.Pause()
+		assert.True(t, q2.Info(ctx).Paused)
+		q2.Resume()
+		assert.False(t, q1.Info(ctx).Paused)
+	})
+}
+
+func TestDatabaseWaitStoreError(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	q := &database{ctx: t.Context(), store: store}
+
+	store.EXPECT().TaskLoad("1").Return(&model.Task{ID: "1", State: model.TaskStateRunning, AgentID: 1}, nil).Once()
+	store.EXPECT().TaskLoad("1").Return(nil, errors.New("database is gone")).Once()
+	assert.ErrorContains(t, q.Wait(t.Context(), "1"), "database is gone")
+
+	store.EXPECT().TaskLoad("2").Return(nil, errors.New("database is gone")).Once()
+	assert.ErrorContains(t, q.Wait(t.Context(), "2"), "database is gone")
+
+	store.EXPECT().TaskLoad("3").Return(nil, types.ErrRecordNotExist).Once()
+	assert.NoError(t, q.Wait(t.Context(), "3"))
+}
@@ -52,6 +52,8 @@ type fifo struct {
 	waitingOnDeps *list.List
 	extension     time.Duration
 	paused        bool
+	share         FairShare
+	resources     AgentResources
 }
 
 // processTimeInterval is the time till the queue rearranges things,
@@ -60,6 +62,10 @@ const processTimeInterval = 100 * time.Millisecond
 
 // NewMemoryQueue returns a new fifo queue.
 func NewMemoryQueue(ctx context.Context) Queue {
+	return newMemoryQueue(ctx, Config{})
+}
+
+func newMemoryQueue(ctx context.Context, config Config) Queue {
 	q := &fifo{
 		ctx:           ctx,
 		workers:       map[*worker]struct{}{},
@@ -68,6 +74,8 @@ func NewMemoryQueue(ctx context.Context) Queue {
 		waitingOnDeps: list.New(),
 		extension:     constant.TaskTimeout,
 		paused:        false,
+		share:         config.FairShare,
+		resources:     config.AgentResources,
 	}
 	go q.process()
 	return q
@@ -159,6 +167,26 @@ func (q *fifo) finished(ids []string, exitStatus model.StatusValue, err error) e
 	return errors.Join(errs...)
 }
 
+// Retry puts a running task back into the queue for the given attempt.
+func (q *fifo) Retry(_ context.Context, id string, attempt int, delay time.Duration) error {
+	q.Lock()
+	defer q.Unlock()
+
+	state, ok := q.running[id]
+	if !ok {
+		return ErrNotFound
+	}
+	delete(q.running, id)
+	close(state.done)
+
+	task := state.item
+	task.Attempt = attempt
+	task.AgentID = 0
+	task.NotBefore = time.Now().Add(delay).UnixMilli()
+	q.pending.PushFront(task)
+	return nil
+}
+
 // Wait waits until the item is done executing.
 // Also signals via error ErrCancel if workflow got canceled.
 func (q *fifo) Wait(ctx context.Context, taskID string) error {
@@ -311,12 +339,37 @@ func (q *fifo) filterWaiting() {
 	}
 }
 
+// assignToWorker picks the next pending task and the worker it goes to.
+// Candidates are ordered by priority first, then by the fair share of their
+// groups if enabled; other tasks keep their queue order. A task is only
+// considered if it fits its concurrency group and at least one worker matches
+// it and has enough resources left, in which case the best scoring worker wins.
+// Tasks waiting for their retry delay are skipped.
+//
+// Expects the queue to be locked by the caller.
 func (q *fifo) assignToWorker() (*list.Element, *worker) {
+	var bestElement *list.Element
 	var bestWorker *worker
-	var bestScore int
 
+	running := q.runningPerGroup()
+	used := q.usedPerAgent()
+	now := time.Now().UnixMilli()
 	for element := q.pending.Front(); element != nil; element = element.Next() {
 		task, _ := element.Value.(*model.Task)
+
+		// a task put back into the queue waits for its retry delay
+		if task.NotBefore > now {
+			continue
+		}
+
+		// a task that was found before wins ties, so only look at tasks that
+		// go strictly before the current candidate.
+		if bestElement != nil {
+			if best, _ := bestElement.Value.(*model.Task); !q.goesBefore(task, best, running) {
+				continue
+			}
+		}
+
 		log.Debug().Msgf("queue: trying to assign task: %v with deps %v", task.ID, task.Dependencies)
 
 		// skip tasks that would exceed their workflow concurrency limit, they
@@ -326,20 +379,93 @@ func (q *fifo) assignToWorker() (*list.Element, *worker) {
 			continue
 		}
 
+		var taskWorker *worker
+		var bestScore int
 		for worker := range q.workers {
+			if !q.fitsAgent(task, worker.agentID, used) {
+				continue
+			}
 			matched, score := worker.filter(task)
 			if matched && score > bestScore {
-				bestWorker = worker
+				taskWorker = worker
 				bestScore = score
 			}
 		}
-		if bestWorker != nil {
-			log.Debug().Msgf("queue: assigned task: %v with deps %v to worker with score %d", task.ID, task.Dependencies, bestScore)
-			return element, bestWorker
+		if taskWorker != nil {
+			log.Debug().Msgf("queue: task %v with priority %d matches worker with score %d", task.ID, task.Priority, bestScore)
+			bestElement = element
+			bestWorker = taskWorker
+		}
+	}
+
+	if bestElement != nil {
+		task, _ := bestElement.Value.(*model.Task)
+		log.Debug().Msgf("queue: assigned task: %v with deps %v", task.ID, task.Dependencies)
+	}
+	return bestElement, bestWorker
+}
+
+// runningPerGroup counts the running tasks of each fair share group.
+//
+// Expects the queue to be locked by the caller.
+func (q *fifo) runningPerGroup() map[string]int {
+	if q.share == nil {
+		return nil
+	}
+	running := make(map[string]int)
+	for _, e := range q.running {
+		for _, group := range q.share.Groups(e.item) {
+			running[group]++
 		}
 	}
+	return running
+}
+
+// usedPerAgent sums up the resources of the running tasks of each agent.
+//
+// Expects the queue to be locked by the caller.
+func (q *fifo) usedPerAgent() map[int64]model.Resources {
+	if q.resources == nil {
+		return nil
+	}
+	used := make(map[int64]model.Resources)
+	for _, e := range q.running {
+		used[e.item.AgentID] = used[e.item.AgentID].Add(e.item.Resources)
+	}
+	return used
+}
 
-	return nil, nil
+// fitsAgent reports whether the agent has enough free resources for the task.
+func (q *fifo) fitsAgent(task *model.Task, agentID int64, used map[int64]model.Resources) bool {
+	if q.resources == nil || task.Resources.IsZero() {
+		return true
+	}
+	return q.resources.Allocatable(agentID).Fits(used[agentID], task.Resources)
+}
+
+// goesBefore reports whether task a is handed out before task b, that is
+// further ahead in the queue. Tasks of a higher priority go first. With a fair
+// share the task of the group using less of its share goes first, groups are
+// compared from the outermost one the tasks do not share.
+func (q *fifo) goesBefore(a, b *model.Task, running map[string]int) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if q.share == nil {
+		return false
+	}
+
+	groupsA, groupsB := q.share.Groups(a), q.share.Groups(b)
+	for i := 0; i < len(groupsA) && i < len(groupsB); i++ {
+		if groupsA[i] == groupsB[i] {
+			continue
+		}
+		// compare running/weight of both groups without dividing
+		usageA := running[groupsA[i]] * q.share.Weight(groupsB[i])
+		usageB := running[groupsB[i]] * q.share.Weight(groupsA[i])
+		return usageA < usageB
+	}
+	return false
 }
 
 // canRunConcurrent reports whether the given task may currently start without
@@ -423,6 +549,7 @@ func (q *fifo) resubmitExpiredPipelines() {
 		if time.Now().After(taskState.deadline) {
 			log.Info().Msgf("queue: resubmitting expired task %s", taskID)
 			taskState.error = ErrTaskExpired
+			taskState.item.Attempt = max(taskState.item.Attempt, 1) + 1
 			q.pending.PushFront(taskState.item)
 			delete(q.running, taskID)
 			close(taskState.done)
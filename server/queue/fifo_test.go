@@ -38,7 +38,10 @@ var (
 	waitForProcess = func() { time.Sleep(processTimeInterval + 50*time.Millisecond) }
 )
 
-func setupTestQueue(t *testing.T) (context.Context, context.CancelCauseFunc, *fifo) {
+// queueSetup creates the queue a shared queue test runs against.
+type queueSetup func(t *testing.T) (context.Context, context.CancelCauseFunc, Queue)
+
+func setupTestQueue(t *testing.T) (context.Context, context.CancelCauseFunc, Queue) {
 	ctx, cancel := context.WithCancelCause(t.Context())
 	t.Cleanup(func() { cancel(nil) })
 
@@ -50,8 +53,40 @@ func setupTestQueue(t *testing.T) (context.Context, context.CancelCauseFunc, *fi
 	return ctx, cancel, q
 }
 
+// assertPolled asserts that the agent polled the pushed task. The memory queue
+// hands out the pushed task itself, the database queue a copy that holds the
+// lease and the dependency results as well.
+func assertPolled(t *testing.T, q Queue, agentID int64, want, got *model.Task) {
+	t.Helper()
+
+	if _, ok := q.(*database); ok && got != nil {
+		leased := *want
+		leased.AgentID = agentID
+		leased.State = model.TaskStateRunning
+		leased.Deadline = got.Deadline
+		leased.DepStatus = got.DepStatus
+		assert.NotZero(t, got.Deadline)
+		want = &leased
+	}
+	assert.Equal(t, want, got)
+}
+
+// setExtension sets how long the lease of a handed out task lasts.
+func setExtension(q Queue, extension time.Duration) {
+	switch q := q.(type) {
+	case *fifo:
+		q.extension = extension
+	case *database:
+		q.extension = extension
+	}
+}
+
 func TestFifoBasicOperations(t *testing.T) {
-	ctx, cancel, q := setupTestQueue(t)
+	testQueueBasicOperations(t, setupTestQueue)
+}
+
+func testQueueBasicOperations(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
 	defer cancel(nil)
 
 	t.Run("push poll done lifecycle", func(t *testing.T) {
@@ -65,7 +100,7 @@ func TestFifoBasicOperations(t *testing.T) {
 
 		got, err := q.Poll(ctx, 1, filterFnTrue)
 		assert.NoError(t, err)
-		assert.Equal(t, dummyTask, got)
+		assertPolled(t, q, 1, dummyTask, got)
 
 		waitForProcess()
 		info = q.Info(ctx)
@@ -360,7 +395,11 @@ func TestFifoBasicOperations(t *testing.T) {
 }
 
 func TestFifoDependencies(t *testing.T) {
-	ctx, cancel, q := setupTestQueue(t)
+	testQueueDependencies(t, setupTestQueue)
+}
+
+func testQueueDependencies(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
 	defer cancel(nil)
 
 	t.Run("basic dependency handling", func(t *testing.T) {
@@ -385,20 +424,20 @@ func TestFifoDependencies(t *testing.T) {
 
 		got, err := q.Poll(ctx, 1, filterFnTrue)
 		assert.NoError(t, err)
-		assert.Equal(t, task1, got)
+		assertPolled(t, q, 1, task1, got)
 		assert.NoError(t, q.Error(ctx, got.ID, fmt.Errorf("exit code 1")))
 
 		waitForProcess()
 		got, err = q.Poll(ctx, 1, filterFnTrue)
 		assert.NoError(t, err)
-		assert.Equal(t, task2, got)
+		assertPolled(t, q, 1, task2, got)
 		assert.False(t, got.ShouldRun())
 		assert.Equal(t, model.StatusFailure, got.DepStatus["dep-basic-1"])
 
 		waitForProcess()
 		got, err = q.Poll(ctx, 1, filterFnTrue)
 		assert.NoError(t, err)
-		assert.Equal(t, task3, got)
+		assertPolled(t, q, 1, task3, got)
 		assert.True(t, got.ShouldRun())
 		assert.Equal(t, model.StatusFailure, got.DepStatus["dep-basic-1"])
 
@@ -407,8 +446,10 @@ func TestFifoDependencies(t *testing.T) {
 		assert.Equal(t, 0, info.Stats.WaitingOnDeps)
 
 		// Edge case: verify DepStatus is correctly set before polling
-		assert.NotEmpty(t, task2.DepStatus)
-		assert.NotEmpty(t, task3.DepStatus)
+		if _, ok := q.(*fifo); ok {
+			assert.NotEmpty(t, task2.DepStatus)
+			assert.NotEmpty(t, task3.DepStatus)
+		}
 	})
 
 	t.Run("multiple dependencies", func(t *testing.T) {
@@ -672,7 +713,11 @@ func TestFifoDependencies(t *testing.T) {
 }
 
 func TestFifoConcurrency(t *testing.T) {
-	ctx, cancel, q := setupTestQueue(t)
+	testQueueConcurrency(t, setupTestQueue)
+}
+
+func testQueueConcurrency(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
 	defer cancel(nil)
 
 	t.Run("limit serializes group in instantiation order", func(t *testing.T) {
@@ -894,13 +939,17 @@ func TestFifoConcurrency(t *testing.T) {
 }
 
 func TestFifoLeaseManagement(t *testing.T) {
-	ctx, cancel, q := setupTestQueue(t)
+	testQueueLeaseManagement(t, setupTestQueue)
+}
+
+func testQueueLeaseManagement(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
 	defer cancel(nil)
 
 	t.Run("lease expiration", func(t *testing.T) {
-		q.extension = 0
+		setExtension(q, 0)
 		t.Cleanup(func() {
-			q.extension = 50 * time.Millisecond
+			setExtension(q, 50*time.Millisecond)
 		})
 		dummyTask := &model.Task{ID: "lease-exp-1"}
 		assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{dummyTask}))
@@ -928,6 +977,7 @@ func TestFifoLeaseManagement(t *testing.T) {
 		// Edge case: verify task was resubmitted to front of queue
 		got2, _ := q.Poll(ctx, 1, filterFnTrue)
 		assert.Equal(t, got.ID, got2.ID) // Same task resubmitted
+		assert.Equal(t, 2, got2.Attempt, "the lost attempt counts")
 
 		assert.NoError(t, q.Done(ctx, got2.ID, model.StatusSuccess))
 		waitForProcess()
@@ -939,7 +989,7 @@ func TestFifoLeaseManagement(t *testing.T) {
 	})
 
 	t.Run("extend lease", func(t *testing.T) {
-		q.extension = 50 * time.Millisecond
+		setExtension(q, 50*time.Millisecond)
 		dummyTask := &model.Task{ID: "extend-1"}
 		assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{dummyTask}))
 
@@ -1048,7 +1098,11 @@ func TestFifoLeaseManagement(t *testing.T) {
 }
 
 func TestFifoWorkerManagement(t *testing.T) {
-	ctx, cancel, q := setupTestQueue(t)
+	testQueueWorkerManagement(t, setupTestQueue)
+}
+
+func testQueueWorkerManagement(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
 	defer cancel(nil)
 
 	t.Run("poll with context cancellation", func(t *testing.T) {
@@ -1178,10 +1232,12 @@ func TestFifoWorkerManagement(t *testing.T) {
 }
 
 func TestFifoLabelBasedScoring(t *testing.T) {
-	ctx, cancel := context.WithCancelCause(t.Context())
-	defer cancel(nil)
+	testQueueLabelBasedScoring(t, setupTestQueue)
+}
 
-	q := NewMemoryQueue(ctx)
+func testQueueLabelBasedScoring(t *testing.T, setup queueSetup) {
+	ctx, cancel, q := setup(t)
+	defer cancel(nil)
 
 	tasks := []*model.Task{
 		{ID: "1", Labels: map[string]string{"org-id": "123", "platform": "linux"}},
@@ -1250,6 +1306,175 @@ func TestFifoLabelBasedScoring(t *testing.T) {
 	waitForProcess()
 }
 
+func TestFifoPriority(t *testing.T) {
+	testQueuePriority(t, setupTestQueue)
+}
+
+func testQueuePriority(t *testing.T, setup queueSetup) {
+	t.Run("higher priority is handed out first", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+
+		tasks := []*model.Task{
+			{ID: "nightly-1", Created: 1},
+			{ID: "nightly-2", Created: 2},
+			{ID: "release", Created: 3, Priority: 10},
+			{ID: "cleanup", Created: 4, Priority: -5},
+			{ID: "nightly-3", Created: 5},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+		waitForProcess()
+
+		var order []string
+		for range tasks {
+			got, err := q.Poll(ctx, 1, filterFnTrue)
+			assert.NoError(t, err)
+			order = append(order, got.ID)
+			assert.NoError(t, q.Done(ctx, got.ID, model.StatusSuccess))
+		}
+		assert.Equal(t, []string{"release", "nightly-1", "nightly-2", "nightly-3", "cleanup"}, order)
+	})
+
+	t.Run("unmatched high priority task does not block others", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+
+		tasks := []*model.Task{
+			{ID: "arm", Priority: 10, Labels: map[string]string{"platform": "linux/arm64"}},
+			{ID: "amd", Labels: map[string]string{"platform": "linux/amd64"}},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		amdOnly := func(task *model.Task) (bool, int) {
+			return task.Labels["platform"] == "linux/amd64", 1
+		}
+		got, err := q.Poll(ctx, 1, amdOnly)
+		assert.NoError(t, err)
+		assert.Equal(t, "amd", got.ID)
+	})
+
+	t.Run("concurrency group still applies", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+
+		tasks := []*model.Task{
+			{ID: "deploy-1", PipelineID: 1, Created: 1, ConcurrencyLimit: 1, ConcurrencyGroup: "deploy"},
+			{ID: "deploy-2", PipelineID: 2, Created: 2, ConcurrencyLimit: 1, ConcurrencyGroup: "deploy", Priority: 10},
+			{ID: "build", PipelineID: 3, Created: 3},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		// deploy-2 has the highest priority but must not overtake deploy-1 in
+		// its concurrency group, so the earlier deploy goes first.
+		first, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "deploy-1", first.ID)
+
+		second, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "build", second.ID)
+
+		assert.NoError(t, q.Done(ctx, first.ID, model.StatusSuccess))
+		third, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "deploy-2", third.ID)
+	})
+}
+
+// testShare groups tasks by their org and repo label.
+type testShare map[string]int
+
+func (s testShare) Groups(task *model.Task) []string {
+	return []string{"org/" + task.Labels["org"], "repo/" + task.Labels["repo"]}
+}
+
+func (s testShare) Weight(group string) int {
+	return max(s[group], 1)
+}
+
+// setFairShare enables fair share scheduling.
+func setFairShare(q Queue, share FairShare) {
+	switch q := q.(type) {
+	case *fifo:
+		q.Lock()
+		q.share = share
+		q.Unlock()
+	case *database:
+		q.Lock()
+		q.share = share
+		q.Unlock()
+	}
+}
+
+func TestFifoFairShare(t *testing.T) {
+	testQueueFairShare(t, setupTestQueue)
+}
+
+func testQueueFairShare(t *testing.T, setup queueSetup) {
+	genTasks := func(org, repo string, ids ...string) (tasks []*model.Task) {
+		for _, id := range ids {
+			tasks = append(tasks, &model.Task{ID: id, Labels: map[string]string{"org": org, "repo": repo}})
+		}
+		return tasks
+	}
+	pollAll := func(t *testing.T, ctx context.Context, q Queue, count int) (order []string) {
+		for range count {
+			got, err := q.Poll(ctx, 1, filterFnTrue)
+			assert.NoError(t, err)
+			order = append(order, got.ID)
+		}
+		return order
+	}
+
+	t.Run("orgs take turns", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setFairShare(q, testShare{})
+
+		tasks := append(genTasks("a", "a/1", "1", "2", "3", "4"), genTasks("b", "b/1", "5", "6")...)
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		// tasks keep running, so every org gets the same number of agents
+		assert.Equal(t, []string{"1", "5", "2", "6", "3", "4"}, pollAll(t, ctx, q, len(tasks)))
+	})
+
+	t.Run("weights", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setFairShare(q, testShare{"org/a": 2})
+
+		tasks := append(genTasks("a", "a/1", "1", "2", "3", "4", "5"), genTasks("b", "b/1", "6", "7")...)
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		// org a gets twice as many agents as org b
+		assert.Equal(t, []string{"1", "6", "2", "3", "7", "4", "5"}, pollAll(t, ctx, q, len(tasks)))
+	})
+
+	t.Run("repos of an org take turns", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setFairShare(q, testShare{})
+
+		tasks := append(genTasks("a", "a/1", "1", "2", "3"), genTasks("a", "a/2", "4")...)
+		tasks = append(tasks, genTasks("b", "b/1", "5")...)
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		assert.Equal(t, []string{"1", "5", "4", "2", "3"}, pollAll(t, ctx, q, len(tasks)))
+	})
+
+	t.Run("priority goes first", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setFairShare(q, testShare{})
+
+		tasks := append(genTasks("a", "a/1", "1", "2"), genTasks("b", "b/1", "3")...)
+		tasks[1].Priority = 10
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		assert.Equal(t, []string{"2", "3", "1"}, pollAll(t, ctx, q, len(tasks)))
+	})
+}
+
 func TestShouldRunLogic(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1311,3 +1536,146 @@ func findTaskByAgent(tasks map[string]int64, agentID int64) string {
 	}
 	return ""
 }
+
+// testResources maps agents to their allocatable resources.
+type testResources map[int64]model.Resources
+
+func (r testResources) Allocatable(agentID int64) model.Resources {
+	return r[agentID]
+}
+
+// setAgentResources enables resource aware scheduling.
+func setAgentResources(q Queue, resources AgentResources) {
+	switch q := q.(type) {
+	case *fifo:
+		q.Lock()
+		q.resources = resources
+		q.Unlock()
+	case *database:
+		q.Lock()
+		q.resources = resources
+		q.Unlock()
+	}
+}
+
+func TestFifoAgentResources(t *testing.T) {
+	testQueueAgentResources(t, setupTestQueue)
+}
+
+func testQueueAgentResources(t *testing.T, setup queueSetup) {
+	resources := testResources{
+		1: {CPU: 2000, Memory: 4 << 30},
+		2: {CPU: 8000},
+	}
+
+	t.Run("task waits for free resources", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setAgentResources(q, resources)
+
+		tasks := []*model.Task{
+			{ID: "1", Created: 1, Resources: model.Resources{CPU: 1500}},
+			{ID: "2", Created: 2, Resources: model.Resources{CPU: 1500}},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		got, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", got.ID)
+
+		// the second task does not fit next to the first one
+		pollCtx, pollCancel := context.WithTimeout(ctx, 300*time.Millisecond)
+		_, err = q.Poll(pollCtx, 1, filterFnTrue)
+		pollCancel()
+		assert.Error(t, err)
+
+		assert.NoError(t, q.Done(ctx, got.ID, model.StatusSuccess))
+		got, err = q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", got.ID)
+	})
+
+	t.Run("task goes to agent with enough resources", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setAgentResources(q, resources)
+
+		tasks := []*model.Task{
+			{ID: "1", Created: 1, Resources: model.Resources{CPU: 4000}},
+			{ID: "2", Created: 2, Resources: model.Resources{Memory: 1 << 30}},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		// agent 1 can not run the first task, so it gets the second one
+		got, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "2", got.ID)
+
+		got, err = q.Poll(ctx, 2, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", got.ID)
+	})
+
+	t.Run("agents without resources are not limited", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+		setAgentResources(q, resources)
+
+		tasks := []*model.Task{
+			{ID: "1", Created: 1, Resources: model.Resources{CPU: 64000}},
+		}
+		assert.NoError(t, q.PushAtOnce(ctx, tasks))
+
+		got, err := q.Poll(ctx, 3, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", got.ID)
+	})
+}
+
+func TestFifoRetry(t *testing.T) {
+	testQueueRetry(t, setupTestQueue)
+}
+
+func testQueueRetry(t *testing.T, setup queueSetup) {
+	t.Run("task is handed out again after the delay", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+
+		assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{{ID: "1", Attempt: 1}}))
+		got, err := q.Poll(ctx, 1, filterFnTrue)
+		assert.NoError(t, err)
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- q.Wait(ctx, got.ID) }()
+		waitForProcess()
+
+		assert.NoError(t, q.Retry(ctx, got.ID, 2, 500*time.Millisecond))
+		select {
+		case err := <-errCh:
+			assert.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timeout waiting for Wait to return")
+		}
+
+		// the task waits for its delay
+		pollCtx, pollCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		_, err = q.Poll(pollCtx, 2, filterFnTrue)
+		pollCancel()
+		assert.Error(t, err)
+
+		got, err = q.Poll(ctx, 2, filterFnTrue)
+		assert.NoError(t, err)
+		assert.Equal(t, "1", got.ID)
+		assert.Equal(t, 2, got.Attempt)
+		assert.EqualValues(t, 2, got.AgentID)
+	})
+
+	t.Run("only running tasks are retried", func(t *testing.T) {
+		ctx, cancel, q := setup(t)
+		defer cancel(nil)
+
+		assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{{ID: "1"}}))
+		assert.ErrorIs(t, q.Retry(ctx, "1", 2, 0), ErrNotFound)
+		assert.ErrorIs(t, q.Retry(ctx, "unknown", 2, 0), ErrNotFound)
+	})
+}
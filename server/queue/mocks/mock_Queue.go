@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
@@ -579,6 +580,75 @@ func (_c *MockQueue_Resume_Call) RunAndReturn(run func()) *MockQueue_Resume_Call
 	return _c
 }
 
+// Retry provides a mock function for the type MockQueue
+func (_mock *MockQueue) Retry(c context.Context, id string, attempt int, delay time.Duration) error {
+	ret := _mock.Called(c, id, attempt, delay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Retry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, time.Duration) error); ok {
+		r0 = returnFunc(c, id, attempt, delay)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockQueue_Retry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Retry'
+type MockQueue_Retry_Call struct {
+	*mock.Call
+}
+
+// Retry is a helper method to define mock.On call
+//   - c context.Context
+//   - id string
+//   - attempt int
+//   - delay time.Duration
+func (_e *MockQueue_Expecter) Retry(c any, id any, attempt any, delay any) *MockQueue_Retry_Call {
+	return &MockQueue_Retry_Call{Call: _e.mock.On("Retry", c, id, attempt, delay)}
+}
+
+func (_c *MockQueue_Retry_Call) Run(run func(c context.Context, id string, attempt int, delay time.Duration)) *MockQueue_Retry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Duration
+		if args[3] != nil {
+			arg3 = args[3].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockQueue_Retry_Call) Return(err error) *MockQueue_Retry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockQueue_Retry_Call) RunAndReturn(run func(c context.Context, id string, attempt int, delay time.Duration) error) *MockQueue_Retry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Wait provides a mock function for the type MockQueue
 func (_mock *MockQueue) Wait(c context.Context, id string) error {
 	ret := _mock.Called(c, id)
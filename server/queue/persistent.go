@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -166,6 +167,38 @@ func (q *persistentQueue) Error(c context.Context, id string, err error) error {
 	return nil
 }
 
+// Retry puts a running task back into the queue for the given attempt. Poll
+// removed the task from the backup already, so it is stored again first to
+// not be dropped as stale task on the next Poll.
+func (q *persistentQueue) Retry(c context.Context, id string, attempt int, delay time.Duration) error {
+	var task *model.Task
+	for _, running := range q.Queue.Info(c).Running {
+		if running.ID == id {
+			task = running
+			break
+		}
+	}
+	if task == nil {
+		return ErrNotFound
+	}
+
+	backup := *task
+	backup.Attempt = attempt
+	backup.AgentID = 0
+	backup.NotBefore = time.Now().Add(delay).UnixMilli()
+	if err := q.store.TaskInsert(&backup); err != nil {
+		return err
+	}
+
+	if err := q.Queue.Retry(c, id, attempt, delay); err != nil {
+		if deleteErr := q.store.TaskDelete(id); deleteErr != nil && !errors.Is(deleteErr, types.ErrRecordNotExist) {
+			return errors.Join(err, deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
 // ErrorAtOnce signals multiple tasks are done and complete with an error.
 // If still pending they will just get removed from the queue.
 func (q *persistentQueue) ErrorAtOnce(c context.Context, ids []string, err error) error {
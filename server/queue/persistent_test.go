@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
@@ -168,3 +169,37 @@ func TestPersistentQueueDoneIgnoresAlreadyRemovedBackupTask(t *testing.T) {
 	assert.Equal(t, 0, info.Stats.Pending)
 	assert.Equal(t, 0, info.Stats.Running)
 }
+
+// A retried task was removed from the backup by Poll already and must be
+// stored again, otherwise the next Poll drops it as stale.
+func TestPersistentQueueRetry(t *testing.T) {
+	ctx, cancel, q := setupTestQueue(t)
+	defer cancel(nil)
+
+	store := store_mocks.NewMockStore(t)
+	store.EXPECT().TaskDelete("1").Return(nil).Twice()
+	store.EXPECT().WorkflowLoad(int64(1)).Return(&model.Workflow{ID: 1, State: model.StatusPending}, nil).Twice()
+	store.EXPECT().TaskInsert(mock.MatchedBy(func(task *model.Task) bool {
+		return task.ID == "1" && task.Attempt == 2 && task.AgentID == 0
+	})).Return(nil).Once()
+
+	pq := &persistentQueue{Queue: q, store: store}
+
+	task := genDummyTask()
+	assert.NoError(t, q.PushAtOnce(ctx, []*model.Task{task}))
+
+	got, err := pq.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	assert.NotNil(t, got)
+
+	assert.NoError(t, pq.Retry(ctx, task.ID, 2, 0))
+
+	got, err = pq.Poll(ctx, 1, filterFnTrue)
+	assert.NoError(t, err)
+	if assert.NotNil(t, got, "retried task must be handed out again") {
+		assert.Equal(t, "1", got.ID)
+		assert.Equal(t, 2, got.Attempt)
+	}
+
+	assert.ErrorIs(t, pq.Retry(ctx, "unknown", 2, 0), ErrNotFound)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store"
@@ -126,6 +127,10 @@ type Queue interface {
 	// If still pending they will just get removed from the queue.
 	ErrorAtOnce(c context.Context, ids []string, err error) error
 
+	// Retry puts a running task back into the queue for the given attempt, it
+	// is handed out again once the delay passed.
+	Retry(c context.Context, id string, attempt int, delay time.Duration) error
+
 	// Wait waits until the task is complete.
 	// Also signals via error ErrCancel if workflow got canceled.
 	Wait(c context.Context, id string) error
@@ -143,17 +148,39 @@ type Queue interface {
 	KickAgentWorkers(agentID int64)
 }
 
+// FairShare balances the tasks handed out between groups of tasks, like the
+// orgs and repos they belong to. Among pending tasks of the same priority,
+// tasks of the group with the fewest running tasks relative to its weight go
+// first.
+type FairShare interface {
+	// Groups returns the groups of a task, from the outermost to the innermost.
+	Groups(task *model.Task) []string
+	// Weight returns the share of a group relative to the others, at least 1.
+	Weight(group string) int
+}
+
+// AgentResources provides the resources agents can allocate for tasks. A task
+// is only handed out to an agent if its resources fit next to the ones of the
+// tasks already running on the agent.
+type AgentResources interface {
+	// Allocatable returns the resources of an agent, unset ones are not limited.
+	Allocatable(agentID int64) model.Resources
+}
+
 // Config holds the configuration for the queue.
 type Config struct {
-	Backend Type
-	Store   store.Store
+	Backend        Type
+	Store          store.Store
+	FairShare      FairShare
+	AgentResources AgentResources
 }
 
 // Queue type.
 type Type string
 
 const (
-	TypeMemory Type = "memory"
+	TypeMemory   Type = "memory"
+	TypeDatabase Type = "database"
 )
 
 // New creates a new queue based on the provided configuration.
@@ -162,10 +189,15 @@ func New(ctx context.Context, config Config) (Queue, error) {
 
 	switch config.Backend {
 	case TypeMemory:
-		q = NewMemoryQueue(ctx)
+		q = newMemoryQueue(ctx, config)
 		if config.Store != nil {
 			q = WithTaskStore(ctx, q, config.Store)
 		}
+	case TypeDatabase:
+		if config.Store == nil {
+			return nil, fmt.Errorf("queue backend %s requires a store", config.Backend)
+		}
+		q = newDatabaseQueue(ctx, config)
 	default:
 		return nil, fmt.Errorf("unsupported queue backend: %s", config.Backend)
 	}
@@ -0,0 +1,308 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retention deletes pipelines and logs as defined by the retention
+// policies of repos, orgs and the global one.
+package retention
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	log_service "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	// Specifies the interval woodpecker applies the retention policies.
+	applyInterval = time.Hour
+
+	// Specifies the batch size of repos and pipelines to retrieve from database.
+	batchItems = 100
+
+	day = 24 * time.Hour
+)
+
+// Retention applies the retention policies, deleting pipelines from the store
+// and their logs and artifacts from the configured services.
+type Retention struct {
+	store     store.Store
+	logs      log_service.Service
+	artifacts artifact.Service
+}
+
+// New returns a Retention working with the given store and services.
+func New(store store.Store, logs log_service.Service, artifacts artifact.Service) *Retention {
+	return &Retention{
+		store:     store,
+		logs:      logs,
+		artifacts: artifacts,
+	}
+}
+
+// Run applies the retention policies periodically until ctx is canceled.
+func (r *Retention) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(applyInterval):
+			if err := r.Apply(ctx, time.Now()); err != nil {
+				log.Error().Err(err).Msg("apply retention policies")
+			}
+		}
+	}
+}
+
+// Apply applies the retention policies to all repos as of now.
+func (r *Retention) Apply(ctx context.Context, now time.Time) error {
+	policies, err := r.store.RetentionPolicyList()
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+	scopes := newPolicyScopes(policies)
+
+	for page := 1; ; page++ {
+		repos, err := r.store.RepoListAll(false, &model.ListOptionsWithAll{
+			ListOptions: &model.ListOptions{Page: page, PerPage: batchItems},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, repo := range repos {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			policy := scopes.forRepo(repo)
+			if policy == nil {
+				continue
+			}
+			// a failing repo must not block the others
+			if err := r.applyRepo(ctx, repo, policy, now); err != nil {
+				log.Error().Err(err).Str("repo", repo.FullName).Msg("apply retention policy")
+			}
+		}
+
+		if len(repos) < batchItems {
+			return nil
+		}
+	}
+}
+
+func (r *Retention) applyRepo(ctx context.Context, repo *model.Repo, policy *model.RetentionPolicy, now time.Time) error {
+	var kept map[int64]bool
+	if policy.DeletesPipelines() || policy.KeepLastSuccess {
+		expired, protected, err := r.selectPipelines(repo, policy, now)
+		if err != nil {
+			return err
+		}
+		for _, pipeline := range expired {
+			if err := r.deletePipeline(ctx, pipeline); err != nil {
+				return err
+			}
+		}
+		log.Debug().Str("repo", repo.FullName).Msgf("retention deleted %d pipelines", len(expired))
+		kept = protected
+	}
+
+	if policy.LogDays > 0 {
+		return r.deleteLogs(repo, now.Add(-time.Duration(policy.LogDays)*day).Unix(), kept)
+	}
+	return nil
+}
+
+// selectPipelines returns the pipelines to delete and the ids of the
+// successful pipelines the policy always keeps.
+func (r *Retention) selectPipelines(repo *model.Repo, policy *model.RetentionPolicy, now time.Time) (expired []*model.Pipeline, protected map[int64]bool, err error) {
+	var createdBefore int64
+	if policy.PipelineDays > 0 {
+		createdBefore = now.Add(-time.Duration(policy.PipelineDays) * day).Unix()
+	}
+
+	count := make(map[string]int)
+	protected = make(map[int64]bool)
+	hasSuccess := make(map[string]bool)
+
+	// pipelines are returned newest first, so counting them per group tells
+	// which ones are the latest
+	for page := 1; ; page++ {
+		pipelines, err := r.store.GetPipelineList(repo, &model.ListOptionsWithAll{
+			ListOptions: &model.ListOptions{Page: page, PerPage: batchItems},
+		}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, pipeline := range pipelines {
+			group := pipelineGroup(pipeline)
+			count[group]++
+
+			if policy.KeepLastSuccess && pipeline.Status == model.StatusSuccess && !hasSuccess[group] {
+				hasSuccess[group] = true
+				protected[pipeline.ID] = true
+				continue
+			}
+
+			switch {
+			case !policy.DeletesPipelines(),
+				count[group] <= policy.KeepPipelines,
+				createdBefore != 0 && pipeline.Created >= createdBefore,
+				isActive(pipeline):
+				continue
+			}
+			expired = append(expired, pipeline)
+		}
+
+		if len(pipelines) < batchItems {
+			return expired, protected, nil
+		}
+	}
+}
+
+// deletePipeline deletes the logs and artifacts of a pipeline from their
+// services before the pipeline itself is deleted.
+func (r *Retention) deletePipeline(ctx context.Context, pipeline *model.Pipeline) error {
+	steps, err := r.store.StepList(pipeline.ID)
+	if err != nil {
+		return err
+	}
+	for _, step := range steps {
+		if err := r.logs.LogDelete(step); err != nil {
+			return err
+		}
+	}
+
+	artifacts, err := r.store.ArtifactList(pipeline)
+	if err != nil {
+		return err
+	}
+	for _, upload := range artifacts {
+		if err := artifact.Delete(ctx, r.store, r.artifacts, upload); err != nil {
+			return err
+		}
+	}
+
+	// another server might have removed it in the meantime
+	if err := r.store.DeletePipeline(pipeline); err != nil && !errors.Is(err, types.ErrRecordNotExist) {
+		return err
+	}
+	return nil
+}
+
+// deleteLogs deletes the logs of all pipelines that finished before the given
+// unix timestamp, except the kept ones.
+func (r *Retention) deleteLogs(repo *model.Repo, finishedBefore int64, kept map[int64]bool) error {
+	// deleted ones drop out of the list, so only the kept ones need to be skipped
+	skipped := 0
+	for {
+		pipelines, err := r.store.GetPipelineListLogsExpired(repo, finishedBefore, skipped, batchItems)
+		if err != nil {
+			return err
+		}
+
+		for _, pipeline := range pipelines {
+			if kept[pipeline.ID] {
+				skipped++
+				continue
+			}
+
+			steps, err := r.store.StepList(pipeline.ID)
+			if err != nil {
+				return err
+			}
+			for _, step := range steps {
+				if err := r.logs.LogDelete(step); err != nil {
+					return err
+				}
+				if err := r.store.LogAttemptDelete(step); err != nil {
+					return err
+				}
+			}
+
+			pipeline.LogsDeleted = true
+			if err := r.store.UpdatePipeline(pipeline); err != nil {
+				return err
+			}
+		}
+
+		if len(pipelines) < batchItems {
+			return nil
+		}
+	}
+}
+
+// pipelineGroup returns the branch, tag or pull request a pipeline belongs to.
+func pipelineGroup(pipeline *model.Pipeline) string {
+	switch pipeline.Event {
+	case model.EventTag, model.EventRelease, model.EventPull, model.EventPullClosed, model.EventPullMetadata:
+		return pipeline.Ref
+	default:
+		return "refs/heads/" + pipeline.Branch
+	}
+}
+
+// isActive returns true if a pipeline still runs or waits to run.
+func isActive(pipeline *model.Pipeline) bool {
+	switch pipeline.Status {
+	case model.StatusRunning, model.StatusPending, model.StatusBlocked:
+		return true
+	}
+	return false
+}
+
+// policyScopes finds the policy of a repo, the most specific policy wins.
+type policyScopes struct {
+	global *model.RetentionPolicy
+	orgs   map[int64]*model.RetentionPolicy
+	repos  map[int64]*model.RetentionPolicy
+}
+
+func newPolicyScopes(policies []*model.RetentionPolicy) *policyScopes {
+	scopes := &policyScopes{
+		orgs:  make(map[int64]*model.RetentionPolicy),
+		repos: make(map[int64]*model.RetentionPolicy),
+	}
+	for _, policy := range policies {
+		switch {
+		case policy.IsGlobal():
+			scopes.global = policy
+		case policy.IsOrganization():
+			scopes.orgs[policy.OrgID] = policy
+		case policy.IsRepository():
+			scopes.repos[policy.RepoID] = policy
+		}
+	}
+	return scopes
+}
+
+func (s *policyScopes) forRepo(repo *model.Repo) *model.RetentionPolicy {
+	if policy, ok := s.repos[repo.ID]; ok {
+		return policy
+	}
+	if policy, ok := s.orgs[repo.OrgID]; ok {
+		return policy
+	}
+	return s.global
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	artifact_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/mocks"
+	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestApply(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	repo := &model.Repo{ID: 1, OrgID: 2, FullName: "octocat/hello-world"}
+	policies := []*model.RetentionPolicy{
+		{KeepPipelines: 5},
+		{OrgID: 2, KeepPipelines: 1, KeepLastSuccess: true, LogDays: 7},
+	}
+
+	// newest first, as the store returns them
+	pipelines := []*model.Pipeline{
+		{ID: 16, Number: 6, Event: model.EventPush, Branch: "main", Status: model.StatusRunning},
+		{ID: 15, Number: 5, Event: model.EventPush, Branch: "main", Status: model.StatusFailure},
+		{ID: 14, Number: 4, Event: model.EventPush, Branch: "main", Status: model.StatusSuccess},
+		{ID: 13, Number: 3, Event: model.EventPush, Branch: "main", Status: model.StatusSuccess},
+		{ID: 12, Number: 2, Event: model.EventPull, Branch: "main", Ref: "refs/pull/1/head", Status: model.StatusFailure},
+		{ID: 11, Number: 1, Event: model.EventTag, Ref: "refs/tags/v1.0.0", Status: model.StatusSuccess},
+	}
+	steps := map[int64][]*model.Step{
+		13: {{ID: 131, PipelineID: 13}},
+		15: {{ID: 151, PipelineID: 15}, {ID: 152, PipelineID: 15}},
+		12: {{ID: 121, PipelineID: 12}},
+	}
+	artifact := &model.Artifact{ID: 1, PipelineID: 15}
+
+	store := store_mocks.NewMockStore(t)
+	logs := log_mocks.NewMockService(t)
+	artifacts := artifact_mocks.NewMockService(t)
+
+	store.On("RetentionPolicyList").Return(policies, nil)
+	store.On("RepoListAll", false, mock.Anything).Return([]*model.Repo{repo}, nil)
+	store.On("GetPipelineList", repo, mock.Anything, (*model.PipelineFilter)(nil)).Return(pipelines, nil)
+	for pipelineID, pipelineSteps := range steps {
+		store.On("StepList", pipelineID).Return(pipelineSteps, nil)
+		for _, step := range pipelineSteps {
+			logs.On("LogDelete", step).Return(nil)
+		}
+	}
+
+	// the failed and the older successful pipeline of main are deleted
+	store.On("ArtifactList", pipelines[1]).Return([]*model.Artifact{artifact}, nil)
+	artifacts.On("ArtifactDelete", mock.Anything, artifact).Return(nil)
+	store.On("ArtifactDelete", artifact).Return(nil)
+	store.On("DeletePipeline", pipelines[1]).Return(nil)
+	store.On("ArtifactList", pipelines[3]).Return([]*model.Artifact{}, nil)
+	store.On("DeletePipeline", pipelines[3]).Return(nil)
+
+	// the tag is the last successful pipeline of its group, so its logs are kept
+	store.On("GetPipelineListLogsExpired", repo, now.Add(-7*day).Unix(), 0, batchItems).
+		Return([]*model.Pipeline{pipelines[5], pipelines[4]}, nil)
+	store.On("LogAttemptDelete", steps[12][0]).Return(nil)
+	store.On("UpdatePipeline", mock.MatchedBy(func(pipeline *model.Pipeline) bool {
+		return pipeline.ID == 12 && pipeline.LogsDeleted
+	})).Return(nil)
+
+	assert.NoError(t, New(store, logs, artifacts).Apply(t.Context(), now))
+	assert.False(t, pipelines[5].LogsDeleted)
+}
+
+func TestApplyPipelineDays(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	repo := &model.Repo{ID: 1}
+	pipelines := []*model.Pipeline{
+		{ID: 3, Branch: "main", Status: model.StatusSuccess, Created: now.Add(-time.Hour).Unix()},
+		{ID: 2, Branch: "main", Status: model.StatusSuccess, Created: now.Add(-2 * day).Unix()},
+		{ID: 1, Branch: "main", Status: model.StatusSuccess, Created: now.Add(-3 * day).Unix()},
+	}
+
+	store := store_mocks.NewMockStore(t)
+	store.On("RetentionPolicyList").Return([]*model.RetentionPolicy{{RepoID: 1, PipelineDays: 1}}, nil)
+	store.On("RepoListAll", false, mock.Anything).Return([]*model.Repo{repo, {ID: 2}}, nil)
+	store.On("GetPipelineList", repo, mock.Anything, (*model.PipelineFilter)(nil)).Return(pipelines, nil)
+	for _, pipeline := range pipelines[1:] {
+		store.On("StepList", pipeline.ID).Return([]*model.Step{}, nil)
+		store.On("ArtifactList", pipeline).Return([]*model.Artifact{}, nil)
+		store.On("DeletePipeline", pipeline).Return(nil)
+	}
+
+	// repo 2 has no policy and is left alone
+	assert.NoError(t, New(store, log_mocks.NewMockService(t), artifact_mocks.NewMockService(t)).Apply(t.Context(), now))
+}
+
+func TestPolicyScopes(t *testing.T) {
+	global := &model.RetentionPolicy{ID: 1}
+	org := &model.RetentionPolicy{ID: 2, OrgID: 10}
+	repo := &model.RetentionPolicy{ID: 3, RepoID: 100}
+	scopes := newPolicyScopes([]*model.RetentionPolicy{global, org, repo})
+
+	assert.Equal(t, repo, scopes.forRepo(&model.Repo{ID: 100, OrgID: 10}))
+	assert.Equal(t, org, scopes.forRepo(&model.Repo{ID: 101, OrgID: 10}))
+	assert.Equal(t, global, scopes.forRepo(&model.Repo{ID: 102, OrgID: 11}))
+	assert.Nil(t, newPolicyScopes([]*model.RetentionPolicy{org}).forRepo(&model.Repo{ID: 102, OrgID: 11}))
+}
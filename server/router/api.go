@@ -21,29 +21,43 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server"
 	"go.woodpecker-ci.org/woodpecker/v3/server/api"
 	"go.woodpecker-ci.org/woodpecker/v3/server/api/debug"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/router/middleware/session"
 )
 
 func apiRoutes(e *gin.RouterGroup) {
+	// scopes personal access tokens need, sessions and user tokens have all of them
+	repoRead := session.MustScope(model.TokenScopeRepoRead)
+	repoWrite := session.MustScope(model.TokenScopeRepoWrite)
+	pipelineWrite := session.MustScope(model.TokenScopePipelineWrite)
+	secretsRead := session.MustScope(model.TokenScopeSecretsRead)
+	secretsWrite := session.MustScope(model.TokenScopeSecretsWrite)
+	userWrite := session.MustScope(model.TokenScopeUserWrite)
+	admin := session.MustScope(model.TokenScopeAdmin)
+
 	apiBase := e.Group("/api")
 	{
 		user := apiBase.Group("/user")
 		{
 			user.Use(session.MustUser())
-			user.GET("", api.GetSelf)
-			user.GET("/feed", api.GetFeed)
-			user.POST("/token", api.PostToken)
-			user.DELETE("/token", api.DeleteToken)
+			user.GET("", repoRead, api.GetSelf)
+			user.GET("/feed", repoRead, api.GetFeed)
+			// user tokens have all scopes
+			user.POST("/token", admin, api.PostToken)
+			user.DELETE("/token", admin, api.DeleteToken)
+			user.GET("/tokens", userWrite, api.GetPersonalAccessTokens)
+			user.POST("/tokens", userWrite, api.PostPersonalAccessToken)
+			user.DELETE("/tokens/:token_id", userWrite, api.DeletePersonalAccessToken)
 			repoUserBase := user.Group("/repos")
 			{
-				repoUserBase.GET("", api.GetRepos)
-				repoUserBase.POST("/refresh", api.RefreshRepos)
+				repoUserBase.GET("", repoRead, api.GetRepos)
+				repoUserBase.POST("/refresh", repoWrite, api.RefreshRepos)
 			}
 		}
 
 		users := apiBase.Group("/users")
 		{
-			users.Use(session.MustAdmin())
+			users.Use(session.MustAdmin(), admin)
 			users.GET("", api.GetUsers)
 			users.POST("", api.PostUser)
 			users.GET("/:login", api.GetUser)
@@ -53,37 +67,53 @@ func apiRoutes(e *gin.RouterGroup) {
 
 		orgs := apiBase.Group("/orgs")
 		{
-			orgs.GET("", session.MustAdmin(), api.GetOrgs)
-			orgs.GET("/lookup/*org_full_name", api.LookupOrg)
+			orgs.GET("", session.MustAdmin(), admin, api.GetOrgs)
+			orgs.GET("/lookup/*org_full_name", repoRead, api.LookupOrg)
 			orgBase := orgs.Group("/:org_id")
 			{
 				orgBase.Use(session.SetOrg())
 				orgBase.Use(session.MustOrg())
+				orgBase.Use(repoRead)
 				orgBase.GET("/permissions", api.GetOrgPermissions)
 				orgBase.GET("", session.MustOrgMember(false), api.GetOrg)
 
 				org := orgBase.Group("")
 				{
 					org.Use(session.MustOrgMember(true))
-					org.DELETE("", session.MustAdmin(), api.DeleteOrg)
-
-					org.GET("/secrets", api.GetOrgSecretList)
-					org.POST("/secrets", api.PostOrgSecret)
-					org.GET("/secrets/:secret", api.GetOrgSecret)
-					org.PATCH("/secrets/:secret", api.PatchOrgSecret)
-					org.DELETE("/secrets/:secret", api.DeleteOrgSecret)
-
-					org.GET("/registries", api.GetOrgRegistryList)
-					org.POST("/registries", api.PostOrgRegistry)
-					org.GET("/registries/:registry", api.GetOrgRegistry)
-					org.PATCH("/registries/:registry", api.PatchOrgRegistry)
-					org.DELETE("/registries/:registry", api.DeleteOrgRegistry)
+					org.PATCH("", session.MustAdmin(), admin, api.PatchOrg)
+					org.DELETE("", session.MustAdmin(), admin, api.DeleteOrg)
+
+					org.GET("/secrets", secretsRead, api.GetOrgSecretList)
+					org.POST("/secrets", secretsWrite, api.PostOrgSecret)
+					org.GET("/secrets/:secret", secretsRead, api.GetOrgSecret)
+					org.PATCH("/secrets/:secret", secretsWrite, api.PatchOrgSecret)
+					org.DELETE("/secrets/:secret", secretsWrite, api.DeleteOrgSecret)
+
+					org.GET("/registries", repoWrite, api.GetOrgRegistryList)
+					org.POST("/registries", repoWrite, api.PostOrgRegistry)
+					org.GET("/registries/:registry", repoWrite, api.GetOrgRegistry)
+					org.PATCH("/registries/:registry", repoWrite, api.PatchOrgRegistry)
+					org.DELETE("/registries/:registry", repoWrite, api.DeleteOrgRegistry)
+
+					org.GET("/audit", admin, api.GetOrgAuditLogs)
+
+					org.GET("/retention", repoWrite, api.GetOrgRetentionPolicy)
+					org.POST("/retention", repoWrite, api.PostOrgRetentionPolicy)
+					org.DELETE("/retention", repoWrite, api.DeleteOrgRetentionPolicy)
+
+					org.GET("/webhooks", repoWrite, api.GetOrgWebhooks)
+					org.POST("/webhooks", repoWrite, api.PostOrgWebhook)
+					org.GET("/webhooks/:webhook_id", repoWrite, api.GetOrgWebhook)
+					org.PATCH("/webhooks/:webhook_id", repoWrite, api.PatchOrgWebhook)
+					org.DELETE("/webhooks/:webhook_id", repoWrite, api.DeleteOrgWebhook)
+					org.GET("/webhooks/:webhook_id/deliveries", repoWrite, api.GetOrgWebhookDeliveries)
+					org.POST("/webhooks/:webhook_id/deliveries/:delivery_id/redeliver", repoWrite, api.PostOrgWebhookRedeliver)
 
 					if !server.Config.Agent.DisableUserRegisteredAgentRegistration {
-						org.GET("/agents", api.GetOrgAgents)
-						org.POST("/agents", api.PostOrgAgent)
-						org.PATCH("/agents/:agent_id", api.PatchOrgAgent)
-						org.DELETE("/agents/:agent_id", api.DeleteOrgAgent)
+						org.GET("/agents", repoWrite, api.GetOrgAgents)
+						org.POST("/agents", repoWrite, api.PostOrgAgent)
+						org.PATCH("/agents/:agent_id", repoWrite, api.PatchOrgAgent)
+						org.DELETE("/agents/:agent_id", repoWrite, api.DeleteOrgAgent)
 					}
 				}
 			}
@@ -91,12 +121,13 @@ func apiRoutes(e *gin.RouterGroup) {
 
 		repo := apiBase.Group("/repos")
 		{
-			repo.GET("/lookup/*repo_full_name", session.SetRepo(), session.SetPerm(), session.MustPull, api.LookupRepo)
-			repo.POST("", session.MustUser(), api.PostRepo)
-			repo.GET("", session.MustAdmin(), api.GetAllRepos)
-			repo.POST("/repair", session.MustAdmin(), api.RepairAllRepos)
+			repo.GET("/lookup/*repo_full_name", repoRead, session.SetRepo(), session.SetPerm(), session.MustPull, api.LookupRepo)
+			repo.POST("", session.MustUser(), repoWrite, api.PostRepo)
+			repo.GET("", session.MustAdmin(), admin, api.GetAllRepos)
+			repo.POST("/repair", session.MustAdmin(), admin, api.RepairAllRepos)
 			repoBase := repo.Group("/:repo_id")
 			{
+				repoBase.Use(repoRead)
 				repoBase.Use(session.SetRepo())
 				repoBase.Use(session.SetPerm())
 
@@ -112,53 +143,70 @@ func apiRoutes(e *gin.RouterGroup) {
 					repo.GET("/pull_requests", api.GetRepoPullRequests)
 
 					repo.GET("/pipelines", api.GetPipelines)
-					repo.POST("/pipelines", session.MustPush, api.CreatePipeline)
-					repo.DELETE("/pipelines/:pipeline_number", session.MustRepoAdmin(), session.SetPipeline(), api.DeletePipeline)
+					repo.POST("/pipelines", session.MustPush, pipelineWrite, api.CreatePipeline)
+					repo.DELETE("/pipelines/:pipeline_number", session.MustRepoAdmin(), pipelineWrite, session.SetPipeline(), api.DeletePipeline)
 					repo.GET("/pipelines/:pipeline_number", api.GetPipeline)
 					repo.GET("/pipelines/:pipeline_number/config", session.SetPipeline(), api.GetPipelineConfig)
+					repo.GET("/pipelines/:pipeline_number/attempts", session.SetPipeline(), api.GetPipelineAttempts)
+					repo.GET("/pipelines/:pipeline_number/artifacts", session.SetPipeline(), api.GetPipelineArtifacts)
+					repo.GET("/pipelines/:pipeline_number/artifacts/:artifact_id", session.SetPipeline(), api.GetPipelineArtifact)
+					repo.GET("/pipelines/:pipeline_number/tests", session.SetPipeline(), api.GetPipelineTests)
 					repo.GET("/pipelines/:pipeline_number/metadata", session.MustPush, session.SetPipeline(), api.GetPipelineMetadata)
 
 					// requires push permissions
-					repo.POST("/pipelines/:pipeline_number", session.MustPush, session.SetPipeline(), api.PostPipeline)
-					repo.POST("/pipelines/:pipeline_number/cancel", session.MustPush, session.SetPipeline(), api.CancelPipeline)
-					repo.POST("/pipelines/:pipeline_number/approve", session.MustPush, session.SetPipeline(), api.PostApproval)
-					repo.POST("/pipelines/:pipeline_number/decline", session.MustPush, session.SetPipeline(), api.PostDecline)
+					repo.POST("/pipelines/:pipeline_number", session.MustPush, pipelineWrite, session.SetPipeline(), api.PostPipeline)
+					repo.POST("/pipelines/:pipeline_number/cancel", session.MustPush, pipelineWrite, session.SetPipeline(), api.CancelPipeline)
+					repo.POST("/pipelines/:pipeline_number/approve", session.MustPush, pipelineWrite, session.SetPipeline(), api.PostApproval)
+					repo.POST("/pipelines/:pipeline_number/decline", session.MustPush, pipelineWrite, session.SetPipeline(), api.PostDecline)
 
+					repo.GET("/logs/search", api.SearchLogs)
 					repo.GET("/logs/:pipeline_number/:step_id", session.SetPipeline(), session.SetStep(), api.GetStepLogs)
 					repo.GET("/logs/:pipeline_number/:step_id/download", session.SetPipeline(), session.SetStep(), api.DownloadStepLogs)
-					repo.DELETE("/logs/:pipeline_number/:step_id", session.MustPush, session.SetPipeline(), session.SetStep(), api.DeleteStepLogs)
+					repo.GET("/logs/:pipeline_number/:step_id/attempts/:attempt", session.SetPipeline(), session.SetStep(), api.GetStepAttemptLogs)
+					repo.DELETE("/logs/:pipeline_number/:step_id", session.MustPush, pipelineWrite, session.SetPipeline(), session.SetStep(), api.DeleteStepLogs)
 
 					// requires push permissions
-					repo.DELETE("/logs/:pipeline_number", session.MustPush, session.SetPipeline(), api.DeletePipelineLogs)
+					repo.DELETE("/logs/:pipeline_number", session.MustPush, pipelineWrite, session.SetPipeline(), api.DeletePipelineLogs)
 
 					// requires push permissions
-					repo.GET("/secrets", session.MustPush, api.GetSecretList)
-					repo.POST("/secrets", session.MustPush, api.PostSecret)
-					repo.GET("/secrets/:secret", session.MustPush, api.GetSecret)
-					repo.PATCH("/secrets/:secret", session.MustPush, api.PatchSecret)
-					repo.DELETE("/secrets/:secret", session.MustPush, api.DeleteSecret)
+					repo.GET("/secrets", session.MustPush, secretsRead, api.GetSecretList)
+					repo.POST("/secrets", session.MustPush, secretsWrite, api.PostSecret)
+					repo.GET("/secrets/:secret", session.MustPush, secretsRead, api.GetSecret)
+					repo.PATCH("/secrets/:secret", session.MustPush, secretsWrite, api.PatchSecret)
+					repo.DELETE("/secrets/:secret", session.MustPush, secretsWrite, api.DeleteSecret)
 
 					// requires push permissions
-					repo.GET("/registries", session.MustPush, api.GetRegistryList)
-					repo.POST("/registries", session.MustPush, api.PostRegistry)
-					repo.GET("/registries/:registry", session.MustPush, api.GetRegistry)
-					repo.PATCH("/registries/:registry", session.MustPush, api.PatchRegistry)
-					repo.DELETE("/registries/:registry", session.MustPush, api.DeleteRegistry)
+					repo.GET("/registries", session.MustPush, repoWrite, api.GetRegistryList)
+					repo.POST("/registries", session.MustPush, repoWrite, api.PostRegistry)
+					repo.GET("/registries/:registry", session.MustPush, repoWrite, api.GetRegistry)
+					repo.PATCH("/registries/:registry", session.MustPush, repoWrite, api.PatchRegistry)
+					repo.DELETE("/registries/:registry", session.MustPush, repoWrite, api.DeleteRegistry)
 
 					// requires push permissions
-					repo.GET("/cron", session.MustPush, api.GetCronList)
-					repo.POST("/cron", session.MustPush, api.PostCron)
-					repo.GET("/cron/:cron", session.MustPush, api.GetCron)
-					repo.POST("/cron/:cron", session.MustPush, api.RunCron)
-					repo.PATCH("/cron/:cron", session.MustPush, api.PatchCron)
-					repo.DELETE("/cron/:cron", session.MustPush, api.DeleteCron)
+					repo.GET("/cron", session.MustPush, repoWrite, api.GetCronList)
+					repo.POST("/cron", session.MustPush, repoWrite, api.PostCron)
+					repo.GET("/cron/:cron", session.MustPush, repoWrite, api.GetCron)
+					repo.POST("/cron/:cron", session.MustPush, pipelineWrite, api.RunCron)
+					repo.PATCH("/cron/:cron", session.MustPush, repoWrite, api.PatchCron)
+					repo.DELETE("/cron/:cron", session.MustPush, repoWrite, api.DeleteCron)
 
 					// requires admin permissions
-					repo.PATCH("", session.MustRepoAdmin(), api.PatchRepo)
-					repo.DELETE("", session.MustRepoAdmin(), api.DeleteRepo)
-					repo.POST("/chown", session.MustRepoAdmin(), api.ChownRepo)
-					repo.POST("/repair", session.MustRepoAdmin(), api.RepairRepo)
-					repo.POST("/move", session.MustRepoAdmin(), api.MoveRepo)
+					repo.PATCH("", session.MustRepoAdmin(), repoWrite, api.PatchRepo)
+					repo.DELETE("", session.MustRepoAdmin(), repoWrite, api.DeleteRepo)
+					repo.POST("/chown", session.MustRepoAdmin(), repoWrite, api.ChownRepo)
+					repo.POST("/repair", session.MustRepoAdmin(), repoWrite, api.RepairRepo)
+					repo.GET("/hook-url", session.MustRepoAdmin(), repoWrite, api.GetRepoHookURL)
+					repo.POST("/move", session.MustRepoAdmin(), repoWrite, api.MoveRepo)
+					repo.GET("/retention", session.MustRepoAdmin(), repoWrite, api.GetRepoRetentionPolicy)
+					repo.POST("/retention", session.MustRepoAdmin(), repoWrite, api.PostRepoRetentionPolicy)
+					repo.DELETE("/retention", session.MustRepoAdmin(), repoWrite, api.DeleteRepoRetentionPolicy)
+					repo.GET("/webhooks", session.MustRepoAdmin(), repoWrite, api.GetRepoWebhooks)
+					repo.POST("/webhooks", session.MustRepoAdmin(), repoWrite, api.PostRepoWebhook)
+					repo.GET("/webhooks/:webhook_id", session.MustRepoAdmin(), repoWrite, api.GetRepoWebhook)
+					repo.PATCH("/webhooks/:webhook_id", session.MustRepoAdmin(), repoWrite, api.PatchRepoWebhook)
+					repo.DELETE("/webhooks/:webhook_id", session.MustRepoAdmin(), repoWrite, api.DeleteRepoWebhook)
+					repo.GET("/webhooks/:webhook_id/deliveries", session.MustRepoAdmin(), repoWrite, api.GetRepoWebhookDeliveries)
+					repo.POST("/webhooks/:webhook_id/deliveries/:delivery_id/redeliver", session.MustRepoAdmin(), repoWrite, api.PostRepoWebhookRedeliver)
 				}
 			}
 		}
@@ -177,13 +225,13 @@ func apiRoutes(e *gin.RouterGroup) {
 
 		pipelines := apiBase.Group("/pipelines")
 		{
-			pipelines.Use(session.MustAdmin())
+			pipelines.Use(session.MustAdmin(), admin)
 			pipelines.GET("", api.GetPipelineQueue)
 		}
 
 		queue := apiBase.Group("/queue")
 		{
-			queue.Use(session.MustAdmin())
+			queue.Use(session.MustAdmin(), admin)
 			queue.GET("/info", api.GetQueueInfo)
 			queue.POST("/pause", api.PauseQueue)
 			queue.POST("/resume", api.ResumeQueue)
@@ -193,13 +241,13 @@ func apiRoutes(e *gin.RouterGroup) {
 		// global secrets can be read without actual values by any user
 		readGlobalSecrets := apiBase.Group("/secrets")
 		{
-			readGlobalSecrets.Use(session.MustUser())
+			readGlobalSecrets.Use(session.MustUser(), secretsRead)
 			readGlobalSecrets.GET("", api.GetGlobalSecretList)
 			readGlobalSecrets.GET("/:secret", api.GetGlobalSecret)
 		}
 		secrets := apiBase.Group("/secrets")
 		{
-			secrets.Use(session.MustAdmin())
+			secrets.Use(session.MustAdmin(), admin)
 			secrets.POST("", api.PostGlobalSecret)
 			secrets.PATCH("/:secret", api.PatchGlobalSecret)
 			secrets.DELETE("/:secret", api.DeleteGlobalSecret)
@@ -208,28 +256,50 @@ func apiRoutes(e *gin.RouterGroup) {
 		// global registries can be read without actual values by any user
 		readGlobalRegistries := apiBase.Group("/registries")
 		{
-			readGlobalRegistries.Use(session.MustUser())
+			readGlobalRegistries.Use(session.MustUser(), repoRead)
 			readGlobalRegistries.GET("", api.GetGlobalRegistryList)
 			readGlobalRegistries.GET("/:registry", api.GetGlobalRegistry)
 		}
 		registries := apiBase.Group("/registries")
 		{
-			registries.Use(session.MustAdmin())
+			registries.Use(session.MustAdmin(), admin)
 			registries.POST("", api.PostGlobalRegistry)
 			registries.PATCH("/:registry", api.PatchGlobalRegistry)
 			registries.DELETE("/:registry", api.DeleteGlobalRegistry)
 		}
 
+		retention := apiBase.Group("/retention")
+		{
+			retention.Use(session.MustAdmin(), admin)
+			retention.GET("", api.GetGlobalRetentionPolicy)
+			retention.POST("", api.PostGlobalRetentionPolicy)
+			retention.DELETE("", api.DeleteGlobalRetentionPolicy)
+		}
+
+		webhooks := apiBase.Group("/webhooks")
+		{
+			webhooks.Use(session.MustAdmin(), admin)
+			webhooks.GET("", api.GetGlobalWebhooks)
+			webhooks.POST("", api.PostGlobalWebhook)
+			webhooks.GET("/:webhook_id", api.GetGlobalWebhook)
+			webhooks.PATCH("/:webhook_id", api.PatchGlobalWebhook)
+			webhooks.DELETE("/:webhook_id", api.DeleteGlobalWebhook)
+			webhooks.GET("/:webhook_id/deliveries", api.GetGlobalWebhookDeliveries)
+			webhooks.POST("/:webhook_id/deliveries/:delivery_id/redeliver", api.PostGlobalWebhookRedeliver)
+		}
+
+		apiBase.GET("/audit", session.MustAdmin(), admin, api.GetAuditLogs)
+
 		logLevel := apiBase.Group("/log-level")
 		{
-			logLevel.Use(session.MustAdmin())
+			logLevel.Use(session.MustAdmin(), admin)
 			logLevel.GET("", api.LogLevel)
 			logLevel.POST("", api.SetLogLevel)
 		}
 
 		agentBase := apiBase.Group("/agents")
 		{
-			agentBase.Use(session.MustAdmin())
+			agentBase.Use(session.MustAdmin(), admin)
 			agentBase.GET("", api.GetAgents)
 			agentBase.POST("", api.PostAgent)
 			agentBase.GET("/:agent_id", api.GetAgent)
@@ -238,34 +308,37 @@ func apiRoutes(e *gin.RouterGroup) {
 			agentBase.DELETE("/:agent_id", api.DeleteAgent)
 		}
 
-		apiBase.GET("/forges", api.GetForges)
-		apiBase.GET("/forges/:forge_id", api.GetForge)
+		apiBase.GET("/forges", admin, api.GetForges)
+		apiBase.GET("/forges/:forge_id", admin, api.GetForge)
 		forgeBase := apiBase.Group("/forges")
 		{
-			forgeBase.Use(session.MustAdmin())
+			forgeBase.Use(session.MustAdmin(), admin)
 			forgeBase.POST("", api.PostForge)
 			forgeBase.PATCH("/:forge_id", api.PatchForge)
 			forgeBase.DELETE("/:forge_id", api.DeleteForge)
 		}
 
 		apiBase.GET("/signature/public-key", api.GetSignaturePublicKey)
+		apiBase.GET("/oidc/jwks", api.GetJWKS)
 
 		apiBase.POST("/hook", api.PostHook)
+		apiBase.POST("/hook/forges/:forge_id", api.PostForgeHook)
 
 		stream := apiBase.Group("/stream")
 		{
 			stream.GET("/logs/:repo_id/:pipeline/:step_id",
+				repoRead,
 				session.SetRepo(),
 				session.SetPerm(),
 				session.MustPull,
 				api.LogStreamSSE)
-			stream.GET("/events", api.EventStreamSSE)
+			stream.GET("/events", repoRead, api.EventStreamSSE)
 		}
 
 		if zerolog.GlobalLevel() <= zerolog.DebugLevel {
 			debugger := apiBase.Group("/debug")
 			{
-				debugger.Use(session.MustAdmin())
+				debugger.Use(session.MustAdmin(), admin)
 				debugger.GET("/pprof/", debug.IndexHandler())
 				debugger.GET("/pprof/heap", debug.HeapHandler())
 				debugger.GET("/pprof/goroutine", debug.GoroutineHandler())
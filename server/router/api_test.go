@@ -0,0 +1,75 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// publicRoutes are the api routes that can be used without authentication.
+var publicRoutes = []string{
+	"GET /api/badges/:repo_id_or_owner/status.svg",
+	"GET /api/badges/:repo_id_or_owner/cc.xml",
+	"GET /api/badges/:repo_id_or_owner/:repo_name/status.svg",
+	"GET /api/badges/:repo_id_or_owner/:repo_name/cc.xml",
+	"GET /api/signature/public-key",
+	"GET /api/oidc/jwks",
+	"POST /api/hook",
+	"POST /api/hook/forges/:forge_id",
+}
+
+var routeParam = regexp.MustCompile(`[:*][a-z_]+`)
+
+// TestAPIRoutesHaveScope makes sure personal access tokens can't use a route
+// without being granted a scope for it.
+func TestAPIRoutesHaveScope(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+
+	scoped := map[string]bool{}
+	e.Use(func(c *gin.Context) {
+		scoped[c.Request.Method+" "+c.FullPath()] = slices.ContainsFunc(c.HandlerNames(), func(name string) bool {
+			return strings.Contains(name, "session.MustScope.")
+		})
+		c.AbortWithStatus(http.StatusNoContent)
+	})
+	apiRoutes(e.Group(""))
+
+	routes := e.Routes()
+	assert.NotEmpty(t, routes)
+	for _, route := range routes {
+		path := routeParam.ReplaceAllString(route.Path, "1")
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(route.Method, path, nil))
+
+		name := route.Method + " " + route.Path
+		checked, ok := scoped[name]
+		if !assert.True(t, ok, "route %s was not reached", name) {
+			continue
+		}
+		if slices.Contains(publicRoutes, name) {
+			assert.False(t, checked, "public route %s checks a scope", name)
+			continue
+		}
+		assert.True(t, checked, "route %s has no scope", name)
+	}
+}
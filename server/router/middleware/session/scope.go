@@ -0,0 +1,89 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package session
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
+)
+
+// lastUsedInterval limits how often the last use of a personal access token
+// is written to the database.
+const lastUsedInterval = time.Minute
+
+var errPersonalAccessTokenExpired = errors.New("personal access token expired")
+
+// PersonalAccessToken returns the personal access token the request was
+// authenticated with, it is nil for sessions and user tokens.
+func PersonalAccessToken(c *gin.Context) *model.PersonalAccessToken {
+	v, ok := c.Get("personal-access-token")
+	if !ok {
+		return nil
+	}
+	t, ok := v.(*model.PersonalAccessToken)
+	if !ok {
+		return nil
+	}
+	return t
+}
+
+// loadPersonalAccessToken returns the stored token of a parsed personal
+// access token, revoked and expired tokens are rejected.
+func loadPersonalAccessToken(c *gin.Context, user *model.User, t *token.Token) (*model.PersonalAccessToken, error) {
+	tokenID, err := strconv.ParseInt(t.Get("token-id"), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	_store := store.FromContext(c)
+	pat, err := _store.PersonalAccessTokenFind(user, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if pat.Expired(now) {
+		return nil, errPersonalAccessTokenExpired
+	}
+	if now.Sub(time.Unix(pat.LastUsed, 0)) >= lastUsedInterval {
+		pat.LastUsed = now.Unix()
+		if err := _store.PersonalAccessTokenUpdate(pat); err != nil {
+			log.Error().Err(err).Msgf("could not update last use of personal access token %d", pat.ID)
+		}
+	}
+	return pat, nil
+}
+
+// MustScope aborts requests authenticated with a personal access token that
+// was not granted the scope. Sessions and user tokens have all scopes.
+func MustScope(scope model.TokenScope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if pat := PersonalAccessToken(c); pat != nil && !pat.HasScope(scope) {
+			c.String(http.StatusForbidden, "Token is missing the %s scope", scope)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package session
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
+)
+
+func newScopeTestRouter(s store.Store) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	e := gin.New()
+	e.Use(func(c *gin.Context) { store.ToContext(c, s) })
+	e.Use(SetUser())
+	e.GET("/read", MustUser(), MustScope(model.TokenScopeRepoRead), func(c *gin.Context) { c.Status(http.StatusOK) })
+	e.POST("/pipelines", MustUser(), MustScope(model.TokenScopePipelineWrite), func(c *gin.Context) { c.Status(http.StatusOK) })
+	return e
+}
+
+func signPersonalToken(t *testing.T, user *model.User, tokenID int64) string {
+	t.Helper()
+	pat := token.New(token.PersonalToken)
+	pat.Set("user-id", strconv.FormatInt(user.ID, 10))
+	pat.Set("token-id", strconv.FormatInt(tokenID, 10))
+	signed, err := pat.Sign(user.Hash)
+	require.NoError(t, err)
+	return signed
+}
+
+func scopeRequest(e *gin.Engine, method, path, bearer string) int {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestPersonalAccessTokenScopes(t *testing.T) {
+	user := &model.User{ID: 1, Login: "alice", Hash: "alice-hash"}
+
+	t.Run("token is limited to its scopes", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("GetUser", user.ID).Return(user, nil)
+		s.On("PersonalAccessTokenFind", user, int64(5)).Return(&model.PersonalAccessToken{
+			ID: 5, UserID: user.ID, Scopes: []model.TokenScope{model.TokenScopeRepoRead},
+		}, nil)
+		// the last use is only stored once a minute
+		s.On("PersonalAccessTokenUpdate", mock.Anything).Return(nil).Once()
+		e := newScopeTestRouter(s)
+		bearer := signPersonalToken(t, user, 5)
+
+		assert.Equal(t, http.StatusOK, scopeRequest(e, http.MethodGet, "/read", bearer))
+		assert.Equal(t, http.StatusForbidden, scopeRequest(e, http.MethodPost, "/pipelines", bearer))
+	})
+
+	t.Run("user token has all scopes", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("GetUser", user.ID).Return(user, nil)
+		e := newScopeTestRouter(s)
+		userToken := token.New(token.UserToken)
+		userToken.Set("user-id", strconv.FormatInt(user.ID, 10))
+		bearer, err := userToken.Sign(user.Hash)
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, scopeRequest(e, http.MethodPost, "/pipelines", bearer))
+	})
+
+	t.Run("revoked token is rejected", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("GetUser", user.ID).Return(user, nil)
+		s.On("PersonalAccessTokenFind", user, int64(6)).Return(nil, types.ErrRecordNotExist)
+		e := newScopeTestRouter(s)
+
+		assert.Equal(t, http.StatusUnauthorized, scopeRequest(e, http.MethodGet, "/read", signPersonalToken(t, user, 6)))
+	})
+
+	t.Run("expired token is rejected", func(t *testing.T) {
+		s := store_mocks.NewMockStore(t)
+		s.On("GetUser", user.ID).Return(user, nil)
+		s.On("PersonalAccessTokenFind", user, int64(7)).Return(&model.PersonalAccessToken{
+			ID: 7, UserID: user.ID, Scopes: []model.TokenScope{model.TokenScopeAdmin}, Expires: time.Now().Add(-time.Minute).Unix(),
+		}, nil)
+		e := newScopeTestRouter(s)
+
+		assert.Equal(t, http.StatusUnauthorized, scopeRequest(e, http.MethodGet, "/read", signPersonalToken(t, user, 7)))
+	})
+}
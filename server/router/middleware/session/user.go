@@ -43,7 +43,7 @@ func SetUser() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var user *model.User
 
-		t, err := token.ParseRequest([]token.Type{token.UserToken, token.SessToken}, c.Request, func(t *token.Token) (string, error) {
+		t, err := token.ParseRequest([]token.Type{token.UserToken, token.SessToken, token.PersonalToken}, c.Request, func(t *token.Token) (string, error) {
 			var err error
 			userID, err := strconv.ParseInt(t.Get("user-id"), 10, 64)
 			if err != nil {
@@ -52,6 +52,14 @@ func SetUser() gin.HandlerFunc {
 			user, err = store.FromContext(c).GetUser(userID)
 			return user.Hash, err
 		})
+		if err == nil && t.Type == token.PersonalToken {
+			var pat *model.PersonalAccessToken
+			if pat, err = loadPersonalAccessToken(c, user, t); err == nil {
+				c.Set("personal-access-token", pat)
+			} else {
+				log.Debug().Err(err).Msgf("personal access token of user %s rejected", user.Login)
+			}
+		}
 		if err == nil {
 			c.Set("user", user)
 
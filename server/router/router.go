@@ -67,6 +67,7 @@ func Load(noRouteHandler http.HandlerFunc, middleware ...gin.HandlerFunc) http.H
 		base.GET("/metrics", metrics.PromHandler())
 		base.GET("/version", api.Version)
 		base.GET("/healthz", api.Health)
+		base.GET("/.well-known/openid-configuration", api.GetOpenIDConfiguration)
 	}
 
 	apiRoutes(base)
@@ -0,0 +1,288 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	grpc_metadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/token"
+)
+
+const (
+	// artifactTokenMargin is added to the timeout of a workflow to get the
+	// expiry of its artifact token.
+	artifactTokenMargin = time.Hour
+
+	// artifactChunkSize is the size of the chunks artifacts are sent in.
+	artifactChunkSize = 512 * 1024
+)
+
+// hasArtifactSteps reports whether a workflow uploads or downloads artifacts.
+func hasArtifactSteps(config *backend_types.Config) bool {
+	if config == nil {
+		return false
+	}
+	for _, stage := range config.Stages {
+		for _, step := range stage.Steps {
+			if step.Type == backend_types.StepTypeArtifacts {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// artifactToken returns the token the artifact steps of a workflow
+// authenticate with. It is signed with the hash of the repo and only valid
+// while the workflow can run.
+func (s *RPC) artifactToken(workflow *rpc.Workflow) (string, error) {
+	workflowID, err := strconv.ParseInt(workflow.ID, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	_, _, repo, err := s.loadArtifactWorkflow(workflowID)
+	if err != nil {
+		return "", err
+	}
+
+	t := token.New(token.ArtifactToken)
+	t.Set("workflow-id", workflow.ID)
+	exp := time.Now().Add(time.Duration(workflow.Timeout)*time.Minute + artifactTokenMargin)
+	return t.SignExpires(repo.Hash, exp.Unix())
+}
+
+func (s *RPC) loadArtifactWorkflow(workflowID int64) (*model.Workflow, *model.Pipeline, *model.Repo, error) {
+	workflow, err := s.store.WorkflowLoad(workflowID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pipeline, err := s.store.GetPipeline(workflow.PipelineID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	repo, err := s.store.GetRepo(pipeline.RepoID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return workflow, pipeline, repo, nil
+}
+
+// authorizeArtifactAccess verifies the artifact token of a call and returns
+// the running workflow it belongs to.
+func (s *RPC) authorizeArtifactAccess(c context.Context) (*model.Workflow, *model.Pipeline, error) {
+	if server.Config.Services.Artifacts == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "artifacts are not enabled on the server")
+	}
+	return s.authorizeArtifactToken(c)
+}
+
+// authorizeArtifactToken verifies the artifact token of a call and returns
+// the running workflow it belongs to. Unlike authorizeArtifactAccess it does
+// not require an artifact store, as test reports are stored in the database.
+func (s *RPC) authorizeArtifactToken(c context.Context) (*model.Workflow, *model.Pipeline, error) {
+	md, _ := grpc_metadata.FromIncomingContext(c)
+	values := md.Get("artifact-token")
+	if len(values) == 0 {
+		return nil, nil, status.Error(codes.Unauthenticated, "artifact token is not provided")
+	}
+
+	var workflow *model.Workflow
+	var pipeline *model.Pipeline
+	_, err := token.Parse([]token.Type{token.ArtifactToken}, values[0], func(t *token.Token) (string, error) {
+		workflowID, err := strconv.ParseInt(t.Get("workflow-id"), 10, 64)
+		if err != nil {
+			return "", err
+		}
+		var repo *model.Repo
+		workflow, pipeline, repo, err = s.loadArtifactWorkflow(workflowID)
+		if err != nil {
+			return "", err
+		}
+		return repo.Hash, nil
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unauthenticated, "artifact token is invalid: %v", err)
+	}
+
+	if workflow.State != model.StatusRunning {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "workflow %d is not running", workflow.ID)
+	}
+	return workflow, pipeline, nil
+}
+
+// UploadArtifact stores an artifact uploaded by a step of a running workflow.
+// An artifact of the workflow with the same name, e.g. uploaded by a previous
+// attempt of the workflow, is replaced.
+func (s *RPC) UploadArtifact(stream proto.Woodpecker_UploadArtifactServer) error {
+	c := stream.Context()
+	workflow, pipeline, err := s.authorizeArtifactAccess(c)
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	name := req.GetName()
+	if name == "" {
+		return status.Error(codes.InvalidArgument, "artifact name is required")
+	}
+
+	// the size has to be known before storing the artifact, so it is
+	// received into a temporary file first
+	file, err := os.CreateTemp("", "woodpecker-artifact-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	maxSize := server.Config.Pipeline.ArtifactMaxSize
+	var size int64
+	for {
+		size += int64(len(req.GetData()))
+		if maxSize > 0 && size > maxSize {
+			return status.Errorf(codes.ResourceExhausted, "artifact %q exceeds the maximum size of %d bytes", name, maxSize)
+		}
+		if _, err := file.Write(req.GetData()); err != nil {
+			return err
+		}
+
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	existing, err := s.store.ArtifactList(pipeline)
+	if err != nil {
+		return err
+	}
+
+	upload := &model.Artifact{
+		RepoID:     pipeline.RepoID,
+		PipelineID: pipeline.ID,
+		WorkflowID: workflow.ID,
+		Workflow:   workflow.Name,
+		Name:       name,
+		Size:       size,
+	}
+	if retention := server.Config.Pipeline.ArtifactRetention; retention > 0 {
+		upload.Expires = time.Now().Add(retention).Unix()
+	}
+	if err := s.store.ArtifactCreate(upload); err != nil {
+		return err
+	}
+	if err := server.Config.Services.Artifacts.ArtifactWrite(c, upload, file); err != nil {
+		if err := s.store.ArtifactDelete(upload); err != nil {
+			log.Error().Err(err).Msgf("cannot remove artifact %d after failed upload", upload.ID)
+		}
+		return fmt.Errorf("store artifact %q: %w", name, err)
+	}
+
+	for _, old := range existing {
+		if old.WorkflowID != workflow.ID || old.Name != name {
+			continue
+		}
+		if err := artifact.Delete(c, s.store, server.Config.Services.Artifacts, old); err != nil {
+			log.Error().Err(err).Msgf("cannot remove replaced artifact %d", old.ID)
+		}
+	}
+
+	log.Debug().Msgf("workflow %d uploaded artifact %q with %d bytes", workflow.ID, name, size)
+	return stream.SendAndClose(new(proto.Empty))
+}
+
+// DownloadArtifact streams all artifacts uploaded by the workflows of the
+// pipeline with the requested name. Matrix workflows share their name, so
+// the artifacts of all of them are sent.
+func (s *RPC) DownloadArtifact(req *proto.DownloadArtifactRequest, stream proto.Woodpecker_DownloadArtifactServer) error {
+	c := stream.Context()
+	_, pipeline, err := s.authorizeArtifactAccess(c)
+	if err != nil {
+		return err
+	}
+
+	artifacts, err := s.store.ArtifactList(pipeline)
+	if err != nil {
+		return err
+	}
+
+	for _, upload := range artifacts {
+		if upload.Workflow != req.GetWorkflow() {
+			continue
+		}
+
+		if err := sendArtifact(c, stream, upload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sendArtifact(c context.Context, stream proto.Woodpecker_DownloadArtifactServer, upload *model.Artifact) error {
+	content, err := server.Config.Services.Artifacts.ArtifactRead(c, upload)
+	if err != nil {
+		return fmt.Errorf("read artifact %q: %w", upload.Name, err)
+	}
+	defer content.Close()
+
+	// the name is only sent with the first chunk of an artifact
+	chunk := &proto.ArtifactChunk{Name: upload.Name}
+	for {
+		// sent messages must not be modified, so every chunk gets its own buffer
+		buf := make([]byte, artifactChunkSize)
+		n, err := io.ReadFull(content, buf)
+		if n > 0 || chunk.GetName() != "" {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &proto.ArtifactChunk{}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read artifact %q: %w", upload.Name, err)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/file"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+type uploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*proto.UploadArtifactRequest
+	closed   bool
+}
+
+func (s *uploadStream) Context() context.Context { return s.ctx }
+
+func (s *uploadStream) Recv() (*proto.UploadArtifactRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(*proto.Empty) error {
+	s.closed = true
+	return nil
+}
+
+type downloadStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []*proto.ArtifactChunk
+}
+
+func (s *downloadStream) Context() context.Context { return s.ctx }
+
+func (s *downloadStream) Send(chunk *proto.ArtifactChunk) error {
+	s.chunks = append(s.chunks, &proto.ArtifactChunk{Name: chunk.GetName(), Data: append([]byte{}, chunk.GetData()...)})
+	return nil
+}
+
+func setupArtifacts(t *testing.T, maxSize int64) {
+	t.Helper()
+	store, err := file.NewArtifactStore(t.TempDir())
+	require.NoError(t, err)
+
+	origStore, origMaxSize := server.Config.Services.Artifacts, server.Config.Pipeline.ArtifactMaxSize
+	server.Config.Services.Artifacts, server.Config.Pipeline.ArtifactMaxSize = store, maxSize
+	t.Cleanup(func() {
+		server.Config.Services.Artifacts, server.Config.Pipeline.ArtifactMaxSize = origStore, origMaxSize
+	})
+}
+
+func artifactContext(t *testing.T, s *RPC, mockStore *store_mocks.MockStore, workflow *model.Workflow) context.Context {
+	t.Helper()
+	repo := defaultRepo()
+	repo.Hash = "repo-hash"
+	mockStore.On("WorkflowLoad", workflow.ID).Return(workflow, nil)
+	mockStore.On("GetPipeline", workflow.PipelineID).Return(defaultPipeline(model.StatusRunning), nil)
+	mockStore.On("GetRepo", repo.ID).Return(repo, nil)
+
+	artifactToken, err := s.artifactToken(&rpc.Workflow{ID: "30", Timeout: 60})
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(t.Context(), metadata.Pairs("artifact-token", artifactToken))
+}
+
+func TestHasArtifactSteps(t *testing.T) {
+	assert.False(t, hasArtifactSteps(nil))
+	assert.False(t, hasArtifactSteps(&backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeCommands}}},
+	}}))
+	assert.True(t, hasArtifactSteps(&backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeCommands}}},
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeArtifacts}}},
+	}}))
+}
+
+func TestUploadAndDownloadArtifact(t *testing.T) {
+	setupArtifacts(t, 0)
+	mockStore := store_mocks.NewMockStore(t)
+	s := newTestRPC(t, mockStore, nil)
+	ctx := artifactContext(t, &s, mockStore, defaultWorkflow(model.StatusRunning))
+
+	// the artifact of a previous attempt gets replaced
+	previous := &model.Artifact{ID: 1, PipelineID: 20, WorkflowID: 30, Workflow: "test-workflow", Name: "dist"}
+	other := &model.Artifact{ID: 2, PipelineID: 20, WorkflowID: 31, Workflow: "other", Name: "dist"}
+	mockStore.On("ArtifactList", mock.Anything).Return([]*model.Artifact{previous, other}, nil).Once()
+	var created *model.Artifact
+	mockStore.On("ArtifactCreate", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*model.Artifact)
+		created.ID = 3
+	}).Return(nil)
+	mockStore.On("ArtifactDelete", previous).Return(nil)
+
+	upload := &uploadStream{ctx: ctx, requests: []*proto.UploadArtifactRequest{
+		{Name: "dist", Data: []byte("abc")},
+		{Data: []byte("def")},
+	}}
+	require.NoError(t, s.UploadArtifact(upload))
+	assert.True(t, upload.closed)
+	require.NotNil(t, created)
+	assert.Equal(t, model.Artifact{
+		ID: 3, RepoID: 10, PipelineID: 20, WorkflowID: 30, Workflow: "test-workflow", Name: "dist", Size: 6,
+	}, *created)
+
+	mockStore.On("ArtifactList", mock.Anything).Return([]*model.Artifact{created, other}, nil).Once()
+	download := &downloadStream{ctx: ctx}
+	require.NoError(t, s.DownloadArtifact(&proto.DownloadArtifactRequest{Workflow: "test-workflow"}, download))
+	require.Len(t, download.chunks, 1)
+	assert.Equal(t, "dist", download.chunks[0].GetName())
+	assert.Equal(t, "abcdef", string(download.chunks[0].GetData()))
+}
+
+func TestUploadArtifactErrors(t *testing.T) {
+	t.Run("missing token", func(t *testing.T) {
+		setupArtifacts(t, 0)
+		s := newTestRPC(t, store_mocks.NewMockStore(t), nil)
+		err := s.UploadArtifact(&uploadStream{ctx: t.Context()})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		setupArtifacts(t, 0)
+		s := newTestRPC(t, store_mocks.NewMockStore(t), nil)
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("artifact-token", "invalid"))
+		err := s.UploadArtifact(&uploadStream{ctx: ctx})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("workflow not running", func(t *testing.T) {
+		setupArtifacts(t, 0)
+		mockStore := store_mocks.NewMockStore(t)
+		s := newTestRPC(t, mockStore, nil)
+		ctx := artifactContext(t, &s, mockStore, defaultWorkflow(model.StatusSuccess))
+		err := s.UploadArtifact(&uploadStream{ctx: ctx})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		setupArtifacts(t, 4)
+		mockStore := store_mocks.NewMockStore(t)
+		s := newTestRPC(t, mockStore, nil)
+		ctx := artifactContext(t, &s, mockStore, defaultWorkflow(model.StatusRunning))
+		err := s.UploadArtifact(&uploadStream{ctx: ctx, requests: []*proto.UploadArtifactRequest{
+			{Name: "dist", Data: []byte("abc")},
+			{Data: []byte("def")},
+		}})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
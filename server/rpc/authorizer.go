@@ -29,6 +29,7 @@
 //  3. Extract agent_id from JWT claims and store in context
 //
 // Auth endpoint (/proto.WoodpeckerAuth/Auth) bypasses validation to allow initial authentication.
+// The artifact endpoints bypass it as well, they verify the artifact token of a workflow instead.
 //
 // # Usage
 //
@@ -127,6 +128,15 @@ func (a *Authorizer) authorize(ctx context.Context, fullMethod string) (context.
 		return ctx, nil
 	}
 
+	// artifacts and test reports are transferred by steps, which
+	// authenticate with the artifact token of their workflow instead
+	switch fullMethod {
+	case proto.Woodpecker_UploadArtifact_FullMethodName,
+		proto.Woodpecker_DownloadArtifact_FullMethodName,
+		proto.Woodpecker_UploadReport_FullMethodName:
+		return ctx, nil
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ctx, status.Errorf(codes.Unauthenticated, "metadata is not provided")
@@ -62,6 +62,21 @@ func TestAuthorize(t *testing.T) {
 		assert.NotNil(t, ctx)
 	})
 
+	t.Run("artifact endpoints bypass JWT validation", func(t *testing.T) {
+		t.Parallel()
+
+		a := newAuthorizer(t)
+		// the handlers verify the artifact token of the workflow themselves
+		for _, method := range []string{
+			proto.Woodpecker_UploadArtifact_FullMethodName,
+			proto.Woodpecker_DownloadArtifact_FullMethodName,
+			proto.Woodpecker_UploadReport_FullMethodName,
+		} {
+			_, err := a.authorize(t.Context(), method)
+			require.NoError(t, err)
+		}
+	})
+
 	t.Run("missing metadata returns Unauthenticated", func(t *testing.T) {
 		t.Parallel()
 
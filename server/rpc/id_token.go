@@ -0,0 +1,98 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/idtoken"
+)
+
+// idTokenMargin is added to the timeout of a workflow to get the expiry of
+// its identity tokens.
+const idTokenMargin = 10 * time.Minute
+
+// idTokenEnv is the environment variable a step gets its identity token in.
+const idTokenEnv = "CI_ID_TOKEN"
+
+// hasIDTokenSteps reports whether a step of a workflow asked for an identity token.
+func hasIDTokenSteps(config *backend_types.Config) bool {
+	if config == nil {
+		return false
+	}
+	for _, stage := range config.Stages {
+		for _, step := range stage.Steps {
+			if step.IDToken != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// injectIDTokens issues the identity tokens the steps of a workflow asked for
+// and adds them to their environment. The tokens are added to the secrets of
+// the workflow, so they are masked in the logs.
+func (s *RPC) injectIDTokens(workflow *rpc.Workflow) error {
+	issuer := server.Config.Services.IDTokens
+	if issuer == nil {
+		return status.Error(codes.FailedPrecondition, "id tokens are not enabled on the server")
+	}
+
+	workflowID, err := strconv.ParseInt(workflow.ID, 10, 64)
+	if err != nil {
+		return err
+	}
+	_workflow, pipeline, repo, err := s.loadArtifactWorkflow(workflowID)
+	if err != nil {
+		return err
+	}
+
+	claims := idtoken.NewClaims(repo, pipeline, _workflow)
+	expiry := time.Duration(workflow.Timeout)*time.Minute + idTokenMargin
+
+	// steps asking for the same audience share a token
+	tokens := make(map[string]string)
+	for _, stage := range workflow.Config.Stages {
+		for _, step := range stage.Steps {
+			if step.IDToken == nil {
+				continue
+			}
+			token, ok := tokens[step.IDToken.Audience]
+			if !ok {
+				if token, err = issuer.Issue(claims, step.IDToken.Audience, expiry); err != nil {
+					return err
+				}
+				tokens[step.IDToken.Audience] = token
+				workflow.Config.Secrets = append(workflow.Config.Secrets, &backend_types.Secret{
+					Name:  "id_token",
+					Value: token,
+				})
+			}
+			if step.Environment == nil {
+				step.Environment = make(map[string]string)
+			}
+			step.Environment[idTokenEnv] = token
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	backend_types "go.woodpecker-ci.org/woodpecker/v3/pipeline/backend/types"
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/idtoken"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func setupIDTokens(t *testing.T) {
+	t.Helper()
+	keyStore := store_mocks.NewMockStore(t)
+	keyStore.On("ServerConfigGet", mock.Anything).Return("", types.ErrRecordNotExist)
+	keyStore.On("ServerConfigSet", mock.Anything, mock.Anything).Return(nil)
+	issuer, err := idtoken.New(keyStore, "https://ci.example.com")
+	require.NoError(t, err)
+
+	orig := server.Config.Services.IDTokens
+	server.Config.Services.IDTokens = issuer
+	t.Cleanup(func() { server.Config.Services.IDTokens = orig })
+}
+
+func TestHasIDTokenSteps(t *testing.T) {
+	assert.False(t, hasIDTokenSteps(nil))
+	assert.False(t, hasIDTokenSteps(&backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeCommands}}},
+	}}))
+	assert.True(t, hasIDTokenSteps(&backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeCommands}}},
+		{Steps: []*backend_types.Step{{Type: backend_types.StepTypeCommands, IDToken: &backend_types.StepIDToken{Audience: "vault"}}}},
+	}}))
+}
+
+func TestInjectIDTokens(t *testing.T) {
+	setupIDTokens(t)
+	mockStore := store_mocks.NewMockStore(t)
+	s := newTestRPC(t, mockStore, nil)
+	workflow := defaultWorkflow(model.StatusRunning)
+	mockStore.On("WorkflowLoad", workflow.ID).Return(workflow, nil)
+	mockStore.On("GetPipeline", workflow.PipelineID).Return(defaultPipeline(model.StatusRunning), nil)
+	mockStore.On("GetRepo", defaultRepo().ID).Return(defaultRepo(), nil)
+
+	build := &backend_types.Step{Name: "build"}
+	deploy := &backend_types.Step{Name: "deploy", IDToken: &backend_types.StepIDToken{Audience: "sts.amazonaws.com"}}
+	upload := &backend_types.Step{Name: "upload", IDToken: &backend_types.StepIDToken{Audience: "sts.amazonaws.com"}}
+	vault := &backend_types.Step{Name: "vault", IDToken: &backend_types.StepIDToken{Audience: "vault"}}
+	rpcWorkflow := &rpc.Workflow{ID: "30", Timeout: 60, Config: &backend_types.Config{Stages: []*backend_types.Stage{
+		{Steps: []*backend_types.Step{build, deploy}},
+		{Steps: []*backend_types.Step{upload, vault}},
+	}}}
+	require.NoError(t, s.injectIDTokens(rpcWorkflow))
+
+	assert.NotContains(t, build.Environment, idTokenEnv)
+	assert.NotEmpty(t, deploy.Environment[idTokenEnv])
+	assert.Equal(t, deploy.Environment[idTokenEnv], upload.Environment[idTokenEnv])
+	assert.NotEmpty(t, vault.Environment[idTokenEnv])
+	assert.NotEqual(t, deploy.Environment[idTokenEnv], vault.Environment[idTokenEnv])
+
+	// the tokens are masked like secrets
+	require.Len(t, rpcWorkflow.Config.Secrets, 2)
+	assert.Equal(t, deploy.Environment[idTokenEnv], rpcWorkflow.Config.Secrets[0].Value)
+	assert.Equal(t, vault.Environment[idTokenEnv], rpcWorkflow.Config.Secrets[1].Value)
+}
+
+func TestInjectIDTokensDisabled(t *testing.T) {
+	orig := server.Config.Services.IDTokens
+	server.Config.Services.IDTokens = nil
+	t.Cleanup(func() { server.Config.Services.IDTokens = orig })
+
+	s := newTestRPC(t, store_mocks.NewMockStore(t), nil)
+	err := s.injectIDTokens(&rpc.Workflow{ID: "30"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline/testreport"
+)
+
+// UploadReport parses the test reports of a step of a running workflow and
+// stores their results. They replace the results of the step reported by a
+// previous attempt of the workflow.
+func (s *RPC) UploadReport(c context.Context, req *proto.UploadReportRequest) error {
+	workflow, pipeline, err := s.authorizeArtifactToken(c)
+	if err != nil {
+		return err
+	}
+
+	steps, err := s.store.StepListFromWorkflowFind(workflow)
+	if err != nil {
+		return err
+	}
+	var step *model.Step
+	for _, candidate := range steps {
+		if candidate.Name == req.GetStep() {
+			step = candidate
+			break
+		}
+	}
+	if step == nil {
+		return status.Errorf(codes.NotFound, "workflow %d has no step %q", workflow.ID, req.GetStep())
+	}
+
+	var results []*model.TestResult
+	var parseErrs []error
+	for _, file := range req.GetFiles() {
+		fileResults, err := testreport.Parse(req.GetFormat(), bytes.NewReader(file.GetData()))
+		if err != nil {
+			// a broken report must not hide the results of the other ones
+			parseErrs = append(parseErrs, fmt.Errorf("%s: %w", file.GetName(), err))
+			continue
+		}
+		results = append(results, fileResults...)
+	}
+
+	for _, result := range results {
+		result.RepoID = pipeline.RepoID
+		result.PipelineID = pipeline.ID
+		result.PipelineNumber = pipeline.Number
+	}
+	if err := s.store.TestResultReplace(step, results); err != nil {
+		return err
+	}
+
+	log.Debug().Msgf("step %d reported %d test results", step.ID, len(results))
+	if len(parseErrs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "cannot parse test reports: %v", errors.Join(parseErrs...))
+	}
+	return nil
+}
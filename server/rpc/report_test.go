@@ -0,0 +1,72 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.woodpecker-ci.org/woodpecker/v3/rpc/proto"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestUploadReport(t *testing.T) {
+	// test reports are stored in the database, so they don't need an artifact store
+	mockStore := store_mocks.NewMockStore(t)
+	s := newTestRPC(t, mockStore, nil)
+	ctx := artifactContext(t, &s, mockStore, defaultWorkflow(model.StatusRunning))
+
+	step := defaultStep(model.StatusRunning)
+	step.Name = "test"
+	mockStore.On("StepListFromWorkflowFind", mock.Anything).Return([]*model.Step{step}, nil)
+	var stored []*model.TestResult
+	mockStore.On("TestResultReplace", step, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.Get(1).([]*model.TestResult)
+	}).Return(nil)
+
+	err := s.UploadReport(ctx, &proto.UploadReportRequest{
+		Step:   step.Name,
+		Format: "junit",
+		Files: []*proto.ReportFile{
+			{Name: "unit.xml", Data: []byte(`<testsuite name="unit"><testcase name="a"><failure message="boom"/></testcase></testsuite>`)},
+			{Name: "broken.xml", Data: []byte(`<testsuite>`)},
+		},
+	})
+	// the results of valid reports are stored even if another one is broken
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "broken.xml")
+	assert.Equal(t, []*model.TestResult{{
+		RepoID: 10, PipelineID: 20, Suite: "unit", Name: "a", Status: model.TestStatusFailed, Message: "boom",
+	}}, stored)
+
+	err = s.UploadReport(ctx, &proto.UploadReportRequest{Step: "unknown", Format: "junit"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestUploadReportWorkflowNotRunning(t *testing.T) {
+	mockStore := store_mocks.NewMockStore(t)
+	s := newTestRPC(t, mockStore, nil)
+	ctx := artifactContext(t, &s, mockStore, defaultWorkflow(model.StatusSuccess))
+
+	err := s.UploadReport(ctx, &proto.UploadReportRequest{Step: "test", Format: "junit"})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
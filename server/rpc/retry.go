@@ -0,0 +1,172 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"cmp"
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/rpc"
+	"go.woodpecker-ci.org/woodpecker/v3/server"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/pipeline"
+)
+
+// errAgentLost is the error of an attempt whose agent stopped responding.
+const errAgentLost = "agent stopped responding while running the workflow"
+
+// retryLostWorkflow is called before a workflow is handed out to an agent. If
+// it was handed out before and never reported as done, the agent of the last
+// attempt got lost and the queue resubmitted the workflow once its lease
+// expired. The lost attempt is recorded and the workflow is either put back
+// into the queue for the next attempt after its backoff, or failed if it is
+// out of attempts. It reports whether the workflow can be handed out.
+func (s *RPC) retryLostWorkflow(c context.Context, agent *model.Agent, strWorkflowID string) (bool, error) {
+	workflowID, err := strconv.ParseInt(strWorkflowID, 10, 64)
+	if err != nil {
+		return false, err
+	}
+
+	workflow, err := s.store.WorkflowLoad(workflowID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot find workflow with id %d", workflowID)
+		return false, err
+	}
+
+	// only workflows handed out before are locked to an agent
+	if workflow.AgentID == 0 || !isActiveState(workflow.State) {
+		return true, nil
+	}
+
+	now := time.Now().Unix()
+	if !workflow.Retry.Retries(model.FailureAgentLost, workflow.CurrentAttempt()) {
+		log.Info().Msgf("agent of workflow %d got lost, no attempts left", workflowID)
+		if err := s.lockAgentToWorkflow(c, agent, strWorkflowID); err != nil {
+			return false, err
+		}
+		return false, s.Done(c, strWorkflowID, rpc.WorkflowState{
+			Started:  cmp.Or(workflow.Started, now),
+			Finished: now,
+			Error:    errAgentLost,
+			Failure:  string(model.FailureAgentLost),
+		})
+	}
+
+	attempt := workflow.CurrentAttempt()
+	log.Info().Msgf("agent of workflow %d got lost, starting attempt %d", workflowID, attempt+1)
+	workflow.Children, err = s.store.StepListFromWorkflowFind(workflow)
+	if err != nil {
+		return false, err
+	}
+	s.recordAttempt(workflow, model.FailureAgentLost, model.StatusFailure, errAgentLost, now)
+	workflow, err = pipeline.UpdateWorkflowToRetry(s.store, server.Config.Services.LogStore, *workflow)
+	if err != nil {
+		return false, err
+	}
+
+	// the polling agent gets the next workflow, this one waits for its backoff
+	if err := s.scheduler.Retry(c, strWorkflowID, workflow.Attempt, workflow.Retry.Delay(attempt)); err != nil {
+		return false, err
+	}
+	s.publishRetry(c, workflow)
+	return false, nil
+}
+
+// publishRetry lets the clients know a lost workflow waits for its next
+// attempt.
+func (s *RPC) publishRetry(c context.Context, workflow *model.Workflow) {
+	currentPipeline, err := s.store.GetPipeline(workflow.PipelineID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot find pipeline with id %d", workflow.PipelineID)
+		return
+	}
+	repo, err := s.store.GetRepo(currentPipeline.RepoID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot find repo with id %d", currentPipeline.RepoID)
+		return
+	}
+	if currentPipeline.Workflows, err = s.store.WorkflowGetTree(currentPipeline); err != nil {
+		log.Error().Err(err).Msgf("cannot load the workflows of pipeline %d", currentPipeline.ID)
+		return
+	}
+	if err := s.scheduler.PublishPipelineEvent(c, repo, currentPipeline); err != nil {
+		log.Error().Err(err).Msgf("cannot publish pipeline %d", currentPipeline.ID)
+	}
+}
+
+// retryFailure returns the failure a workflow reported as done is retried for.
+func retryFailure(workflow *model.Workflow, state rpc.WorkflowState) (model.FailureClass, bool) {
+	failure := model.FailureClass(state.Failure)
+	if state.Canceled || !workflow.Retry.Retries(failure, workflow.CurrentAttempt()) {
+		return "", false
+	}
+
+	// a failure of a step that is allowed to fail does not fail the workflow
+	status := pipeline.WorkflowStatus(workflow.Children)
+	failing := status == model.StatusFailure || status == model.StatusError || status == model.StatusKilled
+	return failure, state.Error != "" || failing
+}
+
+// retryWorkflow records the failed attempt of a workflow reported as done and
+// puts the workflow back into the queue for the next attempt.
+func (s *RPC) retryWorkflow(c context.Context, agent *model.Agent, repo *model.Repo, currentPipeline *model.Pipeline, workflow *model.Workflow, failure model.FailureClass, state rpc.WorkflowState) error {
+	attempt := workflow.CurrentAttempt()
+	log.Info().Msgf("workflow %d failed with %s, starting attempt %d", workflow.ID, failure, attempt+1)
+
+	s.recordAttempt(workflow, failure, model.StatusFailure, state.Error, state.Finished)
+	workflow, err := pipeline.UpdateWorkflowToRetry(s.store, server.Config.Services.LogStore, *workflow)
+	if err != nil {
+		return err
+	}
+
+	if err := s.scheduler.Retry(c, strconv.FormatInt(workflow.ID, 10), workflow.Attempt, workflow.Retry.Delay(attempt)); err != nil {
+		log.Error().Err(err).Msgf("cannot put workflow %d back into the queue", workflow.ID)
+	}
+
+	s.updateForgeStatus(c, repo, currentPipeline, workflow)
+
+	currentPipeline.Workflows, err = s.store.WorkflowGetTree(currentPipeline)
+	if err != nil {
+		return err
+	}
+	if err := s.scheduler.PublishPipelineEvent(c, repo, currentPipeline); err != nil {
+		return err
+	}
+
+	return s.updateAgentLastWork(agent)
+}
+
+// recordAttempt stores a finished attempt of a workflow, so the history of
+// all attempts can be shown.
+func (s *RPC) recordAttempt(workflow *model.Workflow, failure model.FailureClass, state model.StatusValue, errMsg string, finished int64) {
+	attempt := &model.WorkflowAttempt{
+		PipelineID: workflow.PipelineID,
+		WorkflowID: workflow.ID,
+		Attempt:    workflow.CurrentAttempt(),
+		AgentID:    workflow.AgentID,
+		State:      state,
+		Failure:    failure,
+		Error:      errMsg,
+		Started:    workflow.Started,
+		Finished:   finished,
+	}
+	if err := s.store.WorkflowAttemptCreate(attempt); err != nil {
+		log.Error().Err(err).Msgf("cannot record attempt %d of workflow %d", attempt.Attempt, workflow.ID)
+	}
+}
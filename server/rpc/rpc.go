@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"maps"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -54,6 +55,9 @@ type RPC struct {
 	store         store.Store
 	pipelineTime  *prometheus.GaugeVec
 	pipelineCount *prometheus.CounterVec
+	// logChecks tracks the checks of the logs of finished steps running in
+	// the background
+	logChecks *sync.WaitGroup
 }
 
 // Next blocks until it provides the next workflow to execute.
@@ -96,10 +100,26 @@ func (s *RPC) Next(c context.Context, agentFilter rpc.Filter) (*rpc.Workflow, er
 		return nil, err
 	}
 
+	if handOut, err := s.retryLostWorkflow(c, agent, rpcWorkflow.ID); err != nil || !handOut {
+		return nil, err
+	}
+
 	if err := s.lockAgentToWorkflow(c, agent, rpcWorkflow.ID); err != nil {
 		return nil, err
 	}
 
+	if hasArtifactSteps(rpcWorkflow.Config) {
+		if rpcWorkflow.ArtifactToken, err = s.artifactToken(rpcWorkflow); err != nil {
+			return nil, err
+		}
+	}
+
+	if hasIDTokenSteps(rpcWorkflow.Config) {
+		if err := s.injectIDTokens(rpcWorkflow); err != nil {
+			return nil, err
+		}
+	}
+
 	return rpcWorkflow, nil
 }
 
@@ -228,6 +248,7 @@ func (s *RPC) Update(c context.Context, strWorkflowID string, state rpc.StepStat
 	}
 	if state.Exited {
 		server.Config.Services.LogStore.StepFinished(step)
+		s.checkStepLogs(c, repo, step)
 	}
 
 	if currentPipeline.Workflows, err = s.store.WorkflowGetTree(currentPipeline); err != nil {
@@ -238,6 +259,32 @@ func (s *RPC) Update(c context.Context, strWorkflowID string, state rpc.StepStat
 	return s.scheduler.PublishPipelineEvent(c, repo, currentPipeline)
 }
 
+// checkStepLogs scans the logs of a finished step for credentials in the
+// background, as it reads all of them, and publishes the pipeline if it got a
+// warning.
+func (s *RPC) checkStepLogs(c context.Context, repo *model.Repo, step *model.Step) {
+	logStore := server.Config.Services.LogStore
+	ctx := context.WithoutCancel(c)
+	s.logChecks.Go(func() {
+		updatedPipeline, err := pipeline.CheckStepLogs(s.store, logStore, step)
+		if err != nil {
+			log.Error().Err(err).Msgf("cannot check logs of step %d for credentials", step.ID)
+			return
+		}
+		if updatedPipeline == nil {
+			return
+		}
+
+		if updatedPipeline.Workflows, err = s.store.WorkflowGetTree(updatedPipeline); err != nil {
+			log.Error().Err(err).Msg("cannot build tree from step list")
+			return
+		}
+		if err := s.scheduler.PublishPipelineEvent(ctx, repo, updatedPipeline); err != nil {
+			log.Error().Err(err).Msgf("cannot publish pipeline %d", updatedPipeline.ID)
+		}
+	})
+}
+
 // Init signals the workflow is initialized.
 func (s *RPC) Init(c context.Context, strWorkflowID string, state rpc.WorkflowState) error {
 	workflowID, err := strconv.ParseInt(strWorkflowID, 10, 64)
@@ -283,9 +330,13 @@ func (s *RPC) Init(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 		return err
 	}
 
+	pipelineStarted := false
 	if currentPipeline.Status == model.StatusPending {
 		if currentPipeline, err = pipeline.UpdateToStatusRunning(s.store, *currentPipeline, state.Started); err != nil {
 			log.Error().Err(err).Msgf("init: cannot update pipeline %d state", currentPipeline.ID)
+		} else {
+			server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineStarted, repo, currentPipeline, nil)
+			pipelineStarted = true
 		}
 	}
 
@@ -305,6 +356,14 @@ func (s *RPC) Init(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 	}
 	s.updateForgeStatus(c, repo, currentPipeline, workflow)
 
+	if pipelineStarted {
+		if currentPipeline.Workflows, err = s.store.WorkflowGetTree(currentPipeline); err != nil {
+			log.Error().Err(err).Msgf("init: cannot get workflows of pipeline %d", currentPipeline.ID)
+		} else {
+			s.updatePullComment(c, repo, currentPipeline)
+		}
+	}
+
 	return s.updateAgentLastWork(agent)
 }
 
@@ -366,6 +425,10 @@ func (s *RPC) Done(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 	logger.Debug().Msgf("workflow state in store: %#v", workflow)
 	logger.Debug().Msgf("gRPC Done with state: %#v", state)
 
+	if failure, ok := retryFailure(workflow, state); ok {
+		return s.retryWorkflow(c, agent, repo, currentPipeline, workflow, failure, state)
+	}
+
 	// Complete any still-running children (e.g. service containers) before
 	// computing the workflow status, so their final state is reflected.
 	s.completeChildrenIfParentCompleted(workflow, state.Finished)
@@ -374,6 +437,14 @@ func (s *RPC) Done(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 		logger.Error().Err(err).Msgf("pipeline.UpdateWorkflowStatusToDone: cannot update workflow state: %s", err)
 	}
 
+	if workflow.State != model.StatusSkipped {
+		var failure model.FailureClass
+		if workflow.Failing() {
+			failure = model.FailureClass(state.Failure)
+		}
+		s.recordAttempt(workflow, failure, workflow.State, workflow.Error, workflow.Finished)
+	}
+
 	var queueErr error
 	if !state.Canceled {
 		if workflow.Failing() {
@@ -397,13 +468,26 @@ func (s *RPC) Done(c context.Context, strWorkflowID string, state rpc.WorkflowSt
 		return err
 	}
 
+	// a canceled pipeline finished already
+	pipelineFinished := false
 	if !model.IsThereRunningStage(currentPipeline.Workflows) {
+		wasRunning := currentPipeline.Status == model.StatusRunning || currentPipeline.Status == model.StatusPending
 		if currentPipeline, err = pipeline.UpdateStatusToDone(s.store, *currentPipeline, pipeline.PipelineStatus(currentPipeline.Workflows), workflow.Finished); err != nil {
 			logger.Error().Err(err).Msgf("pipeline.UpdateStatusToDone: cannot update workflows final state")
+		} else {
+			pipelineFinished = wasRunning
 		}
 	}
 
 	s.updateForgeStatus(c, repo, currentPipeline, workflow)
+	s.updatePullComment(c, repo, currentPipeline)
+
+	if workflow.State != model.StatusSkipped {
+		server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerWorkflowFinished, repo, currentPipeline, workflow)
+	}
+	if pipelineFinished {
+		server.Config.Services.Webhooks.PipelineEvent(model.WebhookTriggerPipelineFinished, repo, currentPipeline, nil)
+	}
 
 	// make sure writes to pubsub are non blocking (https://github.com/woodpecker-ci/woodpecker/blob/c919f32e0b6432a95e1a6d3d0ad662f591adf73f/server/logging/log.go#L9)
 	go func() {
@@ -518,6 +602,7 @@ func (s *RPC) RegisterAgent(ctx context.Context, info rpc.AgentInfo) (int64, err
 	agent.Capacity = int32(info.Capacity)
 	agent.Version = info.Version
 	agent.CustomLabels = info.CustomLabels
+	agent.Resources = agentResources(info.Resources)
 
 	err = s.store.AgentUpdate(agent)
 	if err != nil {
@@ -544,7 +629,7 @@ func (s *RPC) UnregisterAgent(ctx context.Context) error {
 	return err
 }
 
-func (s *RPC) ReportHealth(ctx context.Context, status string) error {
+func (s *RPC) ReportHealth(ctx context.Context, status string, resources rpc.Resources) error {
 	agent, err := s.getAgentFromContext(ctx)
 	if err != nil {
 		return err
@@ -556,10 +641,19 @@ func (s *RPC) ReportHealth(ctx context.Context, status string) error {
 	}
 
 	agent.LastContact = time.Now().Unix()
+	agent.Resources = agentResources(resources)
 
 	return s.store.AgentUpdate(agent)
 }
 
+func agentResources(resources rpc.Resources) model.Resources {
+	return model.Resources{
+		CPU:    resources.CPU,
+		Memory: resources.Memory,
+		Disk:   resources.Disk,
+	}
+}
+
 func (s *RPC) completeChildrenIfParentCompleted(completedWorkflow *model.Workflow, finished int64) {
 	for _, c := range completedWorkflow.Children {
 		if c.Running() {
@@ -574,7 +668,7 @@ func (s *RPC) completeChildrenIfParentCompleted(completedWorkflow *model.Workflo
 	}
 }
 
-func (s *RPC) updateForgeStatus(ctx context.Context, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) {
+func (s *RPC) updateForgeStatus(ctx context.Context, repo *model.Repo, currentPipeline *model.Pipeline, workflow *model.Workflow) {
 	user, err := s.store.GetUser(repo.UserID)
 	if err != nil {
 		log.Error().Err(err).Msgf("cannot get user with id '%d'", repo.UserID)
@@ -591,13 +685,37 @@ func (s *RPC) updateForgeStatus(ctx context.Context, repo *model.Repo, pipeline
 
 	// only do status updates for parent steps
 	if workflow != nil {
-		err = _forge.Status(ctx, user, repo, pipeline, workflow)
+		err = pipeline.UpdateForgeStatus(ctx, s.store, _forge, user, repo, currentPipeline, workflow)
 		if err != nil {
-			log.Error().Err(err).Msgf("error setting commit status for %s/%d", repo.FullName, pipeline.Number)
+			log.Error().Err(err).Msgf("error setting commit status for %s/%d", repo.FullName, currentPipeline.Number)
 		}
 	}
 }
 
+// updatePullComment updates the summary comment on the pull request of the
+// pipeline, if the repo enabled it.
+func (s *RPC) updatePullComment(ctx context.Context, repo *model.Repo, currentPipeline *model.Pipeline) {
+	if !repo.PRComment || !currentPipeline.IsPullRequest() {
+		return
+	}
+
+	user, err := s.store.GetUser(repo.UserID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot get user with id '%d'", repo.UserID)
+		return
+	}
+
+	_forge, err := server.Config.Services.Manager.ForgeFromRepo(repo)
+	if err != nil {
+		log.Error().Err(err).Msgf("can not get forge for repo '%s'", repo.FullName)
+		return
+	}
+
+	if err := pipeline.UpdatePullComment(ctx, _forge, user, repo, currentPipeline); err != nil {
+		log.Error().Err(err).Msgf("error updating pull request comment for %s/%d", repo.FullName, currentPipeline.Number)
+	}
+}
+
 func (s *RPC) getAgentFromContext(ctx context.Context) (*model.Agent, error) {
 	rawAgentID := ctx.Value(agentIDKey)
 	if rawAgentID == nil {
@@ -17,6 +17,7 @@ package rpc
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -59,6 +60,7 @@ func newTestRPC(t *testing.T, mockStore *store_mocks.MockStore, q queue.Queue) R
 		logger:        logging.New(),
 		pipelineTime:  pipelineTime,
 		pipelineCount: pipelineCount,
+		logChecks:     new(sync.WaitGroup),
 	}
 }
 
@@ -172,6 +174,7 @@ func TestRPCUpdate(t *testing.T) {
 		mockStore.On("StepUpdate", mock.Anything).Return(nil)
 		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{workflow}, nil)
 		mockLogStore.On("StepFinished", mock.Anything).Return()
+		mockLogStore.On("LogFind", mock.Anything).Return([]*model.LogEntry{}, nil)
 
 		rpcInst := newTestRPC(t, mockStore, nil)
 		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
@@ -182,6 +185,7 @@ func TestRPCUpdate(t *testing.T) {
 			Exited:   true,
 			ExitCode: 0,
 		})
+		rpcInst.logChecks.Wait()
 		assert.NoError(t, err)
 	})
 
@@ -474,6 +478,7 @@ func TestRPCDone(t *testing.T) {
 		mockStore.On("UpdatePipeline", mock.Anything).Return(nil)
 		mockStore.On("GetUser", mock.Anything).Return(nil, errors.New("user not found"))
 		mockStore.On("AgentUpdate", mock.Anything).Return(nil)
+		mockStore.On("WorkflowAttemptCreate", mock.Anything).Return(nil)
 		mockQueue.On("Done", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 		rpcInst := newTestRPC(t, mockStore, mockQueue)
@@ -549,6 +554,148 @@ func TestRPCDone(t *testing.T) {
 		err := rpcInst.Done(ctx, "invalid", rpc.WorkflowState{})
 		assert.Error(t, err)
 	})
+
+	t.Run("retry setup error", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockQueue := queue_mocks.NewMockQueue(t)
+		agent := defaultAgent()
+		repo := defaultRepo()
+		pipeline := defaultPipeline(model.StatusRunning)
+		workflow := defaultWorkflow(model.StatusRunning)
+		workflow.Attempt = 1
+		workflow.Started = 100
+		workflow.Retry = &model.RetryPolicy{MaxAttempts: 3, Backoff: 10, On: []model.FailureClass{model.FailureSetupError}}
+
+		mockStore.On("WorkflowLoad", int64(30)).Return(workflow, nil)
+		mockStore.On("StepListFromWorkflowFind", mock.Anything).Return([]*model.Step{}, nil)
+		mockStore.On("GetPipeline", int64(20)).Return(pipeline, nil)
+		mockStore.On("GetRepo", int64(10)).Return(repo, nil)
+		mockStore.On("AgentFind", int64(1)).Return(agent, nil)
+		mockStore.On("WorkflowAttemptCreate", mock.MatchedBy(func(a *model.WorkflowAttempt) bool {
+			return a.Attempt == 1 && a.Failure == model.FailureSetupError && a.State == model.StatusFailure && a.Started == 100
+		})).Return(nil)
+		mockStore.On("WorkflowUpdate", mock.MatchedBy(func(w *model.Workflow) bool {
+			return w.Attempt == 2 && w.State == model.StatusPending && w.AgentID == 0
+		})).Return(nil)
+		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{}, nil)
+		mockStore.On("GetUser", mock.Anything).Return(nil, errors.New("user not found"))
+		mockStore.On("AgentUpdate", mock.Anything).Return(nil)
+		mockQueue.On("Retry", mock.Anything, "30", 2, 10*time.Second).Return(nil)
+
+		rpcInst := newTestRPC(t, mockStore, mockQueue)
+		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
+
+		err := rpcInst.Done(ctx, "30", rpc.WorkflowState{
+			Started:  100,
+			Finished: 200,
+			Error:    "could not set up workflow: no space left",
+			Failure:  rpc.FailureSetupError,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("do not retry once out of attempts", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockLogStore := log_mocks.NewMockService(t)
+		origLogStore := server.Config.Services.LogStore
+		server.Config.Services.LogStore = mockLogStore
+		t.Cleanup(func() { server.Config.Services.LogStore = origLogStore })
+
+		agent := defaultAgent()
+		repo := defaultRepo()
+		pipeline := defaultPipeline(model.StatusRunning)
+		workflow := defaultWorkflow(model.StatusRunning)
+		workflow.Attempt = 3
+		workflow.Retry = &model.RetryPolicy{MaxAttempts: 3, On: []model.FailureClass{model.FailureSetupError}}
+
+		mockStore.On("WorkflowLoad", int64(30)).Return(workflow, nil)
+		mockStore.On("StepListFromWorkflowFind", mock.Anything).Return([]*model.Step{}, nil)
+		mockStore.On("GetPipeline", int64(20)).Return(pipeline, nil)
+		mockStore.On("GetRepo", int64(10)).Return(repo, nil)
+		mockStore.On("AgentFind", int64(1)).Return(agent, nil)
+		mockStore.On("WorkflowUpdate", mock.Anything).Return(nil)
+		mockStore.On("WorkflowAttemptCreate", mock.MatchedBy(func(a *model.WorkflowAttempt) bool {
+			return a.Attempt == 3 && a.Failure == model.FailureSetupError && a.State == model.StatusFailure
+		})).Return(nil)
+		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{}, nil)
+		mockStore.On("UpdatePipeline", mock.Anything).Return(nil)
+		mockStore.On("GetUser", mock.Anything).Return(nil, errors.New("user not found"))
+		mockStore.On("AgentUpdate", mock.Anything).Return(nil)
+		mockQueue.On("Error", mock.Anything, "30", mock.Anything).Return(nil)
+
+		rpcInst := newTestRPC(t, mockStore, mockQueue)
+		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
+
+		err := rpcInst.Done(ctx, "30", rpc.WorkflowState{
+			Started:  100,
+			Finished: 200,
+			Error:    "could not set up workflow: no space left",
+			Failure:  rpc.FailureSetupError,
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestRPCRetryLostWorkflow(t *testing.T) {
+	t.Run("hand out new workflow", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		workflow := defaultWorkflow(model.StatusPending)
+		workflow.AgentID = 0
+
+		mockStore.On("WorkflowLoad", int64(30)).Return(workflow, nil)
+
+		rpcInst := newTestRPC(t, mockStore, nil)
+		handOut, err := rpcInst.retryLostWorkflow(t.Context(), defaultAgent(), "30")
+		assert.NoError(t, err)
+		assert.True(t, handOut)
+	})
+
+	t.Run("retry lost workflow after the backoff", func(t *testing.T) {
+		mockStore := store_mocks.NewMockStore(t)
+		mockQueue := queue_mocks.NewMockQueue(t)
+		mockLogStore := log_mocks.NewMockService(t)
+		origLogStore := server.Config.Services.LogStore
+		server.Config.Services.LogStore = mockLogStore
+		t.Cleanup(func() { server.Config.Services.LogStore = origLogStore })
+
+		workflow := defaultWorkflow(model.StatusRunning)
+		workflow.Attempt = 1
+		workflow.Retry = &model.RetryPolicy{MaxAttempts: 2, Backoff: 30, On: []model.FailureClass{model.FailureAgentLost}}
+		step := defaultStep(model.StatusRunning)
+		step.Started = 100
+
+		// the lost attempt already wrote logs, they are kept for the attempt
+		// and the next one starts at line 0 again
+		isStep := mock.MatchedBy(func(s *model.Step) bool {
+			return s.ID == step.ID
+		})
+		logEntries := []*model.LogEntry{{StepID: step.ID, Data: []byte("building")}}
+		mockLogStore.On("LogFind", isStep).Return(logEntries, nil).Once()
+		mockStore.On("LogAttemptCreate", isStep, 1, logEntries).Return(nil).Once()
+		mockLogStore.On("LogDelete", isStep).Return(nil).Once()
+
+		mockStore.On("WorkflowLoad", int64(30)).Return(workflow, nil)
+		mockStore.On("StepListFromWorkflowFind", mock.Anything).Return([]*model.Step{step}, nil)
+		mockStore.On("WorkflowAttemptCreate", mock.MatchedBy(func(a *model.WorkflowAttempt) bool {
+			return a.Attempt == 1 && a.AgentID == 1 && a.Failure == model.FailureAgentLost && a.Error == errAgentLost
+		})).Return(nil)
+		mockStore.On("StepUpdate", mock.MatchedBy(func(s *model.Step) bool {
+			return s.State == model.StatusPending
+		})).Return(nil)
+		mockStore.On("WorkflowUpdate", mock.MatchedBy(func(w *model.Workflow) bool {
+			return w.Attempt == 2 && w.State == model.StatusPending && w.AgentID == 0
+		})).Return(nil)
+		mockQueue.On("Retry", mock.Anything, "30", 2, 30*time.Second).Return(nil)
+		mockStore.On("GetPipeline", int64(20)).Return(defaultPipeline(model.StatusRunning), nil)
+		mockStore.On("GetRepo", int64(10)).Return(defaultRepo(), nil)
+		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{}, nil)
+
+		rpcInst := newTestRPC(t, mockStore, mockQueue)
+		handOut, err := rpcInst.retryLostWorkflow(t.Context(), defaultAgent(), "30")
+		assert.NoError(t, err)
+		assert.False(t, handOut, "the workflow waits in the queue for its backoff")
+	})
 }
 
 func TestRPCLog(t *testing.T) {
@@ -961,6 +1108,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 		mockStore.On("StepUpdate", mock.Anything).Return(nil)
 		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{workflow}, nil)
 		mockLogStore.On("StepFinished", mock.Anything).Return()
+		mockLogStore.On("LogFind", mock.Anything).Return([]*model.LogEntry{}, nil)
 
 		rpcInst := newTestRPC(t, mockStore, nil)
 		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
@@ -971,6 +1119,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 			Finished: 200,
 			ExitCode: 1,
 		})
+		rpcInst.logChecks.Wait()
 		require.NoError(t, err)
 
 		cStep, err := metric.FailurePipelineStepInfoCount.GetMetricWithLabelValues(workflow.Name, defaultRepo().FullName, step.Name, "commands")
@@ -1023,6 +1172,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 		mockStore.On("StepUpdate", mock.Anything).Return(nil)
 		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{workflow}, nil)
 		mockLogStore.On("StepFinished", mock.Anything).Return()
+		mockLogStore.On("LogFind", mock.Anything).Return([]*model.LogEntry{}, nil)
 
 		rpcInst := newTestRPC(t, mockStore, nil)
 		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
@@ -1033,6 +1183,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 			Finished: 200,
 			ExitCode: 1,
 		})
+		rpcInst.logChecks.Wait()
 		require.NoError(t, err)
 
 		cSvc, err := metric.FailurePipelineStepInfoCount.GetMetricWithLabelValues(workflow.Name, defaultRepo().FullName, step.Name, "service")
@@ -1085,6 +1236,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 		mockStore.On("StepUpdate", mock.Anything).Return(nil)
 		mockStore.On("WorkflowGetTree", mock.Anything).Return([]*model.Workflow{workflow}, nil)
 		mockLogStore.On("StepFinished", mock.Anything).Return()
+		mockLogStore.On("LogFind", mock.Anything).Return([]*model.LogEntry{}, nil)
 
 		rpcInst := newTestRPC(t, mockStore, nil)
 		ctx := context.WithValue(t.Context(), agentIDKey, int64(1))
@@ -1095,6 +1247,7 @@ func TestRPCUpdateStepTypeMetric(t *testing.T) {
 			Finished: 200,
 			ExitCode: 0,
 		})
+		rpcInst.logChecks.Wait()
 		require.NoError(t, err)
 
 		c, err := metric.FailurePipelineStepInfoCount.GetMetricWithLabelValues(workflow.Name, defaultRepo().FullName, step.Name, "step")
@@ -17,6 +17,7 @@ package rpc
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -62,6 +63,7 @@ func NewWoodpeckerServer(scheduler scheduler.Scheduler, logger logging.Log, stor
 		logger:        logger,
 		pipelineTime:  pipelineTime,
 		pipelineCount: pipelineCount,
+		logChecks:     new(sync.WaitGroup),
 	}
 	return &WoodpeckerServer{peer: peer}
 }
@@ -89,11 +91,29 @@ func (s *WoodpeckerServer) Next(c context.Context, req *proto.NextRequest) (*pro
 	res.Workflow = new(proto.Workflow)
 	res.Workflow.Id = pipeline.ID
 	res.Workflow.Timeout = pipeline.Timeout
+	res.Workflow.ArtifactToken = pipeline.ArtifactToken
 	res.Workflow.Payload, err = json.Marshal(pipeline.Config)
 
 	return res, err
 }
 
+// UploadArtifact receives an artifact uploaded by a step.
+func (s *WoodpeckerServer) UploadArtifact(stream proto.Woodpecker_UploadArtifactServer) error {
+	return s.peer.UploadArtifact(stream)
+}
+
+// DownloadArtifact sends the artifacts of a workflow to a step.
+func (s *WoodpeckerServer) DownloadArtifact(req *proto.DownloadArtifactRequest, stream proto.Woodpecker_DownloadArtifactServer) error {
+	return s.peer.DownloadArtifact(req, stream)
+}
+
+// UploadReport receives the test reports of a step.
+func (s *WoodpeckerServer) UploadReport(c context.Context, req *proto.UploadReportRequest) (*proto.Empty, error) {
+	res := new(proto.Empty)
+	err := s.peer.UploadReport(c, req)
+	return res, err
+}
+
 // Init let agent signals to server the workflow is initialized.
 func (s *WoodpeckerServer) Init(c context.Context, req *proto.InitRequest) (*proto.Empty, error) {
 	state := rpc.WorkflowState{
@@ -130,6 +150,7 @@ func (s *WoodpeckerServer) Done(c context.Context, req *proto.DoneRequest) (*pro
 		Finished: req.GetState().GetFinished(),
 		Error:    req.GetState().GetError(),
 		Canceled: req.GetState().GetCanceled(),
+		Failure:  req.GetState().GetFailure(),
 	}
 	res := new(proto.Empty)
 	err := s.peer.Done(c, req.GetId(), state)
@@ -199,6 +220,7 @@ func (s *WoodpeckerServer) RegisterAgent(c context.Context, req *proto.RegisterA
 		Backend:      agentInfo.GetBackend(),
 		Capacity:     int(agentInfo.GetCapacity()),
 		CustomLabels: agentInfo.GetCustomLabels(),
+		Resources:    fromProtoResources(agentInfo.GetResources()),
 	})
 	res.AgentId = agentID
 	return res, err
@@ -213,6 +235,14 @@ func (s *WoodpeckerServer) UnregisterAgent(ctx context.Context, _ *proto.Empty)
 // ReportHealth reports health status of the agent to the server.
 func (s *WoodpeckerServer) ReportHealth(c context.Context, req *proto.ReportHealthRequest) (*proto.Empty, error) {
 	res := new(proto.Empty)
-	err := s.peer.ReportHealth(c, req.GetStatus())
+	err := s.peer.ReportHealth(c, req.GetStatus(), fromProtoResources(req.GetResources()))
 	return res, err
 }
+
+func fromProtoResources(resources *proto.Resources) rpc.Resources {
+	return rpc.Resources{
+		CPU:    resources.GetCpu(),
+		Memory: resources.GetMemory(),
+		Disk:   resources.GetDisk(),
+	}
+}
@@ -80,6 +80,23 @@ func TestServerReportHealth(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("reported resources are stored", func(t *testing.T) {
+		t.Parallel()
+		store := store_mocks.NewMockStore(t)
+		agent := &model.Agent{ID: 7, OwnerID: model.IDNotSet, OrgID: model.IDNotSet}
+		store.On("AgentFind", int64(7)).Return(agent, nil)
+		store.On("AgentUpdate", mock.MatchedBy(func(a *model.Agent) bool {
+			return a.Resources == model.Resources{CPU: 4000, Memory: 8 << 30}
+		})).Return(nil)
+
+		srv := newTestServer(t, store)
+		_, err := srv.ReportHealth(ctxWithAgentID(7), &proto.ReportHealthRequest{
+			Status:    "I am alive!",
+			Resources: &proto.Resources{Cpu: 4000, Memory: 8 << 30},
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("unexpected status is rejected", func(t *testing.T) {
 		t.Parallel()
 		store := store_mocks.NewMockStore(t)
@@ -0,0 +1,110 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline"
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	orgGroupPrefix  = "org/"
+	repoGroupPrefix = "repo/"
+
+	// weightRefreshInterval is how often the org weights are reloaded.
+	weightRefreshInterval = 30 * time.Second
+)
+
+// fairShare balances the queue between orgs and, within an org, between its
+// repos. An org gets agents in proportion to its share weight, so orgs with
+// fewer running workflows relative to their weight go first.
+type fairShare struct {
+	sync.RWMutex
+
+	store   store.Store
+	weights map[string]int
+}
+
+// NewFairShare returns a fair share policy for the queue that uses the share
+// weights admins configured for the orgs.
+func NewFairShare(ctx context.Context, s store.Store) queue.FairShare {
+	f := &fairShare{
+		store:   s,
+		weights: map[string]int{},
+	}
+	f.refresh()
+	go f.run(ctx)
+	return f
+}
+
+// Groups returns the org and the repo of the task.
+func (f *fairShare) Groups(task *model.Task) []string {
+	return []string{
+		orgGroupPrefix + task.Labels[pipeline.LabelFilterOrg],
+		repoGroupPrefix + strconv.FormatInt(task.RepoID, 10),
+	}
+}
+
+// Weight returns the share weight of an org. Repos all have the same weight.
+func (f *fairShare) Weight(group string) int {
+	orgID, ok := strings.CutPrefix(group, orgGroupPrefix)
+	if !ok {
+		return 1
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+	return max(f.weights[orgID], 1)
+}
+
+func (f *fairShare) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(weightRefreshInterval):
+		}
+		f.refresh()
+	}
+}
+
+func (f *fairShare) refresh() {
+	orgs, err := f.store.OrgList(&model.ListOptionsWithAll{All: true})
+	if err != nil {
+		log.Error().Err(err).Msg("scheduler: could not load org share weights")
+		return
+	}
+
+	weights := make(map[string]int, len(orgs))
+	for _, org := range orgs {
+		if org.ShareWeight > 1 {
+			weights[strconv.FormatInt(org.ID, 10)] = org.ShareWeight
+		}
+	}
+
+	f.Lock()
+	f.weights = weights
+	f.Unlock()
+}
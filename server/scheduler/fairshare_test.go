@@ -0,0 +1,44 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestFairShare(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("OrgList", mock.Anything).Return([]*model.Org{
+		{ID: 1, ShareWeight: 3},
+		{ID: 2, ShareWeight: 0},
+	}, nil)
+
+	share := NewFairShare(t.Context(), store)
+
+	task := &model.Task{RepoID: 5, Labels: map[string]string{"org-id": "1"}}
+	groups := share.Groups(task)
+	assert.Equal(t, []string{"org/1", "repo/5"}, groups)
+
+	assert.Equal(t, 3, share.Weight("org/1"))
+	assert.Equal(t, 1, share.Weight("org/2"), "weights below one count as one")
+	assert.Equal(t, 1, share.Weight("org/3"), "unknown orgs get the default weight")
+	assert.Equal(t, 1, share.Weight("repo/5"))
+}
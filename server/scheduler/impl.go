@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/rs/zerolog/log"
@@ -90,6 +91,10 @@ func (p *impl) Poll(c context.Context, agentID int64, agentFilter rpc.Filter, ma
 	}
 }
 
+func (p *impl) Retry(c context.Context, id string, attempt int, delay time.Duration) error {
+	return p.q.Retry(c, id, attempt, delay)
+}
+
 func (p *impl) Resume() {
 	p.q.Resume()
 }
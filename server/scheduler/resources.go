@@ -0,0 +1,97 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/queue"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// resourcesRefreshInterval is how often the resources agents reported are
+// reloaded, agents report them with every health check.
+const resourcesRefreshInterval = 10 * time.Second
+
+// agentResources keeps the resources the agents reported in memory, as the
+// queue looks them up for every task it tries to hand out.
+type agentResources struct {
+	sync.Mutex
+
+	store     store.Store
+	resources map[int64]model.Resources
+}
+
+// NewAgentResources returns the allocatable resources of the agents for the
+// queue, as reported by the agents.
+func NewAgentResources(ctx context.Context, s store.Store) queue.AgentResources {
+	r := &agentResources{
+		store:     s,
+		resources: map[int64]model.Resources{},
+	}
+	r.refresh()
+	go r.run(ctx)
+	return r
+}
+
+// Allocatable returns the resources of an agent.
+func (r *agentResources) Allocatable(agentID int64) model.Resources {
+	r.Lock()
+	defer r.Unlock()
+
+	resources, ok := r.resources[agentID]
+	if !ok {
+		// the agent registered after the last refresh
+		agent, err := r.store.AgentFind(agentID)
+		if err == nil {
+			resources = agent.Resources
+		}
+		r.resources[agentID] = resources
+	}
+	return resources
+}
+
+func (r *agentResources) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resourcesRefreshInterval):
+		}
+		r.refresh()
+	}
+}
+
+func (r *agentResources) refresh() {
+	agents, err := r.store.AgentList(&model.ListOptionsWithAll{All: true})
+	if err != nil {
+		log.Error().Err(err).Msg("scheduler: could not load agent resources")
+		return
+	}
+
+	resources := make(map[int64]model.Resources, len(agents))
+	for _, agent := range agents {
+		resources[agent.ID] = agent.Resources
+	}
+
+	r.Lock()
+	r.resources = resources
+	r.Unlock()
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestAgentResources(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("AgentList", mock.Anything).Return([]*model.Agent{
+		{ID: 1, Resources: model.Resources{CPU: 4000}},
+	}, nil)
+	store.On("AgentFind", int64(2)).Return(&model.Agent{ID: 2, Resources: model.Resources{Memory: 1 << 30}}, nil).Once()
+	store.On("AgentFind", int64(3)).Return(nil, types.ErrRecordNotExist).Once()
+
+	resources := NewAgentResources(t.Context(), store)
+
+	assert.Equal(t, model.Resources{CPU: 4000}, resources.Allocatable(1))
+	// agents that registered after the refresh are loaded once
+	assert.Equal(t, model.Resources{Memory: 1 << 30}, resources.Allocatable(2))
+	assert.Equal(t, model.Resources{Memory: 1 << 30}, resources.Allocatable(2))
+	assert.Equal(t, model.Resources{}, resources.Allocatable(3))
+	assert.Equal(t, model.Resources{}, resources.Allocatable(3))
+}
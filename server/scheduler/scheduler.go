@@ -16,6 +16,7 @@ package scheduler
 
 import (
 	"context"
+	"time"
 
 	"go.woodpecker-ci.org/woodpecker/v3/rpc"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
@@ -46,6 +47,7 @@ type Scheduler interface {
 	Extend(c context.Context, agentID int64, workflowID string) error
 	Done(c context.Context, id string, exitStatus model.StatusValue) error
 	Error(c context.Context, id string, err error) error
+	Retry(c context.Context, id string, attempt int, delay time.Duration) error
 	Wait(c context.Context, id string) error
 	Info(c context.Context) queue.InfoT
 	Pause()
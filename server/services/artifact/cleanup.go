@@ -0,0 +1,82 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	// Specifies the interval woodpecker checks for expired artifacts.
+	cleanupInterval = time.Hour
+
+	// Specifies the batch size of expired artifacts to retrieve from database.
+	cleanupItems = 100
+)
+
+// RunCleanup removes expired artifacts until ctx is canceled.
+func RunCleanup(ctx context.Context, store store.Store, service Service) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(cleanupInterval):
+			if err := Cleanup(ctx, store, service, time.Now()); err != nil {
+				log.Error().Err(err).Msg("cleanup expired artifacts")
+			}
+		}
+	}
+}
+
+// Cleanup removes all artifacts that expired before now.
+func Cleanup(ctx context.Context, store store.Store, service Service, now time.Time) error {
+	for {
+		artifacts, err := store.ArtifactListExpired(now.Unix(), cleanupItems)
+		if err != nil {
+			return err
+		}
+
+		for _, artifact := range artifacts {
+			if err := Delete(ctx, store, service, artifact); err != nil {
+				return err
+			}
+		}
+
+		if len(artifacts) < cleanupItems {
+			return nil
+		}
+	}
+}
+
+// Delete removes an artifact together with its content.
+func Delete(ctx context.Context, store store.Store, service Service, artifact *model.Artifact) error {
+	if err := service.ArtifactDelete(ctx, artifact); err != nil {
+		return err
+	}
+
+	// another server might have removed it in the meantime
+	if err := store.ArtifactDelete(artifact); err != nil && !errors.Is(err, types.ErrRecordNotExist) {
+		return err
+	}
+	return nil
+}
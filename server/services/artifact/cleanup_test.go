@@ -0,0 +1,56 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifact
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/artifact/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestCleanup(t *testing.T) {
+	now := time.Unix(1000, 0)
+	expired := []*model.Artifact{{ID: 1, Expires: 500}, {ID: 2, Expires: 900}}
+
+	store := store_mocks.NewMockStore(t)
+	service := mocks.NewMockService(t)
+	store.On("ArtifactListExpired", int64(1000), int64(cleanupItems)).Return(expired, nil)
+	service.On("ArtifactDelete", mock.Anything, expired[0]).Return(nil)
+	service.On("ArtifactDelete", mock.Anything, expired[1]).Return(nil)
+	store.On("ArtifactDelete", expired[0]).Return(nil)
+	store.On("ArtifactDelete", expired[1]).Return(types.ErrRecordNotExist)
+
+	assert.NoError(t, Cleanup(t.Context(), store, service, now))
+}
+
+func TestCleanupKeepsArtifactOnError(t *testing.T) {
+	artifact := &model.Artifact{ID: 1, Expires: 500}
+
+	store := store_mocks.NewMockStore(t)
+	service := mocks.NewMockService(t)
+	store.On("ArtifactListExpired", int64(1000), int64(cleanupItems)).Return([]*model.Artifact{artifact}, nil)
+	service.On("ArtifactDelete", mock.Anything, artifact).Return(errors.New("storage unavailable"))
+
+	// the artifact is kept in the database, so the next cleanup retries it
+	assert.EqualError(t, Cleanup(t.Context(), store, service, time.Unix(1000, 0)), "storage unavailable")
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	service_artifact "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+)
+
+type artifactStore struct {
+	base string
+}
+
+// NewArtifactStore returns a service storing artifacts as files in base.
+func NewArtifactStore(base string) (service_artifact.Service, error) {
+	if base == "" {
+		return nil, fmt.Errorf("file storage base path is required")
+	}
+	if err := os.MkdirAll(base, 0o700); err != nil {
+		return nil, err
+	}
+	return artifactStore{base: base}, nil
+}
+
+func (s artifactStore) filePath(artifact *model.Artifact) string {
+	return filepath.Join(s.base, fmt.Sprintf("%d.tar.gz", artifact.ID))
+}
+
+func (s artifactStore) ArtifactWrite(_ context.Context, artifact *model.Artifact, content io.Reader) error {
+	// write to a temporary file first, so a failed upload never leaves a
+	// truncated artifact behind
+	file, err := os.CreateTemp(s.base, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, content); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), s.filePath(artifact))
+}
+
+func (s artifactStore) ArtifactRead(_ context.Context, artifact *model.Artifact) (io.ReadCloser, error) {
+	return os.Open(s.filePath(artifact))
+}
+
+func (s artifactStore) ArtifactDelete(_ context.Context, artifact *model.Artifact) error {
+	err := os.Remove(s.filePath(artifact))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestArtifactStore(t *testing.T) {
+	base := filepath.Join(t.TempDir(), "artifacts")
+	store, err := NewArtifactStore(base)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	artifact := &model.Artifact{ID: 7, Size: 3}
+	require.NoError(t, store.ArtifactWrite(ctx, artifact, strings.NewReader("abc")))
+
+	content, err := store.ArtifactRead(ctx, artifact)
+	require.NoError(t, err)
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	require.NoError(t, content.Close())
+	assert.Equal(t, "abc", string(data))
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(base)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, store.ArtifactDelete(ctx, artifact))
+	require.NoError(t, store.ArtifactDelete(ctx, artifact))
+	_, err = store.ArtifactRead(ctx, artifact)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = NewArtifactStore("")
+	assert.Error(t, err)
+}
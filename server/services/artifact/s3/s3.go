@@ -0,0 +1,57 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	service_artifact "go.woodpecker-ci.org/woodpecker/v3/server/services/artifact"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+)
+
+type artifactStore struct {
+	client *s3.Client
+	prefix string
+}
+
+// NewArtifactStore returns a service storing artifacts as objects of an S3
+// compatible bucket. The keys of all objects start with prefix.
+func NewArtifactStore(config s3.Config, prefix string) (service_artifact.Service, error) {
+	client, err := s3.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return artifactStore{client: client, prefix: prefix}, nil
+}
+
+func (s artifactStore) key(artifact *model.Artifact) string {
+	return path.Join(s.prefix, fmt.Sprintf("%d.tar.gz", artifact.ID))
+}
+
+func (s artifactStore) ArtifactWrite(ctx context.Context, artifact *model.Artifact, content io.Reader) error {
+	return s.client.Put(ctx, s.key(artifact), content, artifact.Size)
+}
+
+func (s artifactStore) ArtifactRead(ctx context.Context, artifact *model.Artifact) (io.ReadCloser, error) {
+	return s.client.Get(ctx, s.key(artifact))
+}
+
+func (s artifactStore) ArtifactDelete(ctx context.Context, artifact *model.Artifact) error {
+	return s.client.Delete(ctx, s.key(artifact))
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package artifact stores the archives steps upload as artifacts.
+package artifact
+
+import (
+	"context"
+	"io"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// Service stores the content of artifacts, the metadata is kept in the
+// database.
+type Service interface {
+	// ArtifactWrite stores the content of an artifact, artifact.Size has to
+	// be set to its size.
+	ArtifactWrite(ctx context.Context, artifact *model.Artifact, content io.Reader) error
+	ArtifactRead(ctx context.Context, artifact *model.Artifact) (io.ReadCloser, error)
+	// ArtifactDelete removes the content of an artifact, removing content
+	// that does not exist is no error.
+	ArtifactDelete(ctx context.Context, artifact *model.Artifact) error
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+// redacted replaces the value of credentials in old and new values.
+const redacted = "********"
+
+// sensitiveKeys are the json keys of credentials in the audited models.
+var sensitiveKeys = map[string]bool{
+	"value":               true, // secret
+	"password":            true, // registry
+	"token":               true, // agent
+	"oauth_client_secret": true, // forge
+	"git-password":        true, // forge additional options
+	"app-private-key":     true, // forge additional options
+	"app-webhook-secret":  true, // forge additional options
+}
+
+// Sink receives every recorded entry in addition to the database.
+type Sink interface {
+	Write(entry *model.AuditLog) error
+}
+
+// Record stores an audit log entry and writes it to the sink if one is set.
+// Errors are only logged as the audited action has already been performed.
+func Record(s store.Store, sink Sink, entry *model.AuditLog) {
+	if err := s.AuditLogCreate(entry); err != nil {
+		log.Error().Err(err).Str("action", string(entry.Action)).Msg("could not store audit log entry")
+	}
+	if sink != nil {
+		if err := sink.Write(entry); err != nil {
+			log.Error().Err(err).Str("action", string(entry.Action)).Msg("could not write audit log entry")
+		}
+	}
+}
+
+// Redact converts v to its json representation with the values of all
+// credentials replaced. It returns nil for nil values.
+func Redact(v any) map[string]any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Error().Err(err).Msg("could not marshal audit log value")
+		return nil
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		// null or not an object
+		return nil
+	}
+	redact(out)
+	return out
+}
+
+func redact(m map[string]any) {
+	for key, value := range m {
+		switch value := value.(type) {
+		case map[string]any:
+			redact(value)
+		case string:
+			if sensitiveKeys[key] && value != "" {
+				m[key] = redacted
+			}
+		}
+	}
+}
+
+// FileSink appends entries as json lines to a file.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens or creates the file entries are appended to.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log file: %w", err)
+	}
+	return &FileSink{file: file}, nil
+}
+
+// Write appends the entry as single json line.
+func (s *FileSink) Write(entry *model.AuditLog) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+)
+
+func TestRedact(t *testing.T) {
+	assert.Nil(t, Redact(nil))
+	assert.Nil(t, Redact((*model.Secret)(nil)))
+
+	assert.Equal(t, map[string]any{
+		"id":      float64(1),
+		"org_id":  float64(0),
+		"repo_id": float64(2),
+		"name":    "deploy_key",
+		"value":   redacted,
+		"images":  nil,
+		"events":  []any{"push"},
+		"note":    "",
+	}, Redact(&model.Secret{ID: 1, RepoID: 2, Name: "deploy_key", Value: "s3cr3t", Events: []model.WebhookEvent{model.EventPush}}))
+
+	registry := Redact(&model.Registry{Address: "docker.io", Username: "bot", Password: "s3cr3t"})
+	assert.Equal(t, redacted, registry["password"])
+	assert.Equal(t, "bot", registry["username"])
+
+	// nested and empty credentials
+	forge := Redact(&model.Forge{URL: "https://git.example.com", AdditionalOptions: map[string]any{"git-username": "bot", "git-password": "s3cr3t"}})
+	assert.Equal(t, map[string]any{"git-username": "bot", "git-password": redacted}, forge["additional_options"])
+	agent := Redact(&model.Agent{Name: "agent"})
+	assert.Empty(t, agent["token"])
+
+	// keys only containing a sensitive word are kept
+	repo := Redact(&model.Repo{SecretExtensionEndpoint: "https://ext.example.com"})
+	assert.Equal(t, "https://ext.example.com", repo["secret_extension_endpoint"])
+}
+
+type failingSink struct{ entries []*model.AuditLog }
+
+func (s *failingSink) Write(entry *model.AuditLog) error {
+	s.entries = append(s.entries, entry)
+	return errors.New("disk full")
+}
+
+func TestRecord(t *testing.T) {
+	entry := &model.AuditLog{ActorLogin: "alice", Action: model.AuditUserDelete}
+
+	// a failing store does not prevent writing to the sink
+	store := store_mocks.NewMockStore(t)
+	store.On("AuditLogCreate", entry).Return(errors.New("db down"))
+	sink := &failingSink{}
+	Record(store, sink, entry)
+	assert.Equal(t, []*model.AuditLog{entry}, sink.entries)
+
+	store = store_mocks.NewMockStore(t)
+	store.On("AuditLogCreate", entry).Return(nil)
+	Record(store, nil, entry)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&model.AuditLog{ID: 1, Action: model.AuditRepoDelete}))
+	require.NoError(t, sink.Close())
+
+	// entries are appended after a restart
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&model.AuditLog{ID: 2, Action: model.AuditRepoActivate}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var actions []model.AuditAction
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry model.AuditLog
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		actions = append(actions, entry.Action)
+	}
+	assert.Equal(t, []model.AuditAction{model.AuditRepoDelete, model.AuditRepoActivate}, actions)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idtoken issues OpenID Connect identity tokens to workflows. Steps
+// use them to authenticate to cloud providers or secret stores without a
+// long-lived secret, the relying party verifies them with the published keys.
+package idtoken
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/oklog/ulid/v2"
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	privateKeyID = "id-token-private-key"
+	keySize      = 2048
+)
+
+// Issuer signs identity tokens with a RSA key stored in the server config.
+type Issuer struct {
+	url   string
+	key   *rsa.PrivateKey
+	keyID string
+}
+
+// Claims are the claims of an identity token.
+type Claims struct {
+	jwt.RegisteredClaims
+	Repo           string `json:"repo"`
+	RepoID         int64  `json:"repo_id"`
+	RepoOwner      string `json:"repo_owner"`
+	Branch         string `json:"branch,omitempty"`
+	Ref            string `json:"ref,omitempty"`
+	Commit         string `json:"commit"`
+	Event          string `json:"event"`
+	PipelineNumber int64  `json:"pipeline_number"`
+	Workflow       string `json:"workflow"`
+	DeployTarget   string `json:"deploy_target,omitempty"`
+	Fork           bool   `json:"fork"`
+	Trusted        bool   `json:"trusted"`
+}
+
+// JWK is a public key in the JSON Web Key format.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+} //	@name	JWK
+
+// JWKS is the set of keys identity tokens are signed with.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+} //	@name	JWKS
+
+// Configuration is the OpenID Connect discovery document of the issuer.
+type Configuration struct {
+	Issuer                           string   `json:"issuer"`
+	JWKSURI                          string   `json:"jwks_uri"`
+	ResponseTypesSupported           []string `json:"response_types_supported"`
+	SubjectTypesSupported            []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	ClaimsSupported                  []string `json:"claims_supported"`
+	ScopesSupported                  []string `json:"scopes_supported"`
+} //	@name	OpenIDConfiguration
+
+// New loads the signing key from the store or generates it on first start.
+// The url is the public address of the server and used as issuer.
+func New(_store store.Store, url string) (*Issuer, error) {
+	key, err := loadKey(_store)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := thumbprint(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issuer{url: url, key: key, keyID: keyID}, nil
+}
+
+func loadKey(_store store.Store) (*rsa.PrivateKey, error) {
+	keyPEM, err := _store.ServerConfigGet(privateKeyID)
+	if errors.Is(err, types.ErrRecordNotExist) {
+		key, err := rsa.GenerateKey(rand.Reader, keySize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate id token key: %w", err)
+		}
+		keyPEM = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}))
+		if err := _store.ServerConfigSet(privateKeyID, keyPEM); err != nil {
+			return nil, fmt.Errorf("failed to store id token key: %w", err)
+		}
+		log.Debug().Msg("created id token key")
+		return key, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load id token key: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode id token key")
+	}
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse id token key: %w", err)
+	}
+	return key, nil
+}
+
+// thumbprint is the RFC 7638 thumbprint of a key, it is used as key id.
+func thumbprint(key *rsa.PublicKey) (string, error) {
+	// the members have to be in lexicographic order
+	data, err := json.Marshal(struct {
+		E   string `json:"e"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+	}{
+		E:   encodeInt(big.NewInt(int64(key.E))),
+		Kty: "RSA",
+		N:   encodeInt(key.N),
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// NewClaims returns the claims describing a workflow of a pipeline.
+func NewClaims(repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) Claims {
+	claims := Claims{
+		Repo:           repo.FullName,
+		RepoID:         repo.ID,
+		RepoOwner:      repo.Owner,
+		Branch:         pipeline.Branch,
+		Ref:            pipeline.Ref,
+		Commit:         pipeline.Commit,
+		Event:          string(pipeline.Event),
+		PipelineNumber: pipeline.Number,
+		Workflow:       workflow.Name,
+		DeployTarget:   pipeline.DeployTo,
+		Fork:           pipeline.FromFork,
+		Trusted:        repo.Trusted.Network && repo.Trusted.Volumes && repo.Trusted.Security,
+	}
+
+	// the subject is what relying parties usually match on, pull requests
+	// and deployments get their own to not be mistaken for a branch, and
+	// pull requests from forks to not be mistaken for ones of the repository
+	switch {
+	case pipeline.IsPullRequest() && pipeline.FromFork:
+		claims.Subject = fmt.Sprintf("repo:%s:pull_request:fork", repo.FullName)
+	case pipeline.IsPullRequest():
+		claims.Subject = fmt.Sprintf("repo:%s:pull_request", repo.FullName)
+	case pipeline.Event == model.EventDeploy:
+		claims.Subject = fmt.Sprintf("repo:%s:deploy:%s", repo.FullName, pipeline.DeployTo)
+	default:
+		claims.Subject = fmt.Sprintf("repo:%s:ref:%s", repo.FullName, pipeline.Ref)
+	}
+
+	return claims
+}
+
+// Issue signs a token for the claims, valid for the audience until expiry.
+func (i *Issuer) Issue(claims Claims, audience string, expiry time.Duration) (string, error) {
+	now := time.Now()
+	claims.Issuer = i.url
+	claims.Audience = jwt.ClaimStrings{audience}
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.NotBefore = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(expiry))
+	claims.ID = ulid.Make().String()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = i.keyID
+	return token.SignedString(i.key)
+}
+
+// JWKS returns the keys tokens are signed with.
+func (i *Issuer) JWKS() JWKS {
+	return JWKS{Keys: []JWK{{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: jwt.SigningMethodRS256.Alg(),
+		KeyID:     i.keyID,
+		N:         encodeInt(i.key.N),
+		E:         encodeInt(big.NewInt(int64(i.key.E))),
+	}}}
+}
+
+// Configuration returns the discovery document of the issuer.
+func (i *Issuer) Configuration() Configuration {
+	return Configuration{
+		Issuer:                           i.url,
+		JWKSURI:                          i.url + "/api/oidc/jwks",
+		ResponseTypesSupported:           []string{"id_token"},
+		SubjectTypesSupported:            []string{"public"},
+		IDTokenSigningAlgValuesSupported: []string{jwt.SigningMethodRS256.Alg()},
+		ClaimsSupported: []string{
+			"sub", "aud", "exp", "iat", "iss", "jti", "nbf",
+			"repo", "repo_id", "repo_owner", "branch", "ref", "commit", "event",
+			"pipeline_number", "workflow", "deploy_target", "fork", "trusted",
+		},
+		ScopesSupported: []string{"openid"},
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idtoken
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestIssuer(t *testing.T) {
+	var stored string
+	_store := store_mocks.NewMockStore(t)
+	_store.On("ServerConfigGet", privateKeyID).Return("", types.ErrRecordNotExist).Once()
+	_store.On("ServerConfigSet", privateKeyID, mock.Anything).Run(func(args mock.Arguments) {
+		stored = args.String(1)
+	}).Return(nil).Once()
+
+	issuer, err := New(_store, "https://ci.example.com")
+	require.NoError(t, err)
+
+	// the key is loaded again after a restart
+	_store.On("ServerConfigGet", privateKeyID).Return(stored, nil).Once()
+	reloaded, err := New(_store, "https://ci.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, issuer.JWKS(), reloaded.JWKS())
+
+	claims := NewClaims(
+		&model.Repo{ID: 1, FullName: "octocat/hello-world", Owner: "octocat"},
+		&model.Pipeline{Number: 5, Event: model.EventPush, Branch: "main", Ref: "refs/heads/main", Commit: "abc"},
+		&model.Workflow{Name: "deploy"},
+	)
+	signed, err := issuer.Issue(claims, "sts.amazonaws.com", time.Hour)
+	require.NoError(t, err)
+
+	jwks := reloaded.JWKS()
+	require.Len(t, jwks.Keys, 1)
+	parsed := &Claims{}
+	token, err := jwt.ParseWithClaims(signed, parsed, func(token *jwt.Token) (any, error) {
+		assert.Equal(t, jwks.Keys[0].KeyID, token.Header["kid"])
+		return publicKey(t, jwks.Keys[0]), nil
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer("https://ci.example.com"),
+		jwt.WithAudience("sts.amazonaws.com"),
+	)
+	require.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, "repo:octocat/hello-world:ref:refs/heads/main", parsed.Subject)
+	assert.Equal(t, "octocat/hello-world", parsed.Repo)
+	assert.Equal(t, int64(5), parsed.PipelineNumber)
+	assert.Equal(t, "deploy", parsed.Workflow)
+	assert.NotEmpty(t, parsed.ID)
+
+	_, err = jwt.ParseWithClaims(signed, &Claims{}, func(*jwt.Token) (any, error) {
+		return publicKey(t, jwks.Keys[0]), nil
+	}, jwt.WithAudience("vault"))
+	assert.ErrorIs(t, err, jwt.ErrTokenInvalidAudience)
+}
+
+func publicKey(t *testing.T, key JWK) any {
+	n, err := base64.RawURLEncoding.DecodeString(key.N)
+	require.NoError(t, err)
+	e, err := base64.RawURLEncoding.DecodeString(key.E)
+	require.NoError(t, err)
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+}
+
+func TestNewClaims(t *testing.T) {
+	repo := &model.Repo{ID: 1, FullName: "octocat/hello-world", Trusted: model.TrustedConfiguration{Network: true, Volumes: true, Security: true}}
+	workflow := &model.Workflow{Name: "deploy"}
+
+	claims := NewClaims(repo, &model.Pipeline{Event: model.EventPull, Ref: "refs/pull/1/head"}, workflow)
+	assert.Equal(t, "repo:octocat/hello-world:pull_request", claims.Subject)
+	assert.True(t, claims.Trusted)
+	assert.False(t, claims.Fork)
+
+	claims = NewClaims(repo, &model.Pipeline{Event: model.EventPull, Ref: "refs/pull/2/head", FromFork: true}, workflow)
+	assert.Equal(t, "repo:octocat/hello-world:pull_request:fork", claims.Subject)
+	assert.True(t, claims.Fork)
+
+	claims = NewClaims(repo, &model.Pipeline{Event: model.EventDeploy, DeployTo: "production"}, workflow)
+	assert.Equal(t, "repo:octocat/hello-world:deploy:production", claims.Subject)
+	assert.Equal(t, "production", claims.DeployTarget)
+
+	claims = NewClaims(repo, &model.Pipeline{Event: model.EventTag, Ref: "refs/tags/v1.0.0"}, workflow)
+	assert.Equal(t, "repo:octocat/hello-world:ref:refs/tags/v1.0.0", claims.Subject)
+}
+
+func TestConfiguration(t *testing.T) {
+	issuer := &Issuer{url: "https://ci.example.com/woodpecker"}
+	config := issuer.Configuration()
+	assert.Equal(t, "https://ci.example.com/woodpecker", config.Issuer)
+	assert.Equal(t, "https://ci.example.com/woodpecker/api/oidc/jwks", config.JWKSURI)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+const (
+	// compressProgressKey is the server config key holding the id of the last
+	// step whose logs got compressed by CompressFinished.
+	compressProgressKey = "log-compression-step-id"
+
+	// Specifies the batch size of steps to retrieve from database.
+	finishedItems = 100
+)
+
+// CompressFinished compresses the logs of all finished steps, e.g. of steps
+// finished before the service compressed logs. Its progress is kept in the
+// server config, so it continues where it stopped after a restart and only
+// has to check new steps once it is done. Services not compressing logs are
+// skipped.
+func CompressFinished(ctx context.Context, store store.Store, service Service) error {
+	compressor, ok := service.(Compressor)
+	if !ok {
+		return nil
+	}
+	return forEachFinished(ctx, store, compressProgressKey, func(step *model.Step) {
+		if err := compressor.LogCompress(step); err != nil {
+			log.Error().Err(err).Msgf("could not compress logs of step %d", step.ID)
+		}
+	})
+}
+
+// forEachFinished calls fn for every finished step after the one whose id is
+// kept in the server config at progressKey, updating it as it goes.
+func forEachFinished(ctx context.Context, store store.Store, progressKey string, fn func(step *model.Step)) error {
+	var afterID int64
+	progress, err := store.ServerConfigGet(progressKey)
+	switch {
+	case errors.Is(err, types.ErrRecordNotExist):
+	case err != nil:
+		return err
+	default:
+		if afterID, err = strconv.ParseInt(progress, 10, 64); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		steps, err := store.StepListFinished(afterID, finishedItems)
+		if err != nil {
+			return err
+		}
+
+		// fn handles its errors, as a broken log must not block the others
+		for _, step := range steps {
+			fn(step)
+			afterID = step.ID
+		}
+
+		if len(steps) > 0 {
+			if err := store.ServerConfigSet(progressKey, strconv.FormatInt(afterID, 10)); err != nil {
+				return err
+			}
+		}
+
+		if len(steps) < finishedItems {
+			return nil
+		}
+	}
+}
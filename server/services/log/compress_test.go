@@ -0,0 +1,76 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
+	store_mocks "go.woodpecker-ci.org/woodpecker/v3/server/store/mocks"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+type fakeCompressor struct {
+	*log_mocks.MockService
+	compressed []int64
+}
+
+func (c *fakeCompressor) LogCompress(step *model.Step) error {
+	c.compressed = append(c.compressed, step.ID)
+	if step.ID == 2 {
+		return errors.New("broken")
+	}
+	return nil
+}
+
+func TestCompressFinished(t *testing.T) {
+	steps := make([]*model.Step, finishedItems+2)
+	for i := range steps {
+		steps[i] = &model.Step{ID: int64(i + 1)}
+	}
+
+	store := store_mocks.NewMockStore(t)
+	store.On("ServerConfigGet", compressProgressKey).Return("", types.ErrRecordNotExist)
+	store.On("StepListFinished", int64(0), finishedItems).Return(steps[:finishedItems], nil)
+	store.On("ServerConfigSet", compressProgressKey, "100").Return(nil)
+	store.On("StepListFinished", int64(finishedItems), finishedItems).Return(steps[finishedItems:], nil)
+	store.On("ServerConfigSet", compressProgressKey, "102").Return(nil)
+
+	compressor := &fakeCompressor{}
+	require.NoError(t, CompressFinished(t.Context(), store, compressor))
+	// a failing step does not stop the others
+	assert.Len(t, compressor.compressed, finishedItems+2)
+}
+
+func TestCompressFinishedContinues(t *testing.T) {
+	store := store_mocks.NewMockStore(t)
+	store.On("ServerConfigGet", compressProgressKey).Return("102", nil)
+	store.On("StepListFinished", int64(102), finishedItems).Return([]*model.Step{}, nil)
+
+	compressor := &fakeCompressor{}
+	require.NoError(t, CompressFinished(t.Context(), store, compressor))
+	assert.Empty(t, compressor.compressed)
+}
+
+func TestCompressFinishedWithoutCompressor(t *testing.T) {
+	// the mocks fail on any call
+	store := store_mocks.NewMockStore(t)
+	assert.NoError(t, CompressFinished(t.Context(), store, log_mocks.NewMockService(t)))
+}
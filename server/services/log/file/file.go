@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/rs/zerolog/log"
@@ -55,9 +56,57 @@ func (l logStore) filePath(id int64) string {
 	return filepath.Join(l.base, fmt.Sprintf("%d.json", id))
 }
 
+// compressedFilePath returns the path of the compressed logs of a finished step.
+func (l logStore) compressedFilePath(id int64) string {
+	return filepath.Join(l.base, fmt.Sprintf("%d.json.gz", id))
+}
+
+// hasLogs returns whether a step has logs, compressed or not.
+func (l logStore) hasLogs(id int64) bool {
+	for _, path := range []string{l.compressedFilePath(id), l.filePath(id)} {
+		if _, err := os.Stat(path); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// indexFilePath returns the path of the sidecar file holding the words of the
+// logs of a finished step for searches.
+func (l logStore) indexFilePath(id int64) string {
+	return filepath.Join(l.base, fmt.Sprintf("%d.words", id))
+}
+
+// LogFind returns the compressed logs of a step followed by the ones not
+// compressed yet, e.g. of a running step.
 func (l logStore) LogFind(step *model.Step) ([]*model.LogEntry, error) {
-	filename := l.filePath(step.ID)
-	file, err := os.Open(filename)
+	entries, err := l.readCompressed(step.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uncompressed, err := l.read(step.ID)
+	if err != nil {
+		return nil, err
+	}
+	return append(entries, uncompressed...), nil
+}
+
+func (l logStore) readCompressed(id int64) ([]*model.LogEntry, error) {
+	file, err := os.Open(l.compressedFilePath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	return service_log.DecodeEntries(file)
+}
+
+func (l logStore) read(id int64) ([]*model.LogEntry, error) {
+	file, err := os.Open(l.filePath(id))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil
@@ -114,8 +163,104 @@ func (l logStore) LogAppend(step *model.Step, logEntries []*model.LogEntry) erro
 	return file.Close()
 }
 
+// LogDelete removes the logs of a step. It fails if the step has no logs.
 func (l logStore) LogDelete(step *model.Step) error {
+	if err := os.Remove(l.indexFilePath(step.ID)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	errCompressed := os.Remove(l.compressedFilePath(step.ID))
+	if errCompressed != nil && !os.IsNotExist(errCompressed) {
+		return errCompressed
+	}
+	err := os.Remove(l.filePath(step.ID))
+	if os.IsNotExist(err) && errCompressed == nil {
+		return nil
+	}
+	return err
+}
+
+func (l logStore) StepFinished(step *model.Step) {
+	if err := l.LogCompress(step); err != nil {
+		log.Error().Err(err).Msgf("could not compress logs of step %d", step.ID)
+	}
+	if err := l.LogIndex(step); err != nil {
+		log.Error().Err(err).Msgf("could not index logs of step %d", step.ID)
+	}
+}
+
+// LogCompress moves the logs of a step into its compressed file.
+func (l logStore) LogCompress(step *model.Step) error {
+	uncompressed, err := l.read(step.ID)
+	if err != nil || uncompressed == nil {
+		return err
+	}
+	entries, err := l.readCompressed(step.ID)
+	if err != nil {
+		return err
+	}
+
+	content, err := service_log.EncodeEntries(append(entries, uncompressed...))
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(l.compressedFilePath(step.ID), content); err != nil {
+		return err
+	}
 	return os.Remove(l.filePath(step.ID))
 }
 
-func (l logStore) StepFinished(_ *model.Step) {}
+// writeFile writes to a temporary file first, so readers never see a partial
+// file.
+func writeFile(path string, content []byte) error {
+	if err := os.WriteFile(path+".tmp", content, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// LogIndex writes the words of the logs of a step to its sidecar file. Steps
+// with too many words to index completely get no sidecar file.
+func (l logStore) LogIndex(step *model.Step) error {
+	entries, err := l.LogFind(step)
+	if err != nil {
+		return err
+	}
+	words, complete := service_log.IndexWords(entries)
+	if !complete {
+		if err := os.Remove(l.indexFilePath(step.ID)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	return writeFile(l.indexFilePath(step.ID), []byte(words))
+}
+
+// LogSearch returns the ids of the steps whose sidecar file has a word
+// starting with each of the terms. Steps with logs but without sidecar file
+// are returned as well, as their words are not indexed (completely).
+func (l logStore) LogSearch(stepIDs []int64, terms []string) ([]int64, error) {
+	found := make([]int64, 0, len(stepIDs))
+	for _, id := range stepIDs {
+		content, err := os.ReadFile(l.indexFilePath(id))
+		if os.IsNotExist(err) {
+			if l.hasLogs(id) {
+				found = append(found, id)
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		words := strings.Fields(string(content))
+		if slices.ContainsFunc(terms, func(term string) bool {
+			return !slices.ContainsFunc(words, func(word string) bool {
+				return strings.HasPrefix(word, term)
+			})
+		}) {
+			continue
+		}
+		found = append(found, id)
+	}
+	return found, nil
+}
@@ -15,14 +15,17 @@
 package file
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 )
 
 func TestNewLogStore(t *testing.T) {
@@ -86,7 +89,7 @@ func TestLogStoreAppendFindDelete(t *testing.T) {
 
 	require.NoError(t, s.LogDelete(step))
 
-	// StepFinished is a no-op for the file store but part of the interface
+	// StepFinished has nothing to compress anymore
 	s.StepFinished(step)
 
 	// after delete the file is gone -> find returns no entries, no error
@@ -95,6 +98,92 @@ func TestLogStoreAppendFindDelete(t *testing.T) {
 	assert.Empty(t, got)
 }
 
+func TestLogStoreCompress(t *testing.T) {
+	t.Parallel()
+
+	base := t.TempDir()
+	s, err := NewLogStore(base)
+	require.NoError(t, err)
+
+	step := &model.Step{ID: 43}
+	require.NoError(t, s.LogAppend(step, []*model.LogEntry{
+		{StepID: 43, Line: 0, Data: []byte("hello")},
+		{StepID: 43, Line: 1, Data: []byte("world")},
+	}))
+	s.StepFinished(step)
+
+	_, err = os.Stat(filepath.Join(base, "43.json"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(base, "43.json.gz"))
+	require.NoError(t, err)
+
+	got, err := s.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, []byte("world"), got[1].Data)
+
+	// logs of another attempt are appended to the compressed ones
+	require.NoError(t, s.LogAppend(step, []*model.LogEntry{
+		{StepID: 43, Line: 2, Data: []byte("again")},
+	}))
+	got, err = s.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	require.NoError(t, s.(service_log.Compressor).LogCompress(step))
+	got, err = s.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, []byte("again"), got[2].Data)
+
+	require.NoError(t, s.LogDelete(step))
+	got, err = s.LogFind(step)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestLogStoreSearch(t *testing.T) {
+	t.Parallel()
+
+	base := t.TempDir()
+	s, err := NewLogStore(base)
+	require.NoError(t, err)
+
+	for id, data := range map[int64]string{
+		1: "dial tcp 10.0.0.1:5432: connection refused",
+		2: "connection established",
+	} {
+		step := &model.Step{ID: id}
+		require.NoError(t, s.LogAppend(step, []*model.LogEntry{{StepID: id, Data: []byte(data)}}))
+		s.StepFinished(step)
+	}
+	_, err = os.Stat(filepath.Join(base, "1.words"))
+	require.NoError(t, err)
+
+	// too many words to index, so the step is always searched
+	var many strings.Builder
+	for i := range 50000 {
+		fmt.Fprintf(&many, "word%d ", i)
+	}
+	require.NoError(t, s.LogAppend(&model.Step{ID: 3}, []*model.LogEntry{{StepID: 3, Data: []byte(many.String())}}))
+	s.StepFinished(&model.Step{ID: 3})
+	_, err = os.Stat(filepath.Join(base, "3.words"))
+	require.True(t, os.IsNotExist(err))
+
+	searcher := s.(service_log.Searcher)
+	found, err := searcher.LogSearch([]int64{1, 2, 3, 4}, []string{"connection", "refus"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, found)
+
+	found, err = searcher.LogSearch([]int64{1, 2, 4}, []string{"connection"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, found)
+
+	require.NoError(t, s.LogDelete(&model.Step{ID: 1}))
+	_, err = os.Stat(filepath.Join(base, "1.words"))
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestLogFindMissingFile(t *testing.T) {
 	t.Parallel()
 
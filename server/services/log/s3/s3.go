@@ -0,0 +1,282 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+)
+
+const (
+	// requestTimeout limits a single request to the bucket, as the log service
+	// has no context to cancel them.
+	requestTimeout = time.Minute
+
+	// chunkSize and chunkInterval limit the logs of a running step kept in
+	// memory. Once the buffered entries exceed either, they are written to a
+	// chunk object of the step, so they survive restarts and every replica of
+	// the server can read them.
+	chunkSize     = 1 << 20
+	chunkInterval = 10 * time.Second
+
+	// maxBufferSize limits the logs of a step kept in memory while its chunks
+	// can not be written. Entries beyond it are dropped.
+	maxBufferSize = 16 << 20
+)
+
+// logStore keeps the logs of running steps in chunk objects, the latest of
+// them in memory, and merges them into one gzip compressed object of JSON
+// lines once a step finished.
+type logStore struct {
+	client *s3.Client
+	prefix string
+
+	sync.Mutex
+	buffers map[int64]*buffer
+}
+
+// buffer holds the entries of a running step not written to the bucket yet.
+type buffer struct {
+	entries []*model.LogEntry
+	size    int
+	created time.Time
+}
+
+func (b *buffer) append(entries ...*model.LogEntry) {
+	for _, entry := range entries {
+		b.size += len(entry.Data)
+	}
+	b.entries = append(b.entries, entries...)
+}
+
+// NewLogStore returns a service storing the logs of each step as an object of
+// an S3 compatible bucket. The keys of all objects start with prefix.
+func NewLogStore(config s3.Config, prefix string) (service_log.Service, error) {
+	client, err := s3.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &logStore{
+		client:  client,
+		prefix:  prefix,
+		buffers: make(map[int64]*buffer),
+	}, nil
+}
+
+func (l *logStore) key(stepID int64) string {
+	return path.Join(l.prefix, fmt.Sprintf("%d.json.gz", stepID))
+}
+
+// chunkPrefix returns the key prefix of the chunks of a running step. Chunk
+// keys end with the time they got written, so listing them keeps their order.
+func (l *logStore) chunkPrefix(stepID int64) string {
+	return path.Join(l.prefix, strconv.FormatInt(stepID, 10)) + "/"
+}
+
+func (l *logStore) LogFind(step *model.Step) ([]*model.LogEntry, error) {
+	// a step can finish without the server being told, e.g. if its workflow
+	// got canceled, so its logs are written once they are read
+	if step.Finished != 0 {
+		if err := l.flush(step.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	entries, err := l.read(ctx, l.key(step.ID))
+	if err != nil {
+		return nil, err
+	}
+	chunks, _, err := l.readChunks(ctx, step.ID)
+	if err != nil {
+		return nil, err
+	}
+	entries = append(entries, chunks...)
+
+	l.Lock()
+	defer l.Unlock()
+	if buf, ok := l.buffers[step.ID]; ok {
+		entries = append(entries, buf.entries...)
+	}
+	return entries, nil
+}
+
+func (l *logStore) LogAppend(step *model.Step, logEntries []*model.LogEntry) error {
+	l.Lock()
+	buf, ok := l.buffers[step.ID]
+	if !ok {
+		buf = &buffer{created: time.Now()}
+		l.buffers[step.ID] = buf
+	}
+	buf.append(logEntries...)
+	if buf.size < chunkSize && time.Since(buf.created) < chunkInterval {
+		l.Unlock()
+		return nil
+	}
+	delete(l.buffers, step.ID)
+	l.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	content, err := service_log.EncodeEntries(buf.entries)
+	if err == nil {
+		key := l.chunkPrefix(step.ID) + fmt.Sprintf("%020d.json.gz", time.Now().UnixNano())
+		err = l.client.Put(ctx, key, bytes.NewReader(content), int64(len(content)))
+	}
+	if err != nil {
+		return l.restore(step.ID, buf, err)
+	}
+	return nil
+}
+
+func (l *logStore) LogDelete(step *model.Step) error {
+	l.Lock()
+	delete(l.buffers, step.ID)
+	l.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	chunks, err := l.client.List(ctx, l.chunkPrefix(step.ID))
+	if err != nil {
+		return err
+	}
+	for _, chunk := range chunks {
+		if err := l.client.Delete(ctx, chunk.Key); err != nil {
+			return err
+		}
+	}
+	return l.client.Delete(ctx, l.key(step.ID))
+}
+
+func (l *logStore) StepFinished(step *model.Step) {
+	if err := l.flush(step.ID); err != nil {
+		log.Error().Err(err).Int64("step_id", step.ID).Msg("could not write logs to bucket")
+	}
+}
+
+// flush merges the chunks and the buffered entries of a step into its object.
+// It can be called more than once for a step, e.g. on each replica of the
+// server, as entries written by an earlier flush are kept.
+func (l *logStore) flush(stepID int64) error {
+	l.Lock()
+	buf := l.buffers[stepID]
+	delete(l.buffers, stepID)
+	l.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	chunks, keys, err := l.readChunks(ctx, stepID)
+	if err != nil {
+		return l.restore(stepID, buf, err)
+	}
+	if buf == nil && len(keys) == 0 {
+		return nil
+	}
+
+	entries, err := l.read(ctx, l.key(stepID))
+	if err != nil {
+		return l.restore(stepID, buf, err)
+	}
+	entries = append(entries, chunks...)
+	if buf != nil {
+		entries = append(entries, buf.entries...)
+	}
+
+	content, err := service_log.EncodeEntries(entries)
+	if err == nil {
+		err = l.client.Put(ctx, l.key(stepID), bytes.NewReader(content), int64(len(content)))
+	}
+	if err != nil {
+		return l.restore(stepID, buf, err)
+	}
+
+	for _, key := range keys {
+		if err := l.client.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restore puts back the entries of a step which could not be written, to
+// retry with the next write. It returns err, noting the entries dropped if
+// the step has more entries buffered than maxBufferSize.
+func (l *logStore) restore(stepID int64, buf *buffer, err error) error {
+	if buf == nil {
+		return err
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if newer, ok := l.buffers[stepID]; ok {
+		buf.append(newer.entries...)
+	}
+	if buf.size > maxBufferSize {
+		delete(l.buffers, stepID)
+		return fmt.Errorf("dropped %d log entries of step %d: %w", len(buf.entries), stepID, err)
+	}
+	l.buffers[stepID] = buf
+	return err
+}
+
+// readChunks returns the entries of the chunks of a step along with their
+// keys.
+func (l *logStore) readChunks(ctx context.Context, stepID int64) ([]*model.LogEntry, []string, error) {
+	chunks, err := l.client.List(ctx, l.chunkPrefix(stepID))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []*model.LogEntry
+	keys := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		chunkEntries, err := l.read(ctx, chunk.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, chunkEntries...)
+		keys = append(keys, chunk.Key)
+	}
+	return entries, keys, nil
+}
+
+func (l *logStore) read(ctx context.Context, key string) ([]*model.LogEntry, error) {
+	object, err := l.client.Get(ctx, key)
+	if errors.Is(err, s3.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	return service_log.DecodeEntries(object)
+}
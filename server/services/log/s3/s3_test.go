@@ -0,0 +1,152 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3"
+	"go.woodpecker-ci.org/woodpecker/v3/shared/s3/fixtures"
+)
+
+func newTestLogStore(t *testing.T) (*logStore, *fixtures.Bucket) {
+	t.Helper()
+	bucket := fixtures.NewBucket("logs")
+	server := httptest.NewServer(bucket)
+	t.Cleanup(server.Close)
+
+	service, err := NewLogStore(s3.Config{
+		Endpoint:    server.URL,
+		Bucket:      "logs",
+		AccessKeyID: fixtures.AccessKeyID,
+		PathStyle:   true,
+	}, "woodpecker/logs")
+	require.NoError(t, err)
+	return service.(*logStore), bucket
+}
+
+func TestLogStore(t *testing.T) {
+	store, bucket := newTestLogStore(t)
+	step := &model.Step{ID: 1}
+
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{
+		{StepID: 1, Line: 0, Data: []byte("hello")},
+		{StepID: 1, Line: 1, Data: []byte("world")},
+	}))
+
+	// running steps are served from memory
+	entries, err := store.LogFind(step)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	_, ok := bucket.Object("woodpecker/logs/1.json.gz")
+	assert.False(t, ok)
+
+	store.StepFinished(step)
+	_, ok = bucket.Object("woodpecker/logs/1.json.gz")
+	assert.True(t, ok)
+	assert.Empty(t, store.buffers)
+
+	entries, err = store.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "hello", string(entries[0].Data))
+	assert.Equal(t, 1, entries[1].Line)
+
+	// later entries are appended to the logs already written
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 1, Line: 2, Data: []byte("again")}}))
+	store.StepFinished(step)
+	entries, err = store.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "again", string(entries[2].Data))
+
+	require.NoError(t, store.LogDelete(step))
+	_, ok = bucket.Object("woodpecker/logs/1.json.gz")
+	assert.False(t, ok)
+	entries, err = store.LogFind(step)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLogStoreFinishedWithoutNotice(t *testing.T) {
+	store, bucket := newTestLogStore(t)
+	step := &model.Step{ID: 2}
+
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 2, Data: []byte("canceled")}}))
+
+	step.Finished = 1234
+	entries, err := store.LogFind(step)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+	_, ok := bucket.Object("woodpecker/logs/2.json.gz")
+	assert.True(t, ok)
+	assert.Empty(t, store.buffers)
+}
+
+func TestLogStoreFlushError(t *testing.T) {
+	service, err := NewLogStore(s3.Config{Endpoint: "http://127.0.0.1:1", Bucket: "logs", PathStyle: true}, "")
+	require.NoError(t, err)
+	store := service.(*logStore)
+	step := &model.Step{ID: 3}
+
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 3, Data: []byte("kept")}}))
+	store.StepFinished(step)
+
+	// entries stay buffered to retry the upload later
+	assert.Len(t, store.buffers[3].entries, 1)
+
+	// unless there are too many of them
+	err = store.LogAppend(step, []*model.LogEntry{{StepID: 3, Data: bytes.Repeat([]byte("x"), maxBufferSize)}})
+	assert.ErrorContains(t, err, "dropped 2 log entries of step 3")
+	assert.Empty(t, store.buffers)
+}
+
+func TestLogStoreChunks(t *testing.T) {
+	store, bucket := newTestLogStore(t)
+	step := &model.Step{ID: 4}
+
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 4, Line: 0, Data: []byte("small")}}))
+	assert.Len(t, store.buffers, 1)
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 4, Line: 1, Data: bytes.Repeat([]byte("x"), chunkSize)}}))
+	assert.Empty(t, store.buffers)
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 4, Line: 2, Data: []byte("buffered")}}))
+
+	// other replicas read the chunks of running steps
+	replica := &logStore{client: store.client, prefix: store.prefix, buffers: make(map[int64]*buffer)}
+	entries, err := replica.LogFind(step)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	entries, err = store.LogFind(step)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+
+	// finishing the step merges the chunks into its object
+	store.StepFinished(step)
+	assert.Equal(t, []string{"woodpecker/logs/4.json.gz"}, bucket.Keys())
+	entries, err = replica.LogFind(step)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "buffered", string(entries[2].Data))
+
+	require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: 4, Data: bytes.Repeat([]byte("x"), chunkSize)}}))
+	require.NoError(t, store.LogDelete(step))
+	assert.Empty(t, bucket.Keys())
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/rs/zerolog/log"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	// indexProgressKey is the server config key holding the id of the last
+	// step whose logs got indexed by IndexFinished.
+	indexProgressKey = "log-search-step-id"
+
+	// maxIndexLength limits the words of a step indexed for search, as
+	// databases limit the size of their full text documents.
+	maxIndexLength = 256 * 1024
+)
+
+// IndexFinished adds the logs of all finished steps to the search index, e.g.
+// of steps finished before the service indexed logs. Like CompressFinished it
+// keeps its progress in the server config. Services not indexing logs are
+// skipped.
+func IndexFinished(ctx context.Context, store store.Store, service Service) error {
+	searcher, ok := service.(Searcher)
+	if !ok {
+		return nil
+	}
+	return forEachFinished(ctx, store, indexProgressKey, func(step *model.Step) {
+		if err := searcher.LogIndex(step); err != nil {
+			log.Error().Err(err).Msgf("could not index logs of step %d", step.ID)
+		}
+	})
+}
+
+// IndexWords returns the distinct words of the output of a step separated by
+// spaces, the document a Searcher indexes for the step. Words beyond
+// maxIndexLength are left out, in which case complete is false and the index
+// must not rule out the step.
+func IndexWords(entries []*model.LogEntry) (words string, complete bool) {
+	seen := make(map[string]struct{})
+	var b strings.Builder
+	for _, entry := range entries {
+		if !isOutput(entry) {
+			continue
+		}
+		for _, word := range splitWords(string(entry.Data)) {
+			if _, ok := seen[word]; ok {
+				continue
+			}
+			if b.Len()+len(word)+1 > maxIndexLength {
+				return b.String(), false
+			}
+			seen[word] = struct{}{}
+			if b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(word)
+		}
+	}
+	return b.String(), true
+}
+
+// SearchTerms returns the words of a search query a Searcher looks up in its
+// index. Words are lower case and consist of letters and digits only, so
+// indexes do not have to care about punctuation.
+func SearchTerms(query string) []string {
+	return splitWords(query)
+}
+
+func splitWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func isOutput(entry *model.LogEntry) bool {
+	return entry.Type == model.LogEntryStdout || entry.Type == model.LogEntryStderr
+}
+
+// Search returns the lines of the logs of the given steps which contain the
+// query, ignoring case, along with up to contextLines lines around them. It
+// stops after limit matches. Finished steps are looked up in the index of
+// services implementing Searcher first, so only their matching logs are read.
+func Search(service Service, steps []*model.Step, query string, contextLines, limit int) ([]*model.LogMatch, error) {
+	candidates := steps
+	if searcher, ok := service.(Searcher); ok {
+		var err error
+		if candidates, err = searchIndex(searcher, steps, SearchTerms(query)); err != nil {
+			return nil, err
+		}
+	}
+
+	query = strings.ToLower(query)
+	matches := make([]*model.LogMatch, 0)
+	for _, step := range candidates {
+		entries, err := service.LogFind(step)
+		if err != nil {
+			return nil, err
+		}
+
+		var lines []*model.LogEntry
+		for _, entry := range entries {
+			if isOutput(entry) {
+				lines = append(lines, entry)
+			}
+		}
+
+		for i, line := range lines {
+			if !strings.Contains(strings.ToLower(string(line.Data)), query) {
+				continue
+			}
+			matches = append(matches, &model.LogMatch{
+				StepID:   step.ID,
+				StepName: step.Name,
+				Line:     line.Line,
+				Text:     lineText(line),
+				Before:   linesText(lines[max(0, i-contextLines):i]),
+				After:    linesText(lines[i+1 : min(len(lines), i+1+contextLines)]),
+			})
+			if len(matches) == limit {
+				return matches, nil
+			}
+		}
+	}
+	return matches, nil
+}
+
+// searchIndex returns the steps which may contain the terms: the finished
+// ones found in the index and all others, as their logs are not indexed yet.
+func searchIndex(searcher Searcher, steps []*model.Step, terms []string) ([]*model.Step, error) {
+	var finished []int64
+	for _, step := range steps {
+		if step.Finished != 0 {
+			finished = append(finished, step.ID)
+		}
+	}
+	if len(finished) == 0 || len(terms) == 0 {
+		return steps, nil
+	}
+
+	found, err := searcher.LogSearch(finished, terms)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*model.Step, 0, len(found))
+	for _, step := range steps {
+		if step.Finished == 0 || slices.Contains(found, step.ID) {
+			candidates = append(candidates, step)
+		}
+	}
+	return candidates, nil
+}
+
+func lineText(entry *model.LogEntry) string {
+	return strings.TrimRight(string(entry.Data), "\r\n")
+}
+
+func linesText(entries []*model.LogEntry) []string {
+	if len(entries) == 0 {
+		return nil
+	}
+	text := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		text = append(text, lineText(entry))
+	}
+	return text
+}
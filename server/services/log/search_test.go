@@ -0,0 +1,90 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	log_mocks "go.woodpecker-ci.org/woodpecker/v3/server/services/log/mocks"
+)
+
+type fakeSearcher struct {
+	*log_mocks.MockService
+	found []int64
+	terms []string
+}
+
+func (s *fakeSearcher) LogIndex(*model.Step) error {
+	return nil
+}
+
+func (s *fakeSearcher) LogSearch(_ []int64, terms []string) ([]int64, error) {
+	s.terms = terms
+	return s.found, nil
+}
+
+func TestIndexWords(t *testing.T) {
+	words, complete := IndexWords([]*model.LogEntry{
+		{Data: []byte("dial tcp 10.0.0.1: connection refused")},
+		{Data: []byte(`{"event":"start"}`), Type: model.LogEntryMetadata},
+		{Data: []byte("Connection refused"), Type: model.LogEntryStderr},
+	})
+	assert.Equal(t, "dial tcp 10 0 1 connection refused", words)
+	assert.True(t, complete)
+
+	words, complete = IndexWords([]*model.LogEntry{
+		{Data: []byte(strings.Repeat("a", maxIndexLength-4) + " b c d")},
+	})
+	assert.Len(t, words, maxIndexLength)
+	assert.False(t, complete)
+}
+
+func TestSearch(t *testing.T) {
+	lines := func(data ...string) []*model.LogEntry {
+		entries := []*model.LogEntry{{Data: []byte(`{"event":"start"}`), Type: model.LogEntryMetadata}}
+		for i, d := range data {
+			entries = append(entries, &model.LogEntry{Line: i, Data: []byte(d + "\n")})
+		}
+		return entries
+	}
+	steps := []*model.Step{
+		{ID: 1, Name: "build", Finished: 1},
+		{ID: 2, Name: "test", Finished: 1},
+		{ID: 3, Name: "deploy"},
+	}
+
+	service := log_mocks.NewMockService(t)
+	service.On("LogFind", steps[0]).Return(lines("a", "b", "connection Refused", "c", "d", "e"), nil)
+	service.On("LogFind", steps[2]).Return(lines("connection refused"), nil)
+	searcher := &fakeSearcher{MockService: service, found: []int64{1}}
+
+	matches, err := Search(searcher, steps, "Connection refused", 2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"connection", "refused"}, searcher.terms)
+	assert.Equal(t, []*model.LogMatch{
+		{StepID: 1, StepName: "build", Line: 2, Text: "connection Refused", Before: []string{"a", "b"}, After: []string{"c", "d"}},
+		// running steps are not indexed yet
+		{StepID: 3, StepName: "deploy", Line: 0, Text: "connection refused"},
+	}, matches)
+
+	matches, err = Search(searcher, steps, "refused", 0, 1)
+	require.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
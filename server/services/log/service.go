@@ -22,3 +22,23 @@ type Service interface {
 	LogDelete(step *model.Step) error
 	StepFinished(step *model.Step)
 }
+
+// Compressor is implemented by services that compress the logs of a step
+// once it finished.
+type Compressor interface {
+	// LogCompress compresses the logs of a finished step. Logs already
+	// compressed are kept, so it can be called more than once for a step.
+	LogCompress(step *model.Step) error
+}
+
+// Searcher is implemented by services that index the logs of finished steps
+// for full text search.
+type Searcher interface {
+	// LogIndex adds the logs of a finished step to the search index,
+	// replacing the ones indexed before.
+	LogIndex(step *model.Step) error
+	// LogSearch returns the ids of the given steps whose indexed logs contain
+	// a word starting with each of the terms, see SearchTerms, along with the
+	// ones whose logs are not indexed completely.
+	LogSearch(stepIDs []int64, terms []string) ([]int64, error)
+}
@@ -38,6 +38,9 @@ type SetupForge func(forge *model.Forge) (forge.Forge, error)
 
 type Manager interface {
 	SignaturePublicKey() crypto.PublicKey
+	// WebhookClient returns the client signing the requests to the outgoing
+	// webhooks.
+	WebhookClient() *utils.Client
 	SecretServiceFromRepo(repo *model.Repo) secret.Service
 	SecretService() secret.Service
 	RegistryServiceFromRepo(repo *model.Repo) registry.Service
@@ -60,6 +63,7 @@ type manager struct {
 	forgeCache          *ttlcache.Cache[int64, forge.Forge]
 	setupForge          SetupForge
 	client              *utils.Client
+	webhookClient       *utils.Client
 }
 
 func NewManager(c *cli.Command, store store.Store, setupForge SetupForge) (Manager, error) {
@@ -78,6 +82,11 @@ func NewManager(c *cli.Command, store store.Store, setupForge SetupForge) (Manag
 		return nil, err
 	}
 
+	webhookClient, err := utils.NewWebhookClient(signaturePrivateKey, c.String("webhook-allowed-hosts"))
+	if err != nil {
+		return nil, err
+	}
+
 	configService, err := setupConfigService(c, client)
 	if err != nil {
 		return nil, err
@@ -94,6 +103,7 @@ func NewManager(c *cli.Command, store store.Store, setupForge SetupForge) (Manag
 		forgeCache:          ttlcache.New(ttlcache.WithDisableTouchOnHit[int64, forge.Forge]()),
 		setupForge:          setupForge,
 		client:              client,
+		webhookClient:       webhookClient,
 	}, nil
 }
 
@@ -101,6 +111,10 @@ func (m *manager) SignaturePublicKey() crypto.PublicKey {
 	return m.signaturePublicKey
 }
 
+func (m *manager) WebhookClient() *utils.Client {
+	return m.webhookClient
+}
+
 func (m *manager) SecretServiceFromRepo(repo *model.Repo) secret.Service {
 	if repo.SecretExtensionEndpoint != "" {
 		return secret.NewCombined(m.secret, secret.NewHTTP(strings.TrimRight(repo.SecretExtensionEndpoint, "/"), m.client, repo.SecretExtensionNetrc))
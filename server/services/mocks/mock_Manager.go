@@ -14,6 +14,7 @@ import (
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/environment"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/registry"
 	"go.woodpecker-ci.org/woodpecker/v3/server/services/secret"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/utils"
 )
 
 // NewMockManager creates a new instance of MockManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -571,3 +572,49 @@ func (_c *MockManager_SignaturePublicKey_Call) RunAndReturn(run func() crypto.Pu
 	_c.Call.Return(run)
 	return _c
 }
+
+// WebhookClient provides a mock function for the type MockManager
+func (_mock *MockManager) WebhookClient() *utils.Client {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookClient")
+	}
+
+	var r0 *utils.Client
+	if returnFunc, ok := ret.Get(0).(func() *utils.Client); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*utils.Client)
+		}
+	}
+	return r0
+}
+
+// MockManager_WebhookClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookClient'
+type MockManager_WebhookClient_Call struct {
+	*mock.Call
+}
+
+// WebhookClient is a helper method to define mock.On call
+func (_e *MockManager_Expecter) WebhookClient() *MockManager_WebhookClient_Call {
+	return &MockManager_WebhookClient_Call{Call: _e.mock.On("WebhookClient")}
+}
+
+func (_c *MockManager_WebhookClient_Call) Run(run func()) *MockManager_WebhookClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockManager_WebhookClient_Call) Return(client *utils.Client) *MockManager_WebhookClient_Call {
+	_c.Call.Return(client)
+	return _c
+}
+
+func (_c *MockManager_WebhookClient_Call) RunAndReturn(run func() *utils.Client) *MockManager_WebhookClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -145,6 +145,9 @@ func setupForgeService(c *cli.Command, _store store.Store) error {
 		_forge.Type = model.ForgeTypeGithub
 		_forge.AdditionalOptions["merge-ref"] = c.Bool("github-merge-ref")
 		_forge.AdditionalOptions["public-only"] = c.Bool("github-public-only")
+		_forge.AdditionalOptions["app-id"] = c.String("github-app-id")
+		_forge.AdditionalOptions["app-private-key"] = c.String("github-app-private-key")
+		_forge.AdditionalOptions["app-webhook-secret"] = c.String("github-app-webhook-secret")
 		if _forge.URL == "" {
 			_forge.URL = "https://github.com"
 		}
@@ -171,6 +174,14 @@ func setupForgeService(c *cli.Command, _store store.Store) error {
 		_forge.AdditionalOptions["git-username"] = c.String("bitbucket-dc-git-username")
 		_forge.AdditionalOptions["git-password"] = c.String("bitbucket-dc-git-password")
 		_forge.AdditionalOptions["oauth-enable-project-admin-scope"] = c.Bool("bitbucket-dc-oauth-enable-oauth2-scope-project-admin")
+	case c.Bool("git"):
+		_forge.Type = model.ForgeTypeGit
+		_forge.AdditionalOptions["repos"] = c.StringSlice("git-repos")
+		_forge.AdditionalOptions["users-file"] = c.String("git-users-file")
+		_forge.AdditionalOptions["push-users"] = c.StringSlice("git-push-users")
+		_forge.AdditionalOptions["git-username"] = c.String("git-username")
+		_forge.AdditionalOptions["git-password"] = c.String("git-password")
+		_forge.AdditionalOptions["cache-dir"] = c.String("git-cache-dir")
 	default:
 		return errors.New("forge not configured")
 	}
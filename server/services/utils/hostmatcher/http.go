@@ -13,6 +13,18 @@ import (
 	"time"
 )
 
+// NotAllowedError is returned when a host is not in the allow list.
+type NotAllowedError struct {
+	Usage      string
+	SettingKey string
+	Host       string
+	Addr       string
+}
+
+func (e *NotAllowedError) Error() string {
+	return fmt.Sprintf("%s can only call allowed HTTP servers (check your %s setting), deny '%s(%s)'", e.Usage, e.SettingKey, e.Host, e.Addr)
+}
+
 // NewDialContext returns a DialContext for Transport, the DialContext will do allow/block list check.
 func NewDialContext(usage string, allowList *HostMatchList) func(ctx context.Context, network, addr string) (net.Conn, error) {
 	return NewDialContextWithProxy(usage, allowList, nil)
@@ -56,7 +68,7 @@ func NewDialContextWithProxy(usage string, allowList *HostMatchList, proxy *url.
 				// if we have an allow-list, check the allow-list first
 				if !allowList.IsEmpty() {
 					if !allowList.MatchHostOrIP(host, tcpAddr.IP) {
-						return fmt.Errorf("%s can only call allowed HTTP servers (check your %s setting), deny '%s(%s)'", usage, allowList.SettingKeyHint, host, ipAddr)
+						return &NotAllowedError{Usage: usage, SettingKey: allowList.SettingKeyHint, Host: host, Addr: ipAddr}
 					}
 				}
 
@@ -42,13 +42,13 @@ type Client struct {
 	*httpsign.Client
 }
 
-func getHTTPClient(privateKey crypto.PrivateKey, allowedHostListValue string) (*httpsign.Client, error) {
+func getHTTPClient(privateKey crypto.PrivateKey, usage, settingKey, allowedHostListValue string) (*httpsign.Client, error) {
 	timeout := 10 * time.Second //nolint:mnd
 
 	if allowedHostListValue == "" {
 		allowedHostListValue = hostmatcher.MatchBuiltinExternal
 	}
-	allowedHostMatcher := hostmatcher.ParseHostMatchList("WOODPECKER_EXTENSIONS_ALLOWED_HOSTS", allowedHostListValue)
+	allowedHostMatcher := hostmatcher.ParseHostMatchList(settingKey, allowedHostListValue)
 
 	pubKeyID := "woodpecker-ci-extensions"
 
@@ -68,9 +68,9 @@ func getHTTPClient(privateKey crypto.PrivateKey, allowedHostListValue string) (*
 	baseTransport := httputil.NewUserAgentRoundTripper(
 		&http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: false},
-			DialContext:     hostmatcher.NewDialContext("extensions", allowedHostMatcher),
+			DialContext:     hostmatcher.NewDialContext(usage, allowedHostMatcher),
 		},
-		"server-extensions",
+		"server-"+usage,
 	)
 
 	client := http.Client{
@@ -84,7 +84,21 @@ func getHTTPClient(privateKey crypto.PrivateKey, allowedHostListValue string) (*
 }
 
 func NewHTTPClient(privateKey crypto.PrivateKey, allowedHostList string) (*Client, error) {
-	client, err := getHTTPClient(privateKey, allowedHostList)
+	client, err := getHTTPClient(privateKey, "extensions", "WOODPECKER_EXTENSIONS_ALLOWED_HOSTS", allowedHostList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		Client: client,
+	}, nil
+}
+
+// NewWebhookClient returns a client for the outgoing webhooks. As they are set
+// up by repo and org admins, the hosts it can contact are limited separately
+// from the ones of the extensions.
+func NewWebhookClient(privateKey crypto.PrivateKey, allowedHostList string) (*Client, error) {
+	client, err := getHTTPClient(privateKey, "webhooks", "WOODPECKER_WEBHOOK_ALLOWED_HOSTS", allowedHostList)
 	if err != nil {
 		return nil, err
 	}
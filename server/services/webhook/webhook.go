@@ -0,0 +1,347 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook sends state changes of pipelines and agents to the
+// outgoing webhooks set up globally or for an org or repo.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/errgroup"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/utils"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/utils/hostmatcher"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+)
+
+const (
+	// maxAttempts is the number of attempts to send a delivery before it
+	// is marked as failed.
+	maxAttempts = 5
+
+	// checkInterval is the interval due deliveries are sent and agents are
+	// checked in, new deliveries are sent right away.
+	checkInterval = 10 * time.Second
+
+	// claimTimeout is the time a server has to send a delivery before other
+	// servers send it again.
+	claimTimeout = 5 * time.Minute
+
+	// agentOfflineAfter is the time without contact after which an agent is
+	// reported offline. Agents report their health every 10 seconds.
+	agentOfflineAfter = time.Minute
+
+	// deliveryRetention is the time sent deliveries are kept in the history.
+	deliveryRetention = 30 * 24 * time.Hour
+
+	// batchItems is the number of due deliveries read at once.
+	batchItems = 50
+
+	// parallelDeliveries is the number of deliveries sent in parallel.
+	parallelDeliveries = 10
+)
+
+// Service queues the deliveries of events to the subscribed webhooks and
+// sends them, retrying failed ones with backoff.
+type Service struct {
+	store  store.Store
+	client *utils.Client
+	host   string
+	wake   chan struct{}
+
+	// started is the time the service was created, agents already offline
+	// then are left to the servers that were running.
+	started     time.Time
+	lastCleanup time.Time
+}
+
+// New returns a Service sending the deliveries with the given client, host is
+// the public address of the server used to link to pipelines.
+func New(store store.Store, client *utils.Client, host string) *Service {
+	return &Service{
+		store:   store,
+		client:  client,
+		host:    host,
+		wake:    make(chan struct{}, 1),
+		started: time.Now(),
+	}
+}
+
+// PipelineEvent queues an event of a pipeline, or of one of its workflows if
+// workflow is set, for the webhooks of the repo, its org and the global
+// ones. A nil Service queues nothing, so callers need not check whether
+// webhooks are set up.
+func (s *Service) PipelineEvent(event model.WebhookTrigger, repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) {
+	if s == nil {
+		return
+	}
+
+	// ToAPIModel changes the pipeline it wraps
+	_pipeline := *pipeline
+	s.queue(event, repo.OrgID, repo.ID, &model.WebhookPayload{
+		Event:    event,
+		Repo:     repo,
+		Pipeline: _pipeline.ToAPIModel(),
+		Workflow: workflow,
+		URL:      s.pipelineURL(repo, pipeline, workflow),
+	})
+}
+
+func (s *Service) agentOffline(agent *model.Agent) {
+	_agent := *agent
+	_agent.Token = ""
+	// global agents have no org
+	s.queue(model.WebhookTriggerAgentOffline, max(agent.OrgID, 0), 0, &model.WebhookPayload{
+		Event: model.WebhookTriggerAgentOffline,
+		Agent: &_agent,
+	})
+}
+
+func (s *Service) queue(event model.WebhookTrigger, orgID, repoID int64, payload *model.WebhookPayload) {
+	webhooks, err := s.store.WebhookListEnabled(orgID, repoID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot list webhooks for %s event", event)
+		return
+	}
+
+	var data []byte
+	queued := false
+	for _, webhook := range webhooks {
+		if !webhook.Subscribed(event) {
+			continue
+		}
+		if data == nil {
+			if data, err = json.Marshal(payload); err != nil {
+				log.Error().Err(err).Msgf("cannot marshal %s event", event)
+				return
+			}
+		}
+
+		delivery := &model.WebhookDelivery{
+			WebhookID:   webhook.ID,
+			Event:       event,
+			Payload:     string(data),
+			Status:      model.WebhookDeliveryPending,
+			NextAttempt: time.Now().Unix(),
+		}
+		if err := s.store.WebhookDeliveryCreate(delivery); err != nil {
+			log.Error().Err(err).Msgf("cannot queue %s event for webhook %d", event, webhook.ID)
+			continue
+		}
+		queued = true
+	}
+
+	if queued {
+		s.notify()
+	}
+}
+
+// Redeliver queues the payload of a delivery again as a new delivery.
+func (s *Service) Redeliver(delivery *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	redelivery := &model.WebhookDelivery{
+		WebhookID:   delivery.WebhookID,
+		Event:       delivery.Event,
+		Payload:     delivery.Payload,
+		Status:      model.WebhookDeliveryPending,
+		NextAttempt: time.Now().Unix(),
+	}
+	if err := s.store.WebhookDeliveryCreate(redelivery); err != nil {
+		return nil, err
+	}
+
+	s.notify()
+	return redelivery, nil
+}
+
+func (s *Service) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends the queued deliveries and reports agents going offline until ctx
+// is canceled.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.wake:
+		case now := <-ticker.C:
+			s.checkAgents(now)
+			s.deleteOldDeliveries(now)
+		}
+		s.sendDue(ctx)
+	}
+}
+
+// sendDue sends a batch of due deliveries.
+func (s *Service) sendDue(ctx context.Context) {
+	now := time.Now()
+	deliveries, err := s.store.WebhookDeliveryListDue(now.Unix(), batchItems)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot list due webhook deliveries")
+		return
+	}
+
+	group := errgroup.Group{}
+	group.SetLimit(parallelDeliveries)
+	for _, delivery := range deliveries {
+		claimed, err := s.store.WebhookDeliveryClaim(delivery, now.Add(claimTimeout).Unix())
+		if err != nil {
+			log.Error().Err(err).Msgf("cannot claim webhook delivery %d", delivery.ID)
+			continue
+		}
+		if !claimed {
+			// sent by another server
+			continue
+		}
+		group.Go(func() error {
+			s.send(ctx, delivery)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	// continue with the next batch right away
+	if len(deliveries) == batchItems {
+		s.notify()
+	}
+}
+
+func (s *Service) send(ctx context.Context, delivery *model.WebhookDelivery) {
+	webhook, err := s.store.WebhookFind(delivery.WebhookID)
+	if err != nil {
+		log.Error().Err(err).Msgf("cannot find webhook %d", delivery.WebhookID)
+		return
+	}
+
+	started := time.Now()
+	status, err := s.client.Send(ctx, http.MethodPost, webhook.URL, json.RawMessage(delivery.Payload), nil)
+	if ctx.Err() != nil {
+		// the claim expires and the delivery is sent again
+		return
+	}
+
+	delivery.Attempts++
+	delivery.ResponseCode = status
+	delivery.Delivered = started.Unix()
+	switch {
+	case err == nil:
+		delivery.Status = model.WebhookDeliverySuccess
+		delivery.Error = ""
+		delivery.NextAttempt = 0
+	case delivery.Attempts >= maxAttempts:
+		delivery.Status = model.WebhookDeliveryFailure
+		delivery.Error = deliveryError(status, err)
+		delivery.NextAttempt = 0
+	default:
+		delivery.Status = model.WebhookDeliveryPending
+		delivery.Error = deliveryError(status, err)
+		delivery.NextAttempt = started.Add(retryDelay(delivery.Attempts)).Unix()
+	}
+	if err != nil {
+		log.Debug().Err(err).Msgf("webhook delivery %d to webhook %d failed", delivery.ID, webhook.ID)
+	}
+
+	if err := s.store.WebhookDeliveryUpdate(delivery); err != nil {
+		log.Error().Err(err).Msgf("cannot update webhook delivery %d", delivery.ID)
+	}
+}
+
+// deliveryError returns the error shown for a failed delivery. It leaves out
+// the response body and the details of connection errors, so webhooks can't be
+// used to read from or probe hosts only the server can reach.
+func deliveryError(status int, err error) string {
+	var notAllowed *hostmatcher.NotAllowedError
+	switch {
+	case status != 0:
+		return fmt.Sprintf("unexpected response status %d", status)
+	case errors.As(err, &notAllowed):
+		return "host is not allowed"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "request timed out"
+	default:
+		return "request failed"
+	}
+}
+
+// retryDelay returns the delay before the next attempt of a delivery that
+// failed the given number of times, it starts at a minute and quadruples.
+func retryDelay(attempts int) time.Duration {
+	return time.Minute << (2 * (attempts - 1))
+}
+
+// checkAgents reports the agents without contact for agentOfflineAfter,
+// every agent is only reported once by one of the servers until it is back
+// online.
+func (s *Service) checkAgents(now time.Time) {
+	agents, err := s.store.AgentList(&model.ListOptionsWithAll{All: true})
+	if err != nil {
+		log.Error().Err(err).Msg("cannot list agents")
+		return
+	}
+
+	for _, agent := range agents {
+		if agent.LastContact == 0 || agent.OfflineReported == agent.LastContact {
+			continue
+		}
+		offlineSince := time.Unix(agent.LastContact, 0).Add(agentOfflineAfter)
+		// agents already offline when the server started are not reported
+		if now.Before(offlineSince) || offlineSince.Before(s.started) {
+			continue
+		}
+
+		claimed, err := s.store.AgentClaimOffline(agent)
+		if err != nil {
+			log.Error().Err(err).Msgf("cannot claim offline report of agent %d", agent.ID)
+			continue
+		}
+		if !claimed {
+			// reported by another server
+			continue
+		}
+		s.agentOffline(agent)
+	}
+}
+
+func (s *Service) deleteOldDeliveries(now time.Time) {
+	if now.Sub(s.lastCleanup) < time.Hour {
+		return
+	}
+	s.lastCleanup = now
+
+	if err := s.store.WebhookDeliveryDeleteBefore(now.Add(-deliveryRetention).Unix()); err != nil {
+		log.Error().Err(err).Msg("cannot delete old webhook deliveries")
+	}
+}
+
+func (s *Service) pipelineURL(repo *model.Repo, pipeline *model.Pipeline, workflow *model.Workflow) string {
+	if workflow == nil {
+		return fmt.Sprintf("%s/repos/%d/pipeline/%d", s.host, repo.ID, pipeline.Number)
+	}
+	return fmt.Sprintf("%s/repos/%d/pipeline/%d/%d", s.host, repo.ID, pipeline.Number, workflow.PID)
+}
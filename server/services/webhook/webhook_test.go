@@ -0,0 +1,255 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package webhook
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/services/utils"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/datastore"
+)
+
+// receiver records the payloads posted to it and answers with status.
+type receiver struct {
+	sync.Mutex
+	status   int
+	payloads []*model.WebhookPayload
+	signed   bool
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+
+	payload := new(model.WebhookPayload)
+	if err := json.NewDecoder(req.Body).Decode(payload); err == nil {
+		r.payloads = append(r.payloads, payload)
+	}
+	r.signed = req.Header.Get("Signature") != ""
+	w.WriteHeader(r.status)
+	_, _ = w.Write([]byte("internal details"))
+}
+
+func newTestService(t *testing.T, r *receiver) (*Service, store.Store, string) {
+	t.Helper()
+	return newTestServiceWithHosts(t, r, "loopback")
+}
+
+func newTestServiceWithHosts(t *testing.T, r *receiver, allowedHosts string) (*Service, store.Store, string) {
+	t.Helper()
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	client, err := utils.NewWebhookClient(key, allowedHosts)
+	require.NoError(t, err)
+
+	s := datastore.NewTestStore(t)
+	return New(s, client, "https://ci.example.com"), s, ts.URL
+}
+
+func deliveries(t *testing.T, s store.Store, webhook *model.Webhook) []*model.WebhookDelivery {
+	t.Helper()
+	deliveries, err := s.WebhookDeliveryList(webhook, &model.ListOptions{Page: 1, PerPage: 50})
+	require.NoError(t, err)
+	return deliveries
+}
+
+func TestPipelineEvent(t *testing.T) {
+	r := &receiver{status: http.StatusOK}
+	service, s, url := newTestService(t, r)
+
+	finished := []model.WebhookTrigger{model.WebhookTriggerPipelineFinished}
+	global := &model.Webhook{Name: "global", URL: url, Events: finished, Enabled: true}
+	org := &model.Webhook{OrgID: 1, Name: "org", URL: url, Events: []model.WebhookTrigger{model.WebhookTriggerPipelineCreated}, Enabled: true}
+	otherRepo := &model.Webhook{RepoID: 2, Name: "other repo", URL: url, Events: finished, Enabled: true}
+	disabled := &model.Webhook{RepoID: 1, Name: "disabled", URL: url, Events: finished}
+	for _, webhook := range []*model.Webhook{global, org, otherRepo, disabled} {
+		require.NoError(t, s.WebhookCreate(webhook))
+	}
+
+	repo := &model.Repo{ID: 1, OrgID: 1, FullName: "org/repo"}
+	pipeline := &model.Pipeline{ID: 5, Number: 3, Status: model.StatusFailure}
+	service.PipelineEvent(model.WebhookTriggerPipelineFinished, repo, pipeline, nil)
+
+	assert.Len(t, deliveries(t, s, global), 1)
+	for _, webhook := range []*model.Webhook{org, otherRepo, disabled} {
+		assert.Empty(t, deliveries(t, s, webhook))
+	}
+
+	service.sendDue(t.Context())
+
+	require.Len(t, r.payloads, 1)
+	assert.True(t, r.signed)
+	payload := r.payloads[0]
+	assert.Equal(t, model.WebhookTriggerPipelineFinished, payload.Event)
+	assert.Equal(t, "org/repo", payload.Repo.FullName)
+	assert.Equal(t, model.StatusFailure, payload.Pipeline.Status)
+	assert.Nil(t, payload.Workflow)
+	assert.Equal(t, "https://ci.example.com/repos/1/pipeline/3", payload.URL)
+
+	delivery := deliveries(t, s, global)[0]
+	assert.Equal(t, model.WebhookDeliverySuccess, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+	assert.Zero(t, delivery.NextAttempt)
+
+	t.Run("workflow events link to the workflow", func(t *testing.T) {
+		global.Events = []model.WebhookTrigger{model.WebhookTriggerWorkflowFinished}
+		require.NoError(t, s.WebhookUpdate(global))
+		service.PipelineEvent(model.WebhookTriggerWorkflowFinished, repo, pipeline, &model.Workflow{PID: 2, Name: "build", State: model.StatusFailure})
+		service.sendDue(t.Context())
+
+		require.Len(t, r.payloads, 2)
+		assert.Equal(t, "build", r.payloads[1].Workflow.Name)
+		assert.Equal(t, "https://ci.example.com/repos/1/pipeline/3/2", r.payloads[1].URL)
+	})
+
+	t.Run("nil service sends nothing", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			(*Service)(nil).PipelineEvent(model.WebhookTriggerPipelineFinished, repo, pipeline, nil)
+		})
+	})
+}
+
+func TestRetries(t *testing.T) {
+	r := &receiver{status: http.StatusBadRequest}
+	service, s, url := newTestService(t, r)
+
+	webhook := &model.Webhook{Name: "hook", URL: url, Events: []model.WebhookTrigger{model.WebhookTriggerApprovalRequired}, Enabled: true}
+	require.NoError(t, s.WebhookCreate(webhook))
+	service.PipelineEvent(model.WebhookTriggerApprovalRequired, &model.Repo{ID: 1, OrgID: 1}, &model.Pipeline{Number: 1}, nil)
+
+	before := time.Now()
+	service.sendDue(t.Context())
+
+	delivery := deliveries(t, s, webhook)[0]
+	assert.Equal(t, model.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusBadRequest, delivery.ResponseCode)
+	assert.Equal(t, "unexpected response status 400", delivery.Error)
+	assert.GreaterOrEqual(t, delivery.NextAttempt, before.Add(time.Minute).Unix())
+
+	// not due yet
+	service.sendDue(t.Context())
+	assert.Len(t, r.payloads, 1)
+
+	// the last attempt fails the delivery
+	delivery.Attempts = maxAttempts - 1
+	delivery.NextAttempt = before.Unix()
+	require.NoError(t, s.WebhookDeliveryUpdate(delivery))
+	service.sendDue(t.Context())
+
+	delivery = deliveries(t, s, webhook)[0]
+	assert.Equal(t, model.WebhookDeliveryFailure, delivery.Status)
+	assert.Equal(t, maxAttempts, delivery.Attempts)
+	assert.Zero(t, delivery.NextAttempt)
+
+	t.Run("redelivery sends the payload again", func(t *testing.T) {
+		r.status = http.StatusNoContent
+		redelivery, err := service.Redeliver(delivery)
+		require.NoError(t, err)
+		assert.NotEqual(t, delivery.ID, redelivery.ID)
+		service.sendDue(t.Context())
+
+		require.Len(t, r.payloads, 3)
+		assert.Equal(t, r.payloads[0], r.payloads[2])
+		all := deliveries(t, s, webhook)
+		require.Len(t, all, 2)
+		assert.Equal(t, model.WebhookDeliverySuccess, all[0].Status)
+		assert.Equal(t, model.WebhookDeliveryFailure, all[1].Status)
+	})
+}
+
+func TestInternalHostsRefused(t *testing.T) {
+	r := &receiver{status: http.StatusOK}
+	// the default only allows external hosts
+	service, s, url := newTestServiceWithHosts(t, r, "")
+
+	webhook := &model.Webhook{Name: "hook", URL: url, Events: []model.WebhookTrigger{model.WebhookTriggerApprovalRequired}, Enabled: true}
+	require.NoError(t, s.WebhookCreate(webhook))
+	service.PipelineEvent(model.WebhookTriggerApprovalRequired, &model.Repo{ID: 1, OrgID: 1}, &model.Pipeline{Number: 1}, nil)
+	service.sendDue(t.Context())
+
+	assert.Empty(t, r.payloads)
+	delivery := deliveries(t, s, webhook)[0]
+	assert.Equal(t, model.WebhookDeliveryPending, delivery.Status)
+	assert.Zero(t, delivery.ResponseCode)
+	assert.Equal(t, "host is not allowed", delivery.Error)
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, retryDelay(1))
+	assert.Equal(t, 4*time.Minute, retryDelay(2))
+	assert.Equal(t, 64*time.Minute, retryDelay(4))
+}
+
+func TestCheckAgents(t *testing.T) {
+	r := &receiver{status: http.StatusOK}
+	service, s, url := newTestService(t, r)
+
+	offline := []model.WebhookTrigger{model.WebhookTriggerAgentOffline}
+	global := &model.Webhook{Name: "global", URL: url, Events: offline, Enabled: true}
+	org := &model.Webhook{OrgID: 1, Name: "org", URL: url, Events: offline, Enabled: true}
+	require.NoError(t, s.WebhookCreate(global))
+	require.NoError(t, s.WebhookCreate(org))
+
+	now := time.Now()
+	gone := &model.Agent{Name: "gone", Token: "gone-token", OrgID: model.IDNotSet, LastContact: now.Add(-time.Hour).Unix()}
+	orgAgent := &model.Agent{Name: "org", Token: "org-token", OrgID: 1, LastContact: now.Unix()}
+	require.NoError(t, s.AgentCreate(gone))
+	require.NoError(t, s.AgentCreate(orgAgent))
+
+	// agents offline before the first check are not reported
+	service.checkAgents(now)
+	assert.Empty(t, deliveries(t, s, global))
+
+	// another server sharing the store does not report them again
+	other := New(s, service.client, service.host)
+	other.started = service.started
+	service.checkAgents(now.Add(2 * time.Minute))
+	other.checkAgents(now.Add(2 * time.Minute))
+	require.Len(t, deliveries(t, s, global), 1)
+	require.Len(t, deliveries(t, s, org), 1)
+	service.sendDue(t.Context())
+	require.Len(t, r.payloads, 2)
+	assert.Equal(t, model.WebhookTriggerAgentOffline, r.payloads[0].Event)
+	assert.Equal(t, "org", r.payloads[0].Agent.Name)
+	assert.Empty(t, r.payloads[0].Agent.Token)
+
+	// only reported again after it was back online
+	service.checkAgents(now.Add(3 * time.Minute))
+	assert.Len(t, deliveries(t, s, global), 1)
+	orgAgent.LastContact = now.Add(4 * time.Minute).Unix()
+	require.NoError(t, s.AgentUpdate(orgAgent))
+	service.checkAgents(now.Add(4 * time.Minute))
+	service.checkAgents(now.Add(6 * time.Minute))
+	assert.Len(t, deliveries(t, s, global), 2)
+}
@@ -46,10 +46,26 @@ func (s storage) AgentCreate(agent *model.Agent) error {
 }
 
 func (s storage) AgentUpdate(agent *model.Agent) error {
-	_, err := s.engine.ID(agent.ID).AllCols().Update(agent)
+	// the offline report is only changed by AgentClaimOffline
+	_, err := s.engine.ID(agent.ID).AllCols().Omit("offline_reported").Update(agent)
 	return err
 }
 
+func (s storage) AgentClaimOffline(agent *model.Agent) (bool, error) {
+	count, err := s.engine.
+		Where("id = ? AND last_contact = ? AND offline_reported <> ?", agent.ID, agent.LastContact, agent.LastContact).
+		Cols("offline_reported").
+		NoAutoTime().
+		Update(&model.Agent{OfflineReported: agent.LastContact})
+	if err != nil {
+		return false, err
+	}
+	if count == 1 {
+		agent.OfflineReported = agent.LastContact
+	}
+	return count == 1, nil
+}
+
 func (s storage) AgentDelete(agent *model.Agent) error {
 	return wrapDelete(s.engine.ID(agent.ID).Delete(new(model.Agent)))
 }
@@ -105,6 +105,46 @@ func TestAgentUpdate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAgentClaimOffline(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Agent))
+	defer closer()
+
+	agent := &model.Agent{
+		Name:        "test",
+		Token:       "secret-token",
+		LastContact: 100,
+	}
+	assert.NoError(t, store.AgentCreate(agent))
+
+	// an agent is only claimed once per contact
+	claimed, err := store.AgentClaimOffline(agent)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+	assert.EqualValues(t, 100, agent.OfflineReported)
+	claimed, err = store.AgentClaimOffline(&model.Agent{ID: agent.ID, LastContact: 100})
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	// updates keep the report
+	assert.NoError(t, store.AgentUpdate(&model.Agent{ID: agent.ID, Name: "test", Token: "secret-token", LastContact: 100}))
+	_agent, err := store.AgentFind(agent.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 100, _agent.OfflineReported)
+	claimed, err = store.AgentClaimOffline(_agent)
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	// a stale agent can't be claimed after a new contact
+	agent.LastContact = 200
+	assert.NoError(t, store.AgentUpdate(agent))
+	claimed, err = store.AgentClaimOffline(_agent)
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+	claimed, err = store.AgentClaimOffline(agent)
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+}
+
 func TestAgentListForOrg(t *testing.T) {
 	store, closer := newTestStore(t, new(model.Agent))
 	defer closer()
@@ -0,0 +1,55 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) ArtifactCreate(artifact *model.Artifact) error {
+	// only Insert set auto created ID back to object
+	_, err := s.engine.Insert(artifact)
+	return err
+}
+
+func (s storage) ArtifactFind(pipeline *model.Pipeline, id int64) (*model.Artifact, error) {
+	artifact := new(model.Artifact)
+	return artifact, wrapGet(s.engine.ID(id).Where("pipeline_id = ?", pipeline.ID).Get(artifact))
+}
+
+func (s storage) ArtifactList(pipeline *model.Pipeline) ([]*model.Artifact, error) {
+	artifacts := make([]*model.Artifact, 0)
+	return artifacts, s.engine.
+		Where("pipeline_id = ?", pipeline.ID).
+		OrderBy("workflow, name, id").
+		Find(&artifacts)
+}
+
+// ArtifactListExpired returns a limited number of artifacts that expired
+// before the provided unix timestamp.
+func (s storage) ArtifactListExpired(before, limit int64) ([]*model.Artifact, error) {
+	artifacts := make([]*model.Artifact, 0, limit)
+	return artifacts, s.engine.
+		Where(builder.Gt{"expires": 0}.And(builder.Lt{"expires": before})).
+		OrderBy("expires").
+		Limit(int(limit)).
+		Find(&artifacts)
+}
+
+func (s storage) ArtifactDelete(artifact *model.Artifact) error {
+	return wrapDelete(s.engine.ID(artifact.ID).Delete(new(model.Artifact)))
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestArtifacts(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Artifact))
+	defer closer()
+
+	dist := &model.Artifact{PipelineID: 1, WorkflowID: 1, Workflow: "build", Name: "dist", Size: 3, Expires: 100}
+	docs := &model.Artifact{PipelineID: 1, WorkflowID: 1, Workflow: "build", Name: "docs", Size: 5}
+	other := &model.Artifact{PipelineID: 2, WorkflowID: 2, Workflow: "build", Name: "dist", Expires: 50}
+	for _, artifact := range []*model.Artifact{docs, dist, other} {
+		require.NoError(t, store.ArtifactCreate(artifact))
+		assert.NotZero(t, artifact.ID)
+	}
+
+	artifacts, err := store.ArtifactList(&model.Pipeline{ID: 1})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "dist", artifacts[0].Name)
+	assert.Equal(t, "docs", artifacts[1].Name)
+
+	artifact, err := store.ArtifactFind(&model.Pipeline{ID: 1}, dist.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), artifact.Size)
+	_, err = store.ArtifactFind(&model.Pipeline{ID: 1}, other.ID)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	// artifacts without expiry are never listed as expired
+	expired, err := store.ArtifactListExpired(200, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 2)
+	assert.Equal(t, other.ID, expired[0].ID)
+	assert.Equal(t, dist.ID, expired[1].ID)
+	expired, err = store.ArtifactListExpired(100, 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+
+	require.NoError(t, store.ArtifactDelete(other))
+	assert.ErrorIs(t, store.ArtifactDelete(other), types.ErrRecordNotExist)
+	expired, err = store.ArtifactListExpired(200, 10)
+	require.NoError(t, err)
+	assert.Len(t, expired, 1)
+}
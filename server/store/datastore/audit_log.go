@@ -0,0 +1,59 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) AuditLogCreate(entry *model.AuditLog) error {
+	// only Insert set auto created ID back to object
+	return wrapInsert(s.engine.Insert(entry))
+}
+
+func (s storage) AuditLogList(f *model.AuditLogFilter, p *model.ListOptions) ([]*model.AuditLog, error) {
+	entries := make([]*model.AuditLog, 0, p.PerPage)
+
+	cond := builder.NewCond()
+	if f != nil {
+		if f.OrgID != 0 {
+			cond = cond.And(builder.Eq{"org_id": f.OrgID})
+		}
+		if f.RepoID != 0 {
+			cond = cond.And(builder.Eq{"repo_id": f.RepoID})
+		}
+		if f.ActorLogin != "" {
+			cond = cond.And(builder.Eq{"actor_login": f.ActorLogin})
+		}
+		if f.Action != "" {
+			cond = cond.And(builder.Eq{"action": f.Action})
+		}
+		if f.TargetType != "" {
+			cond = cond.And(builder.Eq{"target_type": f.TargetType})
+		}
+		if f.After != 0 {
+			cond = cond.And(builder.Gt{"created": f.After})
+		}
+		if f.Before != 0 {
+			cond = cond.And(builder.Lt{"created": f.Before})
+		}
+	}
+
+	return entries, s.paginate(&model.ListOptionsWithAll{ListOptions: p}).Where(cond).
+		Desc("id").
+		Find(&entries)
+}
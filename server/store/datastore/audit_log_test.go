@@ -0,0 +1,97 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestAuditLogs(t *testing.T) {
+	store, closer := newTestStore(t, new(model.AuditLog))
+	defer closer()
+
+	entries := []*model.AuditLog{
+		{
+			ActorID:     1,
+			ActorLogin:  "alice",
+			Action:      model.AuditSecretUpdate,
+			AuditTarget: model.AuditTarget{Type: model.AuditTargetSecret, ID: 3, Name: "token", OrgID: 10, RepoID: 20},
+			OldValue:    map[string]any{"name": "token", "value": "********"},
+			NewValue:    map[string]any{"name": "token", "value": "********"},
+			ClientIP:    "127.0.0.1",
+		},
+		{
+			ActorID:     2,
+			ActorLogin:  "bob",
+			Action:      model.AuditRepoUpdate,
+			AuditTarget: model.AuditTarget{Type: model.AuditTargetRepo, ID: 20, Name: "org/repo", OrgID: 10, RepoID: 20},
+		},
+		{
+			ActorID:     1,
+			ActorLogin:  "alice",
+			Action:      model.AuditForgeDelete,
+			AuditTarget: model.AuditTarget{Type: model.AuditTargetForge, ID: 2, Name: "https://git.example.com"},
+		},
+	}
+	for _, entry := range entries {
+		require.NoError(t, store.AuditLogCreate(entry))
+		assert.NotZero(t, entry.ID)
+		assert.NotZero(t, entry.Created)
+	}
+
+	page := &model.ListOptions{Page: 1, PerPage: 50}
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name     string
+		filter   *model.AuditLogFilter
+		page     *model.ListOptions
+		expected []int64
+	}{
+		{name: "all newest first", filter: &model.AuditLogFilter{}, page: page, expected: []int64{3, 2, 1}},
+		{name: "org", filter: &model.AuditLogFilter{OrgID: 10}, page: page, expected: []int64{2, 1}},
+		{name: "repo and target type", filter: &model.AuditLogFilter{RepoID: 20, TargetType: model.AuditTargetSecret}, page: page, expected: []int64{1}},
+		{name: "actor", filter: &model.AuditLogFilter{ActorLogin: "alice"}, page: page, expected: []int64{3, 1}},
+		{name: "action", filter: &model.AuditLogFilter{Action: model.AuditRepoUpdate}, page: page, expected: []int64{2}},
+		{name: "after", filter: &model.AuditLogFilter{After: now - 3600}, page: page, expected: []int64{3, 2, 1}},
+		{name: "before", filter: &model.AuditLogFilter{Before: now - 3600}, page: page, expected: []int64{}},
+		{name: "paginated", filter: &model.AuditLogFilter{}, page: &model.ListOptions{Page: 2, PerPage: 2}, expected: []int64{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := store.AuditLogList(tt.filter, tt.page)
+			require.NoError(t, err)
+			ids := make([]int64, 0, len(list))
+			for _, entry := range list {
+				ids = append(ids, entry.ID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+
+	list, err := store.AuditLogList(&model.AuditLogFilter{RepoID: 20, TargetType: model.AuditTargetSecret}, page)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, entries[0].AuditTarget, list[0].AuditTarget)
+	assert.Equal(t, entries[0].NewValue, list[0].NewValue)
+	assert.Equal(t, "127.0.0.1", list[0].ClientIP)
+}
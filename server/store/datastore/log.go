@@ -15,12 +15,17 @@
 package datastore
 
 import (
+	"bytes"
 	"errors"
+	"strings"
 
 	"github.com/rs/zerolog/log"
+	"xorm.io/builder"
 	"xorm.io/xorm"
+	"xorm.io/xorm/schemas"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	service_log "go.woodpecker-ci.org/woodpecker/v3/server/services/log"
 )
 
 // Maximum number of records to store in one PostgreSQL statement.
@@ -28,10 +33,31 @@ import (
 const pgBatchSize = 1000
 
 // LogFind returns the log entries of a step in the order the agent produced
-// them, which is the order their line numbers carry.
+// them, which is the order their line numbers carry. Compressed entries of a
+// finished step come first, followed by entries written afterwards.
 func (s storage) LogFind(step *model.Step) ([]*model.LogEntry, error) {
-	var logEntries []*model.LogEntry
-	return logEntries, s.engine.Asc("line").Where("step_id = ?", step.ID).Find(&logEntries)
+	sess := s.engine.NewSession()
+	defer sess.Close()
+
+	logEntries, err := logArchiveFind(sess, step.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var uncompressed []*model.LogEntry
+	if err := sess.Asc("line").Where("step_id = ?", step.ID).Find(&uncompressed); err != nil {
+		return nil, err
+	}
+	return append(logEntries, uncompressed...), nil
+}
+
+func logArchiveFind(sess *xorm.Session, stepID int64) ([]*model.LogEntry, error) {
+	archive := new(model.LogArchive)
+	has, err := sess.Where("step_id = ?", stepID).Get(archive)
+	if err != nil || !has {
+		return nil, err
+	}
+	return service_log.DecodeEntries(bytes.NewReader(archive.Data))
 }
 
 func (s storage) LogAppend(_ *model.Step, logEntries []*model.LogEntry) error {
@@ -58,8 +84,153 @@ func (s storage) LogDelete(step *model.Step) error {
 }
 
 func logDelete(sess *xorm.Session, stepID int64) error {
+	if _, err := sess.Where("step_id = ?", stepID).Delete(new(model.LogArchive)); err != nil {
+		return err
+	}
+	if _, err := sess.Where("step_id = ?", stepID).Delete(new(model.LogSearchIndex)); err != nil {
+		return err
+	}
 	_, err := sess.Where("step_id = ?", stepID).Delete(new(model.LogEntry))
 	return err
 }
 
-func (s storage) StepFinished(_ *model.Step) {}
+func (s storage) StepFinished(step *model.Step) {
+	if err := s.LogCompress(step); err != nil {
+		log.Error().Err(err).Msgf("could not compress logs of step %d", step.ID)
+	}
+	if err := s.LogIndex(step); err != nil {
+		log.Error().Err(err).Msgf("could not index logs of step %d", step.ID)
+	}
+}
+
+// LogCompress moves the log entries of a step into its archive.
+func (s storage) LogCompress(step *model.Step) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	var uncompressed []*model.LogEntry
+	if err := sess.Asc("line").Where("step_id = ?", step.ID).Find(&uncompressed); err != nil {
+		return err
+	}
+	if len(uncompressed) == 0 {
+		return nil
+	}
+
+	logEntries, err := logArchiveFind(sess, step.ID)
+	if err != nil {
+		return err
+	}
+	data, err := service_log.EncodeEntries(append(logEntries, uncompressed...))
+	if err != nil {
+		return err
+	}
+
+	if _, err := sess.Where("step_id = ?", step.ID).Delete(new(model.LogArchive)); err != nil {
+		return err
+	}
+	if _, err := sess.Insert(&model.LogArchive{StepID: step.ID, Data: data}); err != nil {
+		return err
+	}
+	if _, err := sess.Where("step_id = ?", step.ID).Delete(new(model.LogEntry)); err != nil {
+		return err
+	}
+
+	return sess.Commit()
+}
+
+// LogAttemptCreate stores the log entries a step wrote during the given
+// attempt of its workflow.
+func (s storage) LogAttemptCreate(step *model.Step, attempt int, logEntries []*model.LogEntry) error {
+	data, err := service_log.EncodeEntries(logEntries)
+	if err != nil {
+		return err
+	}
+	return wrapInsert(s.engine.Insert(&model.LogAttemptArchive{StepID: step.ID, Attempt: attempt, Data: data}))
+}
+
+// LogAttemptFind returns the log entries a step wrote during the given
+// attempt of its workflow.
+func (s storage) LogAttemptFind(step *model.Step, attempt int) ([]*model.LogEntry, error) {
+	archive := new(model.LogAttemptArchive)
+	if err := wrapGet(s.engine.Where("step_id = ? AND attempt = ?", step.ID, attempt).Get(archive)); err != nil {
+		return nil, err
+	}
+	return service_log.DecodeEntries(bytes.NewReader(archive.Data))
+}
+
+func (s storage) LogAttemptDelete(step *model.Step) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	return logAttemptDelete(sess, step.ID)
+}
+
+func logAttemptDelete(sess *xorm.Session, stepID int64) error {
+	_, err := sess.Where("step_id = ?", stepID).Delete(new(model.LogAttemptArchive))
+	return err
+}
+
+// LogIndex stores the words of the logs of a step in the log_search table,
+// which the database indexes for full text search.
+func (s storage) LogIndex(step *model.Step) error {
+	logEntries, err := s.LogFind(step)
+	if err != nil {
+		return err
+	}
+	content, complete := service_log.IndexWords(logEntries)
+
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.Where("step_id = ?", step.ID).Delete(new(model.LogSearchIndex)); err != nil {
+		return err
+	}
+	if content != "" {
+		if _, err := sess.Insert(&model.LogSearchIndex{StepID: step.ID, Content: content, Truncated: !complete}); err != nil {
+			return err
+		}
+	}
+
+	return sess.Commit()
+}
+
+// LogSearch returns the ids of the steps with indexed words starting with all
+// terms, along with the steps whose words were truncated. SQLite looks them up
+// in its full text table and Postgres in the tsvector of the words, other
+// databases fall back to scanning the words.
+func (s storage) LogSearch(stepIDs []int64, terms []string) ([]int64, error) {
+	found := make([]int64, 0, len(stepIDs))
+	if len(stepIDs) == 0 {
+		return found, nil
+	}
+
+	var match builder.Cond
+	switch s.engine.Dialect().URI().DBType {
+	case schemas.SQLITE:
+		query := strings.Join(terms, "* ") + "*"
+		match = builder.Expr("step_id IN (SELECT rowid FROM log_search_fts WHERE log_search_fts MATCH ?)", query)
+	case schemas.POSTGRES:
+		query := strings.Join(terms, ":* & ") + ":*"
+		match = builder.Expr("to_tsvector('simple', content) @@ to_tsquery('simple', ?)", query)
+	default:
+		// words are separated by single spaces, so a word starts either the
+		// content or after a space
+		match = builder.NewCond()
+		for _, term := range terms {
+			match = match.And(builder.Or(
+				builder.Like{"content", term + "%"},
+				builder.Like{"content", "% " + term + "%"},
+			))
+		}
+	}
+
+	return found, s.engine.Table(new(model.LogSearchIndex)).Cols("step_id").
+		Where(builder.In("step_id", stepIDs)).
+		And(builder.Or(builder.Eq{"truncated": true}, match)).
+		Find(&found)
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build test
+
+package datastore
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestLogSearch(t *testing.T) {
+	// the full text index is created on migration
+	store, ok := NewTestStore(t).(*storage)
+	require.True(t, ok)
+
+	for id, data := range map[int64]string{
+		1: "dial tcp 10.0.0.1:5432: connection refused",
+		2: "connection established",
+		3: "Connection REFUSED again",
+	} {
+		step := &model.Step{ID: id}
+		require.NoError(t, store.LogAppend(step, []*model.LogEntry{{StepID: id, Data: []byte(data)}}))
+		store.StepFinished(step)
+	}
+
+	found, err := store.LogSearch([]int64{1, 2, 3}, []string{"connection", "refus"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3}, found)
+
+	found, err = store.LogSearch([]int64{1, 2}, []string{"5432"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, found)
+
+	// words are matched from their start
+	found, err = store.LogSearch([]int64{1, 2, 3}, []string{"efused"})
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	// steps with too many words to index are always found
+	var many strings.Builder
+	for i := range 50000 {
+		fmt.Fprintf(&many, "word%d ", i)
+	}
+	require.NoError(t, store.LogAppend(&model.Step{ID: 4}, []*model.LogEntry{{StepID: 4, Data: []byte(many.String())}}))
+	store.StepFinished(&model.Step{ID: 4})
+	found, err = store.LogSearch([]int64{1, 2, 3, 4}, []string{"connection", "refus"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3, 4}, found)
+
+	// re-indexing replaces the words of a step
+	require.NoError(t, store.LogAppend(&model.Step{ID: 2}, []*model.LogEntry{{StepID: 2, Line: 1, Data: []byte("refused")}}))
+	store.StepFinished(&model.Step{ID: 2})
+	found, err = store.LogSearch([]int64{1, 2, 3}, []string{"refused"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, found)
+
+	assert.NoError(t, store.LogDelete(&model.Step{ID: 1}))
+	found, err = store.LogSearch([]int64{1, 2, 3}, []string{"refused"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{2, 3}, found)
+}
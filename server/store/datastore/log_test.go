@@ -20,10 +20,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
 func TestLogCreateFindDelete(t *testing.T) {
-	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry))
+	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogSearchIndex))
 	defer closer()
 
 	step := model.Step{
@@ -60,7 +61,7 @@ func TestLogCreateFindDelete(t *testing.T) {
 }
 
 func TestLogAppend(t *testing.T) {
-	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry))
+	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogSearchIndex))
 	defer closer()
 
 	step := model.Step{
@@ -98,7 +99,7 @@ func TestLogAppend(t *testing.T) {
 }
 
 func TestLogFindOrdersByLine(t *testing.T) {
-	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry))
+	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogSearchIndex))
 	defer closer()
 
 	step := model.Step{
@@ -131,7 +132,7 @@ func TestLogFindOrdersByLine(t *testing.T) {
 }
 
 func TestLogAppendRejectsResentEntries(t *testing.T) {
-	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry))
+	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogSearchIndex))
 	defer closer()
 
 	step := model.Step{
@@ -161,3 +162,80 @@ func TestLogAppendRejectsResentEntries(t *testing.T) {
 	assert.Equal(t, []int{0, 1}, lines)
 	assert.Equal(t, []string{"hello", "world"}, data)
 }
+
+func TestLogCompress(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogSearchIndex))
+	defer closer()
+
+	step := model.Step{
+		ID: 1,
+	}
+
+	assert.NoError(t, store.LogAppend(&step, []*model.LogEntry{
+		{StepID: step.ID, Data: []byte("hello"), Line: 0, Time: 0},
+		{StepID: step.ID, Data: []byte("world"), Line: 1, Time: 10},
+	}))
+	store.StepFinished(&step)
+
+	count, err := store.engine.Count(new(model.LogEntry))
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+
+	logEntries, err := store.LogFind(&step)
+	assert.NoError(t, err)
+	if assert.Len(t, logEntries, 2) {
+		assert.Equal(t, "world", string(logEntries[1].Data))
+		assert.Equal(t, int64(10), logEntries[1].Time)
+	}
+
+	// entries written afterwards, e.g. by another attempt, follow the archived ones
+	assert.NoError(t, store.LogAppend(&step, []*model.LogEntry{
+		{StepID: step.ID, Data: []byte("again"), Line: 0, Time: 20},
+	}))
+	logEntries, err = store.LogFind(&step)
+	assert.NoError(t, err)
+	assert.Len(t, logEntries, 3)
+
+	assert.NoError(t, store.LogCompress(&step))
+	logEntries, err = store.LogFind(&step)
+	assert.NoError(t, err)
+	if assert.Len(t, logEntries, 3) {
+		assert.Equal(t, "again", string(logEntries[2].Data))
+	}
+
+	// nothing left to compress
+	assert.NoError(t, store.LogCompress(&step))
+
+	assert.NoError(t, store.LogDelete(&step))
+	logEntries, err = store.LogFind(&step)
+	assert.NoError(t, err)
+	assert.Empty(t, logEntries)
+}
+
+func TestLogAttempt(t *testing.T) {
+	store, closer := newTestStore(t, new(model.LogAttemptArchive))
+	defer closer()
+
+	step := &model.Step{ID: 1}
+	assert.NoError(t, store.LogAttemptCreate(step, 1, []*model.LogEntry{
+		{StepID: step.ID, Data: []byte("first"), Line: 0},
+	}))
+	assert.NoError(t, store.LogAttemptCreate(step, 2, []*model.LogEntry{
+		{StepID: step.ID, Data: []byte("second"), Line: 0},
+		{StepID: step.ID, Data: []byte("attempt"), Line: 1},
+	}))
+	assert.ErrorIs(t, store.LogAttemptCreate(step, 2, nil), types.ErrInsertDuplicateDetected)
+
+	logEntries, err := store.LogAttemptFind(step, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, logEntries, 2) {
+		assert.Equal(t, "attempt", string(logEntries[1].Data))
+	}
+
+	_, err = store.LogAttemptFind(step, 3)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	assert.NoError(t, store.LogAttemptDelete(step))
+	_, err = store.LogAttemptFind(step, 1)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"strings"
+
+	"xorm.io/xorm"
+	"xorm.io/xorm/schemas"
+)
+
+// syncLogSearch creates the full text index on the log_search table where the
+// database supports one. SQLite indexes it with an FTS5 table kept up to date
+// by triggers, Postgres with a GIN index on its tsvector. Other databases
+// search the table without an index.
+func syncLogSearch(e *xorm.Engine) error {
+	var statements []string
+	switch e.Dialect().URI().DBType {
+	case schemas.SQLITE:
+		exists, err := e.IsTableExist("log_search_fts")
+		if err != nil || exists {
+			return err
+		}
+		statements, err = sqliteLogSearchStatements(e)
+		if err != nil {
+			return err
+		}
+	case schemas.POSTGRES:
+		statements = []string{
+			`CREATE INDEX IF NOT EXISTS log_search_content_fts ON log_search USING GIN (to_tsvector('simple', content))`,
+		}
+	}
+
+	for _, statement := range statements {
+		if _, err := e.Exec(statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sqliteLogSearchStatements returns the statements creating the full text
+// table of SQLite and its triggers. The go-sqlite3 driver only includes FTS5 if
+// built with the sqlite_fts5 tag, as release builds are, so FTS4 is used if it
+// is missing. Both are queried the same way.
+func sqliteLogSearchStatements(e *xorm.Engine) ([]string, error) {
+	_, err := e.Exec(`CREATE VIRTUAL TABLE log_search_fts USING fts5(content, content="log_search", content_rowid="step_id")`)
+	if err == nil {
+		return []string{
+			`CREATE TRIGGER IF NOT EXISTS log_search_ai AFTER INSERT ON log_search BEGIN
+				INSERT INTO log_search_fts(rowid, content) VALUES (new.step_id, new.content);
+			END`,
+			`CREATE TRIGGER IF NOT EXISTS log_search_ad AFTER DELETE ON log_search BEGIN
+				INSERT INTO log_search_fts(log_search_fts, rowid, content) VALUES ('delete', old.step_id, old.content);
+			END`,
+			`CREATE TRIGGER IF NOT EXISTS log_search_au AFTER UPDATE ON log_search BEGIN
+				INSERT INTO log_search_fts(log_search_fts, rowid, content) VALUES ('delete', old.step_id, old.content);
+				INSERT INTO log_search_fts(rowid, content) VALUES (new.step_id, new.content);
+			END`,
+		}, nil
+	}
+	if !strings.Contains(err.Error(), "no such module") {
+		return nil, err
+	}
+
+	return []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS log_search_fts USING fts4(content="log_search", content)`,
+		`CREATE TRIGGER IF NOT EXISTS log_search_ai AFTER INSERT ON log_search BEGIN
+			INSERT INTO log_search_fts(docid, content) VALUES (new.step_id, new.content);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS log_search_bu BEFORE UPDATE ON log_search BEGIN
+			DELETE FROM log_search_fts WHERE docid = old.step_id;
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS log_search_au AFTER UPDATE ON log_search BEGIN
+			INSERT INTO log_search_fts(docid, content) VALUES (new.step_id, new.content);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS log_search_bd BEFORE DELETE ON log_search BEGIN
+			DELETE FROM log_search_fts WHERE docid = old.step_id;
+		END`,
+	}, nil
+}
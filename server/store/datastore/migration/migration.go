@@ -67,6 +67,9 @@ var allBeans = []any{
 	new(model.PipelineConfig),
 	new(model.Config),
 	new(model.LogEntry),
+	new(model.LogArchive),
+	new(model.LogAttemptArchive),
+	new(model.LogSearchIndex),
 	new(model.Perm),
 	new(model.Step),
 	new(model.Registry),
@@ -79,7 +82,17 @@ var allBeans = []any{
 	new(model.Redirection),
 	new(model.Forge),
 	new(model.Workflow),
+	new(model.WorkflowAttempt),
+	new(model.Artifact),
+	new(model.TestResult),
+	new(model.RetentionPolicy),
+	new(model.PersonalAccessToken),
+	new(model.AuditLog),
+	new(model.Webhook),
+	new(model.WebhookDelivery),
+	new(model.RepoPoll),
 	new(model.Org),
+	new(model.PubSubMessage),
 }
 
 // TODO: make xormigrate context aware
@@ -123,6 +136,10 @@ func Migrate(_ context.Context, e *xorm.Engine, allowLong bool) error {
 		return fmt.Errorf("msg: %w", err)
 	}
 
+	if err := syncLogSearch(e); err != nil {
+		return fmt.Errorf("could not create log search index: %w", err)
+	}
+
 	return nil
 }
 
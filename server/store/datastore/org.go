@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"xorm.io/builder"
 	"xorm.io/xorm"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
@@ -58,6 +59,12 @@ func (s storage) orgDelete(sess *xorm.Session, id int64) error {
 	if _, err := sess.Where("org_id = ?", id).Delete(new(model.Secret)); err != nil {
 		return err
 	}
+	if _, err := sess.Where("org_id = ?", id).Delete(new(model.RetentionPolicy)); err != nil {
+		return err
+	}
+	if err := deleteWebhooks(sess, builder.Eq{"org_id": id}); err != nil {
+		return err
+	}
 
 	var repos []*model.Repo
 	if err := sess.Where("org_id = ?", id).Find(&repos); err != nil {
@@ -0,0 +1,73 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestPersonalAccessTokens(t *testing.T) {
+	store, closer := newTestStore(t, new(model.PersonalAccessToken), new(model.User), new(model.Org), new(model.Secret), new(model.Repo), new(model.Perm), new(model.RetentionPolicy), new(model.Webhook), new(model.WebhookDelivery), new(model.RepoPoll))
+	defer closer()
+
+	alice := &model.User{Login: "alice", ForgeRemoteID: "1", Hash: "A"}
+	bob := &model.User{Login: "bob", ForgeRemoteID: "2", Hash: "B"}
+	require.NoError(t, store.CreateUser(alice))
+	require.NoError(t, store.CreateUser(bob))
+	deploy := &model.PersonalAccessToken{UserID: alice.ID, Name: "deploy", Scopes: []model.TokenScope{model.TokenScopePipelineWrite}}
+	ci := &model.PersonalAccessToken{UserID: alice.ID, Name: "ci", Scopes: []model.TokenScope{model.TokenScopeRepoRead}}
+	for _, token := range []*model.PersonalAccessToken{deploy, ci, {UserID: bob.ID, Name: "deploy"}} {
+		require.NoError(t, store.PersonalAccessTokenCreate(token))
+		assert.NotZero(t, token.ID)
+	}
+
+	// names are unique per user
+	assert.ErrorIs(t, store.PersonalAccessTokenCreate(&model.PersonalAccessToken{UserID: alice.ID, Name: "ci"}), types.ErrInsertDuplicateDetected)
+
+	token, err := store.PersonalAccessTokenFind(alice, deploy.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.TokenScope{model.TokenScopePipelineWrite}, token.Scopes)
+	assert.NotZero(t, token.Created)
+	// tokens of other users are not found
+	_, err = store.PersonalAccessTokenFind(bob, deploy.ID)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	deploy.LastUsed = 100
+	require.NoError(t, store.PersonalAccessTokenUpdate(deploy))
+	token, err = store.PersonalAccessTokenFind(alice, deploy.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, token.LastUsed)
+
+	require.NoError(t, store.PersonalAccessTokenDelete(ci))
+	tokens, err := store.PersonalAccessTokenList(alice)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, deploy.ID, tokens[0].ID)
+
+	// the tokens of a user are removed with it
+	require.NoError(t, store.DeleteUser(alice))
+	tokens, err = store.PersonalAccessTokenList(alice)
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+	tokens, err = store.PersonalAccessTokenList(bob)
+	require.NoError(t, err)
+	assert.Len(t, tokens, 1)
+}
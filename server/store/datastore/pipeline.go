@@ -22,7 +22,9 @@ import (
 	"github.com/cenkalti/backoff/v7"
 	"xorm.io/builder"
 	"xorm.io/xorm"
+	"xorm.io/xorm/schemas"
 
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
@@ -86,6 +88,11 @@ func (s storage) GetPipelineList(repo *model.Repo, p *model.ListOptionsWithAll,
 			cond = cond.And(builder.Eq{"branch": f.Branch})
 		}
 
+		if f.Commit != "" {
+			// commit is a reserved word in SQL
+			cond = cond.And(builder.Eq{s.engine.Dialect().Quoter().Quote("commit"): f.Commit})
+		}
+
 		if f.Status != "" {
 			cond = cond.And(builder.Eq{"status": f.Status})
 		}
@@ -146,6 +153,18 @@ func (s storage) GetActivePipelineList(repo *model.Repo) ([]*model.Pipeline, err
 	return pipelines, query.Find(&pipelines)
 }
 
+// GetPipelineListLogsExpired returns a limited number of pipelines of a repo
+// that finished before the provided unix timestamp and still have logs.
+func (s storage) GetPipelineListLogsExpired(repo *model.Repo, before int64, offset, limit int) ([]*model.Pipeline, error) {
+	pipelines := make([]*model.Pipeline, 0, limit)
+	return pipelines, s.engine.
+		Where(builder.Eq{"repo_id": repo.ID, "logs_deleted": false}).
+		And(builder.Gt{"finished": 0}.And(builder.Lt{"finished": before})).
+		OrderBy("number").
+		Limit(limit, offset).
+		Find(&pipelines)
+}
+
 func (s storage) GetPipelineCount() (int64, error) {
 	return s.engine.Count(new(model.Pipeline))
 }
@@ -212,10 +231,42 @@ func (s storage) CreatePipeline(pipeline *model.Pipeline, stepList ...*model.Ste
 }
 
 func (s storage) UpdatePipeline(pipeline *model.Pipeline) error {
-	_, err := s.engine.ID(pipeline.ID).AllCols().Update(pipeline)
+	// errors are appended concurrently while the pipeline runs, so they
+	// are only written explicitly
+	_, err := s.engine.ID(pipeline.ID).AllCols().Omit("errors").Update(pipeline)
 	return err
 }
 
+func (s storage) UpdatePipelineErrors(pipeline *model.Pipeline) error {
+	_, err := s.engine.ID(pipeline.ID).Cols("errors").Update(pipeline)
+	return err
+}
+
+func (s storage) AppendPipelineErrors(pipelineID int64, errs []*pipeline_errors.PipelineError) (*model.Pipeline, error) {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return nil, err
+	}
+
+	// sqlite locks the whole database within a transaction, other databases
+	// need a row lock so errors appended concurrently do not get lost.
+	if s.engine.Dialect().URI().DBType != schemas.SQLITE {
+		sess = sess.ForUpdate()
+	}
+
+	pipeline := new(model.Pipeline)
+	if err := wrapGet(sess.ID(pipelineID).Get(pipeline)); err != nil {
+		return nil, err
+	}
+	pipeline.Errors = append(pipeline.Errors, errs...)
+	if _, err := sess.ID(pipelineID).Cols("errors").Update(pipeline); err != nil {
+		return nil, err
+	}
+
+	return pipeline, sess.Commit()
+}
+
 func (s storage) DeletePipeline(pipeline *model.Pipeline) error {
 	return s.deletePipeline(s.engine.NewSession(), pipeline.ID)
 }
@@ -16,12 +16,14 @@
 package datastore
 
 import (
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	pipeline_errors "go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
@@ -146,6 +148,7 @@ func TestPipelineListFilter(t *testing.T) {
 		Event:  model.EventPull,
 		Ref:    "refs/pull/32",
 		Branch: "main",
+		Commit: "3f7e5b2",
 	}
 	err := store.CreatePipeline(pipeline1, []*model.Step{}...)
 	assert.NoError(t, err)
@@ -189,6 +192,13 @@ func TestPipelineListFilter(t *testing.T) {
 	assert.Equal(t, pipeline1.ID, pipelines[0].ID)
 	assert.Equal(t, pipeline1.RepoID, pipelines[0].RepoID)
 
+	pipelines, err = store.GetPipelineList(&model.Repo{ID: 1}, nil, &model.PipelineFilter{
+		Commit: "3f7e5b2",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, pipelines, 1)
+	assert.Equal(t, pipeline2.ID, pipelines[0].ID)
+
 	pipelines, err = store.GetPipelineList(&model.Repo{ID: 1}, nil, &model.PipelineFilter{
 		Status: model.StatusSuccess,
 	})
@@ -244,9 +254,43 @@ func TestCreatePipelineDuplicateIsRetryable(t *testing.T) {
 	assert.ErrorIs(t, store.CreatePipeline(&model.Pipeline{ID: existing.ID, RepoID: 1}), types.ErrInsertDuplicateDetected)
 }
 
+func TestAppendPipelineErrors(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Repo), new(model.Step), new(model.Pipeline))
+	defer closer()
+
+	require.NoError(t, store.CreateRepo(&model.Repo{ID: 1, Owner: "1", Name: "1", FullName: "1/1", ForgeRemoteID: "1"}))
+	pipeline := &model.Pipeline{RepoID: 1, Errors: []*pipeline_errors.PipelineError{{Message: "linter"}}}
+	require.NoError(t, store.CreatePipeline(pipeline))
+
+	// appending does not overwrite the other columns
+	pipeline.Status = model.StatusRunning
+	require.NoError(t, store.UpdatePipeline(pipeline))
+
+	var wg sync.WaitGroup
+	for _, message := range []string{"build", "test"} {
+		wg.Go(func() {
+			_, err := store.AppendPipelineErrors(pipeline.ID, []*pipeline_errors.PipelineError{{Message: message, IsWarning: true}})
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+
+	got, err := store.GetPipeline(pipeline.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.StatusRunning, got.Status)
+	var messages []string
+	for _, pipelineErr := range got.Errors {
+		messages = append(messages, pipelineErr.Message)
+	}
+	assert.ElementsMatch(t, []string{"linter", "build", "test"}, messages)
+
+	_, err = store.AppendPipelineErrors(pipeline.ID+1, nil)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+}
+
 func TestDeletePipeline(t *testing.T) {
-	store, closer := newTestStore(t, new(model.Pipeline), new(model.Repo), new(model.Workflow),
-		new(model.Step), new(model.LogEntry), new(model.PipelineConfig), new(model.Config))
+	store, closer := newTestStore(t, new(model.Pipeline), new(model.Repo), new(model.Workflow), new(model.WorkflowAttempt),
+		new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogAttemptArchive), new(model.LogSearchIndex), new(model.PipelineConfig), new(model.Config))
 	defer closer()
 
 	err := wrapInsert(store.engine.Insert(
@@ -361,3 +405,32 @@ func TestGetPipelineLastBeforeUsesNumber(t *testing.T) {
 	assert.EqualValues(t, 2, previous.Number)
 	assert.EqualValues(t, model.StatusFailure, previous.Status)
 }
+
+func TestGetPipelineListLogsExpired(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Pipeline))
+	defer closer()
+
+	repo := &model.Repo{ID: 1}
+	for _, pipeline := range []*model.Pipeline{
+		{RepoID: 1, Number: 1, Finished: 100},
+		{RepoID: 1, Number: 2, Finished: 200, LogsDeleted: true},
+		{RepoID: 1, Number: 3, Finished: 300},
+		{RepoID: 1, Number: 4, Status: model.StatusRunning},
+		{RepoID: 1, Number: 5, Finished: 500},
+		{RepoID: 2, Number: 1, Finished: 100},
+	} {
+		_, err := store.engine.Insert(pipeline)
+		require.NoError(t, err)
+	}
+
+	pipelines, err := store.GetPipelineListLogsExpired(repo, 400, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, pipelines, 2)
+	assert.EqualValues(t, 1, pipelines[0].Number)
+	assert.EqualValues(t, 3, pipelines[1].Number)
+
+	pipelines, err = store.GetPipelineListLogsExpired(repo, 400, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, pipelines, 1)
+	assert.EqualValues(t, 3, pipelines[0].Number)
+}
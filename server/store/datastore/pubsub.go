@@ -0,0 +1,90 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"xorm.io/xorm/schemas"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// pubSubChannel is the postgres notification channel new messages are
+// announced on.
+const pubSubChannel = "woodpecker_pubsub"
+
+func (s storage) PubSubMessageCreate(message *model.PubSubMessage) error {
+	if err := wrapInsert(s.engine.Insert(message)); err != nil {
+		return err
+	}
+
+	if s.engine.Dialect().URI().DBType == schemas.POSTGRES {
+		if _, err := s.engine.Exec("NOTIFY " + pubSubChannel); err != nil {
+			// the listeners still poll for the message
+			log.Error().Err(err).Msg("could not notify about pubsub message")
+		}
+	}
+	return nil
+}
+
+func (s storage) PubSubMessageList(afterID int64) ([]*model.PubSubMessage, error) {
+	messages := make([]*model.PubSubMessage, 0, perPage)
+	return messages, s.engine.Where("id > ?", afterID).OrderBy("id").Find(&messages)
+}
+
+func (s storage) PubSubMessageDeleteBefore(created int64) error {
+	_, err := s.engine.Where("created < ?", created).Delete(new(model.PubSubMessage))
+	return err
+}
+
+func (s storage) PubSubListen(ctx context.Context) (<-chan struct{}, error) {
+	if s.engine.Dialect().URI().DBType != schemas.POSTGRES {
+		return nil, nil
+	}
+
+	listener := pq.NewListener(s.engine.DataSourceName(), time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error().Err(err).Msgf("pubsub listener event %d", event)
+		}
+	})
+	if err := listener.Listen(pubSubChannel); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	notify := make(chan struct{}, 1)
+	go func() {
+		defer listener.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			// a nil notification is sent after a reconnect, messages could
+			// have been missed so it is passed on as well
+			case <-listener.Notify:
+			}
+
+			select {
+			case notify <- struct{}{}:
+			default:
+			}
+		}
+	}()
+	return notify, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"xorm.io/xorm/schemas"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestPubSubMessages(t *testing.T) {
+	store, closer := newTestStore(t, new(model.PubSubMessage))
+	defer closer()
+
+	first := &model.PubSubMessage{MessageID: "a", Topics: []string{"repo.id.1", "public"}, Data: []byte("data")}
+	second := &model.PubSubMessage{MessageID: "b", Topics: []string{"repo.id.2"}}
+	assert.NoError(t, store.PubSubMessageCreate(first))
+	assert.NoError(t, store.PubSubMessageCreate(second))
+
+	messages, err := store.PubSubMessageList(0)
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 2) {
+		assert.Equal(t, "a", messages[0].MessageID)
+		assert.Equal(t, []string{"repo.id.1", "public"}, messages[0].Topics)
+		assert.Equal(t, []byte("data"), messages[0].Data)
+		assert.NotZero(t, messages[0].Created)
+	}
+
+	messages, err = store.PubSubMessageList(first.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, "b", messages[0].MessageID)
+	}
+
+	assert.NoError(t, store.PubSubMessageDeleteBefore(time.Now().Add(time.Minute).Unix()))
+	messages, err = store.PubSubMessageList(0)
+	assert.NoError(t, err)
+	assert.Empty(t, messages)
+
+	// notifications are only supported by postgres
+	notify, err := store.PubSubListen(t.Context())
+	assert.NoError(t, err)
+	if store.engine.Dialect().URI().DBType != schemas.POSTGRES {
+		assert.Nil(t, notify)
+	}
+}
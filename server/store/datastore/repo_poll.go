@@ -0,0 +1,67 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) RepoPollListDue(now, afterID int64, limit int) ([]*model.Repo, error) {
+	repos := make([]*model.Repo, 0, limit)
+	return repos, s.engine.Table("repos").Select("repos.*").
+		Join("LEFT", "repo_polls", "repo_polls.repo_id = repos.id").
+		Where(builder.Eq{"repos.active": true}.
+			And(builder.Gt{"repos.id": afterID}).
+			And(builder.Gt{"repos.poll_interval": 0}).
+			And(builder.IsNull{"repo_polls.repo_id"}.Or(builder.Lte{"repo_polls.next_poll": now}))).
+		OrderBy("repos.id").
+		Limit(limit).
+		Find(&repos)
+}
+
+func (s storage) RepoPollFind(repoID int64) (*model.RepoPoll, error) {
+	poll := new(model.RepoPoll)
+	return poll, wrapGet(s.engine.Where("repo_id = ?", repoID).Get(poll))
+}
+
+func (s storage) RepoPollCreate(poll *model.RepoPoll) error {
+	return wrapInsert(s.engine.Insert(poll))
+}
+
+func (s storage) RepoPollClaim(poll *model.RepoPoll, until int64) (bool, error) {
+	count, err := s.engine.
+		Where("repo_id = ? AND next_poll = ?", poll.RepoID, poll.NextPoll).
+		Cols("next_poll").
+		Update(&model.RepoPoll{NextPoll: until})
+	if err != nil {
+		return false, err
+	}
+	if count == 1 {
+		poll.NextPoll = until
+	}
+	return count == 1, nil
+}
+
+func (s storage) RepoPollUpdate(poll *model.RepoPoll) error {
+	_, err := s.engine.Where("repo_id = ?", poll.RepoID).AllCols().Update(poll)
+	return err
+}
+
+func (s storage) RepoPollDelete(repoID int64) error {
+	_, err := s.engine.Where("repo_id = ?", repoID).Delete(new(model.RepoPoll))
+	return err
+}
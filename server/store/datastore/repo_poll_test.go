@@ -0,0 +1,98 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestRepoPollListDue(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Repo), new(model.RepoPoll))
+	defer closer()
+
+	polled := &model.Repo{Name: "aaaa", Owner: "a", FullName: "a/aaaa", ForgeRemoteID: "1", IsActive: true, PollInterval: 5}
+	fresh := &model.Repo{Name: "bbbb", Owner: "a", FullName: "a/bbbb", ForgeRemoteID: "2", IsActive: true, PollInterval: 5}
+	inactive := &model.Repo{Name: "cccc", Owner: "a", FullName: "a/cccc", ForgeRemoteID: "3", IsActive: false, PollInterval: 5}
+	disabled := &model.Repo{Name: "dddd", Owner: "a", FullName: "a/dddd", ForgeRemoteID: "4", IsActive: true}
+	for _, repo := range []*model.Repo{polled, fresh, inactive, disabled} {
+		assert.NoError(t, store.CreateRepo(repo))
+	}
+	assert.NoError(t, store.RepoPollCreate(&model.RepoPoll{RepoID: polled.ID, NextPoll: 1000}))
+
+	repos, err := store.RepoPollListDue(999, 0, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, repos, 1) {
+		assert.Equal(t, fresh.ID, repos[0].ID)
+	}
+
+	repos, err = store.RepoPollListDue(1000, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, repos, 2)
+
+	repos, err = store.RepoPollListDue(1000, 0, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, repos, 1) {
+		assert.Equal(t, polled.ID, repos[0].ID)
+	}
+
+	// the next batch starts after the last repo of the previous one
+	repos, err = store.RepoPollListDue(1000, polled.ID, 10)
+	assert.NoError(t, err)
+	if assert.Len(t, repos, 1) {
+		assert.Equal(t, fresh.ID, repos[0].ID)
+	}
+}
+
+func TestRepoPoll(t *testing.T) {
+	store, closer := newTestStore(t, new(model.RepoPoll))
+	defer closer()
+
+	_, err := store.RepoPollFind(1)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	poll := &model.RepoPoll{RepoID: 1, NextPoll: 1000, Branches: map[string]string{"main": "3f7e5b2"}}
+	assert.NoError(t, store.RepoPollCreate(poll))
+	assert.ErrorIs(t, store.RepoPollCreate(&model.RepoPoll{RepoID: 1}), types.ErrInsertDuplicateDetected)
+
+	other := &model.RepoPoll{RepoID: 1, NextPoll: 1000}
+	ok, err := store.RepoPollClaim(poll, 2000)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, 2000, poll.NextPoll)
+
+	// the poll was claimed already
+	ok, err = store.RepoPollClaim(other, 2000)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	poll.Branches["main"] = "9a4c1d8"
+	poll.Failures = 2
+	assert.NoError(t, store.RepoPollUpdate(poll))
+
+	found, err := store.RepoPollFind(1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"main": "9a4c1d8"}, found.Branches)
+	assert.Equal(t, 2, found.Failures)
+	assert.EqualValues(t, 2000, found.NextPoll)
+
+	assert.NoError(t, store.RepoPollDelete(1))
+	_, err = store.RepoPollFind(1)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+}
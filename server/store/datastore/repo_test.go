@@ -291,12 +291,21 @@ func TestRepoCrud(t *testing.T) {
 		new(model.Pipeline),
 		new(model.PipelineConfig),
 		new(model.LogEntry),
+		new(model.LogArchive),
+		new(model.LogAttemptArchive),
+		new(model.LogSearchIndex),
+		new(model.TestResult),
+		new(model.RetentionPolicy),
+		new(model.Webhook),
+		new(model.WebhookDelivery),
+		new(model.RepoPoll),
 		new(model.Step),
 		new(model.Secret),
 		new(model.Registry),
 		new(model.Config),
 		new(model.Redirection),
-		new(model.Workflow))
+		new(model.Workflow),
+		new(model.WorkflowAttempt))
 	defer closer()
 
 	repo := model.Repo{
@@ -357,12 +366,21 @@ func TestRepoDelete(t *testing.T) {
 		new(model.Pipeline),
 		new(model.PipelineConfig),
 		new(model.LogEntry),
+		new(model.LogArchive),
+		new(model.LogAttemptArchive),
+		new(model.LogSearchIndex),
+		new(model.TestResult),
+		new(model.RetentionPolicy),
+		new(model.Webhook),
+		new(model.WebhookDelivery),
+		new(model.RepoPoll),
 		new(model.Step),
 		new(model.Secret),
 		new(model.Registry),
 		new(model.Config),
 		new(model.Redirection),
-		new(model.Workflow))
+		new(model.Workflow),
+		new(model.WorkflowAttempt))
 	defer closer()
 
 	repo := model.Repo{
@@ -0,0 +1,47 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) RetentionPolicyFind(orgID, repoID int64) (*model.RetentionPolicy, error) {
+	policy := new(model.RetentionPolicy)
+	return policy, wrapGet(s.engine.Where(
+		builder.Eq{"org_id": orgID, "repo_id": repoID},
+	).Get(policy))
+}
+
+func (s storage) RetentionPolicyList() ([]*model.RetentionPolicy, error) {
+	policies := make([]*model.RetentionPolicy, 0)
+	return policies, s.engine.OrderBy("id").Find(&policies)
+}
+
+func (s storage) RetentionPolicyCreate(policy *model.RetentionPolicy) error {
+	// only Insert set auto created ID back to object
+	return wrapInsert(s.engine.Insert(policy))
+}
+
+func (s storage) RetentionPolicyUpdate(policy *model.RetentionPolicy) error {
+	_, err := s.engine.ID(policy.ID).AllCols().Update(policy)
+	return err
+}
+
+func (s storage) RetentionPolicyDelete(policy *model.RetentionPolicy) error {
+	return wrapDelete(s.engine.ID(policy.ID).Delete(new(model.RetentionPolicy)))
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestRetentionPolicies(t *testing.T) {
+	store, closer := newTestStore(t, new(model.RetentionPolicy))
+	defer closer()
+
+	global := &model.RetentionPolicy{KeepPipelines: 50}
+	org := &model.RetentionPolicy{OrgID: 1, LogDays: 30}
+	repo := &model.RetentionPolicy{RepoID: 1, PipelineDays: 90, KeepLastSuccess: true}
+	for _, policy := range []*model.RetentionPolicy{global, org, repo} {
+		require.NoError(t, store.RetentionPolicyCreate(policy))
+		assert.NotZero(t, policy.ID)
+	}
+
+	// there is one policy per scope
+	assert.ErrorIs(t, store.RetentionPolicyCreate(&model.RetentionPolicy{OrgID: 1, LogDays: 1}), types.ErrInsertDuplicateDetected)
+
+	policy, err := store.RetentionPolicyFind(1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, org.ID, policy.ID)
+	policy, err = store.RetentionPolicyFind(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, global.ID, policy.ID)
+	_, err = store.RetentionPolicyFind(0, 2)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	repo.KeepLastSuccess = false
+	require.NoError(t, store.RetentionPolicyUpdate(repo))
+	policy, err = store.RetentionPolicyFind(0, 1)
+	require.NoError(t, err)
+	assert.False(t, policy.KeepLastSuccess)
+	assert.Equal(t, 90, policy.PipelineDays)
+
+	require.NoError(t, store.RetentionPolicyDelete(org))
+	policies, err := store.RetentionPolicyList()
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, global.ID, policies[0].ID)
+	assert.Equal(t, repo.ID, policies[1].ID)
+}
@@ -41,6 +41,15 @@ func (s storage) StepList(pipelineID int64) ([]*model.Step, error) {
 		Find(&stepList)
 }
 
+func (s storage) StepListFinished(afterID int64, limit int) ([]*model.Step, error) {
+	stepList := make([]*model.Step, 0, limit)
+	return stepList, s.engine.
+		Where(builder.Gt{"id": afterID}.And(builder.Gt{"finished": 0})).
+		OrderBy("id").
+		Limit(limit).
+		Find(&stepList)
+}
+
 func (s storage) StepListFromWorkflowFind(workflow *model.Workflow) ([]*model.Step, error) {
 	return s.stepListWorkflow(s.engine.NewSession(), workflow)
 }
@@ -73,5 +82,11 @@ func deleteStep(sess *xorm.Session, stepID int64) error {
 	if err := logDelete(sess, stepID); err != nil {
 		return err
 	}
+	if err := logAttemptDelete(sess, stepID); err != nil {
+		return err
+	}
+	if err := testResultDelete(sess, stepID); err != nil {
+		return err
+	}
 	return wrapDelete(sess.ID(stepID).Delete(new(model.Step)))
 }
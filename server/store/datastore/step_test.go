@@ -189,3 +189,30 @@ func TestStepLoad(t *testing.T) {
 	assert.ErrorIs(t, err, types.ErrRecordNotExist)
 	assert.Empty(t, step)
 }
+
+func TestStepListFinished(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Step), new(model.Pipeline))
+	defer closer()
+
+	sess := store.engine.NewSession()
+	assert.NoError(t, store.stepCreate(sess, []*model.Step{
+		{UUID: "a", PipelineID: 1, PID: 1, State: model.StatusSuccess, Finished: 10},
+		{UUID: "b", PipelineID: 1, PID: 2, State: model.StatusRunning},
+		{UUID: "c", PipelineID: 1, PID: 3, State: model.StatusFailure, Finished: 20},
+		{UUID: "d", PipelineID: 2, PID: 1, State: model.StatusSuccess, Finished: 30},
+	}))
+	_ = sess.Commit()
+
+	steps, err := store.StepListFinished(0, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, steps, 2) {
+		assert.Equal(t, "a", steps[0].UUID)
+		assert.Equal(t, "c", steps[1].UUID)
+	}
+
+	steps, err = store.StepListFinished(steps[1].ID, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, steps, 1) {
+		assert.Equal(t, "d", steps[0].UUID)
+	}
+}
@@ -15,7 +15,13 @@
 package datastore
 
 import (
+	"slices"
+
+	"xorm.io/builder"
+	"xorm.io/xorm/schemas"
+
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
 func (s storage) TaskList() ([]*model.Task, error) {
@@ -28,6 +34,143 @@ func (s storage) TaskInsert(task *model.Task) error {
 	return wrapInsert(s.engine.Insert(task))
 }
 
+func (s storage) TaskInsertBatch(tasks []*model.Task) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := wrapInsert(sess.Insert(task)); err != nil {
+			return err
+		}
+	}
+
+	return sess.Commit()
+}
+
 func (s storage) TaskDelete(id string) error {
 	return wrapDelete(s.engine.Where("id = ?", id).Delete(new(model.Task)))
 }
+
+func (s storage) TaskLoad(id string) (*model.Task, error) {
+	task := new(model.Task)
+	return task, wrapGet(s.engine.Where("id = ?", id).Get(task))
+}
+
+func (s storage) TaskClaim(task *model.Task) (bool, error) {
+	task.State = model.TaskStateRunning
+	count, err := s.engine.
+		Where("id = ?", task.ID).
+		And(builder.In("state", "", model.TaskStatePending)).
+		Cols("state", "agent_id", "deadline").
+		Update(task)
+	return count == 1, err
+}
+
+func (s storage) TaskExtend(task *model.Task) error {
+	count, err := s.engine.
+		Where("id = ? AND agent_id = ? AND state = ?", task.ID, task.AgentID, model.TaskStateRunning).
+		Cols("deadline").
+		Update(task)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrRecordNotExist
+	}
+	return nil
+}
+
+func (s storage) TaskCancel(task *model.Task) error {
+	task.State = model.TaskStateCanceled
+	count, err := s.engine.
+		Where("id = ? AND state = ?", task.ID, model.TaskStateRunning).
+		Cols("state", "deadline").
+		Update(task)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrRecordNotExist
+	}
+	return nil
+}
+
+func (s storage) TaskRetry(task *model.Task) error {
+	task.State = model.TaskStatePending
+	count, err := s.engine.
+		Where("id = ? AND state = ?", task.ID, model.TaskStateRunning).
+		Cols("state", "agent_id", "deadline", "attempt", "not_before").
+		Update(task)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return types.ErrRecordNotExist
+	}
+	return nil
+}
+
+func (s storage) TaskResubmitExpired(now int64) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	// the lost attempt counts, tasks queued before attempts were counted are
+	// on their first one
+	if _, err := sess.
+		Where("state = ? AND deadline < ?", model.TaskStateRunning, now).
+		SetExpr("attempt", "CASE WHEN attempt < 1 THEN 2 ELSE attempt + 1 END").
+		Cols("state", "agent_id").
+		Update(&model.Task{State: model.TaskStatePending}); err != nil {
+		return err
+	}
+
+	if _, err := sess.
+		Where("state = ? AND deadline < ?", model.TaskStateCanceled, now).
+		Delete(new(model.Task)); err != nil {
+		return err
+	}
+
+	return sess.Commit()
+}
+
+func (s storage) TaskUpdateDepStatus(id string, status model.StatusValue) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	// sqlite locks the whole database within a transaction, other databases
+	// need row locks so concurrent updates of the same task do not get lost.
+	if s.engine.Dialect().URI().DBType != schemas.SQLITE {
+		sess = sess.ForUpdate()
+	}
+
+	// the dependencies are stored as json, so narrow the candidates down by
+	// text and filter them exactly afterwards.
+	var tasks []*model.Task
+	if err := sess.Where(builder.Like{"dependencies", `"` + id + `"`}).Find(&tasks); err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if !slices.Contains(task.Dependencies, id) {
+			continue
+		}
+		if task.DepStatus == nil {
+			task.DepStatus = make(map[string]model.StatusValue)
+		}
+		task.DepStatus[id] = status
+		if _, err := sess.ID(task.ID).Cols("dependencies_status").Update(task); err != nil {
+			return err
+		}
+	}
+
+	return sess.Commit()
+}
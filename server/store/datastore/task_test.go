@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
 )
 
 func TestTaskList(t *testing.T) {
@@ -31,6 +32,7 @@ func TestTaskList(t *testing.T) {
 		Data:      []byte("foo"),
 		Labels:    map[string]string{"foo": "bar"},
 		DepStatus: map[string]model.StatusValue{"test": "dep"},
+		Priority:  10,
 	}))
 
 	list, err := store.TaskList()
@@ -39,6 +41,7 @@ func TestTaskList(t *testing.T) {
 	assert.Equal(t, "some_random_id", list[0].ID)
 	assert.Equal(t, "foo", string(list[0].Data))
 	assert.EqualValues(t, map[string]model.StatusValue{"test": "dep"}, list[0].DepStatus)
+	assert.Equal(t, 10, list[0].Priority)
 
 	assert.NoError(t, store.TaskDelete("some_random_id"))
 
@@ -46,3 +49,135 @@ func TestTaskList(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, list, 0, "Want empty task list after delete")
 }
+
+func TestTaskInsertBatch(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Task))
+	defer closer()
+
+	assert.NoError(t, store.TaskInsertBatch([]*model.Task{{ID: "1"}, {ID: "2"}}))
+
+	// a failing insert leaves none of the tasks behind
+	assert.Error(t, store.TaskInsertBatch([]*model.Task{{ID: "3"}, {ID: "1"}}))
+
+	list, err := store.TaskList()
+	assert.NoError(t, err)
+	var ids []string
+	for _, task := range list {
+		ids = append(ids, task.ID)
+	}
+	assert.ElementsMatch(t, []string{"1", "2"}, ids)
+}
+
+func TestTaskClaim(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Task))
+	defer closer()
+
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "1"}))
+
+	claimed, err := store.TaskClaim(&model.Task{ID: "1", AgentID: 1, Deadline: 100})
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	// a task can only be claimed once
+	claimed, err = store.TaskClaim(&model.Task{ID: "1", AgentID: 2, Deadline: 100})
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+
+	task, err := store.TaskLoad("1")
+	assert.NoError(t, err)
+	assert.Equal(t, model.TaskStateRunning, task.State)
+	assert.EqualValues(t, 1, task.AgentID)
+	assert.EqualValues(t, 100, task.Deadline)
+
+	assert.NoError(t, store.TaskExtend(&model.Task{ID: "1", AgentID: 1, Deadline: 200}))
+	assert.ErrorIs(t, store.TaskExtend(&model.Task{ID: "1", AgentID: 2, Deadline: 300}), types.ErrRecordNotExist)
+
+	task, err = store.TaskLoad("1")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 200, task.Deadline)
+
+	_, err = store.TaskLoad("2")
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+}
+
+func TestTaskResubmitExpired(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Task))
+	defer closer()
+
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "expired"}))
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "running"}))
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "canceled"}))
+	for _, task := range []*model.Task{
+		{ID: "expired", AgentID: 1, Deadline: 100},
+		{ID: "running", AgentID: 2, Deadline: 300},
+		{ID: "canceled", AgentID: 3, Deadline: 300},
+	} {
+		claimed, err := store.TaskClaim(task)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	}
+	assert.NoError(t, store.TaskCancel(&model.Task{ID: "canceled", Deadline: 100}))
+	assert.ErrorIs(t, store.TaskCancel(&model.Task{ID: "canceled", Deadline: 100}), types.ErrRecordNotExist)
+
+	assert.NoError(t, store.TaskResubmitExpired(200))
+
+	task, err := store.TaskLoad("expired")
+	assert.NoError(t, err)
+	assert.Equal(t, model.TaskStatePending, task.State)
+	assert.EqualValues(t, 0, task.AgentID)
+	assert.Equal(t, 2, task.Attempt)
+
+	task, err = store.TaskLoad("running")
+	assert.NoError(t, err)
+	assert.Equal(t, model.TaskStateRunning, task.State)
+	assert.Equal(t, 0, task.Attempt)
+
+	_, err = store.TaskLoad("canceled")
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+}
+
+func TestTaskRetry(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Task))
+	defer closer()
+
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "1", Attempt: 1}))
+	assert.ErrorIs(t, store.TaskRetry(&model.Task{ID: "1", Attempt: 2}), types.ErrRecordNotExist, "pending tasks are not retried")
+
+	claimed, err := store.TaskClaim(&model.Task{ID: "1", AgentID: 1, Deadline: 100})
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	assert.NoError(t, store.TaskRetry(&model.Task{ID: "1", Attempt: 2, NotBefore: 500}))
+
+	task, err := store.TaskLoad("1")
+	assert.NoError(t, err)
+	assert.Equal(t, model.TaskStatePending, task.State)
+	assert.EqualValues(t, 0, task.AgentID)
+	assert.EqualValues(t, 0, task.Deadline)
+	assert.Equal(t, 2, task.Attempt)
+	assert.EqualValues(t, 500, task.NotBefore)
+}
+
+func TestTaskUpdateDepStatus(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Task))
+	defer closer()
+
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "1"}))
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "2", Dependencies: []string{"1"}}))
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "3", Dependencies: []string{"1", "2"}, DepStatus: map[string]model.StatusValue{"2": model.StatusSuccess}}))
+	assert.NoError(t, store.TaskInsert(&model.Task{ID: "4", Dependencies: []string{"11"}}))
+
+	assert.NoError(t, store.TaskUpdateDepStatus("1", model.StatusFailure))
+
+	task, err := store.TaskLoad("2")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]model.StatusValue{"1": model.StatusFailure}, task.DepStatus)
+
+	task, err = store.TaskLoad("3")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]model.StatusValue{"1": model.StatusFailure, "2": model.StatusSuccess}, task.DepStatus)
+
+	task, err = store.TaskLoad("4")
+	assert.NoError(t, err)
+	assert.Empty(t, task.DepStatus)
+}
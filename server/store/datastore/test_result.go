@@ -0,0 +1,84 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/xorm"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+// TestResultReplace replaces the test results of a step, e.g. reported by a
+// previous attempt of its workflow, with the given ones.
+func (s storage) TestResultReplace(step *model.Step, results []*model.TestResult) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if err := testResultDelete(sess, step.ID); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		result.StepID = step.ID
+		// only Insert on single object ref set auto created ID back to object
+		if err := wrapInsert(sess.Insert(result)); err != nil {
+			return err
+		}
+	}
+
+	return sess.Commit()
+}
+
+func (s storage) TestResultList(pipeline *model.Pipeline) ([]*model.TestResult, error) {
+	results := make([]*model.TestResult, 0)
+	return results, s.engine.
+		Where("pipeline_id = ?", pipeline.ID).
+		OrderBy("id").
+		Find(&results)
+}
+
+// TestResultHistory returns the results of the latest pipelines of a repo
+// before the given pipeline number which reported test results. The limit
+// applies to the number of pipelines. Only the fields identifying a test and
+// its status are loaded.
+func (s storage) TestResultHistory(repo *model.Repo, before int64, limit int) ([]*model.TestResult, error) {
+	numbers := make([]int64, 0, limit)
+	err := s.engine.
+		Table(new(model.TestResult)).
+		Distinct("pipeline_number").
+		Where("repo_id = ? AND pipeline_number < ?", repo.ID, before).
+		OrderBy("pipeline_number DESC").
+		Limit(limit).
+		Find(&numbers)
+	if err != nil || len(numbers) == 0 {
+		return nil, err
+	}
+
+	results := make([]*model.TestResult, 0)
+	return results, s.engine.
+		Cols("pipeline_number", "suite", "classname", "name", "status").
+		Where("repo_id = ?", repo.ID).
+		In("pipeline_number", numbers).
+		OrderBy("pipeline_number DESC, id").
+		Find(&results)
+}
+
+func testResultDelete(sess *xorm.Session, stepID int64) error {
+	_, err := sess.Where("step_id = ?", stepID).Delete(new(model.TestResult))
+	return err
+}
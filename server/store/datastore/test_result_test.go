@@ -0,0 +1,83 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestTestResults(t *testing.T) {
+	store, closer := newTestStore(t, new(model.TestResult), new(model.Step), new(model.LogEntry), new(model.LogArchive), new(model.LogAttemptArchive), new(model.LogSearchIndex))
+	defer closer()
+
+	repo := &model.Repo{ID: 1}
+	result := func(number int64, name string, status model.TestStatus) *model.TestResult {
+		return &model.TestResult{RepoID: repo.ID, PipelineID: number * 10, PipelineNumber: number, Name: name, Status: status}
+	}
+
+	require.NoError(t, store.TestResultReplace(&model.Step{ID: 1}, []*model.TestResult{
+		result(1, "a", model.TestStatusPassed),
+	}))
+	require.NoError(t, store.TestResultReplace(&model.Step{ID: 2}, []*model.TestResult{
+		result(2, "a", model.TestStatusFailed),
+		result(2, "b", model.TestStatusPassed),
+	}))
+	require.NoError(t, store.TestResultReplace(&model.Step{ID: 3}, []*model.TestResult{
+		result(4, "a", model.TestStatusPassed),
+	}))
+	// results of another repo are never part of the history
+	require.NoError(t, store.TestResultReplace(&model.Step{ID: 4}, []*model.TestResult{
+		{RepoID: 2, PipelineID: 30, PipelineNumber: 3, Name: "a", Status: model.TestStatusFailed},
+	}))
+
+	results, err := store.TestResultList(&model.Pipeline{ID: 20})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "a", results[0].Name)
+	assert.Equal(t, int64(2), results[0].StepID)
+	assert.Equal(t, model.TestStatusFailed, results[0].Status)
+
+	// a retried step replaces its results
+	require.NoError(t, store.TestResultReplace(&model.Step{ID: 2}, []*model.TestResult{
+		result(2, "a", model.TestStatusPassed),
+	}))
+	results, err = store.TestResultList(&model.Pipeline{ID: 20})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, model.TestStatusPassed, results[0].Status)
+
+	history, err := store.TestResultHistory(repo, 5, 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, int64(4), history[0].PipelineNumber)
+	assert.Equal(t, int64(2), history[1].PipelineNumber)
+
+	history, err = store.TestResultHistory(repo, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	// deleting a step deletes its results
+	_, err = store.engine.Insert(&model.Step{ID: 2, PipelineID: 20})
+	require.NoError(t, err)
+	require.NoError(t, deleteStep(store.engine.NewSession(), 2))
+	results, err = store.TestResultList(&model.Pipeline{ID: 20})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
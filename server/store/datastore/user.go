@@ -104,5 +104,9 @@ func (s storage) DeleteUser(user *model.User) error {
 		return fmt.Errorf("failed to delete perms: %w", err)
 	}
 
+	if _, err := sess.Where("user_id = ?", user.ID).Delete(new(model.PersonalAccessToken)); err != nil {
+		return fmt.Errorf("failed to delete personal access tokens: %w", err)
+	}
+
 	return sess.Commit()
 }
@@ -0,0 +1,139 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"xorm.io/builder"
+	"xorm.io/xorm"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) WebhookFind(id int64) (*model.Webhook, error) {
+	webhook := new(model.Webhook)
+	return webhook, wrapGet(s.engine.ID(id).Get(webhook))
+}
+
+func (s storage) WebhookList(orgID, repoID int64, p *model.ListOptions) ([]*model.Webhook, error) {
+	webhooks := make([]*model.Webhook, 0)
+	return webhooks, s.paginate(&model.ListOptionsWithAll{ListOptions: p}).
+		Where(builder.Eq{"org_id": orgID, "repo_id": repoID}).
+		OrderBy("id").
+		Find(&webhooks)
+}
+
+func (s storage) WebhookListEnabled(orgID, repoID int64) ([]*model.Webhook, error) {
+	cond := builder.Eq{"org_id": 0, "repo_id": 0}.
+		Or(builder.Eq{"org_id": orgID, "repo_id": 0}.And(builder.Neq{"org_id": 0})).
+		Or(builder.Eq{"org_id": 0, "repo_id": repoID}.And(builder.Neq{"repo_id": 0}))
+
+	webhooks := make([]*model.Webhook, 0)
+	return webhooks, s.engine.
+		Where(builder.Eq{"enabled": true}.And(cond)).
+		OrderBy("id").
+		Find(&webhooks)
+}
+
+func (s storage) WebhookCreate(webhook *model.Webhook) error {
+	// only Insert set auto created ID back to object
+	return wrapInsert(s.engine.Insert(webhook))
+}
+
+func (s storage) WebhookUpdate(webhook *model.Webhook) error {
+	_, err := s.engine.ID(webhook.ID).AllCols().Update(webhook)
+	return err
+}
+
+func (s storage) WebhookDelete(webhook *model.Webhook) error {
+	sess := s.engine.NewSession()
+	defer sess.Close()
+	if err := sess.Begin(); err != nil {
+		return err
+	}
+
+	if _, err := sess.Where("webhook_id = ?", webhook.ID).Delete(new(model.WebhookDelivery)); err != nil {
+		return err
+	}
+	if err := wrapDelete(sess.ID(webhook.ID).Delete(new(model.Webhook))); err != nil {
+		return err
+	}
+
+	return sess.Commit()
+}
+
+// deleteWebhooks deletes the webhooks matching cond together with their
+// deliveries.
+func deleteWebhooks(sess *xorm.Session, cond builder.Cond) error {
+	if _, err := sess.Where(builder.In("webhook_id", builder.Select("id").From("webhooks").Where(cond))).Delete(new(model.WebhookDelivery)); err != nil {
+		return err
+	}
+	_, err := sess.Where(cond).Delete(new(model.Webhook))
+	return err
+}
+
+func (s storage) WebhookDeliveryFind(webhook *model.Webhook, id int64) (*model.WebhookDelivery, error) {
+	delivery := new(model.WebhookDelivery)
+	return delivery, wrapGet(s.engine.Where(
+		builder.Eq{"webhook_id": webhook.ID, "id": id},
+	).Get(delivery))
+}
+
+func (s storage) WebhookDeliveryList(webhook *model.Webhook, p *model.ListOptions) ([]*model.WebhookDelivery, error) {
+	deliveries := make([]*model.WebhookDelivery, 0)
+	return deliveries, s.paginate(&model.ListOptionsWithAll{ListOptions: p}).
+		Where(builder.Eq{"webhook_id": webhook.ID}).
+		Desc("id").
+		Find(&deliveries)
+}
+
+func (s storage) WebhookDeliveryListDue(now int64, limit int) ([]*model.WebhookDelivery, error) {
+	deliveries := make([]*model.WebhookDelivery, 0, limit)
+	return deliveries, s.engine.
+		Where(builder.Eq{"status": model.WebhookDeliveryPending}.And(builder.Lte{"next_attempt": now})).
+		OrderBy("next_attempt, id").
+		Limit(limit).
+		Find(&deliveries)
+}
+
+func (s storage) WebhookDeliveryCreate(delivery *model.WebhookDelivery) error {
+	// only Insert set auto created ID back to object
+	return wrapInsert(s.engine.Insert(delivery))
+}
+
+func (s storage) WebhookDeliveryClaim(delivery *model.WebhookDelivery, until int64) (bool, error) {
+	count, err := s.engine.
+		Where("id = ? AND status = ? AND next_attempt = ?", delivery.ID, model.WebhookDeliveryPending, delivery.NextAttempt).
+		Cols("next_attempt").
+		Update(&model.WebhookDelivery{NextAttempt: until})
+	if err != nil {
+		return false, err
+	}
+	if count == 1 {
+		delivery.NextAttempt = until
+	}
+	return count == 1, nil
+}
+
+func (s storage) WebhookDeliveryUpdate(delivery *model.WebhookDelivery) error {
+	_, err := s.engine.ID(delivery.ID).AllCols().Update(delivery)
+	return err
+}
+
+func (s storage) WebhookDeliveryDeleteBefore(before int64) error {
+	_, err := s.engine.
+		Where(builder.Lt{"created": before}.And(builder.Neq{"status": model.WebhookDeliveryPending})).
+		Delete(new(model.WebhookDelivery))
+	return err
+}
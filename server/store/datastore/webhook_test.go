@@ -0,0 +1,128 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+	"go.woodpecker-ci.org/woodpecker/v3/server/store/types"
+)
+
+func TestWebhooks(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Webhook), new(model.WebhookDelivery))
+	defer closer()
+
+	events := []model.WebhookTrigger{model.WebhookTriggerPipelineFinished}
+	global := &model.Webhook{Name: "global", URL: "https://example.com/global", Events: events, Enabled: true}
+	org := &model.Webhook{OrgID: 1, Name: "org", URL: "https://example.com/org", Events: events, Enabled: true}
+	otherOrg := &model.Webhook{OrgID: 2, Name: "other org", URL: "https://example.com/other", Events: events, Enabled: true}
+	repo := &model.Webhook{RepoID: 1, Name: "repo", URL: "https://example.com/repo", Events: events, Enabled: true}
+	disabled := &model.Webhook{RepoID: 1, Name: "disabled", URL: "https://example.com/disabled", Events: events}
+	for _, webhook := range []*model.Webhook{global, org, otherOrg, repo, disabled} {
+		require.NoError(t, store.WebhookCreate(webhook))
+		assert.NotZero(t, webhook.ID)
+	}
+
+	webhook, err := store.WebhookFind(org.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "org", webhook.Name)
+	assert.Equal(t, events, webhook.Events)
+
+	webhooks, err := store.WebhookList(0, 1, &model.ListOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, repo.ID, webhooks[0].ID)
+
+	webhooks, err = store.WebhookListEnabled(1, 1)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 3)
+	assert.Equal(t, global.ID, webhooks[0].ID)
+	assert.Equal(t, org.ID, webhooks[1].ID)
+	assert.Equal(t, repo.ID, webhooks[2].ID)
+
+	// agents of no org only get the global webhooks
+	webhooks, err = store.WebhookListEnabled(0, 0)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, global.ID, webhooks[0].ID)
+
+	disabled.Enabled = true
+	require.NoError(t, store.WebhookUpdate(disabled))
+	webhooks, err = store.WebhookListEnabled(0, 1)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 3)
+
+	require.NoError(t, store.WebhookDeliveryCreate(&model.WebhookDelivery{WebhookID: repo.ID, Status: model.WebhookDeliveryPending}))
+	require.NoError(t, store.WebhookDelete(repo))
+	_, err = store.WebhookFind(repo.ID)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+	deliveries, err := store.WebhookDeliveryListDue(1<<40, 10)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Webhook), new(model.WebhookDelivery))
+	defer closer()
+
+	webhook := &model.Webhook{Name: "hook", URL: "https://example.com", Events: []model.WebhookTrigger{model.WebhookTriggerAgentOffline}}
+	require.NoError(t, store.WebhookCreate(webhook))
+
+	due := &model.WebhookDelivery{WebhookID: webhook.ID, Event: model.WebhookTriggerAgentOffline, Status: model.WebhookDeliveryPending, NextAttempt: 100}
+	later := &model.WebhookDelivery{WebhookID: webhook.ID, Event: model.WebhookTriggerAgentOffline, Status: model.WebhookDeliveryPending, NextAttempt: 200}
+	sent := &model.WebhookDelivery{WebhookID: webhook.ID, Event: model.WebhookTriggerAgentOffline, Status: model.WebhookDeliverySuccess}
+	for _, delivery := range []*model.WebhookDelivery{due, later, sent} {
+		require.NoError(t, store.WebhookDeliveryCreate(delivery))
+	}
+
+	deliveries, err := store.WebhookDeliveryListDue(150, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, due.ID, deliveries[0].ID)
+
+	// a delivery is only claimed once
+	claimed, err := store.WebhookDeliveryClaim(deliveries[0], 400)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	assert.EqualValues(t, 400, deliveries[0].NextAttempt)
+	claimed, err = store.WebhookDeliveryClaim(due, 400)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	deliveries[0].Status = model.WebhookDeliverySuccess
+	deliveries[0].Attempts = 1
+	require.NoError(t, store.WebhookDeliveryUpdate(deliveries[0]))
+	delivery, err := store.WebhookDeliveryFind(webhook, due.ID)
+	require.NoError(t, err)
+	assert.Equal(t, model.WebhookDeliverySuccess, delivery.Status)
+	_, err = store.WebhookDeliveryFind(&model.Webhook{ID: webhook.ID + 1}, due.ID)
+	assert.ErrorIs(t, err, types.ErrRecordNotExist)
+
+	deliveries, err = store.WebhookDeliveryList(webhook, &model.ListOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	assert.Equal(t, sent.ID, deliveries[0].ID)
+
+	// pending deliveries are kept
+	require.NoError(t, store.WebhookDeliveryDeleteBefore(1<<40))
+	deliveries, err = store.WebhookDeliveryList(webhook, &model.ListOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, later.ID, deliveries[0].ID)
+}
@@ -101,6 +101,10 @@ func (s storage) workflowsDelete(sess *xorm.Session, pipelineID int64) error {
 		}
 	}
 
+	if _, err := sess.Where("pipeline_id = ?", pipelineID).Delete(new(model.WorkflowAttempt)); err != nil {
+		return err
+	}
+
 	_, err := sess.Where("pipeline_id = ?", pipelineID).Delete(new(model.Workflow))
 	return err
 }
@@ -0,0 +1,33 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func (s storage) WorkflowAttemptCreate(attempt *model.WorkflowAttempt) error {
+	// only Insert set auto created ID back to object
+	_, err := s.engine.Insert(attempt)
+	return err
+}
+
+func (s storage) WorkflowAttemptList(pipeline *model.Pipeline) ([]*model.WorkflowAttempt, error) {
+	attempts := make([]*model.WorkflowAttempt, 0)
+	return attempts, s.engine.
+		Where("pipeline_id = ?", pipeline.ID).
+		OrderBy("workflow_id, attempt").
+		Find(&attempts)
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.woodpecker-ci.org/woodpecker/v3/server/model"
+)
+
+func TestWorkflowAttempts(t *testing.T) {
+	store, closer := newTestStore(t, new(model.Step), new(model.Pipeline), new(model.Workflow), new(model.WorkflowAttempt))
+	defer closer()
+
+	wf := &model.Workflow{PipelineID: 1, PID: 1, Name: "woodpecker", Attempt: 3}
+	require.NoError(t, store.WorkflowsCreate([]*model.Workflow{wf}))
+
+	require.NoError(t, store.WorkflowAttemptCreate(&model.WorkflowAttempt{
+		PipelineID: 1, WorkflowID: wf.ID, Attempt: 2, AgentID: 2, State: model.StatusError, Failure: model.FailureSetupError,
+	}))
+	require.NoError(t, store.WorkflowAttemptCreate(&model.WorkflowAttempt{
+		PipelineID: 1, WorkflowID: wf.ID, Attempt: 1, AgentID: 1, State: model.StatusError, Failure: model.FailureAgentLost,
+	}))
+	require.NoError(t, store.WorkflowAttemptCreate(&model.WorkflowAttempt{
+		PipelineID: 2, WorkflowID: wf.ID + 1, Attempt: 1,
+	}))
+
+	attempts, err := store.WorkflowAttemptList(&model.Pipeline{ID: 1})
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.Equal(t, 1, attempts[0].Attempt)
+	assert.Equal(t, model.FailureAgentLost, attempts[0].Failure)
+	assert.Equal(t, 2, attempts[1].Attempt)
+	assert.Equal(t, model.FailureSetupError, attempts[1].Failure)
+
+	// attempts are removed together with the workflows of a pipeline
+	require.NoError(t, store.WorkflowsReplace(&model.Pipeline{ID: 1}, nil))
+	attempts, err = store.WorkflowAttemptList(&model.Pipeline{ID: 1})
+	require.NoError(t, err)
+	assert.Empty(t, attempts)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	mock "github.com/stretchr/testify/mock"
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 )
 
@@ -38,6 +39,66 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// AgentClaimOffline provides a mock function for the type MockStore
+func (_mock *MockStore) AgentClaimOffline(agent *model.Agent) (bool, error) {
+	ret := _mock.Called(agent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AgentClaimOffline")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Agent) (bool, error)); ok {
+		return returnFunc(agent)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Agent) bool); ok {
+		r0 = returnFunc(agent)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Agent) error); ok {
+		r1 = returnFunc(agent)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_AgentClaimOffline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AgentClaimOffline'
+type MockStore_AgentClaimOffline_Call struct {
+	*mock.Call
+}
+
+// AgentClaimOffline is a helper method to define mock.On call
+//   - agent *model.Agent
+func (_e *MockStore_Expecter) AgentClaimOffline(agent any) *MockStore_AgentClaimOffline_Call {
+	return &MockStore_AgentClaimOffline_Call{Call: _e.mock.On("AgentClaimOffline", agent)}
+}
+
+func (_c *MockStore_AgentClaimOffline_Call) Run(run func(agent *model.Agent)) *MockStore_AgentClaimOffline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Agent
+		if args[0] != nil {
+			arg0 = args[0].(*model.Agent)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_AgentClaimOffline_Call) Return(b bool, err error) *MockStore_AgentClaimOffline_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStore_AgentClaimOffline_Call) RunAndReturn(run func(agent *model.Agent) (bool, error)) *MockStore_AgentClaimOffline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AgentCreate provides a mock function for the type MockStore
 func (_mock *MockStore) AgentCreate(agent *model.Agent) error {
 	ret := _mock.Called(agent)
@@ -445,94 +506,107 @@ func (_c *MockStore_AgentUpdate_Call) RunAndReturn(run func(agent *model.Agent)
 	return _c
 }
 
-// Close provides a mock function for the type MockStore
-func (_mock *MockStore) Close() error {
-	ret := _mock.Called()
+// AppendPipelineErrors provides a mock function for the type MockStore
+func (_mock *MockStore) AppendPipelineErrors(pipelineID int64, errs []*errors.PipelineError) (*model.Pipeline, error) {
+	ret := _mock.Called(pipelineID, errs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Close")
+		panic("no return value specified for AppendPipelineErrors")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, []*errors.PipelineError) (*model.Pipeline, error)); ok {
+		return returnFunc(pipelineID, errs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, []*errors.PipelineError) *model.Pipeline); ok {
+		r0 = returnFunc(pipelineID, errs)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, []*errors.PipelineError) error); ok {
+		r1 = returnFunc(pipelineID, errs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockStore_Close_Call struct {
+// MockStore_AppendPipelineErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendPipelineErrors'
+type MockStore_AppendPipelineErrors_Call struct {
 	*mock.Call
 }
 
-// Close is a helper method to define mock.On call
-func (_e *MockStore_Expecter) Close() *MockStore_Close_Call {
-	return &MockStore_Close_Call{Call: _e.mock.On("Close")}
+// AppendPipelineErrors is a helper method to define mock.On call
+//   - pipelineID int64
+//   - errs []*errors.PipelineError
+func (_e *MockStore_Expecter) AppendPipelineErrors(pipelineID any, errs any) *MockStore_AppendPipelineErrors_Call {
+	return &MockStore_AppendPipelineErrors_Call{Call: _e.mock.On("AppendPipelineErrors", pipelineID, errs)}
 }
 
-func (_c *MockStore_Close_Call) Run(run func()) *MockStore_Close_Call {
+func (_c *MockStore_AppendPipelineErrors_Call) Run(run func(pipelineID int64, errs []*errors.PipelineError)) *MockStore_AppendPipelineErrors_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 []*errors.PipelineError
+		if args[1] != nil {
+			arg1 = args[1].([]*errors.PipelineError)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_Close_Call) Return(err error) *MockStore_Close_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_AppendPipelineErrors_Call) Return(pipeline *model.Pipeline, err error) *MockStore_AppendPipelineErrors_Call {
+	_c.Call.Return(pipeline, err)
 	return _c
 }
 
-func (_c *MockStore_Close_Call) RunAndReturn(run func() error) *MockStore_Close_Call {
+func (_c *MockStore_AppendPipelineErrors_Call) RunAndReturn(run func(pipelineID int64, errs []*errors.PipelineError) (*model.Pipeline, error)) *MockStore_AppendPipelineErrors_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ConfigPersist provides a mock function for the type MockStore
-func (_mock *MockStore) ConfigPersist(config *model.Config) (*model.Config, error) {
-	ret := _mock.Called(config)
+// ArtifactCreate provides a mock function for the type MockStore
+func (_mock *MockStore) ArtifactCreate(artifact *model.Artifact) error {
+	ret := _mock.Called(artifact)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConfigPersist")
+		panic("no return value specified for ArtifactCreate")
 	}
 
-	var r0 *model.Config
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Config) (*model.Config, error)); ok {
-		return returnFunc(config)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Config) *model.Config); ok {
-		r0 = returnFunc(config)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Config)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Config) error); ok {
-		r1 = returnFunc(config)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Artifact) error); ok {
+		r0 = returnFunc(artifact)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_ConfigPersist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigPersist'
-type MockStore_ConfigPersist_Call struct {
+// MockStore_ArtifactCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtifactCreate'
+type MockStore_ArtifactCreate_Call struct {
 	*mock.Call
 }
 
-// ConfigPersist is a helper method to define mock.On call
-//   - config *model.Config
-func (_e *MockStore_Expecter) ConfigPersist(config any) *MockStore_ConfigPersist_Call {
-	return &MockStore_ConfigPersist_Call{Call: _e.mock.On("ConfigPersist", config)}
+// ArtifactCreate is a helper method to define mock.On call
+//   - artifact *model.Artifact
+func (_e *MockStore_Expecter) ArtifactCreate(artifact any) *MockStore_ArtifactCreate_Call {
+	return &MockStore_ArtifactCreate_Call{Call: _e.mock.On("ArtifactCreate", artifact)}
 }
 
-func (_c *MockStore_ConfigPersist_Call) Run(run func(config *model.Config)) *MockStore_ConfigPersist_Call {
+func (_c *MockStore_ArtifactCreate_Call) Run(run func(artifact *model.Artifact)) *MockStore_ArtifactCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Config
+		var arg0 *model.Artifact
 		if args[0] != nil {
-			arg0 = args[0].(*model.Config)
+			arg0 = args[0].(*model.Artifact)
 		}
 		run(
 			arg0,
@@ -541,60 +615,49 @@ func (_c *MockStore_ConfigPersist_Call) Run(run func(config *model.Config)) *Moc
 	return _c
 }
 
-func (_c *MockStore_ConfigPersist_Call) Return(config1 *model.Config, err error) *MockStore_ConfigPersist_Call {
-	_c.Call.Return(config1, err)
+func (_c *MockStore_ArtifactCreate_Call) Return(err error) *MockStore_ArtifactCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_ConfigPersist_Call) RunAndReturn(run func(config *model.Config) (*model.Config, error)) *MockStore_ConfigPersist_Call {
+func (_c *MockStore_ArtifactCreate_Call) RunAndReturn(run func(artifact *model.Artifact) error) *MockStore_ArtifactCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ConfigsForPipeline provides a mock function for the type MockStore
-func (_mock *MockStore) ConfigsForPipeline(pipelineID int64) ([]*model.Config, error) {
-	ret := _mock.Called(pipelineID)
+// ArtifactDelete provides a mock function for the type MockStore
+func (_mock *MockStore) ArtifactDelete(artifact *model.Artifact) error {
+	ret := _mock.Called(artifact)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ConfigsForPipeline")
+		panic("no return value specified for ArtifactDelete")
 	}
 
-	var r0 []*model.Config
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) ([]*model.Config, error)); ok {
-		return returnFunc(pipelineID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64) []*model.Config); ok {
-		r0 = returnFunc(pipelineID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Config)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(pipelineID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Artifact) error); ok {
+		r0 = returnFunc(artifact)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_ConfigsForPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigsForPipeline'
-type MockStore_ConfigsForPipeline_Call struct {
+// MockStore_ArtifactDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtifactDelete'
+type MockStore_ArtifactDelete_Call struct {
 	*mock.Call
 }
 
-// ConfigsForPipeline is a helper method to define mock.On call
-//   - pipelineID int64
-func (_e *MockStore_Expecter) ConfigsForPipeline(pipelineID any) *MockStore_ConfigsForPipeline_Call {
-	return &MockStore_ConfigsForPipeline_Call{Call: _e.mock.On("ConfigsForPipeline", pipelineID)}
+// ArtifactDelete is a helper method to define mock.On call
+//   - artifact *model.Artifact
+func (_e *MockStore_Expecter) ArtifactDelete(artifact any) *MockStore_ArtifactDelete_Call {
+	return &MockStore_ArtifactDelete_Call{Call: _e.mock.On("ArtifactDelete", artifact)}
 }
 
-func (_c *MockStore_ConfigsForPipeline_Call) Run(run func(pipelineID int64)) *MockStore_ConfigsForPipeline_Call {
+func (_c *MockStore_ArtifactDelete_Call) Run(run func(artifact *model.Artifact)) *MockStore_ArtifactDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Artifact
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Artifact)
 		}
 		run(
 			arg0,
@@ -603,115 +666,128 @@ func (_c *MockStore_ConfigsForPipeline_Call) Run(run func(pipelineID int64)) *Mo
 	return _c
 }
 
-func (_c *MockStore_ConfigsForPipeline_Call) Return(configs []*model.Config, err error) *MockStore_ConfigsForPipeline_Call {
-	_c.Call.Return(configs, err)
+func (_c *MockStore_ArtifactDelete_Call) Return(err error) *MockStore_ArtifactDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_ConfigsForPipeline_Call) RunAndReturn(run func(pipelineID int64) ([]*model.Config, error)) *MockStore_ConfigsForPipeline_Call {
+func (_c *MockStore_ArtifactDelete_Call) RunAndReturn(run func(artifact *model.Artifact) error) *MockStore_ArtifactDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePipeline provides a mock function for the type MockStore
-func (_mock *MockStore) CreatePipeline(pipeline *model.Pipeline, steps ...*model.Step) error {
-	var tmpRet mock.Arguments
-	if len(steps) > 0 {
-		tmpRet = _mock.Called(pipeline, steps)
-	} else {
-		tmpRet = _mock.Called(pipeline)
-	}
-	ret := tmpRet
+// ArtifactFind provides a mock function for the type MockStore
+func (_mock *MockStore) ArtifactFind(pipeline *model.Pipeline, n int64) (*model.Artifact, error) {
+	ret := _mock.Called(pipeline, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreatePipeline")
+		panic("no return value specified for ArtifactFind")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline, ...*model.Step) error); ok {
-		r0 = returnFunc(pipeline, steps...)
+	var r0 *model.Artifact
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline, int64) (*model.Artifact, error)); ok {
+		return returnFunc(pipeline, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline, int64) *model.Artifact); ok {
+		r0 = returnFunc(pipeline, n)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Artifact)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Pipeline, int64) error); ok {
+		r1 = returnFunc(pipeline, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_CreatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePipeline'
-type MockStore_CreatePipeline_Call struct {
+// MockStore_ArtifactFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtifactFind'
+type MockStore_ArtifactFind_Call struct {
 	*mock.Call
 }
 
-// CreatePipeline is a helper method to define mock.On call
+// ArtifactFind is a helper method to define mock.On call
 //   - pipeline *model.Pipeline
-//   - steps ...*model.Step
-func (_e *MockStore_Expecter) CreatePipeline(pipeline any, steps ...any) *MockStore_CreatePipeline_Call {
-	return &MockStore_CreatePipeline_Call{Call: _e.mock.On("CreatePipeline",
-		append([]any{pipeline}, steps...)...)}
+//   - n int64
+func (_e *MockStore_Expecter) ArtifactFind(pipeline any, n any) *MockStore_ArtifactFind_Call {
+	return &MockStore_ArtifactFind_Call{Call: _e.mock.On("ArtifactFind", pipeline, n)}
 }
 
-func (_c *MockStore_CreatePipeline_Call) Run(run func(pipeline *model.Pipeline, steps ...*model.Step)) *MockStore_CreatePipeline_Call {
+func (_c *MockStore_ArtifactFind_Call) Run(run func(pipeline *model.Pipeline, n int64)) *MockStore_ArtifactFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *model.Pipeline
 		if args[0] != nil {
 			arg0 = args[0].(*model.Pipeline)
 		}
-		var arg1 []*model.Step
-		var variadicArgs []*model.Step
-		if len(args) > 1 {
-			variadicArgs = args[1].([]*model.Step)
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
-		arg1 = variadicArgs
 		run(
 			arg0,
-			arg1...,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CreatePipeline_Call) Return(err error) *MockStore_CreatePipeline_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_ArtifactFind_Call) Return(artifact *model.Artifact, err error) *MockStore_ArtifactFind_Call {
+	_c.Call.Return(artifact, err)
 	return _c
 }
 
-func (_c *MockStore_CreatePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline, steps ...*model.Step) error) *MockStore_CreatePipeline_Call {
+func (_c *MockStore_ArtifactFind_Call) RunAndReturn(run func(pipeline *model.Pipeline, n int64) (*model.Artifact, error)) *MockStore_ArtifactFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRedirection provides a mock function for the type MockStore
-func (_mock *MockStore) CreateRedirection(redirection *model.Redirection) error {
-	ret := _mock.Called(redirection)
+// ArtifactList provides a mock function for the type MockStore
+func (_mock *MockStore) ArtifactList(pipeline *model.Pipeline) ([]*model.Artifact, error) {
+	ret := _mock.Called(pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRedirection")
+		panic("no return value specified for ArtifactList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Redirection) error); ok {
-		r0 = returnFunc(redirection)
+	var r0 []*model.Artifact
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) ([]*model.Artifact, error)); ok {
+		return returnFunc(pipeline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) []*model.Artifact); ok {
+		r0 = returnFunc(pipeline)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Artifact)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Pipeline) error); ok {
+		r1 = returnFunc(pipeline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_CreateRedirection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRedirection'
-type MockStore_CreateRedirection_Call struct {
+// MockStore_ArtifactList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtifactList'
+type MockStore_ArtifactList_Call struct {
 	*mock.Call
 }
 
-// CreateRedirection is a helper method to define mock.On call
-//   - redirection *model.Redirection
-func (_e *MockStore_Expecter) CreateRedirection(redirection any) *MockStore_CreateRedirection_Call {
-	return &MockStore_CreateRedirection_Call{Call: _e.mock.On("CreateRedirection", redirection)}
+// ArtifactList is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) ArtifactList(pipeline any) *MockStore_ArtifactList_Call {
+	return &MockStore_ArtifactList_Call{Call: _e.mock.On("ArtifactList", pipeline)}
 }
 
-func (_c *MockStore_CreateRedirection_Call) Run(run func(redirection *model.Redirection)) *MockStore_CreateRedirection_Call {
+func (_c *MockStore_ArtifactList_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_ArtifactList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Redirection
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.Redirection)
+			arg0 = args[0].(*model.Pipeline)
 		}
 		run(
 			arg0,
@@ -720,100 +796,117 @@ func (_c *MockStore_CreateRedirection_Call) Run(run func(redirection *model.Redi
 	return _c
 }
 
-func (_c *MockStore_CreateRedirection_Call) Return(err error) *MockStore_CreateRedirection_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_ArtifactList_Call) Return(artifacts []*model.Artifact, err error) *MockStore_ArtifactList_Call {
+	_c.Call.Return(artifacts, err)
 	return _c
 }
 
-func (_c *MockStore_CreateRedirection_Call) RunAndReturn(run func(redirection *model.Redirection) error) *MockStore_CreateRedirection_Call {
+func (_c *MockStore_ArtifactList_Call) RunAndReturn(run func(pipeline *model.Pipeline) ([]*model.Artifact, error)) *MockStore_ArtifactList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRepo provides a mock function for the type MockStore
-func (_mock *MockStore) CreateRepo(repo *model.Repo) error {
-	ret := _mock.Called(repo)
+// ArtifactListExpired provides a mock function for the type MockStore
+func (_mock *MockStore) ArtifactListExpired(before int64, limit int64) ([]*model.Artifact, error) {
+	ret := _mock.Called(before, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateRepo")
+		panic("no return value specified for ArtifactListExpired")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
-		r0 = returnFunc(repo)
+	var r0 []*model.Artifact
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*model.Artifact, error)); ok {
+		return returnFunc(before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*model.Artifact); ok {
+		r0 = returnFunc(before, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Artifact)
+		}
 	}
-	return r0
-}
-
-// MockStore_CreateRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRepo'
-type MockStore_CreateRepo_Call struct {
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ArtifactListExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArtifactListExpired'
+type MockStore_ArtifactListExpired_Call struct {
 	*mock.Call
 }
 
-// CreateRepo is a helper method to define mock.On call
-//   - repo *model.Repo
-func (_e *MockStore_Expecter) CreateRepo(repo any) *MockStore_CreateRepo_Call {
-	return &MockStore_CreateRepo_Call{Call: _e.mock.On("CreateRepo", repo)}
+// ArtifactListExpired is a helper method to define mock.On call
+//   - before int64
+//   - limit int64
+func (_e *MockStore_Expecter) ArtifactListExpired(before any, limit any) *MockStore_ArtifactListExpired_Call {
+	return &MockStore_ArtifactListExpired_Call{Call: _e.mock.On("ArtifactListExpired", before, limit)}
 }
 
-func (_c *MockStore_CreateRepo_Call) Run(run func(repo *model.Repo)) *MockStore_CreateRepo_Call {
+func (_c *MockStore_ArtifactListExpired_Call) Run(run func(before int64, limit int64)) *MockStore_ArtifactListExpired_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CreateRepo_Call) Return(err error) *MockStore_CreateRepo_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_ArtifactListExpired_Call) Return(artifacts []*model.Artifact, err error) *MockStore_ArtifactListExpired_Call {
+	_c.Call.Return(artifacts, err)
 	return _c
 }
 
-func (_c *MockStore_CreateRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_CreateRepo_Call {
+func (_c *MockStore_ArtifactListExpired_Call) RunAndReturn(run func(before int64, limit int64) ([]*model.Artifact, error)) *MockStore_ArtifactListExpired_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateUser provides a mock function for the type MockStore
-func (_mock *MockStore) CreateUser(user *model.User) error {
-	ret := _mock.Called(user)
+// AuditLogCreate provides a mock function for the type MockStore
+func (_mock *MockStore) AuditLogCreate(auditLog *model.AuditLog) error {
+	ret := _mock.Called(auditLog)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateUser")
+		panic("no return value specified for AuditLogCreate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
-		r0 = returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.AuditLog) error); ok {
+		r0 = returnFunc(auditLog)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
-type MockStore_CreateUser_Call struct {
+// MockStore_AuditLogCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogCreate'
+type MockStore_AuditLogCreate_Call struct {
 	*mock.Call
 }
 
-// CreateUser is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockStore_Expecter) CreateUser(user any) *MockStore_CreateUser_Call {
-	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", user)}
+// AuditLogCreate is a helper method to define mock.On call
+//   - auditLog *model.AuditLog
+func (_e *MockStore_Expecter) AuditLogCreate(auditLog any) *MockStore_AuditLogCreate_Call {
+	return &MockStore_AuditLogCreate_Call{Call: _e.mock.On("AuditLogCreate", auditLog)}
 }
 
-func (_c *MockStore_CreateUser_Call) Run(run func(user *model.User)) *MockStore_CreateUser_Call {
+func (_c *MockStore_AuditLogCreate_Call) Run(run func(auditLog *model.AuditLog)) *MockStore_AuditLogCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.AuditLog
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(*model.AuditLog)
 		}
 		run(
 			arg0,
@@ -822,484 +915,453 @@ func (_c *MockStore_CreateUser_Call) Run(run func(user *model.User)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) Return(err error) *MockStore_CreateUser_Call {
+func (_c *MockStore_AuditLogCreate_Call) Return(err error) *MockStore_AuditLogCreate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_CreateUser_Call {
+func (_c *MockStore_AuditLogCreate_Call) RunAndReturn(run func(auditLog *model.AuditLog) error) *MockStore_AuditLogCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronCreate provides a mock function for the type MockStore
-func (_mock *MockStore) CronCreate(cron *model.Cron) error {
-	ret := _mock.Called(cron)
+// AuditLogList provides a mock function for the type MockStore
+func (_mock *MockStore) AuditLogList(auditLogFilter *model.AuditLogFilter, listOptions *model.ListOptions) ([]*model.AuditLog, error) {
+	ret := _mock.Called(auditLogFilter, listOptions)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronCreate")
+		panic("no return value specified for AuditLogList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Cron) error); ok {
-		r0 = returnFunc(cron)
+	var r0 []*model.AuditLog
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.AuditLogFilter, *model.ListOptions) ([]*model.AuditLog, error)); ok {
+		return returnFunc(auditLogFilter, listOptions)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.AuditLogFilter, *model.ListOptions) []*model.AuditLog); ok {
+		r0 = returnFunc(auditLogFilter, listOptions)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditLog)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.AuditLogFilter, *model.ListOptions) error); ok {
+		r1 = returnFunc(auditLogFilter, listOptions)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_CronCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronCreate'
-type MockStore_CronCreate_Call struct {
+// MockStore_AuditLogList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogList'
+type MockStore_AuditLogList_Call struct {
 	*mock.Call
 }
 
-// CronCreate is a helper method to define mock.On call
-//   - cron *model.Cron
-func (_e *MockStore_Expecter) CronCreate(cron any) *MockStore_CronCreate_Call {
-	return &MockStore_CronCreate_Call{Call: _e.mock.On("CronCreate", cron)}
+// AuditLogList is a helper method to define mock.On call
+//   - auditLogFilter *model.AuditLogFilter
+//   - listOptions *model.ListOptions
+func (_e *MockStore_Expecter) AuditLogList(auditLogFilter any, listOptions any) *MockStore_AuditLogList_Call {
+	return &MockStore_AuditLogList_Call{Call: _e.mock.On("AuditLogList", auditLogFilter, listOptions)}
 }
 
-func (_c *MockStore_CronCreate_Call) Run(run func(cron *model.Cron)) *MockStore_CronCreate_Call {
+func (_c *MockStore_AuditLogList_Call) Run(run func(auditLogFilter *model.AuditLogFilter, listOptions *model.ListOptions)) *MockStore_AuditLogList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Cron
+		var arg0 *model.AuditLogFilter
 		if args[0] != nil {
-			arg0 = args[0].(*model.Cron)
+			arg0 = args[0].(*model.AuditLogFilter)
+		}
+		var arg1 *model.ListOptions
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptions)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronCreate_Call) Return(err error) *MockStore_CronCreate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_AuditLogList_Call) Return(r0 []*model.AuditLog, err error) *MockStore_AuditLogList_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_CronCreate_Call) RunAndReturn(run func(cron *model.Cron) error) *MockStore_CronCreate_Call {
+func (_c *MockStore_AuditLogList_Call) RunAndReturn(run func(auditLogFilter *model.AuditLogFilter, listOptions *model.ListOptions) ([]*model.AuditLog, error)) *MockStore_AuditLogList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronDelete provides a mock function for the type MockStore
-func (_mock *MockStore) CronDelete(repo *model.Repo, n int64) error {
-	ret := _mock.Called(repo, n)
+// Close provides a mock function for the type MockStore
+func (_mock *MockStore) Close() error {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronDelete")
+		panic("no return value specified for Close")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) error); ok {
-		r0 = returnFunc(repo, n)
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_CronDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronDelete'
-type MockStore_CronDelete_Call struct {
+// MockStore_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockStore_Close_Call struct {
 	*mock.Call
 }
 
-// CronDelete is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - n int64
-func (_e *MockStore_Expecter) CronDelete(repo any, n any) *MockStore_CronDelete_Call {
-	return &MockStore_CronDelete_Call{Call: _e.mock.On("CronDelete", repo, n)}
+// Close is a helper method to define mock.On call
+func (_e *MockStore_Expecter) Close() *MockStore_Close_Call {
+	return &MockStore_Close_Call{Call: _e.mock.On("Close")}
 }
 
-func (_c *MockStore_CronDelete_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_CronDelete_Call {
+func (_c *MockStore_Close_Call) Run(run func()) *MockStore_Close_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
-		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_CronDelete_Call) Return(err error) *MockStore_CronDelete_Call {
+func (_c *MockStore_Close_Call) Return(err error) *MockStore_Close_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_CronDelete_Call) RunAndReturn(run func(repo *model.Repo, n int64) error) *MockStore_CronDelete_Call {
+func (_c *MockStore_Close_Call) RunAndReturn(run func() error) *MockStore_Close_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronFind provides a mock function for the type MockStore
-func (_mock *MockStore) CronFind(repo *model.Repo, n int64) (*model.Cron, error) {
-	ret := _mock.Called(repo, n)
+// ConfigPersist provides a mock function for the type MockStore
+func (_mock *MockStore) ConfigPersist(config *model.Config) (*model.Config, error) {
+	ret := _mock.Called(config)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronFind")
+		panic("no return value specified for ConfigPersist")
 	}
 
-	var r0 *model.Cron
+	var r0 *model.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) (*model.Cron, error)); ok {
-		return returnFunc(repo, n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Config) (*model.Config, error)); ok {
+		return returnFunc(config)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) *model.Cron); ok {
-		r0 = returnFunc(repo, n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Config) *model.Config); ok {
+		r0 = returnFunc(config)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Cron)
+			r0 = ret.Get(0).(*model.Config)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64) error); ok {
-		r1 = returnFunc(repo, n)
+	if returnFunc, ok := ret.Get(1).(func(*model.Config) error); ok {
+		r1 = returnFunc(config)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_CronFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronFind'
-type MockStore_CronFind_Call struct {
+// MockStore_ConfigPersist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigPersist'
+type MockStore_ConfigPersist_Call struct {
 	*mock.Call
 }
 
-// CronFind is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - n int64
-func (_e *MockStore_Expecter) CronFind(repo any, n any) *MockStore_CronFind_Call {
-	return &MockStore_CronFind_Call{Call: _e.mock.On("CronFind", repo, n)}
+// ConfigPersist is a helper method to define mock.On call
+//   - config *model.Config
+func (_e *MockStore_Expecter) ConfigPersist(config any) *MockStore_ConfigPersist_Call {
+	return &MockStore_ConfigPersist_Call{Call: _e.mock.On("ConfigPersist", config)}
 }
 
-func (_c *MockStore_CronFind_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_CronFind_Call {
+func (_c *MockStore_ConfigPersist_Call) Run(run func(config *model.Config)) *MockStore_ConfigPersist_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Config
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg0 = args[0].(*model.Config)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronFind_Call) Return(cron *model.Cron, err error) *MockStore_CronFind_Call {
-	_c.Call.Return(cron, err)
+func (_c *MockStore_ConfigPersist_Call) Return(config1 *model.Config, err error) *MockStore_ConfigPersist_Call {
+	_c.Call.Return(config1, err)
 	return _c
 }
 
-func (_c *MockStore_CronFind_Call) RunAndReturn(run func(repo *model.Repo, n int64) (*model.Cron, error)) *MockStore_CronFind_Call {
+func (_c *MockStore_ConfigPersist_Call) RunAndReturn(run func(config *model.Config) (*model.Config, error)) *MockStore_ConfigPersist_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronGetLock provides a mock function for the type MockStore
-func (_mock *MockStore) CronGetLock(cron *model.Cron, n int64) (bool, error) {
-	ret := _mock.Called(cron, n)
+// ConfigsForPipeline provides a mock function for the type MockStore
+func (_mock *MockStore) ConfigsForPipeline(pipelineID int64) ([]*model.Config, error) {
+	ret := _mock.Called(pipelineID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronGetLock")
+		panic("no return value specified for ConfigsForPipeline")
 	}
 
-	var r0 bool
+	var r0 []*model.Config
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Cron, int64) (bool, error)); ok {
-		return returnFunc(cron, n)
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*model.Config, error)); ok {
+		return returnFunc(pipelineID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Cron, int64) bool); ok {
-		r0 = returnFunc(cron, n)
+	if returnFunc, ok := ret.Get(0).(func(int64) []*model.Config); ok {
+		r0 = returnFunc(pipelineID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Config)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Cron, int64) error); ok {
-		r1 = returnFunc(cron, n)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(pipelineID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_CronGetLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronGetLock'
-type MockStore_CronGetLock_Call struct {
+// MockStore_ConfigsForPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigsForPipeline'
+type MockStore_ConfigsForPipeline_Call struct {
 	*mock.Call
 }
 
-// CronGetLock is a helper method to define mock.On call
-//   - cron *model.Cron
-//   - n int64
-func (_e *MockStore_Expecter) CronGetLock(cron any, n any) *MockStore_CronGetLock_Call {
-	return &MockStore_CronGetLock_Call{Call: _e.mock.On("CronGetLock", cron, n)}
+// ConfigsForPipeline is a helper method to define mock.On call
+//   - pipelineID int64
+func (_e *MockStore_Expecter) ConfigsForPipeline(pipelineID any) *MockStore_ConfigsForPipeline_Call {
+	return &MockStore_ConfigsForPipeline_Call{Call: _e.mock.On("ConfigsForPipeline", pipelineID)}
 }
 
-func (_c *MockStore_CronGetLock_Call) Run(run func(cron *model.Cron, n int64)) *MockStore_CronGetLock_Call {
+func (_c *MockStore_ConfigsForPipeline_Call) Run(run func(pipelineID int64)) *MockStore_ConfigsForPipeline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Cron
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Cron)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronGetLock_Call) Return(b bool, err error) *MockStore_CronGetLock_Call {
-	_c.Call.Return(b, err)
+func (_c *MockStore_ConfigsForPipeline_Call) Return(configs []*model.Config, err error) *MockStore_ConfigsForPipeline_Call {
+	_c.Call.Return(configs, err)
 	return _c
 }
 
-func (_c *MockStore_CronGetLock_Call) RunAndReturn(run func(cron *model.Cron, n int64) (bool, error)) *MockStore_CronGetLock_Call {
+func (_c *MockStore_ConfigsForPipeline_Call) RunAndReturn(run func(pipelineID int64) ([]*model.Config, error)) *MockStore_ConfigsForPipeline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronList provides a mock function for the type MockStore
-func (_mock *MockStore) CronList(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Cron, error) {
-	ret := _mock.Called(repo, listOptionsWithAll)
+// CreatePipeline provides a mock function for the type MockStore
+func (_mock *MockStore) CreatePipeline(pipeline *model.Pipeline, steps ...*model.Step) error {
+	var tmpRet mock.Arguments
+	if len(steps) > 0 {
+		tmpRet = _mock.Called(pipeline, steps)
+	} else {
+		tmpRet = _mock.Called(pipeline)
+	}
+	ret := tmpRet
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronList")
+		panic("no return value specified for CreatePipeline")
 	}
 
-	var r0 []*model.Cron
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll) ([]*model.Cron, error)); ok {
-		return returnFunc(repo, listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll) []*model.Cron); ok {
-		r0 = returnFunc(repo, listOptionsWithAll)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline, ...*model.Step) error); ok {
+		r0 = returnFunc(pipeline, steps...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Cron)
-		}
+		r0 = ret.Error(0)
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(repo, listOptionsWithAll)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_CronList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronList'
-type MockStore_CronList_Call struct {
+// MockStore_CreatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePipeline'
+type MockStore_CreatePipeline_Call struct {
 	*mock.Call
 }
 
-// CronList is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) CronList(repo any, listOptionsWithAll any) *MockStore_CronList_Call {
-	return &MockStore_CronList_Call{Call: _e.mock.On("CronList", repo, listOptionsWithAll)}
+// CreatePipeline is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+//   - steps ...*model.Step
+func (_e *MockStore_Expecter) CreatePipeline(pipeline any, steps ...any) *MockStore_CreatePipeline_Call {
+	return &MockStore_CreatePipeline_Call{Call: _e.mock.On("CreatePipeline",
+		append([]any{pipeline}, steps...)...)}
 }
 
-func (_c *MockStore_CronList_Call) Run(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_CronList_Call {
+func (_c *MockStore_CreatePipeline_Call) Run(run func(pipeline *model.Pipeline, steps ...*model.Step)) *MockStore_CreatePipeline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
+			arg0 = args[0].(*model.Pipeline)
 		}
-		var arg1 *model.ListOptionsWithAll
-		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
+		var arg1 []*model.Step
+		var variadicArgs []*model.Step
+		if len(args) > 1 {
+			variadicArgs = args[1].([]*model.Step)
 		}
+		arg1 = variadicArgs
 		run(
 			arg0,
-			arg1,
+			arg1...,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronList_Call) Return(crons []*model.Cron, err error) *MockStore_CronList_Call {
-	_c.Call.Return(crons, err)
+func (_c *MockStore_CreatePipeline_Call) Return(err error) *MockStore_CreatePipeline_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_CronList_Call) RunAndReturn(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Cron, error)) *MockStore_CronList_Call {
+func (_c *MockStore_CreatePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline, steps ...*model.Step) error) *MockStore_CreatePipeline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronListNextExecute provides a mock function for the type MockStore
-func (_mock *MockStore) CronListNextExecute(n int64, n1 int64) ([]*model.Cron, error) {
-	ret := _mock.Called(n, n1)
+// CreateRedirection provides a mock function for the type MockStore
+func (_mock *MockStore) CreateRedirection(redirection *model.Redirection) error {
+	ret := _mock.Called(redirection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronListNextExecute")
+		panic("no return value specified for CreateRedirection")
 	}
 
-	var r0 []*model.Cron
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*model.Cron, error)); ok {
-		return returnFunc(n, n1)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*model.Cron); ok {
-		r0 = returnFunc(n, n1)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Cron)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
-		r1 = returnFunc(n, n1)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Redirection) error); ok {
+		r0 = returnFunc(redirection)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_CronListNextExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronListNextExecute'
-type MockStore_CronListNextExecute_Call struct {
+// MockStore_CreateRedirection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRedirection'
+type MockStore_CreateRedirection_Call struct {
 	*mock.Call
 }
 
-// CronListNextExecute is a helper method to define mock.On call
-//   - n int64
-//   - n1 int64
-func (_e *MockStore_Expecter) CronListNextExecute(n any, n1 any) *MockStore_CronListNextExecute_Call {
-	return &MockStore_CronListNextExecute_Call{Call: _e.mock.On("CronListNextExecute", n, n1)}
+// CreateRedirection is a helper method to define mock.On call
+//   - redirection *model.Redirection
+func (_e *MockStore_Expecter) CreateRedirection(redirection any) *MockStore_CreateRedirection_Call {
+	return &MockStore_CreateRedirection_Call{Call: _e.mock.On("CreateRedirection", redirection)}
 }
 
-func (_c *MockStore_CronListNextExecute_Call) Run(run func(n int64, n1 int64)) *MockStore_CronListNextExecute_Call {
+func (_c *MockStore_CreateRedirection_Call) Run(run func(redirection *model.Redirection)) *MockStore_CreateRedirection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Redirection
 		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg0 = args[0].(*model.Redirection)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronListNextExecute_Call) Return(crons []*model.Cron, err error) *MockStore_CronListNextExecute_Call {
-	_c.Call.Return(crons, err)
+func (_c *MockStore_CreateRedirection_Call) Return(err error) *MockStore_CreateRedirection_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_CronListNextExecute_Call) RunAndReturn(run func(n int64, n1 int64) ([]*model.Cron, error)) *MockStore_CronListNextExecute_Call {
+func (_c *MockStore_CreateRedirection_Call) RunAndReturn(run func(redirection *model.Redirection) error) *MockStore_CreateRedirection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CronUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) CronUpdate(repo *model.Repo, cron *model.Cron) error {
-	ret := _mock.Called(repo, cron)
+// CreateRepo provides a mock function for the type MockStore
+func (_mock *MockStore) CreateRepo(repo *model.Repo) error {
+	ret := _mock.Called(repo)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CronUpdate")
+		panic("no return value specified for CreateRepo")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.Cron) error); ok {
-		r0 = returnFunc(repo, cron)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
+		r0 = returnFunc(repo)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_CronUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronUpdate'
-type MockStore_CronUpdate_Call struct {
+// MockStore_CreateRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRepo'
+type MockStore_CreateRepo_Call struct {
 	*mock.Call
 }
 
-// CronUpdate is a helper method to define mock.On call
+// CreateRepo is a helper method to define mock.On call
 //   - repo *model.Repo
-//   - cron *model.Cron
-func (_e *MockStore_Expecter) CronUpdate(repo any, cron any) *MockStore_CronUpdate_Call {
-	return &MockStore_CronUpdate_Call{Call: _e.mock.On("CronUpdate", repo, cron)}
+func (_e *MockStore_Expecter) CreateRepo(repo any) *MockStore_CreateRepo_Call {
+	return &MockStore_CreateRepo_Call{Call: _e.mock.On("CreateRepo", repo)}
 }
 
-func (_c *MockStore_CronUpdate_Call) Run(run func(repo *model.Repo, cron *model.Cron)) *MockStore_CronUpdate_Call {
+func (_c *MockStore_CreateRepo_Call) Run(run func(repo *model.Repo)) *MockStore_CreateRepo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *model.Repo
 		if args[0] != nil {
 			arg0 = args[0].(*model.Repo)
 		}
-		var arg1 *model.Cron
-		if args[1] != nil {
-			arg1 = args[1].(*model.Cron)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_CronUpdate_Call) Return(err error) *MockStore_CronUpdate_Call {
+func (_c *MockStore_CreateRepo_Call) Return(err error) *MockStore_CreateRepo_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_CronUpdate_Call) RunAndReturn(run func(repo *model.Repo, cron *model.Cron) error) *MockStore_CronUpdate_Call {
+func (_c *MockStore_CreateRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_CreateRepo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeletePipeline provides a mock function for the type MockStore
-func (_mock *MockStore) DeletePipeline(pipeline *model.Pipeline) error {
-	ret := _mock.Called(pipeline)
+// CreateUser provides a mock function for the type MockStore
+func (_mock *MockStore) CreateUser(user *model.User) error {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeletePipeline")
+		panic("no return value specified for CreateUser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) error); ok {
-		r0 = returnFunc(pipeline)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
+		r0 = returnFunc(user)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_DeletePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePipeline'
-type MockStore_DeletePipeline_Call struct {
+// MockStore_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type MockStore_CreateUser_Call struct {
 	*mock.Call
 }
 
-// DeletePipeline is a helper method to define mock.On call
-//   - pipeline *model.Pipeline
-func (_e *MockStore_Expecter) DeletePipeline(pipeline any) *MockStore_DeletePipeline_Call {
-	return &MockStore_DeletePipeline_Call{Call: _e.mock.On("DeletePipeline", pipeline)}
+// CreateUser is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) CreateUser(user any) *MockStore_CreateUser_Call {
+	return &MockStore_CreateUser_Call{Call: _e.mock.On("CreateUser", user)}
 }
 
-func (_c *MockStore_DeletePipeline_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_DeletePipeline_Call {
+func (_c *MockStore_CreateUser_Call) Run(run func(user *model.User)) *MockStore_CreateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Pipeline
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(*model.Pipeline)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
@@ -1308,49 +1370,49 @@ func (_c *MockStore_DeletePipeline_Call) Run(run func(pipeline *model.Pipeline))
 	return _c
 }
 
-func (_c *MockStore_DeletePipeline_Call) Return(err error) *MockStore_DeletePipeline_Call {
+func (_c *MockStore_CreateUser_Call) Return(err error) *MockStore_CreateUser_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_DeletePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline) error) *MockStore_DeletePipeline_Call {
+func (_c *MockStore_CreateUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_CreateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRepo provides a mock function for the type MockStore
-func (_mock *MockStore) DeleteRepo(repo *model.Repo) error {
-	ret := _mock.Called(repo)
+// CronCreate provides a mock function for the type MockStore
+func (_mock *MockStore) CronCreate(cron *model.Cron) error {
+	ret := _mock.Called(cron)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRepo")
+		panic("no return value specified for CronCreate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
-		r0 = returnFunc(repo)
+	if returnFunc, ok := ret.Get(0).(func(*model.Cron) error); ok {
+		r0 = returnFunc(cron)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_DeleteRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRepo'
-type MockStore_DeleteRepo_Call struct {
+// MockStore_CronCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronCreate'
+type MockStore_CronCreate_Call struct {
 	*mock.Call
 }
 
-// DeleteRepo is a helper method to define mock.On call
-//   - repo *model.Repo
-func (_e *MockStore_Expecter) DeleteRepo(repo any) *MockStore_DeleteRepo_Call {
-	return &MockStore_DeleteRepo_Call{Call: _e.mock.On("DeleteRepo", repo)}
+// CronCreate is a helper method to define mock.On call
+//   - cron *model.Cron
+func (_e *MockStore_Expecter) CronCreate(cron any) *MockStore_CronCreate_Call {
+	return &MockStore_CronCreate_Call{Call: _e.mock.On("CronCreate", cron)}
 }
 
-func (_c *MockStore_DeleteRepo_Call) Run(run func(repo *model.Repo)) *MockStore_DeleteRepo_Call {
+func (_c *MockStore_CronCreate_Call) Run(run func(cron *model.Cron)) *MockStore_CronCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Cron
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
+			arg0 = args[0].(*model.Cron)
 		}
 		run(
 			arg0,
@@ -1359,450 +1421,4054 @@ func (_c *MockStore_DeleteRepo_Call) Run(run func(repo *model.Repo)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_DeleteRepo_Call) Return(err error) *MockStore_DeleteRepo_Call {
+func (_c *MockStore_CronCreate_Call) Return(err error) *MockStore_CronCreate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_DeleteRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_DeleteRepo_Call {
+func (_c *MockStore_CronCreate_Call) RunAndReturn(run func(cron *model.Cron) error) *MockStore_CronCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteUser provides a mock function for the type MockStore
-func (_mock *MockStore) DeleteUser(user *model.User) error {
-	ret := _mock.Called(user)
+// CronDelete provides a mock function for the type MockStore
+func (_mock *MockStore) CronDelete(repo *model.Repo, n int64) error {
+	ret := _mock.Called(repo, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteUser")
+		panic("no return value specified for CronDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
-		r0 = returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) error); ok {
+		r0 = returnFunc(repo, n)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
-type MockStore_DeleteUser_Call struct {
+// MockStore_CronDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronDelete'
+type MockStore_CronDelete_Call struct {
 	*mock.Call
 }
 
-// DeleteUser is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockStore_Expecter) DeleteUser(user any) *MockStore_DeleteUser_Call {
-	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", user)}
+// CronDelete is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - n int64
+func (_e *MockStore_Expecter) CronDelete(repo any, n any) *MockStore_CronDelete_Call {
+	return &MockStore_CronDelete_Call{Call: _e.mock.On("CronDelete", repo, n)}
 }
 
-func (_c *MockStore_DeleteUser_Call) Run(run func(user *model.User)) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CronDelete_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_CronDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) Return(err error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CronDelete_Call) Return(err error) *MockStore_CronDelete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_DeleteUser_Call {
+func (_c *MockStore_CronDelete_Call) RunAndReturn(run func(repo *model.Repo, n int64) error) *MockStore_CronDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ForgeCreate provides a mock function for the type MockStore
-func (_mock *MockStore) ForgeCreate(forge *model.Forge) error {
-	ret := _mock.Called(forge)
+// CronFind provides a mock function for the type MockStore
+func (_mock *MockStore) CronFind(repo *model.Repo, n int64) (*model.Cron, error) {
+	ret := _mock.Called(repo, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ForgeCreate")
+		panic("no return value specified for CronFind")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
-		r0 = returnFunc(forge)
-	} else {
-		r0 = ret.Error(0)
+	var r0 *model.Cron
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) (*model.Cron, error)); ok {
+		return returnFunc(repo, n)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) *model.Cron); ok {
+		r0 = returnFunc(repo, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cron)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64) error); ok {
+		r1 = returnFunc(repo, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_ForgeCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeCreate'
-type MockStore_ForgeCreate_Call struct {
+// MockStore_CronFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronFind'
+type MockStore_CronFind_Call struct {
 	*mock.Call
 }
 
-// ForgeCreate is a helper method to define mock.On call
-//   - forge *model.Forge
-func (_e *MockStore_Expecter) ForgeCreate(forge any) *MockStore_ForgeCreate_Call {
-	return &MockStore_ForgeCreate_Call{Call: _e.mock.On("ForgeCreate", forge)}
+// CronFind is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - n int64
+func (_e *MockStore_Expecter) CronFind(repo any, n any) *MockStore_CronFind_Call {
+	return &MockStore_CronFind_Call{Call: _e.mock.On("CronFind", repo, n)}
 }
 
-func (_c *MockStore_ForgeCreate_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeCreate_Call {
+func (_c *MockStore_CronFind_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_CronFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Forge
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(*model.Forge)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ForgeCreate_Call) Return(err error) *MockStore_ForgeCreate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_CronFind_Call) Return(cron *model.Cron, err error) *MockStore_CronFind_Call {
+	_c.Call.Return(cron, err)
 	return _c
 }
 
-func (_c *MockStore_ForgeCreate_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeCreate_Call {
+func (_c *MockStore_CronFind_Call) RunAndReturn(run func(repo *model.Repo, n int64) (*model.Cron, error)) *MockStore_CronFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ForgeDelete provides a mock function for the type MockStore
-func (_mock *MockStore) ForgeDelete(forge *model.Forge) error {
-	ret := _mock.Called(forge)
+// CronGetLock provides a mock function for the type MockStore
+func (_mock *MockStore) CronGetLock(cron *model.Cron, n int64) (bool, error) {
+	ret := _mock.Called(cron, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ForgeDelete")
+		panic("no return value specified for CronGetLock")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
-		r0 = returnFunc(forge)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Cron, int64) (bool, error)); ok {
+		return returnFunc(cron, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Cron, int64) bool); ok {
+		r0 = returnFunc(cron, n)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Cron, int64) error); ok {
+		r1 = returnFunc(cron, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_ForgeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeDelete'
-type MockStore_ForgeDelete_Call struct {
+// MockStore_CronGetLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronGetLock'
+type MockStore_CronGetLock_Call struct {
 	*mock.Call
 }
 
-// ForgeDelete is a helper method to define mock.On call
-//   - forge *model.Forge
-func (_e *MockStore_Expecter) ForgeDelete(forge any) *MockStore_ForgeDelete_Call {
-	return &MockStore_ForgeDelete_Call{Call: _e.mock.On("ForgeDelete", forge)}
+// CronGetLock is a helper method to define mock.On call
+//   - cron *model.Cron
+//   - n int64
+func (_e *MockStore_Expecter) CronGetLock(cron any, n any) *MockStore_CronGetLock_Call {
+	return &MockStore_CronGetLock_Call{Call: _e.mock.On("CronGetLock", cron, n)}
 }
 
-func (_c *MockStore_ForgeDelete_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeDelete_Call {
+func (_c *MockStore_CronGetLock_Call) Run(run func(cron *model.Cron, n int64)) *MockStore_CronGetLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Forge
+		var arg0 *model.Cron
 		if args[0] != nil {
-			arg0 = args[0].(*model.Forge)
+			arg0 = args[0].(*model.Cron)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ForgeDelete_Call) Return(err error) *MockStore_ForgeDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_CronGetLock_Call) Return(b bool, err error) *MockStore_CronGetLock_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockStore_ForgeDelete_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeDelete_Call {
+func (_c *MockStore_CronGetLock_Call) RunAndReturn(run func(cron *model.Cron, n int64) (bool, error)) *MockStore_CronGetLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ForgeGet provides a mock function for the type MockStore
-func (_mock *MockStore) ForgeGet(n int64) (*model.Forge, error) {
-	ret := _mock.Called(n)
+// CronList provides a mock function for the type MockStore
+func (_mock *MockStore) CronList(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Cron, error) {
+	ret := _mock.Called(repo, listOptionsWithAll)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ForgeGet")
+		panic("no return value specified for CronList")
 	}
 
-	var r0 *model.Forge
+	var r0 []*model.Cron
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Forge, error)); ok {
-		return returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll) ([]*model.Cron, error)); ok {
+		return returnFunc(repo, listOptionsWithAll)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *model.Forge); ok {
-		r0 = returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll) []*model.Cron); ok {
+		r0 = returnFunc(repo, listOptionsWithAll)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Forge)
+			r0 = ret.Get(0).([]*model.Cron)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(n)
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(repo, listOptionsWithAll)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_ForgeGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeGet'
-type MockStore_ForgeGet_Call struct {
+// MockStore_CronList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronList'
+type MockStore_CronList_Call struct {
 	*mock.Call
 }
 
-// ForgeGet is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) ForgeGet(n any) *MockStore_ForgeGet_Call {
-	return &MockStore_ForgeGet_Call{Call: _e.mock.On("ForgeGet", n)}
+// CronList is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) CronList(repo any, listOptionsWithAll any) *MockStore_CronList_Call {
+	return &MockStore_CronList_Call{Call: _e.mock.On("CronList", repo, listOptionsWithAll)}
 }
 
-func (_c *MockStore_ForgeGet_Call) Run(run func(n int64)) *MockStore_ForgeGet_Call {
+func (_c *MockStore_CronList_Call) Run(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_CronList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 *model.ListOptionsWithAll
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptionsWithAll)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ForgeGet_Call) Return(forge *model.Forge, err error) *MockStore_ForgeGet_Call {
-	_c.Call.Return(forge, err)
+func (_c *MockStore_CronList_Call) Return(crons []*model.Cron, err error) *MockStore_CronList_Call {
+	_c.Call.Return(crons, err)
 	return _c
 }
 
-func (_c *MockStore_ForgeGet_Call) RunAndReturn(run func(n int64) (*model.Forge, error)) *MockStore_ForgeGet_Call {
+func (_c *MockStore_CronList_Call) RunAndReturn(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Cron, error)) *MockStore_CronList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ForgeList provides a mock function for the type MockStore
-func (_mock *MockStore) ForgeList(p *model.ListOptionsWithAll) ([]*model.Forge, error) {
-	ret := _mock.Called(p)
+// CronListNextExecute provides a mock function for the type MockStore
+func (_mock *MockStore) CronListNextExecute(n int64, n1 int64) ([]*model.Cron, error) {
+	ret := _mock.Called(n, n1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ForgeList")
+		panic("no return value specified for CronListNextExecute")
 	}
 
-	var r0 []*model.Forge
+	var r0 []*model.Cron
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Forge, error)); ok {
-		return returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*model.Cron, error)); ok {
+		return returnFunc(n, n1)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Forge); ok {
-		r0 = returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*model.Cron); ok {
+		r0 = returnFunc(n, n1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Forge)
+			r0 = ret.Get(0).([]*model.Cron)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(p)
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(n, n1)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_ForgeList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeList'
-type MockStore_ForgeList_Call struct {
+// MockStore_CronListNextExecute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronListNextExecute'
+type MockStore_CronListNextExecute_Call struct {
 	*mock.Call
 }
 
-// ForgeList is a helper method to define mock.On call
-//   - p *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) ForgeList(p any) *MockStore_ForgeList_Call {
-	return &MockStore_ForgeList_Call{Call: _e.mock.On("ForgeList", p)}
+// CronListNextExecute is a helper method to define mock.On call
+//   - n int64
+//   - n1 int64
+func (_e *MockStore_Expecter) CronListNextExecute(n any, n1 any) *MockStore_CronListNextExecute_Call {
+	return &MockStore_CronListNextExecute_Call{Call: _e.mock.On("CronListNextExecute", n, n1)}
 }
 
-func (_c *MockStore_ForgeList_Call) Run(run func(p *model.ListOptionsWithAll)) *MockStore_ForgeList_Call {
+func (_c *MockStore_CronListNextExecute_Call) Run(run func(n int64, n1 int64)) *MockStore_CronListNextExecute_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.ListOptionsWithAll
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.ListOptionsWithAll)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ForgeList_Call) Return(forges []*model.Forge, err error) *MockStore_ForgeList_Call {
-	_c.Call.Return(forges, err)
+func (_c *MockStore_CronListNextExecute_Call) Return(crons []*model.Cron, err error) *MockStore_CronListNextExecute_Call {
+	_c.Call.Return(crons, err)
 	return _c
 }
 
-func (_c *MockStore_ForgeList_Call) RunAndReturn(run func(p *model.ListOptionsWithAll) ([]*model.Forge, error)) *MockStore_ForgeList_Call {
+func (_c *MockStore_CronListNextExecute_Call) RunAndReturn(run func(n int64, n1 int64) ([]*model.Cron, error)) *MockStore_CronListNextExecute_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ForgeUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) ForgeUpdate(forge *model.Forge) error {
-	ret := _mock.Called(forge)
+// CronUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) CronUpdate(repo *model.Repo, cron *model.Cron) error {
+	ret := _mock.Called(repo, cron)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ForgeUpdate")
+		panic("no return value specified for CronUpdate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
-		r0 = returnFunc(forge)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.Cron) error); ok {
+		r0 = returnFunc(repo, cron)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_ForgeUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeUpdate'
-type MockStore_ForgeUpdate_Call struct {
+// MockStore_CronUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CronUpdate'
+type MockStore_CronUpdate_Call struct {
 	*mock.Call
 }
 
-// ForgeUpdate is a helper method to define mock.On call
-//   - forge *model.Forge
-func (_e *MockStore_Expecter) ForgeUpdate(forge any) *MockStore_ForgeUpdate_Call {
-	return &MockStore_ForgeUpdate_Call{Call: _e.mock.On("ForgeUpdate", forge)}
+// CronUpdate is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - cron *model.Cron
+func (_e *MockStore_Expecter) CronUpdate(repo any, cron any) *MockStore_CronUpdate_Call {
+	return &MockStore_CronUpdate_Call{Call: _e.mock.On("CronUpdate", repo, cron)}
 }
 
-func (_c *MockStore_ForgeUpdate_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeUpdate_Call {
+func (_c *MockStore_CronUpdate_Call) Run(run func(repo *model.Repo, cron *model.Cron)) *MockStore_CronUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Forge
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(*model.Forge)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 *model.Cron
+		if args[1] != nil {
+			arg1 = args[1].(*model.Cron)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ForgeUpdate_Call) Return(err error) *MockStore_ForgeUpdate_Call {
+func (_c *MockStore_CronUpdate_Call) Return(err error) *MockStore_CronUpdate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_ForgeUpdate_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeUpdate_Call {
+func (_c *MockStore_CronUpdate_Call) RunAndReturn(run func(repo *model.Repo, cron *model.Cron) error) *MockStore_CronUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetActivePipelineList provides a mock function for the type MockStore
-func (_mock *MockStore) GetActivePipelineList(repo *model.Repo) ([]*model.Pipeline, error) {
-	ret := _mock.Called(repo)
+// DeletePipeline provides a mock function for the type MockStore
+func (_mock *MockStore) DeletePipeline(pipeline *model.Pipeline) error {
+	ret := _mock.Called(pipeline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePipeline")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) error); ok {
+		r0 = returnFunc(pipeline)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeletePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePipeline'
+type MockStore_DeletePipeline_Call struct {
+	*mock.Call
+}
+
+// DeletePipeline is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) DeletePipeline(pipeline any) *MockStore_DeletePipeline_Call {
+	return &MockStore_DeletePipeline_Call{Call: _e.mock.On("DeletePipeline", pipeline)}
+}
+
+func (_c *MockStore_DeletePipeline_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_DeletePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Pipeline
+		if args[0] != nil {
+			arg0 = args[0].(*model.Pipeline)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeletePipeline_Call) Return(err error) *MockStore_DeletePipeline_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeletePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline) error) *MockStore_DeletePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRepo provides a mock function for the type MockStore
+func (_mock *MockStore) DeleteRepo(repo *model.Repo) error {
+	ret := _mock.Called(repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRepo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
+		r0 = returnFunc(repo)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeleteRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRepo'
+type MockStore_DeleteRepo_Call struct {
+	*mock.Call
+}
+
+// DeleteRepo is a helper method to define mock.On call
+//   - repo *model.Repo
+func (_e *MockStore_Expecter) DeleteRepo(repo any) *MockStore_DeleteRepo_Call {
+	return &MockStore_DeleteRepo_Call{Call: _e.mock.On("DeleteRepo", repo)}
+}
+
+func (_c *MockStore_DeleteRepo_Call) Run(run func(repo *model.Repo)) *MockStore_DeleteRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteRepo_Call) Return(err error) *MockStore_DeleteRepo_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeleteRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_DeleteRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type MockStore
+func (_mock *MockStore) DeleteUser(user *model.User) error {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockStore_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) DeleteUser(user any) *MockStore_DeleteUser_Call {
+	return &MockStore_DeleteUser_Call{Call: _e.mock.On("DeleteUser", user)}
+}
+
+func (_c *MockStore_DeleteUser_Call) Run(run func(user *model.User)) *MockStore_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) Return(err error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_DeleteUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgeCreate provides a mock function for the type MockStore
+func (_mock *MockStore) ForgeCreate(forge *model.Forge) error {
+	ret := _mock.Called(forge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgeCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
+		r0 = returnFunc(forge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ForgeCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeCreate'
+type MockStore_ForgeCreate_Call struct {
+	*mock.Call
+}
+
+// ForgeCreate is a helper method to define mock.On call
+//   - forge *model.Forge
+func (_e *MockStore_Expecter) ForgeCreate(forge any) *MockStore_ForgeCreate_Call {
+	return &MockStore_ForgeCreate_Call{Call: _e.mock.On("ForgeCreate", forge)}
+}
+
+func (_c *MockStore_ForgeCreate_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Forge
+		if args[0] != nil {
+			arg0 = args[0].(*model.Forge)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ForgeCreate_Call) Return(err error) *MockStore_ForgeCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ForgeCreate_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgeDelete provides a mock function for the type MockStore
+func (_mock *MockStore) ForgeDelete(forge *model.Forge) error {
+	ret := _mock.Called(forge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgeDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
+		r0 = returnFunc(forge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ForgeDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeDelete'
+type MockStore_ForgeDelete_Call struct {
+	*mock.Call
+}
+
+// ForgeDelete is a helper method to define mock.On call
+//   - forge *model.Forge
+func (_e *MockStore_Expecter) ForgeDelete(forge any) *MockStore_ForgeDelete_Call {
+	return &MockStore_ForgeDelete_Call{Call: _e.mock.On("ForgeDelete", forge)}
+}
+
+func (_c *MockStore_ForgeDelete_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Forge
+		if args[0] != nil {
+			arg0 = args[0].(*model.Forge)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ForgeDelete_Call) Return(err error) *MockStore_ForgeDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ForgeDelete_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgeGet provides a mock function for the type MockStore
+func (_mock *MockStore) ForgeGet(n int64) (*model.Forge, error) {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgeGet")
+	}
+
+	var r0 *model.Forge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Forge, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.Forge); ok {
+		r0 = returnFunc(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Forge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ForgeGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeGet'
+type MockStore_ForgeGet_Call struct {
+	*mock.Call
+}
+
+// ForgeGet is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) ForgeGet(n any) *MockStore_ForgeGet_Call {
+	return &MockStore_ForgeGet_Call{Call: _e.mock.On("ForgeGet", n)}
+}
+
+func (_c *MockStore_ForgeGet_Call) Run(run func(n int64)) *MockStore_ForgeGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ForgeGet_Call) Return(forge *model.Forge, err error) *MockStore_ForgeGet_Call {
+	_c.Call.Return(forge, err)
+	return _c
+}
+
+func (_c *MockStore_ForgeGet_Call) RunAndReturn(run func(n int64) (*model.Forge, error)) *MockStore_ForgeGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgeList provides a mock function for the type MockStore
+func (_mock *MockStore) ForgeList(p *model.ListOptionsWithAll) ([]*model.Forge, error) {
+	ret := _mock.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgeList")
+	}
+
+	var r0 []*model.Forge
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Forge, error)); ok {
+		return returnFunc(p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Forge); ok {
+		r0 = returnFunc(p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Forge)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ForgeList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeList'
+type MockStore_ForgeList_Call struct {
+	*mock.Call
+}
+
+// ForgeList is a helper method to define mock.On call
+//   - p *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) ForgeList(p any) *MockStore_ForgeList_Call {
+	return &MockStore_ForgeList_Call{Call: _e.mock.On("ForgeList", p)}
+}
+
+func (_c *MockStore_ForgeList_Call) Run(run func(p *model.ListOptionsWithAll)) *MockStore_ForgeList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ListOptionsWithAll
+		if args[0] != nil {
+			arg0 = args[0].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ForgeList_Call) Return(forges []*model.Forge, err error) *MockStore_ForgeList_Call {
+	_c.Call.Return(forges, err)
+	return _c
+}
+
+func (_c *MockStore_ForgeList_Call) RunAndReturn(run func(p *model.ListOptionsWithAll) ([]*model.Forge, error)) *MockStore_ForgeList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForgeUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) ForgeUpdate(forge *model.Forge) error {
+	ret := _mock.Called(forge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgeUpdate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Forge) error); ok {
+		r0 = returnFunc(forge)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_ForgeUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgeUpdate'
+type MockStore_ForgeUpdate_Call struct {
+	*mock.Call
+}
+
+// ForgeUpdate is a helper method to define mock.On call
+//   - forge *model.Forge
+func (_e *MockStore_Expecter) ForgeUpdate(forge any) *MockStore_ForgeUpdate_Call {
+	return &MockStore_ForgeUpdate_Call{Call: _e.mock.On("ForgeUpdate", forge)}
+}
+
+func (_c *MockStore_ForgeUpdate_Call) Run(run func(forge *model.Forge)) *MockStore_ForgeUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Forge
+		if args[0] != nil {
+			arg0 = args[0].(*model.Forge)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ForgeUpdate_Call) Return(err error) *MockStore_ForgeUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_ForgeUpdate_Call) RunAndReturn(run func(forge *model.Forge) error) *MockStore_ForgeUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActivePipelineList provides a mock function for the type MockStore
+func (_mock *MockStore) GetActivePipelineList(repo *model.Repo) ([]*model.Pipeline, error) {
+	ret := _mock.Called(repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActivePipelineList")
+	}
+
+	var r0 []*model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo) ([]*model.Pipeline, error)); ok {
+		return returnFunc(repo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo) []*model.Pipeline); ok {
+		r0 = returnFunc(repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo) error); ok {
+		r1 = returnFunc(repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetActivePipelineList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivePipelineList'
+type MockStore_GetActivePipelineList_Call struct {
+	*mock.Call
+}
+
+// GetActivePipelineList is a helper method to define mock.On call
+//   - repo *model.Repo
+func (_e *MockStore_Expecter) GetActivePipelineList(repo any) *MockStore_GetActivePipelineList_Call {
+	return &MockStore_GetActivePipelineList_Call{Call: _e.mock.On("GetActivePipelineList", repo)}
+}
+
+func (_c *MockStore_GetActivePipelineList_Call) Run(run func(repo *model.Repo)) *MockStore_GetActivePipelineList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetActivePipelineList_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetActivePipelineList_Call {
+	_c.Call.Return(pipelines, err)
+	return _c
+}
+
+func (_c *MockStore_GetActivePipelineList_Call) RunAndReturn(run func(repo *model.Repo) ([]*model.Pipeline, error)) *MockStore_GetActivePipelineList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipeline provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipeline(n int64) (*model.Pipeline, error) {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipeline")
+	}
+
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Pipeline, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.Pipeline); ok {
+		r0 = returnFunc(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipeline'
+type MockStore_GetPipeline_Call struct {
+	*mock.Call
+}
+
+// GetPipeline is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) GetPipeline(n any) *MockStore_GetPipeline_Call {
+	return &MockStore_GetPipeline_Call{Call: _e.mock.On("GetPipeline", n)}
+}
+
+func (_c *MockStore_GetPipeline_Call) Run(run func(n int64)) *MockStore_GetPipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipeline_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipeline_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipeline_Call) RunAndReturn(run func(n int64) (*model.Pipeline, error)) *MockStore_GetPipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineBadge provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineBadge(repo *model.Repo, s string, webhookEvents []model.WebhookEvent) (*model.Pipeline, error) {
+	ret := _mock.Called(repo, s, webhookEvents)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineBadge")
+	}
+
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, []model.WebhookEvent) (*model.Pipeline, error)); ok {
+		return returnFunc(repo, s, webhookEvents)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, []model.WebhookEvent) *model.Pipeline); ok {
+		r0 = returnFunc(repo, s, webhookEvents)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string, []model.WebhookEvent) error); ok {
+		r1 = returnFunc(repo, s, webhookEvents)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineBadge'
+type MockStore_GetPipelineBadge_Call struct {
+	*mock.Call
+}
+
+// GetPipelineBadge is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - s string
+//   - webhookEvents []model.WebhookEvent
+func (_e *MockStore_Expecter) GetPipelineBadge(repo any, s any, webhookEvents any) *MockStore_GetPipelineBadge_Call {
+	return &MockStore_GetPipelineBadge_Call{Call: _e.mock.On("GetPipelineBadge", repo, s, webhookEvents)}
+}
+
+func (_c *MockStore_GetPipelineBadge_Call) Run(run func(repo *model.Repo, s string, webhookEvents []model.WebhookEvent)) *MockStore_GetPipelineBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []model.WebhookEvent
+		if args[2] != nil {
+			arg2 = args[2].([]model.WebhookEvent)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineBadge_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineBadge_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineBadge_Call) RunAndReturn(run func(repo *model.Repo, s string, webhookEvents []model.WebhookEvent) (*model.Pipeline, error)) *MockStore_GetPipelineBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineCount provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineCount() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineCount'
+type MockStore_GetPipelineCount_Call struct {
+	*mock.Call
+}
+
+// GetPipelineCount is a helper method to define mock.On call
+func (_e *MockStore_Expecter) GetPipelineCount() *MockStore_GetPipelineCount_Call {
+	return &MockStore_GetPipelineCount_Call{Call: _e.mock.On("GetPipelineCount")}
+}
+
+func (_c *MockStore_GetPipelineCount_Call) Run(run func()) *MockStore_GetPipelineCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineCount_Call) Return(n int64, err error) *MockStore_GetPipelineCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetPipelineCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineLastBefore provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineLastBefore(repo *model.Repo, s string, n int64) (*model.Pipeline, error) {
+	ret := _mock.Called(repo, s, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineLastBefore")
+	}
+
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, int64) (*model.Pipeline, error)); ok {
+		return returnFunc(repo, s, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, int64) *model.Pipeline); ok {
+		r0 = returnFunc(repo, s, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string, int64) error); ok {
+		r1 = returnFunc(repo, s, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineLastBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineLastBefore'
+type MockStore_GetPipelineLastBefore_Call struct {
+	*mock.Call
+}
+
+// GetPipelineLastBefore is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - s string
+//   - n int64
+func (_e *MockStore_Expecter) GetPipelineLastBefore(repo any, s any, n any) *MockStore_GetPipelineLastBefore_Call {
+	return &MockStore_GetPipelineLastBefore_Call{Call: _e.mock.On("GetPipelineLastBefore", repo, s, n)}
+}
+
+func (_c *MockStore_GetPipelineLastBefore_Call) Run(run func(repo *model.Repo, s string, n int64)) *MockStore_GetPipelineLastBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineLastBefore_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineLastBefore_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineLastBefore_Call) RunAndReturn(run func(repo *model.Repo, s string, n int64) (*model.Pipeline, error)) *MockStore_GetPipelineLastBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineLastByBranch provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineLastByBranch(repo *model.Repo, s string) (*model.Pipeline, error) {
+	ret := _mock.Called(repo, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineLastByBranch")
+	}
+
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Pipeline, error)); ok {
+		return returnFunc(repo, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Pipeline); ok {
+		r0 = returnFunc(repo, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
+		r1 = returnFunc(repo, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineLastByBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineLastByBranch'
+type MockStore_GetPipelineLastByBranch_Call struct {
+	*mock.Call
+}
+
+// GetPipelineLastByBranch is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - s string
+func (_e *MockStore_Expecter) GetPipelineLastByBranch(repo any, s any) *MockStore_GetPipelineLastByBranch_Call {
+	return &MockStore_GetPipelineLastByBranch_Call{Call: _e.mock.On("GetPipelineLastByBranch", repo, s)}
+}
+
+func (_c *MockStore_GetPipelineLastByBranch_Call) Run(run func(repo *model.Repo, s string)) *MockStore_GetPipelineLastByBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineLastByBranch_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineLastByBranch_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineLastByBranch_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Pipeline, error)) *MockStore_GetPipelineLastByBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineList provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineList(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter) ([]*model.Pipeline, error) {
+	ret := _mock.Called(repo, listOptionsWithAll, pipelineFilter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineList")
+	}
+
+	var r0 []*model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) ([]*model.Pipeline, error)); ok {
+		return returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) []*model.Pipeline); ok {
+		r0 = returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) error); ok {
+		r1 = returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineList'
+type MockStore_GetPipelineList_Call struct {
+	*mock.Call
+}
+
+// GetPipelineList is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - listOptionsWithAll *model.ListOptionsWithAll
+//   - pipelineFilter *model.PipelineFilter
+func (_e *MockStore_Expecter) GetPipelineList(repo any, listOptionsWithAll any, pipelineFilter any) *MockStore_GetPipelineList_Call {
+	return &MockStore_GetPipelineList_Call{Call: _e.mock.On("GetPipelineList", repo, listOptionsWithAll, pipelineFilter)}
+}
+
+func (_c *MockStore_GetPipelineList_Call) Run(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter)) *MockStore_GetPipelineList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 *model.ListOptionsWithAll
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptionsWithAll)
+		}
+		var arg2 *model.PipelineFilter
+		if args[2] != nil {
+			arg2 = args[2].(*model.PipelineFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineList_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetPipelineList_Call {
+	_c.Call.Return(pipelines, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineList_Call) RunAndReturn(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter) ([]*model.Pipeline, error)) *MockStore_GetPipelineList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineListLogsExpired provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineListLogsExpired(repo *model.Repo, before int64, offset int, limit int) ([]*model.Pipeline, error) {
+	ret := _mock.Called(repo, before, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineListLogsExpired")
+	}
+
+	var r0 []*model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64, int, int) ([]*model.Pipeline, error)); ok {
+		return returnFunc(repo, before, offset, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64, int, int) []*model.Pipeline); ok {
+		r0 = returnFunc(repo, before, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64, int, int) error); ok {
+		r1 = returnFunc(repo, before, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineListLogsExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineListLogsExpired'
+type MockStore_GetPipelineListLogsExpired_Call struct {
+	*mock.Call
+}
+
+// GetPipelineListLogsExpired is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - before int64
+//   - offset int
+//   - limit int
+func (_e *MockStore_Expecter) GetPipelineListLogsExpired(repo any, before any, offset any, limit any) *MockStore_GetPipelineListLogsExpired_Call {
+	return &MockStore_GetPipelineListLogsExpired_Call{Call: _e.mock.On("GetPipelineListLogsExpired", repo, before, offset, limit)}
+}
+
+func (_c *MockStore_GetPipelineListLogsExpired_Call) Run(run func(repo *model.Repo, before int64, offset int, limit int)) *MockStore_GetPipelineListLogsExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineListLogsExpired_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetPipelineListLogsExpired_Call {
+	_c.Call.Return(pipelines, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineListLogsExpired_Call) RunAndReturn(run func(repo *model.Repo, before int64, offset int, limit int) ([]*model.Pipeline, error)) *MockStore_GetPipelineListLogsExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineNumber provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineNumber(repo *model.Repo, n int64) (*model.Pipeline, error) {
+	ret := _mock.Called(repo, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineNumber")
+	}
+
+	var r0 *model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) (*model.Pipeline, error)); ok {
+		return returnFunc(repo, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) *model.Pipeline); ok {
+		r0 = returnFunc(repo, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64) error); ok {
+		r1 = returnFunc(repo, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineNumber'
+type MockStore_GetPipelineNumber_Call struct {
+	*mock.Call
+}
+
+// GetPipelineNumber is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - n int64
+func (_e *MockStore_Expecter) GetPipelineNumber(repo any, n any) *MockStore_GetPipelineNumber_Call {
+	return &MockStore_GetPipelineNumber_Call{Call: _e.mock.On("GetPipelineNumber", repo, n)}
+}
+
+func (_c *MockStore_GetPipelineNumber_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_GetPipelineNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineNumber_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineNumber_Call {
+	_c.Call.Return(pipeline, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineNumber_Call) RunAndReturn(run func(repo *model.Repo, n int64) (*model.Pipeline, error)) *MockStore_GetPipelineNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineQueue provides a mock function for the type MockStore
+func (_mock *MockStore) GetPipelineQueue() ([]*model.Feed, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineQueue")
+	}
+
+	var r0 []*model.Feed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*model.Feed, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*model.Feed); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Feed)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetPipelineQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineQueue'
+type MockStore_GetPipelineQueue_Call struct {
+	*mock.Call
+}
+
+// GetPipelineQueue is a helper method to define mock.On call
+func (_e *MockStore_Expecter) GetPipelineQueue() *MockStore_GetPipelineQueue_Call {
+	return &MockStore_GetPipelineQueue_Call{Call: _e.mock.On("GetPipelineQueue")}
+}
+
+func (_c *MockStore_GetPipelineQueue_Call) Run(run func()) *MockStore_GetPipelineQueue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_GetPipelineQueue_Call) Return(feeds []*model.Feed, err error) *MockStore_GetPipelineQueue_Call {
+	_c.Call.Return(feeds, err)
+	return _c
+}
+
+func (_c *MockStore_GetPipelineQueue_Call) RunAndReturn(run func() ([]*model.Feed, error)) *MockStore_GetPipelineQueue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepo provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepo(n int64) (*model.Repo, error) {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepo")
+	}
+
+	var r0 *model.Repo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Repo, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.Repo); ok {
+		r0 = returnFunc(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Repo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepo'
+type MockStore_GetRepo_Call struct {
+	*mock.Call
+}
+
+// GetRepo is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) GetRepo(n any) *MockStore_GetRepo_Call {
+	return &MockStore_GetRepo_Call{Call: _e.mock.On("GetRepo", n)}
+}
+
+func (_c *MockStore_GetRepo_Call) Run(run func(n int64)) *MockStore_GetRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepo_Call) Return(repo *model.Repo, err error) *MockStore_GetRepo_Call {
+	_c.Call.Return(repo, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepo_Call) RunAndReturn(run func(n int64) (*model.Repo, error)) *MockStore_GetRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoCount provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepoCount() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepoCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoCount'
+type MockStore_GetRepoCount_Call struct {
+	*mock.Call
+}
+
+// GetRepoCount is a helper method to define mock.On call
+func (_e *MockStore_Expecter) GetRepoCount() *MockStore_GetRepoCount_Call {
+	return &MockStore_GetRepoCount_Call{Call: _e.mock.On("GetRepoCount")}
+}
+
+func (_c *MockStore_GetRepoCount_Call) Run(run func()) *MockStore_GetRepoCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepoCount_Call) Return(n int64, err error) *MockStore_GetRepoCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepoCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetRepoCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoForgeID provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepoForgeID(n int64, forgeRemoteID model.ForgeRemoteID) (*model.Repo, error) {
+	ret := _mock.Called(n, forgeRemoteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoForgeID")
+	}
+
+	var r0 *model.Repo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) (*model.Repo, error)); ok {
+		return returnFunc(n, forgeRemoteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) *model.Repo); ok {
+		r0 = returnFunc(n, forgeRemoteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Repo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID) error); ok {
+		r1 = returnFunc(n, forgeRemoteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepoForgeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoForgeID'
+type MockStore_GetRepoForgeID_Call struct {
+	*mock.Call
+}
+
+// GetRepoForgeID is a helper method to define mock.On call
+//   - n int64
+//   - forgeRemoteID model.ForgeRemoteID
+func (_e *MockStore_Expecter) GetRepoForgeID(n any, forgeRemoteID any) *MockStore_GetRepoForgeID_Call {
+	return &MockStore_GetRepoForgeID_Call{Call: _e.mock.On("GetRepoForgeID", n, forgeRemoteID)}
+}
+
+func (_c *MockStore_GetRepoForgeID_Call) Run(run func(n int64, forgeRemoteID model.ForgeRemoteID)) *MockStore_GetRepoForgeID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 model.ForgeRemoteID
+		if args[1] != nil {
+			arg1 = args[1].(model.ForgeRemoteID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepoForgeID_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoForgeID_Call {
+	_c.Call.Return(repo, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepoForgeID_Call) RunAndReturn(run func(n int64, forgeRemoteID model.ForgeRemoteID) (*model.Repo, error)) *MockStore_GetRepoForgeID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoLatestPipelines provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepoLatestPipelines(int64s []int64) ([]*model.Pipeline, error) {
+	ret := _mock.Called(int64s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoLatestPipelines")
+	}
+
+	var r0 []*model.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]int64) ([]*model.Pipeline, error)); ok {
+		return returnFunc(int64s)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]int64) []*model.Pipeline); ok {
+		r0 = returnFunc(int64s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = returnFunc(int64s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepoLatestPipelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoLatestPipelines'
+type MockStore_GetRepoLatestPipelines_Call struct {
+	*mock.Call
+}
+
+// GetRepoLatestPipelines is a helper method to define mock.On call
+//   - int64s []int64
+func (_e *MockStore_Expecter) GetRepoLatestPipelines(int64s any) *MockStore_GetRepoLatestPipelines_Call {
+	return &MockStore_GetRepoLatestPipelines_Call{Call: _e.mock.On("GetRepoLatestPipelines", int64s)}
+}
+
+func (_c *MockStore_GetRepoLatestPipelines_Call) Run(run func(int64s []int64)) *MockStore_GetRepoLatestPipelines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []int64
+		if args[0] != nil {
+			arg0 = args[0].([]int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepoLatestPipelines_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetRepoLatestPipelines_Call {
+	_c.Call.Return(pipelines, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepoLatestPipelines_Call) RunAndReturn(run func(int64s []int64) ([]*model.Pipeline, error)) *MockStore_GetRepoLatestPipelines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoName provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepoName(s string) (*model.Repo, error) {
+	ret := _mock.Called(s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoName")
+	}
+
+	var r0 *model.Repo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Repo, error)); ok {
+		return returnFunc(s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Repo); ok {
+		r0 = returnFunc(s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Repo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepoName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoName'
+type MockStore_GetRepoName_Call struct {
+	*mock.Call
+}
+
+// GetRepoName is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) GetRepoName(s any) *MockStore_GetRepoName_Call {
+	return &MockStore_GetRepoName_Call{Call: _e.mock.On("GetRepoName", s)}
+}
+
+func (_c *MockStore_GetRepoName_Call) Run(run func(s string)) *MockStore_GetRepoName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepoName_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoName_Call {
+	_c.Call.Return(repo, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepoName_Call) RunAndReturn(run func(s string) (*model.Repo, error)) *MockStore_GetRepoName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoNameFallback provides a mock function for the type MockStore
+func (_mock *MockStore) GetRepoNameFallback(forgeID int64, remoteID model.ForgeRemoteID, fullName string) (*model.Repo, error) {
+	ret := _mock.Called(forgeID, remoteID, fullName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoNameFallback")
+	}
+
+	var r0 *model.Repo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID, string) (*model.Repo, error)); ok {
+		return returnFunc(forgeID, remoteID, fullName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID, string) *model.Repo); ok {
+		r0 = returnFunc(forgeID, remoteID, fullName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Repo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID, string) error); ok {
+		r1 = returnFunc(forgeID, remoteID, fullName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetRepoNameFallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoNameFallback'
+type MockStore_GetRepoNameFallback_Call struct {
+	*mock.Call
+}
+
+// GetRepoNameFallback is a helper method to define mock.On call
+//   - forgeID int64
+//   - remoteID model.ForgeRemoteID
+//   - fullName string
+func (_e *MockStore_Expecter) GetRepoNameFallback(forgeID any, remoteID any, fullName any) *MockStore_GetRepoNameFallback_Call {
+	return &MockStore_GetRepoNameFallback_Call{Call: _e.mock.On("GetRepoNameFallback", forgeID, remoteID, fullName)}
+}
+
+func (_c *MockStore_GetRepoNameFallback_Call) Run(run func(forgeID int64, remoteID model.ForgeRemoteID, fullName string)) *MockStore_GetRepoNameFallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 model.ForgeRemoteID
+		if args[1] != nil {
+			arg1 = args[1].(model.ForgeRemoteID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetRepoNameFallback_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoNameFallback_Call {
+	_c.Call.Return(repo, err)
+	return _c
+}
+
+func (_c *MockStore_GetRepoNameFallback_Call) RunAndReturn(run func(forgeID int64, remoteID model.ForgeRemoteID, fullName string) (*model.Repo, error)) *MockStore_GetRepoNameFallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUser provides a mock function for the type MockStore
+func (_mock *MockStore) GetUser(n int64) (*model.User, error) {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUser")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.User, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.User); ok {
+		r0 = returnFunc(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
+type MockStore_GetUser_Call struct {
+	*mock.Call
+}
+
+// GetUser is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) GetUser(n any) *MockStore_GetUser_Call {
+	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", n)}
+}
+
+func (_c *MockStore_GetUser_Call) Run(run func(n int64)) *MockStore_GetUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) Return(user *model.User, err error) *MockStore_GetUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockStore_GetUser_Call) RunAndReturn(run func(n int64) (*model.User, error)) *MockStore_GetUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByLogin provides a mock function for the type MockStore
+func (_mock *MockStore) GetUserByLogin(n int64, s string) (*model.User, error) {
+	ret := _mock.Called(n, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByLogin")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.User, error)); ok {
+		return returnFunc(n, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.User); ok {
+		r0 = returnFunc(n, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(n, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
+type MockStore_GetUserByLogin_Call struct {
+	*mock.Call
+}
+
+// GetUserByLogin is a helper method to define mock.On call
+//   - n int64
+//   - s string
+func (_e *MockStore_Expecter) GetUserByLogin(n any, s any) *MockStore_GetUserByLogin_Call {
+	return &MockStore_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", n, s)}
+}
+
+func (_c *MockStore_GetUserByLogin_Call) Run(run func(n int64, s string)) *MockStore_GetUserByLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByLogin_Call) Return(user *model.User, err error) *MockStore_GetUserByLogin_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockStore_GetUserByLogin_Call) RunAndReturn(run func(n int64, s string) (*model.User, error)) *MockStore_GetUserByLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserByRemoteID provides a mock function for the type MockStore
+func (_mock *MockStore) GetUserByRemoteID(n int64, forgeRemoteID model.ForgeRemoteID) (*model.User, error) {
+	ret := _mock.Called(n, forgeRemoteID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserByRemoteID")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) (*model.User, error)); ok {
+		return returnFunc(n, forgeRemoteID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) *model.User); ok {
+		r0 = returnFunc(n, forgeRemoteID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID) error); ok {
+		r1 = returnFunc(n, forgeRemoteID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetUserByRemoteID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByRemoteID'
+type MockStore_GetUserByRemoteID_Call struct {
+	*mock.Call
+}
+
+// GetUserByRemoteID is a helper method to define mock.On call
+//   - n int64
+//   - forgeRemoteID model.ForgeRemoteID
+func (_e *MockStore_Expecter) GetUserByRemoteID(n any, forgeRemoteID any) *MockStore_GetUserByRemoteID_Call {
+	return &MockStore_GetUserByRemoteID_Call{Call: _e.mock.On("GetUserByRemoteID", n, forgeRemoteID)}
+}
+
+func (_c *MockStore_GetUserByRemoteID_Call) Run(run func(n int64, forgeRemoteID model.ForgeRemoteID)) *MockStore_GetUserByRemoteID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 model.ForgeRemoteID
+		if args[1] != nil {
+			arg1 = args[1].(model.ForgeRemoteID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserByRemoteID_Call) Return(user *model.User, err error) *MockStore_GetUserByRemoteID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockStore_GetUserByRemoteID_Call) RunAndReturn(run func(n int64, forgeRemoteID model.ForgeRemoteID) (*model.User, error)) *MockStore_GetUserByRemoteID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserCount provides a mock function for the type MockStore
+func (_mock *MockStore) GetUserCount() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetUserCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCount'
+type MockStore_GetUserCount_Call struct {
+	*mock.Call
+}
+
+// GetUserCount is a helper method to define mock.On call
+func (_e *MockStore_Expecter) GetUserCount() *MockStore_GetUserCount_Call {
+	return &MockStore_GetUserCount_Call{Call: _e.mock.On("GetUserCount")}
+}
+
+func (_c *MockStore_GetUserCount_Call) Run(run func()) *MockStore_GetUserCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserCount_Call) Return(n int64, err error) *MockStore_GetUserCount_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStore_GetUserCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetUserCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserList provides a mock function for the type MockStore
+func (_mock *MockStore) GetUserList(p *model.ListOptionsWithAll) ([]*model.User, error) {
+	ret := _mock.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserList")
+	}
+
+	var r0 []*model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.User, error)); ok {
+		return returnFunc(p)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.User); ok {
+		r0 = returnFunc(p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(p)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GetUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserList'
+type MockStore_GetUserList_Call struct {
+	*mock.Call
+}
+
+// GetUserList is a helper method to define mock.On call
+//   - p *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) GetUserList(p any) *MockStore_GetUserList_Call {
+	return &MockStore_GetUserList_Call{Call: _e.mock.On("GetUserList", p)}
+}
+
+func (_c *MockStore_GetUserList_Call) Run(run func(p *model.ListOptionsWithAll)) *MockStore_GetUserList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ListOptionsWithAll
+		if args[0] != nil {
+			arg0 = args[0].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GetUserList_Call) Return(users []*model.User, err error) *MockStore_GetUserList_Call {
+	_c.Call.Return(users, err)
+	return _c
+}
+
+func (_c *MockStore_GetUserList_Call) RunAndReturn(run func(p *model.ListOptionsWithAll) ([]*model.User, error)) *MockStore_GetUserList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryFind provides a mock function for the type MockStore
+func (_mock *MockStore) GlobalRegistryFind(s string) (*model.Registry, error) {
+	ret := _mock.Called(s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryFind")
+	}
+
+	var r0 *model.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Registry, error)); ok {
+		return returnFunc(s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Registry); ok {
+		r0 = returnFunc(s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GlobalRegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryFind'
+type MockStore_GlobalRegistryFind_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryFind is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) GlobalRegistryFind(s any) *MockStore_GlobalRegistryFind_Call {
+	return &MockStore_GlobalRegistryFind_Call{Call: _e.mock.On("GlobalRegistryFind", s)}
+}
+
+func (_c *MockStore_GlobalRegistryFind_Call) Run(run func(s string)) *MockStore_GlobalRegistryFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GlobalRegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_GlobalRegistryFind_Call {
+	_c.Call.Return(registry, err)
+	return _c
+}
+
+func (_c *MockStore_GlobalRegistryFind_Call) RunAndReturn(run func(s string) (*model.Registry, error)) *MockStore_GlobalRegistryFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRegistryList provides a mock function for the type MockStore
+func (_mock *MockStore) GlobalRegistryList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
+	ret := _mock.Called(listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRegistryList")
+	}
+
+	var r0 []*model.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
+		return returnFunc(listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Registry); ok {
+		r0 = returnFunc(listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GlobalRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryList'
+type MockStore_GlobalRegistryList_Call struct {
+	*mock.Call
+}
+
+// GlobalRegistryList is a helper method to define mock.On call
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) GlobalRegistryList(listOptionsWithAll any) *MockStore_GlobalRegistryList_Call {
+	return &MockStore_GlobalRegistryList_Call{Call: _e.mock.On("GlobalRegistryList", listOptionsWithAll)}
+}
+
+func (_c *MockStore_GlobalRegistryList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_GlobalRegistryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ListOptionsWithAll
+		if args[0] != nil {
+			arg0 = args[0].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GlobalRegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_GlobalRegistryList_Call {
+	_c.Call.Return(registrys, err)
+	return _c
+}
+
+func (_c *MockStore_GlobalRegistryList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_GlobalRegistryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecretFind provides a mock function for the type MockStore
+func (_mock *MockStore) GlobalSecretFind(s string) (*model.Secret, error) {
+	ret := _mock.Called(s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecretFind")
+	}
+
+	var r0 *model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Secret, error)); ok {
+		return returnFunc(s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Secret); ok {
+		r0 = returnFunc(s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GlobalSecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretFind'
+type MockStore_GlobalSecretFind_Call struct {
+	*mock.Call
+}
+
+// GlobalSecretFind is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) GlobalSecretFind(s any) *MockStore_GlobalSecretFind_Call {
+	return &MockStore_GlobalSecretFind_Call{Call: _e.mock.On("GlobalSecretFind", s)}
+}
+
+func (_c *MockStore_GlobalSecretFind_Call) Run(run func(s string)) *MockStore_GlobalSecretFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GlobalSecretFind_Call) Return(secret *model.Secret, err error) *MockStore_GlobalSecretFind_Call {
+	_c.Call.Return(secret, err)
+	return _c
+}
+
+func (_c *MockStore_GlobalSecretFind_Call) RunAndReturn(run func(s string) (*model.Secret, error)) *MockStore_GlobalSecretFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalSecretList provides a mock function for the type MockStore
+func (_mock *MockStore) GlobalSecretList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
+	ret := _mock.Called(listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalSecretList")
+	}
+
+	var r0 []*model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
+		return returnFunc(listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Secret); ok {
+		r0 = returnFunc(listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_GlobalSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretList'
+type MockStore_GlobalSecretList_Call struct {
+	*mock.Call
+}
+
+// GlobalSecretList is a helper method to define mock.On call
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) GlobalSecretList(listOptionsWithAll any) *MockStore_GlobalSecretList_Call {
+	return &MockStore_GlobalSecretList_Call{Call: _e.mock.On("GlobalSecretList", listOptionsWithAll)}
+}
+
+func (_c *MockStore_GlobalSecretList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_GlobalSecretList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ListOptionsWithAll
+		if args[0] != nil {
+			arg0 = args[0].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_GlobalSecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_GlobalSecretList_Call {
+	_c.Call.Return(secrets, err)
+	return _c
+}
+
+func (_c *MockStore_GlobalSecretList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_GlobalSecretList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasRedirectionForRepo provides a mock function for the type MockStore
+func (_mock *MockStore) HasRedirectionForRepo(n int64, s string) (bool, error) {
+	ret := _mock.Called(n, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRedirectionForRepo")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (bool, error)); ok {
+		return returnFunc(n, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string) bool); ok {
+		r0 = returnFunc(n, s)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(n, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_HasRedirectionForRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRedirectionForRepo'
+type MockStore_HasRedirectionForRepo_Call struct {
+	*mock.Call
+}
+
+// HasRedirectionForRepo is a helper method to define mock.On call
+//   - n int64
+//   - s string
+func (_e *MockStore_Expecter) HasRedirectionForRepo(n any, s any) *MockStore_HasRedirectionForRepo_Call {
+	return &MockStore_HasRedirectionForRepo_Call{Call: _e.mock.On("HasRedirectionForRepo", n, s)}
+}
+
+func (_c *MockStore_HasRedirectionForRepo_Call) Run(run func(n int64, s string)) *MockStore_HasRedirectionForRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_HasRedirectionForRepo_Call) Return(b bool, err error) *MockStore_HasRedirectionForRepo_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockStore_HasRedirectionForRepo_Call) RunAndReturn(run func(n int64, s string) (bool, error)) *MockStore_HasRedirectionForRepo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogAppend provides a mock function for the type MockStore
+func (_mock *MockStore) LogAppend(step *model.Step, logEntrys []*model.LogEntry) error {
+	ret := _mock.Called(step, logEntrys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogAppend")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step, []*model.LogEntry) error); ok {
+		r0 = returnFunc(step, logEntrys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_LogAppend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogAppend'
+type MockStore_LogAppend_Call struct {
+	*mock.Call
+}
+
+// LogAppend is a helper method to define mock.On call
+//   - step *model.Step
+//   - logEntrys []*model.LogEntry
+func (_e *MockStore_Expecter) LogAppend(step any, logEntrys any) *MockStore_LogAppend_Call {
+	return &MockStore_LogAppend_Call{Call: _e.mock.On("LogAppend", step, logEntrys)}
+}
+
+func (_c *MockStore_LogAppend_Call) Run(run func(step *model.Step, logEntrys []*model.LogEntry)) *MockStore_LogAppend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		var arg1 []*model.LogEntry
+		if args[1] != nil {
+			arg1 = args[1].([]*model.LogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogAppend_Call) Return(err error) *MockStore_LogAppend_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_LogAppend_Call) RunAndReturn(run func(step *model.Step, logEntrys []*model.LogEntry) error) *MockStore_LogAppend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogAttemptCreate provides a mock function for the type MockStore
+func (_mock *MockStore) LogAttemptCreate(step *model.Step, attempt int, logEntries []*model.LogEntry) error {
+	ret := _mock.Called(step, attempt, logEntries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogAttemptCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step, int, []*model.LogEntry) error); ok {
+		r0 = returnFunc(step, attempt, logEntries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_LogAttemptCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogAttemptCreate'
+type MockStore_LogAttemptCreate_Call struct {
+	*mock.Call
+}
+
+// LogAttemptCreate is a helper method to define mock.On call
+//   - step *model.Step
+//   - attempt int
+//   - logEntries []*model.LogEntry
+func (_e *MockStore_Expecter) LogAttemptCreate(step any, attempt any, logEntries any) *MockStore_LogAttemptCreate_Call {
+	return &MockStore_LogAttemptCreate_Call{Call: _e.mock.On("LogAttemptCreate", step, attempt, logEntries)}
+}
+
+func (_c *MockStore_LogAttemptCreate_Call) Run(run func(step *model.Step, attempt int, logEntries []*model.LogEntry)) *MockStore_LogAttemptCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []*model.LogEntry
+		if args[2] != nil {
+			arg2 = args[2].([]*model.LogEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogAttemptCreate_Call) Return(err error) *MockStore_LogAttemptCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_LogAttemptCreate_Call) RunAndReturn(run func(step *model.Step, attempt int, logEntries []*model.LogEntry) error) *MockStore_LogAttemptCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogAttemptDelete provides a mock function for the type MockStore
+func (_mock *MockStore) LogAttemptDelete(step *model.Step) error {
+	ret := _mock.Called(step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogAttemptDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step) error); ok {
+		r0 = returnFunc(step)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_LogAttemptDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogAttemptDelete'
+type MockStore_LogAttemptDelete_Call struct {
+	*mock.Call
+}
+
+// LogAttemptDelete is a helper method to define mock.On call
+//   - step *model.Step
+func (_e *MockStore_Expecter) LogAttemptDelete(step any) *MockStore_LogAttemptDelete_Call {
+	return &MockStore_LogAttemptDelete_Call{Call: _e.mock.On("LogAttemptDelete", step)}
+}
+
+func (_c *MockStore_LogAttemptDelete_Call) Run(run func(step *model.Step)) *MockStore_LogAttemptDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogAttemptDelete_Call) Return(err error) *MockStore_LogAttemptDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_LogAttemptDelete_Call) RunAndReturn(run func(step *model.Step) error) *MockStore_LogAttemptDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogAttemptFind provides a mock function for the type MockStore
+func (_mock *MockStore) LogAttemptFind(step *model.Step, attempt int) ([]*model.LogEntry, error) {
+	ret := _mock.Called(step, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogAttemptFind")
+	}
+
+	var r0 []*model.LogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step, int) ([]*model.LogEntry, error)); ok {
+		return returnFunc(step, attempt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Step, int) []*model.LogEntry); ok {
+		r0 = returnFunc(step, attempt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Step, int) error); ok {
+		r1 = returnFunc(step, attempt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_LogAttemptFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogAttemptFind'
+type MockStore_LogAttemptFind_Call struct {
+	*mock.Call
+}
+
+// LogAttemptFind is a helper method to define mock.On call
+//   - step *model.Step
+//   - attempt int
+func (_e *MockStore_Expecter) LogAttemptFind(step any, attempt any) *MockStore_LogAttemptFind_Call {
+	return &MockStore_LogAttemptFind_Call{Call: _e.mock.On("LogAttemptFind", step, attempt)}
+}
+
+func (_c *MockStore_LogAttemptFind_Call) Run(run func(step *model.Step, attempt int)) *MockStore_LogAttemptFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogAttemptFind_Call) Return(logEntrys []*model.LogEntry, err error) *MockStore_LogAttemptFind_Call {
+	_c.Call.Return(logEntrys, err)
+	return _c
+}
+
+func (_c *MockStore_LogAttemptFind_Call) RunAndReturn(run func(step *model.Step, attempt int) ([]*model.LogEntry, error)) *MockStore_LogAttemptFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogDelete provides a mock function for the type MockStore
+func (_mock *MockStore) LogDelete(step *model.Step) error {
+	ret := _mock.Called(step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step) error); ok {
+		r0 = returnFunc(step)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_LogDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogDelete'
+type MockStore_LogDelete_Call struct {
+	*mock.Call
+}
+
+// LogDelete is a helper method to define mock.On call
+//   - step *model.Step
+func (_e *MockStore_Expecter) LogDelete(step any) *MockStore_LogDelete_Call {
+	return &MockStore_LogDelete_Call{Call: _e.mock.On("LogDelete", step)}
+}
+
+func (_c *MockStore_LogDelete_Call) Run(run func(step *model.Step)) *MockStore_LogDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogDelete_Call) Return(err error) *MockStore_LogDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_LogDelete_Call) RunAndReturn(run func(step *model.Step) error) *MockStore_LogDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogFind provides a mock function for the type MockStore
+func (_mock *MockStore) LogFind(step *model.Step) ([]*model.LogEntry, error) {
+	ret := _mock.Called(step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogFind")
+	}
+
+	var r0 []*model.LogEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Step) ([]*model.LogEntry, error)); ok {
+		return returnFunc(step)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Step) []*model.LogEntry); ok {
+		r0 = returnFunc(step)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LogEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Step) error); ok {
+		r1 = returnFunc(step)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_LogFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogFind'
+type MockStore_LogFind_Call struct {
+	*mock.Call
+}
+
+// LogFind is a helper method to define mock.On call
+//   - step *model.Step
+func (_e *MockStore_Expecter) LogFind(step any) *MockStore_LogFind_Call {
+	return &MockStore_LogFind_Call{Call: _e.mock.On("LogFind", step)}
+}
+
+func (_c *MockStore_LogFind_Call) Run(run func(step *model.Step)) *MockStore_LogFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Step
+		if args[0] != nil {
+			arg0 = args[0].(*model.Step)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_LogFind_Call) Return(logEntrys []*model.LogEntry, err error) *MockStore_LogFind_Call {
+	_c.Call.Return(logEntrys, err)
+	return _c
+}
+
+func (_c *MockStore_LogFind_Call) RunAndReturn(run func(step *model.Step) ([]*model.LogEntry, error)) *MockStore_LogFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Migrate provides a mock function for the type MockStore
+func (_mock *MockStore) Migrate(context1 context.Context, b bool) error {
+	ret := _mock.Called(context1, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Migrate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = returnFunc(context1, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_Migrate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Migrate'
+type MockStore_Migrate_Call struct {
+	*mock.Call
+}
+
+// Migrate is a helper method to define mock.On call
+//   - context1 context.Context
+//   - b bool
+func (_e *MockStore_Expecter) Migrate(context1 any, b any) *MockStore_Migrate_Call {
+	return &MockStore_Migrate_Call{Call: _e.mock.On("Migrate", context1, b)}
+}
+
+func (_c *MockStore_Migrate_Call) Run(run func(context1 context.Context, b bool)) *MockStore_Migrate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_Migrate_Call) Return(err error) *MockStore_Migrate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_Migrate_Call) RunAndReturn(run func(context1 context.Context, b bool) error) *MockStore_Migrate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgCreate provides a mock function for the type MockStore
+func (_mock *MockStore) OrgCreate(org *model.Org) error {
+	ret := _mock.Called(org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Org) error); ok {
+		r0 = returnFunc(org)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_OrgCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgCreate'
+type MockStore_OrgCreate_Call struct {
+	*mock.Call
+}
+
+// OrgCreate is a helper method to define mock.On call
+//   - org *model.Org
+func (_e *MockStore_Expecter) OrgCreate(org any) *MockStore_OrgCreate_Call {
+	return &MockStore_OrgCreate_Call{Call: _e.mock.On("OrgCreate", org)}
+}
+
+func (_c *MockStore_OrgCreate_Call) Run(run func(org *model.Org)) *MockStore_OrgCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Org
+		if args[0] != nil {
+			arg0 = args[0].(*model.Org)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgCreate_Call) Return(err error) *MockStore_OrgCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_OrgCreate_Call) RunAndReturn(run func(org *model.Org) error) *MockStore_OrgCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgDelete provides a mock function for the type MockStore
+func (_mock *MockStore) OrgDelete(n int64) error {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(n)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_OrgDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgDelete'
+type MockStore_OrgDelete_Call struct {
+	*mock.Call
+}
+
+// OrgDelete is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) OrgDelete(n any) *MockStore_OrgDelete_Call {
+	return &MockStore_OrgDelete_Call{Call: _e.mock.On("OrgDelete", n)}
+}
+
+func (_c *MockStore_OrgDelete_Call) Run(run func(n int64)) *MockStore_OrgDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgDelete_Call) Return(err error) *MockStore_OrgDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_OrgDelete_Call) RunAndReturn(run func(n int64) error) *MockStore_OrgDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgFindByName provides a mock function for the type MockStore
+func (_mock *MockStore) OrgFindByName(s string, n int64) (*model.Org, error) {
+	ret := _mock.Called(s, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgFindByName")
+	}
+
+	var r0 *model.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, int64) (*model.Org, error)); ok {
+		return returnFunc(s, n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, int64) *model.Org); ok {
+		r0 = returnFunc(s, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Org)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = returnFunc(s, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgFindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgFindByName'
+type MockStore_OrgFindByName_Call struct {
+	*mock.Call
+}
+
+// OrgFindByName is a helper method to define mock.On call
+//   - s string
+//   - n int64
+func (_e *MockStore_Expecter) OrgFindByName(s any, n any) *MockStore_OrgFindByName_Call {
+	return &MockStore_OrgFindByName_Call{Call: _e.mock.On("OrgFindByName", s, n)}
+}
+
+func (_c *MockStore_OrgFindByName_Call) Run(run func(s string, n int64)) *MockStore_OrgFindByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgFindByName_Call) Return(org *model.Org, err error) *MockStore_OrgFindByName_Call {
+	_c.Call.Return(org, err)
+	return _c
+}
+
+func (_c *MockStore_OrgFindByName_Call) RunAndReturn(run func(s string, n int64) (*model.Org, error)) *MockStore_OrgFindByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgGet provides a mock function for the type MockStore
+func (_mock *MockStore) OrgGet(n int64) (*model.Org, error) {
+	ret := _mock.Called(n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgGet")
+	}
+
+	var r0 *model.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Org, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.Org); ok {
+		r0 = returnFunc(n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Org)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgGet'
+type MockStore_OrgGet_Call struct {
+	*mock.Call
+}
+
+// OrgGet is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) OrgGet(n any) *MockStore_OrgGet_Call {
+	return &MockStore_OrgGet_Call{Call: _e.mock.On("OrgGet", n)}
+}
+
+func (_c *MockStore_OrgGet_Call) Run(run func(n int64)) *MockStore_OrgGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgGet_Call) Return(org *model.Org, err error) *MockStore_OrgGet_Call {
+	_c.Call.Return(org, err)
+	return _c
+}
+
+func (_c *MockStore_OrgGet_Call) RunAndReturn(run func(n int64) (*model.Org, error)) *MockStore_OrgGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgList provides a mock function for the type MockStore
+func (_mock *MockStore) OrgList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Org, error) {
+	ret := _mock.Called(listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgList")
+	}
+
+	var r0 []*model.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Org, error)); ok {
+		return returnFunc(listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Org); ok {
+		r0 = returnFunc(listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Org)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgList'
+type MockStore_OrgList_Call struct {
+	*mock.Call
+}
+
+// OrgList is a helper method to define mock.On call
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) OrgList(listOptionsWithAll any) *MockStore_OrgList_Call {
+	return &MockStore_OrgList_Call{Call: _e.mock.On("OrgList", listOptionsWithAll)}
+}
+
+func (_c *MockStore_OrgList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ListOptionsWithAll
+		if args[0] != nil {
+			arg0 = args[0].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgList_Call) Return(orgs []*model.Org, err error) *MockStore_OrgList_Call {
+	_c.Call.Return(orgs, err)
+	return _c
+}
+
+func (_c *MockStore_OrgList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Org, error)) *MockStore_OrgList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgLookup provides a mock function for the type MockStore
+func (_mock *MockStore) OrgLookup(s string) (*model.Org, error) {
+	ret := _mock.Called(s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgLookup")
+	}
+
+	var r0 *model.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Org, error)); ok {
+		return returnFunc(s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Org); ok {
+		r0 = returnFunc(s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Org)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgLookup'
+type MockStore_OrgLookup_Call struct {
+	*mock.Call
+}
+
+// OrgLookup is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) OrgLookup(s any) *MockStore_OrgLookup_Call {
+	return &MockStore_OrgLookup_Call{Call: _e.mock.On("OrgLookup", s)}
+}
+
+func (_c *MockStore_OrgLookup_Call) Run(run func(s string)) *MockStore_OrgLookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgLookup_Call) Return(org *model.Org, err error) *MockStore_OrgLookup_Call {
+	_c.Call.Return(org, err)
+	return _c
+}
+
+func (_c *MockStore_OrgLookup_Call) RunAndReturn(run func(s string) (*model.Org, error)) *MockStore_OrgLookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRegistryFind provides a mock function for the type MockStore
+func (_mock *MockStore) OrgRegistryFind(n int64, s string) (*model.Registry, error) {
+	ret := _mock.Called(n, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRegistryFind")
+	}
+
+	var r0 *model.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.Registry, error)); ok {
+		return returnFunc(n, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.Registry); ok {
+		r0 = returnFunc(n, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(n, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgRegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryFind'
+type MockStore_OrgRegistryFind_Call struct {
+	*mock.Call
+}
+
+// OrgRegistryFind is a helper method to define mock.On call
+//   - n int64
+//   - s string
+func (_e *MockStore_Expecter) OrgRegistryFind(n any, s any) *MockStore_OrgRegistryFind_Call {
+	return &MockStore_OrgRegistryFind_Call{Call: _e.mock.On("OrgRegistryFind", n, s)}
+}
+
+func (_c *MockStore_OrgRegistryFind_Call) Run(run func(n int64, s string)) *MockStore_OrgRegistryFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgRegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_OrgRegistryFind_Call {
+	_c.Call.Return(registry, err)
+	return _c
+}
+
+func (_c *MockStore_OrgRegistryFind_Call) RunAndReturn(run func(n int64, s string) (*model.Registry, error)) *MockStore_OrgRegistryFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRegistryList provides a mock function for the type MockStore
+func (_mock *MockStore) OrgRegistryList(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
+	ret := _mock.Called(n, listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRegistryList")
+	}
+
+	var r0 []*model.Registry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
+		return returnFunc(n, listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) []*model.Registry); ok {
+		r0 = returnFunc(n, listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Registry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(n, listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryList'
+type MockStore_OrgRegistryList_Call struct {
+	*mock.Call
+}
+
+// OrgRegistryList is a helper method to define mock.On call
+//   - n int64
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) OrgRegistryList(n any, listOptionsWithAll any) *MockStore_OrgRegistryList_Call {
+	return &MockStore_OrgRegistryList_Call{Call: _e.mock.On("OrgRegistryList", n, listOptionsWithAll)}
+}
+
+func (_c *MockStore_OrgRegistryList_Call) Run(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgRegistryList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *model.ListOptionsWithAll
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgRegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_OrgRegistryList_Call {
+	_c.Call.Return(registrys, err)
+	return _c
+}
+
+func (_c *MockStore_OrgRegistryList_Call) RunAndReturn(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_OrgRegistryList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgRepoList provides a mock function for the type MockStore
+func (_mock *MockStore) OrgRepoList(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Repo, error) {
+	ret := _mock.Called(org, listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgRepoList")
+	}
+
+	var r0 []*model.Repo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Org, *model.ListOptionsWithAll) ([]*model.Repo, error)); ok {
+		return returnFunc(org, listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Org, *model.ListOptionsWithAll) []*model.Repo); ok {
+		r0 = returnFunc(org, listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Repo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.Org, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(org, listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgRepoList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRepoList'
+type MockStore_OrgRepoList_Call struct {
+	*mock.Call
+}
+
+// OrgRepoList is a helper method to define mock.On call
+//   - org *model.Org
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) OrgRepoList(org any, listOptionsWithAll any) *MockStore_OrgRepoList_Call {
+	return &MockStore_OrgRepoList_Call{Call: _e.mock.On("OrgRepoList", org, listOptionsWithAll)}
+}
+
+func (_c *MockStore_OrgRepoList_Call) Run(run func(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgRepoList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Org
+		if args[0] != nil {
+			arg0 = args[0].(*model.Org)
+		}
+		var arg1 *model.ListOptionsWithAll
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgRepoList_Call) Return(repos []*model.Repo, err error) *MockStore_OrgRepoList_Call {
+	_c.Call.Return(repos, err)
+	return _c
+}
+
+func (_c *MockStore_OrgRepoList_Call) RunAndReturn(run func(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Repo, error)) *MockStore_OrgRepoList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecretFind provides a mock function for the type MockStore
+func (_mock *MockStore) OrgSecretFind(n int64, s string) (*model.Secret, error) {
+	ret := _mock.Called(n, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecretFind")
+	}
+
+	var r0 *model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.Secret, error)); ok {
+		return returnFunc(n, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.Secret); ok {
+		r0 = returnFunc(n, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(n, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgSecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretFind'
+type MockStore_OrgSecretFind_Call struct {
+	*mock.Call
+}
+
+// OrgSecretFind is a helper method to define mock.On call
+//   - n int64
+//   - s string
+func (_e *MockStore_Expecter) OrgSecretFind(n any, s any) *MockStore_OrgSecretFind_Call {
+	return &MockStore_OrgSecretFind_Call{Call: _e.mock.On("OrgSecretFind", n, s)}
+}
+
+func (_c *MockStore_OrgSecretFind_Call) Run(run func(n int64, s string)) *MockStore_OrgSecretFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgSecretFind_Call) Return(secret *model.Secret, err error) *MockStore_OrgSecretFind_Call {
+	_c.Call.Return(secret, err)
+	return _c
+}
+
+func (_c *MockStore_OrgSecretFind_Call) RunAndReturn(run func(n int64, s string) (*model.Secret, error)) *MockStore_OrgSecretFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecretList provides a mock function for the type MockStore
+func (_mock *MockStore) OrgSecretList(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
+	ret := _mock.Called(n, listOptionsWithAll)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecretList")
+	}
+
+	var r0 []*model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
+		return returnFunc(n, listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) []*model.Secret); ok {
+		r0 = returnFunc(n, listOptionsWithAll)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(n, listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_OrgSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretList'
+type MockStore_OrgSecretList_Call struct {
+	*mock.Call
+}
+
+// OrgSecretList is a helper method to define mock.On call
+//   - n int64
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) OrgSecretList(n any, listOptionsWithAll any) *MockStore_OrgSecretList_Call {
+	return &MockStore_OrgSecretList_Call{Call: _e.mock.On("OrgSecretList", n, listOptionsWithAll)}
+}
+
+func (_c *MockStore_OrgSecretList_Call) Run(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgSecretList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *model.ListOptionsWithAll
+		if args[1] != nil {
+			arg1 = args[1].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgSecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_OrgSecretList_Call {
+	_c.Call.Return(secrets, err)
+	return _c
+}
+
+func (_c *MockStore_OrgSecretList_Call) RunAndReturn(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_OrgSecretList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) OrgUpdate(org *model.Org) error {
+	ret := _mock.Called(org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgUpdate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Org) error); ok {
+		r0 = returnFunc(org)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_OrgUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgUpdate'
+type MockStore_OrgUpdate_Call struct {
+	*mock.Call
+}
+
+// OrgUpdate is a helper method to define mock.On call
+//   - org *model.Org
+func (_e *MockStore_Expecter) OrgUpdate(org any) *MockStore_OrgUpdate_Call {
+	return &MockStore_OrgUpdate_Call{Call: _e.mock.On("OrgUpdate", org)}
+}
+
+func (_c *MockStore_OrgUpdate_Call) Run(run func(org *model.Org)) *MockStore_OrgUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Org
+		if args[0] != nil {
+			arg0 = args[0].(*model.Org)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_OrgUpdate_Call) Return(err error) *MockStore_OrgUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_OrgUpdate_Call) RunAndReturn(run func(org *model.Org) error) *MockStore_OrgUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PermFind provides a mock function for the type MockStore
+func (_mock *MockStore) PermFind(user *model.User, repo *model.Repo) (*model.Perm, error) {
+	ret := _mock.Called(user, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermFind")
+	}
+
+	var r0 *model.Perm
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User, *model.Repo) (*model.Perm, error)); ok {
+		return returnFunc(user, repo)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User, *model.Repo) *model.Perm); ok {
+		r0 = returnFunc(user, repo)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Perm)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User, *model.Repo) error); ok {
+		r1 = returnFunc(user, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_PermFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermFind'
+type MockStore_PermFind_Call struct {
+	*mock.Call
+}
+
+// PermFind is a helper method to define mock.On call
+//   - user *model.User
+//   - repo *model.Repo
+func (_e *MockStore_Expecter) PermFind(user any, repo any) *MockStore_PermFind_Call {
+	return &MockStore_PermFind_Call{Call: _e.mock.On("PermFind", user, repo)}
+}
+
+func (_c *MockStore_PermFind_Call) Run(run func(user *model.User, repo *model.Repo)) *MockStore_PermFind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		var arg1 *model.Repo
+		if args[1] != nil {
+			arg1 = args[1].(*model.Repo)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PermFind_Call) Return(perm *model.Perm, err error) *MockStore_PermFind_Call {
+	_c.Call.Return(perm, err)
+	return _c
+}
+
+func (_c *MockStore_PermFind_Call) RunAndReturn(run func(user *model.User, repo *model.Repo) (*model.Perm, error)) *MockStore_PermFind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PermPrune provides a mock function for the type MockStore
+func (_mock *MockStore) PermPrune(userID int64, keepRepoIDs []int64) error {
+	ret := _mock.Called(userID, keepRepoIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermPrune")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64, []int64) error); ok {
+		r0 = returnFunc(userID, keepRepoIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PermPrune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermPrune'
+type MockStore_PermPrune_Call struct {
+	*mock.Call
+}
+
+// PermPrune is a helper method to define mock.On call
+//   - userID int64
+//   - keepRepoIDs []int64
+func (_e *MockStore_Expecter) PermPrune(userID any, keepRepoIDs any) *MockStore_PermPrune_Call {
+	return &MockStore_PermPrune_Call{Call: _e.mock.On("PermPrune", userID, keepRepoIDs)}
+}
+
+func (_c *MockStore_PermPrune_Call) Run(run func(userID int64, keepRepoIDs []int64)) *MockStore_PermPrune_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PermPrune_Call) Return(err error) *MockStore_PermPrune_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PermPrune_Call) RunAndReturn(run func(userID int64, keepRepoIDs []int64) error) *MockStore_PermPrune_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PermUpsert provides a mock function for the type MockStore
+func (_mock *MockStore) PermUpsert(perm *model.Perm) error {
+	ret := _mock.Called(perm)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermUpsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Perm) error); ok {
+		r0 = returnFunc(perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PermUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermUpsert'
+type MockStore_PermUpsert_Call struct {
+	*mock.Call
+}
+
+// PermUpsert is a helper method to define mock.On call
+//   - perm *model.Perm
+func (_e *MockStore_Expecter) PermUpsert(perm any) *MockStore_PermUpsert_Call {
+	return &MockStore_PermUpsert_Call{Call: _e.mock.On("PermUpsert", perm)}
+}
+
+func (_c *MockStore_PermUpsert_Call) Run(run func(perm *model.Perm)) *MockStore_PermUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Perm
+		if args[0] != nil {
+			arg0 = args[0].(*model.Perm)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PermUpsert_Call) Return(err error) *MockStore_PermUpsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PermUpsert_Call) RunAndReturn(run func(perm *model.Perm) error) *MockStore_PermUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenCreate provides a mock function for the type MockStore
+func (_mock *MockStore) PersonalAccessTokenCreate(personalAccessToken *model.PersonalAccessToken) error {
+	ret := _mock.Called(personalAccessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersonalAccessTokenCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.PersonalAccessToken) error); ok {
+		r0 = returnFunc(personalAccessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PersonalAccessTokenCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenCreate'
+type MockStore_PersonalAccessTokenCreate_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenCreate is a helper method to define mock.On call
+//   - personalAccessToken *model.PersonalAccessToken
+func (_e *MockStore_Expecter) PersonalAccessTokenCreate(personalAccessToken any) *MockStore_PersonalAccessTokenCreate_Call {
+	return &MockStore_PersonalAccessTokenCreate_Call{Call: _e.mock.On("PersonalAccessTokenCreate", personalAccessToken)}
+}
+
+func (_c *MockStore_PersonalAccessTokenCreate_Call) Run(run func(personalAccessToken *model.PersonalAccessToken)) *MockStore_PersonalAccessTokenCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.PersonalAccessToken
+		if args[0] != nil {
+			arg0 = args[0].(*model.PersonalAccessToken)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenCreate_Call) Return(err error) *MockStore_PersonalAccessTokenCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenCreate_Call) RunAndReturn(run func(personalAccessToken *model.PersonalAccessToken) error) *MockStore_PersonalAccessTokenCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenDelete provides a mock function for the type MockStore
+func (_mock *MockStore) PersonalAccessTokenDelete(personalAccessToken *model.PersonalAccessToken) error {
+	ret := _mock.Called(personalAccessToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersonalAccessTokenDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.PersonalAccessToken) error); ok {
+		r0 = returnFunc(personalAccessToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PersonalAccessTokenDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenDelete'
+type MockStore_PersonalAccessTokenDelete_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenDelete is a helper method to define mock.On call
+//   - personalAccessToken *model.PersonalAccessToken
+func (_e *MockStore_Expecter) PersonalAccessTokenDelete(personalAccessToken any) *MockStore_PersonalAccessTokenDelete_Call {
+	return &MockStore_PersonalAccessTokenDelete_Call{Call: _e.mock.On("PersonalAccessTokenDelete", personalAccessToken)}
+}
+
+func (_c *MockStore_PersonalAccessTokenDelete_Call) Run(run func(personalAccessToken *model.PersonalAccessToken)) *MockStore_PersonalAccessTokenDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.PersonalAccessToken
+		if args[0] != nil {
+			arg0 = args[0].(*model.PersonalAccessToken)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenDelete_Call) Return(err error) *MockStore_PersonalAccessTokenDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenDelete_Call) RunAndReturn(run func(personalAccessToken *model.PersonalAccessToken) error) *MockStore_PersonalAccessTokenDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenFind provides a mock function for the type MockStore
+func (_mock *MockStore) PersonalAccessTokenFind(user *model.User, n int64) (*model.PersonalAccessToken, error) {
+	ret := _mock.Called(user, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetActivePipelineList")
+		panic("no return value specified for PersonalAccessTokenFind")
 	}
 
-	var r0 []*model.Pipeline
+	var r0 *model.PersonalAccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo) ([]*model.Pipeline, error)); ok {
-		return returnFunc(repo)
+	if returnFunc, ok := ret.Get(0).(func(*model.User, int64) (*model.PersonalAccessToken, error)); ok {
+		return returnFunc(user, n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo) []*model.Pipeline); ok {
-		r0 = returnFunc(repo)
+	if returnFunc, ok := ret.Get(0).(func(*model.User, int64) *model.PersonalAccessToken); ok {
+		r0 = returnFunc(user, n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Pipeline)
+			r0 = ret.Get(0).(*model.PersonalAccessToken)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo) error); ok {
-		r1 = returnFunc(repo)
+	if returnFunc, ok := ret.Get(1).(func(*model.User, int64) error); ok {
+		r1 = returnFunc(user, n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetActivePipelineList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivePipelineList'
-type MockStore_GetActivePipelineList_Call struct {
+// MockStore_PersonalAccessTokenFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenFind'
+type MockStore_PersonalAccessTokenFind_Call struct {
 	*mock.Call
 }
 
-// GetActivePipelineList is a helper method to define mock.On call
-//   - repo *model.Repo
-func (_e *MockStore_Expecter) GetActivePipelineList(repo any) *MockStore_GetActivePipelineList_Call {
-	return &MockStore_GetActivePipelineList_Call{Call: _e.mock.On("GetActivePipelineList", repo)}
+// PersonalAccessTokenFind is a helper method to define mock.On call
+//   - user *model.User
+//   - n int64
+func (_e *MockStore_Expecter) PersonalAccessTokenFind(user any, n any) *MockStore_PersonalAccessTokenFind_Call {
+	return &MockStore_PersonalAccessTokenFind_Call{Call: _e.mock.On("PersonalAccessTokenFind", user, n)}
 }
 
-func (_c *MockStore_GetActivePipelineList_Call) Run(run func(repo *model.Repo)) *MockStore_GetActivePipelineList_Call {
+func (_c *MockStore_PersonalAccessTokenFind_Call) Run(run func(user *model.User, n int64)) *MockStore_PersonalAccessTokenFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
+			arg0 = args[0].(*model.User)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetActivePipelineList_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetActivePipelineList_Call {
-	_c.Call.Return(pipelines, err)
+func (_c *MockStore_PersonalAccessTokenFind_Call) Return(r0 *model.PersonalAccessToken, err error) *MockStore_PersonalAccessTokenFind_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_GetActivePipelineList_Call) RunAndReturn(run func(repo *model.Repo) ([]*model.Pipeline, error)) *MockStore_GetActivePipelineList_Call {
+func (_c *MockStore_PersonalAccessTokenFind_Call) RunAndReturn(run func(user *model.User, n int64) (*model.PersonalAccessToken, error)) *MockStore_PersonalAccessTokenFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipeline provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipeline(n int64) (*model.Pipeline, error) {
-	ret := _mock.Called(n)
+// PersonalAccessTokenList provides a mock function for the type MockStore
+func (_mock *MockStore) PersonalAccessTokenList(user *model.User) ([]*model.PersonalAccessToken, error) {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipeline")
+		panic("no return value specified for PersonalAccessTokenList")
 	}
 
-	var r0 *model.Pipeline
+	var r0 []*model.PersonalAccessToken
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Pipeline, error)); ok {
-		return returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) ([]*model.PersonalAccessToken, error)); ok {
+		return returnFunc(user)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *model.Pipeline); ok {
-		r0 = returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) []*model.PersonalAccessToken); ok {
+		r0 = returnFunc(user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Pipeline)
+			r0 = ret.Get(0).([]*model.PersonalAccessToken)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(n)
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetPipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipeline'
-type MockStore_GetPipeline_Call struct {
+// MockStore_PersonalAccessTokenList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenList'
+type MockStore_PersonalAccessTokenList_Call struct {
 	*mock.Call
 }
 
-// GetPipeline is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) GetPipeline(n any) *MockStore_GetPipeline_Call {
-	return &MockStore_GetPipeline_Call{Call: _e.mock.On("GetPipeline", n)}
+// PersonalAccessTokenList is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) PersonalAccessTokenList(user any) *MockStore_PersonalAccessTokenList_Call {
+	return &MockStore_PersonalAccessTokenList_Call{Call: _e.mock.On("PersonalAccessTokenList", user)}
 }
 
-func (_c *MockStore_GetPipeline_Call) Run(run func(n int64)) *MockStore_GetPipeline_Call {
+func (_c *MockStore_PersonalAccessTokenList_Call) Run(run func(user *model.User)) *MockStore_PersonalAccessTokenList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
@@ -1811,709 +5477,720 @@ func (_c *MockStore_GetPipeline_Call) Run(run func(n int64)) *MockStore_GetPipel
 	return _c
 }
 
-func (_c *MockStore_GetPipeline_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipeline_Call {
-	_c.Call.Return(pipeline, err)
+func (_c *MockStore_PersonalAccessTokenList_Call) Return(r0 []*model.PersonalAccessToken, err error) *MockStore_PersonalAccessTokenList_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_GetPipeline_Call) RunAndReturn(run func(n int64) (*model.Pipeline, error)) *MockStore_GetPipeline_Call {
+func (_c *MockStore_PersonalAccessTokenList_Call) RunAndReturn(run func(user *model.User) ([]*model.PersonalAccessToken, error)) *MockStore_PersonalAccessTokenList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineBadge provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineBadge(repo *model.Repo, s string, webhookEvents []model.WebhookEvent) (*model.Pipeline, error) {
-	ret := _mock.Called(repo, s, webhookEvents)
+// PersonalAccessTokenUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) PersonalAccessTokenUpdate(personalAccessToken *model.PersonalAccessToken) error {
+	ret := _mock.Called(personalAccessToken)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineBadge")
+		panic("no return value specified for PersonalAccessTokenUpdate")
 	}
 
-	var r0 *model.Pipeline
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, []model.WebhookEvent) (*model.Pipeline, error)); ok {
-		return returnFunc(repo, s, webhookEvents)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, []model.WebhookEvent) *model.Pipeline); ok {
-		r0 = returnFunc(repo, s, webhookEvents)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.PersonalAccessToken) error); ok {
+		r0 = returnFunc(personalAccessToken)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Pipeline)
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PersonalAccessTokenUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenUpdate'
+type MockStore_PersonalAccessTokenUpdate_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenUpdate is a helper method to define mock.On call
+//   - personalAccessToken *model.PersonalAccessToken
+func (_e *MockStore_Expecter) PersonalAccessTokenUpdate(personalAccessToken any) *MockStore_PersonalAccessTokenUpdate_Call {
+	return &MockStore_PersonalAccessTokenUpdate_Call{Call: _e.mock.On("PersonalAccessTokenUpdate", personalAccessToken)}
+}
+
+func (_c *MockStore_PersonalAccessTokenUpdate_Call) Run(run func(personalAccessToken *model.PersonalAccessToken)) *MockStore_PersonalAccessTokenUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.PersonalAccessToken
+		if args[0] != nil {
+			arg0 = args[0].(*model.PersonalAccessToken)
 		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenUpdate_Call) Return(err error) *MockStore_PersonalAccessTokenUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_PersonalAccessTokenUpdate_Call) RunAndReturn(run func(personalAccessToken *model.PersonalAccessToken) error) *MockStore_PersonalAccessTokenUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ping provides a mock function for the type MockStore
+func (_mock *MockStore) Ping() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string, []model.WebhookEvent) error); ok {
-		r1 = returnFunc(repo, s, webhookEvents)
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPipelineBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineBadge'
-type MockStore_GetPipelineBadge_Call struct {
+// MockStore_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
+type MockStore_Ping_Call struct {
 	*mock.Call
 }
 
-// GetPipelineBadge is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - s string
-//   - webhookEvents []model.WebhookEvent
-func (_e *MockStore_Expecter) GetPipelineBadge(repo any, s any, webhookEvents any) *MockStore_GetPipelineBadge_Call {
-	return &MockStore_GetPipelineBadge_Call{Call: _e.mock.On("GetPipelineBadge", repo, s, webhookEvents)}
+// Ping is a helper method to define mock.On call
+func (_e *MockStore_Expecter) Ping() *MockStore_Ping_Call {
+	return &MockStore_Ping_Call{Call: _e.mock.On("Ping")}
 }
 
-func (_c *MockStore_GetPipelineBadge_Call) Run(run func(repo *model.Repo, s string, webhookEvents []model.WebhookEvent)) *MockStore_GetPipelineBadge_Call {
+func (_c *MockStore_Ping_Call) Run(run func()) *MockStore_Ping_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStore_Ping_Call) Return(err error) *MockStore_Ping_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStore_Ping_Call) RunAndReturn(run func() error) *MockStore_Ping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PipelineConfigCreate provides a mock function for the type MockStore
+func (_mock *MockStore) PipelineConfigCreate(pipelineConfig *model.PipelineConfig) error {
+	ret := _mock.Called(pipelineConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineConfigCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.PipelineConfig) error); ok {
+		r0 = returnFunc(pipelineConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStore_PipelineConfigCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineConfigCreate'
+type MockStore_PipelineConfigCreate_Call struct {
+	*mock.Call
+}
+
+// PipelineConfigCreate is a helper method to define mock.On call
+//   - pipelineConfig *model.PipelineConfig
+func (_e *MockStore_Expecter) PipelineConfigCreate(pipelineConfig any) *MockStore_PipelineConfigCreate_Call {
+	return &MockStore_PipelineConfigCreate_Call{Call: _e.mock.On("PipelineConfigCreate", pipelineConfig)}
+}
+
+func (_c *MockStore_PipelineConfigCreate_Call) Run(run func(pipelineConfig *model.PipelineConfig)) *MockStore_PipelineConfigCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.PipelineConfig
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 []model.WebhookEvent
-		if args[2] != nil {
-			arg2 = args[2].([]model.WebhookEvent)
+			arg0 = args[0].(*model.PipelineConfig)
 		}
 		run(
 			arg0,
-			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineBadge_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineBadge_Call {
-	_c.Call.Return(pipeline, err)
+func (_c *MockStore_PipelineConfigCreate_Call) Return(err error) *MockStore_PipelineConfigCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineBadge_Call) RunAndReturn(run func(repo *model.Repo, s string, webhookEvents []model.WebhookEvent) (*model.Pipeline, error)) *MockStore_GetPipelineBadge_Call {
+func (_c *MockStore_PipelineConfigCreate_Call) RunAndReturn(run func(pipelineConfig *model.PipelineConfig) error) *MockStore_PipelineConfigCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineCount provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineCount() (int64, error) {
-	ret := _mock.Called()
+// PubSubListen provides a mock function for the type MockStore
+func (_mock *MockStore) PubSubListen(context1 context.Context) (<-chan struct{}, error) {
+	ret := _mock.Called(context1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineCount")
+		panic("no return value specified for PubSubListen")
 	}
 
-	var r0 int64
+	var r0 <-chan struct{}
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (<-chan struct{}, error)); ok {
+		return returnFunc(context1)
 	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) <-chan struct{}); ok {
+		r0 = returnFunc(context1)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(context1)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetPipelineCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineCount'
-type MockStore_GetPipelineCount_Call struct {
+// MockStore_PubSubListen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PubSubListen'
+type MockStore_PubSubListen_Call struct {
 	*mock.Call
 }
 
-// GetPipelineCount is a helper method to define mock.On call
-func (_e *MockStore_Expecter) GetPipelineCount() *MockStore_GetPipelineCount_Call {
-	return &MockStore_GetPipelineCount_Call{Call: _e.mock.On("GetPipelineCount")}
+// PubSubListen is a helper method to define mock.On call
+//   - context1 context.Context
+func (_e *MockStore_Expecter) PubSubListen(context1 any) *MockStore_PubSubListen_Call {
+	return &MockStore_PubSubListen_Call{Call: _e.mock.On("PubSubListen", context1)}
 }
 
-func (_c *MockStore_GetPipelineCount_Call) Run(run func()) *MockStore_GetPipelineCount_Call {
+func (_c *MockStore_PubSubListen_Call) Run(run func(context1 context.Context)) *MockStore_PubSubListen_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineCount_Call) Return(n int64, err error) *MockStore_GetPipelineCount_Call {
-	_c.Call.Return(n, err)
+func (_c *MockStore_PubSubListen_Call) Return(ch <-chan struct{}, err error) *MockStore_PubSubListen_Call {
+	_c.Call.Return(ch, err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetPipelineCount_Call {
+func (_c *MockStore_PubSubListen_Call) RunAndReturn(run func(context1 context.Context) (<-chan struct{}, error)) *MockStore_PubSubListen_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineLastBefore provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineLastBefore(repo *model.Repo, s string, n int64) (*model.Pipeline, error) {
-	ret := _mock.Called(repo, s, n)
+// PubSubMessageCreate provides a mock function for the type MockStore
+func (_mock *MockStore) PubSubMessageCreate(pubSubMessage *model.PubSubMessage) error {
+	ret := _mock.Called(pubSubMessage)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineLastBefore")
+		panic("no return value specified for PubSubMessageCreate")
 	}
 
-	var r0 *model.Pipeline
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, int64) (*model.Pipeline, error)); ok {
-		return returnFunc(repo, s, n)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string, int64) *model.Pipeline); ok {
-		r0 = returnFunc(repo, s, n)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Pipeline)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string, int64) error); ok {
-		r1 = returnFunc(repo, s, n)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.PubSubMessage) error); ok {
+		r0 = returnFunc(pubSubMessage)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPipelineLastBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineLastBefore'
-type MockStore_GetPipelineLastBefore_Call struct {
+// MockStore_PubSubMessageCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PubSubMessageCreate'
+type MockStore_PubSubMessageCreate_Call struct {
 	*mock.Call
 }
 
-// GetPipelineLastBefore is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - s string
-//   - n int64
-func (_e *MockStore_Expecter) GetPipelineLastBefore(repo any, s any, n any) *MockStore_GetPipelineLastBefore_Call {
-	return &MockStore_GetPipelineLastBefore_Call{Call: _e.mock.On("GetPipelineLastBefore", repo, s, n)}
+// PubSubMessageCreate is a helper method to define mock.On call
+//   - pubSubMessage *model.PubSubMessage
+func (_e *MockStore_Expecter) PubSubMessageCreate(pubSubMessage any) *MockStore_PubSubMessageCreate_Call {
+	return &MockStore_PubSubMessageCreate_Call{Call: _e.mock.On("PubSubMessageCreate", pubSubMessage)}
 }
 
-func (_c *MockStore_GetPipelineLastBefore_Call) Run(run func(repo *model.Repo, s string, n int64)) *MockStore_GetPipelineLastBefore_Call {
+func (_c *MockStore_PubSubMessageCreate_Call) Run(run func(pubSubMessage *model.PubSubMessage)) *MockStore_PubSubMessageCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.PubSubMessage
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
-		var arg2 int64
-		if args[2] != nil {
-			arg2 = args[2].(int64)
+			arg0 = args[0].(*model.PubSubMessage)
 		}
 		run(
 			arg0,
-			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineLastBefore_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineLastBefore_Call {
-	_c.Call.Return(pipeline, err)
+func (_c *MockStore_PubSubMessageCreate_Call) Return(err error) *MockStore_PubSubMessageCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineLastBefore_Call) RunAndReturn(run func(repo *model.Repo, s string, n int64) (*model.Pipeline, error)) *MockStore_GetPipelineLastBefore_Call {
+func (_c *MockStore_PubSubMessageCreate_Call) RunAndReturn(run func(pubSubMessage *model.PubSubMessage) error) *MockStore_PubSubMessageCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineLastByBranch provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineLastByBranch(repo *model.Repo, s string) (*model.Pipeline, error) {
-	ret := _mock.Called(repo, s)
+// PubSubMessageDeleteBefore provides a mock function for the type MockStore
+func (_mock *MockStore) PubSubMessageDeleteBefore(n int64) error {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineLastByBranch")
+		panic("no return value specified for PubSubMessageDeleteBefore")
 	}
 
-	var r0 *model.Pipeline
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Pipeline, error)); ok {
-		return returnFunc(repo, s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Pipeline); ok {
-		r0 = returnFunc(repo, s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Pipeline)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
-		r1 = returnFunc(repo, s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(n)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPipelineLastByBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineLastByBranch'
-type MockStore_GetPipelineLastByBranch_Call struct {
+// MockStore_PubSubMessageDeleteBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PubSubMessageDeleteBefore'
+type MockStore_PubSubMessageDeleteBefore_Call struct {
 	*mock.Call
 }
 
-// GetPipelineLastByBranch is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - s string
-func (_e *MockStore_Expecter) GetPipelineLastByBranch(repo any, s any) *MockStore_GetPipelineLastByBranch_Call {
-	return &MockStore_GetPipelineLastByBranch_Call{Call: _e.mock.On("GetPipelineLastByBranch", repo, s)}
+// PubSubMessageDeleteBefore is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) PubSubMessageDeleteBefore(n any) *MockStore_PubSubMessageDeleteBefore_Call {
+	return &MockStore_PubSubMessageDeleteBefore_Call{Call: _e.mock.On("PubSubMessageDeleteBefore", n)}
 }
 
-func (_c *MockStore_GetPipelineLastByBranch_Call) Run(run func(repo *model.Repo, s string)) *MockStore_GetPipelineLastByBranch_Call {
+func (_c *MockStore_PubSubMessageDeleteBefore_Call) Run(run func(n int64)) *MockStore_PubSubMessageDeleteBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineLastByBranch_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineLastByBranch_Call {
-	_c.Call.Return(pipeline, err)
+func (_c *MockStore_PubSubMessageDeleteBefore_Call) Return(err error) *MockStore_PubSubMessageDeleteBefore_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineLastByBranch_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Pipeline, error)) *MockStore_GetPipelineLastByBranch_Call {
+func (_c *MockStore_PubSubMessageDeleteBefore_Call) RunAndReturn(run func(n int64) error) *MockStore_PubSubMessageDeleteBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineList provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineList(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter) ([]*model.Pipeline, error) {
-	ret := _mock.Called(repo, listOptionsWithAll, pipelineFilter)
+// PubSubMessageList provides a mock function for the type MockStore
+func (_mock *MockStore) PubSubMessageList(n int64) ([]*model.PubSubMessage, error) {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineList")
+		panic("no return value specified for PubSubMessageList")
 	}
 
-	var r0 []*model.Pipeline
+	var r0 []*model.PubSubMessage
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) ([]*model.Pipeline, error)); ok {
-		return returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*model.PubSubMessage, error)); ok {
+		return returnFunc(n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) []*model.Pipeline); ok {
-		r0 = returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	if returnFunc, ok := ret.Get(0).(func(int64) []*model.PubSubMessage); ok {
+		r0 = returnFunc(n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Pipeline)
+			r0 = ret.Get(0).([]*model.PubSubMessage)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, *model.ListOptionsWithAll, *model.PipelineFilter) error); ok {
-		r1 = returnFunc(repo, listOptionsWithAll, pipelineFilter)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetPipelineList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineList'
-type MockStore_GetPipelineList_Call struct {
+// MockStore_PubSubMessageList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PubSubMessageList'
+type MockStore_PubSubMessageList_Call struct {
 	*mock.Call
 }
 
-// GetPipelineList is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - listOptionsWithAll *model.ListOptionsWithAll
-//   - pipelineFilter *model.PipelineFilter
-func (_e *MockStore_Expecter) GetPipelineList(repo any, listOptionsWithAll any, pipelineFilter any) *MockStore_GetPipelineList_Call {
-	return &MockStore_GetPipelineList_Call{Call: _e.mock.On("GetPipelineList", repo, listOptionsWithAll, pipelineFilter)}
+// PubSubMessageList is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) PubSubMessageList(n any) *MockStore_PubSubMessageList_Call {
+	return &MockStore_PubSubMessageList_Call{Call: _e.mock.On("PubSubMessageList", n)}
 }
 
-func (_c *MockStore_GetPipelineList_Call) Run(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter)) *MockStore_GetPipelineList_Call {
+func (_c *MockStore_PubSubMessageList_Call) Run(run func(n int64)) *MockStore_PubSubMessageList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 *model.ListOptionsWithAll
-		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
-		}
-		var arg2 *model.PipelineFilter
-		if args[2] != nil {
-			arg2 = args[2].(*model.PipelineFilter)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
-			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineList_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetPipelineList_Call {
-	_c.Call.Return(pipelines, err)
+func (_c *MockStore_PubSubMessageList_Call) Return(pubSubMessages []*model.PubSubMessage, err error) *MockStore_PubSubMessageList_Call {
+	_c.Call.Return(pubSubMessages, err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineList_Call) RunAndReturn(run func(repo *model.Repo, listOptionsWithAll *model.ListOptionsWithAll, pipelineFilter *model.PipelineFilter) ([]*model.Pipeline, error)) *MockStore_GetPipelineList_Call {
+func (_c *MockStore_PubSubMessageList_Call) RunAndReturn(run func(n int64) ([]*model.PubSubMessage, error)) *MockStore_PubSubMessageList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineNumber provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineNumber(repo *model.Repo, n int64) (*model.Pipeline, error) {
-	ret := _mock.Called(repo, n)
+// RegistryCreate provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryCreate(registry *model.Registry) error {
+	ret := _mock.Called(registry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineNumber")
+		panic("no return value specified for RegistryCreate")
 	}
 
-	var r0 *model.Pipeline
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) (*model.Pipeline, error)); ok {
-		return returnFunc(repo, n)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64) *model.Pipeline); ok {
-		r0 = returnFunc(repo, n)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Pipeline)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64) error); ok {
-		r1 = returnFunc(repo, n)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
+		r0 = returnFunc(registry)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPipelineNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineNumber'
-type MockStore_GetPipelineNumber_Call struct {
+// MockStore_RegistryCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryCreate'
+type MockStore_RegistryCreate_Call struct {
 	*mock.Call
 }
 
-// GetPipelineNumber is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - n int64
-func (_e *MockStore_Expecter) GetPipelineNumber(repo any, n any) *MockStore_GetPipelineNumber_Call {
-	return &MockStore_GetPipelineNumber_Call{Call: _e.mock.On("GetPipelineNumber", repo, n)}
+// RegistryCreate is a helper method to define mock.On call
+//   - registry *model.Registry
+func (_e *MockStore_Expecter) RegistryCreate(registry any) *MockStore_RegistryCreate_Call {
+	return &MockStore_RegistryCreate_Call{Call: _e.mock.On("RegistryCreate", registry)}
 }
 
-func (_c *MockStore_GetPipelineNumber_Call) Run(run func(repo *model.Repo, n int64)) *MockStore_GetPipelineNumber_Call {
+func (_c *MockStore_RegistryCreate_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Registry
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg0 = args[0].(*model.Registry)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineNumber_Call) Return(pipeline *model.Pipeline, err error) *MockStore_GetPipelineNumber_Call {
-	_c.Call.Return(pipeline, err)
+func (_c *MockStore_RegistryCreate_Call) Return(err error) *MockStore_RegistryCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineNumber_Call) RunAndReturn(run func(repo *model.Repo, n int64) (*model.Pipeline, error)) *MockStore_GetPipelineNumber_Call {
+func (_c *MockStore_RegistryCreate_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineQueue provides a mock function for the type MockStore
-func (_mock *MockStore) GetPipelineQueue() ([]*model.Feed, error) {
-	ret := _mock.Called()
+// RegistryDelete provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryDelete(registry *model.Registry) error {
+	ret := _mock.Called(registry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineQueue")
+		panic("no return value specified for RegistryDelete")
 	}
 
-	var r0 []*model.Feed
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*model.Feed, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() []*model.Feed); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Feed)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
+		r0 = returnFunc(registry)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetPipelineQueue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineQueue'
-type MockStore_GetPipelineQueue_Call struct {
+// MockStore_RegistryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryDelete'
+type MockStore_RegistryDelete_Call struct {
 	*mock.Call
 }
 
-// GetPipelineQueue is a helper method to define mock.On call
-func (_e *MockStore_Expecter) GetPipelineQueue() *MockStore_GetPipelineQueue_Call {
-	return &MockStore_GetPipelineQueue_Call{Call: _e.mock.On("GetPipelineQueue")}
+// RegistryDelete is a helper method to define mock.On call
+//   - registry *model.Registry
+func (_e *MockStore_Expecter) RegistryDelete(registry any) *MockStore_RegistryDelete_Call {
+	return &MockStore_RegistryDelete_Call{Call: _e.mock.On("RegistryDelete", registry)}
 }
 
-func (_c *MockStore_GetPipelineQueue_Call) Run(run func()) *MockStore_GetPipelineQueue_Call {
+func (_c *MockStore_RegistryDelete_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *model.Registry
+		if args[0] != nil {
+			arg0 = args[0].(*model.Registry)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetPipelineQueue_Call) Return(feeds []*model.Feed, err error) *MockStore_GetPipelineQueue_Call {
-	_c.Call.Return(feeds, err)
+func (_c *MockStore_RegistryDelete_Call) Return(err error) *MockStore_RegistryDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetPipelineQueue_Call) RunAndReturn(run func() ([]*model.Feed, error)) *MockStore_GetPipelineQueue_Call {
+func (_c *MockStore_RegistryDelete_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepo provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepo(n int64) (*model.Repo, error) {
-	ret := _mock.Called(n)
+// RegistryFind provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryFind(repo *model.Repo, s string) (*model.Registry, error) {
+	ret := _mock.Called(repo, s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepo")
+		panic("no return value specified for RegistryFind")
 	}
 
-	var r0 *model.Repo
+	var r0 *model.Registry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Repo, error)); ok {
-		return returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Registry, error)); ok {
+		return returnFunc(repo, s)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *model.Repo); ok {
-		r0 = returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Registry); ok {
+		r0 = returnFunc(repo, s)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Repo)
+			r0 = ret.Get(0).(*model.Registry)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(n)
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
+		r1 = returnFunc(repo, s)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepo'
-type MockStore_GetRepo_Call struct {
+// MockStore_RegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryFind'
+type MockStore_RegistryFind_Call struct {
 	*mock.Call
 }
 
-// GetRepo is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) GetRepo(n any) *MockStore_GetRepo_Call {
-	return &MockStore_GetRepo_Call{Call: _e.mock.On("GetRepo", n)}
+// RegistryFind is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - s string
+func (_e *MockStore_Expecter) RegistryFind(repo any, s any) *MockStore_RegistryFind_Call {
+	return &MockStore_RegistryFind_Call{Call: _e.mock.On("RegistryFind", repo, s)}
 }
 
-func (_c *MockStore_GetRepo_Call) Run(run func(n int64)) *MockStore_GetRepo_Call {
+func (_c *MockStore_RegistryFind_Call) Run(run func(repo *model.Repo, s string)) *MockStore_RegistryFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRepo_Call) Return(repo *model.Repo, err error) *MockStore_GetRepo_Call {
-	_c.Call.Return(repo, err)
+func (_c *MockStore_RegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_RegistryFind_Call {
+	_c.Call.Return(registry, err)
 	return _c
 }
 
-func (_c *MockStore_GetRepo_Call) RunAndReturn(run func(n int64) (*model.Repo, error)) *MockStore_GetRepo_Call {
+func (_c *MockStore_RegistryFind_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Registry, error)) *MockStore_RegistryFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoCount provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepoCount() (int64, error) {
-	ret := _mock.Called()
+// RegistryList provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryList(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
+	ret := _mock.Called(repo, b, listOptionsWithAll)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoCount")
+		panic("no return value specified for RegistryList")
 	}
 
-	var r0 int64
+	var r0 []*model.Registry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
+		return returnFunc(repo, b, listOptionsWithAll)
 	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) []*model.Registry); ok {
+		r0 = returnFunc(repo, b, listOptionsWithAll)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Registry)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, bool, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(repo, b, listOptionsWithAll)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetRepoCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoCount'
-type MockStore_GetRepoCount_Call struct {
+// MockStore_RegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryList'
+type MockStore_RegistryList_Call struct {
 	*mock.Call
 }
 
-// GetRepoCount is a helper method to define mock.On call
-func (_e *MockStore_Expecter) GetRepoCount() *MockStore_GetRepoCount_Call {
-	return &MockStore_GetRepoCount_Call{Call: _e.mock.On("GetRepoCount")}
+// RegistryList is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - b bool
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) RegistryList(repo any, b any, listOptionsWithAll any) *MockStore_RegistryList_Call {
+	return &MockStore_RegistryList_Call{Call: _e.mock.On("RegistryList", repo, b, listOptionsWithAll)}
 }
 
-func (_c *MockStore_GetRepoCount_Call) Run(run func()) *MockStore_GetRepoCount_Call {
+func (_c *MockStore_RegistryList_Call) Run(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_RegistryList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 *model.ListOptionsWithAll
+		if args[2] != nil {
+			arg2 = args[2].(*model.ListOptionsWithAll)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRepoCount_Call) Return(n int64, err error) *MockStore_GetRepoCount_Call {
-	_c.Call.Return(n, err)
+func (_c *MockStore_RegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_RegistryList_Call {
+	_c.Call.Return(registrys, err)
 	return _c
 }
 
-func (_c *MockStore_GetRepoCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetRepoCount_Call {
+func (_c *MockStore_RegistryList_Call) RunAndReturn(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_RegistryList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoForgeID provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepoForgeID(n int64, forgeRemoteID model.ForgeRemoteID) (*model.Repo, error) {
-	ret := _mock.Called(n, forgeRemoteID)
+// RegistryListAll provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryListAll() ([]*model.Registry, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoForgeID")
+		panic("no return value specified for RegistryListAll")
 	}
 
-	var r0 *model.Repo
+	var r0 []*model.Registry
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) (*model.Repo, error)); ok {
-		return returnFunc(n, forgeRemoteID)
+	if returnFunc, ok := ret.Get(0).(func() ([]*model.Registry, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) *model.Repo); ok {
-		r0 = returnFunc(n, forgeRemoteID)
+	if returnFunc, ok := ret.Get(0).(func() []*model.Registry); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Repo)
+			r0 = ret.Get(0).([]*model.Registry)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID) error); ok {
-		r1 = returnFunc(n, forgeRemoteID)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetRepoForgeID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoForgeID'
-type MockStore_GetRepoForgeID_Call struct {
+// MockStore_RegistryListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryListAll'
+type MockStore_RegistryListAll_Call struct {
 	*mock.Call
 }
 
-// GetRepoForgeID is a helper method to define mock.On call
-//   - n int64
-//   - forgeRemoteID model.ForgeRemoteID
-func (_e *MockStore_Expecter) GetRepoForgeID(n any, forgeRemoteID any) *MockStore_GetRepoForgeID_Call {
-	return &MockStore_GetRepoForgeID_Call{Call: _e.mock.On("GetRepoForgeID", n, forgeRemoteID)}
+// RegistryListAll is a helper method to define mock.On call
+func (_e *MockStore_Expecter) RegistryListAll() *MockStore_RegistryListAll_Call {
+	return &MockStore_RegistryListAll_Call{Call: _e.mock.On("RegistryListAll")}
 }
 
-func (_c *MockStore_GetRepoForgeID_Call) Run(run func(n int64, forgeRemoteID model.ForgeRemoteID)) *MockStore_GetRepoForgeID_Call {
+func (_c *MockStore_RegistryListAll_Call) Run(run func()) *MockStore_RegistryListAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
-		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 model.ForgeRemoteID
-		if args[1] != nil {
-			arg1 = args[1].(model.ForgeRemoteID)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRepoForgeID_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoForgeID_Call {
-	_c.Call.Return(repo, err)
+func (_c *MockStore_RegistryListAll_Call) Return(registrys []*model.Registry, err error) *MockStore_RegistryListAll_Call {
+	_c.Call.Return(registrys, err)
 	return _c
 }
 
-func (_c *MockStore_GetRepoForgeID_Call) RunAndReturn(run func(n int64, forgeRemoteID model.ForgeRemoteID) (*model.Repo, error)) *MockStore_GetRepoForgeID_Call {
+func (_c *MockStore_RegistryListAll_Call) RunAndReturn(run func() ([]*model.Registry, error)) *MockStore_RegistryListAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoLatestPipelines provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepoLatestPipelines(int64s []int64) ([]*model.Pipeline, error) {
-	ret := _mock.Called(int64s)
+// RegistryUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) RegistryUpdate(registry *model.Registry) error {
+	ret := _mock.Called(registry)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoLatestPipelines")
+		panic("no return value specified for RegistryUpdate")
 	}
 
-	var r0 []*model.Pipeline
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func([]int64) ([]*model.Pipeline, error)); ok {
-		return returnFunc(int64s)
-	}
-	if returnFunc, ok := ret.Get(0).(func([]int64) []*model.Pipeline); ok {
-		r0 = returnFunc(int64s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Pipeline)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func([]int64) error); ok {
-		r1 = returnFunc(int64s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
+		r0 = returnFunc(registry)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetRepoLatestPipelines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoLatestPipelines'
-type MockStore_GetRepoLatestPipelines_Call struct {
+// MockStore_RegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryUpdate'
+type MockStore_RegistryUpdate_Call struct {
 	*mock.Call
 }
 
-// GetRepoLatestPipelines is a helper method to define mock.On call
-//   - int64s []int64
-func (_e *MockStore_Expecter) GetRepoLatestPipelines(int64s any) *MockStore_GetRepoLatestPipelines_Call {
-	return &MockStore_GetRepoLatestPipelines_Call{Call: _e.mock.On("GetRepoLatestPipelines", int64s)}
+// RegistryUpdate is a helper method to define mock.On call
+//   - registry *model.Registry
+func (_e *MockStore_Expecter) RegistryUpdate(registry any) *MockStore_RegistryUpdate_Call {
+	return &MockStore_RegistryUpdate_Call{Call: _e.mock.On("RegistryUpdate", registry)}
 }
 
-func (_c *MockStore_GetRepoLatestPipelines_Call) Run(run func(int64s []int64)) *MockStore_GetRepoLatestPipelines_Call {
+func (_c *MockStore_RegistryUpdate_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 []int64
+		var arg0 *model.Registry
 		if args[0] != nil {
-			arg0 = args[0].([]int64)
+			arg0 = args[0].(*model.Registry)
 		}
 		run(
 			arg0,
@@ -2522,196 +6199,208 @@ func (_c *MockStore_GetRepoLatestPipelines_Call) Run(run func(int64s []int64)) *
 	return _c
 }
 
-func (_c *MockStore_GetRepoLatestPipelines_Call) Return(pipelines []*model.Pipeline, err error) *MockStore_GetRepoLatestPipelines_Call {
-	_c.Call.Return(pipelines, err)
+func (_c *MockStore_RegistryUpdate_Call) Return(err error) *MockStore_RegistryUpdate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetRepoLatestPipelines_Call) RunAndReturn(run func(int64s []int64) ([]*model.Pipeline, error)) *MockStore_GetRepoLatestPipelines_Call {
+func (_c *MockStore_RegistryUpdate_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoName provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepoName(s string) (*model.Repo, error) {
-	ret := _mock.Called(s)
+// RepoList provides a mock function for the type MockStore
+func (_mock *MockStore) RepoList(user *model.User, owned bool, active bool, filter *model.RepoFilter) ([]*model.Repo, error) {
+	ret := _mock.Called(user, owned, active, filter)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoName")
+		panic("no return value specified for RepoList")
 	}
 
-	var r0 *model.Repo
+	var r0 []*model.Repo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*model.Repo, error)); ok {
-		return returnFunc(s)
+	if returnFunc, ok := ret.Get(0).(func(*model.User, bool, bool, *model.RepoFilter) ([]*model.Repo, error)); ok {
+		return returnFunc(user, owned, active, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *model.Repo); ok {
-		r0 = returnFunc(s)
+	if returnFunc, ok := ret.Get(0).(func(*model.User, bool, bool, *model.RepoFilter) []*model.Repo); ok {
+		r0 = returnFunc(user, owned, active, filter)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Repo)
+			r0 = ret.Get(0).([]*model.Repo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(s)
+	if returnFunc, ok := ret.Get(1).(func(*model.User, bool, bool, *model.RepoFilter) error); ok {
+		r1 = returnFunc(user, owned, active, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetRepoName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoName'
-type MockStore_GetRepoName_Call struct {
+// MockStore_RepoList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoList'
+type MockStore_RepoList_Call struct {
 	*mock.Call
 }
 
-// GetRepoName is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) GetRepoName(s any) *MockStore_GetRepoName_Call {
-	return &MockStore_GetRepoName_Call{Call: _e.mock.On("GetRepoName", s)}
+// RepoList is a helper method to define mock.On call
+//   - user *model.User
+//   - owned bool
+//   - active bool
+//   - filter *model.RepoFilter
+func (_e *MockStore_Expecter) RepoList(user any, owned any, active any, filter any) *MockStore_RepoList_Call {
+	return &MockStore_RepoList_Call{Call: _e.mock.On("RepoList", user, owned, active, filter)}
 }
 
-func (_c *MockStore_GetRepoName_Call) Run(run func(s string)) *MockStore_GetRepoName_Call {
+func (_c *MockStore_RepoList_Call) Run(run func(user *model.User, owned bool, active bool, filter *model.RepoFilter)) *MockStore_RepoList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.User)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 *model.RepoFilter
+		if args[3] != nil {
+			arg3 = args[3].(*model.RepoFilter)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRepoName_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoName_Call {
-	_c.Call.Return(repo, err)
+func (_c *MockStore_RepoList_Call) Return(repos []*model.Repo, err error) *MockStore_RepoList_Call {
+	_c.Call.Return(repos, err)
 	return _c
 }
 
-func (_c *MockStore_GetRepoName_Call) RunAndReturn(run func(s string) (*model.Repo, error)) *MockStore_GetRepoName_Call {
+func (_c *MockStore_RepoList_Call) RunAndReturn(run func(user *model.User, owned bool, active bool, filter *model.RepoFilter) ([]*model.Repo, error)) *MockStore_RepoList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoNameFallback provides a mock function for the type MockStore
-func (_mock *MockStore) GetRepoNameFallback(forgeID int64, remoteID model.ForgeRemoteID, fullName string) (*model.Repo, error) {
-	ret := _mock.Called(forgeID, remoteID, fullName)
+// RepoListAll provides a mock function for the type MockStore
+func (_mock *MockStore) RepoListAll(active bool, p *model.ListOptionsWithAll) ([]*model.Repo, error) {
+	ret := _mock.Called(active, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoNameFallback")
+		panic("no return value specified for RepoListAll")
 	}
 
-	var r0 *model.Repo
+	var r0 []*model.Repo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID, string) (*model.Repo, error)); ok {
-		return returnFunc(forgeID, remoteID, fullName)
+	if returnFunc, ok := ret.Get(0).(func(bool, *model.ListOptionsWithAll) ([]*model.Repo, error)); ok {
+		return returnFunc(active, p)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID, string) *model.Repo); ok {
-		r0 = returnFunc(forgeID, remoteID, fullName)
+	if returnFunc, ok := ret.Get(0).(func(bool, *model.ListOptionsWithAll) []*model.Repo); ok {
+		r0 = returnFunc(active, p)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Repo)
+			r0 = ret.Get(0).([]*model.Repo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID, string) error); ok {
-		r1 = returnFunc(forgeID, remoteID, fullName)
+	if returnFunc, ok := ret.Get(1).(func(bool, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(active, p)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetRepoNameFallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoNameFallback'
-type MockStore_GetRepoNameFallback_Call struct {
+// MockStore_RepoListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoListAll'
+type MockStore_RepoListAll_Call struct {
 	*mock.Call
 }
 
-// GetRepoNameFallback is a helper method to define mock.On call
-//   - forgeID int64
-//   - remoteID model.ForgeRemoteID
-//   - fullName string
-func (_e *MockStore_Expecter) GetRepoNameFallback(forgeID any, remoteID any, fullName any) *MockStore_GetRepoNameFallback_Call {
-	return &MockStore_GetRepoNameFallback_Call{Call: _e.mock.On("GetRepoNameFallback", forgeID, remoteID, fullName)}
+// RepoListAll is a helper method to define mock.On call
+//   - active bool
+//   - p *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) RepoListAll(active any, p any) *MockStore_RepoListAll_Call {
+	return &MockStore_RepoListAll_Call{Call: _e.mock.On("RepoListAll", active, p)}
 }
 
-func (_c *MockStore_GetRepoNameFallback_Call) Run(run func(forgeID int64, remoteID model.ForgeRemoteID, fullName string)) *MockStore_GetRepoNameFallback_Call {
+func (_c *MockStore_RepoListAll_Call) Run(run func(active bool, p *model.ListOptionsWithAll)) *MockStore_RepoListAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 bool
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(bool)
 		}
-		var arg1 model.ForgeRemoteID
+		var arg1 *model.ListOptionsWithAll
 		if args[1] != nil {
-			arg1 = args[1].(model.ForgeRemoteID)
-		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg1 = args[1].(*model.ListOptionsWithAll)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetRepoNameFallback_Call) Return(repo *model.Repo, err error) *MockStore_GetRepoNameFallback_Call {
-	_c.Call.Return(repo, err)
+func (_c *MockStore_RepoListAll_Call) Return(repos []*model.Repo, err error) *MockStore_RepoListAll_Call {
+	_c.Call.Return(repos, err)
 	return _c
 }
 
-func (_c *MockStore_GetRepoNameFallback_Call) RunAndReturn(run func(forgeID int64, remoteID model.ForgeRemoteID, fullName string) (*model.Repo, error)) *MockStore_GetRepoNameFallback_Call {
+func (_c *MockStore_RepoListAll_Call) RunAndReturn(run func(active bool, p *model.ListOptionsWithAll) ([]*model.Repo, error)) *MockStore_RepoListAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUser provides a mock function for the type MockStore
-func (_mock *MockStore) GetUser(n int64) (*model.User, error) {
-	ret := _mock.Called(n)
+// RepoListLatest provides a mock function for the type MockStore
+func (_mock *MockStore) RepoListLatest(user *model.User) ([]*model.Feed, error) {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUser")
+		panic("no return value specified for RepoListLatest")
 	}
 
-	var r0 *model.User
+	var r0 []*model.Feed
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*model.User, error)); ok {
-		return returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) ([]*model.Feed, error)); ok {
+		return returnFunc(user)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *model.User); ok {
-		r0 = returnFunc(n)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) []*model.Feed); ok {
+		r0 = returnFunc(user)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
+			r0 = ret.Get(0).([]*model.Feed)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(n)
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUser'
-type MockStore_GetUser_Call struct {
+// MockStore_RepoListLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoListLatest'
+type MockStore_RepoListLatest_Call struct {
 	*mock.Call
 }
 
-// GetUser is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) GetUser(n any) *MockStore_GetUser_Call {
-	return &MockStore_GetUser_Call{Call: _e.mock.On("GetUser", n)}
+// RepoListLatest is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) RepoListLatest(user any) *MockStore_RepoListLatest_Call {
+	return &MockStore_RepoListLatest_Call{Call: _e.mock.On("RepoListLatest", user)}
 }
 
-func (_c *MockStore_GetUser_Call) Run(run func(n int64)) *MockStore_GetUser_Call {
+func (_c *MockStore_RepoListLatest_Call) Run(run func(user *model.User)) *MockStore_RepoListLatest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
@@ -2720,65 +6409,63 @@ func (_c *MockStore_GetUser_Call) Run(run func(n int64)) *MockStore_GetUser_Call
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) Return(user *model.User, err error) *MockStore_GetUser_Call {
-	_c.Call.Return(user, err)
+func (_c *MockStore_RepoListLatest_Call) Return(feeds []*model.Feed, err error) *MockStore_RepoListLatest_Call {
+	_c.Call.Return(feeds, err)
 	return _c
 }
 
-func (_c *MockStore_GetUser_Call) RunAndReturn(run func(n int64) (*model.User, error)) *MockStore_GetUser_Call {
+func (_c *MockStore_RepoListLatest_Call) RunAndReturn(run func(user *model.User) ([]*model.Feed, error)) *MockStore_RepoListLatest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByLogin provides a mock function for the type MockStore
-func (_mock *MockStore) GetUserByLogin(n int64, s string) (*model.User, error) {
-	ret := _mock.Called(n, s)
+// RepoPollClaim provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollClaim(poll *model.RepoPoll, until int64) (bool, error) {
+	ret := _mock.Called(poll, until)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByLogin")
+		panic("no return value specified for RepoPollClaim")
 	}
 
-	var r0 *model.User
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.User, error)); ok {
-		return returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(*model.RepoPoll, int64) (bool, error)); ok {
+		return returnFunc(poll, until)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.User); ok {
-		r0 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(*model.RepoPoll, int64) bool); ok {
+		r0 = returnFunc(poll, until)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
-		r1 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(1).(func(*model.RepoPoll, int64) error); ok {
+		r1 = returnFunc(poll, until)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetUserByLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByLogin'
-type MockStore_GetUserByLogin_Call struct {
+// MockStore_RepoPollClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollClaim'
+type MockStore_RepoPollClaim_Call struct {
 	*mock.Call
 }
 
-// GetUserByLogin is a helper method to define mock.On call
-//   - n int64
-//   - s string
-func (_e *MockStore_Expecter) GetUserByLogin(n any, s any) *MockStore_GetUserByLogin_Call {
-	return &MockStore_GetUserByLogin_Call{Call: _e.mock.On("GetUserByLogin", n, s)}
+// RepoPollClaim is a helper method to define mock.On call
+//   - poll *model.RepoPoll
+//   - until int64
+func (_e *MockStore_Expecter) RepoPollClaim(poll any, until any) *MockStore_RepoPollClaim_Call {
+	return &MockStore_RepoPollClaim_Call{Call: _e.mock.On("RepoPollClaim", poll, until)}
 }
 
-func (_c *MockStore_GetUserByLogin_Call) Run(run func(n int64, s string)) *MockStore_GetUserByLogin_Call {
+func (_c *MockStore_RepoPollClaim_Call) Run(run func(poll *model.RepoPoll, until int64)) *MockStore_RepoPollClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.RepoPoll
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.RepoPoll)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -2788,181 +6475,162 @@ func (_c *MockStore_GetUserByLogin_Call) Run(run func(n int64, s string)) *MockS
 	return _c
 }
 
-func (_c *MockStore_GetUserByLogin_Call) Return(user *model.User, err error) *MockStore_GetUserByLogin_Call {
-	_c.Call.Return(user, err)
+func (_c *MockStore_RepoPollClaim_Call) Return(r0 bool, err error) *MockStore_RepoPollClaim_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_GetUserByLogin_Call) RunAndReturn(run func(n int64, s string) (*model.User, error)) *MockStore_GetUserByLogin_Call {
+func (_c *MockStore_RepoPollClaim_Call) RunAndReturn(run func(poll *model.RepoPoll, until int64) (bool, error)) *MockStore_RepoPollClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserByRemoteID provides a mock function for the type MockStore
-func (_mock *MockStore) GetUserByRemoteID(n int64, forgeRemoteID model.ForgeRemoteID) (*model.User, error) {
-	ret := _mock.Called(n, forgeRemoteID)
+// RepoPollCreate provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollCreate(repoPoll *model.RepoPoll) error {
+	ret := _mock.Called(repoPoll)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserByRemoteID")
+		panic("no return value specified for RepoPollCreate")
 	}
 
-	var r0 *model.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) (*model.User, error)); ok {
-		return returnFunc(n, forgeRemoteID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64, model.ForgeRemoteID) *model.User); ok {
-		r0 = returnFunc(n, forgeRemoteID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64, model.ForgeRemoteID) error); ok {
-		r1 = returnFunc(n, forgeRemoteID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.RepoPoll) error); ok {
+		r0 = returnFunc(repoPoll)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetUserByRemoteID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserByRemoteID'
-type MockStore_GetUserByRemoteID_Call struct {
+// MockStore_RepoPollCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollCreate'
+type MockStore_RepoPollCreate_Call struct {
 	*mock.Call
 }
 
-// GetUserByRemoteID is a helper method to define mock.On call
-//   - n int64
-//   - forgeRemoteID model.ForgeRemoteID
-func (_e *MockStore_Expecter) GetUserByRemoteID(n any, forgeRemoteID any) *MockStore_GetUserByRemoteID_Call {
-	return &MockStore_GetUserByRemoteID_Call{Call: _e.mock.On("GetUserByRemoteID", n, forgeRemoteID)}
+// RepoPollCreate is a helper method to define mock.On call
+//   - repoPoll *model.RepoPoll
+func (_e *MockStore_Expecter) RepoPollCreate(repoPoll any) *MockStore_RepoPollCreate_Call {
+	return &MockStore_RepoPollCreate_Call{Call: _e.mock.On("RepoPollCreate", repoPoll)}
 }
 
-func (_c *MockStore_GetUserByRemoteID_Call) Run(run func(n int64, forgeRemoteID model.ForgeRemoteID)) *MockStore_GetUserByRemoteID_Call {
+func (_c *MockStore_RepoPollCreate_Call) Run(run func(repoPoll *model.RepoPoll)) *MockStore_RepoPollCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.RepoPoll
 		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 model.ForgeRemoteID
-		if args[1] != nil {
-			arg1 = args[1].(model.ForgeRemoteID)
+			arg0 = args[0].(*model.RepoPoll)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GetUserByRemoteID_Call) Return(user *model.User, err error) *MockStore_GetUserByRemoteID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockStore_RepoPollCreate_Call) Return(err error) *MockStore_RepoPollCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetUserByRemoteID_Call) RunAndReturn(run func(n int64, forgeRemoteID model.ForgeRemoteID) (*model.User, error)) *MockStore_GetUserByRemoteID_Call {
+func (_c *MockStore_RepoPollCreate_Call) RunAndReturn(run func(repoPoll *model.RepoPoll) error) *MockStore_RepoPollCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserCount provides a mock function for the type MockStore
-func (_mock *MockStore) GetUserCount() (int64, error) {
-	ret := _mock.Called()
+// RepoPollDelete provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollDelete(repoID int64) error {
+	ret := _mock.Called(repoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserCount")
+		panic("no return value specified for RepoPollDelete")
 	}
 
-	var r0 int64
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
-	} else {
-		r0 = ret.Get(0).(int64)
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(repoID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GetUserCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserCount'
-type MockStore_GetUserCount_Call struct {
+// MockStore_RepoPollDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollDelete'
+type MockStore_RepoPollDelete_Call struct {
 	*mock.Call
 }
 
-// GetUserCount is a helper method to define mock.On call
-func (_e *MockStore_Expecter) GetUserCount() *MockStore_GetUserCount_Call {
-	return &MockStore_GetUserCount_Call{Call: _e.mock.On("GetUserCount")}
+// RepoPollDelete is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockStore_Expecter) RepoPollDelete(repoID any) *MockStore_RepoPollDelete_Call {
+	return &MockStore_RepoPollDelete_Call{Call: _e.mock.On("RepoPollDelete", repoID)}
 }
 
-func (_c *MockStore_GetUserCount_Call) Run(run func()) *MockStore_GetUserCount_Call {
+func (_c *MockStore_RepoPollDelete_Call) Run(run func(repoID int64)) *MockStore_RepoPollDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
-
-func (_c *MockStore_GetUserCount_Call) Return(n int64, err error) *MockStore_GetUserCount_Call {
-	_c.Call.Return(n, err)
+
+func (_c *MockStore_RepoPollDelete_Call) Return(err error) *MockStore_RepoPollDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GetUserCount_Call) RunAndReturn(run func() (int64, error)) *MockStore_GetUserCount_Call {
+func (_c *MockStore_RepoPollDelete_Call) RunAndReturn(run func(repoID int64) error) *MockStore_RepoPollDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUserList provides a mock function for the type MockStore
-func (_mock *MockStore) GetUserList(p *model.ListOptionsWithAll) ([]*model.User, error) {
-	ret := _mock.Called(p)
+// RepoPollFind provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollFind(repoID int64) (*model.RepoPoll, error) {
+	ret := _mock.Called(repoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserList")
+		panic("no return value specified for RepoPollFind")
 	}
 
-	var r0 []*model.User
+	var r0 *model.RepoPoll
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.User, error)); ok {
-		return returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.RepoPoll, error)); ok {
+		return returnFunc(repoID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.User); ok {
-		r0 = returnFunc(p)
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.RepoPoll); ok {
+		r0 = returnFunc(repoID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.User)
+			r0 = ret.Get(0).(*model.RepoPoll)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(p)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(repoID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GetUserList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserList'
-type MockStore_GetUserList_Call struct {
+// MockStore_RepoPollFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollFind'
+type MockStore_RepoPollFind_Call struct {
 	*mock.Call
 }
 
-// GetUserList is a helper method to define mock.On call
-//   - p *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) GetUserList(p any) *MockStore_GetUserList_Call {
-	return &MockStore_GetUserList_Call{Call: _e.mock.On("GetUserList", p)}
+// RepoPollFind is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockStore_Expecter) RepoPollFind(repoID any) *MockStore_RepoPollFind_Call {
+	return &MockStore_RepoPollFind_Call{Call: _e.mock.On("RepoPollFind", repoID)}
 }
 
-func (_c *MockStore_GetUserList_Call) Run(run func(p *model.ListOptionsWithAll)) *MockStore_GetUserList_Call {
+func (_c *MockStore_RepoPollFind_Call) Run(run func(repoID int64)) *MockStore_RepoPollFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.ListOptionsWithAll
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.ListOptionsWithAll)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
@@ -2971,122 +6639,123 @@ func (_c *MockStore_GetUserList_Call) Run(run func(p *model.ListOptionsWithAll))
 	return _c
 }
 
-func (_c *MockStore_GetUserList_Call) Return(users []*model.User, err error) *MockStore_GetUserList_Call {
-	_c.Call.Return(users, err)
+func (_c *MockStore_RepoPollFind_Call) Return(r0 *model.RepoPoll, err error) *MockStore_RepoPollFind_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_GetUserList_Call) RunAndReturn(run func(p *model.ListOptionsWithAll) ([]*model.User, error)) *MockStore_GetUserList_Call {
+func (_c *MockStore_RepoPollFind_Call) RunAndReturn(run func(repoID int64) (*model.RepoPoll, error)) *MockStore_RepoPollFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryFind provides a mock function for the type MockStore
-func (_mock *MockStore) GlobalRegistryFind(s string) (*model.Registry, error) {
-	ret := _mock.Called(s)
+// RepoPollListDue provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollListDue(now int64, afterID int64, limit int) ([]*model.Repo, error) {
+	ret := _mock.Called(now, afterID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryFind")
+		panic("no return value specified for RepoPollListDue")
 	}
 
-	var r0 *model.Registry
+	var r0 []*model.Repo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*model.Registry, error)); ok {
-		return returnFunc(s)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, int) ([]*model.Repo, error)); ok {
+		return returnFunc(now, afterID, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *model.Registry); ok {
-		r0 = returnFunc(s)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, int) []*model.Repo); ok {
+		r0 = returnFunc(now, afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Registry)
+			r0 = ret.Get(0).([]*model.Repo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(s)
+	if returnFunc, ok := ret.Get(1).(func(int64, int64, int) error); ok {
+		r1 = returnFunc(now, afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_GlobalRegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryFind'
-type MockStore_GlobalRegistryFind_Call struct {
+// MockStore_RepoPollListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollListDue'
+type MockStore_RepoPollListDue_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryFind is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) GlobalRegistryFind(s any) *MockStore_GlobalRegistryFind_Call {
-	return &MockStore_GlobalRegistryFind_Call{Call: _e.mock.On("GlobalRegistryFind", s)}
+// RepoPollListDue is a helper method to define mock.On call
+//   - now int64
+//   - afterID int64
+//   - limit int
+func (_e *MockStore_Expecter) RepoPollListDue(now any, afterID any, limit any) *MockStore_RepoPollListDue_Call {
+	return &MockStore_RepoPollListDue_Call{Call: _e.mock.On("RepoPollListDue", now, afterID, limit)}
 }
 
-func (_c *MockStore_GlobalRegistryFind_Call) Run(run func(s string)) *MockStore_GlobalRegistryFind_Call {
+func (_c *MockStore_RepoPollListDue_Call) Run(run func(now int64, afterID int64, limit int)) *MockStore_RepoPollListDue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_GlobalRegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_GlobalRegistryFind_Call {
-	_c.Call.Return(registry, err)
+func (_c *MockStore_RepoPollListDue_Call) Return(r0 []*model.Repo, err error) *MockStore_RepoPollListDue_Call {
+	_c.Call.Return(r0, err)
 	return _c
 }
 
-func (_c *MockStore_GlobalRegistryFind_Call) RunAndReturn(run func(s string) (*model.Registry, error)) *MockStore_GlobalRegistryFind_Call {
+func (_c *MockStore_RepoPollListDue_Call) RunAndReturn(run func(now int64, afterID int64, limit int) ([]*model.Repo, error)) *MockStore_RepoPollListDue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalRegistryList provides a mock function for the type MockStore
-func (_mock *MockStore) GlobalRegistryList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
-	ret := _mock.Called(listOptionsWithAll)
+// RepoPollUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) RepoPollUpdate(repoPoll *model.RepoPoll) error {
+	ret := _mock.Called(repoPoll)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalRegistryList")
+		panic("no return value specified for RepoPollUpdate")
 	}
 
-	var r0 []*model.Registry
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
-		return returnFunc(listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Registry); ok {
-		r0 = returnFunc(listOptionsWithAll)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Registry)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(listOptionsWithAll)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.RepoPoll) error); ok {
+		r0 = returnFunc(repoPoll)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GlobalRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRegistryList'
-type MockStore_GlobalRegistryList_Call struct {
+// MockStore_RepoPollUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoPollUpdate'
+type MockStore_RepoPollUpdate_Call struct {
 	*mock.Call
 }
 
-// GlobalRegistryList is a helper method to define mock.On call
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) GlobalRegistryList(listOptionsWithAll any) *MockStore_GlobalRegistryList_Call {
-	return &MockStore_GlobalRegistryList_Call{Call: _e.mock.On("GlobalRegistryList", listOptionsWithAll)}
+// RepoPollUpdate is a helper method to define mock.On call
+//   - repoPoll *model.RepoPoll
+func (_e *MockStore_Expecter) RepoPollUpdate(repoPoll any) *MockStore_RepoPollUpdate_Call {
+	return &MockStore_RepoPollUpdate_Call{Call: _e.mock.On("RepoPollUpdate", repoPoll)}
 }
 
-func (_c *MockStore_GlobalRegistryList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_GlobalRegistryList_Call {
+func (_c *MockStore_RepoPollUpdate_Call) Run(run func(repoPoll *model.RepoPoll)) *MockStore_RepoPollUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.ListOptionsWithAll
+		var arg0 *model.RepoPoll
 		if args[0] != nil {
-			arg0 = args[0].(*model.ListOptionsWithAll)
+			arg0 = args[0].(*model.RepoPoll)
 		}
 		run(
 			arg0,
@@ -3095,60 +6764,49 @@ func (_c *MockStore_GlobalRegistryList_Call) Run(run func(listOptionsWithAll *mo
 	return _c
 }
 
-func (_c *MockStore_GlobalRegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_GlobalRegistryList_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockStore_RepoPollUpdate_Call) Return(err error) *MockStore_RepoPollUpdate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GlobalRegistryList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_GlobalRegistryList_Call {
+func (_c *MockStore_RepoPollUpdate_Call) RunAndReturn(run func(repoPoll *model.RepoPoll) error) *MockStore_RepoPollUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretFind provides a mock function for the type MockStore
-func (_mock *MockStore) GlobalSecretFind(s string) (*model.Secret, error) {
-	ret := _mock.Called(s)
+// RetentionPolicyCreate provides a mock function for the type MockStore
+func (_mock *MockStore) RetentionPolicyCreate(retentionPolicy *model.RetentionPolicy) error {
+	ret := _mock.Called(retentionPolicy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretFind")
+		panic("no return value specified for RetentionPolicyCreate")
 	}
 
-	var r0 *model.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*model.Secret, error)); ok {
-		return returnFunc(s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) *model.Secret); ok {
-		r0 = returnFunc(s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.RetentionPolicy) error); ok {
+		r0 = returnFunc(retentionPolicy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GlobalSecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretFind'
-type MockStore_GlobalSecretFind_Call struct {
+// MockStore_RetentionPolicyCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyCreate'
+type MockStore_RetentionPolicyCreate_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretFind is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) GlobalSecretFind(s any) *MockStore_GlobalSecretFind_Call {
-	return &MockStore_GlobalSecretFind_Call{Call: _e.mock.On("GlobalSecretFind", s)}
+// RetentionPolicyCreate is a helper method to define mock.On call
+//   - retentionPolicy *model.RetentionPolicy
+func (_e *MockStore_Expecter) RetentionPolicyCreate(retentionPolicy any) *MockStore_RetentionPolicyCreate_Call {
+	return &MockStore_RetentionPolicyCreate_Call{Call: _e.mock.On("RetentionPolicyCreate", retentionPolicy)}
 }
 
-func (_c *MockStore_GlobalSecretFind_Call) Run(run func(s string)) *MockStore_GlobalSecretFind_Call {
+func (_c *MockStore_RetentionPolicyCreate_Call) Run(run func(retentionPolicy *model.RetentionPolicy)) *MockStore_RetentionPolicyCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.RetentionPolicy
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.RetentionPolicy)
 		}
 		run(
 			arg0,
@@ -3157,60 +6815,49 @@ func (_c *MockStore_GlobalSecretFind_Call) Run(run func(s string)) *MockStore_Gl
 	return _c
 }
 
-func (_c *MockStore_GlobalSecretFind_Call) Return(secret *model.Secret, err error) *MockStore_GlobalSecretFind_Call {
-	_c.Call.Return(secret, err)
+func (_c *MockStore_RetentionPolicyCreate_Call) Return(err error) *MockStore_RetentionPolicyCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GlobalSecretFind_Call) RunAndReturn(run func(s string) (*model.Secret, error)) *MockStore_GlobalSecretFind_Call {
+func (_c *MockStore_RetentionPolicyCreate_Call) RunAndReturn(run func(retentionPolicy *model.RetentionPolicy) error) *MockStore_RetentionPolicyCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GlobalSecretList provides a mock function for the type MockStore
-func (_mock *MockStore) GlobalSecretList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
-	ret := _mock.Called(listOptionsWithAll)
+// RetentionPolicyDelete provides a mock function for the type MockStore
+func (_mock *MockStore) RetentionPolicyDelete(retentionPolicy *model.RetentionPolicy) error {
+	ret := _mock.Called(retentionPolicy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GlobalSecretList")
+		panic("no return value specified for RetentionPolicyDelete")
 	}
 
-	var r0 []*model.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
-		return returnFunc(listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Secret); ok {
-		r0 = returnFunc(listOptionsWithAll)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(listOptionsWithAll)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.RetentionPolicy) error); ok {
+		r0 = returnFunc(retentionPolicy)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_GlobalSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalSecretList'
-type MockStore_GlobalSecretList_Call struct {
+// MockStore_RetentionPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyDelete'
+type MockStore_RetentionPolicyDelete_Call struct {
 	*mock.Call
 }
 
-// GlobalSecretList is a helper method to define mock.On call
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) GlobalSecretList(listOptionsWithAll any) *MockStore_GlobalSecretList_Call {
-	return &MockStore_GlobalSecretList_Call{Call: _e.mock.On("GlobalSecretList", listOptionsWithAll)}
+// RetentionPolicyDelete is a helper method to define mock.On call
+//   - retentionPolicy *model.RetentionPolicy
+func (_e *MockStore_Expecter) RetentionPolicyDelete(retentionPolicy any) *MockStore_RetentionPolicyDelete_Call {
+	return &MockStore_RetentionPolicyDelete_Call{Call: _e.mock.On("RetentionPolicyDelete", retentionPolicy)}
 }
 
-func (_c *MockStore_GlobalSecretList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_GlobalSecretList_Call {
+func (_c *MockStore_RetentionPolicyDelete_Call) Run(run func(retentionPolicy *model.RetentionPolicy)) *MockStore_RetentionPolicyDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.ListOptionsWithAll
+		var arg0 *model.RetentionPolicy
 		if args[0] != nil {
-			arg0 = args[0].(*model.ListOptionsWithAll)
+			arg0 = args[0].(*model.RetentionPolicy)
 		}
 		run(
 			arg0,
@@ -3219,63 +6866,65 @@ func (_c *MockStore_GlobalSecretList_Call) Run(run func(listOptionsWithAll *mode
 	return _c
 }
 
-func (_c *MockStore_GlobalSecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_GlobalSecretList_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockStore_RetentionPolicyDelete_Call) Return(err error) *MockStore_RetentionPolicyDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_GlobalSecretList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_GlobalSecretList_Call {
+func (_c *MockStore_RetentionPolicyDelete_Call) RunAndReturn(run func(retentionPolicy *model.RetentionPolicy) error) *MockStore_RetentionPolicyDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasRedirectionForRepo provides a mock function for the type MockStore
-func (_mock *MockStore) HasRedirectionForRepo(n int64, s string) (bool, error) {
-	ret := _mock.Called(n, s)
+// RetentionPolicyFind provides a mock function for the type MockStore
+func (_mock *MockStore) RetentionPolicyFind(orgID int64, repoID int64) (*model.RetentionPolicy, error) {
+	ret := _mock.Called(orgID, repoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HasRedirectionForRepo")
+		panic("no return value specified for RetentionPolicyFind")
 	}
 
-	var r0 bool
+	var r0 *model.RetentionPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) (bool, error)); ok {
-		return returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*model.RetentionPolicy, error)); ok {
+		return returnFunc(orgID, repoID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, string) bool); ok {
-		r0 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) *model.RetentionPolicy); ok {
+		r0 = returnFunc(orgID, repoID)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RetentionPolicy)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
-		r1 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(orgID, repoID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_HasRedirectionForRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRedirectionForRepo'
-type MockStore_HasRedirectionForRepo_Call struct {
+// MockStore_RetentionPolicyFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyFind'
+type MockStore_RetentionPolicyFind_Call struct {
 	*mock.Call
 }
 
-// HasRedirectionForRepo is a helper method to define mock.On call
-//   - n int64
-//   - s string
-func (_e *MockStore_Expecter) HasRedirectionForRepo(n any, s any) *MockStore_HasRedirectionForRepo_Call {
-	return &MockStore_HasRedirectionForRepo_Call{Call: _e.mock.On("HasRedirectionForRepo", n, s)}
+// RetentionPolicyFind is a helper method to define mock.On call
+//   - orgID int64
+//   - repoID int64
+func (_e *MockStore_Expecter) RetentionPolicyFind(orgID any, repoID any) *MockStore_RetentionPolicyFind_Call {
+	return &MockStore_RetentionPolicyFind_Call{Call: _e.mock.On("RetentionPolicyFind", orgID, repoID)}
 }
 
-func (_c *MockStore_HasRedirectionForRepo_Call) Run(run func(n int64, s string)) *MockStore_HasRedirectionForRepo_Call {
+func (_c *MockStore_RetentionPolicyFind_Call) Run(run func(orgID int64, repoID int64)) *MockStore_RetentionPolicyFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 string
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -3285,106 +6934,104 @@ func (_c *MockStore_HasRedirectionForRepo_Call) Run(run func(n int64, s string))
 	return _c
 }
 
-func (_c *MockStore_HasRedirectionForRepo_Call) Return(b bool, err error) *MockStore_HasRedirectionForRepo_Call {
-	_c.Call.Return(b, err)
+func (_c *MockStore_RetentionPolicyFind_Call) Return(retentionPolicy *model.RetentionPolicy, err error) *MockStore_RetentionPolicyFind_Call {
+	_c.Call.Return(retentionPolicy, err)
 	return _c
 }
 
-func (_c *MockStore_HasRedirectionForRepo_Call) RunAndReturn(run func(n int64, s string) (bool, error)) *MockStore_HasRedirectionForRepo_Call {
+func (_c *MockStore_RetentionPolicyFind_Call) RunAndReturn(run func(orgID int64, repoID int64) (*model.RetentionPolicy, error)) *MockStore_RetentionPolicyFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogAppend provides a mock function for the type MockStore
-func (_mock *MockStore) LogAppend(step *model.Step, logEntrys []*model.LogEntry) error {
-	ret := _mock.Called(step, logEntrys)
+// RetentionPolicyList provides a mock function for the type MockStore
+func (_mock *MockStore) RetentionPolicyList() ([]*model.RetentionPolicy, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogAppend")
+		panic("no return value specified for RetentionPolicyList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Step, []*model.LogEntry) error); ok {
-		r0 = returnFunc(step, logEntrys)
+	var r0 []*model.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*model.RetentionPolicy, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*model.RetentionPolicy); ok {
+		r0 = returnFunc()
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RetentionPolicy)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_LogAppend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogAppend'
-type MockStore_LogAppend_Call struct {
+// MockStore_RetentionPolicyList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyList'
+type MockStore_RetentionPolicyList_Call struct {
 	*mock.Call
 }
 
-// LogAppend is a helper method to define mock.On call
-//   - step *model.Step
-//   - logEntrys []*model.LogEntry
-func (_e *MockStore_Expecter) LogAppend(step any, logEntrys any) *MockStore_LogAppend_Call {
-	return &MockStore_LogAppend_Call{Call: _e.mock.On("LogAppend", step, logEntrys)}
+// RetentionPolicyList is a helper method to define mock.On call
+func (_e *MockStore_Expecter) RetentionPolicyList() *MockStore_RetentionPolicyList_Call {
+	return &MockStore_RetentionPolicyList_Call{Call: _e.mock.On("RetentionPolicyList")}
 }
 
-func (_c *MockStore_LogAppend_Call) Run(run func(step *model.Step, logEntrys []*model.LogEntry)) *MockStore_LogAppend_Call {
+func (_c *MockStore_RetentionPolicyList_Call) Run(run func()) *MockStore_RetentionPolicyList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Step
-		if args[0] != nil {
-			arg0 = args[0].(*model.Step)
-		}
-		var arg1 []*model.LogEntry
-		if args[1] != nil {
-			arg1 = args[1].([]*model.LogEntry)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_LogAppend_Call) Return(err error) *MockStore_LogAppend_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_RetentionPolicyList_Call) Return(retentionPolicys []*model.RetentionPolicy, err error) *MockStore_RetentionPolicyList_Call {
+	_c.Call.Return(retentionPolicys, err)
 	return _c
 }
 
-func (_c *MockStore_LogAppend_Call) RunAndReturn(run func(step *model.Step, logEntrys []*model.LogEntry) error) *MockStore_LogAppend_Call {
+func (_c *MockStore_RetentionPolicyList_Call) RunAndReturn(run func() ([]*model.RetentionPolicy, error)) *MockStore_RetentionPolicyList_Call {
 	_c.Call.Return(run)
 	return _c
-}
-
-// LogDelete provides a mock function for the type MockStore
-func (_mock *MockStore) LogDelete(step *model.Step) error {
-	ret := _mock.Called(step)
+}
+
+// RetentionPolicyUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) RetentionPolicyUpdate(retentionPolicy *model.RetentionPolicy) error {
+	ret := _mock.Called(retentionPolicy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogDelete")
+		panic("no return value specified for RetentionPolicyUpdate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Step) error); ok {
-		r0 = returnFunc(step)
+	if returnFunc, ok := ret.Get(0).(func(*model.RetentionPolicy) error); ok {
+		r0 = returnFunc(retentionPolicy)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_LogDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogDelete'
-type MockStore_LogDelete_Call struct {
+// MockStore_RetentionPolicyUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyUpdate'
+type MockStore_RetentionPolicyUpdate_Call struct {
 	*mock.Call
 }
 
-// LogDelete is a helper method to define mock.On call
-//   - step *model.Step
-func (_e *MockStore_Expecter) LogDelete(step any) *MockStore_LogDelete_Call {
-	return &MockStore_LogDelete_Call{Call: _e.mock.On("LogDelete", step)}
+// RetentionPolicyUpdate is a helper method to define mock.On call
+//   - retentionPolicy *model.RetentionPolicy
+func (_e *MockStore_Expecter) RetentionPolicyUpdate(retentionPolicy any) *MockStore_RetentionPolicyUpdate_Call {
+	return &MockStore_RetentionPolicyUpdate_Call{Call: _e.mock.On("RetentionPolicyUpdate", retentionPolicy)}
 }
 
-func (_c *MockStore_LogDelete_Call) Run(run func(step *model.Step)) *MockStore_LogDelete_Call {
+func (_c *MockStore_RetentionPolicyUpdate_Call) Run(run func(retentionPolicy *model.RetentionPolicy)) *MockStore_RetentionPolicyUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Step
+		var arg0 *model.RetentionPolicy
 		if args[0] != nil {
-			arg0 = args[0].(*model.Step)
+			arg0 = args[0].(*model.RetentionPolicy)
 		}
 		run(
 			arg0,
@@ -3393,60 +7040,49 @@ func (_c *MockStore_LogDelete_Call) Run(run func(step *model.Step)) *MockStore_L
 	return _c
 }
 
-func (_c *MockStore_LogDelete_Call) Return(err error) *MockStore_LogDelete_Call {
+func (_c *MockStore_RetentionPolicyUpdate_Call) Return(err error) *MockStore_RetentionPolicyUpdate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_LogDelete_Call) RunAndReturn(run func(step *model.Step) error) *MockStore_LogDelete_Call {
+func (_c *MockStore_RetentionPolicyUpdate_Call) RunAndReturn(run func(retentionPolicy *model.RetentionPolicy) error) *MockStore_RetentionPolicyUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LogFind provides a mock function for the type MockStore
-func (_mock *MockStore) LogFind(step *model.Step) ([]*model.LogEntry, error) {
-	ret := _mock.Called(step)
+// SecretCreate provides a mock function for the type MockStore
+func (_mock *MockStore) SecretCreate(secret *model.Secret) error {
+	ret := _mock.Called(secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for LogFind")
+		panic("no return value specified for SecretCreate")
 	}
 
-	var r0 []*model.LogEntry
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Step) ([]*model.LogEntry, error)); ok {
-		return returnFunc(step)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Step) []*model.LogEntry); ok {
-		r0 = returnFunc(step)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.LogEntry)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Step) error); ok {
-		r1 = returnFunc(step)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
+		r0 = returnFunc(secret)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_LogFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogFind'
-type MockStore_LogFind_Call struct {
+// MockStore_SecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretCreate'
+type MockStore_SecretCreate_Call struct {
 	*mock.Call
 }
 
-// LogFind is a helper method to define mock.On call
-//   - step *model.Step
-func (_e *MockStore_Expecter) LogFind(step any) *MockStore_LogFind_Call {
-	return &MockStore_LogFind_Call{Call: _e.mock.On("LogFind", step)}
+// SecretCreate is a helper method to define mock.On call
+//   - secret *model.Secret
+func (_e *MockStore_Expecter) SecretCreate(secret any) *MockStore_SecretCreate_Call {
+	return &MockStore_SecretCreate_Call{Call: _e.mock.On("SecretCreate", secret)}
 }
 
-func (_c *MockStore_LogFind_Call) Run(run func(step *model.Step)) *MockStore_LogFind_Call {
+func (_c *MockStore_SecretCreate_Call) Run(run func(secret *model.Secret)) *MockStore_SecretCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Step
+		var arg0 *model.Secret
 		if args[0] != nil {
-			arg0 = args[0].(*model.Step)
+			arg0 = args[0].(*model.Secret)
 		}
 		run(
 			arg0,
@@ -3455,287 +7091,297 @@ func (_c *MockStore_LogFind_Call) Run(run func(step *model.Step)) *MockStore_Log
 	return _c
 }
 
-func (_c *MockStore_LogFind_Call) Return(logEntrys []*model.LogEntry, err error) *MockStore_LogFind_Call {
-	_c.Call.Return(logEntrys, err)
+func (_c *MockStore_SecretCreate_Call) Return(err error) *MockStore_SecretCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_LogFind_Call) RunAndReturn(run func(step *model.Step) ([]*model.LogEntry, error)) *MockStore_LogFind_Call {
+func (_c *MockStore_SecretCreate_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Migrate provides a mock function for the type MockStore
-func (_mock *MockStore) Migrate(context1 context.Context, b bool) error {
-	ret := _mock.Called(context1, b)
+// SecretDelete provides a mock function for the type MockStore
+func (_mock *MockStore) SecretDelete(secret *model.Secret) error {
+	ret := _mock.Called(secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Migrate")
+		panic("no return value specified for SecretDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) error); ok {
-		r0 = returnFunc(context1, b)
+	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
+		r0 = returnFunc(secret)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_Migrate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Migrate'
-type MockStore_Migrate_Call struct {
+// MockStore_SecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretDelete'
+type MockStore_SecretDelete_Call struct {
 	*mock.Call
 }
 
-// Migrate is a helper method to define mock.On call
-//   - context1 context.Context
-//   - b bool
-func (_e *MockStore_Expecter) Migrate(context1 any, b any) *MockStore_Migrate_Call {
-	return &MockStore_Migrate_Call{Call: _e.mock.On("Migrate", context1, b)}
+// SecretDelete is a helper method to define mock.On call
+//   - secret *model.Secret
+func (_e *MockStore_Expecter) SecretDelete(secret any) *MockStore_SecretDelete_Call {
+	return &MockStore_SecretDelete_Call{Call: _e.mock.On("SecretDelete", secret)}
 }
 
-func (_c *MockStore_Migrate_Call) Run(run func(context1 context.Context, b bool)) *MockStore_Migrate_Call {
+func (_c *MockStore_SecretDelete_Call) Run(run func(secret *model.Secret)) *MockStore_SecretDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 *model.Secret
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
-		}
-		var arg1 bool
-		if args[1] != nil {
-			arg1 = args[1].(bool)
+			arg0 = args[0].(*model.Secret)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_Migrate_Call) Return(err error) *MockStore_Migrate_Call {
+func (_c *MockStore_SecretDelete_Call) Return(err error) *MockStore_SecretDelete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_Migrate_Call) RunAndReturn(run func(context1 context.Context, b bool) error) *MockStore_Migrate_Call {
+func (_c *MockStore_SecretDelete_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgCreate provides a mock function for the type MockStore
-func (_mock *MockStore) OrgCreate(org *model.Org) error {
-	ret := _mock.Called(org)
+// SecretFind provides a mock function for the type MockStore
+func (_mock *MockStore) SecretFind(repo *model.Repo, s string) (*model.Secret, error) {
+	ret := _mock.Called(repo, s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgCreate")
+		panic("no return value specified for SecretFind")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Org) error); ok {
-		r0 = returnFunc(org)
+	var r0 *model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Secret, error)); ok {
+		return returnFunc(repo, s)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Secret); ok {
+		r0 = returnFunc(repo, s)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Secret)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
+		r1 = returnFunc(repo, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_OrgCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgCreate'
-type MockStore_OrgCreate_Call struct {
+// MockStore_SecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretFind'
+type MockStore_SecretFind_Call struct {
 	*mock.Call
 }
 
-// OrgCreate is a helper method to define mock.On call
-//   - org *model.Org
-func (_e *MockStore_Expecter) OrgCreate(org any) *MockStore_OrgCreate_Call {
-	return &MockStore_OrgCreate_Call{Call: _e.mock.On("OrgCreate", org)}
+// SecretFind is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - s string
+func (_e *MockStore_Expecter) SecretFind(repo any, s any) *MockStore_SecretFind_Call {
+	return &MockStore_SecretFind_Call{Call: _e.mock.On("SecretFind", repo, s)}
 }
 
-func (_c *MockStore_OrgCreate_Call) Run(run func(org *model.Org)) *MockStore_OrgCreate_Call {
+func (_c *MockStore_SecretFind_Call) Run(run func(repo *model.Repo, s string)) *MockStore_SecretFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Org
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(*model.Org)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgCreate_Call) Return(err error) *MockStore_OrgCreate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_SecretFind_Call) Return(secret *model.Secret, err error) *MockStore_SecretFind_Call {
+	_c.Call.Return(secret, err)
 	return _c
 }
 
-func (_c *MockStore_OrgCreate_Call) RunAndReturn(run func(org *model.Org) error) *MockStore_OrgCreate_Call {
+func (_c *MockStore_SecretFind_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Secret, error)) *MockStore_SecretFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgDelete provides a mock function for the type MockStore
-func (_mock *MockStore) OrgDelete(n int64) error {
-	ret := _mock.Called(n)
+// SecretList provides a mock function for the type MockStore
+func (_mock *MockStore) SecretList(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
+	ret := _mock.Called(repo, b, listOptionsWithAll)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgDelete")
+		panic("no return value specified for SecretList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
-		r0 = returnFunc(n)
+	var r0 []*model.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
+		return returnFunc(repo, b, listOptionsWithAll)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) []*model.Secret); ok {
+		r0 = returnFunc(repo, b, listOptionsWithAll)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Secret)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, bool, *model.ListOptionsWithAll) error); ok {
+		r1 = returnFunc(repo, b, listOptionsWithAll)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_OrgDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgDelete'
-type MockStore_OrgDelete_Call struct {
+// MockStore_SecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretList'
+type MockStore_SecretList_Call struct {
 	*mock.Call
 }
 
-// OrgDelete is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) OrgDelete(n any) *MockStore_OrgDelete_Call {
-	return &MockStore_OrgDelete_Call{Call: _e.mock.On("OrgDelete", n)}
+// SecretList is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - b bool
+//   - listOptionsWithAll *model.ListOptionsWithAll
+func (_e *MockStore_Expecter) SecretList(repo any, b any, listOptionsWithAll any) *MockStore_SecretList_Call {
+	return &MockStore_SecretList_Call{Call: _e.mock.On("SecretList", repo, b, listOptionsWithAll)}
 }
 
-func (_c *MockStore_OrgDelete_Call) Run(run func(n int64)) *MockStore_OrgDelete_Call {
+func (_c *MockStore_SecretList_Call) Run(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_SecretList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		var arg2 *model.ListOptionsWithAll
+		if args[2] != nil {
+			arg2 = args[2].(*model.ListOptionsWithAll)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgDelete_Call) Return(err error) *MockStore_OrgDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_SecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_SecretList_Call {
+	_c.Call.Return(secrets, err)
 	return _c
 }
 
-func (_c *MockStore_OrgDelete_Call) RunAndReturn(run func(n int64) error) *MockStore_OrgDelete_Call {
+func (_c *MockStore_SecretList_Call) RunAndReturn(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_SecretList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgFindByName provides a mock function for the type MockStore
-func (_mock *MockStore) OrgFindByName(s string, n int64) (*model.Org, error) {
-	ret := _mock.Called(s, n)
+// SecretListAll provides a mock function for the type MockStore
+func (_mock *MockStore) SecretListAll() ([]*model.Secret, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgFindByName")
+		panic("no return value specified for SecretListAll")
 	}
 
-	var r0 *model.Org
+	var r0 []*model.Secret
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, int64) (*model.Org, error)); ok {
-		return returnFunc(s, n)
+	if returnFunc, ok := ret.Get(0).(func() ([]*model.Secret, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, int64) *model.Org); ok {
-		r0 = returnFunc(s, n)
+	if returnFunc, ok := ret.Get(0).(func() []*model.Secret); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Org)
+			r0 = ret.Get(0).([]*model.Secret)
 		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string, int64) error); ok {
-		r1 = returnFunc(s, n)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_OrgFindByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgFindByName'
-type MockStore_OrgFindByName_Call struct {
+// MockStore_SecretListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretListAll'
+type MockStore_SecretListAll_Call struct {
 	*mock.Call
 }
 
-// OrgFindByName is a helper method to define mock.On call
-//   - s string
-//   - n int64
-func (_e *MockStore_Expecter) OrgFindByName(s any, n any) *MockStore_OrgFindByName_Call {
-	return &MockStore_OrgFindByName_Call{Call: _e.mock.On("OrgFindByName", s, n)}
+// SecretListAll is a helper method to define mock.On call
+func (_e *MockStore_Expecter) SecretListAll() *MockStore_SecretListAll_Call {
+	return &MockStore_SecretListAll_Call{Call: _e.mock.On("SecretListAll")}
 }
 
-func (_c *MockStore_OrgFindByName_Call) Run(run func(s string, n int64)) *MockStore_OrgFindByName_Call {
+func (_c *MockStore_SecretListAll_Call) Run(run func()) *MockStore_SecretListAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
-		if args[0] != nil {
-			arg0 = args[0].(string)
-		}
-		var arg1 int64
-		if args[1] != nil {
-			arg1 = args[1].(int64)
-		}
-		run(
-			arg0,
-			arg1,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgFindByName_Call) Return(org *model.Org, err error) *MockStore_OrgFindByName_Call {
-	_c.Call.Return(org, err)
+func (_c *MockStore_SecretListAll_Call) Return(secrets []*model.Secret, err error) *MockStore_SecretListAll_Call {
+	_c.Call.Return(secrets, err)
 	return _c
 }
 
-func (_c *MockStore_OrgFindByName_Call) RunAndReturn(run func(s string, n int64) (*model.Org, error)) *MockStore_OrgFindByName_Call {
+func (_c *MockStore_SecretListAll_Call) RunAndReturn(run func() ([]*model.Secret, error)) *MockStore_SecretListAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgGet provides a mock function for the type MockStore
-func (_mock *MockStore) OrgGet(n int64) (*model.Org, error) {
-	ret := _mock.Called(n)
+// SecretUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) SecretUpdate(secret *model.Secret) error {
+	ret := _mock.Called(secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgGet")
+		panic("no return value specified for SecretUpdate")
 	}
 
-	var r0 *model.Org
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Org, error)); ok {
-		return returnFunc(n)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64) *model.Org); ok {
-		r0 = returnFunc(n)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Org)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(n)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
+		r0 = returnFunc(secret)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_OrgGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgGet'
-type MockStore_OrgGet_Call struct {
+// MockStore_SecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretUpdate'
+type MockStore_SecretUpdate_Call struct {
 	*mock.Call
 }
 
-// OrgGet is a helper method to define mock.On call
-//   - n int64
-func (_e *MockStore_Expecter) OrgGet(n any) *MockStore_OrgGet_Call {
-	return &MockStore_OrgGet_Call{Call: _e.mock.On("OrgGet", n)}
+// SecretUpdate is a helper method to define mock.On call
+//   - secret *model.Secret
+func (_e *MockStore_Expecter) SecretUpdate(secret any) *MockStore_SecretUpdate_Call {
+	return &MockStore_SecretUpdate_Call{Call: _e.mock.On("SecretUpdate", secret)}
 }
 
-func (_c *MockStore_OrgGet_Call) Run(run func(n int64)) *MockStore_OrgGet_Call {
+func (_c *MockStore_SecretUpdate_Call) Run(run func(secret *model.Secret)) *MockStore_SecretUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Secret
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Secret)
 		}
 		run(
 			arg0,
@@ -3744,60 +7390,49 @@ func (_c *MockStore_OrgGet_Call) Run(run func(n int64)) *MockStore_OrgGet_Call {
 	return _c
 }
 
-func (_c *MockStore_OrgGet_Call) Return(org *model.Org, err error) *MockStore_OrgGet_Call {
-	_c.Call.Return(org, err)
+func (_c *MockStore_SecretUpdate_Call) Return(err error) *MockStore_SecretUpdate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_OrgGet_Call) RunAndReturn(run func(n int64) (*model.Org, error)) *MockStore_OrgGet_Call {
+func (_c *MockStore_SecretUpdate_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgList provides a mock function for the type MockStore
-func (_mock *MockStore) OrgList(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Org, error) {
-	ret := _mock.Called(listOptionsWithAll)
+// ServerConfigDelete provides a mock function for the type MockStore
+func (_mock *MockStore) ServerConfigDelete(s string) error {
+	ret := _mock.Called(s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgList")
+		panic("no return value specified for ServerConfigDelete")
 	}
 
-	var r0 []*model.Org
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) ([]*model.Org, error)); ok {
-		return returnFunc(listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.ListOptionsWithAll) []*model.Org); ok {
-		r0 = returnFunc(listOptionsWithAll)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Org)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(listOptionsWithAll)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(s)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_OrgList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgList'
-type MockStore_OrgList_Call struct {
+// MockStore_ServerConfigDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigDelete'
+type MockStore_ServerConfigDelete_Call struct {
 	*mock.Call
 }
 
-// OrgList is a helper method to define mock.On call
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) OrgList(listOptionsWithAll any) *MockStore_OrgList_Call {
-	return &MockStore_OrgList_Call{Call: _e.mock.On("OrgList", listOptionsWithAll)}
+// ServerConfigDelete is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) ServerConfigDelete(s any) *MockStore_ServerConfigDelete_Call {
+	return &MockStore_ServerConfigDelete_Call{Call: _e.mock.On("ServerConfigDelete", s)}
 }
 
-func (_c *MockStore_OrgList_Call) Run(run func(listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgList_Call {
+func (_c *MockStore_ServerConfigDelete_Call) Run(run func(s string)) *MockStore_ServerConfigDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.ListOptionsWithAll
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(*model.ListOptionsWithAll)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
@@ -3806,35 +7441,33 @@ func (_c *MockStore_OrgList_Call) Run(run func(listOptionsWithAll *model.ListOpt
 	return _c
 }
 
-func (_c *MockStore_OrgList_Call) Return(orgs []*model.Org, err error) *MockStore_OrgList_Call {
-	_c.Call.Return(orgs, err)
+func (_c *MockStore_ServerConfigDelete_Call) Return(err error) *MockStore_ServerConfigDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_OrgList_Call) RunAndReturn(run func(listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Org, error)) *MockStore_OrgList_Call {
+func (_c *MockStore_ServerConfigDelete_Call) RunAndReturn(run func(s string) error) *MockStore_ServerConfigDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgLookup provides a mock function for the type MockStore
-func (_mock *MockStore) OrgLookup(s string) (*model.Org, error) {
+// ServerConfigGet provides a mock function for the type MockStore
+func (_mock *MockStore) ServerConfigGet(s string) (string, error) {
 	ret := _mock.Called(s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgLookup")
+		panic("no return value specified for ServerConfigGet")
 	}
 
-	var r0 *model.Org
+	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*model.Org, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
 		return returnFunc(s)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *model.Org); ok {
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
 		r0 = returnFunc(s)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Org)
-		}
+		r0 = ret.Get(0).(string)
 	}
 	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
 		r1 = returnFunc(s)
@@ -3844,18 +7477,18 @@ func (_mock *MockStore) OrgLookup(s string) (*model.Org, error) {
 	return r0, r1
 }
 
-// MockStore_OrgLookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgLookup'
-type MockStore_OrgLookup_Call struct {
+// MockStore_ServerConfigGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigGet'
+type MockStore_ServerConfigGet_Call struct {
 	*mock.Call
 }
 
-// OrgLookup is a helper method to define mock.On call
+// ServerConfigGet is a helper method to define mock.On call
 //   - s string
-func (_e *MockStore_Expecter) OrgLookup(s any) *MockStore_OrgLookup_Call {
-	return &MockStore_OrgLookup_Call{Call: _e.mock.On("OrgLookup", s)}
+func (_e *MockStore_Expecter) ServerConfigGet(s any) *MockStore_ServerConfigGet_Call {
+	return &MockStore_ServerConfigGet_Call{Call: _e.mock.On("ServerConfigGet", s)}
 }
 
-func (_c *MockStore_OrgLookup_Call) Run(run func(s string)) *MockStore_OrgLookup_Call {
+func (_c *MockStore_ServerConfigGet_Call) Run(run func(s string)) *MockStore_ServerConfigGet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
@@ -3868,61 +7501,50 @@ func (_c *MockStore_OrgLookup_Call) Run(run func(s string)) *MockStore_OrgLookup
 	return _c
 }
 
-func (_c *MockStore_OrgLookup_Call) Return(org *model.Org, err error) *MockStore_OrgLookup_Call {
-	_c.Call.Return(org, err)
+func (_c *MockStore_ServerConfigGet_Call) Return(s1 string, err error) *MockStore_ServerConfigGet_Call {
+	_c.Call.Return(s1, err)
 	return _c
 }
 
-func (_c *MockStore_OrgLookup_Call) RunAndReturn(run func(s string) (*model.Org, error)) *MockStore_OrgLookup_Call {
+func (_c *MockStore_ServerConfigGet_Call) RunAndReturn(run func(s string) (string, error)) *MockStore_ServerConfigGet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgRegistryFind provides a mock function for the type MockStore
-func (_mock *MockStore) OrgRegistryFind(n int64, s string) (*model.Registry, error) {
-	ret := _mock.Called(n, s)
+// ServerConfigSet provides a mock function for the type MockStore
+func (_mock *MockStore) ServerConfigSet(s string, s1 string) error {
+	ret := _mock.Called(s, s1)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgRegistryFind")
+		panic("no return value specified for ServerConfigSet")
 	}
 
-	var r0 *model.Registry
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.Registry, error)); ok {
-		return returnFunc(n, s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.Registry); ok {
-		r0 = returnFunc(n, s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Registry)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
-		r1 = returnFunc(n, s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(s, s1)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_OrgRegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryFind'
-type MockStore_OrgRegistryFind_Call struct {
+// MockStore_ServerConfigSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigSet'
+type MockStore_ServerConfigSet_Call struct {
 	*mock.Call
 }
 
-// OrgRegistryFind is a helper method to define mock.On call
-//   - n int64
+// ServerConfigSet is a helper method to define mock.On call
 //   - s string
-func (_e *MockStore_Expecter) OrgRegistryFind(n any, s any) *MockStore_OrgRegistryFind_Call {
-	return &MockStore_OrgRegistryFind_Call{Call: _e.mock.On("OrgRegistryFind", n, s)}
+//   - s1 string
+func (_e *MockStore_Expecter) ServerConfigSet(s any, s1 any) *MockStore_ServerConfigSet_Call {
+	return &MockStore_ServerConfigSet_Call{Call: _e.mock.On("ServerConfigSet", s, s1)}
 }
 
-func (_c *MockStore_OrgRegistryFind_Call) Run(run func(n int64, s string)) *MockStore_OrgRegistryFind_Call {
+func (_c *MockStore_ServerConfigSet_Call) Run(run func(s string, s1 string)) *MockStore_ServerConfigSet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -3936,269 +7558,229 @@ func (_c *MockStore_OrgRegistryFind_Call) Run(run func(n int64, s string)) *Mock
 	return _c
 }
 
-func (_c *MockStore_OrgRegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_OrgRegistryFind_Call {
-	_c.Call.Return(registry, err)
+func (_c *MockStore_ServerConfigSet_Call) Return(err error) *MockStore_ServerConfigSet_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_OrgRegistryFind_Call) RunAndReturn(run func(n int64, s string) (*model.Registry, error)) *MockStore_OrgRegistryFind_Call {
+func (_c *MockStore_ServerConfigSet_Call) RunAndReturn(run func(s string, s1 string) error) *MockStore_ServerConfigSet_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgRegistryList provides a mock function for the type MockStore
-func (_mock *MockStore) OrgRegistryList(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
-	ret := _mock.Called(n, listOptionsWithAll)
+// StepByUUID provides a mock function for the type MockStore
+func (_mock *MockStore) StepByUUID(uuid string) (*model.Step, error) {
+	ret := _mock.Called(uuid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgRegistryList")
+		panic("no return value specified for StepByUUID")
 	}
 
-	var r0 []*model.Registry
+	var r0 *model.Step
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
-		return returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Step, error)); ok {
+		return returnFunc(uuid)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) []*model.Registry); ok {
-		r0 = returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Step); ok {
+		r0 = returnFunc(uuid)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Registry)
+			r0 = ret.Get(0).(*model.Step)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(uuid)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_OrgRegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRegistryList'
-type MockStore_OrgRegistryList_Call struct {
+// MockStore_StepByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepByUUID'
+type MockStore_StepByUUID_Call struct {
 	*mock.Call
 }
 
-// OrgRegistryList is a helper method to define mock.On call
-//   - n int64
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) OrgRegistryList(n any, listOptionsWithAll any) *MockStore_OrgRegistryList_Call {
-	return &MockStore_OrgRegistryList_Call{Call: _e.mock.On("OrgRegistryList", n, listOptionsWithAll)}
+// StepByUUID is a helper method to define mock.On call
+//   - uuid string
+func (_e *MockStore_Expecter) StepByUUID(uuid any) *MockStore_StepByUUID_Call {
+	return &MockStore_StepByUUID_Call{Call: _e.mock.On("StepByUUID", uuid)}
 }
 
-func (_c *MockStore_OrgRegistryList_Call) Run(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgRegistryList_Call {
+func (_c *MockStore_StepByUUID_Call) Run(run func(uuid string)) *MockStore_StepByUUID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 *model.ListOptionsWithAll
-		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgRegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_OrgRegistryList_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockStore_StepByUUID_Call) Return(step *model.Step, err error) *MockStore_StepByUUID_Call {
+	_c.Call.Return(step, err)
 	return _c
 }
 
-func (_c *MockStore_OrgRegistryList_Call) RunAndReturn(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_OrgRegistryList_Call {
+func (_c *MockStore_StepByUUID_Call) RunAndReturn(run func(uuid string) (*model.Step, error)) *MockStore_StepByUUID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgRepoList provides a mock function for the type MockStore
-func (_mock *MockStore) OrgRepoList(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Repo, error) {
-	ret := _mock.Called(org, listOptionsWithAll)
-
-	if len(ret) == 0 {
-		panic("no return value specified for OrgRepoList")
-	}
-
-	var r0 []*model.Repo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Org, *model.ListOptionsWithAll) ([]*model.Repo, error)); ok {
-		return returnFunc(org, listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Org, *model.ListOptionsWithAll) []*model.Repo); ok {
-		r0 = returnFunc(org, listOptionsWithAll)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Repo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Org, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(org, listOptionsWithAll)
-	} else {
-		r1 = ret.Error(1)
-	}
-	return r0, r1
+// StepFinished provides a mock function for the type MockStore
+func (_mock *MockStore) StepFinished(step *model.Step) {
+	_mock.Called(step)
+	return
 }
 
-// MockStore_OrgRepoList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRepoList'
-type MockStore_OrgRepoList_Call struct {
+// MockStore_StepFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepFinished'
+type MockStore_StepFinished_Call struct {
 	*mock.Call
 }
 
-// OrgRepoList is a helper method to define mock.On call
-//   - org *model.Org
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) OrgRepoList(org any, listOptionsWithAll any) *MockStore_OrgRepoList_Call {
-	return &MockStore_OrgRepoList_Call{Call: _e.mock.On("OrgRepoList", org, listOptionsWithAll)}
+// StepFinished is a helper method to define mock.On call
+//   - step *model.Step
+func (_e *MockStore_Expecter) StepFinished(step any) *MockStore_StepFinished_Call {
+	return &MockStore_StepFinished_Call{Call: _e.mock.On("StepFinished", step)}
 }
 
-func (_c *MockStore_OrgRepoList_Call) Run(run func(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgRepoList_Call {
+func (_c *MockStore_StepFinished_Call) Run(run func(step *model.Step)) *MockStore_StepFinished_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Org
+		var arg0 *model.Step
 		if args[0] != nil {
-			arg0 = args[0].(*model.Org)
-		}
-		var arg1 *model.ListOptionsWithAll
-		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
+			arg0 = args[0].(*model.Step)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgRepoList_Call) Return(repos []*model.Repo, err error) *MockStore_OrgRepoList_Call {
-	_c.Call.Return(repos, err)
+func (_c *MockStore_StepFinished_Call) Return() *MockStore_StepFinished_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockStore_OrgRepoList_Call) RunAndReturn(run func(org *model.Org, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Repo, error)) *MockStore_OrgRepoList_Call {
-	_c.Call.Return(run)
+func (_c *MockStore_StepFinished_Call) RunAndReturn(run func(step *model.Step)) *MockStore_StepFinished_Call {
+	_c.Run(run)
 	return _c
 }
 
-// OrgSecretFind provides a mock function for the type MockStore
-func (_mock *MockStore) OrgSecretFind(n int64, s string) (*model.Secret, error) {
-	ret := _mock.Called(n, s)
+// StepList provides a mock function for the type MockStore
+func (_mock *MockStore) StepList(pipelineID int64) ([]*model.Step, error) {
+	ret := _mock.Called(pipelineID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecretFind")
+		panic("no return value specified for StepList")
 	}
 
-	var r0 *model.Secret
+	var r0 []*model.Step
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) (*model.Secret, error)); ok {
-		return returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(int64) ([]*model.Step, error)); ok {
+		return returnFunc(pipelineID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, string) *model.Secret); ok {
-		r0 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(0).(func(int64) []*model.Step); ok {
+		r0 = returnFunc(pipelineID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Secret)
+			r0 = ret.Get(0).([]*model.Step)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
-		r1 = returnFunc(n, s)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(pipelineID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_OrgSecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretFind'
-type MockStore_OrgSecretFind_Call struct {
+// MockStore_StepList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepList'
+type MockStore_StepList_Call struct {
 	*mock.Call
 }
 
-// OrgSecretFind is a helper method to define mock.On call
-//   - n int64
-//   - s string
-func (_e *MockStore_Expecter) OrgSecretFind(n any, s any) *MockStore_OrgSecretFind_Call {
-	return &MockStore_OrgSecretFind_Call{Call: _e.mock.On("OrgSecretFind", n, s)}
+// StepList is a helper method to define mock.On call
+//   - pipelineID int64
+func (_e *MockStore_Expecter) StepList(pipelineID any) *MockStore_StepList_Call {
+	return &MockStore_StepList_Call{Call: _e.mock.On("StepList", pipelineID)}
 }
 
-func (_c *MockStore_OrgSecretFind_Call) Run(run func(n int64, s string)) *MockStore_OrgSecretFind_Call {
+func (_c *MockStore_StepList_Call) Run(run func(pipelineID int64)) *MockStore_StepList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_OrgSecretFind_Call) Return(secret *model.Secret, err error) *MockStore_OrgSecretFind_Call {
-	_c.Call.Return(secret, err)
+func (_c *MockStore_StepList_Call) Return(steps []*model.Step, err error) *MockStore_StepList_Call {
+	_c.Call.Return(steps, err)
 	return _c
 }
 
-func (_c *MockStore_OrgSecretFind_Call) RunAndReturn(run func(n int64, s string) (*model.Secret, error)) *MockStore_OrgSecretFind_Call {
+func (_c *MockStore_StepList_Call) RunAndReturn(run func(pipelineID int64) ([]*model.Step, error)) *MockStore_StepList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgSecretList provides a mock function for the type MockStore
-func (_mock *MockStore) OrgSecretList(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
-	ret := _mock.Called(n, listOptionsWithAll)
+// StepListFinished provides a mock function for the type MockStore
+func (_mock *MockStore) StepListFinished(afterID int64, limit int) ([]*model.Step, error) {
+	ret := _mock.Called(afterID, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecretList")
+		panic("no return value specified for StepListFinished")
 	}
 
-	var r0 []*model.Secret
+	var r0 []*model.Step
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
-		return returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func(int64, int) ([]*model.Step, error)); ok {
+		return returnFunc(afterID, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *model.ListOptionsWithAll) []*model.Secret); ok {
-		r0 = returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func(int64, int) []*model.Step); ok {
+		r0 = returnFunc(afterID, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Secret)
+			r0 = ret.Get(0).([]*model.Step)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(n, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = returnFunc(afterID, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_OrgSecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretList'
-type MockStore_OrgSecretList_Call struct {
+// MockStore_StepListFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepListFinished'
+type MockStore_StepListFinished_Call struct {
 	*mock.Call
 }
 
-// OrgSecretList is a helper method to define mock.On call
-//   - n int64
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) OrgSecretList(n any, listOptionsWithAll any) *MockStore_OrgSecretList_Call {
-	return &MockStore_OrgSecretList_Call{Call: _e.mock.On("OrgSecretList", n, listOptionsWithAll)}
+// StepListFinished is a helper method to define mock.On call
+//   - afterID int64
+//   - limit int
+func (_e *MockStore_Expecter) StepListFinished(afterID any, limit any) *MockStore_StepListFinished_Call {
+	return &MockStore_StepListFinished_Call{Call: _e.mock.On("StepListFinished", afterID, limit)}
 }
 
-func (_c *MockStore_OrgSecretList_Call) Run(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_OrgSecretList_Call {
+func (_c *MockStore_StepListFinished_Call) Run(run func(afterID int64, limit int)) *MockStore_StepListFinished_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 *model.ListOptionsWithAll
+		var arg1 int
 		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
+			arg1 = args[1].(int)
 		}
 		run(
 			arg0,
@@ -4208,49 +7790,60 @@ func (_c *MockStore_OrgSecretList_Call) Run(run func(n int64, listOptionsWithAll
 	return _c
 }
 
-func (_c *MockStore_OrgSecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_OrgSecretList_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockStore_StepListFinished_Call) Return(steps []*model.Step, err error) *MockStore_StepListFinished_Call {
+	_c.Call.Return(steps, err)
 	return _c
 }
 
-func (_c *MockStore_OrgSecretList_Call) RunAndReturn(run func(n int64, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_OrgSecretList_Call {
+func (_c *MockStore_StepListFinished_Call) RunAndReturn(run func(afterID int64, limit int) ([]*model.Step, error)) *MockStore_StepListFinished_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) OrgUpdate(org *model.Org) error {
-	ret := _mock.Called(org)
+// StepListFromWorkflowFind provides a mock function for the type MockStore
+func (_mock *MockStore) StepListFromWorkflowFind(workflow *model.Workflow) ([]*model.Step, error) {
+	ret := _mock.Called(workflow)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgUpdate")
+		panic("no return value specified for StepListFromWorkflowFind")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Org) error); ok {
-		r0 = returnFunc(org)
+	var r0 []*model.Step
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Workflow) ([]*model.Step, error)); ok {
+		return returnFunc(workflow)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Workflow) []*model.Step); ok {
+		r0 = returnFunc(workflow)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Step)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Workflow) error); ok {
+		r1 = returnFunc(workflow)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_OrgUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgUpdate'
-type MockStore_OrgUpdate_Call struct {
+// MockStore_StepListFromWorkflowFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepListFromWorkflowFind'
+type MockStore_StepListFromWorkflowFind_Call struct {
 	*mock.Call
 }
 
-// OrgUpdate is a helper method to define mock.On call
-//   - org *model.Org
-func (_e *MockStore_Expecter) OrgUpdate(org any) *MockStore_OrgUpdate_Call {
-	return &MockStore_OrgUpdate_Call{Call: _e.mock.On("OrgUpdate", org)}
+// StepListFromWorkflowFind is a helper method to define mock.On call
+//   - workflow *model.Workflow
+func (_e *MockStore_Expecter) StepListFromWorkflowFind(workflow any) *MockStore_StepListFromWorkflowFind_Call {
+	return &MockStore_StepListFromWorkflowFind_Call{Call: _e.mock.On("StepListFromWorkflowFind", workflow)}
 }
 
-func (_c *MockStore_OrgUpdate_Call) Run(run func(org *model.Org)) *MockStore_OrgUpdate_Call {
+func (_c *MockStore_StepListFromWorkflowFind_Call) Run(run func(workflow *model.Workflow)) *MockStore_StepListFromWorkflowFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Org
+		var arg0 *model.Workflow
 		if args[0] != nil {
-			arg0 = args[0].(*model.Org)
+			arg0 = args[0].(*model.Workflow)
 		}
 		run(
 			arg0,
@@ -4259,65 +7852,65 @@ func (_c *MockStore_OrgUpdate_Call) Run(run func(org *model.Org)) *MockStore_Org
 	return _c
 }
 
-func (_c *MockStore_OrgUpdate_Call) Return(err error) *MockStore_OrgUpdate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_StepListFromWorkflowFind_Call) Return(steps []*model.Step, err error) *MockStore_StepListFromWorkflowFind_Call {
+	_c.Call.Return(steps, err)
 	return _c
 }
 
-func (_c *MockStore_OrgUpdate_Call) RunAndReturn(run func(org *model.Org) error) *MockStore_OrgUpdate_Call {
+func (_c *MockStore_StepListFromWorkflowFind_Call) RunAndReturn(run func(workflow *model.Workflow) ([]*model.Step, error)) *MockStore_StepListFromWorkflowFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PermFind provides a mock function for the type MockStore
-func (_mock *MockStore) PermFind(user *model.User, repo *model.Repo) (*model.Perm, error) {
-	ret := _mock.Called(user, repo)
+// StepLoad provides a mock function for the type MockStore
+func (_mock *MockStore) StepLoad(pipelineID int64, stepID int64) (*model.Step, error) {
+	ret := _mock.Called(pipelineID, stepID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PermFind")
+		panic("no return value specified for StepLoad")
 	}
 
-	var r0 *model.Perm
+	var r0 *model.Step
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User, *model.Repo) (*model.Perm, error)); ok {
-		return returnFunc(user, repo)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*model.Step, error)); ok {
+		return returnFunc(pipelineID, stepID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.User, *model.Repo) *model.Perm); ok {
-		r0 = returnFunc(user, repo)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) *model.Step); ok {
+		r0 = returnFunc(pipelineID, stepID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Perm)
+			r0 = ret.Get(0).(*model.Step)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.User, *model.Repo) error); ok {
-		r1 = returnFunc(user, repo)
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(pipelineID, stepID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_PermFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermFind'
-type MockStore_PermFind_Call struct {
-	*mock.Call
-}
-
-// PermFind is a helper method to define mock.On call
-//   - user *model.User
-//   - repo *model.Repo
-func (_e *MockStore_Expecter) PermFind(user any, repo any) *MockStore_PermFind_Call {
-	return &MockStore_PermFind_Call{Call: _e.mock.On("PermFind", user, repo)}
+// MockStore_StepLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepLoad'
+type MockStore_StepLoad_Call struct {
+	*mock.Call
 }
 
-func (_c *MockStore_PermFind_Call) Run(run func(user *model.User, repo *model.Repo)) *MockStore_PermFind_Call {
+// StepLoad is a helper method to define mock.On call
+//   - pipelineID int64
+//   - stepID int64
+func (_e *MockStore_Expecter) StepLoad(pipelineID any, stepID any) *MockStore_StepLoad_Call {
+	return &MockStore_StepLoad_Call{Call: _e.mock.On("StepLoad", pipelineID, stepID)}
+}
+
+func (_c *MockStore_StepLoad_Call) Run(run func(pipelineID int64, stepID int64)) *MockStore_StepLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(int64)
 		}
-		var arg1 *model.Repo
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*model.Repo)
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
@@ -4327,106 +7920,100 @@ func (_c *MockStore_PermFind_Call) Run(run func(user *model.User, repo *model.Re
 	return _c
 }
 
-func (_c *MockStore_PermFind_Call) Return(perm *model.Perm, err error) *MockStore_PermFind_Call {
-	_c.Call.Return(perm, err)
+func (_c *MockStore_StepLoad_Call) Return(step *model.Step, err error) *MockStore_StepLoad_Call {
+	_c.Call.Return(step, err)
 	return _c
 }
 
-func (_c *MockStore_PermFind_Call) RunAndReturn(run func(user *model.User, repo *model.Repo) (*model.Perm, error)) *MockStore_PermFind_Call {
+func (_c *MockStore_StepLoad_Call) RunAndReturn(run func(pipelineID int64, stepID int64) (*model.Step, error)) *MockStore_StepLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PermPrune provides a mock function for the type MockStore
-func (_mock *MockStore) PermPrune(userID int64, keepRepoIDs []int64) error {
-	ret := _mock.Called(userID, keepRepoIDs)
+// StepUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) StepUpdate(step *model.Step) error {
+	ret := _mock.Called(step)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PermPrune")
+		panic("no return value specified for StepUpdate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int64, []int64) error); ok {
-		r0 = returnFunc(userID, keepRepoIDs)
+	if returnFunc, ok := ret.Get(0).(func(*model.Step) error); ok {
+		r0 = returnFunc(step)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_PermPrune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermPrune'
-type MockStore_PermPrune_Call struct {
+// MockStore_StepUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepUpdate'
+type MockStore_StepUpdate_Call struct {
 	*mock.Call
 }
 
-// PermPrune is a helper method to define mock.On call
-//   - userID int64
-//   - keepRepoIDs []int64
-func (_e *MockStore_Expecter) PermPrune(userID any, keepRepoIDs any) *MockStore_PermPrune_Call {
-	return &MockStore_PermPrune_Call{Call: _e.mock.On("PermPrune", userID, keepRepoIDs)}
+// StepUpdate is a helper method to define mock.On call
+//   - step *model.Step
+func (_e *MockStore_Expecter) StepUpdate(step any) *MockStore_StepUpdate_Call {
+	return &MockStore_StepUpdate_Call{Call: _e.mock.On("StepUpdate", step)}
 }
 
-func (_c *MockStore_PermPrune_Call) Run(run func(userID int64, keepRepoIDs []int64)) *MockStore_PermPrune_Call {
+func (_c *MockStore_StepUpdate_Call) Run(run func(step *model.Step)) *MockStore_StepUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Step
 		if args[0] != nil {
-			arg0 = args[0].(int64)
-		}
-		var arg1 []int64
-		if args[1] != nil {
-			arg1 = args[1].([]int64)
+			arg0 = args[0].(*model.Step)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_PermPrune_Call) Return(err error) *MockStore_PermPrune_Call {
+func (_c *MockStore_StepUpdate_Call) Return(err error) *MockStore_StepUpdate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_PermPrune_Call) RunAndReturn(run func(userID int64, keepRepoIDs []int64) error) *MockStore_PermPrune_Call {
+func (_c *MockStore_StepUpdate_Call) RunAndReturn(run func(step *model.Step) error) *MockStore_StepUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PermUpsert provides a mock function for the type MockStore
-func (_mock *MockStore) PermUpsert(perm *model.Perm) error {
-	ret := _mock.Called(perm)
+// TaskCancel provides a mock function for the type MockStore
+func (_mock *MockStore) TaskCancel(task *model.Task) error {
+	ret := _mock.Called(task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PermUpsert")
+		panic("no return value specified for TaskCancel")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Perm) error); ok {
-		r0 = returnFunc(perm)
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) error); ok {
+		r0 = returnFunc(task)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_PermUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PermUpsert'
-type MockStore_PermUpsert_Call struct {
+// MockStore_TaskCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskCancel'
+type MockStore_TaskCancel_Call struct {
 	*mock.Call
 }
 
-// PermUpsert is a helper method to define mock.On call
-//   - perm *model.Perm
-func (_e *MockStore_Expecter) PermUpsert(perm any) *MockStore_PermUpsert_Call {
-	return &MockStore_PermUpsert_Call{Call: _e.mock.On("PermUpsert", perm)}
+// TaskCancel is a helper method to define mock.On call
+//   - task *model.Task
+func (_e *MockStore_Expecter) TaskCancel(task any) *MockStore_TaskCancel_Call {
+	return &MockStore_TaskCancel_Call{Call: _e.mock.On("TaskCancel", task)}
 }
 
-func (_c *MockStore_PermUpsert_Call) Run(run func(perm *model.Perm)) *MockStore_PermUpsert_Call {
+func (_c *MockStore_TaskCancel_Call) Run(run func(task *model.Task)) *MockStore_TaskCancel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Perm
+		var arg0 *model.Task
 		if args[0] != nil {
-			arg0 = args[0].(*model.Perm)
+			arg0 = args[0].(*model.Task)
 		}
 		run(
 			arg0,
@@ -4435,93 +8022,109 @@ func (_c *MockStore_PermUpsert_Call) Run(run func(perm *model.Perm)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_PermUpsert_Call) Return(err error) *MockStore_PermUpsert_Call {
+func (_c *MockStore_TaskCancel_Call) Return(err error) *MockStore_TaskCancel_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_PermUpsert_Call) RunAndReturn(run func(perm *model.Perm) error) *MockStore_PermUpsert_Call {
+func (_c *MockStore_TaskCancel_Call) RunAndReturn(run func(task *model.Task) error) *MockStore_TaskCancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Ping provides a mock function for the type MockStore
-func (_mock *MockStore) Ping() error {
-	ret := _mock.Called()
+// TaskClaim provides a mock function for the type MockStore
+func (_mock *MockStore) TaskClaim(task *model.Task) (bool, error) {
+	ret := _mock.Called(task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Ping")
+		panic("no return value specified for TaskClaim")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func() error); ok {
-		r0 = returnFunc()
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) (bool, error)); ok {
+		return returnFunc(task)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) bool); ok {
+		r0 = returnFunc(task)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Task) error); ok {
+		r1 = returnFunc(task)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_Ping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ping'
-type MockStore_Ping_Call struct {
+// MockStore_TaskClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskClaim'
+type MockStore_TaskClaim_Call struct {
 	*mock.Call
 }
 
-// Ping is a helper method to define mock.On call
-func (_e *MockStore_Expecter) Ping() *MockStore_Ping_Call {
-	return &MockStore_Ping_Call{Call: _e.mock.On("Ping")}
+// TaskClaim is a helper method to define mock.On call
+//   - task *model.Task
+func (_e *MockStore_Expecter) TaskClaim(task any) *MockStore_TaskClaim_Call {
+	return &MockStore_TaskClaim_Call{Call: _e.mock.On("TaskClaim", task)}
 }
 
-func (_c *MockStore_Ping_Call) Run(run func()) *MockStore_Ping_Call {
+func (_c *MockStore_TaskClaim_Call) Run(run func(task *model.Task)) *MockStore_TaskClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *model.Task
+		if args[0] != nil {
+			arg0 = args[0].(*model.Task)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_Ping_Call) Return(err error) *MockStore_Ping_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_TaskClaim_Call) Return(b bool, err error) *MockStore_TaskClaim_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockStore_Ping_Call) RunAndReturn(run func() error) *MockStore_Ping_Call {
+func (_c *MockStore_TaskClaim_Call) RunAndReturn(run func(task *model.Task) (bool, error)) *MockStore_TaskClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PipelineConfigCreate provides a mock function for the type MockStore
-func (_mock *MockStore) PipelineConfigCreate(pipelineConfig *model.PipelineConfig) error {
-	ret := _mock.Called(pipelineConfig)
+// TaskDelete provides a mock function for the type MockStore
+func (_mock *MockStore) TaskDelete(s string) error {
+	ret := _mock.Called(s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PipelineConfigCreate")
+		panic("no return value specified for TaskDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.PipelineConfig) error); ok {
-		r0 = returnFunc(pipelineConfig)
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(s)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_PipelineConfigCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineConfigCreate'
-type MockStore_PipelineConfigCreate_Call struct {
+// MockStore_TaskDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskDelete'
+type MockStore_TaskDelete_Call struct {
 	*mock.Call
 }
 
-// PipelineConfigCreate is a helper method to define mock.On call
-//   - pipelineConfig *model.PipelineConfig
-func (_e *MockStore_Expecter) PipelineConfigCreate(pipelineConfig any) *MockStore_PipelineConfigCreate_Call {
-	return &MockStore_PipelineConfigCreate_Call{Call: _e.mock.On("PipelineConfigCreate", pipelineConfig)}
+// TaskDelete is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) TaskDelete(s any) *MockStore_TaskDelete_Call {
+	return &MockStore_TaskDelete_Call{Call: _e.mock.On("TaskDelete", s)}
 }
 
-func (_c *MockStore_PipelineConfigCreate_Call) Run(run func(pipelineConfig *model.PipelineConfig)) *MockStore_PipelineConfigCreate_Call {
+func (_c *MockStore_TaskDelete_Call) Run(run func(s string)) *MockStore_TaskDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.PipelineConfig
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(*model.PipelineConfig)
+			arg0 = args[0].(string)
 		}
 		run(
 			arg0,
@@ -4530,49 +8133,49 @@ func (_c *MockStore_PipelineConfigCreate_Call) Run(run func(pipelineConfig *mode
 	return _c
 }
 
-func (_c *MockStore_PipelineConfigCreate_Call) Return(err error) *MockStore_PipelineConfigCreate_Call {
+func (_c *MockStore_TaskDelete_Call) Return(err error) *MockStore_TaskDelete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_PipelineConfigCreate_Call) RunAndReturn(run func(pipelineConfig *model.PipelineConfig) error) *MockStore_PipelineConfigCreate_Call {
+func (_c *MockStore_TaskDelete_Call) RunAndReturn(run func(s string) error) *MockStore_TaskDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryCreate provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryCreate(registry *model.Registry) error {
-	ret := _mock.Called(registry)
+// TaskExtend provides a mock function for the type MockStore
+func (_mock *MockStore) TaskExtend(task *model.Task) error {
+	ret := _mock.Called(task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryCreate")
+		panic("no return value specified for TaskExtend")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) error); ok {
+		r0 = returnFunc(task)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_RegistryCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryCreate'
-type MockStore_RegistryCreate_Call struct {
+// MockStore_TaskExtend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskExtend'
+type MockStore_TaskExtend_Call struct {
 	*mock.Call
 }
 
-// RegistryCreate is a helper method to define mock.On call
-//   - registry *model.Registry
-func (_e *MockStore_Expecter) RegistryCreate(registry any) *MockStore_RegistryCreate_Call {
-	return &MockStore_RegistryCreate_Call{Call: _e.mock.On("RegistryCreate", registry)}
+// TaskExtend is a helper method to define mock.On call
+//   - task *model.Task
+func (_e *MockStore_Expecter) TaskExtend(task any) *MockStore_TaskExtend_Call {
+	return &MockStore_TaskExtend_Call{Call: _e.mock.On("TaskExtend", task)}
 }
 
-func (_c *MockStore_RegistryCreate_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryCreate_Call {
+func (_c *MockStore_TaskExtend_Call) Run(run func(task *model.Task)) *MockStore_TaskExtend_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Registry
+		var arg0 *model.Task
 		if args[0] != nil {
-			arg0 = args[0].(*model.Registry)
+			arg0 = args[0].(*model.Task)
 		}
 		run(
 			arg0,
@@ -4581,49 +8184,49 @@ func (_c *MockStore_RegistryCreate_Call) Run(run func(registry *model.Registry))
 	return _c
 }
 
-func (_c *MockStore_RegistryCreate_Call) Return(err error) *MockStore_RegistryCreate_Call {
+func (_c *MockStore_TaskExtend_Call) Return(err error) *MockStore_TaskExtend_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RegistryCreate_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryCreate_Call {
+func (_c *MockStore_TaskExtend_Call) RunAndReturn(run func(task *model.Task) error) *MockStore_TaskExtend_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryDelete provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryDelete(registry *model.Registry) error {
-	ret := _mock.Called(registry)
+// TaskInsert provides a mock function for the type MockStore
+func (_mock *MockStore) TaskInsert(task *model.Task) error {
+	ret := _mock.Called(task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryDelete")
+		panic("no return value specified for TaskInsert")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) error); ok {
+		r0 = returnFunc(task)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_RegistryDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryDelete'
-type MockStore_RegistryDelete_Call struct {
+// MockStore_TaskInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskInsert'
+type MockStore_TaskInsert_Call struct {
 	*mock.Call
 }
 
-// RegistryDelete is a helper method to define mock.On call
-//   - registry *model.Registry
-func (_e *MockStore_Expecter) RegistryDelete(registry any) *MockStore_RegistryDelete_Call {
-	return &MockStore_RegistryDelete_Call{Call: _e.mock.On("RegistryDelete", registry)}
+// TaskInsert is a helper method to define mock.On call
+//   - task *model.Task
+func (_e *MockStore_Expecter) TaskInsert(task any) *MockStore_TaskInsert_Call {
+	return &MockStore_TaskInsert_Call{Call: _e.mock.On("TaskInsert", task)}
 }
 
-func (_c *MockStore_RegistryDelete_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryDelete_Call {
+func (_c *MockStore_TaskInsert_Call) Run(run func(task *model.Task)) *MockStore_TaskInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Registry
+		var arg0 *model.Task
 		if args[0] != nil {
-			arg0 = args[0].(*model.Registry)
+			arg0 = args[0].(*model.Task)
 		}
 		run(
 			arg0,
@@ -4632,246 +8235,217 @@ func (_c *MockStore_RegistryDelete_Call) Run(run func(registry *model.Registry))
 	return _c
 }
 
-func (_c *MockStore_RegistryDelete_Call) Return(err error) *MockStore_RegistryDelete_Call {
+func (_c *MockStore_TaskInsert_Call) Return(err error) *MockStore_TaskInsert_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RegistryDelete_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryDelete_Call {
+func (_c *MockStore_TaskInsert_Call) RunAndReturn(run func(task *model.Task) error) *MockStore_TaskInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryFind provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryFind(repo *model.Repo, s string) (*model.Registry, error) {
-	ret := _mock.Called(repo, s)
+// TaskInsertBatch provides a mock function for the type MockStore
+func (_mock *MockStore) TaskInsertBatch(tasks []*model.Task) error {
+	ret := _mock.Called(tasks)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryFind")
+		panic("no return value specified for TaskInsertBatch")
 	}
 
-	var r0 *model.Registry
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Registry, error)); ok {
-		return returnFunc(repo, s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Registry); ok {
-		r0 = returnFunc(repo, s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Registry)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
-		r1 = returnFunc(repo, s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]*model.Task) error); ok {
+		r0 = returnFunc(tasks)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_RegistryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryFind'
-type MockStore_RegistryFind_Call struct {
+// MockStore_TaskInsertBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskInsertBatch'
+type MockStore_TaskInsertBatch_Call struct {
 	*mock.Call
 }
 
-// RegistryFind is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - s string
-func (_e *MockStore_Expecter) RegistryFind(repo any, s any) *MockStore_RegistryFind_Call {
-	return &MockStore_RegistryFind_Call{Call: _e.mock.On("RegistryFind", repo, s)}
+// TaskInsertBatch is a helper method to define mock.On call
+//   - tasks []*model.Task
+func (_e *MockStore_Expecter) TaskInsertBatch(tasks any) *MockStore_TaskInsertBatch_Call {
+	return &MockStore_TaskInsertBatch_Call{Call: _e.mock.On("TaskInsertBatch", tasks)}
 }
 
-func (_c *MockStore_RegistryFind_Call) Run(run func(repo *model.Repo, s string)) *MockStore_RegistryFind_Call {
+func (_c *MockStore_TaskInsertBatch_Call) Run(run func(tasks []*model.Task)) *MockStore_TaskInsertBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 []*model.Task
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg0 = args[0].([]*model.Task)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_RegistryFind_Call) Return(registry *model.Registry, err error) *MockStore_RegistryFind_Call {
-	_c.Call.Return(registry, err)
+func (_c *MockStore_TaskInsertBatch_Call) Return(err error) *MockStore_TaskInsertBatch_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RegistryFind_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Registry, error)) *MockStore_RegistryFind_Call {
+func (_c *MockStore_TaskInsertBatch_Call) RunAndReturn(run func(tasks []*model.Task) error) *MockStore_TaskInsertBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryList provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryList(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error) {
-	ret := _mock.Called(repo, b, listOptionsWithAll)
+// TaskList provides a mock function for the type MockStore
+func (_mock *MockStore) TaskList() ([]*model.Task, error) {
+	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryList")
+		panic("no return value specified for TaskList")
 	}
 
-	var r0 []*model.Registry
+	var r0 []*model.Task
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) ([]*model.Registry, error)); ok {
-		return returnFunc(repo, b, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func() ([]*model.Task, error)); ok {
+		return returnFunc()
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) []*model.Registry); ok {
-		r0 = returnFunc(repo, b, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(0).(func() []*model.Task); ok {
+		r0 = returnFunc()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Registry)
+			r0 = ret.Get(0).([]*model.Task)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, bool, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(repo, b, listOptionsWithAll)
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_RegistryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryList'
-type MockStore_RegistryList_Call struct {
+// MockStore_TaskList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskList'
+type MockStore_TaskList_Call struct {
 	*mock.Call
 }
 
-// RegistryList is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - b bool
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) RegistryList(repo any, b any, listOptionsWithAll any) *MockStore_RegistryList_Call {
-	return &MockStore_RegistryList_Call{Call: _e.mock.On("RegistryList", repo, b, listOptionsWithAll)}
+// TaskList is a helper method to define mock.On call
+func (_e *MockStore_Expecter) TaskList() *MockStore_TaskList_Call {
+	return &MockStore_TaskList_Call{Call: _e.mock.On("TaskList")}
 }
 
-func (_c *MockStore_RegistryList_Call) Run(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_RegistryList_Call {
+func (_c *MockStore_TaskList_Call) Run(run func()) *MockStore_TaskList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
-		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 bool
-		if args[1] != nil {
-			arg1 = args[1].(bool)
-		}
-		var arg2 *model.ListOptionsWithAll
-		if args[2] != nil {
-			arg2 = args[2].(*model.ListOptionsWithAll)
-		}
-		run(
-			arg0,
-			arg1,
-			arg2,
-		)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockStore_RegistryList_Call) Return(registrys []*model.Registry, err error) *MockStore_RegistryList_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockStore_TaskList_Call) Return(tasks []*model.Task, err error) *MockStore_TaskList_Call {
+	_c.Call.Return(tasks, err)
 	return _c
 }
 
-func (_c *MockStore_RegistryList_Call) RunAndReturn(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Registry, error)) *MockStore_RegistryList_Call {
+func (_c *MockStore_TaskList_Call) RunAndReturn(run func() ([]*model.Task, error)) *MockStore_TaskList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryListAll provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryListAll() ([]*model.Registry, error) {
-	ret := _mock.Called()
+// TaskLoad provides a mock function for the type MockStore
+func (_mock *MockStore) TaskLoad(s string) (*model.Task, error) {
+	ret := _mock.Called(s)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryListAll")
+		panic("no return value specified for TaskLoad")
 	}
 
-	var r0 []*model.Registry
+	var r0 *model.Task
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*model.Registry, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string) (*model.Task, error)); ok {
+		return returnFunc(s)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []*model.Registry); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string) *model.Task); ok {
+		r0 = returnFunc(s)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Registry)
+			r0 = ret.Get(0).(*model.Task)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(s)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_RegistryListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryListAll'
-type MockStore_RegistryListAll_Call struct {
+// MockStore_TaskLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskLoad'
+type MockStore_TaskLoad_Call struct {
 	*mock.Call
 }
 
-// RegistryListAll is a helper method to define mock.On call
-func (_e *MockStore_Expecter) RegistryListAll() *MockStore_RegistryListAll_Call {
-	return &MockStore_RegistryListAll_Call{Call: _e.mock.On("RegistryListAll")}
+// TaskLoad is a helper method to define mock.On call
+//   - s string
+func (_e *MockStore_Expecter) TaskLoad(s any) *MockStore_TaskLoad_Call {
+	return &MockStore_TaskLoad_Call{Call: _e.mock.On("TaskLoad", s)}
 }
 
-func (_c *MockStore_RegistryListAll_Call) Run(run func()) *MockStore_RegistryListAll_Call {
+func (_c *MockStore_TaskLoad_Call) Run(run func(s string)) *MockStore_TaskLoad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_RegistryListAll_Call) Return(registrys []*model.Registry, err error) *MockStore_RegistryListAll_Call {
-	_c.Call.Return(registrys, err)
+func (_c *MockStore_TaskLoad_Call) Return(task *model.Task, err error) *MockStore_TaskLoad_Call {
+	_c.Call.Return(task, err)
 	return _c
 }
 
-func (_c *MockStore_RegistryListAll_Call) RunAndReturn(run func() ([]*model.Registry, error)) *MockStore_RegistryListAll_Call {
+func (_c *MockStore_TaskLoad_Call) RunAndReturn(run func(s string) (*model.Task, error)) *MockStore_TaskLoad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegistryUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) RegistryUpdate(registry *model.Registry) error {
-	ret := _mock.Called(registry)
+// TaskResubmitExpired provides a mock function for the type MockStore
+func (_mock *MockStore) TaskResubmitExpired(n int64) error {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegistryUpdate")
+		panic("no return value specified for TaskResubmitExpired")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Registry) error); ok {
-		r0 = returnFunc(registry)
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(n)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_RegistryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegistryUpdate'
-type MockStore_RegistryUpdate_Call struct {
+// MockStore_TaskResubmitExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskResubmitExpired'
+type MockStore_TaskResubmitExpired_Call struct {
 	*mock.Call
 }
 
-// RegistryUpdate is a helper method to define mock.On call
-//   - registry *model.Registry
-func (_e *MockStore_Expecter) RegistryUpdate(registry any) *MockStore_RegistryUpdate_Call {
-	return &MockStore_RegistryUpdate_Call{Call: _e.mock.On("RegistryUpdate", registry)}
+// TaskResubmitExpired is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) TaskResubmitExpired(n any) *MockStore_TaskResubmitExpired_Call {
+	return &MockStore_TaskResubmitExpired_Call{Call: _e.mock.On("TaskResubmitExpired", n)}
 }
 
-func (_c *MockStore_RegistryUpdate_Call) Run(run func(registry *model.Registry)) *MockStore_RegistryUpdate_Call {
+func (_c *MockStore_TaskResubmitExpired_Call) Run(run func(n int64)) *MockStore_TaskResubmitExpired_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Registry
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Registry)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
@@ -4880,145 +8454,105 @@ func (_c *MockStore_RegistryUpdate_Call) Run(run func(registry *model.Registry))
 	return _c
 }
 
-func (_c *MockStore_RegistryUpdate_Call) Return(err error) *MockStore_RegistryUpdate_Call {
+func (_c *MockStore_TaskResubmitExpired_Call) Return(err error) *MockStore_TaskResubmitExpired_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RegistryUpdate_Call) RunAndReturn(run func(registry *model.Registry) error) *MockStore_RegistryUpdate_Call {
+func (_c *MockStore_TaskResubmitExpired_Call) RunAndReturn(run func(n int64) error) *MockStore_TaskResubmitExpired_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RepoList provides a mock function for the type MockStore
-func (_mock *MockStore) RepoList(user *model.User, owned bool, active bool, filter *model.RepoFilter) ([]*model.Repo, error) {
-	ret := _mock.Called(user, owned, active, filter)
+// TaskRetry provides a mock function for the type MockStore
+func (_mock *MockStore) TaskRetry(task *model.Task) error {
+	ret := _mock.Called(task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RepoList")
+		panic("no return value specified for TaskRetry")
 	}
 
-	var r0 []*model.Repo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User, bool, bool, *model.RepoFilter) ([]*model.Repo, error)); ok {
-		return returnFunc(user, owned, active, filter)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.User, bool, bool, *model.RepoFilter) []*model.Repo); ok {
-		r0 = returnFunc(user, owned, active, filter)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Repo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.User, bool, bool, *model.RepoFilter) error); ok {
-		r1 = returnFunc(user, owned, active, filter)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Task) error); ok {
+		r0 = returnFunc(task)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_RepoList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoList'
-type MockStore_RepoList_Call struct {
+// MockStore_TaskRetry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskRetry'
+type MockStore_TaskRetry_Call struct {
 	*mock.Call
 }
 
-// RepoList is a helper method to define mock.On call
-//   - user *model.User
-//   - owned bool
-//   - active bool
-//   - filter *model.RepoFilter
-func (_e *MockStore_Expecter) RepoList(user any, owned any, active any, filter any) *MockStore_RepoList_Call {
-	return &MockStore_RepoList_Call{Call: _e.mock.On("RepoList", user, owned, active, filter)}
+// TaskRetry is a helper method to define mock.On call
+//   - task *model.Task
+func (_e *MockStore_Expecter) TaskRetry(task any) *MockStore_TaskRetry_Call {
+	return &MockStore_TaskRetry_Call{Call: _e.mock.On("TaskRetry", task)}
 }
 
-func (_c *MockStore_RepoList_Call) Run(run func(user *model.User, owned bool, active bool, filter *model.RepoFilter)) *MockStore_RepoList_Call {
+func (_c *MockStore_TaskRetry_Call) Run(run func(task *model.Task)) *MockStore_TaskRetry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.Task
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
-		}
-		var arg1 bool
-		if args[1] != nil {
-			arg1 = args[1].(bool)
-		}
-		var arg2 bool
-		if args[2] != nil {
-			arg2 = args[2].(bool)
-		}
-		var arg3 *model.RepoFilter
-		if args[3] != nil {
-			arg3 = args[3].(*model.RepoFilter)
+			arg0 = args[0].(*model.Task)
 		}
 		run(
 			arg0,
-			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_RepoList_Call) Return(repos []*model.Repo, err error) *MockStore_RepoList_Call {
-	_c.Call.Return(repos, err)
+func (_c *MockStore_TaskRetry_Call) Return(err error) *MockStore_TaskRetry_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RepoList_Call) RunAndReturn(run func(user *model.User, owned bool, active bool, filter *model.RepoFilter) ([]*model.Repo, error)) *MockStore_RepoList_Call {
+func (_c *MockStore_TaskRetry_Call) RunAndReturn(run func(task *model.Task) error) *MockStore_TaskRetry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RepoListAll provides a mock function for the type MockStore
-func (_mock *MockStore) RepoListAll(active bool, p *model.ListOptionsWithAll) ([]*model.Repo, error) {
-	ret := _mock.Called(active, p)
+// TaskUpdateDepStatus provides a mock function for the type MockStore
+func (_mock *MockStore) TaskUpdateDepStatus(s string, statusValue model.StatusValue) error {
+	ret := _mock.Called(s, statusValue)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RepoListAll")
+		panic("no return value specified for TaskUpdateDepStatus")
 	}
 
-	var r0 []*model.Repo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(bool, *model.ListOptionsWithAll) ([]*model.Repo, error)); ok {
-		return returnFunc(active, p)
-	}
-	if returnFunc, ok := ret.Get(0).(func(bool, *model.ListOptionsWithAll) []*model.Repo); ok {
-		r0 = returnFunc(active, p)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Repo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(bool, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(active, p)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.StatusValue) error); ok {
+		r0 = returnFunc(s, statusValue)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_RepoListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoListAll'
-type MockStore_RepoListAll_Call struct {
+// MockStore_TaskUpdateDepStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskUpdateDepStatus'
+type MockStore_TaskUpdateDepStatus_Call struct {
 	*mock.Call
 }
 
-// RepoListAll is a helper method to define mock.On call
-//   - active bool
-//   - p *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) RepoListAll(active any, p any) *MockStore_RepoListAll_Call {
-	return &MockStore_RepoListAll_Call{Call: _e.mock.On("RepoListAll", active, p)}
+// TaskUpdateDepStatus is a helper method to define mock.On call
+//   - s string
+//   - statusValue model.StatusValue
+func (_e *MockStore_Expecter) TaskUpdateDepStatus(s any, statusValue any) *MockStore_TaskUpdateDepStatus_Call {
+	return &MockStore_TaskUpdateDepStatus_Call{Call: _e.mock.On("TaskUpdateDepStatus", s, statusValue)}
 }
 
-func (_c *MockStore_RepoListAll_Call) Run(run func(active bool, p *model.ListOptionsWithAll)) *MockStore_RepoListAll_Call {
+func (_c *MockStore_TaskUpdateDepStatus_Call) Run(run func(s string, statusValue model.StatusValue)) *MockStore_TaskUpdateDepStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 bool
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(bool)
+			arg0 = args[0].(string)
 		}
-		var arg1 *model.ListOptionsWithAll
+		var arg1 model.StatusValue
 		if args[1] != nil {
-			arg1 = args[1].(*model.ListOptionsWithAll)
+			arg1 = args[1].(model.StatusValue)
 		}
 		run(
 			arg0,
@@ -5028,111 +8562,134 @@ func (_c *MockStore_RepoListAll_Call) Run(run func(active bool, p *model.ListOpt
 	return _c
 }
 
-func (_c *MockStore_RepoListAll_Call) Return(repos []*model.Repo, err error) *MockStore_RepoListAll_Call {
-	_c.Call.Return(repos, err)
+func (_c *MockStore_TaskUpdateDepStatus_Call) Return(err error) *MockStore_TaskUpdateDepStatus_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_RepoListAll_Call) RunAndReturn(run func(active bool, p *model.ListOptionsWithAll) ([]*model.Repo, error)) *MockStore_RepoListAll_Call {
+func (_c *MockStore_TaskUpdateDepStatus_Call) RunAndReturn(run func(s string, statusValue model.StatusValue) error) *MockStore_TaskUpdateDepStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RepoListLatest provides a mock function for the type MockStore
-func (_mock *MockStore) RepoListLatest(user *model.User) ([]*model.Feed, error) {
-	ret := _mock.Called(user)
+// TestResultHistory provides a mock function for the type MockStore
+func (_mock *MockStore) TestResultHistory(repo *model.Repo, before int64, limit int) ([]*model.TestResult, error) {
+	ret := _mock.Called(repo, before, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RepoListLatest")
+		panic("no return value specified for TestResultHistory")
 	}
 
-	var r0 []*model.Feed
+	var r0 []*model.TestResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) ([]*model.Feed, error)); ok {
-		return returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64, int) ([]*model.TestResult, error)); ok {
+		return returnFunc(repo, before, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.User) []*model.Feed); ok {
-		r0 = returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo, int64, int) []*model.TestResult); ok {
+		r0 = returnFunc(repo, before, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Feed)
+			r0 = ret.Get(0).([]*model.TestResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
-		r1 = returnFunc(user)
+	if returnFunc, ok := ret.Get(1).(func(*model.Repo, int64, int) error); ok {
+		r1 = returnFunc(repo, before, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_RepoListLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RepoListLatest'
-type MockStore_RepoListLatest_Call struct {
+// MockStore_TestResultHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestResultHistory'
+type MockStore_TestResultHistory_Call struct {
 	*mock.Call
 }
 
-// RepoListLatest is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockStore_Expecter) RepoListLatest(user any) *MockStore_RepoListLatest_Call {
-	return &MockStore_RepoListLatest_Call{Call: _e.mock.On("RepoListLatest", user)}
+// TestResultHistory is a helper method to define mock.On call
+//   - repo *model.Repo
+//   - before int64
+//   - limit int
+func (_e *MockStore_Expecter) TestResultHistory(repo any, before any, limit any) *MockStore_TestResultHistory_Call {
+	return &MockStore_TestResultHistory_Call{Call: _e.mock.On("TestResultHistory", repo, before, limit)}
 }
 
-func (_c *MockStore_RepoListLatest_Call) Run(run func(user *model.User)) *MockStore_RepoListLatest_Call {
+func (_c *MockStore_TestResultHistory_Call) Run(run func(repo *model.Repo, before int64, limit int)) *MockStore_TestResultHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.Repo
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(*model.Repo)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_RepoListLatest_Call) Return(feeds []*model.Feed, err error) *MockStore_RepoListLatest_Call {
-	_c.Call.Return(feeds, err)
+func (_c *MockStore_TestResultHistory_Call) Return(testResults []*model.TestResult, err error) *MockStore_TestResultHistory_Call {
+	_c.Call.Return(testResults, err)
 	return _c
 }
 
-func (_c *MockStore_RepoListLatest_Call) RunAndReturn(run func(user *model.User) ([]*model.Feed, error)) *MockStore_RepoListLatest_Call {
+func (_c *MockStore_TestResultHistory_Call) RunAndReturn(run func(repo *model.Repo, before int64, limit int) ([]*model.TestResult, error)) *MockStore_TestResultHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretCreate provides a mock function for the type MockStore
-func (_mock *MockStore) SecretCreate(secret *model.Secret) error {
-	ret := _mock.Called(secret)
+// TestResultList provides a mock function for the type MockStore
+func (_mock *MockStore) TestResultList(pipeline *model.Pipeline) ([]*model.TestResult, error) {
+	ret := _mock.Called(pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretCreate")
+		panic("no return value specified for TestResultList")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
-		r0 = returnFunc(secret)
+	var r0 []*model.TestResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) ([]*model.TestResult, error)); ok {
+		return returnFunc(pipeline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) []*model.TestResult); ok {
+		r0 = returnFunc(pipeline)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TestResult)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.Pipeline) error); ok {
+		r1 = returnFunc(pipeline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_SecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretCreate'
-type MockStore_SecretCreate_Call struct {
+// MockStore_TestResultList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestResultList'
+type MockStore_TestResultList_Call struct {
 	*mock.Call
 }
 
-// SecretCreate is a helper method to define mock.On call
-//   - secret *model.Secret
-func (_e *MockStore_Expecter) SecretCreate(secret any) *MockStore_SecretCreate_Call {
-	return &MockStore_SecretCreate_Call{Call: _e.mock.On("SecretCreate", secret)}
+// TestResultList is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) TestResultList(pipeline any) *MockStore_TestResultList_Call {
+	return &MockStore_TestResultList_Call{Call: _e.mock.On("TestResultList", pipeline)}
 }
 
-func (_c *MockStore_SecretCreate_Call) Run(run func(secret *model.Secret)) *MockStore_SecretCreate_Call {
+func (_c *MockStore_TestResultList_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_TestResultList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Secret
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.Secret)
+			arg0 = args[0].(*model.Pipeline)
 		}
 		run(
 			arg0,
@@ -5141,297 +8698,259 @@ func (_c *MockStore_SecretCreate_Call) Run(run func(secret *model.Secret)) *Mock
 	return _c
 }
 
-func (_c *MockStore_SecretCreate_Call) Return(err error) *MockStore_SecretCreate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_TestResultList_Call) Return(testResults []*model.TestResult, err error) *MockStore_TestResultList_Call {
+	_c.Call.Return(testResults, err)
 	return _c
 }
 
-func (_c *MockStore_SecretCreate_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretCreate_Call {
+func (_c *MockStore_TestResultList_Call) RunAndReturn(run func(pipeline *model.Pipeline) ([]*model.TestResult, error)) *MockStore_TestResultList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretDelete provides a mock function for the type MockStore
-func (_mock *MockStore) SecretDelete(secret *model.Secret) error {
-	ret := _mock.Called(secret)
+// TestResultReplace provides a mock function for the type MockStore
+func (_mock *MockStore) TestResultReplace(step *model.Step, testResults []*model.TestResult) error {
+	ret := _mock.Called(step, testResults)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretDelete")
+		panic("no return value specified for TestResultReplace")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
-		r0 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(*model.Step, []*model.TestResult) error); ok {
+		r0 = returnFunc(step, testResults)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_SecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretDelete'
-type MockStore_SecretDelete_Call struct {
+// MockStore_TestResultReplace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TestResultReplace'
+type MockStore_TestResultReplace_Call struct {
 	*mock.Call
 }
 
-// SecretDelete is a helper method to define mock.On call
-//   - secret *model.Secret
-func (_e *MockStore_Expecter) SecretDelete(secret any) *MockStore_SecretDelete_Call {
-	return &MockStore_SecretDelete_Call{Call: _e.mock.On("SecretDelete", secret)}
+// TestResultReplace is a helper method to define mock.On call
+//   - step *model.Step
+//   - testResults []*model.TestResult
+func (_e *MockStore_Expecter) TestResultReplace(step any, testResults any) *MockStore_TestResultReplace_Call {
+	return &MockStore_TestResultReplace_Call{Call: _e.mock.On("TestResultReplace", step, testResults)}
 }
 
-func (_c *MockStore_SecretDelete_Call) Run(run func(secret *model.Secret)) *MockStore_SecretDelete_Call {
+func (_c *MockStore_TestResultReplace_Call) Run(run func(step *model.Step, testResults []*model.TestResult)) *MockStore_TestResultReplace_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Secret
+		var arg0 *model.Step
 		if args[0] != nil {
-			arg0 = args[0].(*model.Secret)
+			arg0 = args[0].(*model.Step)
+		}
+		var arg1 []*model.TestResult
+		if args[1] != nil {
+			arg1 = args[1].([]*model.TestResult)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_SecretDelete_Call) Return(err error) *MockStore_SecretDelete_Call {
+func (_c *MockStore_TestResultReplace_Call) Return(err error) *MockStore_TestResultReplace_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SecretDelete_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretDelete_Call {
+func (_c *MockStore_TestResultReplace_Call) RunAndReturn(run func(step *model.Step, testResults []*model.TestResult) error) *MockStore_TestResultReplace_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretFind provides a mock function for the type MockStore
-func (_mock *MockStore) SecretFind(repo *model.Repo, s string) (*model.Secret, error) {
-	ret := _mock.Called(repo, s)
+// UpdatePipeline provides a mock function for the type MockStore
+func (_mock *MockStore) UpdatePipeline(pipeline *model.Pipeline) error {
+	ret := _mock.Called(pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretFind")
+		panic("no return value specified for UpdatePipeline")
 	}
 
-	var r0 *model.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) (*model.Secret, error)); ok {
-		return returnFunc(repo, s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, string) *model.Secret); ok {
-		r0 = returnFunc(repo, s)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, string) error); ok {
-		r1 = returnFunc(repo, s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) error); ok {
+		r0 = returnFunc(pipeline)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_SecretFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretFind'
-type MockStore_SecretFind_Call struct {
+// MockStore_UpdatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipeline'
+type MockStore_UpdatePipeline_Call struct {
 	*mock.Call
 }
 
-// SecretFind is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - s string
-func (_e *MockStore_Expecter) SecretFind(repo any, s any) *MockStore_SecretFind_Call {
-	return &MockStore_SecretFind_Call{Call: _e.mock.On("SecretFind", repo, s)}
+// UpdatePipeline is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) UpdatePipeline(pipeline any) *MockStore_UpdatePipeline_Call {
+	return &MockStore_UpdatePipeline_Call{Call: _e.mock.On("UpdatePipeline", pipeline)}
 }
 
-func (_c *MockStore_SecretFind_Call) Run(run func(repo *model.Repo, s string)) *MockStore_SecretFind_Call {
+func (_c *MockStore_UpdatePipeline_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_UpdatePipeline_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg0 = args[0].(*model.Pipeline)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_SecretFind_Call) Return(secret *model.Secret, err error) *MockStore_SecretFind_Call {
-	_c.Call.Return(secret, err)
+func (_c *MockStore_UpdatePipeline_Call) Return(err error) *MockStore_UpdatePipeline_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SecretFind_Call) RunAndReturn(run func(repo *model.Repo, s string) (*model.Secret, error)) *MockStore_SecretFind_Call {
+func (_c *MockStore_UpdatePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline) error) *MockStore_UpdatePipeline_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretList provides a mock function for the type MockStore
-func (_mock *MockStore) SecretList(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error) {
-	ret := _mock.Called(repo, b, listOptionsWithAll)
+// UpdatePipelineErrors provides a mock function for the type MockStore
+func (_mock *MockStore) UpdatePipelineErrors(pipeline *model.Pipeline) error {
+	ret := _mock.Called(pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretList")
+		panic("no return value specified for UpdatePipelineErrors")
 	}
 
-	var r0 []*model.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) ([]*model.Secret, error)); ok {
-		return returnFunc(repo, b, listOptionsWithAll)
-	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo, bool, *model.ListOptionsWithAll) []*model.Secret); ok {
-		r0 = returnFunc(repo, b, listOptionsWithAll)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Repo, bool, *model.ListOptionsWithAll) error); ok {
-		r1 = returnFunc(repo, b, listOptionsWithAll)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) error); ok {
+		r0 = returnFunc(pipeline)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_SecretList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretList'
-type MockStore_SecretList_Call struct {
+// MockStore_UpdatePipelineErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipelineErrors'
+type MockStore_UpdatePipelineErrors_Call struct {
 	*mock.Call
 }
 
-// SecretList is a helper method to define mock.On call
-//   - repo *model.Repo
-//   - b bool
-//   - listOptionsWithAll *model.ListOptionsWithAll
-func (_e *MockStore_Expecter) SecretList(repo any, b any, listOptionsWithAll any) *MockStore_SecretList_Call {
-	return &MockStore_SecretList_Call{Call: _e.mock.On("SecretList", repo, b, listOptionsWithAll)}
+// UpdatePipelineErrors is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) UpdatePipelineErrors(pipeline any) *MockStore_UpdatePipelineErrors_Call {
+	return &MockStore_UpdatePipelineErrors_Call{Call: _e.mock.On("UpdatePipelineErrors", pipeline)}
 }
 
-func (_c *MockStore_SecretList_Call) Run(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll)) *MockStore_SecretList_Call {
+func (_c *MockStore_UpdatePipelineErrors_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_UpdatePipelineErrors_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
-		}
-		var arg1 bool
-		if args[1] != nil {
-			arg1 = args[1].(bool)
-		}
-		var arg2 *model.ListOptionsWithAll
-		if args[2] != nil {
-			arg2 = args[2].(*model.ListOptionsWithAll)
+			arg0 = args[0].(*model.Pipeline)
 		}
 		run(
 			arg0,
-			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_SecretList_Call) Return(secrets []*model.Secret, err error) *MockStore_SecretList_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockStore_UpdatePipelineErrors_Call) Return(err error) *MockStore_UpdatePipelineErrors_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SecretList_Call) RunAndReturn(run func(repo *model.Repo, b bool, listOptionsWithAll *model.ListOptionsWithAll) ([]*model.Secret, error)) *MockStore_SecretList_Call {
+func (_c *MockStore_UpdatePipelineErrors_Call) RunAndReturn(run func(pipeline *model.Pipeline) error) *MockStore_UpdatePipelineErrors_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretListAll provides a mock function for the type MockStore
-func (_mock *MockStore) SecretListAll() ([]*model.Secret, error) {
-	ret := _mock.Called()
+// UpdateRepo provides a mock function for the type MockStore
+func (_mock *MockStore) UpdateRepo(repo *model.Repo) error {
+	ret := _mock.Called(repo)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretListAll")
+		panic("no return value specified for UpdateRepo")
 	}
 
-	var r0 []*model.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*model.Secret, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() []*model.Secret); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
+		r0 = returnFunc(repo)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_SecretListAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretListAll'
-type MockStore_SecretListAll_Call struct {
+// MockStore_UpdateRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRepo'
+type MockStore_UpdateRepo_Call struct {
 	*mock.Call
 }
 
-// SecretListAll is a helper method to define mock.On call
-func (_e *MockStore_Expecter) SecretListAll() *MockStore_SecretListAll_Call {
-	return &MockStore_SecretListAll_Call{Call: _e.mock.On("SecretListAll")}
+// UpdateRepo is a helper method to define mock.On call
+//   - repo *model.Repo
+func (_e *MockStore_Expecter) UpdateRepo(repo any) *MockStore_UpdateRepo_Call {
+	return &MockStore_UpdateRepo_Call{Call: _e.mock.On("UpdateRepo", repo)}
 }
 
-func (_c *MockStore_SecretListAll_Call) Run(run func()) *MockStore_SecretListAll_Call {
+func (_c *MockStore_UpdateRepo_Call) Run(run func(repo *model.Repo)) *MockStore_UpdateRepo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 *model.Repo
+		if args[0] != nil {
+			arg0 = args[0].(*model.Repo)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_SecretListAll_Call) Return(secrets []*model.Secret, err error) *MockStore_SecretListAll_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockStore_UpdateRepo_Call) Return(err error) *MockStore_UpdateRepo_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SecretListAll_Call) RunAndReturn(run func() ([]*model.Secret, error)) *MockStore_SecretListAll_Call {
+func (_c *MockStore_UpdateRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_UpdateRepo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SecretUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) SecretUpdate(secret *model.Secret) error {
-	ret := _mock.Called(secret)
+// UpdateUser provides a mock function for the type MockStore
+func (_mock *MockStore) UpdateUser(user *model.User) error {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SecretUpdate")
+		panic("no return value specified for UpdateUser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Secret) error); ok {
-		r0 = returnFunc(secret)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
+		r0 = returnFunc(user)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_SecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretUpdate'
-type MockStore_SecretUpdate_Call struct {
+// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockStore_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// SecretUpdate is a helper method to define mock.On call
-//   - secret *model.Secret
-func (_e *MockStore_Expecter) SecretUpdate(secret any) *MockStore_SecretUpdate_Call {
-	return &MockStore_SecretUpdate_Call{Call: _e.mock.On("SecretUpdate", secret)}
+// UpdateUser is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) UpdateUser(user any) *MockStore_UpdateUser_Call {
+	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", user)}
 }
 
-func (_c *MockStore_SecretUpdate_Call) Run(run func(secret *model.Secret)) *MockStore_SecretUpdate_Call {
+func (_c *MockStore_UpdateUser_Call) Run(run func(user *model.User)) *MockStore_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Secret
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(*model.Secret)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
@@ -5440,49 +8959,60 @@ func (_c *MockStore_SecretUpdate_Call) Run(run func(secret *model.Secret)) *Mock
 	return _c
 }
 
-func (_c *MockStore_SecretUpdate_Call) Return(err error) *MockStore_SecretUpdate_Call {
+func (_c *MockStore_UpdateUser_Call) Return(err error) *MockStore_UpdateUser_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_SecretUpdate_Call) RunAndReturn(run func(secret *model.Secret) error) *MockStore_SecretUpdate_Call {
+func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ServerConfigDelete provides a mock function for the type MockStore
-func (_mock *MockStore) ServerConfigDelete(s string) error {
-	ret := _mock.Called(s)
+// UserFeed provides a mock function for the type MockStore
+func (_mock *MockStore) UserFeed(user *model.User) ([]*model.Feed, error) {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ServerConfigDelete")
+		panic("no return value specified for UserFeed")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(s)
+	var r0 []*model.Feed
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) ([]*model.Feed, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) []*model.Feed); ok {
+		r0 = returnFunc(user)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Feed)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_ServerConfigDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigDelete'
-type MockStore_ServerConfigDelete_Call struct {
+// MockStore_UserFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserFeed'
+type MockStore_UserFeed_Call struct {
 	*mock.Call
 }
 
-// ServerConfigDelete is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) ServerConfigDelete(s any) *MockStore_ServerConfigDelete_Call {
-	return &MockStore_ServerConfigDelete_Call{Call: _e.mock.On("ServerConfigDelete", s)}
+// UserFeed is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockStore_Expecter) UserFeed(user any) *MockStore_UserFeed_Call {
+	return &MockStore_UserFeed_Call{Call: _e.mock.On("UserFeed", user)}
 }
 
-func (_c *MockStore_ServerConfigDelete_Call) Run(run func(s string)) *MockStore_ServerConfigDelete_Call {
+func (_c *MockStore_UserFeed_Call) Run(run func(user *model.User)) *MockStore_UserFeed_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
@@ -5491,58 +9021,49 @@ func (_c *MockStore_ServerConfigDelete_Call) Run(run func(s string)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_ServerConfigDelete_Call) Return(err error) *MockStore_ServerConfigDelete_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_UserFeed_Call) Return(feeds []*model.Feed, err error) *MockStore_UserFeed_Call {
+	_c.Call.Return(feeds, err)
 	return _c
 }
 
-func (_c *MockStore_ServerConfigDelete_Call) RunAndReturn(run func(s string) error) *MockStore_ServerConfigDelete_Call {
+func (_c *MockStore_UserFeed_Call) RunAndReturn(run func(user *model.User) ([]*model.Feed, error)) *MockStore_UserFeed_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ServerConfigGet provides a mock function for the type MockStore
-func (_mock *MockStore) ServerConfigGet(s string) (string, error) {
-	ret := _mock.Called(s)
+// WebhookCreate provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookCreate(webhook *model.Webhook) error {
+	ret := _mock.Called(webhook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ServerConfigGet")
+		panic("no return value specified for WebhookCreate")
 	}
 
-	var r0 string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(s)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(s)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(s)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook) error); ok {
+		r0 = returnFunc(webhook)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_ServerConfigGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigGet'
-type MockStore_ServerConfigGet_Call struct {
+// MockStore_WebhookCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookCreate'
+type MockStore_WebhookCreate_Call struct {
 	*mock.Call
 }
 
-// ServerConfigGet is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) ServerConfigGet(s any) *MockStore_ServerConfigGet_Call {
-	return &MockStore_ServerConfigGet_Call{Call: _e.mock.On("ServerConfigGet", s)}
+// WebhookCreate is a helper method to define mock.On call
+//   - webhook *model.Webhook
+func (_e *MockStore_Expecter) WebhookCreate(webhook any) *MockStore_WebhookCreate_Call {
+	return &MockStore_WebhookCreate_Call{Call: _e.mock.On("WebhookCreate", webhook)}
 }
 
-func (_c *MockStore_ServerConfigGet_Call) Run(run func(s string)) *MockStore_ServerConfigGet_Call {
+func (_c *MockStore_WebhookCreate_Call) Run(run func(webhook *model.Webhook)) *MockStore_WebhookCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.Webhook
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.Webhook)
 		}
 		run(
 			arg0,
@@ -5551,157 +9072,166 @@ func (_c *MockStore_ServerConfigGet_Call) Run(run func(s string)) *MockStore_Ser
 	return _c
 }
 
-func (_c *MockStore_ServerConfigGet_Call) Return(s1 string, err error) *MockStore_ServerConfigGet_Call {
-	_c.Call.Return(s1, err)
+func (_c *MockStore_WebhookCreate_Call) Return(err error) *MockStore_WebhookCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_ServerConfigGet_Call) RunAndReturn(run func(s string) (string, error)) *MockStore_ServerConfigGet_Call {
+func (_c *MockStore_WebhookCreate_Call) RunAndReturn(run func(webhook *model.Webhook) error) *MockStore_WebhookCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ServerConfigSet provides a mock function for the type MockStore
-func (_mock *MockStore) ServerConfigSet(s string, s1 string) error {
-	ret := _mock.Called(s, s1)
+// WebhookDelete provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDelete(webhook *model.Webhook) error {
+	ret := _mock.Called(webhook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ServerConfigSet")
+		panic("no return value specified for WebhookDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = returnFunc(s, s1)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook) error); ok {
+		r0 = returnFunc(webhook)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_ServerConfigSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServerConfigSet'
-type MockStore_ServerConfigSet_Call struct {
+// MockStore_WebhookDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDelete'
+type MockStore_WebhookDelete_Call struct {
 	*mock.Call
 }
 
-// ServerConfigSet is a helper method to define mock.On call
-//   - s string
-//   - s1 string
-func (_e *MockStore_Expecter) ServerConfigSet(s any, s1 any) *MockStore_ServerConfigSet_Call {
-	return &MockStore_ServerConfigSet_Call{Call: _e.mock.On("ServerConfigSet", s, s1)}
+// WebhookDelete is a helper method to define mock.On call
+//   - webhook *model.Webhook
+func (_e *MockStore_Expecter) WebhookDelete(webhook any) *MockStore_WebhookDelete_Call {
+	return &MockStore_WebhookDelete_Call{Call: _e.mock.On("WebhookDelete", webhook)}
 }
 
-func (_c *MockStore_ServerConfigSet_Call) Run(run func(s string, s1 string)) *MockStore_ServerConfigSet_Call {
+func (_c *MockStore_WebhookDelete_Call) Run(run func(webhook *model.Webhook)) *MockStore_WebhookDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.Webhook
 		if args[0] != nil {
-			arg0 = args[0].(string)
-		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg0 = args[0].(*model.Webhook)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_ServerConfigSet_Call) Return(err error) *MockStore_ServerConfigSet_Call {
+func (_c *MockStore_WebhookDelete_Call) Return(err error) *MockStore_WebhookDelete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_ServerConfigSet_Call) RunAndReturn(run func(s string, s1 string) error) *MockStore_ServerConfigSet_Call {
+func (_c *MockStore_WebhookDelete_Call) RunAndReturn(run func(webhook *model.Webhook) error) *MockStore_WebhookDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StepByUUID provides a mock function for the type MockStore
-func (_mock *MockStore) StepByUUID(uuid string) (*model.Step, error) {
-	ret := _mock.Called(uuid)
+// WebhookDeliveryClaim provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryClaim(delivery *model.WebhookDelivery, until int64) (bool, error) {
+	ret := _mock.Called(delivery, until)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StepByUUID")
+		panic("no return value specified for WebhookDeliveryClaim")
 	}
 
-	var r0 *model.Step
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*model.Step, error)); ok {
-		return returnFunc(uuid)
+	if returnFunc, ok := ret.Get(0).(func(*model.WebhookDelivery, int64) (bool, error)); ok {
+		return returnFunc(delivery, until)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) *model.Step); ok {
-		r0 = returnFunc(uuid)
+	if returnFunc, ok := ret.Get(0).(func(*model.WebhookDelivery, int64) bool); ok {
+		r0 = returnFunc(delivery, until)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Step)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(uuid)
+	if returnFunc, ok := ret.Get(1).(func(*model.WebhookDelivery, int64) error); ok {
+		r1 = returnFunc(delivery, until)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_StepByUUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepByUUID'
-type MockStore_StepByUUID_Call struct {
+// MockStore_WebhookDeliveryClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryClaim'
+type MockStore_WebhookDeliveryClaim_Call struct {
 	*mock.Call
 }
 
-// StepByUUID is a helper method to define mock.On call
-//   - uuid string
-func (_e *MockStore_Expecter) StepByUUID(uuid any) *MockStore_StepByUUID_Call {
-	return &MockStore_StepByUUID_Call{Call: _e.mock.On("StepByUUID", uuid)}
+// WebhookDeliveryClaim is a helper method to define mock.On call
+//   - delivery *model.WebhookDelivery
+//   - until int64
+func (_e *MockStore_Expecter) WebhookDeliveryClaim(delivery any, until any) *MockStore_WebhookDeliveryClaim_Call {
+	return &MockStore_WebhookDeliveryClaim_Call{Call: _e.mock.On("WebhookDeliveryClaim", delivery, until)}
 }
 
-func (_c *MockStore_StepByUUID_Call) Run(run func(uuid string)) *MockStore_StepByUUID_Call {
+func (_c *MockStore_WebhookDeliveryClaim_Call) Run(run func(delivery *model.WebhookDelivery, until int64)) *MockStore_WebhookDeliveryClaim_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.WebhookDelivery
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.WebhookDelivery)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_StepByUUID_Call) Return(step *model.Step, err error) *MockStore_StepByUUID_Call {
-	_c.Call.Return(step, err)
+func (_c *MockStore_WebhookDeliveryClaim_Call) Return(b bool, err error) *MockStore_WebhookDeliveryClaim_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockStore_StepByUUID_Call) RunAndReturn(run func(uuid string) (*model.Step, error)) *MockStore_StepByUUID_Call {
+func (_c *MockStore_WebhookDeliveryClaim_Call) RunAndReturn(run func(delivery *model.WebhookDelivery, until int64) (bool, error)) *MockStore_WebhookDeliveryClaim_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StepFinished provides a mock function for the type MockStore
-func (_mock *MockStore) StepFinished(step *model.Step) {
-	_mock.Called(step)
-	return
+// WebhookDeliveryCreate provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryCreate(webhookDelivery *model.WebhookDelivery) error {
+	ret := _mock.Called(webhookDelivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeliveryCreate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.WebhookDelivery) error); ok {
+		r0 = returnFunc(webhookDelivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
 }
 
-// MockStore_StepFinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepFinished'
-type MockStore_StepFinished_Call struct {
+// MockStore_WebhookDeliveryCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryCreate'
+type MockStore_WebhookDeliveryCreate_Call struct {
 	*mock.Call
 }
 
-// StepFinished is a helper method to define mock.On call
-//   - step *model.Step
-func (_e *MockStore_Expecter) StepFinished(step any) *MockStore_StepFinished_Call {
-	return &MockStore_StepFinished_Call{Call: _e.mock.On("StepFinished", step)}
+// WebhookDeliveryCreate is a helper method to define mock.On call
+//   - webhookDelivery *model.WebhookDelivery
+func (_e *MockStore_Expecter) WebhookDeliveryCreate(webhookDelivery any) *MockStore_WebhookDeliveryCreate_Call {
+	return &MockStore_WebhookDeliveryCreate_Call{Call: _e.mock.On("WebhookDeliveryCreate", webhookDelivery)}
 }
 
-func (_c *MockStore_StepFinished_Call) Run(run func(step *model.Step)) *MockStore_StepFinished_Call {
+func (_c *MockStore_WebhookDeliveryCreate_Call) Run(run func(webhookDelivery *model.WebhookDelivery)) *MockStore_WebhookDeliveryCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Step
+		var arg0 *model.WebhookDelivery
 		if args[0] != nil {
-			arg0 = args[0].(*model.Step)
+			arg0 = args[0].(*model.WebhookDelivery)
 		}
 		run(
 			arg0,
@@ -5710,56 +9240,45 @@ func (_c *MockStore_StepFinished_Call) Run(run func(step *model.Step)) *MockStor
 	return _c
 }
 
-func (_c *MockStore_StepFinished_Call) Return() *MockStore_StepFinished_Call {
-	_c.Call.Return()
+func (_c *MockStore_WebhookDeliveryCreate_Call) Return(err error) *MockStore_WebhookDeliveryCreate_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_StepFinished_Call) RunAndReturn(run func(step *model.Step)) *MockStore_StepFinished_Call {
-	_c.Run(run)
+func (_c *MockStore_WebhookDeliveryCreate_Call) RunAndReturn(run func(webhookDelivery *model.WebhookDelivery) error) *MockStore_WebhookDeliveryCreate_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// StepList provides a mock function for the type MockStore
-func (_mock *MockStore) StepList(pipelineID int64) ([]*model.Step, error) {
-	ret := _mock.Called(pipelineID)
+// WebhookDeliveryDeleteBefore provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryDeleteBefore(n int64) error {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StepList")
+		panic("no return value specified for WebhookDeliveryDeleteBefore")
 	}
 
-	var r0 []*model.Step
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64) ([]*model.Step, error)); ok {
-		return returnFunc(pipelineID)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64) []*model.Step); ok {
-		r0 = returnFunc(pipelineID)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Step)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
-		r1 = returnFunc(pipelineID)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(n)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockStore_StepList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepList'
-type MockStore_StepList_Call struct {
+// MockStore_WebhookDeliveryDeleteBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryDeleteBefore'
+type MockStore_WebhookDeliveryDeleteBefore_Call struct {
 	*mock.Call
 }
 
-// StepList is a helper method to define mock.On call
-//   - pipelineID int64
-func (_e *MockStore_Expecter) StepList(pipelineID any) *MockStore_StepList_Call {
-	return &MockStore_StepList_Call{Call: _e.mock.On("StepList", pipelineID)}
+// WebhookDeliveryDeleteBefore is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) WebhookDeliveryDeleteBefore(n any) *MockStore_WebhookDeliveryDeleteBefore_Call {
+	return &MockStore_WebhookDeliveryDeleteBefore_Call{Call: _e.mock.On("WebhookDeliveryDeleteBefore", n)}
 }
 
-func (_c *MockStore_StepList_Call) Run(run func(pipelineID int64)) *MockStore_StepList_Call {
+func (_c *MockStore_WebhookDeliveryDeleteBefore_Call) Run(run func(n int64)) *MockStore_WebhookDeliveryDeleteBefore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
@@ -5772,127 +9291,133 @@ func (_c *MockStore_StepList_Call) Run(run func(pipelineID int64)) *MockStore_St
 	return _c
 }
 
-func (_c *MockStore_StepList_Call) Return(steps []*model.Step, err error) *MockStore_StepList_Call {
-	_c.Call.Return(steps, err)
+func (_c *MockStore_WebhookDeliveryDeleteBefore_Call) Return(err error) *MockStore_WebhookDeliveryDeleteBefore_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_StepList_Call) RunAndReturn(run func(pipelineID int64) ([]*model.Step, error)) *MockStore_StepList_Call {
+func (_c *MockStore_WebhookDeliveryDeleteBefore_Call) RunAndReturn(run func(n int64) error) *MockStore_WebhookDeliveryDeleteBefore_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StepListFromWorkflowFind provides a mock function for the type MockStore
-func (_mock *MockStore) StepListFromWorkflowFind(workflow *model.Workflow) ([]*model.Step, error) {
-	ret := _mock.Called(workflow)
+// WebhookDeliveryFind provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryFind(webhook *model.Webhook, n int64) (*model.WebhookDelivery, error) {
+	ret := _mock.Called(webhook, n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StepListFromWorkflowFind")
+		panic("no return value specified for WebhookDeliveryFind")
 	}
 
-	var r0 []*model.Step
+	var r0 *model.WebhookDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Workflow) ([]*model.Step, error)); ok {
-		return returnFunc(workflow)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook, int64) (*model.WebhookDelivery, error)); ok {
+		return returnFunc(webhook, n)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.Workflow) []*model.Step); ok {
-		r0 = returnFunc(workflow)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook, int64) *model.WebhookDelivery); ok {
+		r0 = returnFunc(webhook, n)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Step)
+			r0 = ret.Get(0).(*model.WebhookDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.Workflow) error); ok {
-		r1 = returnFunc(workflow)
+	if returnFunc, ok := ret.Get(1).(func(*model.Webhook, int64) error); ok {
+		r1 = returnFunc(webhook, n)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_StepListFromWorkflowFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepListFromWorkflowFind'
-type MockStore_StepListFromWorkflowFind_Call struct {
+// MockStore_WebhookDeliveryFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryFind'
+type MockStore_WebhookDeliveryFind_Call struct {
 	*mock.Call
 }
 
-// StepListFromWorkflowFind is a helper method to define mock.On call
-//   - workflow *model.Workflow
-func (_e *MockStore_Expecter) StepListFromWorkflowFind(workflow any) *MockStore_StepListFromWorkflowFind_Call {
-	return &MockStore_StepListFromWorkflowFind_Call{Call: _e.mock.On("StepListFromWorkflowFind", workflow)}
+// WebhookDeliveryFind is a helper method to define mock.On call
+//   - webhook *model.Webhook
+//   - n int64
+func (_e *MockStore_Expecter) WebhookDeliveryFind(webhook any, n any) *MockStore_WebhookDeliveryFind_Call {
+	return &MockStore_WebhookDeliveryFind_Call{Call: _e.mock.On("WebhookDeliveryFind", webhook, n)}
 }
 
-func (_c *MockStore_StepListFromWorkflowFind_Call) Run(run func(workflow *model.Workflow)) *MockStore_StepListFromWorkflowFind_Call {
+func (_c *MockStore_WebhookDeliveryFind_Call) Run(run func(webhook *model.Webhook, n int64)) *MockStore_WebhookDeliveryFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Workflow
+		var arg0 *model.Webhook
 		if args[0] != nil {
-			arg0 = args[0].(*model.Workflow)
+			arg0 = args[0].(*model.Webhook)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_StepListFromWorkflowFind_Call) Return(steps []*model.Step, err error) *MockStore_StepListFromWorkflowFind_Call {
-	_c.Call.Return(steps, err)
+func (_c *MockStore_WebhookDeliveryFind_Call) Return(webhookDelivery *model.WebhookDelivery, err error) *MockStore_WebhookDeliveryFind_Call {
+	_c.Call.Return(webhookDelivery, err)
 	return _c
 }
 
-func (_c *MockStore_StepListFromWorkflowFind_Call) RunAndReturn(run func(workflow *model.Workflow) ([]*model.Step, error)) *MockStore_StepListFromWorkflowFind_Call {
+func (_c *MockStore_WebhookDeliveryFind_Call) RunAndReturn(run func(webhook *model.Webhook, n int64) (*model.WebhookDelivery, error)) *MockStore_WebhookDeliveryFind_Call {
 	_c.Call.Return(run)
 	return _c
-}
-
-// StepLoad provides a mock function for the type MockStore
-func (_mock *MockStore) StepLoad(pipelineID int64, stepID int64) (*model.Step, error) {
-	ret := _mock.Called(pipelineID, stepID)
+}
+
+// WebhookDeliveryList provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryList(webhook *model.Webhook, listOptions *model.ListOptions) ([]*model.WebhookDelivery, error) {
+	ret := _mock.Called(webhook, listOptions)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StepLoad")
+		panic("no return value specified for WebhookDeliveryList")
 	}
 
-	var r0 *model.Step
+	var r0 []*model.WebhookDelivery
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*model.Step, error)); ok {
-		return returnFunc(pipelineID, stepID)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook, *model.ListOptions) ([]*model.WebhookDelivery, error)); ok {
+		return returnFunc(webhook, listOptions)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) *model.Step); ok {
-		r0 = returnFunc(pipelineID, stepID)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook, *model.ListOptions) []*model.WebhookDelivery); ok {
+		r0 = returnFunc(webhook, listOptions)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Step)
+			r0 = ret.Get(0).([]*model.WebhookDelivery)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
-		r1 = returnFunc(pipelineID, stepID)
+	if returnFunc, ok := ret.Get(1).(func(*model.Webhook, *model.ListOptions) error); ok {
+		r1 = returnFunc(webhook, listOptions)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_StepLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepLoad'
-type MockStore_StepLoad_Call struct {
+// MockStore_WebhookDeliveryList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryList'
+type MockStore_WebhookDeliveryList_Call struct {
 	*mock.Call
 }
 
-// StepLoad is a helper method to define mock.On call
-//   - pipelineID int64
-//   - stepID int64
-func (_e *MockStore_Expecter) StepLoad(pipelineID any, stepID any) *MockStore_StepLoad_Call {
-	return &MockStore_StepLoad_Call{Call: _e.mock.On("StepLoad", pipelineID, stepID)}
+// WebhookDeliveryList is a helper method to define mock.On call
+//   - webhook *model.Webhook
+//   - listOptions *model.ListOptions
+func (_e *MockStore_Expecter) WebhookDeliveryList(webhook any, listOptions any) *MockStore_WebhookDeliveryList_Call {
+	return &MockStore_WebhookDeliveryList_Call{Call: _e.mock.On("WebhookDeliveryList", webhook, listOptions)}
 }
 
-func (_c *MockStore_StepLoad_Call) Run(run func(pipelineID int64, stepID int64)) *MockStore_StepLoad_Call {
+func (_c *MockStore_WebhookDeliveryList_Call) Run(run func(webhook *model.Webhook, listOptions *model.ListOptions)) *MockStore_WebhookDeliveryList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 *model.Webhook
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(*model.Webhook)
 		}
-		var arg1 int64
+		var arg1 *model.ListOptions
 		if args[1] != nil {
-			arg1 = args[1].(int64)
+			arg1 = args[1].(*model.ListOptions)
 		}
 		run(
 			arg0,
@@ -5902,100 +9427,117 @@ func (_c *MockStore_StepLoad_Call) Run(run func(pipelineID int64, stepID int64))
 	return _c
 }
 
-func (_c *MockStore_StepLoad_Call) Return(step *model.Step, err error) *MockStore_StepLoad_Call {
-	_c.Call.Return(step, err)
+func (_c *MockStore_WebhookDeliveryList_Call) Return(webhookDeliverys []*model.WebhookDelivery, err error) *MockStore_WebhookDeliveryList_Call {
+	_c.Call.Return(webhookDeliverys, err)
 	return _c
 }
 
-func (_c *MockStore_StepLoad_Call) RunAndReturn(run func(pipelineID int64, stepID int64) (*model.Step, error)) *MockStore_StepLoad_Call {
+func (_c *MockStore_WebhookDeliveryList_Call) RunAndReturn(run func(webhook *model.Webhook, listOptions *model.ListOptions) ([]*model.WebhookDelivery, error)) *MockStore_WebhookDeliveryList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StepUpdate provides a mock function for the type MockStore
-func (_mock *MockStore) StepUpdate(step *model.Step) error {
-	ret := _mock.Called(step)
+// WebhookDeliveryListDue provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryListDue(now int64, limit int) ([]*model.WebhookDelivery, error) {
+	ret := _mock.Called(now, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StepUpdate")
+		panic("no return value specified for WebhookDeliveryListDue")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Step) error); ok {
-		r0 = returnFunc(step)
+	var r0 []*model.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int) ([]*model.WebhookDelivery, error)); ok {
+		return returnFunc(now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int) []*model.WebhookDelivery); ok {
+		r0 = returnFunc(now, limit)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WebhookDelivery)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = returnFunc(now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_StepUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StepUpdate'
-type MockStore_StepUpdate_Call struct {
+// MockStore_WebhookDeliveryListDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryListDue'
+type MockStore_WebhookDeliveryListDue_Call struct {
 	*mock.Call
 }
 
-// StepUpdate is a helper method to define mock.On call
-//   - step *model.Step
-func (_e *MockStore_Expecter) StepUpdate(step any) *MockStore_StepUpdate_Call {
-	return &MockStore_StepUpdate_Call{Call: _e.mock.On("StepUpdate", step)}
+// WebhookDeliveryListDue is a helper method to define mock.On call
+//   - now int64
+//   - limit int
+func (_e *MockStore_Expecter) WebhookDeliveryListDue(now any, limit any) *MockStore_WebhookDeliveryListDue_Call {
+	return &MockStore_WebhookDeliveryListDue_Call{Call: _e.mock.On("WebhookDeliveryListDue", now, limit)}
 }
 
-func (_c *MockStore_StepUpdate_Call) Run(run func(step *model.Step)) *MockStore_StepUpdate_Call {
+func (_c *MockStore_WebhookDeliveryListDue_Call) Run(run func(now int64, limit int)) *MockStore_WebhookDeliveryListDue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Step
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Step)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_StepUpdate_Call) Return(err error) *MockStore_StepUpdate_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_WebhookDeliveryListDue_Call) Return(webhookDeliverys []*model.WebhookDelivery, err error) *MockStore_WebhookDeliveryListDue_Call {
+	_c.Call.Return(webhookDeliverys, err)
 	return _c
 }
 
-func (_c *MockStore_StepUpdate_Call) RunAndReturn(run func(step *model.Step) error) *MockStore_StepUpdate_Call {
+func (_c *MockStore_WebhookDeliveryListDue_Call) RunAndReturn(run func(now int64, limit int) ([]*model.WebhookDelivery, error)) *MockStore_WebhookDeliveryListDue_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TaskDelete provides a mock function for the type MockStore
-func (_mock *MockStore) TaskDelete(s string) error {
-	ret := _mock.Called(s)
+// WebhookDeliveryUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookDeliveryUpdate(webhookDelivery *model.WebhookDelivery) error {
+	ret := _mock.Called(webhookDelivery)
 
 	if len(ret) == 0 {
-		panic("no return value specified for TaskDelete")
+		panic("no return value specified for WebhookDeliveryUpdate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
-		r0 = returnFunc(s)
+	if returnFunc, ok := ret.Get(0).(func(*model.WebhookDelivery) error); ok {
+		r0 = returnFunc(webhookDelivery)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_TaskDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskDelete'
-type MockStore_TaskDelete_Call struct {
+// MockStore_WebhookDeliveryUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryUpdate'
+type MockStore_WebhookDeliveryUpdate_Call struct {
 	*mock.Call
 }
 
-// TaskDelete is a helper method to define mock.On call
-//   - s string
-func (_e *MockStore_Expecter) TaskDelete(s any) *MockStore_TaskDelete_Call {
-	return &MockStore_TaskDelete_Call{Call: _e.mock.On("TaskDelete", s)}
+// WebhookDeliveryUpdate is a helper method to define mock.On call
+//   - webhookDelivery *model.WebhookDelivery
+func (_e *MockStore_Expecter) WebhookDeliveryUpdate(webhookDelivery any) *MockStore_WebhookDeliveryUpdate_Call {
+	return &MockStore_WebhookDeliveryUpdate_Call{Call: _e.mock.On("WebhookDeliveryUpdate", webhookDelivery)}
 }
 
-func (_c *MockStore_TaskDelete_Call) Run(run func(s string)) *MockStore_TaskDelete_Call {
+func (_c *MockStore_WebhookDeliveryUpdate_Call) Run(run func(webhookDelivery *model.WebhookDelivery)) *MockStore_WebhookDeliveryUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.WebhookDelivery
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(*model.WebhookDelivery)
 		}
 		run(
 			arg0,
@@ -6004,49 +9546,60 @@ func (_c *MockStore_TaskDelete_Call) Run(run func(s string)) *MockStore_TaskDele
 	return _c
 }
 
-func (_c *MockStore_TaskDelete_Call) Return(err error) *MockStore_TaskDelete_Call {
+func (_c *MockStore_WebhookDeliveryUpdate_Call) Return(err error) *MockStore_WebhookDeliveryUpdate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_TaskDelete_Call) RunAndReturn(run func(s string) error) *MockStore_TaskDelete_Call {
+func (_c *MockStore_WebhookDeliveryUpdate_Call) RunAndReturn(run func(webhookDelivery *model.WebhookDelivery) error) *MockStore_WebhookDeliveryUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TaskInsert provides a mock function for the type MockStore
-func (_mock *MockStore) TaskInsert(task *model.Task) error {
-	ret := _mock.Called(task)
+// WebhookFind provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookFind(n int64) (*model.Webhook, error) {
+	ret := _mock.Called(n)
 
 	if len(ret) == 0 {
-		panic("no return value specified for TaskInsert")
+		panic("no return value specified for WebhookFind")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Task) error); ok {
-		r0 = returnFunc(task)
+	var r0 *model.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*model.Webhook, error)); ok {
+		return returnFunc(n)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *model.Webhook); ok {
+		r0 = returnFunc(n)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Webhook)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_TaskInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskInsert'
-type MockStore_TaskInsert_Call struct {
+// MockStore_WebhookFind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookFind'
+type MockStore_WebhookFind_Call struct {
 	*mock.Call
 }
 
-// TaskInsert is a helper method to define mock.On call
-//   - task *model.Task
-func (_e *MockStore_Expecter) TaskInsert(task any) *MockStore_TaskInsert_Call {
-	return &MockStore_TaskInsert_Call{Call: _e.mock.On("TaskInsert", task)}
+// WebhookFind is a helper method to define mock.On call
+//   - n int64
+func (_e *MockStore_Expecter) WebhookFind(n any) *MockStore_WebhookFind_Call {
+	return &MockStore_WebhookFind_Call{Call: _e.mock.On("WebhookFind", n)}
 }
 
-func (_c *MockStore_TaskInsert_Call) Run(run func(task *model.Task)) *MockStore_TaskInsert_Call {
+func (_c *MockStore_WebhookFind_Call) Run(run func(n int64)) *MockStore_WebhookFind_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Task
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Task)
+			arg0 = args[0].(int64)
 		}
 		run(
 			arg0,
@@ -6055,155 +9608,191 @@ func (_c *MockStore_TaskInsert_Call) Run(run func(task *model.Task)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_TaskInsert_Call) Return(err error) *MockStore_TaskInsert_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_WebhookFind_Call) Return(webhook *model.Webhook, err error) *MockStore_WebhookFind_Call {
+	_c.Call.Return(webhook, err)
 	return _c
 }
 
-func (_c *MockStore_TaskInsert_Call) RunAndReturn(run func(task *model.Task) error) *MockStore_TaskInsert_Call {
+func (_c *MockStore_WebhookFind_Call) RunAndReturn(run func(n int64) (*model.Webhook, error)) *MockStore_WebhookFind_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// TaskList provides a mock function for the type MockStore
-func (_mock *MockStore) TaskList() ([]*model.Task, error) {
-	ret := _mock.Called()
+// WebhookList provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookList(orgID int64, repoID int64, p *model.ListOptions) ([]*model.Webhook, error) {
+	ret := _mock.Called(orgID, repoID, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for TaskList")
+		panic("no return value specified for WebhookList")
 	}
 
-	var r0 []*model.Task
+	var r0 []*model.Webhook
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*model.Task, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, *model.ListOptions) ([]*model.Webhook, error)); ok {
+		return returnFunc(orgID, repoID, p)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []*model.Task); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, *model.ListOptions) []*model.Webhook); ok {
+		r0 = returnFunc(orgID, repoID, p)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Task)
+			r0 = ret.Get(0).([]*model.Webhook)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(int64, int64, *model.ListOptions) error); ok {
+		r1 = returnFunc(orgID, repoID, p)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_TaskList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TaskList'
-type MockStore_TaskList_Call struct {
+// MockStore_WebhookList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookList'
+type MockStore_WebhookList_Call struct {
 	*mock.Call
 }
 
-// TaskList is a helper method to define mock.On call
-func (_e *MockStore_Expecter) TaskList() *MockStore_TaskList_Call {
-	return &MockStore_TaskList_Call{Call: _e.mock.On("TaskList")}
+// WebhookList is a helper method to define mock.On call
+//   - orgID int64
+//   - repoID int64
+//   - p *model.ListOptions
+func (_e *MockStore_Expecter) WebhookList(orgID any, repoID any, p any) *MockStore_WebhookList_Call {
+	return &MockStore_WebhookList_Call{Call: _e.mock.On("WebhookList", orgID, repoID, p)}
 }
 
-func (_c *MockStore_TaskList_Call) Run(run func()) *MockStore_TaskList_Call {
+func (_c *MockStore_WebhookList_Call) Run(run func(orgID int64, repoID int64, p *model.ListOptions)) *MockStore_WebhookList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 *model.ListOptions
+		if args[2] != nil {
+			arg2 = args[2].(*model.ListOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
 	})
 	return _c
 }
 
-func (_c *MockStore_TaskList_Call) Return(tasks []*model.Task, err error) *MockStore_TaskList_Call {
-	_c.Call.Return(tasks, err)
+func (_c *MockStore_WebhookList_Call) Return(webhooks []*model.Webhook, err error) *MockStore_WebhookList_Call {
+	_c.Call.Return(webhooks, err)
 	return _c
 }
 
-func (_c *MockStore_TaskList_Call) RunAndReturn(run func() ([]*model.Task, error)) *MockStore_TaskList_Call {
+func (_c *MockStore_WebhookList_Call) RunAndReturn(run func(orgID int64, repoID int64, p *model.ListOptions) ([]*model.Webhook, error)) *MockStore_WebhookList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePipeline provides a mock function for the type MockStore
-func (_mock *MockStore) UpdatePipeline(pipeline *model.Pipeline) error {
-	ret := _mock.Called(pipeline)
+// WebhookListEnabled provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookListEnabled(orgID int64, repoID int64) ([]*model.Webhook, error) {
+	ret := _mock.Called(orgID, repoID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePipeline")
+		panic("no return value specified for WebhookListEnabled")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) error); ok {
-		r0 = returnFunc(pipeline)
+	var r0 []*model.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*model.Webhook, error)); ok {
+		return returnFunc(orgID, repoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*model.Webhook); ok {
+		r0 = returnFunc(orgID, repoID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(orgID, repoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockStore_UpdatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipeline'
-type MockStore_UpdatePipeline_Call struct {
+// MockStore_WebhookListEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookListEnabled'
+type MockStore_WebhookListEnabled_Call struct {
 	*mock.Call
 }
 
-// UpdatePipeline is a helper method to define mock.On call
-//   - pipeline *model.Pipeline
-func (_e *MockStore_Expecter) UpdatePipeline(pipeline any) *MockStore_UpdatePipeline_Call {
-	return &MockStore_UpdatePipeline_Call{Call: _e.mock.On("UpdatePipeline", pipeline)}
+// WebhookListEnabled is a helper method to define mock.On call
+//   - orgID int64
+//   - repoID int64
+func (_e *MockStore_Expecter) WebhookListEnabled(orgID any, repoID any) *MockStore_WebhookListEnabled_Call {
+	return &MockStore_WebhookListEnabled_Call{Call: _e.mock.On("WebhookListEnabled", orgID, repoID)}
 }
 
-func (_c *MockStore_UpdatePipeline_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_UpdatePipeline_Call {
+func (_c *MockStore_WebhookListEnabled_Call) Run(run func(orgID int64, repoID int64)) *MockStore_WebhookListEnabled_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Pipeline
+		var arg0 int64
 		if args[0] != nil {
-			arg0 = args[0].(*model.Pipeline)
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockStore_UpdatePipeline_Call) Return(err error) *MockStore_UpdatePipeline_Call {
-	_c.Call.Return(err)
+func (_c *MockStore_WebhookListEnabled_Call) Return(webhooks []*model.Webhook, err error) *MockStore_WebhookListEnabled_Call {
+	_c.Call.Return(webhooks, err)
 	return _c
 }
 
-func (_c *MockStore_UpdatePipeline_Call) RunAndReturn(run func(pipeline *model.Pipeline) error) *MockStore_UpdatePipeline_Call {
+func (_c *MockStore_WebhookListEnabled_Call) RunAndReturn(run func(orgID int64, repoID int64) ([]*model.Webhook, error)) *MockStore_WebhookListEnabled_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRepo provides a mock function for the type MockStore
-func (_mock *MockStore) UpdateRepo(repo *model.Repo) error {
-	ret := _mock.Called(repo)
+// WebhookUpdate provides a mock function for the type MockStore
+func (_mock *MockStore) WebhookUpdate(webhook *model.Webhook) error {
+	ret := _mock.Called(webhook)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRepo")
+		panic("no return value specified for WebhookUpdate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Repo) error); ok {
-		r0 = returnFunc(repo)
+	if returnFunc, ok := ret.Get(0).(func(*model.Webhook) error); ok {
+		r0 = returnFunc(webhook)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_UpdateRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRepo'
-type MockStore_UpdateRepo_Call struct {
+// MockStore_WebhookUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookUpdate'
+type MockStore_WebhookUpdate_Call struct {
 	*mock.Call
 }
 
-// UpdateRepo is a helper method to define mock.On call
-//   - repo *model.Repo
-func (_e *MockStore_Expecter) UpdateRepo(repo any) *MockStore_UpdateRepo_Call {
-	return &MockStore_UpdateRepo_Call{Call: _e.mock.On("UpdateRepo", repo)}
+// WebhookUpdate is a helper method to define mock.On call
+//   - webhook *model.Webhook
+func (_e *MockStore_Expecter) WebhookUpdate(webhook any) *MockStore_WebhookUpdate_Call {
+	return &MockStore_WebhookUpdate_Call{Call: _e.mock.On("WebhookUpdate", webhook)}
 }
 
-func (_c *MockStore_UpdateRepo_Call) Run(run func(repo *model.Repo)) *MockStore_UpdateRepo_Call {
+func (_c *MockStore_WebhookUpdate_Call) Run(run func(webhook *model.Webhook)) *MockStore_WebhookUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Repo
+		var arg0 *model.Webhook
 		if args[0] != nil {
-			arg0 = args[0].(*model.Repo)
+			arg0 = args[0].(*model.Webhook)
 		}
 		run(
 			arg0,
@@ -6212,49 +9801,49 @@ func (_c *MockStore_UpdateRepo_Call) Run(run func(repo *model.Repo)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_UpdateRepo_Call) Return(err error) *MockStore_UpdateRepo_Call {
+func (_c *MockStore_WebhookUpdate_Call) Return(err error) *MockStore_WebhookUpdate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_UpdateRepo_Call) RunAndReturn(run func(repo *model.Repo) error) *MockStore_UpdateRepo_Call {
+func (_c *MockStore_WebhookUpdate_Call) RunAndReturn(run func(webhook *model.Webhook) error) *MockStore_WebhookUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function for the type MockStore
-func (_mock *MockStore) UpdateUser(user *model.User) error {
-	ret := _mock.Called(user)
+// WorkflowAttemptCreate provides a mock function for the type MockStore
+func (_mock *MockStore) WorkflowAttemptCreate(workflowAttempt *model.WorkflowAttempt) error {
+	ret := _mock.Called(workflowAttempt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for WorkflowAttemptCreate")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) error); ok {
-		r0 = returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.WorkflowAttempt) error); ok {
+		r0 = returnFunc(workflowAttempt)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockStore_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type MockStore_UpdateUser_Call struct {
+// MockStore_WorkflowAttemptCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowAttemptCreate'
+type MockStore_WorkflowAttemptCreate_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockStore_Expecter) UpdateUser(user any) *MockStore_UpdateUser_Call {
-	return &MockStore_UpdateUser_Call{Call: _e.mock.On("UpdateUser", user)}
+// WorkflowAttemptCreate is a helper method to define mock.On call
+//   - workflowAttempt *model.WorkflowAttempt
+func (_e *MockStore_Expecter) WorkflowAttemptCreate(workflowAttempt any) *MockStore_WorkflowAttemptCreate_Call {
+	return &MockStore_WorkflowAttemptCreate_Call{Call: _e.mock.On("WorkflowAttemptCreate", workflowAttempt)}
 }
 
-func (_c *MockStore_UpdateUser_Call) Run(run func(user *model.User)) *MockStore_UpdateUser_Call {
+func (_c *MockStore_WorkflowAttemptCreate_Call) Run(run func(workflowAttempt *model.WorkflowAttempt)) *MockStore_WorkflowAttemptCreate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.WorkflowAttempt
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(*model.WorkflowAttempt)
 		}
 		run(
 			arg0,
@@ -6263,60 +9852,60 @@ func (_c *MockStore_UpdateUser_Call) Run(run func(user *model.User)) *MockStore_
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) Return(err error) *MockStore_UpdateUser_Call {
+func (_c *MockStore_WorkflowAttemptCreate_Call) Return(err error) *MockStore_WorkflowAttemptCreate_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockStore_UpdateUser_Call) RunAndReturn(run func(user *model.User) error) *MockStore_UpdateUser_Call {
+func (_c *MockStore_WorkflowAttemptCreate_Call) RunAndReturn(run func(workflowAttempt *model.WorkflowAttempt) error) *MockStore_WorkflowAttemptCreate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UserFeed provides a mock function for the type MockStore
-func (_mock *MockStore) UserFeed(user *model.User) ([]*model.Feed, error) {
-	ret := _mock.Called(user)
+// WorkflowAttemptList provides a mock function for the type MockStore
+func (_mock *MockStore) WorkflowAttemptList(pipeline *model.Pipeline) ([]*model.WorkflowAttempt, error) {
+	ret := _mock.Called(pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UserFeed")
+		panic("no return value specified for WorkflowAttemptList")
 	}
 
-	var r0 []*model.Feed
+	var r0 []*model.WorkflowAttempt
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(*model.User) ([]*model.Feed, error)); ok {
-		return returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) ([]*model.WorkflowAttempt, error)); ok {
+		return returnFunc(pipeline)
 	}
-	if returnFunc, ok := ret.Get(0).(func(*model.User) []*model.Feed); ok {
-		r0 = returnFunc(user)
+	if returnFunc, ok := ret.Get(0).(func(*model.Pipeline) []*model.WorkflowAttempt); ok {
+		r0 = returnFunc(pipeline)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Feed)
+			r0 = ret.Get(0).([]*model.WorkflowAttempt)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
-		r1 = returnFunc(user)
+	if returnFunc, ok := ret.Get(1).(func(*model.Pipeline) error); ok {
+		r1 = returnFunc(pipeline)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockStore_UserFeed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserFeed'
-type MockStore_UserFeed_Call struct {
+// MockStore_WorkflowAttemptList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowAttemptList'
+type MockStore_WorkflowAttemptList_Call struct {
 	*mock.Call
 }
 
-// UserFeed is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockStore_Expecter) UserFeed(user any) *MockStore_UserFeed_Call {
-	return &MockStore_UserFeed_Call{Call: _e.mock.On("UserFeed", user)}
+// WorkflowAttemptList is a helper method to define mock.On call
+//   - pipeline *model.Pipeline
+func (_e *MockStore_Expecter) WorkflowAttemptList(pipeline any) *MockStore_WorkflowAttemptList_Call {
+	return &MockStore_WorkflowAttemptList_Call{Call: _e.mock.On("WorkflowAttemptList", pipeline)}
 }
 
-func (_c *MockStore_UserFeed_Call) Run(run func(user *model.User)) *MockStore_UserFeed_Call {
+func (_c *MockStore_WorkflowAttemptList_Call) Run(run func(pipeline *model.Pipeline)) *MockStore_WorkflowAttemptList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.User
+		var arg0 *model.Pipeline
 		if args[0] != nil {
-			arg0 = args[0].(*model.User)
+			arg0 = args[0].(*model.Pipeline)
 		}
 		run(
 			arg0,
@@ -6325,12 +9914,12 @@ func (_c *MockStore_UserFeed_Call) Run(run func(user *model.User)) *MockStore_Us
 	return _c
 }
 
-func (_c *MockStore_UserFeed_Call) Return(feeds []*model.Feed, err error) *MockStore_UserFeed_Call {
-	_c.Call.Return(feeds, err)
+func (_c *MockStore_WorkflowAttemptList_Call) Return(attempts []*model.WorkflowAttempt, err error) *MockStore_WorkflowAttemptList_Call {
+	_c.Call.Return(attempts, err)
 	return _c
 }
 
-func (_c *MockStore_UserFeed_Call) RunAndReturn(run func(user *model.User) ([]*model.Feed, error)) *MockStore_UserFeed_Call {
+func (_c *MockStore_WorkflowAttemptList_Call) RunAndReturn(run func(pipeline *model.Pipeline) ([]*model.WorkflowAttempt, error)) *MockStore_WorkflowAttemptList_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -17,6 +17,7 @@ package store
 import (
 	"context"
 
+	"go.woodpecker-ci.org/woodpecker/v3/pipeline/errors"
 	"go.woodpecker-ci.org/woodpecker/v3/server/model"
 )
 
@@ -82,16 +83,24 @@ type Store interface {
 	GetRepoLatestPipelines([]int64) ([]*model.Pipeline, error)
 	// GetActivePipelineList gets a list of the active pipelines for the repository
 	GetActivePipelineList(repo *model.Repo) ([]*model.Pipeline, error)
+	// GetPipelineListLogsExpired returns a limited number of pipelines of a
+	// repo that finished before the given unix timestamp and still have logs.
+	GetPipelineListLogsExpired(repo *model.Repo, before int64, offset, limit int) ([]*model.Pipeline, error)
 	// GetPipelineQueue gets a list of pipelines in queue.
 	GetPipelineQueue() ([]*model.Feed, error)
 	// GetPipelineCount gets a count of all pipelines in the system.
 	GetPipelineCount() (int64, error)
 	// CreatePipeline creates a new pipeline and steps.
 	CreatePipeline(*model.Pipeline, ...*model.Step) error
-	// UpdatePipeline updates a pipeline.
+	// UpdatePipeline updates a pipeline, except for its errors.
 	UpdatePipeline(*model.Pipeline) error
+	// UpdatePipelineErrors replaces the errors of a pipeline.
+	UpdatePipelineErrors(*model.Pipeline) error
 	// DeletePipeline deletes a pipeline.
 	DeletePipeline(*model.Pipeline) error
+	// AppendPipelineErrors adds errors to a pipeline, keeping the ones added
+	// concurrently, and returns the updated pipeline.
+	AppendPipelineErrors(pipelineID int64, errs []*errors.PipelineError) (*model.Pipeline, error)
 
 	// Feeds
 	UserFeed(*model.User) ([]*model.Feed, error)
@@ -141,18 +150,58 @@ type Store interface {
 	StepList(pipelineID int64) ([]*model.Step, error)
 	StepUpdate(*model.Step) error
 	StepListFromWorkflowFind(*model.Workflow) ([]*model.Step, error)
+	// StepListFinished returns a limited number of finished steps with an id
+	// greater than afterID, ordered by id.
+	StepListFinished(afterID int64, limit int) ([]*model.Step, error)
 
 	// Logs
 	LogFind(*model.Step) ([]*model.LogEntry, error)
 	LogAppend(*model.Step, []*model.LogEntry) error
 	LogDelete(*model.Step) error
 	StepFinished(*model.Step)
+	// LogAttemptCreate stores the logs a step wrote during a previous attempt
+	// of its workflow.
+	LogAttemptCreate(step *model.Step, attempt int, logEntries []*model.LogEntry) error
+	// LogAttemptFind returns the logs a step wrote during a previous attempt
+	// of its workflow.
+	LogAttemptFind(step *model.Step, attempt int) ([]*model.LogEntry, error)
+	// LogAttemptDelete removes the logs of all previous attempts of a step.
+	LogAttemptDelete(*model.Step) error
 
 	// Tasks
 	// TaskList TODO: paginate & opt filter
 	TaskList() ([]*model.Task, error)
 	TaskInsert(*model.Task) error
+	// TaskInsertBatch inserts all given tasks or none of them.
+	TaskInsertBatch([]*model.Task) error
 	TaskDelete(string) error
+	TaskLoad(string) (*model.Task, error)
+	// TaskClaim hands a pending task to task.AgentID until task.Deadline,
+	// it returns false if the task is not pending anymore.
+	TaskClaim(*model.Task) (bool, error)
+	// TaskExtend moves the deadline of a task running on task.AgentID.
+	TaskExtend(*model.Task) error
+	// TaskCancel marks a running task as canceled until task.Deadline.
+	TaskCancel(*model.Task) error
+	// TaskRetry puts a running task back to pending for task.Attempt, to be
+	// handed out after task.NotBefore.
+	TaskRetry(*model.Task) error
+	// TaskResubmitExpired puts running tasks with a deadline before the given
+	// unix milliseconds back to pending and removes expired canceled tasks.
+	TaskResubmitExpired(int64) error
+	// TaskUpdateDepStatus sets the status of the given task in the
+	// dependency status of all tasks depending on it.
+	TaskUpdateDepStatus(string, model.StatusValue) error
+
+	// PubSub
+	PubSubMessageCreate(*model.PubSubMessage) error
+	// PubSubMessageList returns the messages with an id greater than the given one.
+	PubSubMessageList(int64) ([]*model.PubSubMessage, error)
+	// PubSubMessageDeleteBefore removes messages created before the given unix time.
+	PubSubMessageDeleteBefore(int64) error
+	// PubSubListen signals new messages if the database supports notifications,
+	// otherwise the returned channel is nil.
+	PubSubListen(context.Context) (<-chan struct{}, error)
 
 	// ServerConfig
 	ServerConfigGet(string) (string, error)
@@ -181,6 +230,10 @@ type Store interface {
 	AgentFindByToken(string) (*model.Agent, error)
 	AgentList(p *model.ListOptionsWithAll) ([]*model.Agent, error)
 	AgentUpdate(*model.Agent) error
+	// AgentClaimOffline marks the agent as reported offline at its last
+	// contact. It returns false if the agent was reported already or was in
+	// contact again meanwhile.
+	AgentClaimOffline(*model.Agent) (bool, error)
 	AgentDelete(*model.Agent) error
 	AgentListForOrg(orgID int64, opt *model.ListOptionsWithAll) ([]*model.Agent, error)
 
@@ -191,6 +244,89 @@ type Store interface {
 	WorkflowLoad(int64) (*model.Workflow, error)
 	WorkflowByStep(*model.Step) (*model.Workflow, error)
 	WorkflowUpdate(*model.Workflow) error
+	// WorkflowAttemptCreate records a finished attempt of a workflow.
+	WorkflowAttemptCreate(*model.WorkflowAttempt) error
+	// WorkflowAttemptList returns the finished attempts of all workflows of a pipeline.
+	WorkflowAttemptList(*model.Pipeline) ([]*model.WorkflowAttempt, error)
+
+	// Artifact
+	ArtifactCreate(*model.Artifact) error
+	ArtifactFind(*model.Pipeline, int64) (*model.Artifact, error)
+	ArtifactList(*model.Pipeline) ([]*model.Artifact, error)
+	// ArtifactListExpired returns a limited number of artifacts that expired
+	// before the given unix timestamp.
+	ArtifactListExpired(before, limit int64) ([]*model.Artifact, error)
+	ArtifactDelete(*model.Artifact) error
+
+	// TestResult
+	// TestResultReplace replaces the test results of a step with the given ones.
+	TestResultReplace(*model.Step, []*model.TestResult) error
+	TestResultList(*model.Pipeline) ([]*model.TestResult, error)
+	// TestResultHistory returns the test results of the latest limit pipelines
+	// of a repo before the given pipeline number.
+	TestResultHistory(repo *model.Repo, before int64, limit int) ([]*model.TestResult, error)
+
+	// RetentionPolicy
+	// RetentionPolicyFind returns the policy of a repo, of an org if repoID
+	// is 0 or the global one if both are 0.
+	RetentionPolicyFind(orgID, repoID int64) (*model.RetentionPolicy, error)
+	RetentionPolicyList() ([]*model.RetentionPolicy, error)
+	RetentionPolicyCreate(*model.RetentionPolicy) error
+	RetentionPolicyUpdate(*model.RetentionPolicy) error
+	RetentionPolicyDelete(*model.RetentionPolicy) error
+
+	// Webhook
+	WebhookFind(int64) (*model.Webhook, error)
+	// WebhookList returns the webhooks of a repo, of an org if repoID is 0
+	// or the global ones if both are 0.
+	WebhookList(orgID, repoID int64, p *model.ListOptions) ([]*model.Webhook, error)
+	// WebhookListEnabled returns the enabled global webhooks and the enabled
+	// webhooks of the given org and repo.
+	WebhookListEnabled(orgID, repoID int64) ([]*model.Webhook, error)
+	WebhookCreate(*model.Webhook) error
+	WebhookUpdate(*model.Webhook) error
+	// WebhookDelete deletes the webhook together with its deliveries.
+	WebhookDelete(*model.Webhook) error
+
+	// WebhookDelivery
+	WebhookDeliveryFind(*model.Webhook, int64) (*model.WebhookDelivery, error)
+	WebhookDeliveryList(*model.Webhook, *model.ListOptions) ([]*model.WebhookDelivery, error)
+	// WebhookDeliveryListDue returns up to limit pending deliveries with their
+	// next attempt due at the given unix time.
+	WebhookDeliveryListDue(now int64, limit int) ([]*model.WebhookDelivery, error)
+	WebhookDeliveryCreate(*model.WebhookDelivery) error
+	// WebhookDeliveryClaim moves the next attempt of a pending delivery to
+	// until, so other servers do not send it meanwhile. It returns false if
+	// the delivery was claimed or sent already.
+	WebhookDeliveryClaim(delivery *model.WebhookDelivery, until int64) (bool, error)
+	WebhookDeliveryUpdate(*model.WebhookDelivery) error
+	// WebhookDeliveryDeleteBefore deletes the sent deliveries created before
+	// the given unix time.
+	WebhookDeliveryDeleteBefore(int64) error
+
+	// RepoPoll
+	// RepoPollListDue returns up to limit active repos with an ID above afterID
+	// and polling enabled that were never polled or whose next poll is due at
+	// the given unix time, ordered by ID.
+	RepoPollListDue(now, afterID int64, limit int) ([]*model.Repo, error)
+	RepoPollFind(repoID int64) (*model.RepoPoll, error)
+	RepoPollCreate(*model.RepoPoll) error
+	// RepoPollClaim moves the next poll of a repo to until, so other servers
+	// do not poll it meanwhile. It returns false if it was claimed already.
+	RepoPollClaim(poll *model.RepoPoll, until int64) (bool, error)
+	RepoPollUpdate(*model.RepoPoll) error
+	RepoPollDelete(repoID int64) error
+
+	// AuditLog
+	AuditLogCreate(*model.AuditLog) error
+	AuditLogList(*model.AuditLogFilter, *model.ListOptions) ([]*model.AuditLog, error)
+
+	// PersonalAccessToken
+	PersonalAccessTokenFind(*model.User, int64) (*model.PersonalAccessToken, error)
+	PersonalAccessTokenList(*model.User) ([]*model.PersonalAccessToken, error)
+	PersonalAccessTokenCreate(*model.PersonalAccessToken) error
+	PersonalAccessTokenUpdate(*model.PersonalAccessToken) error
+	PersonalAccessTokenDelete(*model.PersonalAccessToken) error
 
 	// Org
 	OrgCreate(*model.Org) error
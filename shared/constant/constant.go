@@ -28,6 +28,11 @@ const (
 	// DefaultClonePlugin can be changed by 'WOODPECKER_DEFAULT_CLONE_PLUGIN' at runtime.
 	// renovate: datasource=docker depName=woodpeckerci/plugin-git
 	DefaultClonePlugin = "docker.io/woodpeckerci/plugin-git:2.10.0"
+
+	// DefaultHelperImage runs cache and artifact steps on container backends
+	// and can be changed by 'WOODPECKER_CACHE_IMAGE' and
+	// 'WOODPECKER_ARTIFACTS_IMAGE' at runtime.
+	DefaultHelperImage = "docker.io/woodpeckerci/woodpecker-agent:v3"
 )
 
 // TrustedClonePlugins can be changed by 'WOODPECKER_PLUGINS_TRUSTED_CLONE' at runtime.
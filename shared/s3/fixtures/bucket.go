@@ -0,0 +1,121 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixtures
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// AccessKeyID is the only access key id the bucket accepts.
+const AccessKeyID = "id"
+
+// Bucket is a minimal in memory S3 bucket, addressed path style.
+type Bucket struct {
+	sync.Mutex
+	name    string
+	objects map[string]string
+}
+
+// NewBucket returns an empty bucket with the given name.
+func NewBucket(name string) *Bucket {
+	return &Bucket{name: name, objects: map[string]string{}}
+}
+
+// Object returns the content of an object and whether it exists.
+func (b *Bucket) Object(key string) (string, bool) {
+	b.Lock()
+	defer b.Unlock()
+	content, ok := b.objects[key]
+	return content, ok
+}
+
+// Keys returns the sorted keys of all objects.
+func (b *Bucket) Keys() []string {
+	b.Lock()
+	defer b.Unlock()
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (b *Bucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+AccessKeyID+"/") {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, "<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>")
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+b.name+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, "<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message></Error>")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		keys := make([]string, 0, len(b.objects))
+		for key := range b.objects {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		// return one object per page to cover the pagination
+		start := 0
+		if token := r.URL.Query().Get("continuation-token"); token != "" {
+			start = slices.Index(keys, token)
+		}
+		_, _ = io.WriteString(w, "<ListBucketResult>")
+		if start < len(keys) {
+			_, _ = fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size><LastModified>2026-01-02T03:04:05.000Z</LastModified></Contents>",
+				keys[start], len(b.objects[keys[start]]))
+		}
+		if start+1 < len(keys) {
+			_, _ = fmt.Fprintf(w, "<IsTruncated>true</IsTruncated><NextContinuationToken>%s</NextContinuationToken>", keys[start+1])
+		}
+		_, _ = io.WriteString(w, "</ListBucketResult>")
+	case r.Method == http.MethodGet:
+		content, ok := b.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, content)
+	case r.Method == http.MethodPut:
+		if r.ContentLength < 0 {
+			w.WriteHeader(http.StatusLengthRequired)
+			return
+		}
+		content, _ := io.ReadAll(r.Body)
+		b.objects[key] = string(content)
+	case r.Method == http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package s3 implements a minimal client for S3 compatible object storages.
+// It only covers what Woodpecker needs to store blobs: reading, writing,
+// deleting and listing objects of a single bucket.
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.woodpecker-ci.org/woodpecker/v3/shared/httputil"
+)
+
+// ErrNotFound is returned if an object does not exist.
+var ErrNotFound = errors.New("object not found")
+
+// Config configures the bucket a client works with.
+type Config struct {
+	// Endpoint is the URL of the storage, e.g. https://s3.eu-central-1.amazonaws.com.
+	Endpoint string
+	// Bucket is the name of the bucket.
+	Bucket string
+	// Region is the region of the bucket, it is part of every signature.
+	Region string
+	// AccessKeyID and SecretAccessKey are the credentials to sign requests with.
+	AccessKeyID     string
+	SecretAccessKey string
+	// PathStyle addresses the bucket as part of the path instead of the
+	// host name, as most self-hosted storages require.
+	PathStyle bool
+}
+
+// Object is an entry of a bucket listing.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// Client talks to a single bucket.
+type Client struct {
+	config   Config
+	endpoint *url.URL
+	http     *http.Client
+	now      func() time.Time
+}
+
+// New returns a client for the bucket of the given config.
+func New(config Config) (*Client, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, errors.New("s3: endpoint and bucket are required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("s3: invalid endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("s3: endpoint %q must be a http or https url", config.Endpoint)
+	}
+
+	return &Client{
+		config:   config,
+		endpoint: endpoint,
+		http: &http.Client{
+			Transport: httputil.NewUserAgentRoundTripper(nil, "s3"),
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Get returns the content of an object. It returns ErrNotFound if the object
+// does not exist.
+func (c *Client) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, http.MethodGet, key, nil, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Put writes an object. S3 requires the size of an object to be known
+// upfront, so it has to be passed along with the content.
+func (c *Client) Put(ctx context.Context, key string, content io.Reader, size int64) error {
+	resp, err := c.do(ctx, http.MethodPut, key, nil, content, size)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Delete removes an object. Deleting an object that does not exist is no error.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, key, nil, nil, 0)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// List returns all objects with the given key prefix.
+func (c *Client) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objects []Object
+	var continuationToken string
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		resp, err := c.do(ctx, http.MethodGet, "", query, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3: decode listing: %w", err)
+		}
+
+		for _, content := range result.Contents {
+			objects = append(objects, Object{
+				Key:          content.Key,
+				Size:         content.Size,
+				LastModified: content.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+type errorResponse struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// objectURL returns the url of an object, or of the bucket if key is empty.
+func (c *Client) objectURL(key string, query url.Values) *url.URL {
+	u := *c.endpoint
+	basePath := strings.TrimSuffix(u.Path, "/")
+	if c.config.PathStyle {
+		basePath += "/" + c.config.Bucket
+	} else {
+		u.Host = c.config.Bucket + "." + u.Host
+	}
+	u.Path = basePath + "/" + key
+	u.RawPath = basePath + "/" + encodePath(key)
+	u.RawQuery = encodeQuery(query)
+	return &u
+}
+
+func (c *Client) do(ctx context.Context, method, key string, query url.Values, body io.Reader, size int64) (*http.Response, error) {
+	u := c.objectURL(key, query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		req.ContentLength = size
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+		// the content is streamed, so it can't be hashed before sending it
+		payloadHash = unsignedPayload
+	}
+	c.sign(req, payloadHash, c.now())
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, httputil.EnhanceHTTPError(err, method, u.Redacted())
+	}
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && key != "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+
+	var errResp errorResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Code == "" {
+		return nil, fmt.Errorf("s3: %s %s: unexpected status %d", method, u.Path, resp.StatusCode)
+	}
+	return nil, fmt.Errorf("s3: %s %s: %s: %s", method, u.Path, errResp.Code, errResp.Message)
+}
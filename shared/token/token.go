@@ -34,6 +34,8 @@ const (
 	CsrfToken       Type = "csrf"
 	AgentToken      Type = "agent"
 	OAuthStateToken Type = "oauth-state"
+	ArtifactToken   Type = "artifact" // workflow token to transfer artifacts
+	PersonalToken   Type = "pat"      // scoped and expiring user token
 )
 
 // SignerAlgo id default algorithm used to sign JWT tokens.
@@ -1,4 +1,4 @@
-import type { PipelineStatus } from '~/lib/api/types';
+import type { PipelineStatus, TestStatus } from '~/lib/api/types';
 
 export const pipelineStatusColors: Record<PipelineStatus, 'green' | 'gray' | 'red' | 'blue' | 'orange'> = {
   blocked: 'gray',
@@ -13,3 +13,11 @@ export const pipelineStatusColors: Record<PipelineStatus, 'green' | 'gray' | 're
   started: 'blue',
   success: 'green',
 };
+
+// test results are shown with the icon of the matching pipeline status
+export const testStatuses: Record<TestStatus, PipelineStatus> = {
+  passed: 'success',
+  failed: 'failure',
+  error: 'error',
+  skipped: 'skipped',
+};
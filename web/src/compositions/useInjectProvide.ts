@@ -1,7 +1,16 @@
 import type { InjectionKey, Ref } from 'vue';
 import { inject as vueInject, provide as vueProvide } from 'vue';
 
-import type { Org, OrgPermissions, Pipeline, PipelineConfig, Repo, RepoPermissions } from '~/lib/api/types';
+import type {
+  Artifact,
+  Org,
+  OrgPermissions,
+  Pipeline,
+  PipelineConfig,
+  Repo,
+  RepoPermissions,
+  TestResult,
+} from '~/lib/api/types';
 
 import type { Tab } from './useTabs';
 
@@ -12,6 +21,8 @@ export interface InjectKeys {
   'org-permissions': Ref<OrgPermissions>;
   pipeline: Ref<Pipeline>;
   'pipeline-configs': Ref<PipelineConfig[] | undefined>;
+  'pipeline-artifacts': Ref<Artifact[]>;
+  'pipeline-tests': Ref<TestResult[]>;
   tabs: Ref<Tab[]>;
   pipelines: Ref<Pipeline[]>;
 }
@@ -1,6 +1,7 @@
 import ApiClient, { encodeQueryString } from './client';
 import type {
   Agent,
+  Artifact,
   Cron,
   ExtensionSettings,
   Forge,
@@ -17,7 +18,9 @@ import type {
   RepoPermissions,
   RepoSettings,
   Secret,
+  TestResult,
   User,
+  WorkflowAttempt,
 } from './types';
 
 const DEFAULT_FORGE_ID = 1;
@@ -126,6 +129,18 @@ export default class WoodpeckerClient extends ApiClient {
     return this._get(`/api/repos/${repoId}/pipelines/${pipelineNumber}/config`) as Promise<PipelineConfig[]>;
   }
 
+  async getPipelineAttempts(repoId: number, pipelineNumber: number): Promise<WorkflowAttempt[]> {
+    return this._get(`/api/repos/${repoId}/pipelines/${pipelineNumber}/attempts`) as Promise<WorkflowAttempt[]>;
+  }
+
+  async getPipelineArtifacts(repoId: number, pipelineNumber: number): Promise<Artifact[]> {
+    return this._get(`/api/repos/${repoId}/pipelines/${pipelineNumber}/artifacts`) as Promise<Artifact[]>;
+  }
+
+  async getPipelineTests(repoId: number, pipelineNumber: number): Promise<TestResult[]> {
+    return this._get(`/api/repos/${repoId}/pipelines/${pipelineNumber}/tests`) as Promise<TestResult[]>;
+  }
+
   async getPipelineMetadata(repoId: number, pipelineNumber: number): Promise<any> {
     return this._get(`/api/repos/${repoId}/pipelines/${pipelineNumber}/metadata`) as Promise<any>;
   }
@@ -159,6 +174,10 @@ export default class WoodpeckerClient extends ApiClient {
     return this._get(`/api/repos/${repoId}/logs/${pipeline}/${step}`) as Promise<PipelineLog[]>;
   }
 
+  async getStepAttemptLogs(repoId: number, pipeline: number, step: number, attempt: number): Promise<PipelineLog[]> {
+    return this._get(`/api/repos/${repoId}/logs/${pipeline}/${step}/attempts/${attempt}`) as Promise<PipelineLog[]>;
+  }
+
   async deleteLogs(repoId: number, pipeline: number, step: number): Promise<unknown> {
     return this._delete(`/api/repos/${repoId}/logs/${pipeline}/${step}`);
   }
@@ -13,4 +13,15 @@ export interface Agent {
   version: string;
   no_schedule: boolean;
   custom_labels: Record<string, string>;
+  resources: AgentResources;
+}
+
+// The resources an agent can allocate for workflows, unset ones are zero.
+export interface AgentResources {
+  // in millicores
+  cpu: number;
+  // in bytes
+  memory: number;
+  // in bytes
+  disk: number;
 }
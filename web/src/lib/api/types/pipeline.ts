@@ -94,6 +94,9 @@ export interface Pipeline {
 
   changed_files?: string[];
 
+  // Whether the logs got removed by a retention policy.
+  logs_deleted?: boolean;
+
   cancel_info: CancelInfo;
 
   version: string;
@@ -123,9 +126,62 @@ export interface PipelineWorkflow {
   finished?: number;
   agent_id?: number;
   error?: string;
+  attempt?: number;
   children: PipelineStep[];
 }
 
+export type FailureClass = 'agent_lost' | 'setup_error' | 'oom';
+
+export interface Artifact {
+  id: number;
+  pipeline_id: number;
+  workflow_id: number;
+  workflow: string;
+  name: string;
+  size: number;
+  created: number;
+  expires?: number;
+}
+
+export type TestStatus = 'passed' | 'failed' | 'error' | 'skipped';
+
+export interface TestRun {
+  pipeline_number: number;
+  status: TestStatus;
+}
+
+export interface TestResult {
+  id: number;
+  pipeline_id: number;
+  pipeline_number: number;
+  step_id: number;
+  suite: string;
+  classname: string;
+  name: string;
+  // in milliseconds
+  duration: number;
+  status: TestStatus;
+  message?: string;
+  details?: string;
+  file?: string;
+  line?: number;
+  history?: TestRun[];
+  flaky?: boolean;
+}
+
+export interface WorkflowAttempt {
+  id: number;
+  pipeline_id: number;
+  workflow_id: number;
+  attempt: number;
+  agent_id: number;
+  state: PipelineStatus;
+  failure?: FailureClass;
+  error?: string;
+  started: number;
+  finished: number;
+}
+
 export interface PipelineStep {
   id: number;
   uuid: string;
@@ -150,6 +206,15 @@ export interface PipelineLog {
   type: number;
 }
 
+// data of a log entry of the metadata type marking a command of a step
+export interface PipelineLogCommand {
+  event: 'start' | 'end';
+  index: number;
+  started: number; // unix time in milliseconds
+  duration?: number; // milliseconds
+  exit_code?: number;
+}
+
 export type PipelineFeed = Pipeline & {
   repo_id: number;
 };
@@ -161,5 +226,6 @@ export enum StepType {
   Plugin = 'plugin',
   Commands = 'commands',
   Cache = 'cache',
+  Artifacts = 'artifacts',
 }
 /* eslint-enable */
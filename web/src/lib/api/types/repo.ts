@@ -58,11 +58,20 @@ export interface Repo {
   // The amount of time in minutes before the pipeline is killed.
   timeout: number;
 
+  // The interval in minutes the forge is polled for new commits, 0 disables polling.
+  poll_interval: number;
+
+  // The default queue priority of the workflows, higher values are scheduled first.
+  priority: number;
+
   // Whether pull requests should trigger a pipeline.
   allow_pr: boolean;
 
   allow_deploy: boolean;
 
+  // Whether a comment summarizing the latest pipeline is kept on pull requests.
+  pr_comment: boolean;
+
   config_file: string;
 
   visibility: RepoVisibility;
@@ -126,12 +135,15 @@ export type RepoSettings = Pick<
   Repo,
   | 'config_file'
   | 'timeout'
+  | 'poll_interval'
+  | 'priority'
   | 'visibility'
   | 'trusted'
   | 'require_approval'
   | 'approval_allowed_users'
   | 'allow_pr'
   | 'allow_deploy'
+  | 'pr_comment'
   | 'cancel_previous_pipeline_events'
   | 'netrc_trusted'
 >;
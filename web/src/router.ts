@@ -104,6 +104,24 @@ const routes: RouteRecordRaw[] = [
                 component: (): Component => import('~/views/repo/pipeline/PipelineConfig.vue'),
                 props: true,
               },
+              {
+                path: 'attempts',
+                name: 'repo-pipeline-attempts',
+                component: (): Component => import('~/views/repo/pipeline/PipelineAttempts.vue'),
+                props: true,
+              },
+              {
+                path: 'artifacts',
+                name: 'repo-pipeline-artifacts',
+                component: (): Component => import('~/views/repo/pipeline/PipelineArtifacts.vue'),
+                props: true,
+              },
+              {
+                path: 'tests',
+                name: 'repo-pipeline-tests',
+                component: (): Component => import('~/views/repo/pipeline/PipelineTests.vue'),
+                props: true,
+              },
               {
                 path: 'errors',
                 name: 'repo-pipeline-errors',
@@ -54,11 +54,25 @@ const (
 type StepType string
 
 const (
-	StepTypeClone    StepType = "clone"
-	StepTypeService  StepType = "service"
-	StepTypePlugin   StepType = "plugin"
-	StepTypeCommands StepType = "commands"
-	StepTypeCache    StepType = "cache"
+	StepTypeClone     StepType = "clone"
+	StepTypeService   StepType = "service"
+	StepTypePlugin    StepType = "plugin"
+	StepTypeCommands  StepType = "commands"
+	StepTypeCache     StepType = "cache"
+	StepTypeArtifacts StepType = "artifacts"
+)
+
+// TokenScope is a permission granted to a personal access token.
+type TokenScope string
+
+const (
+	TokenScopeRepoRead      TokenScope = "repo:read"
+	TokenScopeRepoWrite     TokenScope = "repo:write"
+	TokenScopePipelineWrite TokenScope = "pipeline:write"
+	TokenScopeSecretsRead   TokenScope = "secrets:read"
+	TokenScopeSecretsWrite  TokenScope = "secrets:write"
+	TokenScopeUserWrite     TokenScope = "user:write"
+	TokenScopeAdmin         TokenScope = "admin"
 )
 
 const defaultForgeID = 1
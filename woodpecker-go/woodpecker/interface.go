@@ -15,6 +15,7 @@
 package woodpecker
 
 import (
+	"io"
 	"net/http"
 )
 
@@ -46,6 +47,17 @@ type Client interface {
 	// It is recommended to specify forgeID (default is 1).
 	UserDel(login string, forgeID ...int64) error
 
+	// PersonalAccessTokenList returns the personal access tokens of the
+	// currently authenticated user.
+	PersonalAccessTokenList() ([]*PersonalAccessToken, error)
+
+	// PersonalAccessTokenCreate creates a personal access token, the token
+	// value is only returned once.
+	PersonalAccessTokenCreate(opt *PersonalAccessTokenCreate) (*NewPersonalAccessToken, error)
+
+	// PersonalAccessTokenDelete revokes a personal access token.
+	PersonalAccessTokenDelete(tokenID int64) error
+
 	// Repo returns a repository by name.
 	Repo(repoID int64) (*Repo, error)
 
@@ -107,9 +119,27 @@ type Client interface {
 	// PipelineMetadata returns metadata for a pipeline.
 	PipelineMetadata(repoID int64, pipelineNumber int) ([]byte, error)
 
+	// PipelineAttempts returns the finished attempts of all workflows of a pipeline.
+	PipelineAttempts(repoID, pipeline int64) ([]*WorkflowAttempt, error)
+
+	// PipelineArtifacts returns the artifacts uploaded by the workflows of a pipeline.
+	PipelineArtifacts(repoID, pipeline int64) ([]*Artifact, error)
+
+	// PipelineArtifactDownload returns the content of an artifact, a gzip
+	// compressed tar archive. The caller has to close it.
+	PipelineArtifactDownload(repoID, pipeline, artifactID int64) (io.ReadCloser, error)
+
+	// PipelineTests returns the results of the tests reported by the steps of a
+	// pipeline, along with their history.
+	PipelineTests(repoID, pipeline int64, opt PipelineTestsOptions) ([]*TestResult, error)
+
 	// StepLogEntries returns the LogEntries for the given pipeline step
 	StepLogEntries(repoID, pipeline, stepID int64) ([]*LogEntry, error)
 
+	// LogSearch returns the lines of the logs of the recent pipelines of a
+	// repo containing the query.
+	LogSearch(repoID int64, opt LogSearchOptions) ([]*LogMatch, error)
+
 	// Deploy triggers a deployment for an existing pipeline using the specified
 	// target environment.
 	Deploy(repoID, pipeline int64, opt DeployOptions) (*Pipeline, error)
@@ -165,6 +195,33 @@ type Client interface {
 	// GlobalRegistryDelete deletes a global registry.
 	GlobalRegistryDelete(registry string) error
 
+	// RetentionPolicy returns the retention policy of a repository.
+	RetentionPolicy(repoID int64) (*RetentionPolicy, error)
+
+	// RetentionPolicySet creates or replaces the retention policy of a repository.
+	RetentionPolicySet(repoID int64, policy *RetentionPolicy) (*RetentionPolicy, error)
+
+	// RetentionPolicyDelete deletes the retention policy of a repository.
+	RetentionPolicyDelete(repoID int64) error
+
+	// OrgRetentionPolicy returns the retention policy of an organization.
+	OrgRetentionPolicy(orgID int64) (*RetentionPolicy, error)
+
+	// OrgRetentionPolicySet creates or replaces the retention policy of an organization.
+	OrgRetentionPolicySet(orgID int64, policy *RetentionPolicy) (*RetentionPolicy, error)
+
+	// OrgRetentionPolicyDelete deletes the retention policy of an organization.
+	OrgRetentionPolicyDelete(orgID int64) error
+
+	// GlobalRetentionPolicy returns the global retention policy.
+	GlobalRetentionPolicy() (*RetentionPolicy, error)
+
+	// GlobalRetentionPolicySet creates or replaces the global retention policy.
+	GlobalRetentionPolicySet(policy *RetentionPolicy) (*RetentionPolicy, error)
+
+	// GlobalRetentionPolicyDelete deletes the global retention policy.
+	GlobalRetentionPolicyDelete() error
+
 	// Secret returns a secret by name.
 	Secret(repoID int64, secret string) (*Secret, error)
 
@@ -189,6 +246,9 @@ type Client interface {
 	// OrgList returns a list of all organizations.
 	OrgList(opt ListOptions) ([]*Org, error)
 
+	// OrgUpdate updates an organization.
+	OrgUpdate(orgID int64, org *OrgPatch) (*Org, error)
+
 	// OrgSecret returns an organization secret by name.
 	OrgSecret(orgID int64, secret string) (*Secret, error)
 
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"io"
 	"net/http"
 
 	mock "github.com/stretchr/testify/mock"
@@ -1094,6 +1095,167 @@ func (_c *MockClient_GlobalRegistryUpdate_Call) RunAndReturn(run func(registry *
 	return _c
 }
 
+// GlobalRetentionPolicy provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRetentionPolicy() (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRetentionPolicy")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRetentionPolicy'
+type MockClient_GlobalRetentionPolicy_Call struct {
+	*mock.Call
+}
+
+// GlobalRetentionPolicy is a helper method to define mock.On call
+func (_e *MockClient_Expecter) GlobalRetentionPolicy() *MockClient_GlobalRetentionPolicy_Call {
+	return &MockClient_GlobalRetentionPolicy_Call{Call: _e.mock.On("GlobalRetentionPolicy")}
+}
+
+func (_c *MockClient_GlobalRetentionPolicy_Call) Run(run func()) *MockClient_GlobalRetentionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicy_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_GlobalRetentionPolicy_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicy_Call) RunAndReturn(run func() (*woodpecker.RetentionPolicy, error)) *MockClient_GlobalRetentionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRetentionPolicyDelete provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRetentionPolicyDelete() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRetentionPolicyDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_GlobalRetentionPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRetentionPolicyDelete'
+type MockClient_GlobalRetentionPolicyDelete_Call struct {
+	*mock.Call
+}
+
+// GlobalRetentionPolicyDelete is a helper method to define mock.On call
+func (_e *MockClient_Expecter) GlobalRetentionPolicyDelete() *MockClient_GlobalRetentionPolicyDelete_Call {
+	return &MockClient_GlobalRetentionPolicyDelete_Call{Call: _e.mock.On("GlobalRetentionPolicyDelete")}
+}
+
+func (_c *MockClient_GlobalRetentionPolicyDelete_Call) Run(run func()) *MockClient_GlobalRetentionPolicyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicyDelete_Call) Return(err error) *MockClient_GlobalRetentionPolicyDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicyDelete_Call) RunAndReturn(run func() error) *MockClient_GlobalRetentionPolicyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GlobalRetentionPolicySet provides a mock function for the type MockClient
+func (_mock *MockClient) GlobalRetentionPolicySet(policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GlobalRetentionPolicySet")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.RetentionPolicy) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.RetentionPolicy) error); ok {
+		r1 = returnFunc(policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GlobalRetentionPolicySet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GlobalRetentionPolicySet'
+type MockClient_GlobalRetentionPolicySet_Call struct {
+	*mock.Call
+}
+
+// GlobalRetentionPolicySet is a helper method to define mock.On call
+//   - policy *woodpecker.RetentionPolicy
+func (_e *MockClient_Expecter) GlobalRetentionPolicySet(policy any) *MockClient_GlobalRetentionPolicySet_Call {
+	return &MockClient_GlobalRetentionPolicySet_Call{Call: _e.mock.On("GlobalRetentionPolicySet", policy)}
+}
+
+func (_c *MockClient_GlobalRetentionPolicySet_Call) Run(run func(policy *woodpecker.RetentionPolicy)) *MockClient_GlobalRetentionPolicySet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *woodpecker.RetentionPolicy
+		if args[0] != nil {
+			arg0 = args[0].(*woodpecker.RetentionPolicy)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicySet_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_GlobalRetentionPolicySet_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_GlobalRetentionPolicySet_Call) RunAndReturn(run func(policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)) *MockClient_GlobalRetentionPolicySet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GlobalSecret provides a mock function for the type MockClient
 func (_mock *MockClient) GlobalSecret(secret string) (*woodpecker.Secret, error) {
 	ret := _mock.Called(secret)
@@ -1448,6 +1610,74 @@ func (_c *MockClient_LogLevel_Call) RunAndReturn(run func() (*woodpecker.LogLeve
 	return _c
 }
 
+// LogSearch provides a mock function for the type MockClient
+func (_mock *MockClient) LogSearch(repoID int64, opt woodpecker.LogSearchOptions) ([]*woodpecker.LogMatch, error) {
+	ret := _mock.Called(repoID, opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogSearch")
+	}
+
+	var r0 []*woodpecker.LogMatch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.LogSearchOptions) ([]*woodpecker.LogMatch, error)); ok {
+		return returnFunc(repoID, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, woodpecker.LogSearchOptions) []*woodpecker.LogMatch); ok {
+		r0 = returnFunc(repoID, opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.LogMatch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, woodpecker.LogSearchOptions) error); ok {
+		r1 = returnFunc(repoID, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_LogSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogSearch'
+type MockClient_LogSearch_Call struct {
+	*mock.Call
+}
+
+// LogSearch is a helper method to define mock.On call
+//   - repoID int64
+//   - opt woodpecker.LogSearchOptions
+func (_e *MockClient_Expecter) LogSearch(repoID any, opt any) *MockClient_LogSearch_Call {
+	return &MockClient_LogSearch_Call{Call: _e.mock.On("LogSearch", repoID, opt)}
+}
+
+func (_c *MockClient_LogSearch_Call) Run(run func(repoID int64, opt woodpecker.LogSearchOptions)) *MockClient_LogSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 woodpecker.LogSearchOptions
+		if args[1] != nil {
+			arg1 = args[1].(woodpecker.LogSearchOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_LogSearch_Call) Return(matches []*woodpecker.LogMatch, err error) *MockClient_LogSearch_Call {
+	_c.Call.Return(matches, err)
+	return _c
+}
+
+func (_c *MockClient_LogSearch_Call) RunAndReturn(run func(repoID int64, opt woodpecker.LogSearchOptions) ([]*woodpecker.LogMatch, error)) *MockClient_LogSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LogsPurge provides a mock function for the type MockClient
 func (_mock *MockClient) LogsPurge(repoID int64, pipeline int64) error {
 	ret := _mock.Called(repoID, pipeline)
@@ -2020,178 +2250,359 @@ func (_c *MockClient_OrgRegistryUpdate_Call) RunAndReturn(run func(orgID int64,
 	return _c
 }
 
-// OrgSecret provides a mock function for the type MockClient
-func (_mock *MockClient) OrgSecret(orgID int64, secret string) (*woodpecker.Secret, error) {
-	ret := _mock.Called(orgID, secret)
+// OrgRetentionPolicy provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetentionPolicy(orgID int64) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(orgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecret")
+		panic("no return value specified for OrgRetentionPolicy")
 	}
 
-	var r0 *woodpecker.Secret
+	var r0 *woodpecker.RetentionPolicy
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) (*woodpecker.Secret, error)); ok {
-		return returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(orgID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, string) *woodpecker.Secret); ok {
-		r0 = returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(orgID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
-		r1 = returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(orgID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_OrgSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecret'
-type MockClient_OrgSecret_Call struct {
+// MockClient_OrgRetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetentionPolicy'
+type MockClient_OrgRetentionPolicy_Call struct {
 	*mock.Call
 }
 
-// OrgSecret is a helper method to define mock.On call
+// OrgRetentionPolicy is a helper method to define mock.On call
 //   - orgID int64
-//   - secret string
-func (_e *MockClient_Expecter) OrgSecret(orgID any, secret any) *MockClient_OrgSecret_Call {
-	return &MockClient_OrgSecret_Call{Call: _e.mock.On("OrgSecret", orgID, secret)}
+func (_e *MockClient_Expecter) OrgRetentionPolicy(orgID any) *MockClient_OrgRetentionPolicy_Call {
+	return &MockClient_OrgRetentionPolicy_Call{Call: _e.mock.On("OrgRetentionPolicy", orgID)}
 }
 
-func (_c *MockClient_OrgSecret_Call) Run(run func(orgID int64, secret string)) *MockClient_OrgSecret_Call {
+func (_c *MockClient_OrgRetentionPolicy_Call) Run(run func(orgID int64)) *MockClient_OrgRetentionPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_OrgSecret_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecret_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_OrgRetentionPolicy_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_OrgRetentionPolicy_Call {
+	_c.Call.Return(retentionPolicy, err)
 	return _c
 }
 
-func (_c *MockClient_OrgSecret_Call) RunAndReturn(run func(orgID int64, secret string) (*woodpecker.Secret, error)) *MockClient_OrgSecret_Call {
+func (_c *MockClient_OrgRetentionPolicy_Call) RunAndReturn(run func(orgID int64) (*woodpecker.RetentionPolicy, error)) *MockClient_OrgRetentionPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgSecretCreate provides a mock function for the type MockClient
-func (_mock *MockClient) OrgSecretCreate(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error) {
-	ret := _mock.Called(orgID, secret)
+// OrgRetentionPolicyDelete provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetentionPolicyDelete(orgID int64) error {
+	ret := _mock.Called(orgID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecretCreate")
+		panic("no return value specified for OrgRetentionPolicyDelete")
 	}
 
-	var r0 *woodpecker.Secret
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) (*woodpecker.Secret, error)); ok {
-		return returnFunc(orgID, secret)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) *woodpecker.Secret); ok {
-		r0 = returnFunc(orgID, secret)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Secret) error); ok {
-		r1 = returnFunc(orgID, secret)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(orgID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_OrgSecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretCreate'
-type MockClient_OrgSecretCreate_Call struct {
+// MockClient_OrgRetentionPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetentionPolicyDelete'
+type MockClient_OrgRetentionPolicyDelete_Call struct {
 	*mock.Call
 }
 
-// OrgSecretCreate is a helper method to define mock.On call
+// OrgRetentionPolicyDelete is a helper method to define mock.On call
 //   - orgID int64
-//   - secret *woodpecker.Secret
-func (_e *MockClient_Expecter) OrgSecretCreate(orgID any, secret any) *MockClient_OrgSecretCreate_Call {
-	return &MockClient_OrgSecretCreate_Call{Call: _e.mock.On("OrgSecretCreate", orgID, secret)}
+func (_e *MockClient_Expecter) OrgRetentionPolicyDelete(orgID any) *MockClient_OrgRetentionPolicyDelete_Call {
+	return &MockClient_OrgRetentionPolicyDelete_Call{Call: _e.mock.On("OrgRetentionPolicyDelete", orgID)}
 }
 
-func (_c *MockClient_OrgSecretCreate_Call) Run(run func(orgID int64, secret *woodpecker.Secret)) *MockClient_OrgSecretCreate_Call {
+func (_c *MockClient_OrgRetentionPolicyDelete_Call) Run(run func(orgID int64)) *MockClient_OrgRetentionPolicyDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 *woodpecker.Secret
-		if args[1] != nil {
-			arg1 = args[1].(*woodpecker.Secret)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_OrgSecretCreate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecretCreate_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_OrgRetentionPolicyDelete_Call) Return(err error) *MockClient_OrgRetentionPolicyDelete_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_OrgSecretCreate_Call) RunAndReturn(run func(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_OrgSecretCreate_Call {
+func (_c *MockClient_OrgRetentionPolicyDelete_Call) RunAndReturn(run func(orgID int64) error) *MockClient_OrgRetentionPolicyDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgSecretDelete provides a mock function for the type MockClient
-func (_mock *MockClient) OrgSecretDelete(orgID int64, secret string) error {
-	ret := _mock.Called(orgID, secret)
+// OrgRetentionPolicySet provides a mock function for the type MockClient
+func (_mock *MockClient) OrgRetentionPolicySet(orgID int64, policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(orgID, policy)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecretDelete")
+		panic("no return value specified for OrgRetentionPolicySet")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int64, string) error); ok {
-		r0 = returnFunc(orgID, secret)
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(orgID, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicy) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(orgID, policy)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.RetentionPolicy) error); ok {
+		r1 = returnFunc(orgID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_OrgSecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretDelete'
-type MockClient_OrgSecretDelete_Call struct {
+// MockClient_OrgRetentionPolicySet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgRetentionPolicySet'
+type MockClient_OrgRetentionPolicySet_Call struct {
 	*mock.Call
 }
 
-// OrgSecretDelete is a helper method to define mock.On call
+// OrgRetentionPolicySet is a helper method to define mock.On call
 //   - orgID int64
-//   - secret string
-func (_e *MockClient_Expecter) OrgSecretDelete(orgID any, secret any) *MockClient_OrgSecretDelete_Call {
-	return &MockClient_OrgSecretDelete_Call{Call: _e.mock.On("OrgSecretDelete", orgID, secret)}
+//   - policy *woodpecker.RetentionPolicy
+func (_e *MockClient_Expecter) OrgRetentionPolicySet(orgID any, policy any) *MockClient_OrgRetentionPolicySet_Call {
+	return &MockClient_OrgRetentionPolicySet_Call{Call: _e.mock.On("OrgRetentionPolicySet", orgID, policy)}
 }
 
-func (_c *MockClient_OrgSecretDelete_Call) Run(run func(orgID int64, secret string)) *MockClient_OrgSecretDelete_Call {
+func (_c *MockClient_OrgRetentionPolicySet_Call) Run(run func(orgID int64, policy *woodpecker.RetentionPolicy)) *MockClient_OrgRetentionPolicySet_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 string
+		var arg1 *woodpecker.RetentionPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.RetentionPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgRetentionPolicySet_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_OrgRetentionPolicySet_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_OrgRetentionPolicySet_Call) RunAndReturn(run func(orgID int64, policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)) *MockClient_OrgRetentionPolicySet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecret provides a mock function for the type MockClient
+func (_mock *MockClient) OrgSecret(orgID int64, secret string) (*woodpecker.Secret, error) {
+	ret := _mock.Called(orgID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecret")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) (*woodpecker.Secret, error)); ok {
+		return returnFunc(orgID, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, string) *woodpecker.Secret); ok {
+		r0 = returnFunc(orgID, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, string) error); ok {
+		r1 = returnFunc(orgID, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecret'
+type MockClient_OrgSecret_Call struct {
+	*mock.Call
+}
+
+// OrgSecret is a helper method to define mock.On call
+//   - orgID int64
+//   - secret string
+func (_e *MockClient_Expecter) OrgSecret(orgID any, secret any) *MockClient_OrgSecret_Call {
+	return &MockClient_OrgSecret_Call{Call: _e.mock.On("OrgSecret", orgID, secret)}
+}
+
+func (_c *MockClient_OrgSecret_Call) Run(run func(orgID int64, secret string)) *MockClient_OrgSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgSecret_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecret_Call {
+	_c.Call.Return(secret1, err)
+	return _c
+}
+
+func (_c *MockClient_OrgSecret_Call) RunAndReturn(run func(orgID int64, secret string) (*woodpecker.Secret, error)) *MockClient_OrgSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecretCreate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgSecretCreate(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error) {
+	ret := _mock.Called(orgID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecretCreate")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) (*woodpecker.Secret, error)); ok {
+		return returnFunc(orgID, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) *woodpecker.Secret); ok {
+		r0 = returnFunc(orgID, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Secret) error); ok {
+		r1 = returnFunc(orgID, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgSecretCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretCreate'
+type MockClient_OrgSecretCreate_Call struct {
+	*mock.Call
+}
+
+// OrgSecretCreate is a helper method to define mock.On call
+//   - orgID int64
+//   - secret *woodpecker.Secret
+func (_e *MockClient_Expecter) OrgSecretCreate(orgID any, secret any) *MockClient_OrgSecretCreate_Call {
+	return &MockClient_OrgSecretCreate_Call{Call: _e.mock.On("OrgSecretCreate", orgID, secret)}
+}
+
+func (_c *MockClient_OrgSecretCreate_Call) Run(run func(orgID int64, secret *woodpecker.Secret)) *MockClient_OrgSecretCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.Secret
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.Secret)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgSecretCreate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecretCreate_Call {
+	_c.Call.Return(secret1, err)
+	return _c
+}
+
+func (_c *MockClient_OrgSecretCreate_Call) RunAndReturn(run func(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_OrgSecretCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecretDelete provides a mock function for the type MockClient
+func (_mock *MockClient) OrgSecretDelete(orgID int64, secret string) error {
+	ret := _mock.Called(orgID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecretDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = returnFunc(orgID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_OrgSecretDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretDelete'
+type MockClient_OrgSecretDelete_Call struct {
+	*mock.Call
+}
+
+// OrgSecretDelete is a helper method to define mock.On call
+//   - orgID int64
+//   - secret string
+func (_e *MockClient_Expecter) OrgSecretDelete(orgID any, secret any) *MockClient_OrgSecretDelete_Call {
+	return &MockClient_OrgSecretDelete_Call{Call: _e.mock.On("OrgSecretDelete", orgID, secret)}
+}
+
+func (_c *MockClient_OrgSecretDelete_Call) Run(run func(orgID int64, secret string)) *MockClient_OrgSecretDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
@@ -2276,97 +2687,543 @@ func (_c *MockClient_OrgSecretList_Call) Return(secrets []*woodpecker.Secret, er
 	return _c
 }
 
-func (_c *MockClient_OrgSecretList_Call) RunAndReturn(run func(orgID int64, opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)) *MockClient_OrgSecretList_Call {
+func (_c *MockClient_OrgSecretList_Call) RunAndReturn(run func(orgID int64, opt woodpecker.SecretListOptions) ([]*woodpecker.Secret, error)) *MockClient_OrgSecretList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgSecretUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgSecretUpdate(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error) {
+	ret := _mock.Called(orgID, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgSecretUpdate")
+	}
+
+	var r0 *woodpecker.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) (*woodpecker.Secret, error)); ok {
+		return returnFunc(orgID, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) *woodpecker.Secret); ok {
+		r0 = returnFunc(orgID, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Secret) error); ok {
+		r1 = returnFunc(orgID, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgSecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretUpdate'
+type MockClient_OrgSecretUpdate_Call struct {
+	*mock.Call
+}
+
+// OrgSecretUpdate is a helper method to define mock.On call
+//   - orgID int64
+//   - secret *woodpecker.Secret
+func (_e *MockClient_Expecter) OrgSecretUpdate(orgID any, secret any) *MockClient_OrgSecretUpdate_Call {
+	return &MockClient_OrgSecretUpdate_Call{Call: _e.mock.On("OrgSecretUpdate", orgID, secret)}
+}
+
+func (_c *MockClient_OrgSecretUpdate_Call) Run(run func(orgID int64, secret *woodpecker.Secret)) *MockClient_OrgSecretUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.Secret
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.Secret)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgSecretUpdate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecretUpdate_Call {
+	_c.Call.Return(secret1, err)
+	return _c
+}
+
+func (_c *MockClient_OrgSecretUpdate_Call) RunAndReturn(run func(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_OrgSecretUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrgUpdate provides a mock function for the type MockClient
+func (_mock *MockClient) OrgUpdate(orgID int64, org *woodpecker.OrgPatch) (*woodpecker.Org, error) {
+	ret := _mock.Called(orgID, org)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgUpdate")
+	}
+
+	var r0 *woodpecker.Org
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.OrgPatch) (*woodpecker.Org, error)); ok {
+		return returnFunc(orgID, org)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.OrgPatch) *woodpecker.Org); ok {
+		r0 = returnFunc(orgID, org)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Org)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.OrgPatch) error); ok {
+		r1 = returnFunc(orgID, org)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_OrgUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgUpdate'
+type MockClient_OrgUpdate_Call struct {
+	*mock.Call
+}
+
+// OrgUpdate is a helper method to define mock.On call
+//   - orgID int64
+//   - org *woodpecker.OrgPatch
+func (_e *MockClient_Expecter) OrgUpdate(orgID any, org any) *MockClient_OrgUpdate_Call {
+	return &MockClient_OrgUpdate_Call{Call: _e.mock.On("OrgUpdate", orgID, org)}
+}
+
+func (_c *MockClient_OrgUpdate_Call) Run(run func(orgID int64, org *woodpecker.OrgPatch)) *MockClient_OrgUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.OrgPatch
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.OrgPatch)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_OrgUpdate_Call) Return(org1 *woodpecker.Org, err error) *MockClient_OrgUpdate_Call {
+	_c.Call.Return(org1, err)
+	return _c
+}
+
+func (_c *MockClient_OrgUpdate_Call) RunAndReturn(run func(orgID int64, org *woodpecker.OrgPatch) (*woodpecker.Org, error)) *MockClient_OrgUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenCreate provides a mock function for the type MockClient
+func (_mock *MockClient) PersonalAccessTokenCreate(opt *woodpecker.PersonalAccessTokenCreate) (*woodpecker.NewPersonalAccessToken, error) {
+	ret := _mock.Called(opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersonalAccessTokenCreate")
+	}
+
+	var r0 *woodpecker.NewPersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.PersonalAccessTokenCreate) (*woodpecker.NewPersonalAccessToken, error)); ok {
+		return returnFunc(opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*woodpecker.PersonalAccessTokenCreate) *woodpecker.NewPersonalAccessToken); ok {
+		r0 = returnFunc(opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.NewPersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*woodpecker.PersonalAccessTokenCreate) error); ok {
+		r1 = returnFunc(opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PersonalAccessTokenCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenCreate'
+type MockClient_PersonalAccessTokenCreate_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenCreate is a helper method to define mock.On call
+//   - opt *woodpecker.PersonalAccessTokenCreate
+func (_e *MockClient_Expecter) PersonalAccessTokenCreate(opt any) *MockClient_PersonalAccessTokenCreate_Call {
+	return &MockClient_PersonalAccessTokenCreate_Call{Call: _e.mock.On("PersonalAccessTokenCreate", opt)}
+}
+
+func (_c *MockClient_PersonalAccessTokenCreate_Call) Run(run func(opt *woodpecker.PersonalAccessTokenCreate)) *MockClient_PersonalAccessTokenCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *woodpecker.PersonalAccessTokenCreate
+		if args[0] != nil {
+			arg0 = args[0].(*woodpecker.PersonalAccessTokenCreate)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenCreate_Call) Return(r0 *woodpecker.NewPersonalAccessToken, err error) *MockClient_PersonalAccessTokenCreate_Call {
+	_c.Call.Return(r0, err)
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenCreate_Call) RunAndReturn(run func(opt *woodpecker.PersonalAccessTokenCreate) (*woodpecker.NewPersonalAccessToken, error)) *MockClient_PersonalAccessTokenCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenDelete provides a mock function for the type MockClient
+func (_mock *MockClient) PersonalAccessTokenDelete(tokenID int64) error {
+	ret := _mock.Called(tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersonalAccessTokenDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_PersonalAccessTokenDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenDelete'
+type MockClient_PersonalAccessTokenDelete_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenDelete is a helper method to define mock.On call
+//   - tokenID int64
+func (_e *MockClient_Expecter) PersonalAccessTokenDelete(tokenID any) *MockClient_PersonalAccessTokenDelete_Call {
+	return &MockClient_PersonalAccessTokenDelete_Call{Call: _e.mock.On("PersonalAccessTokenDelete", tokenID)}
+}
+
+func (_c *MockClient_PersonalAccessTokenDelete_Call) Run(run func(tokenID int64)) *MockClient_PersonalAccessTokenDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenDelete_Call) Return(err error) *MockClient_PersonalAccessTokenDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenDelete_Call) RunAndReturn(run func(tokenID int64) error) *MockClient_PersonalAccessTokenDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PersonalAccessTokenList provides a mock function for the type MockClient
+func (_mock *MockClient) PersonalAccessTokenList() ([]*woodpecker.PersonalAccessToken, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PersonalAccessTokenList")
+	}
+
+	var r0 []*woodpecker.PersonalAccessToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*woodpecker.PersonalAccessToken, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*woodpecker.PersonalAccessToken); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.PersonalAccessToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PersonalAccessTokenList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PersonalAccessTokenList'
+type MockClient_PersonalAccessTokenList_Call struct {
+	*mock.Call
+}
+
+// PersonalAccessTokenList is a helper method to define mock.On call
+func (_e *MockClient_Expecter) PersonalAccessTokenList() *MockClient_PersonalAccessTokenList_Call {
+	return &MockClient_PersonalAccessTokenList_Call{Call: _e.mock.On("PersonalAccessTokenList")}
+}
+
+func (_c *MockClient_PersonalAccessTokenList_Call) Run(run func()) *MockClient_PersonalAccessTokenList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenList_Call) Return(r0 []*woodpecker.PersonalAccessToken, err error) *MockClient_PersonalAccessTokenList_Call {
+	_c.Call.Return(r0, err)
+	return _c
+}
+
+func (_c *MockClient_PersonalAccessTokenList_Call) RunAndReturn(run func() ([]*woodpecker.PersonalAccessToken, error)) *MockClient_PersonalAccessTokenList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pipeline provides a mock function for the type MockClient
+func (_mock *MockClient) Pipeline(repoID int64, pipeline int64) (*woodpecker.Pipeline, error) {
+	ret := _mock.Called(repoID, pipeline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pipeline")
+	}
+
+	var r0 *woodpecker.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Pipeline, error)); ok {
+		return returnFunc(repoID, pipeline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Pipeline); ok {
+		r0 = returnFunc(repoID, pipeline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(repoID, pipeline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_Pipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pipeline'
+type MockClient_Pipeline_Call struct {
+	*mock.Call
+}
+
+// Pipeline is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+func (_e *MockClient_Expecter) Pipeline(repoID any, pipeline any) *MockClient_Pipeline_Call {
+	return &MockClient_Pipeline_Call{Call: _e.mock.On("Pipeline", repoID, pipeline)}
+}
+
+func (_c *MockClient_Pipeline_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_Pipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_Pipeline_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_Pipeline_Call {
+	_c.Call.Return(pipeline1, err)
+	return _c
+}
+
+func (_c *MockClient_Pipeline_Call) RunAndReturn(run func(repoID int64, pipeline int64) (*woodpecker.Pipeline, error)) *MockClient_Pipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PipelineApprove provides a mock function for the type MockClient
+func (_mock *MockClient) PipelineApprove(repoID int64, pipeline int64) (*woodpecker.Pipeline, error) {
+	ret := _mock.Called(repoID, pipeline)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineApprove")
+	}
+
+	var r0 *woodpecker.Pipeline
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Pipeline, error)); ok {
+		return returnFunc(repoID, pipeline)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Pipeline); ok {
+		r0 = returnFunc(repoID, pipeline)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.Pipeline)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = returnFunc(repoID, pipeline)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PipelineApprove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineApprove'
+type MockClient_PipelineApprove_Call struct {
+	*mock.Call
+}
+
+// PipelineApprove is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+func (_e *MockClient_Expecter) PipelineApprove(repoID any, pipeline any) *MockClient_PipelineApprove_Call {
+	return &MockClient_PipelineApprove_Call{Call: _e.mock.On("PipelineApprove", repoID, pipeline)}
+}
+
+func (_c *MockClient_PipelineApprove_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_PipelineApprove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PipelineApprove_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_PipelineApprove_Call {
+	_c.Call.Return(pipeline1, err)
+	return _c
+}
+
+func (_c *MockClient_PipelineApprove_Call) RunAndReturn(run func(repoID int64, pipeline int64) (*woodpecker.Pipeline, error)) *MockClient_PipelineApprove_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OrgSecretUpdate provides a mock function for the type MockClient
-func (_mock *MockClient) OrgSecretUpdate(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error) {
-	ret := _mock.Called(orgID, secret)
+// PipelineArtifactDownload provides a mock function for the type MockClient
+func (_mock *MockClient) PipelineArtifactDownload(repoID int64, pipeline int64, artifactID int64) (io.ReadCloser, error) {
+	ret := _mock.Called(repoID, pipeline, artifactID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OrgSecretUpdate")
+		panic("no return value specified for PipelineArtifactDownload")
 	}
 
-	var r0 *woodpecker.Secret
+	var r0 io.ReadCloser
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) (*woodpecker.Secret, error)); ok {
-		return returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, int64) (io.ReadCloser, error)); ok {
+		return returnFunc(repoID, pipeline, artifactID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.Secret) *woodpecker.Secret); ok {
-		r0 = returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, int64) io.ReadCloser); ok {
+		r0 = returnFunc(repoID, pipeline, artifactID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Secret)
+			r0 = ret.Get(0).(io.ReadCloser)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.Secret) error); ok {
-		r1 = returnFunc(orgID, secret)
+	if returnFunc, ok := ret.Get(1).(func(int64, int64, int64) error); ok {
+		r1 = returnFunc(repoID, pipeline, artifactID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_OrgSecretUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrgSecretUpdate'
-type MockClient_OrgSecretUpdate_Call struct {
+// MockClient_PipelineArtifactDownload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineArtifactDownload'
+type MockClient_PipelineArtifactDownload_Call struct {
 	*mock.Call
 }
 
-// OrgSecretUpdate is a helper method to define mock.On call
-//   - orgID int64
-//   - secret *woodpecker.Secret
-func (_e *MockClient_Expecter) OrgSecretUpdate(orgID any, secret any) *MockClient_OrgSecretUpdate_Call {
-	return &MockClient_OrgSecretUpdate_Call{Call: _e.mock.On("OrgSecretUpdate", orgID, secret)}
+// PipelineArtifactDownload is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+//   - artifactID int64
+func (_e *MockClient_Expecter) PipelineArtifactDownload(repoID any, pipeline any, artifactID any) *MockClient_PipelineArtifactDownload_Call {
+	return &MockClient_PipelineArtifactDownload_Call{Call: _e.mock.On("PipelineArtifactDownload", repoID, pipeline, artifactID)}
 }
 
-func (_c *MockClient_OrgSecretUpdate_Call) Run(run func(orgID int64, secret *woodpecker.Secret)) *MockClient_OrgSecretUpdate_Call {
+func (_c *MockClient_PipelineArtifactDownload_Call) Run(run func(repoID int64, pipeline int64, artifactID int64)) *MockClient_PipelineArtifactDownload_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
 			arg0 = args[0].(int64)
 		}
-		var arg1 *woodpecker.Secret
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(*woodpecker.Secret)
+			arg1 = args[1].(int64)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_OrgSecretUpdate_Call) Return(secret1 *woodpecker.Secret, err error) *MockClient_OrgSecretUpdate_Call {
-	_c.Call.Return(secret1, err)
+func (_c *MockClient_PipelineArtifactDownload_Call) Return(readCloser io.ReadCloser, err error) *MockClient_PipelineArtifactDownload_Call {
+	_c.Call.Return(readCloser, err)
 	return _c
 }
 
-func (_c *MockClient_OrgSecretUpdate_Call) RunAndReturn(run func(orgID int64, secret *woodpecker.Secret) (*woodpecker.Secret, error)) *MockClient_OrgSecretUpdate_Call {
+func (_c *MockClient_PipelineArtifactDownload_Call) RunAndReturn(run func(repoID int64, pipeline int64, artifactID int64) (io.ReadCloser, error)) *MockClient_PipelineArtifactDownload_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Pipeline provides a mock function for the type MockClient
-func (_mock *MockClient) Pipeline(repoID int64, pipeline int64) (*woodpecker.Pipeline, error) {
+// PipelineArtifacts provides a mock function for the type MockClient
+func (_mock *MockClient) PipelineArtifacts(repoID int64, pipeline int64) ([]*woodpecker.Artifact, error) {
 	ret := _mock.Called(repoID, pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Pipeline")
+		panic("no return value specified for PipelineArtifacts")
 	}
 
-	var r0 *woodpecker.Pipeline
+	var r0 []*woodpecker.Artifact
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Pipeline, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*woodpecker.Artifact, error)); ok {
 		return returnFunc(repoID, pipeline)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Pipeline); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*woodpecker.Artifact); ok {
 		r0 = returnFunc(repoID, pipeline)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Pipeline)
+			r0 = ret.Get(0).([]*woodpecker.Artifact)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
@@ -2377,19 +3234,19 @@ func (_mock *MockClient) Pipeline(repoID int64, pipeline int64) (*woodpecker.Pip
 	return r0, r1
 }
 
-// MockClient_Pipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pipeline'
-type MockClient_Pipeline_Call struct {
+// MockClient_PipelineArtifacts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineArtifacts'
+type MockClient_PipelineArtifacts_Call struct {
 	*mock.Call
 }
 
-// Pipeline is a helper method to define mock.On call
+// PipelineArtifacts is a helper method to define mock.On call
 //   - repoID int64
 //   - pipeline int64
-func (_e *MockClient_Expecter) Pipeline(repoID any, pipeline any) *MockClient_Pipeline_Call {
-	return &MockClient_Pipeline_Call{Call: _e.mock.On("Pipeline", repoID, pipeline)}
+func (_e *MockClient_Expecter) PipelineArtifacts(repoID any, pipeline any) *MockClient_PipelineArtifacts_Call {
+	return &MockClient_PipelineArtifacts_Call{Call: _e.mock.On("PipelineArtifacts", repoID, pipeline)}
 }
 
-func (_c *MockClient_Pipeline_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_Pipeline_Call {
+func (_c *MockClient_PipelineArtifacts_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_PipelineArtifacts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
@@ -2407,34 +3264,34 @@ func (_c *MockClient_Pipeline_Call) Run(run func(repoID int64, pipeline int64))
 	return _c
 }
 
-func (_c *MockClient_Pipeline_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_Pipeline_Call {
-	_c.Call.Return(pipeline1, err)
+func (_c *MockClient_PipelineArtifacts_Call) Return(artifacts []*woodpecker.Artifact, err error) *MockClient_PipelineArtifacts_Call {
+	_c.Call.Return(artifacts, err)
 	return _c
 }
 
-func (_c *MockClient_Pipeline_Call) RunAndReturn(run func(repoID int64, pipeline int64) (*woodpecker.Pipeline, error)) *MockClient_Pipeline_Call {
+func (_c *MockClient_PipelineArtifacts_Call) RunAndReturn(run func(repoID int64, pipeline int64) ([]*woodpecker.Artifact, error)) *MockClient_PipelineArtifacts_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PipelineApprove provides a mock function for the type MockClient
-func (_mock *MockClient) PipelineApprove(repoID int64, pipeline int64) (*woodpecker.Pipeline, error) {
+// PipelineAttempts provides a mock function for the type MockClient
+func (_mock *MockClient) PipelineAttempts(repoID int64, pipeline int64) ([]*woodpecker.WorkflowAttempt, error) {
 	ret := _mock.Called(repoID, pipeline)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PipelineApprove")
+		panic("no return value specified for PipelineAttempts")
 	}
 
-	var r0 *woodpecker.Pipeline
+	var r0 []*woodpecker.WorkflowAttempt
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) (*woodpecker.Pipeline, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) ([]*woodpecker.WorkflowAttempt, error)); ok {
 		return returnFunc(repoID, pipeline)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int64) *woodpecker.Pipeline); ok {
+	if returnFunc, ok := ret.Get(0).(func(int64, int64) []*woodpecker.WorkflowAttempt); ok {
 		r0 = returnFunc(repoID, pipeline)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*woodpecker.Pipeline)
+			r0 = ret.Get(0).([]*woodpecker.WorkflowAttempt)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(int64, int64) error); ok {
@@ -2445,19 +3302,19 @@ func (_mock *MockClient) PipelineApprove(repoID int64, pipeline int64) (*woodpec
 	return r0, r1
 }
 
-// MockClient_PipelineApprove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineApprove'
-type MockClient_PipelineApprove_Call struct {
+// MockClient_PipelineAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineAttempts'
+type MockClient_PipelineAttempts_Call struct {
 	*mock.Call
 }
 
-// PipelineApprove is a helper method to define mock.On call
+// PipelineAttempts is a helper method to define mock.On call
 //   - repoID int64
 //   - pipeline int64
-func (_e *MockClient_Expecter) PipelineApprove(repoID any, pipeline any) *MockClient_PipelineApprove_Call {
-	return &MockClient_PipelineApprove_Call{Call: _e.mock.On("PipelineApprove", repoID, pipeline)}
+func (_e *MockClient_Expecter) PipelineAttempts(repoID any, pipeline any) *MockClient_PipelineAttempts_Call {
+	return &MockClient_PipelineAttempts_Call{Call: _e.mock.On("PipelineAttempts", repoID, pipeline)}
 }
 
-func (_c *MockClient_PipelineApprove_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_PipelineApprove_Call {
+func (_c *MockClient_PipelineAttempts_Call) Run(run func(repoID int64, pipeline int64)) *MockClient_PipelineAttempts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int64
 		if args[0] != nil {
@@ -2475,12 +3332,12 @@ func (_c *MockClient_PipelineApprove_Call) Run(run func(repoID int64, pipeline i
 	return _c
 }
 
-func (_c *MockClient_PipelineApprove_Call) Return(pipeline1 *woodpecker.Pipeline, err error) *MockClient_PipelineApprove_Call {
-	_c.Call.Return(pipeline1, err)
+func (_c *MockClient_PipelineAttempts_Call) Return(attempts []*woodpecker.WorkflowAttempt, err error) *MockClient_PipelineAttempts_Call {
+	_c.Call.Return(attempts, err)
 	return _c
 }
 
-func (_c *MockClient_PipelineApprove_Call) RunAndReturn(run func(repoID int64, pipeline int64) (*woodpecker.Pipeline, error)) *MockClient_PipelineApprove_Call {
+func (_c *MockClient_PipelineAttempts_Call) RunAndReturn(run func(repoID int64, pipeline int64) ([]*woodpecker.WorkflowAttempt, error)) *MockClient_PipelineAttempts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3068,6 +3925,80 @@ func (_c *MockClient_PipelineStop_Call) RunAndReturn(run func(repoID int64, pipe
 	return _c
 }
 
+// PipelineTests provides a mock function for the type MockClient
+func (_mock *MockClient) PipelineTests(repoID int64, pipeline int64, opt woodpecker.PipelineTestsOptions) ([]*woodpecker.TestResult, error) {
+	ret := _mock.Called(repoID, pipeline, opt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineTests")
+	}
+
+	var r0 []*woodpecker.TestResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.PipelineTestsOptions) ([]*woodpecker.TestResult, error)); ok {
+		return returnFunc(repoID, pipeline, opt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, int64, woodpecker.PipelineTestsOptions) []*woodpecker.TestResult); ok {
+		r0 = returnFunc(repoID, pipeline, opt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*woodpecker.TestResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, int64, woodpecker.PipelineTestsOptions) error); ok {
+		r1 = returnFunc(repoID, pipeline, opt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PipelineTests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineTests'
+type MockClient_PipelineTests_Call struct {
+	*mock.Call
+}
+
+// PipelineTests is a helper method to define mock.On call
+//   - repoID int64
+//   - pipeline int64
+//   - opt woodpecker.PipelineTestsOptions
+func (_e *MockClient_Expecter) PipelineTests(repoID any, pipeline any, opt any) *MockClient_PipelineTests_Call {
+	return &MockClient_PipelineTests_Call{Call: _e.mock.On("PipelineTests", repoID, pipeline, opt)}
+}
+
+func (_c *MockClient_PipelineTests_Call) Run(run func(repoID int64, pipeline int64, opt woodpecker.PipelineTestsOptions)) *MockClient_PipelineTests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 woodpecker.PipelineTestsOptions
+		if args[2] != nil {
+			arg2 = args[2].(woodpecker.PipelineTestsOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PipelineTests_Call) Return(testResults []*woodpecker.TestResult, err error) *MockClient_PipelineTests_Call {
+	_c.Call.Return(testResults, err)
+	return _c
+}
+
+func (_c *MockClient_PipelineTests_Call) RunAndReturn(run func(repoID int64, pipeline int64, opt woodpecker.PipelineTestsOptions) ([]*woodpecker.TestResult, error)) *MockClient_PipelineTests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueueInfo provides a mock function for the type MockClient
 func (_mock *MockClient) QueueInfo() (*woodpecker.Info, error) {
 	ret := _mock.Called()
@@ -3989,6 +4920,187 @@ func (_c *MockClient_RepoRepair_Call) RunAndReturn(run func(repoID int64) error)
 	return _c
 }
 
+// RetentionPolicy provides a mock function for the type MockClient
+func (_mock *MockClient) RetentionPolicy(repoID int64) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(repoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetentionPolicy")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(repoID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(repoID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = returnFunc(repoID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RetentionPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicy'
+type MockClient_RetentionPolicy_Call struct {
+	*mock.Call
+}
+
+// RetentionPolicy is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockClient_Expecter) RetentionPolicy(repoID any) *MockClient_RetentionPolicy_Call {
+	return &MockClient_RetentionPolicy_Call{Call: _e.mock.On("RetentionPolicy", repoID)}
+}
+
+func (_c *MockClient_RetentionPolicy_Call) Run(run func(repoID int64)) *MockClient_RetentionPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicy_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_RetentionPolicy_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicy_Call) RunAndReturn(run func(repoID int64) (*woodpecker.RetentionPolicy, error)) *MockClient_RetentionPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetentionPolicyDelete provides a mock function for the type MockClient
+func (_mock *MockClient) RetentionPolicyDelete(repoID int64) error {
+	ret := _mock.Called(repoID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetentionPolicyDelete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = returnFunc(repoID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RetentionPolicyDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicyDelete'
+type MockClient_RetentionPolicyDelete_Call struct {
+	*mock.Call
+}
+
+// RetentionPolicyDelete is a helper method to define mock.On call
+//   - repoID int64
+func (_e *MockClient_Expecter) RetentionPolicyDelete(repoID any) *MockClient_RetentionPolicyDelete_Call {
+	return &MockClient_RetentionPolicyDelete_Call{Call: _e.mock.On("RetentionPolicyDelete", repoID)}
+}
+
+func (_c *MockClient_RetentionPolicyDelete_Call) Run(run func(repoID int64)) *MockClient_RetentionPolicyDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicyDelete_Call) Return(err error) *MockClient_RetentionPolicyDelete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicyDelete_Call) RunAndReturn(run func(repoID int64) error) *MockClient_RetentionPolicyDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetentionPolicySet provides a mock function for the type MockClient
+func (_mock *MockClient) RetentionPolicySet(repoID int64, policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error) {
+	ret := _mock.Called(repoID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetentionPolicySet")
+	}
+
+	var r0 *woodpecker.RetentionPolicy
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)); ok {
+		return returnFunc(repoID, policy)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64, *woodpecker.RetentionPolicy) *woodpecker.RetentionPolicy); ok {
+		r0 = returnFunc(repoID, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*woodpecker.RetentionPolicy)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64, *woodpecker.RetentionPolicy) error); ok {
+		r1 = returnFunc(repoID, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_RetentionPolicySet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetentionPolicySet'
+type MockClient_RetentionPolicySet_Call struct {
+	*mock.Call
+}
+
+// RetentionPolicySet is a helper method to define mock.On call
+//   - repoID int64
+//   - policy *woodpecker.RetentionPolicy
+func (_e *MockClient_Expecter) RetentionPolicySet(repoID any, policy any) *MockClient_RetentionPolicySet_Call {
+	return &MockClient_RetentionPolicySet_Call{Call: _e.mock.On("RetentionPolicySet", repoID, policy)}
+}
+
+func (_c *MockClient_RetentionPolicySet_Call) Run(run func(repoID int64, policy *woodpecker.RetentionPolicy)) *MockClient_RetentionPolicySet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		var arg1 *woodpecker.RetentionPolicy
+		if args[1] != nil {
+			arg1 = args[1].(*woodpecker.RetentionPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicySet_Call) Return(retentionPolicy *woodpecker.RetentionPolicy, err error) *MockClient_RetentionPolicySet_Call {
+	_c.Call.Return(retentionPolicy, err)
+	return _c
+}
+
+func (_c *MockClient_RetentionPolicySet_Call) RunAndReturn(run func(repoID int64, policy *woodpecker.RetentionPolicy) (*woodpecker.RetentionPolicy, error)) *MockClient_RetentionPolicySet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Secret provides a mock function for the type MockClient
 func (_mock *MockClient) Secret(repoID int64, secret string) (*woodpecker.Secret, error) {
 	ret := _mock.Called(repoID, secret)
@@ -18,11 +18,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 )
 
 const (
-	pathPipelineQueue    = "%s/api/pipelines"
-	pathPipelineMetadata = "%s/api/repos/%d/pipelines/%d/metadata"
+	pathPipelineQueue     = "%s/api/pipelines"
+	pathPipelineMetadata  = "%s/api/repos/%d/pipelines/%d/metadata"
+	pathPipelineAttempts  = "%s/api/repos/%d/pipelines/%d/attempts"
+	pathPipelineArtifacts = "%s/api/repos/%d/pipelines/%d/artifacts"
+	pathPipelineArtifact  = "%s/api/repos/%d/pipelines/%d/artifacts/%d"
+	pathPipelineTests     = "%s/api/repos/%d/pipelines/%d/tests"
 )
 
 // PipelineQueue returns a list of enqueued pipelines.
@@ -45,3 +50,36 @@ func (c *client) PipelineMetadata(repoID int64, pipelineNumber int) ([]byte, err
 
 	return io.ReadAll(body)
 }
+
+// PipelineAttempts returns the finished attempts of all workflows of a pipeline.
+func (c *client) PipelineAttempts(repoID, pipeline int64) ([]*WorkflowAttempt, error) {
+	var out []*WorkflowAttempt
+	uri := fmt.Sprintf(pathPipelineAttempts, c.addr, repoID, pipeline)
+	err := c.get(uri, &out)
+	return out, err
+}
+
+// PipelineArtifacts returns the artifacts uploaded by the workflows of a pipeline.
+func (c *client) PipelineArtifacts(repoID, pipeline int64) ([]*Artifact, error) {
+	var out []*Artifact
+	uri := fmt.Sprintf(pathPipelineArtifacts, c.addr, repoID, pipeline)
+	err := c.get(uri, &out)
+	return out, err
+}
+
+// PipelineArtifactDownload returns the content of an artifact, a gzip
+// compressed tar archive. The caller has to close it.
+func (c *client) PipelineArtifactDownload(repoID, pipeline, artifactID int64) (io.ReadCloser, error) {
+	uri := fmt.Sprintf(pathPipelineArtifact, c.addr, repoID, pipeline, artifactID)
+	return c.open(uri, http.MethodGet, nil)
+}
+
+// PipelineTests returns the results of the tests reported by the steps of a
+// pipeline, along with their history.
+func (c *client) PipelineTests(repoID, pipeline int64, opt PipelineTestsOptions) ([]*TestResult, error) {
+	var out []*TestResult
+	uri, _ := url.Parse(fmt.Sprintf(pathPipelineTests, c.addr, repoID, pipeline))
+	uri.RawQuery = opt.QueryEncode()
+	err := c.get(uri.String(), &out)
+	return out, err
+}
@@ -33,6 +33,7 @@ const (
 	pathPipeline       = "%s/api/repos/%d/pipelines/%v"
 	pathPipelineLogs   = "%s/api/repos/%d/logs/%d"
 	pathStepLogs       = "%s/api/repos/%d/logs/%d/%d"
+	pathLogSearch      = "%s/api/repos/%d/logs/search"
 	pathApprove        = "%s/api/repos/%d/pipelines/%d/approve"
 	pathDecline        = "%s/api/repos/%d/pipelines/%d/decline"
 	pathStop           = "%s/api/repos/%d/pipelines/%d/cancel"
@@ -75,6 +76,17 @@ type PipelineStartOptions struct {
 	Params map[string]string // custom KEY=value parameters to be injected into the step environment
 }
 
+type PipelineTestsOptions struct {
+	Status string // only return tests with the given status
+	Flaky  bool   // only return tests which passed and failed in recent pipelines
+}
+
+type LogSearchOptions struct {
+	Query   string    // text the log lines have to contain, ignoring case
+	Since   time.Time // only search pipelines created after this time
+	Context int       // number of lines returned around a match, the server default if zero
+}
+
 type PipelineLastOptions struct {
 	Branch string // last pipeline from given branch, an empty branch will result in the default branch
 }
@@ -111,6 +123,31 @@ func (opt *PipelineListOptions) QueryEncode() string {
 	return query.Encode()
 }
 
+// QueryEncode returns the URL query parameters for the PipelineTestsOptions.
+func (opt *PipelineTestsOptions) QueryEncode() string {
+	query := make(url.Values)
+	if opt.Status != "" {
+		query.Add("status", opt.Status)
+	}
+	if opt.Flaky {
+		query.Add("flaky", "true")
+	}
+	return query.Encode()
+}
+
+// QueryEncode returns the URL query parameters for the LogSearchOptions.
+func (opt *LogSearchOptions) QueryEncode() string {
+	query := make(url.Values)
+	query.Add("q", opt.Query)
+	if !opt.Since.IsZero() {
+		query.Add("since", opt.Since.Format(time.RFC3339))
+	}
+	if opt.Context != 0 {
+		query.Add("context", strconv.Itoa(opt.Context))
+	}
+	return query.Encode()
+}
+
 // QueryEncode returns the URL query parameters for the DeployOptions.
 func (opt *DeployOptions) QueryEncode() string {
 	query := mapValues(opt.Params)
@@ -424,6 +461,16 @@ func (c *client) StepLogEntries(repoID, num, step int64) ([]*LogEntry, error) {
 	return out, err
 }
 
+// LogSearch returns the lines of the logs of the recent pipelines of a repo
+// containing the query.
+func (c *client) LogSearch(repoID int64, opt LogSearchOptions) ([]*LogMatch, error) {
+	var out []*LogMatch
+	uri, _ := url.Parse(fmt.Sprintf(pathLogSearch, c.addr, repoID))
+	uri.RawQuery = opt.QueryEncode()
+	err := c.get(uri.String(), &out)
+	return out, err
+}
+
 // StepLogsPurge purges the pipeline logs for the specified step.
 func (c *client) StepLogsPurge(repoID, pipelineNumber, stepID int64) error {
 	uri := fmt.Sprintf(pathStepLogs, c.addr, repoID, pipelineNumber, stepID)
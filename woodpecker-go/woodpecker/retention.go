@@ -0,0 +1,89 @@
+// Copyright 2026 Woodpecker Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package woodpecker
+
+import "fmt"
+
+const (
+	pathRepoRetention   = "%s/api/repos/%d/retention"
+	pathOrgRetention    = "%s/api/orgs/%d/retention"
+	pathGlobalRetention = "%s/api/retention"
+)
+
+// RetentionPolicy returns the retention policy of a repository.
+func (c *client) RetentionPolicy(repoID int64) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// RetentionPolicySet creates or replaces the retention policy of a repository.
+func (c *client) RetentionPolicySet(repoID int64, in *RetentionPolicy) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	err := c.post(uri, in, out)
+	return out, err
+}
+
+// RetentionPolicyDelete deletes the retention policy of a repository.
+func (c *client) RetentionPolicyDelete(repoID int64) error {
+	uri := fmt.Sprintf(pathRepoRetention, c.addr, repoID)
+	return c.delete(uri)
+}
+
+// OrgRetentionPolicy returns the retention policy of an organization.
+func (c *client) OrgRetentionPolicy(orgID int64) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// OrgRetentionPolicySet creates or replaces the retention policy of an organization.
+func (c *client) OrgRetentionPolicySet(orgID int64, in *RetentionPolicy) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	err := c.post(uri, in, out)
+	return out, err
+}
+
+// OrgRetentionPolicyDelete deletes the retention policy of an organization.
+func (c *client) OrgRetentionPolicyDelete(orgID int64) error {
+	uri := fmt.Sprintf(pathOrgRetention, c.addr, orgID)
+	return c.delete(uri)
+}
+
+// GlobalRetentionPolicy returns the global retention policy.
+func (c *client) GlobalRetentionPolicy() (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathGlobalRetention, c.addr)
+	err := c.get(uri, out)
+	return out, err
+}
+
+// GlobalRetentionPolicySet creates or replaces the global retention policy.
+func (c *client) GlobalRetentionPolicySet(in *RetentionPolicy) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	uri := fmt.Sprintf(pathGlobalRetention, c.addr)
+	err := c.post(uri, in, out)
+	return out, err
+}
+
+// GlobalRetentionPolicyDelete deletes the global retention policy.
+func (c *client) GlobalRetentionPolicyDelete() error {
+	uri := fmt.Sprintf(pathGlobalRetention, c.addr)
+	return c.delete(uri)
+}
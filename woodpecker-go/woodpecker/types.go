@@ -67,6 +67,7 @@ type (
 		Branch                       string               `json:"default_branch,omitempty"`
 		SCMKind                      string               `json:"scm,omitempty"`
 		Timeout                      int64                `json:"timeout,omitempty"`
+		Priority                     int                  `json:"priority"`
 		Visibility                   string               `json:"visibility"`
 		IsSCMPrivate                 bool                 `json:"private"`
 		Trusted                      TrustedConfiguration `json:"trusted"`
@@ -92,6 +93,7 @@ type (
 		Trusted         *TrustedConfigurationPatch `json:"trusted,omitempty"`
 		RequireApproval *ApprovalMode              `json:"require_approval,omitempty"`
 		Timeout         *int64                     `json:"timeout,omitempty"`
+		Priority        *int                       `json:"priority,omitempty"`
 		Visibility      *string                    `json:"visibility"`
 		AllowPull       *bool                      `json:"allow_pr,omitempty"`
 		PipelineCounter *int                       `json:"pipeline_counter,omitempty"`
@@ -134,6 +136,7 @@ type (
 		Reviewed    int64            `json:"reviewed"`
 		Workflows   []*Workflow      `json:"workflows,omitempty"`
 		Release     *Release         `json:"release,omitempty"`
+		LogsDeleted bool             `json:"logs_deleted,omitempty"`
 	}
 
 	// Workflow represents a workflow in the pipeline.
@@ -148,9 +151,59 @@ type (
 		AgentID  int64             `json:"agent_id,omitempty"`
 		Platform string            `json:"platform,omitempty"`
 		Environ  map[string]string `json:"environ,omitempty"`
+		Attempt  int               `json:"attempt,omitempty"`
 		Children []*Step           `json:"children,omitempty"`
 	}
 
+	// WorkflowAttempt represents a finished attempt of a workflow.
+	WorkflowAttempt struct {
+		ID         int64  `json:"id"`
+		PipelineID int64  `json:"pipeline_id"`
+		WorkflowID int64  `json:"workflow_id"`
+		Attempt    int    `json:"attempt"`
+		AgentID    int64  `json:"agent_id"`
+		State      string `json:"state"`
+		Failure    string `json:"failure,omitempty"`
+		Error      string `json:"error,omitempty"`
+		Started    int64  `json:"started,omitempty"`
+		Stopped    int64  `json:"finished,omitempty"`
+	}
+
+	// Artifact represents an archive of files uploaded by a step.
+	Artifact struct {
+		ID         int64  `json:"id"`
+		PipelineID int64  `json:"pipeline_id"`
+		WorkflowID int64  `json:"workflow_id"`
+		Workflow   string `json:"workflow"`
+		Name       string `json:"name"`
+		Size       int64  `json:"size"`
+		Created    int64  `json:"created"`
+		Expires    int64  `json:"expires,omitempty"`
+	}
+
+	// TestResult represents the result of a test case reported by a step.
+	TestResult struct {
+		ID             int64      `json:"id"`
+		PipelineID     int64      `json:"pipeline_id"`
+		PipelineNumber int64      `json:"pipeline_number"`
+		StepID         int64      `json:"step_id"`
+		Suite          string     `json:"suite"`
+		Classname      string     `json:"classname"`
+		Name           string     `json:"name"`
+		Duration       int64      `json:"duration"`
+		Status         string     `json:"status"`
+		Message        string     `json:"message,omitempty"`
+		Details        string     `json:"details,omitempty"`
+		History        []*TestRun `json:"history,omitempty"`
+		Flaky          bool       `json:"flaky,omitempty"`
+	}
+
+	// TestRun represents the status of a test in a previous pipeline.
+	TestRun struct {
+		PipelineNumber int64  `json:"pipeline_number"`
+		Status         string `json:"status"`
+	}
+
 	// Step represents a process in the pipeline.
 	Step struct {
 		ID       int64    `json:"id"`
@@ -175,6 +228,42 @@ type (
 		Password string `json:"password,omitempty"`
 	}
 
+	// PersonalAccessToken is a named token of a user, limited to its scopes.
+	PersonalAccessToken struct {
+		ID       int64        `json:"id"`
+		Name     string       `json:"name"`
+		Scopes   []TokenScope `json:"scopes"`
+		Created  int64        `json:"created"`
+		Expires  int64        `json:"expires"`
+		LastUsed int64        `json:"last_used"`
+	}
+
+	// PersonalAccessTokenCreate is the request to create a personal access token.
+	PersonalAccessTokenCreate struct {
+		Name    string       `json:"name"`
+		Scopes  []TokenScope `json:"scopes"`
+		Expires int64        `json:"expires"`
+	}
+
+	// NewPersonalAccessToken is a created personal access token including its value.
+	NewPersonalAccessToken struct {
+		PersonalAccessToken
+		Token string `json:"token"`
+	}
+
+	// RetentionPolicy defines how long pipelines and their logs are kept.
+	RetentionPolicy struct {
+		ID              int64 `json:"id"`
+		OrgID           int64 `json:"org_id"`
+		RepoID          int64 `json:"repo_id"`
+		KeepPipelines   int   `json:"keep_pipelines"`
+		PipelineDays    int   `json:"pipeline_days"`
+		LogDays         int   `json:"log_days"`
+		KeepLastSuccess bool  `json:"keep_last_success"`
+		Created         int64 `json:"created"`
+		Updated         int64 `json:"updated"`
+	}
+
 	// Release represents pipeline release metadata.
 	Release struct {
 		Title        string `json:"title,omitempty"`
@@ -255,6 +344,27 @@ type (
 		Type   LogEntryType `json:"type"`
 	}
 
+	// LogMatch is a line of the logs of a step matching a search.
+	LogMatch struct {
+		PipelineNumber int64    `json:"pipeline_number"`
+		StepID         int64    `json:"step_id"`
+		StepName       string   `json:"step_name"`
+		Line           int      `json:"line"`
+		Text           string   `json:"text"`
+		Before         []string `json:"before,omitempty"`
+		After          []string `json:"after,omitempty"`
+	}
+
+	// LogCommand is the data of a log entry of type LogEntryMetadata, it
+	// marks where a command of a step starts or ends.
+	LogCommand struct {
+		Event    string `json:"event"`
+		Index    int    `json:"index"`
+		Started  int64  `json:"started"`
+		Duration int64  `json:"duration,omitempty"`
+		ExitCode *int   `json:"exit_code,omitempty"`
+	}
+
 	// Cron is the JSON data of a cron job.
 	Cron struct {
 		ID        int64  `json:"id"`
@@ -266,6 +376,7 @@ type (
 		Created   int64  `json:"created"`
 		Branch    string `json:"branch"`
 		Enabled   bool   `json:"enabled"`
+		Priority  *int   `json:"priority,omitempty"`
 	}
 
 	// PipelineOptions is the JSON data for creating a new pipeline.
@@ -291,6 +402,15 @@ type (
 		Version      string            `json:"version"`
 		NoSchedule   bool              `json:"no_schedule"`
 		CustomLabels map[string]string `json:"custom_labels"`
+		Resources    Resources         `json:"resources"`
+	}
+
+	// Resources are the CPU, memory and disk requested by a workflow or
+	// allocatable on an agent.
+	Resources struct {
+		CPU    int64 `json:"cpu"`    // in millicores
+		Memory int64 `json:"memory"` // in bytes
+		Disk   int64 `json:"disk"`   // in bytes
 	}
 
 	// Task is the JSON data for a task.
@@ -301,12 +421,19 @@ type (
 		RunOn        []string          `json:"run_on"`
 		DepStatus    map[string]string `json:"dep_status"`
 		AgentID      int64             `json:"agent_id"`
+		Resources    Resources         `json:"resources"`
 	}
 
 	// Org is the JSON data for an organization.
 	Org struct {
-		ID     int64  `json:"id"`
-		Name   string `json:"name"`
-		IsUser bool   `json:"is_user"`
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		IsUser      bool   `json:"is_user"`
+		ShareWeight int    `json:"share_weight"`
+	}
+
+	// OrgPatch defines an organization patch request.
+	OrgPatch struct {
+		ShareWeight *int `json:"share_weight,omitempty"`
 	}
 )
@@ -24,6 +24,9 @@ const (
 	pathRepos = "%s/api/user/repos"
 	pathUsers = "%s/api/users"
 	pathUser  = "%s/api/users/%s?forge_id=%d"
+
+	pathPersonalAccessTokens = "%s/api/user/tokens"
+	pathPersonalAccessToken  = "%s/api/user/tokens/%d"
 )
 
 type RepoListOptions struct {
@@ -99,6 +102,30 @@ func (c *client) UserDel(login string, forgeID ...int64) error {
 	return c.delete(fmt.Sprintf(pathUser, c.addr, login, forgeID[0]))
 }
 
+// PersonalAccessTokenList returns the personal access tokens of the
+// currently authenticated user.
+func (c *client) PersonalAccessTokenList() ([]*PersonalAccessToken, error) {
+	var out []*PersonalAccessToken
+	uri := fmt.Sprintf(pathPersonalAccessTokens, c.addr)
+	err := c.get(uri, &out)
+	return out, err
+}
+
+// PersonalAccessTokenCreate creates a personal access token, the token
+// value is only returned once.
+func (c *client) PersonalAccessTokenCreate(in *PersonalAccessTokenCreate) (*NewPersonalAccessToken, error) {
+	out := new(NewPersonalAccessToken)
+	uri := fmt.Sprintf(pathPersonalAccessTokens, c.addr)
+	err := c.post(uri, in, out)
+	return out, err
+}
+
+// PersonalAccessTokenDelete revokes a personal access token.
+func (c *client) PersonalAccessTokenDelete(tokenID int64) error {
+	uri := fmt.Sprintf(pathPersonalAccessToken, c.addr, tokenID)
+	return c.delete(uri)
+}
+
 // RepoList returns a list of all repositories to which
 // the user has explicit access in the host system.
 func (c *client) RepoList(opt RepoListOptions) ([]*Repo, error) {
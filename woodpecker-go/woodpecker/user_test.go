@@ -351,3 +351,62 @@ func TestClient_RepoList(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_PersonalAccessTokenCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		handler  http.HandlerFunc
+		expected *NewPersonalAccessToken
+		wantErr  bool
+	}{
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/user/tokens" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, err := fmt.Fprint(w, `{"id":1,"name":"deploy","scopes":["pipeline:write"],"expires":1700000000,"token":"secret"}`)
+				assert.NoError(t, err)
+			},
+			expected: &NewPersonalAccessToken{
+				PersonalAccessToken: PersonalAccessToken{
+					ID:      1,
+					Name:    "deploy",
+					Scopes:  []TokenScope{TokenScopePipelineWrite},
+					Expires: 1700000000,
+				},
+				Token: "secret",
+			},
+		},
+		{
+			name: "missing scope",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusForbidden)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(tt.handler)
+			defer ts.Close()
+
+			client := NewClient(ts.URL, http.DefaultClient)
+			token, err := client.PersonalAccessTokenCreate(&PersonalAccessTokenCreate{
+				Name:    "deploy",
+				Scopes:  []TokenScope{TokenScopePipelineWrite},
+				Expires: 1700000000,
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, token)
+		})
+	}
+}